@@ -0,0 +1,372 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"mcp-digitalocean/internal/cache"
+	"mcp-digitalocean/pkg/registry"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// doctorTimeout bounds the API checks of the doctor command, including the client's retries.
+const doctorTimeout = 30 * time.Second
+
+// parseInterspersed parses flags that may appear before, between or after positional arguments,
+// and returns the positional arguments.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// newCLILogger logs to stderr so the output of the tools and doctor commands stays clean on stdout.
+func newCLILogger(cfg *config) *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: parseLogLevel(cfg.logLevel)}))
+}
+
+// runTools runs the tools subcommands and returns the exit code.
+func runTools(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "usage: tools list [--json] | tools call <name> [--args JSON]")
+		return 2
+	}
+	switch args[0] {
+	case "list":
+		return runToolsList(args[1:], os.Stdout)
+	case "call":
+		return runToolsCall(args[1:], os.Stdout)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown tools command %q\n", args[0])
+		return 2
+	}
+}
+
+// runToolsList prints the tools that the server would register with the given flags.
+func runToolsList(args []string, out io.Writer) int {
+	var cfg config
+	fs := flag.NewFlagSet("tools list", flag.ExitOnError)
+	registerFlags(fs, &cfg)
+	asJSON := fs.Bool("json", false, "Print the full tool definitions, including input schemas, as JSON")
+	_ = fs.Parse(args)
+
+	// listing tools never calls the API, so no token is needed.
+	getClientFn := func(ctx context.Context) (*godo.Client, error) {
+		return nil, errors.New("the tools list command does not call the API")
+	}
+	svr, catalog, err := newMCPServer(newCLILogger(&cfg), &cfg, getClientFn, cache.New(cfg.cacheTTL, nil), nil, nil, nil)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if err := printTools(out, svr, catalog, *asJSON); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// printTools writes the registered tools sorted by name, either as a table or as JSON.
+func printTools(out io.Writer, svr *server.MCPServer, catalog registry.Catalog, asJSON bool) error {
+	registered := svr.ListTools()
+	names := make([]string, 0, len(registered))
+	for name := range registered {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if asJSON {
+		tools := make([]mcp.Tool, 0, len(names))
+		for _, name := range names {
+			tools = append(tools, registered[name].Tool)
+		}
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(tools)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSERVICE\tDESCRIPTION")
+	for _, name := range names {
+		svc := catalog[name]
+		if svc == "" {
+			// tools added by the server itself, such as do-capabilities.
+			svc = "server"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", name, svc, firstSentence(registered[name].Tool.Description))
+	}
+	return w.Flush()
+}
+
+// firstSentence shortens a tool description to its first sentence for the table output.
+func firstSentence(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if i := strings.Index(s, ". "); i >= 0 {
+		return s[:i+1]
+	}
+	return s
+}
+
+// runToolsCall calls one tool through the server, so the same middleware applies as for MCP
+// clients, and prints its result. The exit code is 1 when the tool returns an error.
+func runToolsCall(args []string, out io.Writer) int {
+	var cfg config
+	fs := flag.NewFlagSet("tools call", flag.ExitOnError)
+	registerFlags(fs, &cfg)
+	argsJSON := fs.String("args", "{}", "Tool arguments as a JSON object, or @file to read them from a file")
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	if len(positional) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: tools call <name> [--args JSON]")
+		return 2
+	}
+	name := positional[0]
+
+	toolArgs, err := parseToolArgs(*argsJSON)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	ctx, stop := signalContext()
+	defer stop()
+
+	if _, err := resolveToken(ctx, &cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	apiHTTPClient, err := newAPIHTTPClient(&cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	godoClient, err := newGodoClientWithTokenAndEndpoint(ctx, cfg.token, cfg.endpoint, cfg.userAgent, newRateLimiter(&cfg), apiHTTPClient)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to create DigitalOcean client: "+err.Error())
+		return 1
+	}
+	getClientFn := func(ctx context.Context) (*godo.Client, error) {
+		return godoClient, nil
+	}
+	svr, _, err := newMCPServer(newCLILogger(&cfg), &cfg, getClientFn, cache.New(cfg.cacheTTL, nil), nil, nil, nil)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if svr.GetTool(name) == nil {
+		fmt.Fprintf(os.Stderr, "tool %q is not registered; run \"tools list\" to see the available tools\n", name)
+		return 1
+	}
+
+	result, err := callTool(ctx, svr, name, toolArgs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if err := printToolResult(out, result); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if result.IsError {
+		return 1
+	}
+	return 0
+}
+
+// parseToolArgs decodes the --args value, reading it from a file when it starts with @.
+func parseToolArgs(value string) (map[string]any, error) {
+	data := []byte(value)
+	if path, ok := strings.CutPrefix(value, "@"); ok {
+		var err error
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read arguments: %w", err)
+		}
+	}
+	var args map[string]any
+	if err := json.Unmarshal(data, &args); err != nil {
+		return nil, fmt.Errorf("arguments must be a JSON object: %w", err)
+	}
+	return args, nil
+}
+
+// callTool sends a tools/call request to the server and returns the tool's result.
+func callTool(ctx context.Context, svr *server.MCPServer, name string, args map[string]any) (*mcp.CallToolResult, error) {
+	message, err := json.Marshal(mcp.JSONRPCRequest{
+		JSONRPC: mcp.JSONRPC_VERSION,
+		ID:      mcp.NewRequestId(1),
+		Request: mcp.Request{Method: string(mcp.MethodToolsCall)},
+		Params:  mcp.CallToolParams{Name: name, Arguments: args},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	switch resp := svr.HandleMessage(ctx, message).(type) {
+	case mcp.JSONRPCResponse:
+		result, ok := resp.Result.(*mcp.CallToolResult)
+		if !ok {
+			return nil, fmt.Errorf("unexpected tool result type %T", resp.Result)
+		}
+		return result, nil
+	case mcp.JSONRPCError:
+		return nil, fmt.Errorf("tool call failed: %s", resp.Error.Message)
+	default:
+		return nil, fmt.Errorf("unexpected response type %T", resp)
+	}
+}
+
+// printToolResult writes the text content of a tool result, and any other content as JSON.
+func printToolResult(out io.Writer, result *mcp.CallToolResult) error {
+	for _, content := range result.Content {
+		if text, ok := content.(mcp.TextContent); ok {
+			if _, err := fmt.Fprintln(out, text.Text); err != nil {
+				return err
+			}
+			continue
+		}
+		data, err := json.MarshalIndent(content, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal error: %w", err)
+		}
+		if _, err := fmt.Fprintln(out, string(data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// doctorCheck is the outcome of one doctor check.
+type doctorCheck struct {
+	name   string
+	ok     bool
+	detail string
+}
+
+// runDoctor checks the token, the API endpoint and the tool configuration, and prints one line per check.
+func runDoctor(args []string) int {
+	var cfg config
+	fs := flag.NewFlagSet("doctor", flag.ExitOnError)
+	registerFlags(fs, &cfg)
+	_ = fs.Parse(args)
+
+	ctx, stop := signalContext()
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, doctorTimeout)
+	defer cancel()
+
+	checks := doctorChecks(ctx, &cfg, newCLILogger(&cfg))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	failed := false
+	for _, c := range checks {
+		status := "ok"
+		if !c.ok {
+			status = "FAIL"
+			failed = true
+		}
+		fmt.Fprintf(w, "[%s]\t%s\t%s\n", status, c.name, c.detail)
+	}
+	_ = w.Flush()
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+// doctorChecks runs the doctor checks in order. Checks that depend on a failed one are skipped.
+func doctorChecks(ctx context.Context, cfg *config, logger *slog.Logger) []doctorCheck {
+	var checks []doctorCheck
+
+	if endpoint, err := url.Parse(cfg.endpoint); err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		checks = append(checks, doctorCheck{name: "endpoint", detail: fmt.Sprintf("%q is not an absolute URL", cfg.endpoint)})
+	} else {
+		checks = append(checks, doctorCheck{name: "endpoint", ok: true, detail: cfg.endpoint})
+	}
+
+	apiHTTPClient, err := newAPIHTTPClient(cfg)
+	if err != nil {
+		checks = append(checks, doctorCheck{name: "connection", detail: err.Error()})
+	} else {
+		checks = append(checks, doctorCheck{name: "connection", ok: true, detail: describeAPIConnection(cfg)})
+	}
+
+	var godoClient *godo.Client
+	if source, err := resolveToken(ctx, cfg); err != nil {
+		checks = append(checks, doctorCheck{name: "token", detail: err.Error()})
+	} else {
+		checks = append(checks, doctorCheck{name: "token", ok: true, detail: "provided by " + source})
+		client, err := newGodoClientWithTokenAndEndpoint(ctx, cfg.token, cfg.endpoint, cfg.userAgent, newRateLimiter(cfg), apiHTTPClient)
+		if err != nil {
+			checks = append(checks, doctorCheck{name: "client", detail: err.Error()})
+		} else {
+			godoClient = client
+		}
+	}
+
+	if godoClient != nil && checks[0].ok && apiHTTPClient != nil {
+		start := time.Now()
+		account, _, err := godoClient.Account.Get(ctx)
+		if err != nil {
+			checks = append(checks, doctorCheck{name: "api", detail: err.Error()})
+		} else {
+			checks = append(checks, doctorCheck{
+				name: "api",
+				ok:   true,
+				detail: fmt.Sprintf("authenticated as %s (status %s, droplet limit %d) in %s",
+					account.Email, account.Status, account.DropletLimit, time.Since(start).Round(time.Millisecond)),
+			})
+		}
+	}
+
+	getClientFn := func(ctx context.Context) (*godo.Client, error) {
+		if godoClient == nil {
+			return nil, errors.New("no DigitalOcean client configured")
+		}
+		return godoClient, nil
+	}
+	svr, catalog, err := newMCPServer(logger, cfg, getClientFn, cache.New(cfg.cacheTTL, nil), nil, nil, nil)
+	if err != nil {
+		checks = append(checks, doctorCheck{name: "tools", detail: err.Error()})
+		return checks
+	}
+	services := catalog.Services()
+	checks = append(checks, doctorCheck{
+		name:   "tools",
+		ok:     len(catalog) > 0,
+		detail: fmt.Sprintf("%d tools registered for %d services (%s)", len(svr.ListTools()), len(services), strings.Join(services, ",")),
+	})
+	return checks
+}
+
+// signalContext returns a context cancelled on Ctrl+C or SIGTERM, so a long tool call can be interrupted.
+func signalContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestParseInterspersed(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	services := fs.String("services", "", "")
+	args := fs.String("args", "{}", "")
+
+	positional, err := parseInterspersed(fs, []string{"--services", "droplets", "droplet-get", "--args", `{"ID":1}`})
+	if err != nil {
+		t.Fatalf("parseInterspersed() error = %v", err)
+	}
+	if len(positional) != 1 || positional[0] != "droplet-get" {
+		t.Fatalf("unexpected positional arguments %v", positional)
+	}
+	if *services != "droplets" || *args != `{"ID":1}` {
+		t.Fatalf("flags not parsed: services=%q args=%q", *services, *args)
+	}
+}
+
+func TestParseToolArgs(t *testing.T) {
+	args, err := parseToolArgs(`{"ID": 42, "Name": "web"}`)
+	if err != nil {
+		t.Fatalf("parseToolArgs() error = %v", err)
+	}
+	if args["ID"] != float64(42) || args["Name"] != "web" {
+		t.Fatalf("unexpected arguments %v", args)
+	}
+	if _, err := parseToolArgs(`[1, 2]`); err == nil {
+		t.Fatal("expected an error for a non-object argument")
+	}
+}
+
+func TestRunToolsList(t *testing.T) {
+	var out bytes.Buffer
+	if code := runToolsList([]string{"--services", "accounts", "--disable-tools", "action-*"}, &out); code != 0 {
+		t.Fatalf("runToolsList() exit code = %d", code)
+	}
+	table := out.String()
+	if !strings.Contains(table, "key-list") || !strings.Contains(table, "accounts") {
+		t.Fatalf("expected key-list of the accounts service, got:\n%s", table)
+	}
+	if strings.Contains(table, "action-get") {
+		t.Fatalf("disabled tool listed:\n%s", table)
+	}
+	if !strings.Contains(table, "do-capabilities") {
+		t.Fatalf("server tool missing:\n%s", table)
+	}
+}
+
+func TestRunToolsList_annotations(t *testing.T) {
+	var out bytes.Buffer
+	if code := runToolsList([]string{"--services", "droplets", "--json"}, &out); code != 0 {
+		t.Fatalf("runToolsList() exit code = %d", code)
+	}
+	var tools []mcp.Tool
+	if err := json.Unmarshal(out.Bytes(), &tools); err != nil {
+		t.Fatal(err)
+	}
+	annotations := map[string]mcp.ToolAnnotation{}
+	for _, tool := range tools {
+		annotations[tool.Name] = tool.Annotations
+	}
+	for name, want := range map[string][3]bool{
+		"droplet-list":    {true, false, true},
+		"droplet-create":  {false, false, false},
+		"droplet-delete":  {false, true, true},
+		"cleanup-by-tag":  {false, true, true},
+		"userdata-render": {true, false, true},
+	} {
+		a, ok := annotations[name]
+		if !ok {
+			t.Fatalf("%s not listed", name)
+		}
+		if got := [3]bool{*a.ReadOnlyHint, *a.DestructiveHint, *a.IdempotentHint}; got != want {
+			t.Errorf("%s read-only, destructive, idempotent hints = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestRunToolsCall(t *testing.T) {
+	var requests atomic.Int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/v2/account/keys" || r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ssh_keys":[{"id":7,"name":"laptop"}],"meta":{"total":1}}`))
+	}))
+	defer api.Close()
+
+	var out bytes.Buffer
+	code := runToolsCall([]string{
+		"key-list",
+		"--services", "accounts",
+		"--digitalocean-api-token", "test-token",
+		"--digitalocean-api-endpoint", api.URL,
+		"--args", `{"Page": 1}`,
+	}, &out)
+	if code != 0 {
+		t.Fatalf("runToolsCall() exit code = %d, output:\n%s", code, out.String())
+	}
+	if requests.Load() != 1 || !strings.Contains(out.String(), "laptop") {
+		t.Fatalf("unexpected result after %d requests:\n%s", requests.Load(), out.String())
+	}
+}
+
+func TestRunToolsCall_dryRun(t *testing.T) {
+	var writes atomic.Int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writes.Add(1)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer api.Close()
+
+	var out bytes.Buffer
+	code := runToolsCall([]string{
+		"key-delete",
+		"--services", "accounts",
+		"--digitalocean-api-token", "test-token",
+		"--digitalocean-api-endpoint", api.URL,
+		"--args", `{"ID": 7, "DryRun": true}`,
+	}, &out)
+	if code != 0 {
+		t.Fatalf("runToolsCall() exit code = %d, output:\n%s", code, out.String())
+	}
+	if writes.Load() != 0 || !strings.Contains(out.String(), `"dry_run": true`) {
+		t.Fatalf("dry run was not applied, %d writes:\n%s", writes.Load(), out.String())
+	}
+}
+
+func TestRunToolsCall_policy(t *testing.T) {
+	var writes atomic.Int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writes.Add(1)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer api.Close()
+
+	policyFile := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(policyFile, []byte("allowed-regions: [nyc3]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	code := runToolsCall([]string{
+		"volume-create",
+		"--services", "volumes",
+		"--digitalocean-api-token", "test-token",
+		"--digitalocean-api-endpoint", api.URL,
+		"--policy-file", policyFile,
+		"--args", `{"Name": "data", "SizeGigaBytes": 10, "Region": "sfo3"}`,
+	}, &out)
+	if code != 1 {
+		t.Fatalf("runToolsCall() exit code = %d, want 1, output:\n%s", code, out.String())
+	}
+	if writes.Load() != 0 || !strings.Contains(out.String(), "policy violation") {
+		t.Fatalf("policy was not enforced, %d writes:\n%s", writes.Load(), out.String())
+	}
+}
@@ -5,21 +5,52 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
 	middleware "mcp-digitalocean/internal"
+	"mcp-digitalocean/internal/annotate"
+	"mcp-digitalocean/internal/apierror"
+	"mcp-digitalocean/internal/audit"
+	"mcp-digitalocean/internal/cache"
+	"mcp-digitalocean/internal/capabilities"
+	"mcp-digitalocean/internal/clientlog"
+	"mcp-digitalocean/internal/coerce"
+	"mcp-digitalocean/internal/confirm"
+	"mcp-digitalocean/internal/correlation"
+	"mcp-digitalocean/internal/credentials"
+	"mcp-digitalocean/internal/drain"
+	"mcp-digitalocean/internal/dryrun"
+	"mcp-digitalocean/internal/health"
+	"mcp-digitalocean/internal/managedtag"
+	"mcp-digitalocean/internal/metrics"
 	"mcp-digitalocean/internal/oauthmeta"
 	"mcp-digitalocean/internal/openaichallenge"
+	"mcp-digitalocean/internal/pagination"
+	"mcp-digitalocean/internal/policy"
+	"mcp-digitalocean/internal/profiles"
+	"mcp-digitalocean/internal/ratelimit"
+	"mcp-digitalocean/internal/redact"
+	"mcp-digitalocean/internal/serverinfo"
+	"mcp-digitalocean/internal/sessionstate"
+	"mcp-digitalocean/internal/shape"
+	"mcp-digitalocean/internal/subscriptions"
+	"mcp-digitalocean/internal/toolfilter"
+	"mcp-digitalocean/internal/tracing"
+	"mcp-digitalocean/internal/validate"
 	"mcp-digitalocean/internal/wslogging"
 	"mcp-digitalocean/pkg/registry"
+	"mcp-digitalocean/pkg/registry/account"
 
 	"github.com/digitalocean/godo"
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/mark3labs/mcp-go/server"
 	"golang.org/x/oauth2"
 )
@@ -31,6 +62,10 @@ const (
 	// mcpEndpointPath is the path the streamable HTTP server serves the MCP
 	// protocol on. It matches mcp-go's default so existing clients are unaffected.
 	mcpEndpointPath = "/mcp"
+	// sseEndpointPath and sseMessageEndpointPath are the paths of the legacy SSE
+	// transport's event stream and message endpoint, matching mcp-go's defaults.
+	sseEndpointPath        = "/sse"
+	sseMessageEndpointPath = "/message"
 )
 
 // getEnv retrieves the value of the environment variable named by the key.
@@ -42,34 +77,231 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
-func main() {
-	logLevelFlag := flag.String("log-level", getEnv("LOG_LEVEL", "info"), "Log level: debug, info, warn, error")
-	serviceFlag := flag.String("services", getEnv("SERVICES", ""), "Comma-separated list of services to activate (e.g., apps,networking,droplets)")
-	tokenFlag := flag.String("digitalocean-api-token", getEnv("DIGITALOCEAN_API_TOKEN", ""), "DigitalOcean API token")
-	endpointFlag := flag.String("digitalocean-api-endpoint", getEnv("DIGITALOCEAN_API_ENDPOINT", "https://api.digitalocean.com"), "DigitalOcean API endpoint")
-	transport := flag.String("transport", getEnv("TRANSPORT", "stdio"), "The transport protocol to use (http or stdio). Default is stdio.")
-	bindAddr := flag.String("bind-addr", getEnv("BIND_ADDR", "127.0.0.1:8080"), "Bind address to bind to. Only used for http transport.")
-	wsLoggingURL := flag.String("ws-logging-url", getEnv("WS_LOGGING_URL", ""), "WebSocket URL for WebSocket logging (optional)")
-	wsLoggingToken := flag.String("ws-logging-token", getEnv("WS_LOGGING_TOKEN", ""), "Authentication token for WebSocket logging (optional)")
-	enableToolErrorLogging := flag.Bool("enable-tool-error-logging", getEnv("ENABLE_TOOL_ERROR_LOGGING", "false") == "true", "Enable logging of tool errors")
-	serverURLFlag := flag.String("mcp-resource-url", getEnv("MCP_RESOURCE_URL", ""), "This server's public base URL advertised in the OAuth protected resource metadata. When empty, it is derived from each request (remote transport only, optional)")
-	openaiAppsVerificationTokenFlag := flag.String("openai-apps-verification-token", getEnv("OPENAI_APPS_VERIFICATION_TOKEN", ""), "Plain-text token served at /.well-known/openai-apps-challenge for OpenAI ChatGPT app domain verification (remote transport only, optional)")
-	userAgent := flag.String("user-agent", getEnv("USER_AGENT", ""), "Indicate this server is running as a remote MCP ")
-	flag.Parse()
-
-	var level slog.Level
-	switch strings.ToLower(*logLevelFlag) {
+// getEnvDuration parses the environment variable named by the key as a duration.
+// If the variable is empty, not present or invalid, it returns the fallback value.
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil {
+		return d
+	}
+	return fallback
+}
+
+// getEnvFloat parses the environment variable named by the key as a float.
+// If the variable is empty, not present or invalid, it returns the fallback value.
+func getEnvFloat(key string, fallback float64) float64 {
+	if f, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil {
+		return f
+	}
+	return fallback
+}
+
+// getEnvInt parses the environment variable named by the key as an integer.
+// If the variable is empty, not present or invalid, it returns the fallback value.
+func getEnvInt(key string, fallback int) int {
+	if i, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return i
+	}
+	return fallback
+}
+
+// newRateLimiter returns the limiter configured by --rate-limit and --max-in-flight, or nil when both are off.
+func newRateLimiter(cfg *config) *ratelimit.Limiter {
+	return ratelimit.New(cfg.rateLimit, cfg.rateLimitBurst, cfg.maxInFlight)
+}
+
+// config holds the flag values shared by the serve, tools and doctor commands.
+type config struct {
+	logLevel               string
+	services               string
+	token                  string
+	endpoint               string
+	apiCABundle            string
+	apiProxy               string
+	apiTimeout             time.Duration
+	userAgent              string
+	enableToolErrorLogging bool
+	enableTools            string
+	disableTools           string
+	toolsConfig            string
+	confirmDestructive     bool
+	authSource             string
+	authFile               string
+	doctlConfig            string
+	doctlContext           string
+	profilesFile           string
+	profile                string
+	cacheTTL               time.Duration
+	compactOutput          bool
+	rateLimit              float64
+	rateLimitBurst         int
+	maxInFlight            int
+	kubeconfigDir          string
+	spacesAccessKeyID      string
+	spacesSecretAccessKey  string
+	dropletExecKeyFile     string
+	dropletExecKnownHosts  string
+
+	// the remaining flags are only used by the serve command.
+	transport                   string
+	bindAddr                    string
+	wsLoggingURL                string
+	wsLoggingToken              string
+	serverURL                   string
+	openaiAppsVerificationToken string
+	subscriptionPollInterval    time.Duration
+	httpStateful                bool
+	httpSessionIdleTimeout      time.Duration
+	httpHeartbeatInterval       time.Duration
+	shutdownTimeout             time.Duration
+	readinessCacheTTL           time.Duration
+	enableMetrics               bool
+	enableTracing               bool
+	auditLogFile                string
+	auditWebhookURL             string
+	auditWebhookToken           string
+	sessionStateFile            string
+	managedTag                  string
+	managedTagSession           bool
+	policyFile                  string
+	maxMonthlySpend             float64
+	maxMonthlySpendConfirm      bool
+	oauthAuthorizationServer    string
+	oauthTokenValidation        string
+	oauthIntrospectionURL       string
+	oauthClientID               string
+	oauthClientSecret           string
+	oauthTokenMap               string
+}
+
+// registerFlags defines the flags that configure the DigitalOcean client and the registered tools.
+func registerFlags(fs *flag.FlagSet, cfg *config) {
+	fs.StringVar(&cfg.logLevel, "log-level", getEnv("LOG_LEVEL", "info"), "Log level: debug, info, warn, error")
+	fs.StringVar(&cfg.services, "services", getEnv("SERVICES", ""), "Comma-separated list of services to activate (e.g., apps,networking,droplets)")
+	fs.StringVar(&cfg.token, "digitalocean-api-token", getEnv("DIGITALOCEAN_API_TOKEN", ""), "DigitalOcean API token")
+	fs.StringVar(&cfg.endpoint, "digitalocean-api-endpoint", getEnv("DIGITALOCEAN_API_ENDPOINT", "https://api.digitalocean.com"), "DigitalOcean API endpoint")
+	fs.StringVar(&cfg.apiCABundle, "digitalocean-api-ca-bundle", getEnv("DIGITALOCEAN_API_CA_BUNDLE", ""), "PEM file of CA certificates trusted for the DigitalOcean API endpoint in addition to the system roots, e.g. of a TLS-inspecting proxy (optional)")
+	fs.StringVar(&cfg.apiProxy, "digitalocean-api-proxy", getEnv("DIGITALOCEAN_API_PROXY", ""), "URL of the http, https or socks5 proxy DigitalOcean API requests are sent through. When empty, HTTPS_PROXY and NO_PROXY are used")
+	fs.DurationVar(&cfg.apiTimeout, "digitalocean-api-timeout", getEnvDuration("DIGITALOCEAN_API_TIMEOUT", time.Minute), "How long each attempt of a DigitalOcean API request may take before it is retried. 0 disables the timeout")
+	fs.BoolVar(&cfg.enableToolErrorLogging, "enable-tool-error-logging", getEnv("ENABLE_TOOL_ERROR_LOGGING", "false") == "true", "Enable logging of tool errors")
+	fs.StringVar(&cfg.enableTools, "enable-tools", getEnv("ENABLE_TOOLS", ""), "Comma-separated list of tool names or globs to expose (e.g., droplet-*,image-*). When empty, all tools of the activated services are exposed")
+	fs.StringVar(&cfg.disableTools, "disable-tools", getEnv("DISABLE_TOOLS", ""), "Comma-separated list of tool names or globs to hide (e.g., droplet-delete*). Takes precedence over --enable-tools")
+	fs.StringVar(&cfg.toolsConfig, "tools-config", getEnv("TOOLS_CONFIG", ""), "Path to a JSON file with \"enable\" and \"disable\" tool pattern lists, merged with --enable-tools and --disable-tools (optional)")
+	fs.BoolVar(&cfg.confirmDestructive, "confirm-destructive", getEnv("CONFIRM_DESTRUCTIVE", "false") == "true", "Require Confirm: true or a token from the confirm-destructive tool before delete, rebuild and restore tools run; unconfirmed calls return a preview")
+	fs.StringVar(&cfg.authSource, "auth-source", getEnv("AUTH_SOURCE", "auto"), "Where to read the API token from when --digitalocean-api-token and DIGITALOCEAN_API_TOKEN are not set: env, file, doctl, keyring, or auto for the first of env, file and doctl that has one")
+	fs.StringVar(&cfg.authFile, "auth-file", getEnv("AUTH_FILE", ""), "Token file of the file auth source, only readable by its owner (default: mcp-digitalocean/token in the user config directory)")
+	fs.StringVar(&cfg.doctlConfig, "doctl-config", getEnv("DOCTL_CONFIG", ""), "doctl config.yaml of the doctl auth source (default: doctl/config.yaml in the user config directory)")
+	fs.StringVar(&cfg.doctlContext, "doctl-context", getEnv("DOCTL_CONTEXT", ""), "doctl auth context of the doctl auth source (default: doctl's current context)")
+	fs.StringVar(&cfg.profilesFile, "profiles-file", getEnv("PROFILES_FILE", ""), "Path to a YAML or JSON file of named account profiles, each saying where its API token is read from. The account-profile-use tool switches between them (stdio only, optional)")
+	fs.StringVar(&cfg.profile, "profile", getEnv("PROFILE", ""), "Account profile of --profiles-file that is active at startup (default: the file's default profile)")
+	fs.DurationVar(&cfg.cacheTTL, "cache-ttl", getEnvDuration("CACHE_TTL", cache.DefaultTTL), "How long results of region-list, size-list, gpu-size-list and public image-list calls are reused for the same arguments; CacheBypass: true skips them. 0 disables the cache")
+	fs.BoolVar(&cfg.compactOutput, "compact-output", getEnv("COMPACT_OUTPUT", "false") == "true", "Return tool results as minified JSON without null or empty fields unless a call passes Compact: false")
+	fs.Float64Var(&cfg.rateLimit, "rate-limit", getEnvFloat("RATE_LIMIT", 0), "Maximum DigitalOcean API requests per second for each token, shared by all tools. The API allows 5,000 requests an hour; 1.3 stays below that. 0 disables the limit")
+	fs.IntVar(&cfg.rateLimitBurst, "rate-limit-burst", getEnvInt("RATE_LIMIT_BURST", 10), "Number of API requests that may be sent at once before --rate-limit paces them")
+	fs.IntVar(&cfg.maxInFlight, "max-in-flight", getEnvInt("MAX_IN_FLIGHT", 0), "Maximum concurrent DigitalOcean API requests for each token. 0 disables the cap")
+	fs.StringVar(&cfg.kubeconfigDir, "kubeconfig-dir", getEnv("KUBECONFIG_DIR", ""), "Directory below which doks-credentials-get may write kubeconfigs to the OutputPath of a call, readable only by the server's user. When empty, kubeconfigs are only returned")
+	fs.StringVar(&cfg.spacesAccessKeyID, "spaces-access-key-id", getEnv("SPACES_ACCESS_KEY_ID", ""), "Spaces access key the bucket and object tools sign S3 requests with. They are only registered with --spaces-secret-access-key too")
+	fs.StringVar(&cfg.spacesSecretAccessKey, "spaces-secret-access-key", getEnv("SPACES_SECRET_ACCESS_KEY", ""), "Secret of the Spaces access key of --spaces-access-key-id")
+	fs.StringVar(&cfg.dropletExecKeyFile, "droplet-exec-key-file", getEnv("DROPLET_EXEC_KEY_FILE", ""), "Unencrypted SSH private key the droplet-exec tool runs commands on droplets with. droplet-exec is only registered when it is set")
+	fs.StringVar(&cfg.dropletExecKnownHosts, "droplet-exec-known-hosts-file", getEnv("DROPLET_EXEC_KNOWN_HOSTS_FILE", ""), "known_hosts file droplet-exec checks the host keys of droplets against. When empty, the first key each droplet presents is trusted for the life of the server")
+	fs.StringVar(&cfg.policyFile, "policy-file", getEnv("POLICY_FILE", ""), "Path to a YAML or JSON policy file of guardrails the tools that change resources are held to: max-droplet-size, allowed-regions, forbidden-images and max-resources-per-session (optional)")
+	fs.StringVar(&cfg.userAgent, "user-agent", getEnv("USER_AGENT", ""), "Indicate this server is running as a remote MCP ")
+}
+
+// registerServeFlags defines the flags that configure the transport and logging of the serve command.
+func registerServeFlags(fs *flag.FlagSet, cfg *config) {
+	fs.StringVar(&cfg.transport, "transport", getEnv("TRANSPORT", "stdio"), "The transport protocol to use (stdio, http for the streamable HTTP transport, or sse for the legacy SSE transport; streamable-http is accepted as an alias of http). Default is stdio.")
+	fs.StringVar(&cfg.bindAddr, "bind-addr", getEnv("BIND_ADDR", "127.0.0.1:8080"), "Bind address to bind to. Only used for http and sse transports.")
+	fs.BoolVar(&cfg.httpStateful, "http-stateful", getEnv("HTTP_STATEFUL", "false") == "true", "Issue Mcp-Session-Id session IDs and keep per-session state, which resource subscriptions and server notifications need. Sessions live in one process, so run a single replica or use sticky sessions. Only used for http transport.")
+	fs.DurationVar(&cfg.httpSessionIdleTimeout, "http-session-idle-timeout", getEnvDuration("HTTP_SESSION_IDLE_TIMEOUT", 30*time.Minute), "Drop stateful sessions that have been idle this long, for clients that disconnect without ending their session. 0 keeps them until they are ended.")
+	fs.DurationVar(&cfg.httpHeartbeatInterval, "http-heartbeat-interval", getEnvDuration("HTTP_HEARTBEAT_INTERVAL", 30*time.Second), "Interval of the keep-alive pings on the notification stream of stateful sessions and on sse connections, so proxies don't close it. 0 disables them.")
+	fs.DurationVar(&cfg.shutdownTimeout, "shutdown-timeout", getEnvDuration("SHUTDOWN_TIMEOUT", 15*time.Second), "How long in-flight tool calls and HTTP requests may take to finish after a shutdown signal. New tool calls are refused meanwhile.")
+	fs.DurationVar(&cfg.readinessCacheTTL, "readiness-cache-ttl", getEnvDuration("READINESS_CACHE_TTL", health.DefaultTTL), "How long the outcome of the /readyz check of the --digitalocean-api-token token is reused. Only used for http and sse transports.")
+	fs.BoolVar(&cfg.enableMetrics, "enable-metrics", getEnv("ENABLE_METRICS", "false") == "true", "Serve Prometheus metrics of tool calls and DigitalOcean API requests at /metrics. Only used for http and sse transports.")
+	fs.BoolVar(&cfg.enableTracing, "enable-tracing", getEnv("ENABLE_TRACING", "false") == "true", "Export OpenTelemetry spans of tool calls and DigitalOcean API requests with OTLP over HTTP, to the collector of the standard OTEL_EXPORTER_OTLP_* environment variables")
+	fs.StringVar(&cfg.auditLogFile, "audit-log-file", getEnv("AUDIT_LOG_FILE", ""), "Append a JSON Lines record of every call of a tool that changes resources to this file (optional)")
+	fs.StringVar(&cfg.auditWebhookURL, "audit-webhook-url", getEnv("AUDIT_WEBHOOK_URL", ""), "Post a JSON record of every call of a tool that changes resources to this URL (optional)")
+	fs.StringVar(&cfg.auditWebhookToken, "audit-webhook-token", getEnv("AUDIT_WEBHOOK_TOKEN", ""), "Bearer token sent with the records posted to --audit-webhook-url (optional)")
+	fs.StringVar(&cfg.sessionStateFile, "session-state-file", getEnv("SESSION_STATE_FILE", ""), "Record the resources create tools make in each MCP session to this file, so session-resources-list and session-cleanup can find and delete them after an interrupted run (optional)")
+	fs.StringVar(&cfg.managedTag, "managed-tag", getEnv("MANAGED_TAG", ""), "Tag every droplet, volume, snapshot, image, load balancer, database and Kubernetes cluster or node pool the server creates with this tag, e.g. mcp-managed (optional)")
+	fs.BoolVar(&cfg.managedTagSession, "managed-tag-session", getEnv("MANAGED_TAG_SESSION", "false") == "true", "Also tag the resources of --managed-tag with mcp-session:<MCP session ID>")
+	fs.Float64Var(&cfg.maxMonthlySpend, "max-monthly-spend", getEnvFloat("MAX_MONTHLY_SPEND", 0), "Refuse droplet, volume, load balancer and Kubernetes create calls that would take the projected monthly spend of the account over this many USD. 0 disables the cap")
+	fs.BoolVar(&cfg.maxMonthlySpendConfirm, "max-monthly-spend-confirm", getEnv("MAX_MONTHLY_SPEND_CONFIRM", "false") == "true", "Let create calls over --max-monthly-spend go ahead when called with Confirm: true instead of refusing them")
+	fs.StringVar(&cfg.wsLoggingURL, "ws-logging-url", getEnv("WS_LOGGING_URL", ""), "WebSocket URL for WebSocket logging (optional)")
+	fs.StringVar(&cfg.wsLoggingToken, "ws-logging-token", getEnv("WS_LOGGING_TOKEN", ""), "Authentication token for WebSocket logging (optional)")
+	fs.StringVar(&cfg.serverURL, "mcp-resource-url", getEnv("MCP_RESOURCE_URL", ""), "This server's public base URL advertised in the OAuth protected resource metadata. When empty, it is derived from each request (remote transport only, optional)")
+	fs.DurationVar(&cfg.subscriptionPollInterval, "subscription-poll-interval", getEnvDuration("SUBSCRIPTION_POLL_INTERVAL", subscriptions.DefaultInterval), "How often resources subscribed with resources/subscribe are polled for changes")
+	fs.StringVar(&cfg.oauthAuthorizationServer, "oauth-authorization-server", getEnv("OAUTH_AUTHORIZATION_SERVER", oauthmeta.ProdAuthorizationServer), "Issuer URL of the authorization server advertised in the OAuth protected resource metadata (remote transport only)")
+	fs.StringVar(&cfg.oauthTokenValidation, "oauth-token-validation", getEnv("OAUTH_TOKEN_VALIDATION", "none"), "How bearer tokens are validated before requests reach the tools: none (the DigitalOcean API validates them), digitalocean (fetch the account of the token) or introspection (RFC 7662, see --oauth-introspection-url). Remote transport only.")
+	fs.StringVar(&cfg.oauthIntrospectionURL, "oauth-introspection-url", getEnv("OAUTH_INTROSPECTION_URL", ""), "Token introspection endpoint of the authorization server, for --oauth-token-validation=introspection")
+	fs.StringVar(&cfg.oauthClientID, "oauth-client-id", getEnv("OAUTH_CLIENT_ID", ""), "Client ID this server authenticates to the introspection endpoint with (optional)")
+	fs.StringVar(&cfg.oauthClientSecret, "oauth-client-secret", getEnv("OAUTH_CLIENT_SECRET", ""), "Client secret this server authenticates to the introspection endpoint with (optional)")
+	fs.StringVar(&cfg.oauthTokenMap, "oauth-token-map", getEnv("OAUTH_TOKEN_MAP", ""), "Path to a JSON object mapping validated token subjects to DigitalOcean API tokens, for authorization servers that don't issue DigitalOcean tokens. When empty, the presented token is used as the DigitalOcean token.")
+	fs.StringVar(&cfg.openaiAppsVerificationToken, "openai-apps-verification-token", getEnv("OPENAI_APPS_VERIFICATION_TOKEN", ""), "Plain-text token served at /.well-known/openai-apps-challenge for OpenAI ChatGPT app domain verification (remote transport only, optional)")
+}
+
+// parseLogLevel maps the --log-level value to a slog level, defaulting to info.
+func parseLogLevel(s string) slog.Level {
+	switch strings.ToLower(s) {
 	case "debug":
-		level = slog.LevelDebug
+		return slog.LevelDebug
 	case "info":
-		level = slog.LevelInfo
+		return slog.LevelInfo
 	case "warn", "warning":
-		level = slog.LevelWarn
+		return slog.LevelWarn
 	case "error":
-		level = slog.LevelError
+		return slog.LevelError
 	default:
-		level = slog.LevelInfo
+		return slog.LevelInfo
 	}
+}
+
+func main() {
+	args := os.Args[1:]
+	// without a subcommand the binary serves MCP, so existing invocations keep working.
+	command := "serve"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
+	}
+
+	switch command {
+	case "serve":
+		os.Exit(runServe(args))
+	case "tools":
+		os.Exit(runTools(args))
+	case "doctor":
+		os.Exit(runDoctor(args))
+	case "help":
+		usage(os.Stdout)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", command)
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+}
+
+// usage prints the available commands.
+func usage(w io.Writer) {
+	fmt.Fprintf(w, `Usage: %[1]s [command] [flags]
+
+Commands:
+  serve                          Serve the MCP protocol (default)
+  tools list [--json]            List the tools that would be registered
+  tools call <name> [--args {}]  Call a tool directly and print its result
+  doctor                         Check the token, the API endpoint and the tool configuration
+
+Run "%[1]s <command> -h" for the flags of a command.
+`, mcpName)
+}
+
+// runServe runs the MCP server until it fails or the process is signalled, and returns the exit code.
+func runServe(args []string) int {
+	var cfg config
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	registerFlags(fs, &cfg)
+	registerServeFlags(fs, &cfg)
+	_ = fs.Parse(args)
+
+	level := parseLogLevel(cfg.logLevel)
 
 	// setup signal context for graceful shutdown
 	// This context is cancelled when the user presses Ctrl+C or the process receives SIGTERM/SIGINT.
@@ -80,10 +312,10 @@ func main() {
 	// create WebSocket logging handler (drop-in replacement for slog.NewJSONHandler)
 	wsLoggingHandler := wslogging.NewHandler(os.Stderr, &slog.HandlerOptions{Level: level})
 	// configure WebSocket logging if URL is provided
-	if *wsLoggingURL != "" {
-		if err := wsLoggingHandler.ConfigureWebSocket(*wsLoggingURL, *wsLoggingToken); err != nil {
+	if cfg.wsLoggingURL != "" {
+		if err := wsLoggingHandler.ConfigureWebSocket(cfg.wsLoggingURL, cfg.wsLoggingToken); err != nil {
 			fmt.Fprintf(os.Stderr, "Failed to configure WebSocket logging: %v\n", err)
-			os.Exit(1)
+			return 1
 		}
 
 		// start WebSocket logging with signal context for graceful shutdown
@@ -105,16 +337,11 @@ func main() {
 		}()
 	}
 
-	var services []string
-	if *serviceFlag != "" {
-		services = strings.Split(*serviceFlag, ",")
-	}
-
 	// add enabled_services as persistent attribute for context/metrics
 	// this helps with filtering and understanding server configuration
-	if *serviceFlag != "" {
+	if cfg.services != "" {
 		wsLoggingHandler = wsLoggingHandler.WithAttrs([]slog.Attr{
-			slog.String("enabled_services", *serviceFlag),
+			slog.String("enabled_services", cfg.services),
 		}).(*wslogging.Handler)
 	} else {
 		wsLoggingHandler = wsLoggingHandler.WithAttrs([]slog.Attr{
@@ -122,22 +349,72 @@ func main() {
 		}).(*wslogging.Handler)
 	}
 
-	// create logger after adding service attributes
-	logger := slog.New(wsLoggingHandler)
-	token := *tokenFlag
-	if token == "" && *transport == "stdio" {
-		logger.Error("DigitalOcean API token not provided. Use --digitalocean-api-token flag or set DIGITALOCEAN_API_TOKEN environment variable")
-		os.Exit(1)
+	// create logger after adding service attributes. Records of tool calls also reach the client
+	// of the call at the level it set with logging/setLevel.
+	clientLogHandler := clientlog.NewHandler(wsLoggingHandler)
+	logger := slog.New(clientLogHandler)
+	if cfg.enableTracing {
+		shutdownTracing, err := tracing.Setup(ctx, mcpName, mcpVersion)
+		if err != nil {
+			logger.Error("Failed to configure tracing: " + err.Error())
+			return 1
+		}
+		defer func() {
+			// the signal context is done by now, so buffered spans get a fresh deadline.
+			flushCtx, cancel := context.WithTimeout(context.Background(), cfg.shutdownTimeout)
+			defer cancel()
+			if err := shutdownTracing(flushCtx); err != nil {
+				logger.Error("Failed to flush traces: " + err.Error())
+			}
+		}()
+		logger.Info("exporting OpenTelemetry traces")
 	}
-
-	var opts []server.ServerOption
-	opts = append(opts, server.WithPromptCapabilities(true))
-	if *enableToolErrorLogging {
-		toolLoggingMiddleware := middleware.ToolLoggingMiddleware{Logger: logger}
-		opts = append(opts, server.WithToolHandlerMiddleware(toolLoggingMiddleware.ToolMiddleware))
+	switch cfg.transport {
+	case "stdio", "http", "sse":
+	case "streamable-http":
+		cfg.transport = "http"
+	default:
+		logger.Error(fmt.Sprintf("unsupported transport %q: use stdio, http or sse", cfg.transport))
+		return 2
+	}
+	// every client of the process shares the limits of its token.
+	limiter := newRateLimiter(&cfg)
+	apiHTTPClient, err := newAPIHTTPClient(&cfg)
+	if err != nil {
+		logger.Error("Failed to configure DigitalOcean API connections: " + err.Error())
+		return 2
+	}
+	if cfg.managedTag != "" {
+		if err := managedtag.Validate(cfg.managedTag); err != nil {
+			logger.Error("Failed to configure the managed tag: " + err.Error())
+			return 2
+		}
+		// every attempt of a request creating a resource carries the tags, whichever client sends it.
+		apiHTTPClient.Transport = managedtag.NewTransport(apiHTTPClient.Transport, cfg.managedTag, cfg.managedTagSession)
+		logger.Info("tagging created resources", "tag", cfg.managedTag, "session_tag", cfg.managedTagSession)
+	} else if cfg.managedTagSession {
+		logger.Error("--managed-tag-session needs --managed-tag")
+		return 2
+	}
+	var profileSet *profiles.Set
+	if cfg.transport == "stdio" && cfg.profilesFile != "" {
+		var err error
+		if profileSet, err = newProfileSet(&cfg, limiter, apiHTTPClient); err == nil {
+			_, err = profileSet.Client(ctx)
+		}
+		if err != nil {
+			logger.Error("Failed to configure account profiles: " + err.Error())
+			return 1
+		}
+		logger.Debug("using account profile", "profile", profileSet.Active())
+	} else if cfg.transport == "stdio" {
+		source, err := resolveToken(ctx, &cfg)
+		if err != nil {
+			logger.Error(err.Error())
+			return 1
+		}
+		logger.Debug("using DigitalOcean API token", "source", source)
 	}
-
-	svr := server.NewMCPServer(mcpName, mcpVersion, opts...)
 
 	// For remote (non-stdio) transports, serve the OAuth protected resource
 	// metadata document and challenge unauthenticated requests. The resource is
@@ -147,10 +424,25 @@ func main() {
 		wellKnownHandler       http.HandlerFunc
 		openaiChallengeHandler http.HandlerFunc
 		requireAuth            func(http.Handler) http.Handler
+		readiness              *health.Checker
 	)
-	if *transport != "stdio" {
-		authServer := oauthmeta.ProdAuthorizationServer
-		serverURL := strings.TrimSpace(*serverURLFlag)
+	if cfg.transport != "stdio" {
+		// /readyz verifies the process token when one is configured; without one, every request
+		// brings its own token and there is nothing to verify up front.
+		var readinessCheck func(ctx context.Context) error
+		if strings.TrimSpace(cfg.token) != "" {
+			healthClient, err := newGodoClientWithTokenAndEndpoint(context.Background(), cfg.token, cfg.endpoint, cfg.userAgent, limiter, apiHTTPClient)
+			if err != nil {
+				logger.Error("Failed to create DigitalOcean client: " + err.Error())
+				return 1
+			}
+			readinessCheck = health.AccountCheck(healthClient)
+		}
+		readiness = health.NewChecker(readinessCheck, cfg.readinessCacheTTL)
+		logger.Info("serving health endpoints", "liveness", health.LivenessPath, "readiness", health.ReadinessPath, "token_check", readinessCheck != nil)
+
+		authServer := strings.TrimSpace(cfg.oauthAuthorizationServer)
+		serverURL := strings.TrimSpace(cfg.serverURL)
 
 		wellKnownHandler = oauthmeta.Handler(oauthmeta.Config{
 			Resource:               serverURL,
@@ -162,13 +454,24 @@ func main() {
 			Resource: serverURL,
 			Scopes:   []string{"read", "write"},
 		}
+		validator, provider, err := newTokenValidator(&cfg, apiHTTPClient)
+		if err != nil {
+			logger.Error("Failed to configure OAuth token validation: " + err.Error())
+			return 1
+		}
 		requireAuth = func(next http.Handler) http.Handler {
 			return oauthmeta.RequireBearer(next, challengeCfg)
 		}
+		if validator != nil {
+			requireAuth = func(next http.Handler) http.Handler {
+				return oauthmeta.RequireValidBearer(next, challengeCfg, validator, provider)
+			}
+			logger.Info("validating OAuth bearer tokens", "validation", cfg.oauthTokenValidation, "token_map", cfg.oauthTokenMap != "")
+		}
 
 		logger.Info("serving OAuth protected resource metadata", "path", oauthmeta.WellKnownPath, "authorization_server", authServer)
 
-		if token := strings.TrimSpace(*openaiAppsVerificationTokenFlag); token != "" && token != "OPENAI_APPS_VERIFICATION_TOKEN" {
+		if token := strings.TrimSpace(cfg.openaiAppsVerificationToken); token != "" && token != "OPENAI_APPS_VERIFICATION_TOKEN" {
 			openaiChallengeHandler = openaichallenge.Handler(token)
 			logger.Info("serving OpenAI app domain verification", "path", openaichallenge.WellKnownPath)
 		}
@@ -176,56 +479,388 @@ func main() {
 
 	// by default, we create a new client per request.
 	getClientFn := func(ctx context.Context) (*godo.Client, error) {
-		return clientFromContext(ctx, *endpointFlag, *userAgent)
+		return clientFromContext(ctx, cfg.endpoint, cfg.userAgent, limiter, apiHTTPClient)
 	}
 
-	// if using stdio, we can re-use the client.
-	if *transport == "stdio" {
-		godoClient, err := newGodoClientWithTokenAndEndpoint(context.Background(), token, *endpointFlag, *userAgent)
+	// if using stdio, we can re-use the client, or the client of the active profile.
+	if profileSet != nil {
+		getClientFn = func(ctx context.Context) (*godo.Client, error) {
+			if middleware.BearerToken(ctx) != "" {
+				return clientFromContext(ctx, cfg.endpoint, cfg.userAgent, limiter, apiHTTPClient)
+			}
+			return profileSet.Client(ctx)
+		}
+	} else if cfg.transport == "stdio" {
+		godoClient, err := newGodoClientWithTokenAndEndpoint(context.Background(), cfg.token, cfg.endpoint, cfg.userAgent, limiter, apiHTTPClient)
 		if err != nil {
 			logger.Error("Failed to create DigitalOcean client: " + err.Error())
-			os.Exit(1)
+			return 1
 		}
 		getClientFn = func(ctx context.Context) (*godo.Client, error) {
+			// a token passed in the tool call's _meta replaces the process token for that call.
+			if middleware.BearerToken(ctx) != "" {
+				return clientFromContext(ctx, cfg.endpoint, cfg.userAgent, limiter, apiHTTPClient)
+			}
 			return godoClient, nil
 		}
 	}
 
+	// resource subscriptions are answered in front of the transport and delivered as notifications.
+	subs := subscriptions.NewManager(cfg.subscriptionPollInterval, logger)
+	// the cached results of one account profile are not served to another.
+	var cacheScope func(ctx context.Context) string
+	if profileSet != nil {
+		cacheScope = func(ctx context.Context) string { return profileSet.Active() }
+	}
+	respCache := cache.New(cfg.cacheTTL, cacheScope)
+	var toolMetrics *metrics.Metrics
+	if cfg.enableMetrics && cfg.transport != "stdio" {
+		toolMetrics = metrics.New()
+		logger.Info("serving metrics", "path", metrics.Path)
+	}
+	auditor, closeAudit, err := newAuditor(logger, &cfg, profileSet)
+	if err != nil {
+		logger.Error("Failed to configure the audit log: " + err.Error())
+		return 1
+	}
+	defer closeAudit()
+	// the drainer is the innermost middleware, so it sees the actions in the results of the tools.
+	drainer := drain.New(cfg.endpoint)
+	serverOpts := append(subs.ServerOptions(), server.WithToolHandlerMiddleware(drainer.Middleware))
+	var profileTools []server.ServerTool
+	if profileSet != nil {
+		profileTools = profileSet.Tools()
+	}
+	svr, _, err := newMCPServer(logger, &cfg, getClientFn, respCache, toolMetrics, auditor, profileTools, serverOpts...)
+	if err != nil {
+		logger.Error(err.Error())
+		return 1
+	}
+	subs.SetServer(svr)
+	clientLogHandler.SetServer(svr)
+
+	// start our server.
+	err = runServer(ctx, svr, subs, drainer, logger, &cfg, wellKnownHandler, openaiChallengeHandler, requireAuth, readiness, toolMetrics)
+	// a restarted session can resume monitoring the actions that were started but not awaited.
+	drainer.LogHandoff(logger)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			logger.Info("shutting down mcp server")
+			return 0
+		}
+		logger.Error("Failed to serve MCP server: " + err.Error())
+		return 1
+	}
+	return 0
+}
+
+// newAuditor returns the auditor of the --audit-log-file and --audit-webhook-url sinks and the
+// function closing them, or a nil auditor when neither is set.
+func newAuditor(logger *slog.Logger, cfg *config, profileSet *profiles.Set) (*audit.Auditor, func(), error) {
+	var sinks []audit.Sink
+	closeSinks := func() {}
+	if cfg.auditLogFile != "" {
+		file, err := audit.NewFileSink(cfg.auditLogFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		sinks = append(sinks, file)
+		closeSinks = func() { _ = file.Close() }
+	}
+	if cfg.auditWebhookURL != "" {
+		sinks = append(sinks, &audit.WebhookSink{URL: cfg.auditWebhookURL, Token: cfg.auditWebhookToken})
+	}
+	if len(sinks) == 0 {
+		return nil, closeSinks, nil
+	}
+	logger.Info("auditing mutating tool calls", "file", cfg.auditLogFile, "webhook", cfg.auditWebhookURL != "")
+
+	// calls without a token of their own are made with the process token or the active profile.
+	processToken := ""
+	if cfg.transport == "stdio" && profileSet == nil {
+		processToken = strings.Trim(strings.TrimSpace(cfg.token), "'")
+	}
+	caller := func(ctx context.Context) audit.Caller {
+		c := audit.CallerFromContext(ctx)
+		if c.TokenFingerprint == "" {
+			c.TokenFingerprint = audit.Fingerprint(processToken)
+			if profileSet != nil {
+				c.Profile = profileSet.Active()
+			}
+		}
+		return c
+	}
+	return audit.New(logger, caller, sinks...), closeSinks, nil
+}
+
+// resolveToken fills in the token from --auth-source unless one was given with --digitalocean-api-token
+// or DIGITALOCEAN_API_TOKEN, and returns the name of the source it came from. With --profiles-file,
+// the token of the selected profile is used instead.
+func resolveToken(ctx context.Context, cfg *config) (string, error) {
+	if cfg.profilesFile != "" {
+		set, err := newProfileSet(cfg, nil, nil)
+		if err != nil {
+			return "", err
+		}
+		token, err := set.Token(ctx, set.Active())
+		if err != nil {
+			return "", fmt.Errorf("failed to read DigitalOcean API token: %w", err)
+		}
+		cfg.token = token
+		return "profile " + set.Active(), nil
+	}
+	if strings.TrimSpace(cfg.token) != "" {
+		return "flag", nil
+	}
+	token, source, err := credentials.Lookup(ctx, cfg.authSource, credentials.Options{
+		File:         cfg.authFile,
+		DoctlConfig:  cfg.doctlConfig,
+		DoctlContext: cfg.doctlContext,
+	})
+	if errors.Is(err, credentials.ErrNotFound) {
+		return "", errors.New("DigitalOcean API token not provided. Use --digitalocean-api-token flag, set DIGITALOCEAN_API_TOKEN environment variable or choose a source with --auth-source")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read DigitalOcean API token: %w", err)
+	}
+	cfg.token = token
+	return source, nil
+}
+
+// newProfileSet loads the profiles of --profiles-file with the --profile profile active.
+func newProfileSet(cfg *config, limiter *ratelimit.Limiter, apiHTTPClient *http.Client) (*profiles.Set, error) {
+	profilesCfg, err := profiles.LoadConfig(cfg.profilesFile)
+	if err != nil {
+		return nil, err
+	}
+	opts := credentials.Options{File: cfg.authFile, DoctlConfig: cfg.doctlConfig}
+	return profiles.New(profilesCfg, opts, cfg.profile, func(ctx context.Context, token string) (*godo.Client, error) {
+		// the client outlives the tool call that created it.
+		return newGodoClientWithTokenAndEndpoint(context.Background(), token, cfg.endpoint, cfg.userAgent, limiter, apiHTTPClient)
+	})
+}
+
+// tokenValidationCacheTTL is how long a validated bearer token is trusted without validating it again.
+const tokenValidationCacheTTL = time.Minute
+
+// newTokenValidator returns the validator and token provider selected by --oauth-token-validation,
+// or a nil validator when tokens are left to the DigitalOcean API.
+func newTokenValidator(cfg *config, apiHTTPClient *http.Client) (oauthmeta.TokenValidator, oauthmeta.TokenProvider, error) {
+	var validator oauthmeta.TokenValidator
+	switch strings.ToLower(strings.TrimSpace(cfg.oauthTokenValidation)) {
+	case "", "none":
+		return nil, nil, nil
+	case "digitalocean":
+		validator = &oauthmeta.AccountValidator{Endpoint: cfg.endpoint, Client: apiHTTPClient}
+	case "introspection":
+		if cfg.oauthIntrospectionURL == "" {
+			return nil, nil, errors.New("--oauth-introspection-url is required for introspection")
+		}
+		validator = &oauthmeta.IntrospectionValidator{
+			URL:          cfg.oauthIntrospectionURL,
+			ClientID:     cfg.oauthClientID,
+			ClientSecret: cfg.oauthClientSecret,
+			// tokens must have been issued for this server when its URL is known.
+			Audience: strings.TrimSpace(cfg.serverURL),
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported token validation %q: use none, digitalocean or introspection", cfg.oauthTokenValidation)
+	}
+
+	var provider oauthmeta.TokenProvider = oauthmeta.PassthroughProvider{}
+	if cfg.oauthTokenMap != "" {
+		static, err := oauthmeta.LoadStaticProvider(cfg.oauthTokenMap)
+		if err != nil {
+			return nil, nil, err
+		}
+		provider = static
+	}
+	return oauthmeta.NewCachingValidator(validator, tokenValidationCacheTTL), provider, nil
+}
+
+// newMCPServer creates the MCP server with its middleware and registers the tools of the configured
+// services, scoped by the tool filter. It returns the server and the service of each registered tool.
+// respCache, when not nil, serves repeated catalog calls, and toolMetrics, when not nil, counts the
+// tool calls. auditor, when not nil, records the calls of mutating tools. tools, such as the account profile tools, are
+// registered and filtered along with those of the services. extra options are applied after the built-in ones.
+func newMCPServer(logger *slog.Logger, cfg *config, getClientFn func(ctx context.Context) (*godo.Client, error), respCache *cache.Cache, toolMetrics *metrics.Metrics, auditor *audit.Auditor, tools []server.ServerTool, extra ...server.ServerOption) (*server.MCPServer, registry.Catalog, error) {
+	var services []string
+	if cfg.services != "" {
+		services = strings.Split(cfg.services, ",")
+	}
+
+	var opts []server.ServerOption
+	opts = append(opts, server.WithPromptCapabilities(true))
+	// clients choose the level of the log messages they are sent with logging/setLevel.
+	opts = append(opts, server.WithLogging())
+	// the token of a tool call's _meta is in place before any other middleware sees the call.
+	opts = append(opts, server.WithToolHandlerMiddleware(middleware.MetaTokenMiddleware))
+	opts = append(opts, server.WithToolHandlerMiddleware(correlation.Middleware))
+	opts = append(opts, server.WithToolHandlerMiddleware(clientlog.Middleware(logger)))
+	// the span of a call encloses the work of all other middleware, and so do the metrics.
+	opts = append(opts, server.WithToolHandlerMiddleware(tracing.Middleware))
+	if toolMetrics != nil {
+		opts = append(opts, server.WithToolHandlerMiddleware(toolMetrics.Middleware))
+	}
+	// the auditor sees the DryRun and Confirm arguments as they were passed.
+	if auditor != nil {
+		opts = append(opts, server.WithToolHandlerMiddleware(auditor.Middleware))
+	}
+	if cfg.enableToolErrorLogging {
+		toolLoggingMiddleware := middleware.ToolLoggingMiddleware{Logger: logger}
+		opts = append(opts, server.WithToolHandlerMiddleware(toolLoggingMiddleware.ToolMiddleware))
+	}
+	if respCache != nil {
+		opts = append(opts, server.WithToolHandlerMiddleware(respCache.Middleware))
+	}
+	opts = append(opts, server.WithToolHandlerMiddleware(apierror.Middleware))
+	// the capability tracker learns which service owns each tool once the tools are registered.
+	capabilityTracker := capabilities.NewTracker(nil)
+	opts = append(opts, server.WithToolHandlerMiddleware(capabilityTracker.Middleware))
+	// dry runs wrap the confirmation guard so previews of destructive tools need no confirmation.
+	opts = append(opts, server.WithToolHandlerMiddleware(dryrun.Middleware))
+	// the policy is enforced inside dry runs, so a preview shows the violation a real call would hit.
+	if cfg.policyFile != "" {
+		policyCfg, err := policy.LoadConfig(cfg.policyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		opts = append(opts, server.WithToolHandlerMiddleware(policy.New(*policyCfg, getClientFn).Middleware))
+		logger.Info("enforcing the policy", "file", cfg.policyFile)
+	}
+	var spendGuard *account.SpendGuard
+	if cfg.maxMonthlySpend < 0 {
+		return nil, nil, fmt.Errorf("--max-monthly-spend must not be negative")
+	} else if cfg.maxMonthlySpend > 0 {
+		spendGuard = account.NewSpendGuard(cfg.maxMonthlySpend, cfg.maxMonthlySpendConfirm, getClientFn)
+		opts = append(opts, server.WithToolHandlerMiddleware(spendGuard.Middleware))
+		logger.Info("capping the projected monthly spend", "usd", cfg.maxMonthlySpend, "confirm", cfg.maxMonthlySpendConfirm)
+	}
+
+	var confirmGuard *confirm.Guard
+	if cfg.confirmDestructive {
+		var err error
+		confirmGuard, err = confirm.NewGuard(confirm.DefaultTokenTTL)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to configure destructive confirmation: %w", err)
+		}
+		opts = append(opts, server.WithToolHandlerMiddleware(confirmGuard.Middleware))
+	}
+
+	// the session state records what a call created once the call has got past the guards.
+	var sessionState *sessionstate.Store
+	if cfg.sessionStateFile != "" {
+		var err error
+		sessionState, err = sessionstate.Open(cfg.sessionStateFile, getClientFn)
+		if err != nil {
+			return nil, nil, err
+		}
+		opts = append(opts, server.WithToolHandlerMiddleware(sessionState.Middleware))
+		logger.Info("recording the resources of each session", "file", cfg.sessionStateFile)
+	}
+
+	opts = append(opts, extra...)
+	svr := server.NewMCPServer(mcpName, mcpVersion, opts...)
+
 	// register the tools.
-	err := registry.Register(
+	catalog, err := registry.RegisterWithOptions(
 		logger,
 		svr,
 		getClientFn,
+		registry.Options{
+			KubeconfigDir:             cfg.kubeconfigDir,
+			SpacesAccessKeyID:         cfg.spacesAccessKeyID,
+			SpacesSecretAccessKey:     cfg.spacesSecretAccessKey,
+			DropletExecKeyFile:        cfg.dropletExecKeyFile,
+			DropletExecKnownHostsFile: cfg.dropletExecKnownHosts,
+		},
 		services...,
 	)
 	if err != nil {
-		logger.Error("Failed to register tools: " + err.Error())
-		os.Exit(1)
+		return nil, nil, fmt.Errorf("failed to register tools: %w", err)
+	}
+	svr.AddTools(capabilityTracker.Tools()...)
+	svr.AddTools(serverinfo.New(mcpName, mcpVersion, cfg.transport, apiUserAgent(cfg.userAgent)).Tools()...)
+	if sessionState != nil {
+		svr.AddTools(sessionState.Tools()...)
 	}
+	if confirmGuard != nil {
+		svr.AddTools(confirmGuard.Tools()...)
+	}
+	svr.AddTools(tools...)
 
-	// start our server.
-	err = runServer(ctx, svr, logger, *bindAddr, transport, wellKnownHandler, openaiChallengeHandler, requireAuth)
+	// scope the registered tools to the operator's allowlist and denylist.
+	toolFilter, err := newToolFilter(cfg.enableTools, cfg.disableTools, cfg.toolsConfig)
 	if err != nil {
-		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
-			logger.Info("shutting down mcp server")
-			os.Exit(0)
-		} else {
-			logger.Error("Failed to serve MCP server: " + err.Error())
-			os.Exit(1)
+		return nil, nil, fmt.Errorf("failed to configure tool filter: %w", err)
+	}
+	if !toolFilter.Empty() {
+		removed := registry.FilterTools(svr, toolFilter.Allowed)
+		for _, name := range removed {
+			delete(catalog, name)
 		}
+		logger.Info("filtered tools", "removed", len(removed), "remaining", len(svr.ListTools()))
+		logger.Debug("removed tools", "tools", strings.Join(removed, ","))
 	}
+
+	capabilityTracker.SetCatalog(catalog)
+
+	// redact the secrets of tool results, report the pagination of list tools, let tools select the fields they return, summarize
+	// large lists and compact their output, and enforce the declared argument constraints, accepting numeric strings for number arguments
+	// before they are checked, then advertise the DryRun, CacheBypass and confirmation arguments
+	// once the final set of tools is known.
+	redact.Apply(svr)
+	pagination.Apply(svr)
+	shape.Apply(svr, cfg.compactOutput, catalog.ItemURIs())
+	validate.Apply(svr)
+	coerce.Apply(svr)
+	dryrun.Apply(svr)
+	if respCache != nil {
+		respCache.Apply(svr)
+	}
+
+	if spendGuard != nil {
+		spendGuard.Apply(svr)
+	}
+	if confirmGuard != nil {
+		confirmGuard.Apply(svr)
+		logger.Info("destructive confirmation mode enabled", "tool", confirm.ToolName)
+	}
+
+	// give every tool a title and the category of its service for clients that group the catalog.
+	registry.Describe(svr, catalog)
+	// annotate every tool, including those added above, as read-only, destructive or idempotent
+	// so clients can prompt before the calls that change or delete resources.
+	annotate.Apply(svr)
+
+	return svr, catalog, nil
 }
 
-func clientFromContext(ctx context.Context, endpoint string, userAgent string) (*godo.Client, error) {
+// newToolFilter builds the tool filter from the comma-separated flag values and the optional JSON config file.
+func newToolFilter(enable, disable, configFile string) (*toolfilter.Filter, error) {
+	enablePatterns := toolfilter.SplitPatterns(enable)
+	disablePatterns := toolfilter.SplitPatterns(disable)
+	if configFile != "" {
+		cfg, err := toolfilter.LoadConfig(configFile)
+		if err != nil {
+			return nil, err
+		}
+		enablePatterns = append(enablePatterns, cfg.Enable...)
+		disablePatterns = append(disablePatterns, cfg.Disable...)
+	}
+	return toolfilter.New(enablePatterns, disablePatterns)
+}
+
+func clientFromContext(ctx context.Context, endpoint string, userAgent string, limiter *ratelimit.Limiter, httpClient *http.Client) (*godo.Client, error) {
 	auth, ok := ctx.Value(middleware.AuthKey{}).(string)
 	if !ok || strings.TrimSpace(auth) == "" {
 		return nil, errors.New("no auth header found")
 	}
-	token := strings.TrimPrefix(auth, "Bearer ")
+	token := middleware.BearerToken(ctx)
 	if token == "" {
 		return nil, errors.New("no bearer token found")
 	}
-	client, err := newGodoClientWithTokenAndEndpoint(ctx, token, endpoint, userAgent)
+	client, err := newGodoClientWithTokenAndEndpoint(ctx, token, endpoint, userAgent, limiter, httpClient)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create godo client: %w", err)
 	}
@@ -233,8 +868,19 @@ func clientFromContext(ctx context.Context, endpoint string, userAgent string) (
 	return client, nil
 }
 
+// apiUserAgent returns the product the DigitalOcean API requests are sent with: the server name, or
+// userAgent when set, with the server version.
+func apiUserAgent(userAgent string) string {
+	if userAgent != "" {
+		return fmt.Sprintf("%s/%s", userAgent, mcpVersion)
+	}
+	return fmt.Sprintf("%s/%s", mcpName, mcpVersion)
+}
+
 // newGodoClientWithTokenAndEndpoint initializes a new godo client with a custom user agent and endpoint.
-func newGodoClientWithTokenAndEndpoint(ctx context.Context, token string, endpoint string, userAgent string) (*godo.Client, error) {
+// httpClient, when not nil, provides the transport and timeout of each attempt of a request.
+// Its requests, including retries, are paced by limiter, which may be nil.
+func newGodoClientWithTokenAndEndpoint(ctx context.Context, token string, endpoint string, userAgent string, limiter *ratelimit.Limiter, httpClient *http.Client) (*godo.Client, error) {
 	cleanToken := strings.Trim(strings.TrimSpace(token), "'")
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: cleanToken})
 	oauthClient := oauth2.NewClient(ctx, ts)
@@ -245,30 +891,122 @@ func newGodoClientWithTokenAndEndpoint(ctx context.Context, token string, endpoi
 		RetryWaitMax: godo.PtrTo(float64(30)),
 	}
 
-	mcpUserAgent := fmt.Sprintf("%s/%s", mcpName, mcpVersion)
-	if userAgent != "" {
-		mcpUserAgent = fmt.Sprintf("%s/%s", userAgent, mcpVersion)
-	}
-
-	return godo.New(oauthClient,
+	client, err := godo.New(oauthClient,
 		godo.WithRetryAndBackoffs(retry),
 		godo.SetBaseURL(endpoint),
-		godo.SetUserAgent(mcpUserAgent))
+		godo.SetUserAgent(apiUserAgent(userAgent)))
+	if err != nil {
+		return nil, err
+	}
+
+	// with retries, godo replaces the HTTP client by one of its own and keeps only the token source,
+	// so the per-attempt layers go below its retry layer: every attempt counts against the limits of
+	// the token, is counted by the metrics of the tool call that made it, gets a span of its own,
+	// leaves its request ID in the audit record of the call and warns its client of rate limiting,
+	// retries and rejected tokens.
+	if auth, ok := client.HTTPClient.Transport.(*oauth2.Transport); ok {
+		if retrying, ok := auth.Base.(*retryablehttp.RoundTripper); ok && retrying.Client != nil {
+			attempts := retrying.Client.HTTPClient
+			if httpClient != nil {
+				if httpClient.Transport != nil {
+					attempts.Transport = httpClient.Transport
+				}
+				attempts.Timeout = httpClient.Timeout
+			}
+			attempts.Transport = tracing.NewTransport(metrics.NewTransport(audit.NewTransport(clientlog.NewTransport(limiter.Transport(attempts.Transport, cleanToken)))))
+		}
+	}
+
+	// intercept the mutating requests of dry-run tool calls before they reach the auth and retry layers,
+	// the capability tracker observes the responses of requests that were actually sent, list
+	// responses are recorded for the pagination of tool results and failed responses for their
+	// error details. Every request carries the correlation ID of its tool call and names the MCP
+	// client it was sent for in its User-Agent.
+	client.HTTPClient.Transport = serverinfo.NewTransport(correlation.NewTransport(apierror.NewTransport(pagination.NewTransport(capabilities.NewTransport(dryrun.NewTransport(client.HTTPClient.Transport))))))
+
+	return client, nil
 }
 
-func runServer(ctx context.Context, s *server.MCPServer, logger *slog.Logger, bindAddr string, transport *string, wellKnownHandler http.HandlerFunc, openaiChallengeHandler http.HandlerFunc, requireAuth func(http.Handler) http.Handler) error {
-	logger.Info("starting MCP server", "name", mcpName, "version", mcpVersion, "transport", *transport)
-	switch *transport {
+// closeStreamsOnShutdown ends the long-lived GET notification streams of stateful sessions once srv
+// starts shutting down. Shutdown waits for active requests, and these would otherwise only end
+// at the shutdown timeout; other requests keep their context and finish normally.
+func closeStreamsOnShutdown(srv *http.Server, next http.Handler) http.Handler {
+	streams, closeStreams := context.WithCancel(context.Background())
+	srv.RegisterOnShutdown(closeStreams)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		stop := context.AfterFunc(streams, cancel)
+		defer stop()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func runServer(ctx context.Context, s *server.MCPServer, subs *subscriptions.Manager, drainer *drain.Drainer, logger *slog.Logger, cfg *config, wellKnownHandler http.HandlerFunc, openaiChallengeHandler http.HandlerFunc, requireAuth func(http.Handler) http.Handler, readiness *health.Checker, toolMetrics *metrics.Metrics) error {
+	bindAddr := cfg.bindAddr
+	logger.Info("starting MCP server", "name", mcpName, "version", mcpVersion, "transport", cfg.transport)
+	switch cfg.transport {
 	case "stdio":
 		logger.Info("stdio server started")
-		err := server.ServeStdio(s)
+		// the session outlives the signal until the tool calls in flight have drained, so their
+		// API requests are not cancelled.
+		listenCtx, stopListening := context.WithCancel(context.WithoutCancel(ctx))
+		defer stopListening()
+		stopDraining := context.AfterFunc(ctx, func() {
+			drainCtx, cancel := context.WithTimeout(context.Background(), cfg.shutdownTimeout)
+			defer cancel()
+			drainCalls(drainCtx, logger, drainer)
+			stopListening()
+		})
+		defer stopDraining()
+		var in io.Reader = os.Stdin
+		var out io.Writer = os.Stdout
+		if subs != nil {
+			in, out = subs.WrapStdio(listenCtx, in, out)
+		}
+		err := server.NewStdioServer(s).Listen(listenCtx, in, out)
 		if err != nil {
 			return fmt.Errorf("failed to start http server: %w", err)
 		}
-	// fallback to http
+		return nil
+	case "sse":
+		logger.Info("sse server started", "bind_addr", bindAddr)
+
+		// The legacy SSE transport shares the auth guard and the well-known routes
+		// of the streamable transport. Responses travel over the GET stream rather
+		// than the POST that carried the request, so resource subscriptions, which
+		// are answered in front of the server, are not available.
+		mux := http.NewServeMux()
+		sseOpts := []server.SSEOption{
+			server.WithSSEContextFunc(middleware.AuthFromRequest),
+			server.WithHTTPServer(&http.Server{Handler: mux}),
+		}
+		if cfg.httpHeartbeatInterval > 0 {
+			sseOpts = append(sseOpts, server.WithKeepAlive(true), server.WithKeepAliveInterval(cfg.httpHeartbeatInterval))
+		}
+		if serverURL := strings.TrimSpace(cfg.serverURL); serverURL != "" {
+			sseOpts = append(sseOpts, server.WithBaseURL(serverURL))
+		}
+		sseServer := server.NewSSEServer(s, sseOpts...)
+
+		sseHandler, messageHandler := sseServer.SSEHandler(), sseServer.MessageHandler()
+		if requireAuth != nil {
+			sseHandler, messageHandler = requireAuth(sseHandler), requireAuth(messageHandler)
+		}
+		mux.Handle(sseEndpointPath, sseHandler)
+		mux.Handle(sseMessageEndpointPath, messageHandler)
+		handleWellKnown(mux, wellKnownHandler, openaiChallengeHandler)
+		handleHealth(mux, readiness)
+		handleMetrics(mux, toolMetrics)
+
+		return serveHTTP(ctx, logger, drainer, cfg.shutdownTimeout, func() error { return sseServer.Start(bindAddr) }, sseServer.Shutdown)
+	// streamable http
 	default:
-		errC := make(chan error, 1)
-		logger.Info("http server started", "bind_addr", bindAddr)
+		logger.Info("http server started", "bind_addr", bindAddr, "stateful", cfg.httpStateful)
 
 		// When extra routing is needed (the public OAuth metadata route and/or the
 		// bearer-token guard on the MCP endpoint), we own the mux and hand it to the
@@ -276,16 +1014,25 @@ func runServer(ctx context.Context, s *server.MCPServer, logger *slog.Logger, bi
 		// is registered explicitly so its behavior is unchanged; the well-known
 		// route is served alongside it and is intentionally left unauthenticated.
 		var streamableOpts []server.StreamableHTTPOption
-		streamableOpts = append(streamableOpts,
-			server.WithHTTPContextFunc(middleware.AuthFromRequest),
-			server.WithStateLess(true),
-		)
+		streamableOpts = append(streamableOpts, server.WithHTTPContextFunc(middleware.AuthFromRequest))
+		if cfg.httpStateful {
+			// sessions are registered on initialize and end with DELETE or after the idle timeout.
+			streamableOpts = append(streamableOpts,
+				server.WithStateful(true),
+				server.WithSessionIdleTTL(cfg.httpSessionIdleTimeout),
+				server.WithHeartbeatInterval(cfg.httpHeartbeatInterval),
+			)
+		} else {
+			streamableOpts = append(streamableOpts, server.WithStateLess(true))
+		}
 
-		useCustomMux := wellKnownHandler != nil || openaiChallengeHandler != nil || requireAuth != nil
+		useCustomMux := wellKnownHandler != nil || openaiChallengeHandler != nil || requireAuth != nil || subs != nil || cfg.httpStateful || readiness != nil || toolMetrics != nil
 		var mux *http.ServeMux
+		var srv *http.Server
 		if useCustomMux {
 			mux = http.NewServeMux()
-			streamableOpts = append(streamableOpts, server.WithStreamableHTTPServer(&http.Server{Handler: mux}))
+			srv = &http.Server{Handler: mux}
+			streamableOpts = append(streamableOpts, server.WithStreamableHTTPServer(srv))
 		}
 
 		httpServer := server.NewStreamableHTTPServer(s, streamableOpts...)
@@ -293,46 +1040,91 @@ func runServer(ctx context.Context, s *server.MCPServer, logger *slog.Logger, bi
 		if mux != nil {
 			// We provided a custom *http.Server, so routing is our responsibility.
 			var mcpHandler http.Handler = httpServer
-			if requireAuth != nil {
-				mcpHandler = requireAuth(httpServer)
+			if subs != nil {
+				mcpHandler = subs.HTTPMiddleware(mcpHandler)
 			}
-			mux.Handle(mcpEndpointPath, mcpHandler)
-			if wellKnownHandler != nil {
-				mux.HandleFunc(oauthmeta.WellKnownPath, wellKnownHandler)
+			if requireAuth != nil {
+				mcpHandler = requireAuth(mcpHandler)
 			}
-			if openaiChallengeHandler != nil {
-				mux.HandleFunc(openaichallenge.WellKnownPath, openaiChallengeHandler)
+			if cfg.httpStateful {
+				mcpHandler = closeStreamsOnShutdown(srv, mcpHandler)
 			}
+			mux.Handle(mcpEndpointPath, mcpHandler)
+			handleWellKnown(mux, wellKnownHandler, openaiChallengeHandler)
+			handleHealth(mux, readiness)
+			handleMetrics(mux, toolMetrics)
 		}
 
-		go func() {
-			errC <- httpServer.Start(bindAddr)
-		}()
+		return serveHTTP(ctx, logger, drainer, cfg.shutdownTimeout, func() error { return httpServer.Start(bindAddr) }, httpServer.Shutdown)
+	}
+}
 
-		select {
-		case <-ctx.Done():
+// handleWellKnown serves the unauthenticated well-known documents that are configured.
+func handleWellKnown(mux *http.ServeMux, wellKnownHandler, openaiChallengeHandler http.HandlerFunc) {
+	if wellKnownHandler != nil {
+		mux.HandleFunc(oauthmeta.WellKnownPath, wellKnownHandler)
+	}
+	if openaiChallengeHandler != nil {
+		mux.HandleFunc(openaichallenge.WellKnownPath, openaiChallengeHandler)
+	}
+}
 
-			// allow 15 seconds for graceful shutdown
-			timeoutCtx, cancelFunc := context.WithTimeout(context.Background(), time.Second*15)
-			defer cancelFunc()
+// handleHealth serves the unauthenticated liveness and readiness endpoints when readiness is set.
+func handleHealth(mux *http.ServeMux, readiness *health.Checker) {
+	if readiness == nil {
+		return
+	}
+	mux.HandleFunc(health.LivenessPath, health.LivenessHandler())
+	mux.HandleFunc(health.ReadinessPath, readiness.ReadinessHandler())
+}
 
-			logger.Info("received shutdown signal")
-			err := httpServer.Shutdown(timeoutCtx)
-			if err != nil {
-				// this happens if the clients still hold connections after the timeout.
-				if errors.Is(err, context.DeadlineExceeded) {
-					return fmt.Errorf("timeout waiting for server to shutdown: %w", err)
-				}
+// handleMetrics serves the unauthenticated metrics endpoint when toolMetrics is set.
+func handleMetrics(mux *http.ServeMux, toolMetrics *metrics.Metrics) {
+	if toolMetrics != nil {
+		mux.HandleFunc(metrics.Path, toolMetrics.Handler())
+	}
+}
 
-				return fmt.Errorf("failed to gracefully shutdown http server: %w", err)
-			}
+// drainCalls refuses new tool calls and waits until those in flight finish or ctx is done.
+func drainCalls(ctx context.Context, logger *slog.Logger, drainer *drain.Drainer) {
+	logger.Info("draining in-flight tool calls")
+	if running := drainer.Drain(ctx); running > 0 {
+		logger.Warn("tool calls still running after the shutdown timeout", "running", running)
+	}
+}
 
-			return nil
-		case err := <-errC:
-			if err != nil {
-				logger.Error("http server error", "error", err)
-				return fmt.Errorf("http server error: %w", err)
+// serveHTTP runs start until it fails or ctx is done, and then shuts the server down, giving
+// in-flight tool calls and requests up to timeout to finish.
+func serveHTTP(ctx context.Context, logger *slog.Logger, drainer *drain.Drainer, timeout time.Duration, start func() error, shutdown func(context.Context) error) error {
+	errC := make(chan error, 1)
+	go func() {
+		errC <- start()
+	}()
+
+	select {
+	case <-ctx.Done():
+
+		// allow in-flight requests to finish before the shutdown timeout.
+		timeoutCtx, cancelFunc := context.WithTimeout(context.Background(), timeout)
+		defer cancelFunc()
+
+		logger.Info("received shutdown signal")
+		drainCalls(timeoutCtx, logger, drainer)
+		err := shutdown(timeoutCtx)
+		if err != nil {
+			// this happens if the clients still hold connections after the timeout.
+			if errors.Is(err, context.DeadlineExceeded) {
+				return fmt.Errorf("timeout waiting for server to shutdown: %w", err)
 			}
+
+			return fmt.Errorf("failed to gracefully shutdown http server: %w", err)
+		}
+
+		return nil
+	case err := <-errC:
+		if err != nil {
+			logger.Error("http server error", "error", err)
+			return fmt.Errorf("http server error: %w", err)
 		}
 	}
 
@@ -0,0 +1,325 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"mcp-digitalocean/internal/health"
+	"mcp-digitalocean/internal/metrics"
+	"mcp-digitalocean/internal/ratelimit"
+	"mcp-digitalocean/internal/subscriptions"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func freeAddr(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+// testClient doesn't pool connections: the server's shutdown waits for pooled connections
+// that were dialed but never used.
+var testClient = &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+
+func postMCP(t *testing.T, url, sessionID, body string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("http.NewRequest() error = %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	if sessionID != "" {
+		req.Header.Set("Mcp-Session-Id", sessionID)
+	}
+	resp, err := testClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST %s error = %v", url, err)
+	}
+	return resp
+}
+
+func TestRunServer_statefulHTTP(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	subs := subscriptions.NewManager(time.Hour, logger)
+	s := server.NewMCPServer("test", "0.0.0", subs.ServerOptions()...)
+	s.AddResource(mcp.NewResource("digitalocean://regions", "Regions"), func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		return []mcp.ResourceContents{mcp.TextResourceContents{URI: req.Params.URI, Text: "[]"}}, nil
+	})
+	subs.SetServer(s)
+
+	cfg := &config{
+		transport:              "http",
+		bindAddr:               freeAddr(t),
+		httpStateful:           true,
+		httpSessionIdleTimeout: time.Minute,
+		shutdownTimeout:        10 * time.Second,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errC := make(chan error, 1)
+	go func() {
+		errC <- runServer(ctx, s, subs, nil, logger, cfg, nil, nil, nil, health.NewChecker(nil, time.Minute), metrics.New())
+	}()
+
+	url := "http://" + cfg.bindAddr + mcpEndpointPath
+	var resp *http.Response
+	for i := 0; ; i++ {
+		req, _ := http.NewRequest(http.MethodPost, url, strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`))
+		req.Header.Set("Content-Type", "application/json")
+		var err error
+		if resp, err = testClient.Do(req); err == nil {
+			break
+		}
+		if i == 50 {
+			t.Fatalf("server did not start: %v", err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	resp.Body.Close()
+	sessionID := resp.Header.Get("Mcp-Session-Id")
+	if sessionID == "" {
+		t.Fatal("initialize response has no Mcp-Session-Id")
+	}
+
+	for _, path := range []string{health.LivenessPath, health.ReadinessPath, metrics.Path} {
+		resp, err := testClient.Get("http://" + cfg.bindAddr + path)
+		if err != nil {
+			t.Fatalf("GET %s error = %v", path, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("GET %s status = %d, want 200", path, resp.StatusCode)
+		}
+	}
+
+	resp = postMCP(t, url, sessionID, `{"jsonrpc":"2.0","id":2,"method":"resources/subscribe","params":{"uri":"digitalocean://regions"}}`)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), `"result":{}`) {
+		t.Fatalf("subscribe response = %s, want an empty result", body)
+	}
+
+	// an open notification stream must not hold up the shutdown.
+	stream, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("http.NewRequest() error = %v", err)
+	}
+	stream.Header.Set("Accept", "text/event-stream")
+	stream.Header.Set("Mcp-Session-Id", sessionID)
+	streamResp, err := testClient.Do(stream)
+	if err != nil {
+		t.Fatalf("GET %s error = %v", url, err)
+	}
+	defer streamResp.Body.Close()
+
+	start := time.Now()
+	cancel()
+	select {
+	case err := <-errC:
+		if err != nil {
+			t.Fatalf("runServer() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("runServer() did not return after the shutdown signal")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("shutdown took %s", elapsed)
+	}
+}
+
+func TestRunServer_sse(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := server.NewMCPServer("test", "0.0.0")
+	requireAuth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	cfg := &config{transport: "sse", bindAddr: freeAddr(t), shutdownTimeout: 10 * time.Second}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errC := make(chan error, 1)
+	readiness := health.NewChecker(func(ctx context.Context) error { return health.ErrInvalidToken }, time.Minute)
+	go func() { errC <- runServer(ctx, s, nil, nil, logger, cfg, nil, nil, requireAuth, readiness, nil) }()
+
+	base := "http://" + cfg.bindAddr
+	var resp *http.Response
+	for i := 0; ; i++ {
+		var err error
+		if resp, err = testClient.Get(base + sseEndpointPath); err == nil {
+			break
+		}
+		if i == 50 {
+			t.Fatalf("server did not start: %v", err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("unauthenticated GET %s status = %d, want 401", sseEndpointPath, resp.StatusCode)
+	}
+
+	// the health endpoints are served without authentication.
+	for path, want := range map[string]int{health.LivenessPath: http.StatusOK, health.ReadinessPath: http.StatusServiceUnavailable} {
+		resp, err := testClient.Get(base + path)
+		if err != nil {
+			t.Fatalf("GET %s error = %v", path, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != want {
+			t.Fatalf("GET %s status = %d, want %d", path, resp.StatusCode, want)
+		}
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, base+sseEndpointPath, nil)
+	req.Header.Set("Authorization", "Bearer test-token")
+	stream, err := testClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET %s error = %v", sseEndpointPath, err)
+	}
+	defer stream.Body.Close()
+	events := bufio.NewReader(stream.Body)
+	readData := func() string {
+		t.Helper()
+		for {
+			line, err := events.ReadString('\n')
+			if err != nil {
+				t.Fatalf("reading the event stream: %v", err)
+			}
+			if data, ok := strings.CutPrefix(line, "data: "); ok {
+				return strings.TrimSpace(data)
+			}
+		}
+	}
+	endpoint := readData()
+	if !strings.HasPrefix(endpoint, sseMessageEndpointPath+"?sessionId=") {
+		t.Fatalf("endpoint event = %q", endpoint)
+	}
+
+	post, _ := http.NewRequest(http.MethodPost, base+endpoint, strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"ping"}`))
+	post.Header.Set("Content-Type", "application/json")
+	post.Header.Set("Authorization", "Bearer test-token")
+	resp, err = testClient.Do(post)
+	if err != nil {
+		t.Fatalf("POST %s error = %v", endpoint, err)
+	}
+	resp.Body.Close()
+	if got := readData(); !strings.Contains(got, `"id":1,"result":{}`) {
+		t.Fatalf("ping response = %s", got)
+	}
+
+	cancel()
+	select {
+	case err := <-errC:
+		if err != nil {
+			t.Fatalf("runServer() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("runServer() did not return after the shutdown signal")
+	}
+}
+
+func TestNewGodoClient_countsAttemptsOfToolCalls(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Authorization = %q, want the token", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"account":{"uuid":"acc-1"}}`))
+	}))
+	defer srv.Close()
+
+	// with a limiter, the client is paced below godo's retry layer.
+	client, err := newGodoClientWithTokenAndEndpoint(context.Background(), "test-token", srv.URL, "", ratelimit.New(100, 10, 2), nil)
+	if err != nil {
+		t.Fatalf("newGodoClientWithTokenAndEndpoint() error = %v", err)
+	}
+	toolMetrics := metrics.New()
+	handler := toolMetrics.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if _, _, err := client.Account.Get(ctx); err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultText("ok"), nil
+	})
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "account-get-information"
+	if _, err := handler(context.Background(), req); err != nil {
+		t.Fatalf("tool call error = %v", err)
+	}
+
+	var out strings.Builder
+	toolMetrics.Write(&out)
+	if want := `digitalocean_api_requests_total{tool="account-get-information",method="GET",code="200"} 1`; !strings.Contains(out.String(), want) {
+		t.Fatalf("metrics do not contain %q:\n%s", want, out.String())
+	}
+}
+
+func TestNewMCPServer_filtersServerTools(t *testing.T) {
+	cfg := config{
+		services:           "accounts",
+		disableTools:       "do-capabilities,server-version,confirm-destructive,account-profile-*",
+		confirmDestructive: true,
+	}
+	profileTool := server.ServerTool{
+		Tool:    mcp.NewTool("account-profile-list"),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) { return nil, nil },
+	}
+	svr, _, err := newMCPServer(slog.New(slog.NewTextHandler(io.Discard, nil)), &cfg, nil, nil, nil, nil, []server.ServerTool{profileTool})
+	if err != nil {
+		t.Fatalf("newMCPServer() error = %v", err)
+	}
+	tools := svr.ListTools()
+	for _, name := range []string{"do-capabilities", "server-version", "confirm-destructive", "account-profile-list"} {
+		if _, ok := tools[name]; ok {
+			t.Errorf("disabled %s registered", name)
+		}
+	}
+	if _, ok := tools["key-list"]; !ok {
+		t.Error("key-list not registered")
+	}
+}
+
+func TestNewMCPServer_sessionTools(t *testing.T) {
+	cfg := config{
+		services:           "accounts",
+		disableTools:       "session-resources-list",
+		confirmDestructive: true,
+		sessionStateFile:   filepath.Join(t.TempDir(), "state.json"),
+	}
+	svr, _, err := newMCPServer(slog.New(slog.NewTextHandler(io.Discard, nil)), &cfg, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("newMCPServer() error = %v", err)
+	}
+	tools := svr.ListTools()
+	if _, ok := tools["session-resources-list"]; ok {
+		t.Error("disabled session-resources-list registered")
+	}
+	cleanup, ok := tools["session-cleanup"]
+	if !ok {
+		t.Fatal("session-cleanup not registered")
+	}
+	if _, ok := cleanup.Tool.InputSchema.Properties["Confirm"]; !ok {
+		t.Errorf("session-cleanup is not guarded: %v", cleanup.Tool.InputSchema.Properties)
+	}
+}
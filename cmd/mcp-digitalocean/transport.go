@@ -0,0 +1,82 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// newAPIHTTPClient returns the HTTP client the DigitalOcean API is reached with, configured by
+// --digitalocean-api-ca-bundle, --digitalocean-api-proxy and --digitalocean-api-timeout. Its
+// transport is nil when neither a CA bundle nor a proxy is set, so the clients keep their default
+// transports, which use the proxy of the HTTPS_PROXY and NO_PROXY environment variables.
+func newAPIHTTPClient(cfg *config) (*http.Client, error) {
+	if cfg.apiTimeout < 0 {
+		return nil, fmt.Errorf("--digitalocean-api-timeout must not be negative, got %s", cfg.apiTimeout)
+	}
+	client := &http.Client{Timeout: cfg.apiTimeout}
+	caBundle, proxy := strings.TrimSpace(cfg.apiCABundle), strings.TrimSpace(cfg.apiProxy)
+	if caBundle == "" && proxy == "" {
+		return client, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caBundle != "" {
+		pem, err := os.ReadFile(caBundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		// the bundle adds to the system roots, so the public API stays reachable.
+		roots, err := x509.SystemCertPool()
+		if err != nil {
+			roots = x509.NewCertPool()
+		}
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA bundle %s contains no PEM certificates", caBundle)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+	}
+	if proxy != "" {
+		proxyURL, err := url.Parse(proxy)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("--digitalocean-api-proxy %q is not a proxy URL such as http://proxy.example.com:3128", redactURL(proxy))
+		}
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5", "socks5h":
+		default:
+			return nil, fmt.Errorf("--digitalocean-api-proxy has unsupported scheme %q: use http, https or socks5", proxyURL.Scheme)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	client.Transport = transport
+	return client, nil
+}
+
+// describeAPIConnection summarizes how the DigitalOcean API is reached, for the doctor command.
+func describeAPIConnection(cfg *config) string {
+	parts := []string{"proxy from environment"}
+	if proxy := strings.TrimSpace(cfg.apiProxy); proxy != "" {
+		parts[0] = "proxy " + redactURL(proxy)
+	}
+	if caBundle := strings.TrimSpace(cfg.apiCABundle); caBundle != "" {
+		parts = append(parts, "CA bundle "+caBundle)
+	}
+	timeout := "no timeout"
+	if cfg.apiTimeout > 0 {
+		timeout = "timeout " + cfg.apiTimeout.String()
+	}
+	return strings.Join(append(parts, timeout), ", ")
+}
+
+// redactURL hides the password of a proxy URL.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	return u.Redacted()
+}
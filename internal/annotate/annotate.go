@@ -0,0 +1,102 @@
+// Package annotate sets the MCP tool annotations of every registered tool from its name, so clients
+// can tell the tools that only read from those that change resources, and prompt before the ones
+// that delete or overwrite them. Every tool calls the DigitalOcean API and so talks to an open
+// world, unless it says otherwise.
+package annotate
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"mcp-digitalocean/internal/confirm"
+	"mcp-digitalocean/internal/dryrun"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// destructiveVerbs are the tool name segments, besides those that need confirmation, that mark a
+// tool as removing resources, settings or data that can't be brought back by calling it again.
+var destructiveVerbs = []string{"cancel", "exec", "flush", "recycle", "release", "remove", "reset"}
+
+// idempotentVerbs are the tool name segments whose repeated calls with the same arguments have no
+// further effect, and onceVerbs those that act again on every call. A mutating tool is idempotent
+// when it has one of the first and none of the second. Snapshot and run are left out of both, as
+// they also name what tools such as snapshot-delete act on.
+var (
+	idempotentVerbs = []string{
+		"add", "assign", "attach", "cancel", "change", "cleanup", "delete", "destroy", "detach", "disable", "edit", "enable",
+		"flush", "prune", "purge", "remove", "rename", "resize", "set", "switch", "sync", "unassign", "update",
+	}
+	onceVerbs = []string{
+		"apply", "bootstrap", "clone", "convert", "create", "deploy", "exec", "import", "install", "invoke", "migrate", "power",
+		"promote", "provision", "reassign", "reboot", "rebuild", "recycle", "release", "reserve", "reset", "restore",
+		"shutdown", "start", "stop", "transfer", "upgrade", "upload",
+	}
+)
+
+// defaults are the annotations mcp.NewTool gives a tool that doesn't set any.
+var defaults = mcp.NewTool("").Annotations
+
+// IsDestructive reports whether the tool name marks the tool as destructive: it contains a verb
+// that needs confirmation, or another destructive verb, as one of its dash-separated segments.
+func IsDestructive(name string) bool {
+	if confirm.IsDestructive(name) {
+		return true
+	}
+	for _, segment := range strings.Split(name, "-") {
+		if slices.Contains(destructiveVerbs, segment) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsIdempotent reports whether the tool name marks the tool as idempotent: it changes nothing, or
+// one of its dash-separated segments is an idempotent verb and none is a verb that acts again.
+func IsIdempotent(name string) bool {
+	if !dryrun.IsMutating(name) {
+		return true
+	}
+	idempotent := false
+	for _, segment := range strings.Split(name, "-") {
+		if slices.Contains(onceVerbs, segment) {
+			return false
+		}
+		idempotent = idempotent || slices.Contains(idempotentVerbs, segment)
+	}
+	return idempotent
+}
+
+// Hints returns the annotations of the tool with the given name: tools that get or list resources,
+// or have no mutating verb, are read-only as dryrun.IsMutating says, and the others destructive and
+// idempotent as their verbs say.
+func Hints(name string) mcp.ToolAnnotation {
+	readOnly := !dryrun.IsMutating(name)
+	return mcp.ToolAnnotation{
+		ReadOnlyHint:    mcp.ToBoolPtr(readOnly),
+		DestructiveHint: mcp.ToBoolPtr(!readOnly && IsDestructive(name)),
+		IdempotentHint:  mcp.ToBoolPtr(readOnly || IsIdempotent(name)),
+		OpenWorldHint:   mcp.ToBoolPtr(true),
+	}
+}
+
+// Apply sets the annotations of every tool registered with s from its name. Tools whose
+// annotations differ from those mcp.NewTool gives by default were annotated by hand and keep them.
+func Apply(s *server.MCPServer) {
+	var updated []server.ServerTool
+	for _, st := range s.ListTools() {
+		tool := st.Tool
+		hints := tool.Annotations
+		hints.Title = ""
+		if !reflect.DeepEqual(hints, mcp.ToolAnnotation{}) && !reflect.DeepEqual(hints, defaults) {
+			continue
+		}
+		hints = Hints(tool.Name)
+		hints.Title = tool.Annotations.Title
+		tool.Annotations = hints
+		updated = append(updated, server.ServerTool{Tool: tool, Handler: st.Handler})
+	}
+	s.AddTools(updated...)
+}
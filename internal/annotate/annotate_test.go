@@ -0,0 +1,77 @@
+package annotate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func TestHints(t *testing.T) {
+	tests := []struct {
+		name                              string
+		readOnly, destructive, idempotent bool
+	}{
+		{name: "droplet-list", readOnly: true, idempotent: true},
+		{name: "droplet-get", readOnly: true, idempotent: true},
+		{name: "droplet-history", readOnly: true, idempotent: true},
+		{name: "droplet-create", idempotent: false},
+		{name: "droplet-delete", destructive: true, idempotent: true},
+		{name: "cleanup-by-tag", destructive: true, idempotent: true},
+		{name: "rebuild-droplet", destructive: true},
+		{name: "droplet-exec", destructive: true},
+		{name: "lb-update", idempotent: true},
+		{name: "firewall-remove-rules", destructive: true, idempotent: true},
+		{name: "functions-create-or-update-action"},
+		{name: "reboot-droplet"},
+		{name: "doks-bootstrap"},
+		{name: "snapshot-list", readOnly: true, idempotent: true},
+		{name: "genai-model-eval-get-run", readOnly: true, idempotent: true},
+		{name: "genai-batch-inference-cancel", destructive: true, idempotent: true},
+		{name: "image-action-transfer"},
+		{name: "snapshot-delete", destructive: true, idempotent: true},
+		{name: "snapshot-droplet"},
+		{name: "genai-model-eval-update-run", idempotent: true},
+		// the names of these say otherwise.
+		{name: "firewall-sync", destructive: true, idempotent: true},
+		{name: "db-restore-from-backup"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			hints := Hints(tc.name)
+			if *hints.ReadOnlyHint != tc.readOnly || *hints.DestructiveHint != tc.destructive || *hints.IdempotentHint != tc.idempotent {
+				t.Fatalf("Hints() = read-only %v, destructive %v, idempotent %v, want %v, %v, %v",
+					*hints.ReadOnlyHint, *hints.DestructiveHint, *hints.IdempotentHint, tc.readOnly, tc.destructive, tc.idempotent)
+			}
+			if !*hints.OpenWorldHint {
+				t.Fatal("open world hint not set")
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	s := server.NewMCPServer("test", "0.0.0")
+	noop := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) { return nil, nil }
+	s.AddTool(mcp.NewTool("droplet-list", mcp.WithTitleAnnotation("List droplets")), noop)
+	s.AddTool(mcp.NewTool("image-delete", mcp.WithDestructiveHintAnnotation(true)), noop)
+	s.AddTool(mcp.NewTool("doks-upgrade-options", mcp.WithReadOnlyHintAnnotation(true)), noop)
+
+	Apply(s)
+
+	tools := s.ListTools()
+	list := tools["droplet-list"].Tool.Annotations
+	if !*list.ReadOnlyHint || *list.DestructiveHint || list.Title != "List droplets" {
+		t.Fatalf("droplet-list annotations = %+v", list)
+	}
+	// the default annotations are replaced even when a tool sets them by hand.
+	del := tools["image-delete"].Tool.Annotations
+	if *del.ReadOnlyHint || !*del.DestructiveHint || !*del.IdempotentHint {
+		t.Fatalf("image-delete annotations = %+v", del)
+	}
+	// other hand-set annotations are kept, even when the name says otherwise.
+	if options := tools["doks-upgrade-options"].Tool.Annotations; !*options.ReadOnlyHint {
+		t.Fatalf("doks-upgrade-options annotations = %+v", options)
+	}
+}
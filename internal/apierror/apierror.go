@@ -0,0 +1,167 @@
+// Package apierror adds the details of DigitalOcean API errors to failed tool results.
+//
+// Tools report API failures as text wrapping the godo error, which leaves a model to guess
+// whether a resource is missing, the token lacks a scope or an argument is invalid. The
+// Transport parses every error response of a tool call into a *godo.ErrorResponse, and the
+// Middleware appends the details of the one named in a failed result as JSON: the HTTP status,
+// the DigitalOcean error ID, the message, the request ID and a hint on what to do next.
+package apierror
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Detail describes one failed API request.
+type Detail struct {
+	Status    int    `json:"status"`
+	ID        string `json:"id,omitempty"`
+	Message   string `json:"message,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+	Method    string `json:"method"`
+	URL       string `json:"url"`
+	Hint      string `json:"hint,omitempty"`
+}
+
+// Result is the JSON content appended to a failed tool result.
+type Result struct {
+	Error Detail `json:"error"`
+}
+
+// hints tell the model how to react to the common statuses.
+var hints = map[int]string{
+	http.StatusBadRequest:          "The request was malformed; check the argument types and formats.",
+	http.StatusUnauthorized:        "The API token is missing, expired or revoked; retrying will not help.",
+	http.StatusForbidden:           "The token lacks the scope for this operation, or the account may not use the feature.",
+	http.StatusNotFound:            "The resource does not exist; check the ID, name or region, e.g. with a list tool.",
+	http.StatusConflict:            "The resource is busy or in a conflicting state; wait for pending actions to finish and retry.",
+	http.StatusUnprocessableEntity: "The API rejected an argument value; correct the argument named in the message and retry.",
+	http.StatusTooManyRequests:     "The account's API rate limit is exhausted; wait before retrying.",
+}
+
+// hint returns the hint for status, with a generic one for server errors.
+func hint(status int) string {
+	if h, ok := hints[status]; ok {
+		return h
+	}
+	if status >= 500 {
+		return "The API failed to process the request; retry later."
+	}
+	return ""
+}
+
+type observationKey struct{}
+
+// observation collects the error responses of one tool call.
+type observation struct {
+	mu      sync.Mutex
+	details []Detail
+}
+
+func (o *observation) add(d Detail) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.details = append(o.details, d)
+}
+
+// transport records the error responses of requests made during a tool call.
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps base so the error responses of a tool call can be described in its result.
+// It should wrap the retry layer, so only the final response of a request is recorded.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	obs, ok := req.Context().Value(observationKey{}).(*observation)
+	if !ok || err != nil || resp.StatusCode < 300 {
+		return resp, err
+	}
+
+	var data []byte
+	if resp.Body != nil {
+		data, _ = io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	obs.add(parse(req, resp, data))
+	return resp, nil
+}
+
+// parse describes an error response the way godo reports it, adding the DigitalOcean error ID godo drops.
+func parse(req *http.Request, resp *http.Response, data []byte) Detail {
+	parsed := *resp
+	parsed.Body = io.NopCloser(bytes.NewReader(data))
+	d := Detail{Status: resp.StatusCode, Method: req.Method, URL: req.URL.String(), Hint: hint(resp.StatusCode)}
+	var errResp *godo.ErrorResponse
+	if errors.As(godo.CheckResponse(&parsed), &errResp) {
+		d.Message = errResp.Message
+		d.RequestID = errResp.RequestID
+	}
+	var body struct {
+		ID string `json:"id"`
+	}
+	if json.Unmarshal(data, &body) == nil {
+		d.ID = body.ID
+	}
+	return d
+}
+
+// signature is the part of godo's error string that identifies the failed request.
+func (d Detail) signature() string {
+	return fmt.Sprintf("%s %s: %d", d.Method, d.URL, d.Status)
+}
+
+// Middleware appends the details of the API error that failed a tool call to its result. Only an
+// error the result's text names is described, so a handled error of an earlier request is not
+// mistaken for the cause.
+func Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		obs := &observation{}
+		res, err := next(context.WithValue(ctx, observationKey{}, obs), req)
+		if err != nil || res == nil || !res.IsError {
+			return res, err
+		}
+
+		var text strings.Builder
+		for _, c := range res.Content {
+			if tc, ok := c.(mcp.TextContent); ok {
+				text.WriteString(tc.Text)
+			}
+		}
+		obs.mu.Lock()
+		defer obs.mu.Unlock()
+		for i := len(obs.details) - 1; i >= 0; i-- {
+			d := obs.details[i]
+			if !strings.Contains(text.String(), d.signature()) {
+				continue
+			}
+			jsonResult, err := json.MarshalIndent(Result{Error: d}, "", "  ")
+			if err != nil {
+				return nil, fmt.Errorf("marshal error: %w", err)
+			}
+			res.Content = append(res.Content, mcp.NewTextContent(string(jsonResult)))
+			break
+		}
+		return res, nil
+	}
+}
@@ -0,0 +1,114 @@
+package apierror
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func setupClient(t *testing.T) *godo.Client {
+	t.Helper()
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/droplets/1":
+			w.Header().Set("x-request-id", "req-123")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"id":"not_found","message":"The resource you were accessing could not be found."}`))
+		case "/v2/droplets":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"id":"unprocessable_entity","message":"size is invalid","request_id":"req-456"}`))
+		default:
+			_, _ = w.Write([]byte(`{"account":{"uuid":"1"}}`))
+		}
+	}))
+	t.Cleanup(api.Close)
+	client := godo.NewFromToken("token")
+	if err := godo.SetBaseURL(api.URL)(client); err != nil {
+		t.Fatal(err)
+	}
+	client.HTTPClient.Transport = NewTransport(client.HTTPClient.Transport)
+	return client
+}
+
+func details(t *testing.T, res *mcp.CallToolResult) *Detail {
+	t.Helper()
+	if len(res.Content) < 2 {
+		return nil
+	}
+	var result Result
+	if err := json.Unmarshal([]byte(res.Content[len(res.Content)-1].(mcp.TextContent).Text), &result); err != nil {
+		t.Fatalf("error details are not JSON: %v", err)
+	}
+	return &result.Error
+}
+
+func TestMiddleware(t *testing.T) {
+	client := setupClient(t)
+	tests := []struct {
+		name    string
+		handler func(ctx context.Context) *mcp.CallToolResult
+		want    *Detail
+	}{
+		{
+			name: "Not found",
+			handler: func(ctx context.Context) *mcp.CallToolResult {
+				_, _, err := client.Droplets.Get(ctx, 1)
+				return mcp.NewToolResultErrorFromErr("api error", err)
+			},
+			want: &Detail{Status: 404, ID: "not_found", Message: "The resource you were accessing could not be found.", RequestID: "req-123", Method: "GET"},
+		},
+		{
+			name: "Unprocessable",
+			handler: func(ctx context.Context) *mcp.CallToolResult {
+				_, _, err := client.Droplets.Create(ctx, &godo.DropletCreateRequest{Name: "web"})
+				return mcp.NewToolResultErrorFromErr("failed to create droplet", err)
+			},
+			want: &Detail{Status: 422, ID: "unprocessable_entity", Message: "size is invalid", RequestID: "req-456", Method: "POST"},
+		},
+		{
+			name: "Handled error before another failure",
+			handler: func(ctx context.Context) *mcp.CallToolResult {
+				_, _, _ = client.Droplets.Get(ctx, 1)
+				return mcp.NewToolResultError("Name is required")
+			},
+		},
+		{
+			name: "Success",
+			handler: func(ctx context.Context) *mcp.CallToolResult {
+				_, _, _ = client.Droplets.Get(ctx, 1)
+				return mcp.NewToolResultText("ok")
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return tc.handler(ctx), nil
+			})
+			res, err := handler(context.Background(), mcp.CallToolRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := details(t, res)
+			if tc.want == nil {
+				if got != nil {
+					t.Fatalf("unexpected error details %+v", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("missing error details")
+			}
+			if got.Status != tc.want.Status || got.ID != tc.want.ID || got.Message != tc.want.Message ||
+				got.RequestID != tc.want.RequestID || got.Method != tc.want.Method || got.Hint == "" {
+				t.Fatalf("details = %+v, want %+v with a hint", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+// Package audit keeps a record of every call of a tool that changes resources: when it was made,
+// by whom, with which arguments, which DigitalOcean API requests it sent and how it ended. Records
+// are appended to a JSON Lines file, posted to a webhook, or both.
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	middleware "mcp-digitalocean/internal"
+	"mcp-digitalocean/internal/correlation"
+	"mcp-digitalocean/internal/dryrun"
+	"mcp-digitalocean/internal/oauthmeta"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// Redacted replaces the values of arguments that look like secrets.
+	Redacted = "[REDACTED]"
+	// writeTimeout bounds the time a record may take to reach all sinks.
+	writeTimeout = 10 * time.Second
+	// maxErrorLength caps the error text kept in a record.
+	maxErrorLength = 1000
+)
+
+// secretArgFragments are the fragments of argument names whose values are not recorded.
+var secretArgFragments = []string{"password", "secret", "token", "private_key", "privatekey"}
+
+// Caller identifies who made a tool call. The token itself is never recorded, only a fingerprint
+// that tells the tokens apart.
+type Caller struct {
+	// Subject is the OAuth subject of the bearer token, when tokens are validated.
+	Subject string `json:"subject,omitempty"`
+	// Profile is the active account profile of a stdio server.
+	Profile          string `json:"profile,omitempty"`
+	TokenFingerprint string `json:"token_fingerprint,omitempty"`
+	SessionID        string `json:"session_id,omitempty"`
+}
+
+// Record is one audited tool call.
+type Record struct {
+	Time      time.Time      `json:"time"`
+	Tool      string         `json:"tool"`
+	Arguments map[string]any `json:"arguments"`
+	Caller    Caller         `json:"caller"`
+	DryRun    bool           `json:"dry_run,omitempty"`
+	// CorrelationID is the correlation ID the call's API requests were sent with.
+	CorrelationID string   `json:"correlation_id,omitempty"`
+	RequestIDs    []string `json:"request_ids"`
+	Outcome       string   `json:"outcome"`
+	Error         string   `json:"error,omitempty"`
+	DurationMS    int64    `json:"duration_ms"`
+}
+
+// Sink stores audit records.
+type Sink interface {
+	Write(ctx context.Context, rec Record) error
+}
+
+// Auditor records the calls of mutating tools to its sinks.
+type Auditor struct {
+	sinks  []Sink
+	caller func(ctx context.Context) Caller
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// New returns an auditor writing to sinks. caller identifies the caller of a tool call; when nil,
+// CallerFromContext is used. Records that can't be written are logged to logger.
+func New(logger *slog.Logger, caller func(ctx context.Context) Caller, sinks ...Sink) *Auditor {
+	if caller == nil {
+		caller = CallerFromContext
+	}
+	return &Auditor{sinks: sinks, caller: caller, logger: logger, now: time.Now}
+}
+
+// CallerFromContext identifies the caller by the OAuth principal, bearer token and MCP session of ctx.
+func CallerFromContext(ctx context.Context) Caller {
+	var caller Caller
+	if p, ok := oauthmeta.PrincipalFromContext(ctx); ok {
+		caller.Subject = p.Subject
+	}
+	caller.TokenFingerprint = Fingerprint(middleware.BearerToken(ctx))
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		caller.SessionID = session.SessionID()
+	}
+	return caller
+}
+
+// Fingerprint returns a short hash of token, or "" for no token.
+func Fingerprint(token string) string {
+	if token == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:6])
+}
+
+type requestIDsKey struct{}
+
+// requestIDs collects the request IDs of the mutating API requests of one tool call.
+type requestIDs struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+// Middleware records each call of a mutating tool once it returns. Other tools are not recorded.
+func (a *Auditor) Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if !dryrun.IsMutating(req.Params.Name) {
+			return next(ctx, req)
+		}
+		start := a.now()
+		ids := &requestIDs{}
+		res, err := next(context.WithValue(ctx, requestIDsKey{}, ids), req)
+
+		args := req.GetArguments()
+		dry, _ := args[dryrun.Arg].(bool)
+		rec := Record{
+			Time:          start.UTC(),
+			Tool:          req.Params.Name,
+			Arguments:     redact(args),
+			Caller:        a.caller(ctx),
+			DryRun:        dry,
+			CorrelationID: correlation.FromContext(ctx),
+			Outcome:       "success",
+			DurationMS:    a.now().Sub(start).Milliseconds(),
+		}
+		ids.mu.Lock()
+		rec.RequestIDs = append([]string{}, ids.ids...)
+		ids.mu.Unlock()
+		switch {
+		case err != nil:
+			rec.Outcome, rec.Error = "error", truncate(err.Error())
+		case res != nil && res.IsError:
+			rec.Outcome, rec.Error = "error", truncate(resultText(res))
+		}
+		a.write(ctx, rec)
+		return res, err
+	}
+}
+
+// write hands rec to every sink. The call's result doesn't wait on a sink longer than writeTimeout,
+// and a canceled call is still recorded.
+func (a *Auditor) write(ctx context.Context, rec Record) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), writeTimeout)
+	defer cancel()
+	for _, sink := range a.sinks {
+		if err := sink.Write(ctx, rec); err != nil {
+			a.logger.Error("failed to write audit record", "tool", rec.Tool, "error", err)
+		}
+	}
+}
+
+// redact copies args, replacing the values of secret-looking arguments, at any depth, with Redacted.
+func redact(args map[string]any) map[string]any {
+	out := make(map[string]any, len(args))
+	for k, v := range args {
+		if isSecretArg(k) {
+			out[k] = Redacted
+			continue
+		}
+		out[k] = redactValue(v)
+	}
+	return out
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return redact(v)
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = redactValue(item)
+		}
+		return out
+	}
+	return v
+}
+
+func isSecretArg(name string) bool {
+	name = strings.ToLower(name)
+	for _, fragment := range secretArgFragments {
+		if strings.Contains(name, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+func resultText(res *mcp.CallToolResult) string {
+	for _, content := range res.Content {
+		if text, ok := content.(mcp.TextContent); ok {
+			return text.Text
+		}
+	}
+	return ""
+}
+
+func truncate(s string) string {
+	if len(s) > maxErrorLength {
+		return s[:maxErrorLength]
+	}
+	return s
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps base so the request IDs of the mutating API requests of an audited tool
+// call end up in its record. It sits below the retry layer, so each attempt's ID is kept.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	ids, ok := req.Context().Value(requestIDsKey{}).(*requestIDs)
+	if !ok || err != nil || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return resp, err
+	}
+	if id := resp.Header.Get("X-Request-Id"); id != "" {
+		ids.mu.Lock()
+		ids.ids = append(ids.ids, id)
+		ids.mu.Unlock()
+	}
+	return resp, nil
+}
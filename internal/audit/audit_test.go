@@ -0,0 +1,169 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	middleware "mcp-digitalocean/internal"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+type memorySink struct {
+	records []Record
+}
+
+func (m *memorySink) Write(_ context.Context, rec Record) error {
+	m.records = append(m.records, rec)
+	return nil
+}
+
+func callTool(a *Auditor, ctx context.Context, name string, args map[string]any, handler func(ctx context.Context) (*mcp.CallToolResult, error)) {
+	req := mcp.CallToolRequest{}
+	req.Params.Name = name
+	req.Params.Arguments = args
+	_, _ = a.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handler(ctx)
+	})(ctx, req)
+}
+
+func TestMiddleware_recordsMutatingCalls(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", r.Method+"-id")
+	}))
+	defer srv.Close()
+	client := &http.Client{Transport: NewTransport(nil)}
+	send := func(ctx context.Context, method string) {
+		req, _ := http.NewRequestWithContext(ctx, method, srv.URL+"/v2/droplets", nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s error = %v", method, err)
+		}
+		resp.Body.Close()
+	}
+
+	sink := &memorySink{}
+	auditor := New(slog.New(slog.NewTextHandler(io.Discard, nil)), nil, sink)
+	ctx := middleware.WithAuthKey(context.Background(), "Bearer dop_v1_secret")
+
+	callTool(auditor, ctx, "droplet-create", map[string]any{
+		"Name":   "web",
+		"Config": map[string]any{"AdminPassword": "hunter2"},
+	}, func(ctx context.Context) (*mcp.CallToolResult, error) {
+		send(ctx, http.MethodGet)
+		send(ctx, http.MethodPost)
+		return mcp.NewToolResultText("created"), nil
+	})
+	callTool(auditor, ctx, "droplet-list", nil, func(ctx context.Context) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("[]"), nil
+	})
+	callTool(auditor, ctx, "droplet-delete", map[string]any{"ID": float64(1)}, func(ctx context.Context) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultError("droplet not found"), nil
+	})
+	callTool(auditor, ctx, "volume-delete", map[string]any{"ID": "v"}, func(ctx context.Context) (*mcp.CallToolResult, error) {
+		return nil, errors.New("no client")
+	})
+
+	if len(sink.records) != 3 {
+		t.Fatalf("recorded %d calls, want the 3 mutating ones", len(sink.records))
+	}
+	created := sink.records[0]
+	if created.Tool != "droplet-create" || created.Outcome != "success" {
+		t.Fatalf("record = %+v", created)
+	}
+	if got := created.Arguments["Config"].(map[string]any)["AdminPassword"]; got != Redacted {
+		t.Errorf("password argument = %v, want it redacted", got)
+	}
+	if created.Arguments["Name"] != "web" {
+		t.Errorf("name argument = %v", created.Arguments["Name"])
+	}
+	if len(created.RequestIDs) != 1 || created.RequestIDs[0] != "POST-id" {
+		t.Errorf("request IDs = %v, want only the POST", created.RequestIDs)
+	}
+	if created.Caller.TokenFingerprint != Fingerprint("dop_v1_secret") || created.Caller.TokenFingerprint == "" {
+		t.Errorf("token fingerprint = %q", created.Caller.TokenFingerprint)
+	}
+
+	if rec := sink.records[1]; rec.Outcome != "error" || rec.Error != "droplet not found" {
+		t.Errorf("error result record = %+v", rec)
+	}
+	if rec := sink.records[2]; rec.Outcome != "error" || rec.Error != "no client" {
+		t.Errorf("failed call record = %+v", rec)
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("NewFileSink() error = %v", err)
+	}
+	for _, tool := range []string{"droplet-create", "droplet-delete"} {
+		if err := sink.Write(context.Background(), Record{Time: time.Now(), Tool: tool, Outcome: "success"}); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("permissions = %o, want 600", perm)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer f.Close()
+	var tools []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var rec Record
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			t.Fatalf("line %q is not a record: %v", scanner.Text(), err)
+		}
+		tools = append(tools, rec.Tool)
+	}
+	if len(tools) != 2 || tools[0] != "droplet-create" || tools[1] != "droplet-delete" {
+		t.Fatalf("tools = %v", tools)
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var got Record
+	status := http.StatusNoContent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "Bearer hook-token" {
+			t.Errorf("Authorization = %q", auth)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	sink := &WebhookSink{URL: srv.URL, Token: "hook-token"}
+	if err := sink.Write(context.Background(), Record{Tool: "droplet-create"}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if got.Tool != "droplet-create" {
+		t.Fatalf("posted record = %+v", got)
+	}
+	status = http.StatusInternalServerError
+	if err := sink.Write(context.Background(), Record{Tool: "droplet-create"}); err == nil {
+		t.Fatal("Write() succeeded on a 500")
+	}
+}
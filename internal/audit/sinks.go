@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// FileSink appends records to a JSON Lines file, one record per line.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens path for appending, creating it readable only by its owner.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	return &FileSink{file: f}, nil
+}
+
+// Write appends rec as one line.
+func (f *FileSink) Write(_ context.Context, rec Record) error {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("marshal error: %w", err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to append to audit log: %w", err)
+	}
+	return nil
+}
+
+// Close closes the file.
+func (f *FileSink) Close() error {
+	return f.file.Close()
+}
+
+// WebhookSink posts each record as a JSON object to a URL.
+type WebhookSink struct {
+	URL string
+	// Token, when set, is sent as a bearer token.
+	Token string
+	// Client sends the requests; http.DefaultClient when nil.
+	Client *http.Client
+}
+
+// Write posts rec and fails unless the webhook answers with a 2xx status.
+func (w *WebhookSink) Write(ctx context.Context, rec Record) error {
+	body, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("marshal error: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+w.Token)
+	}
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post audit record: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to post audit record: status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+// Package cache keeps the results of read-only catalog tools for a while.
+//
+// Agents list regions, sizes and distribution images again and again within a session, and the
+// answer rarely changes. The Cache returns a recent result of the same tool called with the same
+// arguments by the same account instead of calling the API. A call with CacheBypass: true always
+// reaches the API and refreshes the cached result.
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"maps"
+	"sync"
+	"time"
+
+	middleware "mcp-digitalocean/internal"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Arg is the boolean argument that skips the cache for one call.
+const Arg = "CacheBypass"
+
+// DefaultTTL is how long a result is served from the cache.
+const DefaultTTL = 5 * time.Minute
+
+// Cacheable maps the tools whose results are cached to a check of their arguments. Only calls the
+// check accepts are cached; image-list is cached for public images, not for snapshots and backups.
+var Cacheable = map[string]func(args map[string]any) bool{
+	"region-list":   always,
+	"size-list":     always,
+	"gpu-size-list": always,
+	"image-list": func(args map[string]any) bool {
+		imageType, _ := args["Type"].(string)
+		return imageType == "distribution" || imageType == "application"
+	},
+}
+
+func always(map[string]any) bool { return true }
+
+type entry struct {
+	result  *mcp.CallToolResult
+	expires time.Time
+}
+
+// Cache holds the results of cacheable tool calls.
+type Cache struct {
+	ttl   time.Duration
+	scope func(ctx context.Context) string
+	now   func() time.Time
+
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]entry
+}
+
+// New creates a cache keeping results for ttl, or returns nil when ttl is zero or less. Results are
+// kept apart by the caller's credentials and, when scope is not nil, by the scope of the call, e.g.
+// the active account profile.
+func New(ttl time.Duration, scope func(ctx context.Context) string) *Cache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &Cache{ttl: ttl, scope: scope, now: time.Now, entries: map[[sha256.Size]byte]entry{}}
+}
+
+// key identifies a call by its account, tool and arguments. encoding/json sorts map keys, so equal
+// arguments always encode the same.
+func (c *Cache) key(ctx context.Context, name string, args map[string]any) ([sha256.Size]byte, bool) {
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return [sha256.Size]byte{}, false
+	}
+	auth, _ := ctx.Value(middleware.AuthKey{}).(string)
+	var scope string
+	if c.scope != nil {
+		scope = c.scope(ctx)
+	}
+	h := sha256.New()
+	for _, part := range []string{auth, scope, name, string(encoded)} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	var key [sha256.Size]byte
+	copy(key[:], h.Sum(nil))
+	return key, true
+}
+
+// Middleware serves cacheable calls from the cache and stores their successful results.
+func (c *Cache) Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		cacheable, ok := Cacheable[req.Params.Name]
+		if !ok {
+			return next(ctx, req)
+		}
+		args := maps.Clone(req.GetArguments())
+		bypass, _ := args[Arg].(bool)
+		delete(args, Arg)
+		req.Params.Arguments = args
+		if !cacheable(args) {
+			return next(ctx, req)
+		}
+		key, ok := c.key(ctx, req.Params.Name, args)
+		if !ok {
+			return next(ctx, req)
+		}
+
+		now := c.now()
+		if !bypass {
+			c.mu.Lock()
+			e, ok := c.entries[key]
+			c.mu.Unlock()
+			if ok && now.Before(e.expires) {
+				return e.result, nil
+			}
+		}
+
+		res, err := next(ctx, req)
+		if err != nil || res == nil || res.IsError {
+			return res, err
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.entries[key] = entry{result: res, expires: now.Add(c.ttl)}
+		return res, nil
+	}
+}
+
+// Apply advertises the CacheBypass argument on every cacheable tool registered with s.
+func (c *Cache) Apply(s *server.MCPServer) {
+	var updated []server.ServerTool
+	for name, st := range s.ListTools() {
+		if _, ok := Cacheable[name]; !ok || st.Tool.RawInputSchema != nil {
+			continue
+		}
+		tool := st.Tool
+		props := maps.Clone(tool.InputSchema.Properties)
+		if props == nil {
+			props = map[string]any{}
+		}
+		props[Arg] = map[string]any{
+			"type":        "boolean",
+			"description": "Fetch a fresh result from the API instead of one cached in the last few minutes",
+		}
+		tool.InputSchema.Properties = props
+		updated = append(updated, server.ServerTool{Tool: tool, Handler: st.Handler})
+	}
+	s.AddTools(updated...)
+}
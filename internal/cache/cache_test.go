@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	middleware "mcp-digitalocean/internal"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// countingHandler answers every call with a fresh result and counts the calls that reached it.
+type countingHandler struct {
+	calls   int
+	fail    bool
+	lastArg map[string]any
+}
+
+func (h *countingHandler) handle(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	h.calls++
+	h.lastArg = req.GetArguments()
+	if h.fail {
+		return mcp.NewToolResultError("api error"), nil
+	}
+	return mcp.NewToolResultText("result"), nil
+}
+
+func call(t *testing.T, handler server.ToolHandlerFunc, ctx context.Context, name string, args map[string]any) {
+	t.Helper()
+	req := mcp.CallToolRequest{}
+	req.Params.Name = name
+	req.Params.Arguments = args
+	if _, err := handler(ctx, req); err != nil {
+		t.Fatalf("%s error = %v", name, err)
+	}
+}
+
+func TestCache_Middleware(t *testing.T) {
+	scope := "staging"
+	c := New(time.Minute, func(ctx context.Context) string { return scope })
+	now := time.Unix(0, 0)
+	c.now = func() time.Time { return now }
+	h := &countingHandler{}
+	handler := c.Middleware(h.handle)
+	ctx := context.Background()
+
+	call(t, handler, ctx, "region-list", map[string]any{"Page": float64(1)})
+	call(t, handler, ctx, "region-list", map[string]any{"Page": float64(1)})
+	if h.calls != 1 {
+		t.Fatalf("calls = %d, want the second call served from the cache", h.calls)
+	}
+
+	// other arguments, accounts and scopes are cached separately.
+	call(t, handler, ctx, "region-list", map[string]any{"Page": float64(2)})
+	call(t, handler, middleware.WithAuthKey(ctx, "Bearer other"), "region-list", map[string]any{"Page": float64(1)})
+	scope = "production"
+	call(t, handler, ctx, "region-list", map[string]any{"Page": float64(1)})
+	scope = "staging"
+	if h.calls != 4 {
+		t.Fatalf("calls = %d, want 4", h.calls)
+	}
+
+	// CacheBypass reaches the tool without the argument and refreshes the entry.
+	call(t, handler, ctx, "region-list", map[string]any{"Page": float64(1), Arg: true})
+	if _, ok := h.lastArg[Arg]; ok || h.calls != 5 {
+		t.Fatalf("calls = %d with arguments %v, want a bypassing call without %s", h.calls, h.lastArg, Arg)
+	}
+	call(t, handler, ctx, "region-list", map[string]any{"Page": float64(1)})
+	if h.calls != 5 {
+		t.Fatalf("calls = %d, want the refreshed result served from the cache", h.calls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	call(t, handler, ctx, "region-list", map[string]any{"Page": float64(1)})
+	if h.calls != 6 {
+		t.Fatalf("calls = %d, want an expired result fetched again", h.calls)
+	}
+}
+
+func TestCache_notCached(t *testing.T) {
+	c := New(time.Minute, nil)
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		tool string
+		args map[string]any
+		fail bool
+	}{
+		{name: "Other tool", tool: "droplet-list"},
+		{name: "User images", tool: "image-list", args: map[string]any{"Type": "user"}},
+		{name: "All images", tool: "image-list"},
+		{name: "Errors", tool: "size-list", fail: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := &countingHandler{fail: tc.fail}
+			handler := c.Middleware(h.handle)
+			call(t, handler, ctx, tc.tool, tc.args)
+			call(t, handler, ctx, tc.tool, tc.args)
+			if h.calls != 2 {
+				t.Fatalf("calls = %d, want both calls to reach the tool", h.calls)
+			}
+		})
+	}
+
+	h := &countingHandler{}
+	handler := c.Middleware(h.handle)
+	call(t, handler, ctx, "image-list", map[string]any{"Type": "distribution"})
+	call(t, handler, ctx, "image-list", map[string]any{"Type": "distribution"})
+	if h.calls != 1 {
+		t.Fatalf("calls = %d, want distribution images cached", h.calls)
+	}
+}
+
+func TestNew_disabled(t *testing.T) {
+	if c := New(0, nil); c != nil {
+		t.Fatalf("New(0) = %v, want nil", c)
+	}
+}
+
+func TestCache_Apply(t *testing.T) {
+	s := server.NewMCPServer("test", "0.0.0")
+	s.AddTool(mcp.NewTool("region-list"), (&countingHandler{}).handle)
+	s.AddTool(mcp.NewTool("droplet-list"), (&countingHandler{}).handle)
+	New(time.Minute, nil).Apply(s)
+
+	tools := s.ListTools()
+	if _, ok := tools["region-list"].Tool.InputSchema.Properties[Arg]; !ok {
+		t.Fatalf("region-list does not advertise %s", Arg)
+	}
+	if _, ok := tools["droplet-list"].Tool.InputSchema.Properties[Arg]; ok {
+		t.Fatalf("droplet-list advertises %s", Arg)
+	}
+}
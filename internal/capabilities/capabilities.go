@@ -0,0 +1,301 @@
+// Package capabilities tracks which services are usable for the calling account.
+//
+// A service whose API keeps answering "not found" on collection endpoints, or says the feature
+// is not enabled, is marked degraded after a few consecutive calls. While degraded, its tools
+// return a clear message without calling the API. After a cooldown the next call is let
+// through again, and any successful response marks the service available.
+package capabilities
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	middleware "mcp-digitalocean/internal"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// ToolName is the name of the tool reporting service capabilities.
+	ToolName = "do-capabilities"
+	// DefaultThreshold is the number of consecutive unavailable calls that degrade a service.
+	DefaultThreshold = 3
+	// DefaultCooldown is how long a degraded service short-circuits its tools.
+	DefaultCooldown = 10 * time.Minute
+
+	// StatusAvailable and StatusDegraded are the service statuses reported by do-capabilities.
+	StatusAvailable = "available"
+	StatusDegraded  = "degraded"
+)
+
+// unavailableMessages are fragments of API error messages saying a feature is off for the account.
+var unavailableMessages = []string{"not enabled", "not available", "not supported"}
+
+// ServiceCapability is the status of one service as reported by the do-capabilities tool.
+type ServiceCapability struct {
+	Service       string `json:"service"`
+	Status        string `json:"status"`
+	Tools         int    `json:"tools"`
+	Reason        string `json:"reason,omitempty"`
+	DegradedSince string `json:"degraded_since,omitempty"`
+	RetryAfter    string `json:"retry_after,omitempty"`
+}
+
+// Capabilities is the result of the do-capabilities tool.
+type Capabilities struct {
+	Services []ServiceCapability `json:"services"`
+}
+
+type stateKey struct {
+	account string
+	service string
+}
+
+type serviceState struct {
+	failures      int
+	reason        string
+	degradedSince time.Time
+	degradedUntil time.Time
+}
+
+// Tracker records API availability per account and service.
+type Tracker struct {
+	catalog   map[string]string
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu    sync.Mutex
+	state map[stateKey]*serviceState
+}
+
+// NewTracker creates a tracker for the tools in catalog, which maps each tool name to its service.
+// The catalog may be nil and set once the tools are registered.
+func NewTracker(catalog map[string]string) *Tracker {
+	return &Tracker{
+		catalog:   catalog,
+		threshold: DefaultThreshold,
+		cooldown:  DefaultCooldown,
+		now:       time.Now,
+		state:     map[stateKey]*serviceState{},
+	}
+}
+
+// SetCatalog replaces the tool-to-service mapping.
+func (t *Tracker) SetCatalog(catalog map[string]string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.catalog = catalog
+}
+
+type observationKey struct{}
+
+// observation collects what the API answered during one tool call.
+type observation struct {
+	mu          sync.Mutex
+	success     bool
+	unavailable bool
+	reason      string
+}
+
+// transport reports API responses to the observation of the tool call that made the request.
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps base so API responses are attributed to the service of the calling tool.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	obs, ok := req.Context().Value(observationKey{}).(*observation)
+	if !ok || err != nil {
+		return resp, err
+	}
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		obs.mu.Lock()
+		obs.success = true
+		obs.mu.Unlock()
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden:
+		if reason, unavailable := unavailableReason(req, resp); unavailable {
+			obs.mu.Lock()
+			obs.unavailable = true
+			obs.reason = reason
+			obs.mu.Unlock()
+		}
+	}
+	return resp, nil
+}
+
+// unavailableReason decides whether a 403 or 404 response means the service is off for the
+// account, rather than a single resource being missing. The body is restored for the caller.
+func unavailableReason(req *http.Request, resp *http.Response) (string, bool) {
+	var message string
+	if resp.Body != nil {
+		data, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if err == nil {
+			var body struct {
+				Message string `json:"message"`
+			}
+			if json.Unmarshal(data, &body) == nil {
+				message = body.Message
+			}
+		}
+	}
+
+	lower := strings.ToLower(message)
+	for _, fragment := range unavailableMessages {
+		if strings.Contains(lower, fragment) {
+			return message, true
+		}
+	}
+	if resp.StatusCode == http.StatusNotFound && req.Method == http.MethodGet && isCollectionPath(req.URL.Path) {
+		return fmt.Sprintf("%s %s returned 404 Not Found", req.Method, req.URL.Path), true
+	}
+	return "", false
+}
+
+// isCollectionPath reports whether path names a top-level collection such as /v2/uptime/checks.
+// A path with a resource ID, slug or domain name in any segment, which all contain digits or
+// dots, is not one: a 404 of /v2/droplets/123/actions means the droplet is missing.
+func isCollectionPath(path string) bool {
+	segments := strings.Split(strings.TrimPrefix(strings.Trim(path, "/"), "v2/"), "/")
+	for _, segment := range segments {
+		if segment == "" || strings.ContainsAny(segment, "0123456789.") {
+			return false
+		}
+	}
+	return true
+}
+
+// accountKey identifies the caller's account by a hash of its credentials. It is empty for stdio,
+// where a single token is used.
+func accountKey(ctx context.Context) string {
+	auth, _ := ctx.Value(middleware.AuthKey{}).(string)
+	if auth == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(auth))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Middleware short-circuits the tools of degraded services and records the API responses of every other call.
+func (t *Tracker) Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		t.mu.Lock()
+		svc, ok := t.catalog[req.Params.Name]
+		if !ok {
+			t.mu.Unlock()
+			return next(ctx, req)
+		}
+		key := stateKey{account: accountKey(ctx), service: svc}
+		st := t.state[key]
+		if st != nil && t.now().Before(st.degradedUntil) {
+			msg := fmt.Sprintf("The %s service is unavailable for this account (%s), so %s was not called. It will be retried after %s. Use %s to see which services are available.",
+				svc, st.reason, req.Params.Name, st.degradedUntil.UTC().Format(time.RFC3339), ToolName)
+			t.mu.Unlock()
+			return mcp.NewToolResultError(msg), nil
+		}
+		t.mu.Unlock()
+
+		obs := &observation{}
+		res, err := next(context.WithValue(ctx, observationKey{}, obs), req)
+		t.record(key, obs)
+		return res, err
+	}
+}
+
+// record updates the state of a service from the responses seen during one tool call.
+func (t *Tracker) record(key stateKey, obs *observation) {
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch {
+	case obs.success:
+		delete(t.state, key)
+	case obs.unavailable:
+		st := t.state[key]
+		if st == nil {
+			st = &serviceState{}
+			t.state[key] = st
+		}
+		st.failures++
+		st.reason = obs.reason
+		if st.failures >= t.threshold {
+			now := t.now()
+			if st.degradedSince.IsZero() {
+				st.degradedSince = now
+			}
+			st.degradedUntil = now.Add(t.cooldown)
+		}
+	}
+}
+
+// capabilities handles the do-capabilities tool.
+func (t *Tracker) capabilities(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tools := map[string]int{}
+	for _, svc := range t.catalog {
+		tools[svc]++
+	}
+	services := make([]string, 0, len(tools))
+	for svc := range tools {
+		services = append(services, svc)
+	}
+	sort.Strings(services)
+
+	account := accountKey(ctx)
+	result := Capabilities{Services: make([]ServiceCapability, 0, len(services))}
+	for _, svc := range services {
+		c := ServiceCapability{Service: svc, Status: StatusAvailable, Tools: tools[svc]}
+		if st := t.state[stateKey{account: account, service: svc}]; st != nil && !st.degradedSince.IsZero() {
+			c.Status = StatusDegraded
+			c.Reason = st.reason
+			c.DegradedSince = st.degradedSince.UTC().Format(time.RFC3339)
+			c.RetryAfter = st.degradedUntil.UTC().Format(time.RFC3339)
+		}
+		result.Services = append(result.Services, c)
+	}
+
+	jsonResult, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonResult)), nil
+}
+
+// Tools returns the do-capabilities tool.
+func (t *Tracker) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: t.capabilities,
+			Tool: mcp.NewTool(ToolName,
+				mcp.WithDescription("List the services exposed by this server with their number of tools, and whether each service is available or degraded for this account. Tools of a degraded service return an error without calling the API until the retry time."),
+			),
+		},
+	}
+}
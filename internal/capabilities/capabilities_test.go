@@ -0,0 +1,181 @@
+package capabilities
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	middleware "mcp-digitalocean/internal"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// apiHandler returns a tool handler that issues a GET for path against the test server.
+func apiHandler(t *testing.T, client *http.Client, baseURL, path string, calls *int) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		*calls++
+		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(httpReq)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return mcp.NewToolResultError(resp.Status), nil
+		}
+		return mcp.NewToolResultText("ok"), nil
+	}
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/uptime/checks":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"id":"not_found","message":"The resource you were accessing could not be found."}`))
+		case "/v2/droplets/123", "/v2/droplets/123/actions", "/v2/domains/typo.com/records":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"id":"not_found","message":"The resource you were accessing could not be found."}`))
+		case "/v2/gen-ai/agents":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"id":"forbidden","message":"GenAI is not enabled for this team"}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func callTool(t *testing.T, handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), ctx context.Context, name string) *mcp.CallToolResult {
+	t.Helper()
+	res, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Name: name}})
+	if err != nil {
+		t.Fatalf("%s returned error: %v", name, err)
+	}
+	return res
+}
+
+func TestTracker_degradesAfterConsecutiveNotFound(t *testing.T) {
+	srv := newTestServer(t)
+	client := &http.Client{Transport: NewTransport(nil)}
+	tracker := NewTracker(map[string]string{"uptimecheck-list": "networking", "droplet-get": "droplets"})
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker.now = func() time.Time { return now }
+
+	calls := 0
+	handler := tracker.Middleware(apiHandler(t, client, srv.URL, "/v2/uptime/checks", &calls))
+	for range DefaultThreshold {
+		callTool(t, handler, context.Background(), "uptimecheck-list")
+	}
+	res := callTool(t, handler, context.Background(), "uptimecheck-list")
+	if calls != DefaultThreshold {
+		t.Fatalf("expected the degraded service to be short-circuited, got %d API calls", calls)
+	}
+	if !res.IsError || !strings.Contains(res.Content[0].(mcp.TextContent).Text, "networking service is unavailable") {
+		t.Fatalf("unexpected short-circuit result: %+v", res)
+	}
+
+	caps := callTool(t, tracker.capabilities, context.Background(), ToolName)
+	var result Capabilities
+	if err := json.Unmarshal([]byte(caps.Content[0].(mcp.TextContent).Text), &result); err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Services) != 2 || result.Services[1].Service != "networking" || result.Services[1].Status != StatusDegraded {
+		t.Fatalf("unexpected capabilities: %+v", result)
+	}
+	if result.Services[0].Status != StatusAvailable {
+		t.Fatalf("droplets should be available: %+v", result.Services[0])
+	}
+
+	// After the cooldown the tool is tried again.
+	now = now.Add(DefaultCooldown + time.Second)
+	callTool(t, handler, context.Background(), "uptimecheck-list")
+	if calls != DefaultThreshold+1 {
+		t.Fatalf("expected a retry after the cooldown, got %d API calls", calls)
+	}
+}
+
+func TestTracker_missingResourceDoesNotDegrade(t *testing.T) {
+	srv := newTestServer(t)
+	client := &http.Client{Transport: NewTransport(nil)}
+	tracker := NewTracker(map[string]string{"droplet-get": "droplets"})
+
+	calls := 0
+	handler := tracker.Middleware(apiHandler(t, client, srv.URL, "/v2/droplets/123", &calls))
+	for range DefaultThreshold + 1 {
+		callTool(t, handler, context.Background(), "droplet-get")
+	}
+	if calls != DefaultThreshold+1 {
+		t.Fatalf("a missing droplet must not degrade the service, got %d API calls", calls)
+	}
+}
+
+func TestTracker_missingParentDoesNotDegrade(t *testing.T) {
+	srv := newTestServer(t)
+	client := &http.Client{Transport: NewTransport(nil)}
+
+	for name, path := range map[string]string{
+		"droplet-actions": "/v2/droplets/123/actions",
+		"domain-records":  "/v2/domains/typo.com/records",
+	} {
+		tracker := NewTracker(map[string]string{name: "droplets"})
+		calls := 0
+		handler := tracker.Middleware(apiHandler(t, client, srv.URL, path, &calls))
+		for range DefaultThreshold + 1 {
+			callTool(t, handler, context.Background(), name)
+		}
+		if calls != DefaultThreshold+1 {
+			t.Fatalf("a 404 of %s must not degrade the service, got %d API calls", path, calls)
+		}
+	}
+}
+
+func TestTracker_notEnabledIsPerAccount(t *testing.T) {
+	srv := newTestServer(t)
+	client := &http.Client{Transport: NewTransport(nil)}
+	tracker := NewTracker(map[string]string{"genai-list-agents": "genai"})
+
+	calls := 0
+	handler := tracker.Middleware(apiHandler(t, client, srv.URL, "/v2/gen-ai/agents", &calls))
+	ctxA := middleware.WithAuthKey(context.Background(), "Bearer a")
+	ctxB := middleware.WithAuthKey(context.Background(), "Bearer b")
+	for range DefaultThreshold {
+		callTool(t, handler, ctxA, "genai-list-agents")
+	}
+	callTool(t, handler, ctxA, "genai-list-agents")
+	if calls != DefaultThreshold {
+		t.Fatalf("expected account A to be short-circuited, got %d API calls", calls)
+	}
+	callTool(t, handler, ctxB, "genai-list-agents")
+	if calls != DefaultThreshold+1 {
+		t.Fatal("account B must not inherit account A's degraded state")
+	}
+}
+
+func TestTracker_successResets(t *testing.T) {
+	srv := newTestServer(t)
+	client := &http.Client{Transport: NewTransport(nil)}
+	tracker := NewTracker(map[string]string{"uptimecheck-list": "networking", "vpc-list": "networking"})
+
+	calls := 0
+	failing := tracker.Middleware(apiHandler(t, client, srv.URL, "/v2/uptime/checks", &calls))
+	working := tracker.Middleware(apiHandler(t, client, srv.URL, "/v2/vpcs", &calls))
+	for range DefaultThreshold - 1 {
+		callTool(t, failing, context.Background(), "uptimecheck-list")
+	}
+	callTool(t, working, context.Background(), "vpc-list")
+	callTool(t, failing, context.Background(), "uptimecheck-list")
+	callTool(t, failing, context.Background(), "uptimecheck-list")
+	if calls != DefaultThreshold+2 {
+		t.Fatalf("a success should reset the failure count, got %d API calls", calls)
+	}
+}
@@ -0,0 +1,194 @@
+// Package clientlog sends server log records to the MCP client that caused them, as
+// notifications/message at the level the client chose with logging/setLevel, so warnings such as
+// rate limiting, retries or a rejected token show up in the conversation and not only in the
+// server's logs.
+package clientlog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// LoggerName is the logger the notifications are sent as.
+	LoggerName = "mcp-digitalocean"
+	// lowRateLimitPercent is the share of the hourly API rate limit below which a tool call warns
+	// that few requests are left.
+	lowRateLimitPercent = 5
+)
+
+// Handler passes records to a base handler and, when the record's context belongs to an MCP
+// session that asked for log messages, to the client of the session.
+type Handler struct {
+	base   slog.Handler
+	server *atomic.Pointer[server.MCPServer]
+	attrs  []slog.Attr
+	group  string
+}
+
+// NewHandler wraps base. Records are only sent to clients once SetServer is called.
+func NewHandler(base slog.Handler) *Handler {
+	return &Handler{base: base, server: &atomic.Pointer[server.MCPServer]{}}
+}
+
+// SetServer sets the server that sends the notifications.
+func (h *Handler) SetServer(s *server.MCPServer) {
+	h.server.Store(s)
+}
+
+// session returns the session of ctx when it accepts records of level.
+func session(ctx context.Context, level slog.Level) (server.SessionWithLogging, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	s, ok := server.ClientSessionFromContext(ctx).(server.SessionWithLogging)
+	if !ok || !s.Initialized() {
+		return nil, false
+	}
+	return s, mcpLevel(level).ShouldSendTo(s.GetLogLevel())
+}
+
+// Enabled implements slog.Handler. A client may ask for levels the base handler drops.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	if h.base.Enabled(ctx, level) {
+		return true
+	}
+	_, ok := session(ctx, level)
+	return ok && h.server.Load() != nil
+}
+
+// Handle implements slog.Handler.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	var err error
+	if h.base.Enabled(ctx, r.Level) {
+		err = h.base.Handle(ctx, r)
+	}
+	srv := h.server.Load()
+	if _, ok := session(ctx, r.Level); !ok || srv == nil {
+		return err
+	}
+
+	data := map[string]any{"message": r.Message}
+	for _, a := range h.attrs {
+		data[a.Key] = a.Value.Resolve().Any()
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		data[h.group+a.Key] = a.Value.Resolve().Any()
+		return true
+	})
+	// a client that went away doesn't fail the record.
+	_ = srv.SendLogMessageToClient(ctx, mcp.NewLoggingMessageNotification(mcpLevel(r.Level), LoggerName, data))
+	return err
+}
+
+// WithAttrs implements slog.Handler.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.base = h.base.WithAttrs(attrs)
+	clone.attrs = append([]slog.Attr{}, h.attrs...)
+	for _, a := range attrs {
+		clone.attrs = append(clone.attrs, slog.Attr{Key: h.group + a.Key, Value: a.Value})
+	}
+	return &clone
+}
+
+// WithGroup implements slog.Handler. The attributes sent to clients are flattened to dotted keys.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	clone := *h
+	clone.base = h.base.WithGroup(name)
+	clone.group = h.group + name + "."
+	return &clone
+}
+
+// mcpLevel maps a slog level to the MCP logging level of the same severity.
+func mcpLevel(level slog.Level) mcp.LoggingLevel {
+	switch {
+	case level >= slog.LevelError:
+		return mcp.LoggingLevelError
+	case level >= slog.LevelWarn:
+		return mcp.LoggingLevelWarning
+	case level >= slog.LevelInfo:
+		return mcp.LoggingLevelInfo
+	default:
+		return mcp.LoggingLevelDebug
+	}
+}
+
+type callKey struct{}
+
+// call is the logger of one tool call, and whether it already warned about the rate limit.
+type call struct {
+	logger *slog.Logger
+
+	mu         sync.Mutex
+	warnedRate bool
+}
+
+// Middleware makes logger the logger of the API requests of each tool call. The call's context
+// carries its session, so the records of its requests reach its client.
+func Middleware(logger *slog.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return next(context.WithValue(ctx, callKey{}, &call{logger: logger.With("tool", req.Params.Name)}), req)
+		}
+	}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps base so API responses that slow a tool call down or make it fail are logged
+// to the logger of the call: rate limiting, server errors that are retried, a rejected token and
+// a nearly used up rate limit. It sits below the retry layer, so each retried attempt is logged.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	c, ok := req.Context().Value(callKey{}).(*call)
+	if !ok || err != nil {
+		return resp, err
+	}
+	ctx := req.Context()
+	endpoint := req.Method + " " + req.URL.Path
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		c.logger.WarnContext(ctx, "DigitalOcean API rate limit exceeded, retrying with backoff",
+			"request", endpoint, "reset", resp.Header.Get("Ratelimit-Reset"))
+	case resp.StatusCode >= http.StatusInternalServerError:
+		c.logger.WarnContext(ctx, fmt.Sprintf("DigitalOcean API answered %d, retrying with backoff", resp.StatusCode),
+			"request", endpoint, "request_id", resp.Header.Get("X-Request-Id"))
+	case resp.StatusCode == http.StatusUnauthorized:
+		c.logger.ErrorContext(ctx, "DigitalOcean API rejected the token: it may have expired or been revoked",
+			"request", endpoint)
+	}
+
+	limit, limitErr := strconv.Atoi(resp.Header.Get("Ratelimit-Limit"))
+	remaining, remainingErr := strconv.Atoi(resp.Header.Get("Ratelimit-Remaining"))
+	if limitErr == nil && remainingErr == nil && limit > 0 && remaining*100 < limit*lowRateLimitPercent {
+		c.mu.Lock()
+		warn := !c.warnedRate
+		c.warnedRate = true
+		c.mu.Unlock()
+		if warn {
+			c.logger.WarnContext(ctx, fmt.Sprintf("only %d of %d DigitalOcean API requests are left in the current rate limit window", remaining, limit),
+				"reset", resp.Header.Get("Ratelimit-Reset"))
+		}
+	}
+	return resp, nil
+}
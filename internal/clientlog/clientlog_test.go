@@ -0,0 +1,122 @@
+package clientlog
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+type testSession struct {
+	notifications chan mcp.JSONRPCNotification
+	level         mcp.LoggingLevel
+}
+
+func (s *testSession) SessionID() string                                   { return "session-1" }
+func (s *testSession) NotificationChannel() chan<- mcp.JSONRPCNotification { return s.notifications }
+func (s *testSession) Initialize()                                         {}
+func (s *testSession) Initialized() bool                                   { return true }
+func (s *testSession) SetLogLevel(level mcp.LoggingLevel)                  { s.level = level }
+func (s *testSession) GetLogLevel() mcp.LoggingLevel                       { return s.level }
+
+// setup returns a logger writing info and above to buf and to the client of the returned context.
+func setup(t *testing.T, level mcp.LoggingLevel) (*slog.Logger, *bytes.Buffer, context.Context, *testSession) {
+	t.Helper()
+	srv := server.NewMCPServer("test", "0.0.0", server.WithLogging())
+	session := &testSession{notifications: make(chan mcp.JSONRPCNotification, 10), level: level}
+	if err := srv.RegisterSession(context.Background(), session); err != nil {
+		t.Fatalf("RegisterSession() error = %v", err)
+	}
+	var buf bytes.Buffer
+	handler := NewHandler(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	handler.SetServer(srv)
+	return slog.New(handler), &buf, srv.WithContext(context.Background(), session), session
+}
+
+func received(session *testSession) []mcp.JSONRPCNotification {
+	var out []mcp.JSONRPCNotification
+	for {
+		select {
+		case n := <-session.notifications:
+			out = append(out, n)
+		default:
+			return out
+		}
+	}
+}
+
+func TestHandler_sendsRecordsAtTheClientsLevel(t *testing.T) {
+	logger, buf, ctx, session := setup(t, mcp.LoggingLevelDebug)
+
+	logger.With("tool", "droplet-list").DebugContext(ctx, "listing droplets", "page", 2)
+	logger.Info("not in a session")
+
+	notifications := received(session)
+	if len(notifications) != 1 {
+		t.Fatalf("sent %d notifications, want 1", len(notifications))
+	}
+	params := notifications[0].Params.AdditionalFields
+	if params["level"] != mcp.LoggingLevelDebug || params["logger"] != LoggerName {
+		t.Fatalf("params = %v", params)
+	}
+	data := params["data"].(map[string]any)
+	if data["message"] != "listing droplets" || data["tool"] != "droplet-list" || data["page"] != int64(2) {
+		t.Fatalf("data = %v", data)
+	}
+	// the base handler keeps its own level.
+	if strings.Contains(buf.String(), "listing droplets") || !strings.Contains(buf.String(), "not in a session") {
+		t.Fatalf("base handler output = %q", buf.String())
+	}
+
+	session.SetLogLevel(mcp.LoggingLevelError)
+	logger.WarnContext(ctx, "below the client's level")
+	if n := received(session); len(n) != 0 {
+		t.Fatalf("sent %d notifications below the client's level", len(n))
+	}
+}
+
+func TestTransport_warnsAboutRateLimitingAndRejectedTokens(t *testing.T) {
+	logger, _, ctx, session := setup(t, mcp.LoggingLevelWarning)
+	status := http.StatusTooManyRequests
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Ratelimit-Limit", "5000")
+		w.Header().Set("Ratelimit-Remaining", "12")
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+	client := &http.Client{Transport: NewTransport(nil)}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "droplet-list"
+	_, _ = Middleware(logger)(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		for _, s := range []int{http.StatusTooManyRequests, http.StatusUnauthorized} {
+			status = s
+			apiReq, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/v2/droplets", nil)
+			resp, err := client.Do(apiReq)
+			if err != nil {
+				t.Fatalf("GET error = %v", err)
+			}
+			resp.Body.Close()
+		}
+		return mcp.NewToolResultText("[]"), nil
+	})(ctx, req)
+
+	var messages []string
+	for _, n := range received(session) {
+		messages = append(messages, n.Params.AdditionalFields["data"].(map[string]any)["message"].(string))
+	}
+	want := []string{
+		"DigitalOcean API rate limit exceeded, retrying with backoff",
+		"only 12 of 5000 DigitalOcean API requests are left in the current rate limit window",
+		"DigitalOcean API rejected the token: it may have expired or been revoked",
+	}
+	if strings.Join(messages, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("messages = %q, want %q", messages, want)
+	}
+}
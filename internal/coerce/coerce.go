@@ -0,0 +1,128 @@
+// Package coerce accepts numeric strings for the number arguments of every tool.
+//
+// Models often pass an ID as "12345" instead of 12345, and handlers reading the argument as a
+// number then reject the whole call. Apply wraps each registered tool so arguments its input
+// schema declares as numbers, or arrays of numbers, are converted from numeric strings before
+// the handler sees them. Strings that are not numbers are passed on for the handler to reject.
+package coerce
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// kind is how the value of a number argument is coerced.
+type kind int
+
+const (
+	scalar kind = iota
+	array
+)
+
+// isNumber reports whether a JSON schema type is numeric.
+func isNumber(schema map[string]any) bool {
+	t, _ := schema["type"].(string)
+	return t == "number" || t == "integer"
+}
+
+// numberArgs returns the top-level number and number-array properties of the tool's input schema.
+func numberArgs(tool mcp.Tool) map[string]kind {
+	props := tool.InputSchema.Properties
+	if tool.RawInputSchema != nil {
+		var raw struct {
+			Properties map[string]any `json:"properties"`
+		}
+		if json.Unmarshal(tool.RawInputSchema, &raw) != nil {
+			return nil
+		}
+		props = raw.Properties
+	}
+
+	args := map[string]kind{}
+	for name, p := range props {
+		schema, ok := p.(map[string]any)
+		if !ok {
+			continue
+		}
+		if isNumber(schema) {
+			args[name] = scalar
+			continue
+		}
+		if items, ok := schema["items"].(map[string]any); ok && schema["type"] == "array" && isNumber(items) {
+			args[name] = array
+		}
+	}
+	return args
+}
+
+// Number converts a numeric string to a float64 and returns any other value unchanged.
+func Number(v any) any {
+	s, ok := v.(string)
+	if !ok {
+		return v
+	}
+	n, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return v
+	}
+	return n
+}
+
+// wrap returns a handler that coerces the number arguments of each call before calling next.
+func wrap(args map[string]kind, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		values := req.GetArguments()
+		var coerced map[string]any
+		for name, k := range args {
+			v, ok := values[name]
+			if !ok {
+				continue
+			}
+			var nv any
+			switch k {
+			case scalar:
+				if _, isString := v.(string); isString {
+					nv = Number(v)
+				}
+			case array:
+				if list, isList := v.([]any); isList {
+					converted := make([]any, len(list))
+					for i, item := range list {
+						converted[i] = Number(item)
+					}
+					nv = converted
+				}
+			}
+			if nv == nil {
+				continue
+			}
+			if coerced == nil {
+				coerced = maps.Clone(values)
+			}
+			coerced[name] = nv
+		}
+		if coerced != nil {
+			req.Params.Arguments = coerced
+		}
+		return next(ctx, req)
+	}
+}
+
+// Apply wraps the handler of every tool registered with s that has number arguments.
+func Apply(s *server.MCPServer) {
+	var updated []server.ServerTool
+	for _, st := range s.ListTools() {
+		args := numberArgs(st.Tool)
+		if len(args) == 0 {
+			continue
+		}
+		updated = append(updated, server.ServerTool{Tool: st.Tool, Handler: wrap(args, st.Handler)})
+	}
+	s.AddTools(updated...)
+}
@@ -0,0 +1,78 @@
+package coerce
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func TestApply(t *testing.T) {
+	var got map[string]any
+	s := server.NewMCPServer("test", "1.0.0")
+	s.AddTool(mcp.NewTool("droplet-get",
+		mcp.WithNumber("ID", mcp.Required()),
+		mcp.WithString("Name"),
+		mcp.WithArray("DropletIDs", mcp.Items(map[string]any{"type": "number"})),
+	), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		got = req.GetArguments()
+		return mcp.NewToolResultText("ok"), nil
+	})
+	Apply(s)
+
+	tests := []struct {
+		name string
+		args map[string]any
+		want map[string]any
+	}{
+		{
+			name: "Numeric strings",
+			args: map[string]any{"ID": "12345", "Name": "42", "DropletIDs": []any{"1", float64(2), " 3 "}},
+			want: map[string]any{"ID": float64(12345), "Name": "42", "DropletIDs": []any{float64(1), float64(2), float64(3)}},
+		},
+		{
+			name: "Numbers unchanged",
+			args: map[string]any{"ID": float64(7)},
+			want: map[string]any{"ID": float64(7)},
+		},
+		{
+			name: "Non-numeric string left for the handler",
+			args: map[string]any{"ID": "web-1"},
+			want: map[string]any{"ID": "web-1"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			st := s.GetTool("droplet-get")
+			if st == nil {
+				t.Fatal("tool not registered")
+			}
+			if _, err := st.Handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("arguments = %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestApply_rawSchema(t *testing.T) {
+	var got any
+	s := server.NewMCPServer("test", "1.0.0")
+	s.AddTool(mcp.NewToolWithRawSchema("size-get", "", []byte(`{"type":"object","properties":{"Count":{"type":"integer"}}}`)),
+		func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			got = req.GetArguments()["Count"]
+			return mcp.NewToolResultText("ok"), nil
+		})
+	Apply(s)
+
+	if _, err := s.GetTool("size-get").Handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Count": "3"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if got != float64(3) {
+		t.Fatalf("Count = %#v, want 3", got)
+	}
+}
@@ -0,0 +1,300 @@
+// Package confirm implements the opt-in destructive-operation confirmation mode.
+//
+// When enabled, tools that delete, destroy, rebuild, restore, purge, prune or clean up
+// resources, and firewall-sync, only run when called with Confirm: true or with a
+// ConfirmationToken issued by the confirm-destructive tool for the exact same tool name and
+// arguments. Any other call returns a preview of what would be executed instead of running the
+// tool. Dry runs are let through since they never send a mutating request.
+package confirm
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"mcp-digitalocean/internal/dryrun"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// ToolName is the name of the tool that issues confirmation tokens.
+	ToolName = "confirm-destructive"
+	// ConfirmArg is the boolean argument that confirms a destructive call.
+	ConfirmArg = "Confirm"
+	// TokenArg is the argument carrying a token issued by the confirm-destructive tool.
+	TokenArg = "ConfirmationToken"
+	// DefaultTokenTTL is how long an issued confirmation token stays valid.
+	DefaultTokenTTL = 5 * time.Minute
+)
+
+// destructiveVerbs are the tool name segments that mark a tool as destructive.
+var destructiveVerbs = []string{"delete", "destroy", "rebuild", "restore", "purge", "prune", "cleanup"}
+
+// destructiveTools are the tools whose names say otherwise: firewall-sync removes the rules
+// missing from the rule sets it is passed, and db-restore-from-backup restores into a new cluster, leaving the
+// backed-up one alone.
+var destructiveTools = map[string]bool{
+	"firewall-sync":          true,
+	"db-restore-from-backup": false,
+}
+
+// IsDestructive reports whether the tool is one of destructiveTools that is destructive, or else
+// whether its name contains a destructive verb as one of its dash-separated segments.
+func IsDestructive(name string) bool {
+	if destructive, ok := destructiveTools[name]; ok {
+		return destructive
+	}
+	for _, segment := range strings.Split(name, "-") {
+		if slices.Contains(destructiveVerbs, segment) {
+			return true
+		}
+	}
+	return false
+}
+
+// Preview is returned instead of running a destructive tool that was not confirmed.
+type Preview struct {
+	Executed    bool           `json:"executed"`
+	Tool        string         `json:"tool"`
+	Description string         `json:"description,omitempty"`
+	Arguments   map[string]any `json:"arguments"`
+	Message     string         `json:"message"`
+}
+
+// Guard holds the signing key for confirmation tokens, the descriptions of the guarded tools and
+// which of them declare a Confirm argument of their own.
+type Guard struct {
+	key []byte
+	ttl time.Duration
+	now func() time.Time
+
+	mu           sync.RWMutex
+	descriptions map[string]string
+	// ownConfirm are the guarded tools that read Confirm themselves, like cleanup-by-tag, which
+	// only lists what it would delete without it. A confirmed call passes them Confirm: true.
+	ownConfirm map[string]bool
+}
+
+// NewGuard creates a guard whose confirmation tokens are valid for ttl.
+func NewGuard(ttl time.Duration) (*Guard, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate confirmation key: %w", err)
+	}
+	if ttl <= 0 {
+		ttl = DefaultTokenTTL
+	}
+	return &Guard{
+		key:          key,
+		ttl:          ttl,
+		now:          time.Now,
+		descriptions: map[string]string{},
+		ownConfirm:   map[string]bool{},
+	}, nil
+}
+
+// Apply advertises the Confirm and ConfirmationToken arguments on every destructive tool
+// registered with s. The confirm-destructive tool of Tools is registered with the other tools,
+// so the tool filter applies to it too.
+func (g *Guard) Apply(s *server.MCPServer) {
+	var guarded []server.ServerTool
+	for name, st := range s.ListTools() {
+		if !IsDestructive(name) {
+			continue
+		}
+		tool := st.Tool
+		_, own := tool.InputSchema.Properties[ConfirmArg]
+		g.mu.Lock()
+		g.descriptions[name] = tool.Description
+		g.ownConfirm[name] = own
+		g.mu.Unlock()
+
+		description := strings.TrimRight(tool.Description, " \n")
+		if description != "" && !strings.HasSuffix(description, ".") {
+			description += "."
+		}
+		tool.Description = strings.TrimLeft(description+" Requires Confirm: true, or a ConfirmationToken from the confirm-destructive tool; otherwise a preview is returned and nothing is changed.", " ")
+		if tool.RawInputSchema == nil {
+			props := maps.Clone(tool.InputSchema.Properties)
+			if props == nil {
+				props = map[string]any{}
+			}
+			props[ConfirmArg] = map[string]any{
+				"type":        "boolean",
+				"description": "Set to true to confirm this destructive operation",
+			}
+			props[TokenArg] = map[string]any{
+				"type":        "string",
+				"description": "Confirmation token issued by the confirm-destructive tool for this exact call",
+			}
+			tool.InputSchema.Properties = props
+		}
+		guarded = append(guarded, server.ServerTool{Tool: tool, Handler: st.Handler})
+	}
+	s.AddTools(guarded...)
+}
+
+// Middleware blocks unconfirmed calls to destructive tools and returns a preview instead.
+func (g *Guard) Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		name := req.Params.Name
+		if !IsDestructive(name) || dryrun.Active(ctx) {
+			return next(ctx, req)
+		}
+
+		args := req.GetArguments()
+		confirmed, _ := args[ConfirmArg].(bool)
+		token, _ := args[TokenArg].(string)
+		callArgs := withoutConfirmation(args)
+
+		if !confirmed && token != "" {
+			if err := g.verify(token, name, callArgs); err != nil {
+				return mcp.NewToolResultErrorFromErr("invalid confirmation token", err), nil
+			}
+			confirmed = true
+		}
+		g.mu.RLock()
+		description, own := g.descriptions[name], g.ownConfirm[name]
+		g.mu.RUnlock()
+		if confirmed {
+			if own {
+				callArgs[ConfirmArg] = true
+			}
+			req.Params.Arguments = callArgs
+			return next(ctx, req)
+		}
+
+		preview := Preview{
+			Tool:        name,
+			Description: description,
+			Arguments:   callArgs,
+			Message:     fmt.Sprintf("%s is destructive and was not executed. Review the arguments, then call it again with %s: true, or with the %s issued by %s.", name, ConfirmArg, TokenArg, ToolName),
+		}
+		jsonPreview, err := json.MarshalIndent(preview, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: %w", err)
+		}
+		return mcp.NewToolResultStructured(preview, string(jsonPreview)), nil
+	}
+}
+
+// issueToken handles the confirm-destructive tool.
+func (g *Guard) issueToken(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	name, _ := args["ToolName"].(string)
+	if name == "" {
+		return mcp.NewToolResultError("ToolName is required"), nil
+	}
+	if !IsDestructive(name) {
+		return mcp.NewToolResultError(fmt.Sprintf("%s is not a destructive tool and needs no confirmation", name)), nil
+	}
+	callArgs, _ := args["Arguments"].(map[string]any)
+	callArgs = withoutConfirmation(callArgs)
+
+	expires := g.now().Add(g.ttl)
+	token, err := g.sign(name, callArgs, expires)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{
+		"tool":       name,
+		"arguments":  callArgs,
+		"token":      token,
+		"expires_at": expires.UTC().Format(time.RFC3339),
+	}
+	jsonResult, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonResult)), nil
+}
+
+// sign returns a token of the form "<unix expiry>.<hex mac>" bound to the tool name and arguments.
+func (g *Guard) sign(name string, args map[string]any, expires time.Time) (string, error) {
+	mac, err := g.mac(name, args, expires.Unix())
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(expires.Unix(), 10) + "." + hex.EncodeToString(mac), nil
+}
+
+func (g *Guard) verify(token, name string, args map[string]any) error {
+	expiry, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return errors.New("malformed token")
+	}
+	unix, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return errors.New("malformed token")
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return errors.New("malformed token")
+	}
+	want, err := g.mac(name, args, unix)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(got, want) {
+		return errors.New("token does not match this tool and arguments")
+	}
+	if g.now().Unix() > unix {
+		return errors.New("token expired")
+	}
+	return nil
+}
+
+func (g *Guard) mac(name string, args map[string]any, expires int64) ([]byte, error) {
+	// encoding/json sorts map keys, which makes the encoding canonical.
+	jsonArgs, err := json.Marshal(args)
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	h := hmac.New(sha256.New, g.key)
+	fmt.Fprintf(h, "%s\n%d\n%s", name, expires, jsonArgs)
+	return h.Sum(nil), nil
+}
+
+// withoutConfirmation returns a copy of args without the confirmation arguments.
+func withoutConfirmation(args map[string]any) map[string]any {
+	out := make(map[string]any, len(args))
+	for k, v := range args {
+		if k == ConfirmArg || k == TokenArg {
+			continue
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// Tools returns the confirm-destructive tool.
+func (g *Guard) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: g.issueToken,
+			Tool: mcp.NewTool(ToolName,
+				mcp.WithDescription(fmt.Sprintf("Issue a short-lived confirmation token for one destructive tool call. Pass the returned token as %s with exactly the same arguments to run the call.", TokenArg)),
+				mcp.WithString("ToolName", mcp.Required(), mcp.Description("Name of the destructive tool to confirm, e.g. droplet-delete")),
+				mcp.WithObject("Arguments", mcp.Description("Arguments the destructive tool will be called with")),
+				mcp.WithReadOnlyHintAnnotation(true),
+				mcp.WithDestructiveHintAnnotation(false),
+				mcp.WithIdempotentHintAnnotation(true),
+				mcp.WithOpenWorldHintAnnotation(false),
+			),
+		},
+	}
+}
@@ -0,0 +1,179 @@
+package confirm
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func callRequest(name string, args map[string]any) mcp.CallToolRequest {
+	return mcp.CallToolRequest{Params: mcp.CallToolParams{Name: name, Arguments: args}}
+}
+
+func resultText(t *testing.T, res *mcp.CallToolResult) string {
+	t.Helper()
+	if res == nil || len(res.Content) == 0 {
+		t.Fatal("expected a result with content")
+	}
+	return res.Content[0].(mcp.TextContent).Text
+}
+
+func newTestGuard(t *testing.T) *Guard {
+	t.Helper()
+	g, err := NewGuard(time.Minute)
+	if err != nil {
+		t.Fatalf("NewGuard() error = %v", err)
+	}
+	return g
+}
+
+func TestIsDestructive(t *testing.T) {
+	tests := map[string]bool{
+		"droplet-delete":          true,
+		"doks-delete-cluster":     true,
+		"rebuild-droplet-by-slug": true,
+		"restore-droplet":         true,
+		"snapshot-prune":          true,
+		"session-cleanup":         true,
+		"firewall-sync":           true,
+		"db-restore-from-backup":  false,
+		"droplet-get":             false,
+		"undeleted-thing":         false,
+	}
+	for name, want := range tests {
+		if got := IsDestructive(name); got != want {
+			t.Errorf("IsDestructive(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	g := newTestGuard(t)
+	calls := new(int)
+	var gotArgs map[string]any
+	handler := g.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		*calls++
+		gotArgs = req.GetArguments()
+		return mcp.NewToolResultText("done"), nil
+	})
+
+	// Non-destructive tools pass straight through.
+	if _, err := handler(context.Background(), callRequest("droplet-get", map[string]any{"ID": float64(1)})); err != nil || *calls != 1 {
+		t.Fatalf("non-destructive call: err = %v, calls = %d", err, *calls)
+	}
+
+	// Unconfirmed destructive calls return a preview.
+	res, err := handler(context.Background(), callRequest("droplet-delete", map[string]any{"ID": float64(1)}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *calls != 1 {
+		t.Fatal("destructive tool ran without confirmation")
+	}
+	var preview Preview
+	if err := json.Unmarshal([]byte(resultText(t, res)), &preview); err != nil {
+		t.Fatalf("preview is not JSON: %v", err)
+	}
+	if preview.Executed || preview.Tool != "droplet-delete" || preview.Arguments["ID"] != float64(1) {
+		t.Fatalf("unexpected preview: %+v", preview)
+	}
+	if structured, ok := res.StructuredContent.(Preview); !ok || structured.Tool != "droplet-delete" {
+		t.Fatalf("expected the preview as structured content, got %+v", res.StructuredContent)
+	}
+
+	// Confirm: true runs the tool without the confirmation argument.
+	if _, err := handler(context.Background(), callRequest("droplet-delete", map[string]any{"ID": float64(1), ConfirmArg: true})); err != nil || *calls != 2 {
+		t.Fatalf("confirmed call: err = %v, calls = %d", err, *calls)
+	}
+	if _, ok := gotArgs[ConfirmArg]; ok {
+		t.Fatal("Confirm argument was passed to the tool handler")
+	}
+}
+
+func TestMiddleware_token(t *testing.T) {
+	g := newTestGuard(t)
+	calls := new(int)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	g.now = func() time.Time { return now }
+	handler := g.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		*calls++
+		return mcp.NewToolResultText("done"), nil
+	})
+
+	res, err := g.issueToken(context.Background(), callRequest(ToolName, map[string]any{
+		"ToolName":  "droplet-delete",
+		"Arguments": map[string]any{"ID": float64(7)},
+	}))
+	if err != nil || res.IsError {
+		t.Fatalf("issueToken() = %v, %v", res, err)
+	}
+	var issued struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal([]byte(resultText(t, res)), &issued); err != nil || issued.Token == "" {
+		t.Fatalf("token not returned: %v", err)
+	}
+
+	// A token for different arguments is rejected.
+	res, _ = handler(context.Background(), callRequest("droplet-delete", map[string]any{"ID": float64(8), TokenArg: issued.Token}))
+	if !res.IsError || *calls != 0 {
+		t.Fatal("token accepted for different arguments")
+	}
+
+	// The matching call runs.
+	res, _ = handler(context.Background(), callRequest("droplet-delete", map[string]any{"ID": float64(7), TokenArg: issued.Token}))
+	if res.IsError || *calls != 1 {
+		t.Fatalf("token rejected for matching call: %s", resultText(t, res))
+	}
+
+	// Expired tokens are rejected.
+	now = now.Add(2 * time.Minute)
+	res, _ = handler(context.Background(), callRequest("droplet-delete", map[string]any{"ID": float64(7), TokenArg: issued.Token}))
+	if !res.IsError || *calls != 1 {
+		t.Fatal("expired token accepted")
+	}
+}
+
+func TestIssueToken_nonDestructive(t *testing.T) {
+	g := newTestGuard(t)
+	res, err := g.issueToken(context.Background(), callRequest(ToolName, map[string]any{"ToolName": "droplet-get"}))
+	if err != nil || !res.IsError {
+		t.Fatalf("expected tool error, got %v, %v", res, err)
+	}
+}
+
+func TestApply(t *testing.T) {
+	g := newTestGuard(t)
+	s := server.NewMCPServer("test", "0.0.0")
+	noop := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) { return nil, nil }
+	s.AddTool(mcp.NewTool("droplet-delete", mcp.WithDescription("Delete a droplet"), mcp.WithNumber("ID", mcp.Required())), noop)
+	s.AddTool(mcp.NewTool("volume-delete", mcp.WithDescription("Delete a volume. ")), noop)
+	s.AddTool(mcp.NewTool("droplet-get", mcp.WithNumber("ID", mcp.Required())), noop)
+	s.AddTools(g.Tools()...)
+
+	g.Apply(s)
+
+	tools := s.ListTools()
+	if _, ok := tools[ToolName]; !ok {
+		t.Fatal("confirm-destructive tool not registered")
+	}
+	if _, ok := tools[ToolName].Tool.InputSchema.Properties[ConfirmArg]; ok {
+		t.Fatal("Confirm argument advertised on the confirm-destructive tool")
+	}
+	if _, ok := tools["droplet-delete"].Tool.InputSchema.Properties[ConfirmArg]; !ok {
+		t.Fatal("Confirm argument not advertised on destructive tool")
+	}
+	if _, ok := tools["droplet-get"].Tool.InputSchema.Properties[ConfirmArg]; ok {
+		t.Fatal("Confirm argument advertised on non-destructive tool")
+	}
+	for name, want := range map[string]string{"droplet-delete": "Delete a droplet. Requires Confirm", "volume-delete": "Delete a volume. Requires Confirm"} {
+		if got := tools[name].Tool.Description; !strings.HasPrefix(got, want) {
+			t.Fatalf("%s description = %q, want it to start with %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Package correlation ties the DigitalOcean API requests of a tool call together for support
+// escalations. Every call gets a correlation ID, passed by the client in the call's _meta or
+// generated, which is sent with each of its API requests. The request IDs the API returns are
+// reported in the _meta of the call's result, and in the text of a failed result.
+package correlation
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// MetaKey is the _meta field of a tools/call request with the client's correlation ID, and
+	// the _meta field of the result that reports it back.
+	MetaKey = "digitalocean.com/correlation_id"
+	// RequestIDsMetaKey is the _meta field of a result listing the request IDs of the call's API requests.
+	RequestIDsMetaKey = "digitalocean.com/request_ids"
+	// Header is the header the correlation ID is sent to the API in.
+	Header = "X-Correlation-Id"
+	// maxIDLength caps the length of a client correlation ID.
+	maxIDLength = 128
+)
+
+type callKey struct{}
+
+// call holds the correlation ID of one tool call and the request IDs of its API requests.
+type call struct {
+	id string
+
+	mu         sync.Mutex
+	requestIDs []string
+}
+
+// FromContext returns the correlation ID of the tool call of ctx, or "" outside of tool calls.
+func FromContext(ctx context.Context) string {
+	if c, ok := ctx.Value(callKey{}).(*call); ok {
+		return c.id
+	}
+	return ""
+}
+
+// validID reports whether a client correlation ID can be sent as a header value.
+func validID(id string) bool {
+	if id == "" || len(id) > maxIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r <= ' ' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// Middleware gives each tool call its correlation ID and reports the ID and the request IDs of
+// the call's API requests in the _meta of its result. A failed result also names the request IDs
+// in its text, so they reach whoever reads the error. A correlation ID that is too long or
+// contains spaces or non-ASCII characters is replaced by a generated one.
+func Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		c := &call{}
+		if meta := req.Params.Meta; meta != nil {
+			if id, _ := meta.AdditionalFields[MetaKey].(string); validID(strings.TrimSpace(id)) {
+				c.id = strings.TrimSpace(id)
+			}
+		}
+		if c.id == "" {
+			c.id = uuid.NewString()
+		}
+
+		res, err := next(context.WithValue(ctx, callKey{}, c), req)
+		if err != nil || res == nil {
+			return res, err
+		}
+		c.mu.Lock()
+		requestIDs := append([]string{}, c.requestIDs...)
+		c.mu.Unlock()
+
+		// results may be shared, e.g. by the response cache, so the copy carries the IDs.
+		out := *res
+		out.Meta = &mcp.Meta{AdditionalFields: map[string]any{}}
+		if res.Meta != nil {
+			out.Meta.ProgressToken = res.Meta.ProgressToken
+			maps.Copy(out.Meta.AdditionalFields, res.Meta.AdditionalFields)
+		}
+		out.Meta.AdditionalFields[MetaKey] = c.id
+		if len(requestIDs) > 0 {
+			out.Meta.AdditionalFields[RequestIDsMetaKey] = requestIDs
+		}
+		if res.IsError && len(requestIDs) > 0 {
+			out.Content = append(slices.Clone(res.Content), mcp.NewTextContent(fmt.Sprintf(
+				"DigitalOcean API request IDs: %s (correlation ID %s). Include them when contacting DigitalOcean support.",
+				strings.Join(requestIDs, ", "), c.id)))
+		}
+		return &out, nil
+	}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps base so the API requests of a tool call carry its correlation ID, and the
+// request IDs of their responses are kept for its result. It should wrap the retry layer, so only
+// the final response of a request is kept.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c, ok := req.Context().Value(callKey{}).(*call)
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set(Header, c.id)
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	if id := resp.Header.Get("X-Request-Id"); id != "" {
+		c.mu.Lock()
+		c.requestIDs = append(c.requestIDs, id)
+		c.mu.Unlock()
+	}
+	return resp, nil
+}
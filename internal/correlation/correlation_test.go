@@ -0,0 +1,91 @@
+package correlation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// callTool calls a tool that sends a GET to url with the given _meta, and returns its result and
+// the correlation ID the API received.
+func callTool(t *testing.T, url string, meta map[string]any, fail bool) (*mcp.CallToolResult, string) {
+	t.Helper()
+	client := &http.Client{Transport: NewTransport(nil)}
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "droplet-get"
+	if meta != nil {
+		req.Params.Meta = &mcp.Meta{AdditionalFields: meta}
+	}
+	var sent string
+	res, err := Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		apiReq, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		resp, err := client.Do(apiReq)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body.Close()
+		sent = resp.Header.Get("X-Echo-Correlation-Id")
+		if fail {
+			return mcp.NewToolResultError("api error"), nil
+		}
+		return mcp.NewToolResultText("ok"), nil
+	})(context.Background(), req)
+	if err != nil {
+		t.Fatalf("tool call error = %v", err)
+	}
+	return res, sent
+}
+
+func newServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Echo-Correlation-Id", r.Header.Get(Header))
+		w.Header().Set("X-Request-Id", "req-1")
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestMiddleware_sendsTheClientsCorrelationID(t *testing.T) {
+	srv := newServer(t)
+	res, sent := callTool(t, srv.URL, map[string]any{MetaKey: "ticket-4711"}, false)
+	if sent != "ticket-4711" {
+		t.Fatalf("API received correlation ID %q, want ticket-4711", sent)
+	}
+	fields := res.Meta.AdditionalFields
+	if fields[MetaKey] != "ticket-4711" {
+		t.Errorf("result correlation ID = %v", fields[MetaKey])
+	}
+	if ids, _ := fields[RequestIDsMetaKey].([]string); len(ids) != 1 || ids[0] != "req-1" {
+		t.Errorf("result request IDs = %v", fields[RequestIDsMetaKey])
+	}
+	if len(res.Content) != 1 {
+		t.Errorf("successful result has %d contents, want only its own", len(res.Content))
+	}
+}
+
+func TestMiddleware_generatesCorrelationIDs(t *testing.T) {
+	srv := newServer(t)
+	for _, meta := range []map[string]any{nil, {MetaKey: "has spaces"}, {MetaKey: strings.Repeat("x", maxIDLength+1)}} {
+		res, sent := callTool(t, srv.URL, meta, false)
+		if len(sent) != 36 || res.Meta.AdditionalFields[MetaKey] != sent {
+			t.Errorf("meta %v: API received %q, result reports %v, want the same generated ID", meta, sent, res.Meta.AdditionalFields[MetaKey])
+		}
+	}
+}
+
+func TestMiddleware_namesRequestIDsInErrors(t *testing.T) {
+	srv := newServer(t)
+	res, _ := callTool(t, srv.URL, map[string]any{MetaKey: "ticket-4711"}, true)
+	if len(res.Content) != 2 {
+		t.Fatalf("failed result has %d contents, want the request IDs added", len(res.Content))
+	}
+	text := res.Content[1].(mcp.TextContent).Text
+	if !strings.Contains(text, "req-1") || !strings.Contains(text, "ticket-4711") {
+		t.Fatalf("text = %q", text)
+	}
+}
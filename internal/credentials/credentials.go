@@ -0,0 +1,280 @@
+// Package credentials looks up the DigitalOcean API token of a local installation.
+//
+// The token can come from environment variables, a token file, the doctl configuration or the
+// OS keyring. A Source reads one of them; the auto source tries the ones that never prompt the user.
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNotFound is returned by a Source that holds no token.
+var ErrNotFound = errors.New("no token found")
+
+const (
+	// KeyringService is the service name the token is stored under in the OS keyring.
+	KeyringService = "mcp-digitalocean"
+	// DefaultKeyringAccount is the keyring account the token is stored under.
+	DefaultKeyringAccount = "api-token"
+)
+
+// EnvVars are the environment variables the env source reads, in order. DIGITALOCEAN_ACCESS_TOKEN
+// is the variable doctl reads.
+var EnvVars = []string{"DIGITALOCEAN_API_TOKEN", "DIGITALOCEAN_TOKEN", "DIGITALOCEAN_ACCESS_TOKEN"}
+
+// Source returns the API token from one place.
+type Source interface {
+	// Name identifies the source in messages, e.g. "doctl".
+	Name() string
+	// Token returns the token, or ErrNotFound when the source holds none.
+	Token(ctx context.Context) (string, error)
+}
+
+// Options locate the token in each source. Empty fields use the defaults of the platform.
+type Options struct {
+	// File is the token file, DefaultFile() when empty.
+	File string
+	// DoctlConfig is doctl's config.yaml, DefaultDoctlConfig() when empty.
+	DoctlConfig string
+	// DoctlContext selects a doctl auth context; the config's current context when empty.
+	DoctlContext string
+	// KeyringAccount is the keyring account, DefaultKeyringAccount when empty.
+	KeyringAccount string
+	// EnvVar, when set, is the only variable the env source reads instead of EnvVars.
+	EnvVar string
+}
+
+// Names are the source names accepted by New.
+var Names = []string{"auto", "env", "file", "doctl", "keyring"}
+
+// New returns the named source: env, file, doctl, keyring, or auto for the first of env, file and
+// doctl that holds a token. The keyring is left out of auto since reading it may prompt the user.
+func New(name string, opts Options) (Source, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "auto":
+		return chain{envSource{name: opts.EnvVar}, fileSource{path: opts.File}, doctlSource{path: opts.DoctlConfig, context: opts.DoctlContext}}, nil
+	case "env":
+		return envSource{name: opts.EnvVar}, nil
+	case "file":
+		return fileSource{path: opts.File}, nil
+	case "doctl":
+		return doctlSource{path: opts.DoctlConfig, context: opts.DoctlContext}, nil
+	case "keyring":
+		return keyringSource{account: opts.KeyringAccount}, nil
+	default:
+		return nil, fmt.Errorf("unsupported auth source %q: use one of %s", name, strings.Join(Names, ", "))
+	}
+}
+
+// Lookup returns the token of the named source and the name of the source that held it.
+func Lookup(ctx context.Context, name string, opts Options) (string, string, error) {
+	source, err := New(name, opts)
+	if err != nil {
+		return "", "", err
+	}
+	if c, ok := source.(chain); ok {
+		return c.lookup(ctx)
+	}
+	token, err := source.Token(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("%s: %w", source.Name(), err)
+	}
+	return token, source.Name(), nil
+}
+
+type chain []Source
+
+func (c chain) Name() string { return "auto" }
+
+func (c chain) Token(ctx context.Context) (string, error) {
+	token, _, err := c.lookup(ctx)
+	return token, err
+}
+
+// lookup returns the token of the first source that holds one. Errors other than ErrNotFound stop
+// the lookup, so a broken source is reported rather than skipped.
+func (c chain) lookup(ctx context.Context) (string, string, error) {
+	for _, s := range c {
+		token, err := s.Token(ctx)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("%s: %w", s.Name(), err)
+		}
+		return token, s.Name(), nil
+	}
+	return "", "", ErrNotFound
+}
+
+type envSource struct {
+	name string
+}
+
+func (envSource) Name() string { return "env" }
+
+func (e envSource) Token(ctx context.Context) (string, error) {
+	names := EnvVars
+	if e.name != "" {
+		names = []string{e.name}
+	}
+	for _, name := range names {
+		if token := strings.TrimSpace(os.Getenv(name)); token != "" {
+			return token, nil
+		}
+	}
+	return "", ErrNotFound
+}
+
+// DefaultFile is the token file read by the file source, mcp-digitalocean/token in the user's
+// config directory.
+func DefaultFile() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "mcp-digitalocean", "token")
+}
+
+type fileSource struct {
+	path string
+}
+
+func (fileSource) Name() string { return "file" }
+
+// Token reads the file, which must not be readable by other users, like an SSH key.
+func (f fileSource) Token(ctx context.Context) (string, error) {
+	path := f.path
+	if path == "" {
+		path = DefaultFile()
+	}
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		return "", fmt.Errorf("%s is accessible by other users; restrict it with chmod 600", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("%s is empty", path)
+	}
+	return token, nil
+}
+
+// DefaultDoctlConfig is the doctl configuration file, doctl/config.yaml in the user's config
+// directory.
+func DefaultDoctlConfig() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "doctl", "config.yaml")
+}
+
+type doctlSource struct {
+	path    string
+	context string
+}
+
+func (doctlSource) Name() string { return "doctl" }
+
+// doctlConfig is the part of doctl's config.yaml that holds tokens. The default context's token is
+// access-token; other contexts are in auth-contexts.
+type doctlConfig struct {
+	AccessToken  string            `yaml:"access-token"`
+	Context      string            `yaml:"context"`
+	AuthContexts map[string]string `yaml:"auth-contexts"`
+}
+
+// Token returns the token of the configured context, or of doctl's current context.
+func (d doctlSource) Token(ctx context.Context) (string, error) {
+	path := d.path
+	if path == "" {
+		path = DefaultDoctlConfig()
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	var cfg doctlConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	authContext := d.context
+	if authContext == "" {
+		authContext = cfg.Context
+	}
+	token := cfg.AccessToken
+	if authContext != "" && authContext != "default" {
+		var ok bool
+		if token, ok = cfg.AuthContexts[authContext]; !ok {
+			return "", fmt.Errorf("doctl has no auth context %q", authContext)
+		}
+	}
+	if strings.TrimSpace(token) == "" {
+		return "", ErrNotFound
+	}
+	return strings.TrimSpace(token), nil
+}
+
+type keyringSource struct {
+	account string
+}
+
+func (keyringSource) Name() string { return "keyring" }
+
+// Token reads the token with the keyring tool of the platform: security on macOS and secret-tool
+// on Linux and BSD.
+func (k keyringSource) Token(ctx context.Context) (string, error) {
+	account := k.account
+	if account == "" {
+		account = DefaultKeyringAccount
+	}
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.CommandContext(ctx, "security", "find-generic-password", "-s", KeyringService, "-a", account, "-w")
+	case "windows":
+		return "", errors.New("the keyring source is not supported on Windows; use the file or env source")
+	default:
+		cmd = exec.CommandContext(ctx, "secret-tool", "lookup", "service", KeyringService, "account", account)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		// security exits with status 44 when there is no such item, secret-tool with 1 and no output.
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && (exitErr.ExitCode() == 44 || stdout.Len() == 0 && stderr.Len() == 0) {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("%s: %w %s", cmd.Args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", ErrNotFound
+	}
+	return token, nil
+}
@@ -0,0 +1,178 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, name, content string, perm os.FileMode) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), perm); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func clearEnv(t *testing.T) {
+	t.Helper()
+	for _, name := range EnvVars {
+		t.Setenv(name, "")
+	}
+}
+
+const doctlYAML = `access-token: dop_v1_default
+context: staging
+auth-contexts:
+  staging: dop_v1_staging
+  production: dop_v1_production
+`
+
+func TestLookup(t *testing.T) {
+	tokenFile := writeFile(t, "token", "dop_v1_file\n", 0o600)
+	doctlFile := writeFile(t, "config.yaml", doctlYAML, 0o600)
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	tests := []struct {
+		name       string
+		env        map[string]string
+		source     string
+		opts       Options
+		wantToken  string
+		wantSource string
+		wantErr    string
+	}{
+		{
+			name:       "Env",
+			env:        map[string]string{"DIGITALOCEAN_ACCESS_TOKEN": "dop_v1_env"},
+			source:     "env",
+			wantToken:  "dop_v1_env",
+			wantSource: "env",
+		},
+		{
+			name:       "Env selected variable",
+			env:        map[string]string{"DIGITALOCEAN_TOKEN": "dop_v1_env", "STAGING_TOKEN": "dop_v1_staging"},
+			source:     "env",
+			opts:       Options{EnvVar: "STAGING_TOKEN"},
+			wantToken:  "dop_v1_staging",
+			wantSource: "env",
+		},
+		{
+			name:       "File",
+			source:     "file",
+			opts:       Options{File: tokenFile},
+			wantToken:  "dop_v1_file",
+			wantSource: "file",
+		},
+		{
+			name:       "Doctl current context",
+			source:     "doctl",
+			opts:       Options{DoctlConfig: doctlFile},
+			wantToken:  "dop_v1_staging",
+			wantSource: "doctl",
+		},
+		{
+			name:       "Doctl selected context",
+			source:     "doctl",
+			opts:       Options{DoctlConfig: doctlFile, DoctlContext: "default"},
+			wantToken:  "dop_v1_default",
+			wantSource: "doctl",
+		},
+		{
+			name:    "Doctl unknown context",
+			source:  "doctl",
+			opts:    Options{DoctlConfig: doctlFile, DoctlContext: "qa"},
+			wantErr: `no auth context "qa"`,
+		},
+		{
+			name:       "Auto prefers env",
+			env:        map[string]string{"DIGITALOCEAN_TOKEN": "dop_v1_env"},
+			source:     "auto",
+			opts:       Options{File: tokenFile, DoctlConfig: doctlFile},
+			wantToken:  "dop_v1_env",
+			wantSource: "env",
+		},
+		{
+			name:       "Auto falls back to doctl",
+			source:     "",
+			opts:       Options{File: missing, DoctlConfig: doctlFile},
+			wantToken:  "dop_v1_staging",
+			wantSource: "doctl",
+		},
+		{
+			name:    "Auto without a token",
+			source:  "auto",
+			opts:    Options{File: missing, DoctlConfig: missing},
+			wantErr: ErrNotFound.Error(),
+		},
+		{
+			name:    "Unknown source",
+			source:  "vault",
+			wantErr: "unsupported auth source",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clearEnv(t)
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+			token, source, err := Lookup(context.Background(), tc.source, tc.opts)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("Lookup() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Lookup() error = %v", err)
+			}
+			if token != tc.wantToken || source != tc.wantSource {
+				t.Fatalf("Lookup() = %q from %s, want %q from %s", token, source, tc.wantToken, tc.wantSource)
+			}
+		})
+	}
+}
+
+func TestFileSource_permissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not enforced on Windows")
+	}
+	path := writeFile(t, "token", "dop_v1_file", 0o644)
+	_, err := fileSource{path: path}.Token(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "chmod 600") {
+		t.Fatalf("Token() error = %v, want a permissions error", err)
+	}
+
+	// a broken source stops the auto lookup instead of being skipped.
+	clearEnv(t)
+	_, _, err = Lookup(context.Background(), "auto", Options{File: path, DoctlConfig: filepath.Join(t.TempDir(), "missing")})
+	if err == nil || errors.Is(err, ErrNotFound) {
+		t.Fatalf("Lookup() error = %v, want the permissions error", err)
+	}
+}
+
+func TestKeyringSource(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("the fake keyring tool is a shell script for secret-tool")
+	}
+	bin := t.TempDir()
+	script := "#!/bin/sh\nif [ \"$5\" = \"work\" ]; then echo dop_v1_keyring; else exit 1; fi\n"
+	if err := os.WriteFile(filepath.Join(bin, "secret-tool"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", bin)
+
+	token, err := keyringSource{account: "work"}.Token(context.Background())
+	if err != nil || token != "dop_v1_keyring" {
+		t.Fatalf("Token(work) = %q, %v", token, err)
+	}
+	if _, err := (keyringSource{}).Token(context.Background()); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Token() error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,232 @@
+// Package drain lets the server shut down without cutting tool calls off. Once draining starts,
+// new tool calls are refused while the calls in flight run to completion, up to a deadline.
+//
+// The drainer also remembers the actions tool calls started, e.g. a droplet resize, until a
+// later call reports them finished. The ones still pending at shutdown are logged, with the API
+// URI to poll, so a restarted session can pick up monitoring them.
+package drain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"mcp-digitalocean/internal/dryrun"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// statusInProgress is the status of an action that has not finished.
+const statusInProgress = "in-progress"
+
+// maxDepth bounds how deep results are searched for actions, e.g. a batch result holding the
+// action of every droplet.
+const maxDepth = 3
+
+// Action is an action a tool call started and no call has seen finish.
+type Action struct {
+	ID           int    `json:"id"`
+	Type         string `json:"type"`
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceID   int    `json:"resource_id,omitempty"`
+	// URI is the API URI of the action, to poll until it completes.
+	URI string `json:"uri"`
+	// Tool is the tool whose call started the action.
+	Tool string `json:"tool"`
+}
+
+// Drainer tracks the tool calls in flight and the actions they started.
+type Drainer struct {
+	endpoint string
+
+	mu       sync.Mutex
+	draining bool
+	inFlight int
+	idle     chan struct{}
+	actions  map[int]Action
+}
+
+// New returns a drainer whose action URIs are relative to the API endpoint, e.g.
+// https://api.digitalocean.com.
+func New(endpoint string) *Drainer {
+	return &Drainer{endpoint: strings.TrimRight(endpoint, "/"), actions: map[int]Action{}}
+}
+
+// Middleware refuses tool calls once draining has started and records the actions in the
+// results of the others.
+func (d *Drainer) Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if !d.enter() {
+			return mcp.NewToolResultError("the server is shutting down and accepts no new tool calls; retry once it has restarted"), nil
+		}
+		defer d.leave()
+
+		res, err := next(ctx, req)
+		if err == nil && res != nil && !res.IsError && !dryrun.Active(ctx) {
+			d.record(req.Params.Name, res)
+		}
+		return res, err
+	}
+}
+
+func (d *Drainer) enter() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining {
+		return false
+	}
+	d.inFlight++
+	return true
+}
+
+func (d *Drainer) leave() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.inFlight--
+	if d.draining && d.inFlight == 0 && d.idle != nil {
+		close(d.idle)
+		d.idle = nil
+	}
+}
+
+// Drain stops accepting tool calls and waits until the calls in flight have finished or ctx is
+// done, returning the number of calls still running. A nil drainer has nothing to drain.
+func (d *Drainer) Drain(ctx context.Context) int {
+	if d == nil {
+		return 0
+	}
+	d.mu.Lock()
+	d.draining = true
+	if d.inFlight == 0 {
+		d.mu.Unlock()
+		return 0
+	}
+	if d.idle == nil {
+		d.idle = make(chan struct{})
+	}
+	idle := d.idle
+	d.mu.Unlock()
+
+	select {
+	case <-idle:
+		return 0
+	case <-ctx.Done():
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		return d.inFlight
+	}
+}
+
+// Pending returns the actions started and not seen finished, by ID.
+func (d *Drainer) Pending() []Action {
+	if d == nil {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	pending := make([]Action, 0, len(d.actions))
+	for _, a := range d.actions {
+		pending = append(pending, a)
+	}
+	slices.SortFunc(pending, func(a, b Action) int { return a.ID - b.ID })
+	return pending
+}
+
+// LogHandoff logs every pending action, and then one record listing their IDs.
+func (d *Drainer) LogHandoff(logger *slog.Logger) {
+	pending := d.Pending()
+	if len(pending) == 0 {
+		return
+	}
+	ids := make([]int, 0, len(pending))
+	for _, a := range pending {
+		ids = append(ids, a.ID)
+		logger.Warn("action started but not awaited", "action_id", a.ID, "uri", a.URI, "type", a.Type,
+			"resource_type", a.ResourceType, "resource_id", a.ResourceID, "tool", a.Tool)
+	}
+	logger.Warn("shutting down with actions in progress; poll them with action-get", "action_ids", ids)
+}
+
+// record updates the pending actions from the actions in res: in-progress ones are added and
+// finished ones removed.
+func (d *Drainer) record(tool string, res *mcp.CallToolResult) {
+	var found []Action
+	var finished []int
+	visit := func(m map[string]any) {
+		id, ok := m["id"].(float64)
+		status, _ := m["status"].(string)
+		_, started := m["started_at"]
+		if !ok || status == "" || !started {
+			return
+		}
+		if status != statusInProgress {
+			finished = append(finished, int(id))
+			return
+		}
+		a := Action{ID: int(id), Tool: tool, URI: fmt.Sprintf("%s/v2/actions/%d", d.endpoint, int(id))}
+		a.Type, _ = m["type"].(string)
+		a.ResourceType, _ = m["resource_type"].(string)
+		if resourceID, ok := m["resource_id"].(float64); ok {
+			a.ResourceID = int(resourceID)
+		}
+		found = append(found, a)
+	}
+	walk(resultValue(res), maxDepth, visit)
+	if len(found) == 0 && len(finished) == 0 {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, id := range finished {
+		delete(d.actions, id)
+	}
+	for _, a := range found {
+		d.actions[a.ID] = a
+	}
+}
+
+// resultValue decodes the structured content of res, or its first text content, as JSON.
+func resultValue(res *mcp.CallToolResult) any {
+	var data []byte
+	if res.StructuredContent != nil {
+		var err error
+		if data, err = json.Marshal(res.StructuredContent); err != nil {
+			return nil
+		}
+	} else if len(res.Content) > 0 {
+		text, ok := res.Content[0].(mcp.TextContent)
+		if !ok {
+			return nil
+		}
+		data = []byte(text.Text)
+	}
+	var v any
+	if json.Unmarshal(data, &v) != nil {
+		return nil
+	}
+	return v
+}
+
+// walk calls visit with every JSON object of v, depth levels deep.
+func walk(v any, depth int, visit func(map[string]any)) {
+	if depth < 0 {
+		return
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		visit(v)
+		for _, child := range v {
+			walk(child, depth-1, visit)
+		}
+	case []any:
+		for _, child := range v {
+			walk(child, depth-1, visit)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package drain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func callTool(t *testing.T, handler server.ToolHandlerFunc, name string) *mcp.CallToolResult {
+	t.Helper()
+	req := mcp.CallToolRequest{}
+	req.Params.Name = name
+	res, err := handler(context.Background(), req)
+	if err != nil {
+		t.Fatalf("handler() error = %v", err)
+	}
+	return res
+}
+
+func actionResult(action *godo.Action) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultStructured(action, "action"), nil
+	}
+}
+
+func TestDrainer_recordsActions(t *testing.T) {
+	d := New("https://api.digitalocean.com/")
+	started := &godo.Action{ID: 7, Status: "in-progress", Type: "resize", ResourceType: "droplet", ResourceID: 12, StartedAt: &godo.Timestamp{Time: time.Now()}}
+	callTool(t, d.Middleware(actionResult(started)), "droplet-resize")
+
+	// a batch result in text content, as the tag-based actions return it.
+	batch, _ := json.Marshal(map[string]any{"results": []any{
+		map[string]any{"droplet_id": 1, "action": godo.Action{ID: 8, Status: "in-progress", Type: "power_off", StartedAt: &godo.Timestamp{}}},
+		map[string]any{"droplet_id": 2, "error": "not found"},
+	}})
+	callTool(t, d.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(string(batch)), nil
+	}), "power-off-droplets-tag")
+
+	pending := d.Pending()
+	if len(pending) != 2 {
+		t.Fatalf("Pending() = %+v, want 2 actions", pending)
+	}
+	want := Action{ID: 7, Type: "resize", ResourceType: "droplet", ResourceID: 12, URI: "https://api.digitalocean.com/v2/actions/7", Tool: "droplet-resize"}
+	if pending[0] != want {
+		t.Errorf("Pending()[0] = %+v, want %+v", pending[0], want)
+	}
+
+	// seeing the action completed, e.g. from action-get, means it was awaited.
+	completed := *started
+	completed.Status = "completed"
+	callTool(t, d.Middleware(actionResult(&completed)), "action-get")
+	if pending := d.Pending(); len(pending) != 1 || pending[0].ID != 8 {
+		t.Fatalf("Pending() = %+v, want only action 8", pending)
+	}
+
+	var buf bytes.Buffer
+	d.LogHandoff(slog.New(slog.NewJSONHandler(&buf, nil)))
+	if !strings.Contains(buf.String(), `"uri":"https://api.digitalocean.com/v2/actions/8"`) || !strings.Contains(buf.String(), `"action_ids":[8]`) {
+		t.Errorf("LogHandoff() logged %s", buf.String())
+	}
+}
+
+func TestDrainer_ignoresErrors(t *testing.T) {
+	d := New("https://api.digitalocean.com")
+	callTool(t, d.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		res := mcp.NewToolResultStructured(&godo.Action{ID: 9, Status: "in-progress", StartedAt: &godo.Timestamp{}}, "action")
+		res.IsError = true
+		return res, nil
+	}), "droplet-resize")
+	if pending := d.Pending(); len(pending) != 0 {
+		t.Errorf("Pending() = %+v, want none", pending)
+	}
+}
+
+func TestDrainer_Drain(t *testing.T) {
+	d := New("https://api.digitalocean.com")
+	entered, release := make(chan struct{}), make(chan struct{})
+	slow := d.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		close(entered)
+		<-release
+		return mcp.NewToolResultText("done"), nil
+	})
+	done := make(chan *mcp.CallToolResult)
+	go func() { done <- callTool(t, slow, "slow") }()
+	<-entered
+
+	// the call in flight outlives a short deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if running := d.Drain(ctx); running != 1 {
+		t.Errorf("Drain() = %d, want 1 call running", running)
+	}
+
+	// new calls are refused while draining.
+	refused := callTool(t, d.Middleware(actionResult(&godo.Action{ID: 1})), "droplet-get")
+	if !refused.IsError {
+		t.Error("a tool call started while draining was not refused")
+	}
+
+	drained := make(chan int)
+	go func() { drained <- d.Drain(context.Background()) }()
+	close(release)
+	if res := <-done; res.IsError {
+		t.Error("the call in flight failed")
+	}
+	if running := <-drained; running != 0 {
+		t.Errorf("Drain() = %d after the call finished, want 0", running)
+	}
+}
+
+func TestDrainer_nil(t *testing.T) {
+	var d *Drainer
+	if running := d.Drain(context.Background()); running != 0 {
+		t.Errorf("Drain() = %d, want 0", running)
+	}
+	d.LogHandoff(slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil)))
+}
@@ -0,0 +1,240 @@
+// Package dryrun lets any tool be called with DryRun: true. Read requests made by the tool
+// still reach the API, so arguments are validated and slugs or IDs are resolved, but every
+// mutating request is recorded instead of sent and returned to the caller.
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"mcp-digitalocean/internal/shape"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Arg is the boolean argument that requests a dry run.
+const Arg = "DryRun"
+
+// mutatingVerbs are the tool name segments that mark a tool as changing resources.
+var mutatingVerbs = []string{
+	"add", "apply", "assign", "attach", "bootstrap", "cancel", "change", "cleanup", "clone", "convert", "create", "delete", "deploy", "destroy", "detach",
+	"disable", "edit", "enable", "exec", "flush", "import", "install", "invoke", "migrate", "power", "promote", "provision", "prune", "purge", "reassign",
+	"reboot", "rebuild", "recycle", "release", "remove", "rename", "reserve", "reset", "resize",
+	"restore", "run", "set", "shutdown", "snapshot", "start", "stop", "switch", "sync", "transfer", "unassign", "update", "upgrade", "upload",
+}
+
+// IsMutating reports whether the tool name contains a mutating verb as one of its dash-separated
+// segments. Tools that read, such as snapshot-list, are not mutating even though "snapshot" is
+// also a verb.
+func IsMutating(name string) bool {
+	if shape.IsRead(name) {
+		return false
+	}
+	for _, segment := range strings.Split(name, "-") {
+		if slices.Contains(mutatingVerbs, segment) {
+			return true
+		}
+	}
+	return false
+}
+
+// Request is a mutating API request that a dry run did not send.
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   any    `json:"body,omitempty"`
+}
+
+// Result is returned by a dry run instead of the tool's own result.
+type Result struct {
+	DryRun   bool      `json:"dry_run"`
+	Tool     string    `json:"tool"`
+	Requests []Request `json:"requests"`
+	Message  string    `json:"message"`
+}
+
+type recorderKey struct{}
+
+// recorder collects the requests intercepted during one dry run.
+type recorder struct {
+	mu       sync.Mutex
+	requests []Request
+	planned  bool
+}
+
+func (r *recorder) add(req Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, req)
+}
+
+func (r *recorder) list() []Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.requests)
+}
+
+// Active reports whether ctx belongs to a dry-run tool call.
+func Active(ctx context.Context) bool {
+	_, ok := ctx.Value(recorderKey{}).(*recorder)
+	return ok
+}
+
+// Planned marks the dry run of ctx as answered by the tool itself, typically with a plan of the
+// steps it would take. Its result is then returned as it is instead of the intercepted requests.
+func Planned(ctx context.Context) {
+	if rec, ok := ctx.Value(recorderKey{}).(*recorder); ok {
+		rec.mu.Lock()
+		rec.planned = true
+		rec.mu.Unlock()
+	}
+}
+
+// transport records mutating requests made in a dry-run context instead of sending them.
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps base so mutating requests made during a dry run are recorded and never sent.
+// It should wrap the godo client's final transport so intercepted requests skip the retry layer.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rec, ok := req.Context().Value(recorderKey{}).(*recorder)
+	if !ok || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return t.base.RoundTrip(req)
+	}
+
+	recorded := Request{Method: req.Method, URL: req.URL.String()}
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read dry-run request body: %w", err)
+		}
+		if len(bytes.TrimSpace(data)) > 0 {
+			var body any
+			if err := json.Unmarshal(data, &body); err != nil {
+				body = string(data)
+			}
+			recorded.Body = body
+		}
+	}
+	rec.add(recorded)
+
+	// A client error stops the tool at this point and, unlike 429 and 5xx responses, is not retried.
+	return &http.Response{
+		Status:     "422 Unprocessable Entity",
+		StatusCode: http.StatusUnprocessableEntity,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"id":"dry_run","message":"dry run: request was not sent"}`)),
+		Request:    req,
+	}, nil
+}
+
+// Middleware runs tools called with DryRun: true in a dry-run context and replaces their
+// result with the requests that would have been sent.
+func Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := req.GetArguments()
+		if dry, _ := args[Arg].(bool); !dry {
+			return next(ctx, req)
+		}
+		callArgs := maps.Clone(args)
+		delete(callArgs, Arg)
+		req.Params.Arguments = callArgs
+
+		rec := &recorder{}
+		res, err := next(context.WithValue(ctx, recorderKey{}, rec), req)
+		rec.mu.Lock()
+		planned := rec.planned
+		rec.mu.Unlock()
+		if planned {
+			return res, err
+		}
+		requests := rec.list()
+		if len(requests) == 0 && (err != nil || (res != nil && res.IsError)) {
+			// The tool failed before it would have changed anything, e.g. on invalid arguments.
+			return res, err
+		}
+
+		result := Result{
+			DryRun:   true,
+			Tool:     req.Params.Name,
+			Requests: requests,
+			Message:  "Dry run: no changes were made. Requests listed here were not sent; steps that depend on their responses were not evaluated.",
+		}
+		if len(requests) == 0 {
+			result.Requests = []Request{}
+			result.Message = "Dry run: the tool would not send any mutating request."
+		}
+		jsonResult, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: %w", err)
+		}
+		return mcp.NewToolResultStructured(result, string(jsonResult)), nil
+	}
+}
+
+// argSchema is the schema of the DryRun argument.
+var argSchema = map[string]any{
+	"type":        "boolean",
+	"description": "Validate the arguments and return the API requests that would be sent, without changing anything",
+}
+
+// Apply advertises the DryRun argument on every mutating tool registered with s.
+func Apply(s *server.MCPServer) {
+	var updated []server.ServerTool
+	for name, st := range s.ListTools() {
+		if !IsMutating(name) {
+			continue
+		}
+		tool := st.Tool
+		if tool.RawInputSchema != nil {
+			// Tools built from a JSON schema, like apps-create-app-from-spec and
+			// doks-create-cluster, get the argument added to the properties of that schema.
+			var schema map[string]any
+			if json.Unmarshal(tool.RawInputSchema, &schema) != nil {
+				continue
+			}
+			props, _ := schema["properties"].(map[string]any)
+			if props == nil {
+				props = map[string]any{}
+			}
+			props[Arg] = argSchema
+			schema["properties"] = props
+			raw, err := json.Marshal(schema)
+			if err != nil {
+				continue
+			}
+			tool.RawInputSchema = raw
+		} else {
+			props := maps.Clone(tool.InputSchema.Properties)
+			if props == nil {
+				props = map[string]any{}
+			}
+			props[Arg] = argSchema
+			tool.InputSchema.Properties = props
+		}
+		updated = append(updated, server.ServerTool{Tool: tool, Handler: st.Handler})
+	}
+	s.AddTools(updated...)
+}
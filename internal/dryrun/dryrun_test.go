@@ -0,0 +1,199 @@
+package dryrun
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"golang.org/x/oauth2"
+)
+
+func newTestClient(t *testing.T) (*godo.Client, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+	var gets, writes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writes.Add(1)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"droplet":{"id":2}}`))
+			return
+		}
+		gets.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"regions":[{"slug":"nyc3"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	oauthClient := oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}))
+	client, err := godo.New(oauthClient,
+		godo.SetBaseURL(srv.URL),
+		godo.WithRetryAndBackoffs(godo.RetryConfig{RetryMax: 3}),
+	)
+	if err != nil {
+		t.Fatalf("godo.New() error = %v", err)
+	}
+	client.HTTPClient.Transport = NewTransport(client.HTTPClient.Transport)
+	return client, &gets, &writes
+}
+
+// createHandler lists regions and then creates a droplet, like a typical create tool.
+func createHandler(client *godo.Client) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		name, _ := req.GetArguments()["Name"].(string)
+		if name == "" {
+			return mcp.NewToolResultError("Name is required"), nil
+		}
+		if _, _, err := client.Regions.List(ctx, nil); err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		droplet, _, err := client.Droplets.Create(ctx, &godo.DropletCreateRequest{Name: name, Region: "nyc3", Size: "s-1vcpu-1gb"})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		return mcp.NewToolResultText(droplet.Name), nil
+	}
+}
+
+func callRequest(args map[string]any) mcp.CallToolRequest {
+	return mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "droplet-create", Arguments: args}}
+}
+
+func TestMiddleware_recordsMutatingRequests(t *testing.T) {
+	client, gets, writes := newTestClient(t)
+	handler := Middleware(createHandler(client))
+
+	res, err := handler(context.Background(), callRequest(map[string]any{"Name": "web", Arg: true}))
+	if err != nil || res.IsError {
+		t.Fatalf("dry run failed: %v, %+v", err, res)
+	}
+	if gets.Load() != 1 {
+		t.Fatalf("expected the region lookup to reach the API, got %d GETs", gets.Load())
+	}
+	if writes.Load() != 0 {
+		t.Fatalf("dry run sent %d mutating requests", writes.Load())
+	}
+
+	var result Result
+	if err := json.Unmarshal([]byte(res.Content[0].(mcp.TextContent).Text), &result); err != nil {
+		t.Fatalf("result is not JSON: %v", err)
+	}
+	if !result.DryRun || len(result.Requests) != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if structured, ok := res.StructuredContent.(Result); !ok || !structured.DryRun || len(structured.Requests) != 1 {
+		t.Fatalf("expected the result as structured content, got %+v", res.StructuredContent)
+	}
+	got := result.Requests[0]
+	body, _ := got.Body.(map[string]any)
+	if got.Method != http.MethodPost || body["name"] != "web" || body["size"] != "s-1vcpu-1gb" {
+		t.Fatalf("unexpected recorded request: %+v", got)
+	}
+}
+
+func TestMiddleware_passesThroughWithoutDryRun(t *testing.T) {
+	client, _, writes := newTestClient(t)
+	handler := Middleware(createHandler(client))
+
+	res, err := handler(context.Background(), callRequest(map[string]any{"Name": "web"}))
+	if err != nil || res.IsError {
+		t.Fatalf("call failed: %v, %+v", err, res)
+	}
+	if writes.Load() != 1 {
+		t.Fatalf("expected one mutating request, got %d", writes.Load())
+	}
+}
+
+func TestMiddleware_validationError(t *testing.T) {
+	client, _, _ := newTestClient(t)
+	handler := Middleware(createHandler(client))
+
+	res, err := handler(context.Background(), callRequest(map[string]any{Arg: true}))
+	if err != nil || !res.IsError {
+		t.Fatalf("expected the tool's validation error, got %v, %+v", err, res)
+	}
+}
+
+func TestActive(t *testing.T) {
+	var active bool
+	handler := Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		active = Active(ctx)
+		if _, ok := req.GetArguments()[Arg]; ok {
+			t.Fatal("DryRun argument was passed to the tool handler")
+		}
+		return mcp.NewToolResultText("ok"), nil
+	})
+	if _, err := handler(context.Background(), callRequest(map[string]any{Arg: true})); err != nil || !active {
+		t.Fatalf("expected an active dry run, err = %v", err)
+	}
+	if Active(context.Background()) {
+		t.Fatal("background context reported as dry run")
+	}
+}
+
+func TestPlanned(t *testing.T) {
+	handler := Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		Planned(ctx)
+		return mcp.NewToolResultText("plan"), nil
+	})
+	res, err := handler(context.Background(), callRequest(map[string]any{Arg: true}))
+	if err != nil || res.Content[0].(mcp.TextContent).Text != "plan" {
+		t.Fatalf("expected the tool's own plan, got %v, %+v", err, res)
+	}
+	// Planned outside a dry run is a no-op.
+	Planned(context.Background())
+}
+
+func TestApply(t *testing.T) {
+	s := server.NewMCPServer("test", "0.0.0")
+	noop := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) { return nil, nil }
+	s.AddTool(mcp.NewTool("droplet-create"), noop)
+	s.AddTool(mcp.NewTool("droplet-list"), noop)
+	s.AddTool(mcp.NewToolWithRawSchema("doks-create-cluster", "Create a cluster",
+		[]byte(`{"type":"object","properties":{"name":{"type":"string"}},"required":["name"]}`)), noop)
+
+	Apply(s)
+
+	tools := s.ListTools()
+	if _, ok := tools["droplet-create"].Tool.InputSchema.Properties[Arg]; !ok {
+		t.Fatal("DryRun not advertised on mutating tool")
+	}
+	if _, ok := tools["droplet-list"].Tool.InputSchema.Properties[Arg]; ok {
+		t.Fatal("DryRun advertised on read-only tool")
+	}
+	var schema struct {
+		Properties map[string]any `json:"properties"`
+		Required   []string       `json:"required"`
+	}
+	if err := json.Unmarshal(tools["doks-create-cluster"].Tool.RawInputSchema, &schema); err != nil {
+		t.Fatalf("raw schema is not JSON: %v", err)
+	}
+	if _, ok := schema.Properties[Arg]; !ok || schema.Properties["name"] == nil || len(schema.Required) != 1 {
+		t.Fatalf("DryRun not added to the raw schema: %+v", schema)
+	}
+}
+
+func TestIsMutating(t *testing.T) {
+	tests := map[string]bool{
+		"droplet-create":         true,
+		"droplet-snapshot":       true,
+		"snapshot-prune":         true,
+		"snapshot-list":          false,
+		"volume-snapshot-get":    false,
+		"nfs-snapshot-list":      false,
+		"droplet-get":            false,
+		"userdata-render":        false,
+		"doks-get-credentials":   false,
+		"volume-snapshot-create": true,
+	}
+	for name, want := range tests {
+		if got := IsMutating(name); got != want {
+			t.Errorf("IsMutating(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Package fanout runs a function over the items of the tools that act on several resources in one
+// call, such as the by-tag droplet actions, cleanup-by-tag and the inventory, with a bounded
+// number of items in flight, an optional timeout for each item and the outcome of every item, so a
+// failure of one item is reported next to the others instead of ending the call. Batch is the
+// result envelope those tools report the items that succeeded and failed in.
+package fanout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultConcurrency is the number of items in flight at once when Options.Concurrency is not set.
+const DefaultConcurrency = 5
+
+// ErrSkipped is the error of the items that were not started because an earlier item failed with
+// Options.StopOnError set, or because the context was done.
+var ErrSkipped = errors.New("skipped")
+
+// Options configure a Run.
+type Options struct {
+	// Concurrency is the most items in flight at once; DefaultConcurrency when 0 or less.
+	Concurrency int
+	// Timeout bounds the context of each item; no bound when 0.
+	Timeout time.Duration
+	// StopOnError skips the items not started yet once an item fails.
+	StopOnError bool
+}
+
+// Outcome is what fn returned for one item.
+type Outcome[T any] struct {
+	Value T
+	Err   error
+}
+
+// Skipped reports whether the item was not started.
+func (o Outcome[T]) Skipped() bool {
+	return errors.Is(o.Err, ErrSkipped)
+}
+
+// Run calls fn with each of items, at most opts.Concurrency at a time, and returns the outcomes in
+// the order of items. It returns once every started call has returned.
+func Run[I, O any](ctx context.Context, items []I, opts Options, fn func(ctx context.Context, item I) (O, error)) []Outcome[O] {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	outcomes := make([]Outcome[O], len(items))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	failed := false
+	for i, item := range items {
+		sem <- struct{}{}
+		mu.Lock()
+		stop := opts.StopOnError && failed
+		mu.Unlock()
+		if stop || ctx.Err() != nil {
+			<-sem
+			outcomes[i].Err = ErrSkipped
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			itemCtx := ctx
+			if opts.Timeout > 0 {
+				var cancel context.CancelFunc
+				itemCtx, cancel = context.WithTimeout(ctx, opts.Timeout)
+				defer cancel()
+			}
+			value, err := fn(itemCtx, item)
+			outcomes[i] = Outcome[O]{Value: value, Err: err}
+			if err != nil {
+				mu.Lock()
+				failed = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return outcomes
+}
+
+// Report counts the outcomes of a Run.
+type Report struct {
+	Total     int
+	Succeeded int
+	Failed    int
+	Skipped   int
+	// Errors are the errors of the failed items, by their index in the items of the Run.
+	Errors map[int]error
+}
+
+// Summarize counts outcomes.
+func Summarize[T any](outcomes []Outcome[T]) Report {
+	r := Report{Total: len(outcomes)}
+	for i, o := range outcomes {
+		switch {
+		case o.Err == nil:
+			r.Succeeded++
+		case o.Skipped():
+			r.Skipped++
+		default:
+			r.Failed++
+			if r.Errors == nil {
+				r.Errors = map[int]error{}
+			}
+			r.Errors[i] = o.Err
+		}
+	}
+	return r
+}
+
+// Err returns nil when no item failed, and otherwise an error saying how many did, wrapping their
+// errors.
+func (r Report) Err() error {
+	if r.Failed == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(r.Errors))
+	for i := range r.Total {
+		if err, ok := r.Errors[i]; ok {
+			errs = append(errs, fmt.Errorf("item %d: %w", i, err))
+		}
+	}
+	return fmt.Errorf("%d of %d items failed: %w", r.Failed, r.Total, errors.Join(errs...))
+}
+
+// Failure is an item a batch failed on, with its error.
+type Failure[I any] struct {
+	Item  I      `json:"item"`
+	Error string `json:"error"`
+}
+
+// Batch is the result envelope of the tools that act on several items in one call: what each item
+// that succeeded produced, and each item that failed with its error, so a caller can retry only
+// the failures. Skipped lists the items that were not attempted.
+type Batch[S, I any] struct {
+	Succeeded []S          `json:"succeeded"`
+	Failed    []Failure[I] `json:"failed"`
+	Skipped   []I          `json:"skipped,omitempty"`
+}
+
+// NewBatch returns an empty batch, whose lists encode as [] rather than null.
+func NewBatch[S, I any]() Batch[S, I] {
+	return Batch[S, I]{Succeeded: []S{}, Failed: []Failure[I]{}}
+}
+
+// Succeed records what an item that succeeded produced.
+func (b *Batch[S, I]) Succeed(s S) {
+	b.Succeeded = append(b.Succeeded, s)
+}
+
+// Fail records an item that failed with err.
+func (b *Batch[S, I]) Fail(item I, err error) {
+	b.Failed = append(b.Failed, Failure[I]{Item: item, Error: err.Error()})
+}
+
+// Skip records an item that was not attempted.
+func (b *Batch[S, I]) Skip(item I) {
+	b.Skipped = append(b.Skipped, item)
+}
+
+// Collect records the outcomes of a Run over items in a batch, with succeeded returning what an
+// item that succeeded produced.
+func Collect[I, O, S any](b *Batch[S, I], items []I, outcomes []Outcome[O], succeeded func(item I, value O) S) {
+	for i, o := range outcomes {
+		switch {
+		case o.Skipped():
+			b.Skip(items[i])
+		case o.Err != nil:
+			b.Fail(items[i], o.Err)
+		default:
+			b.Succeed(succeeded(items[i], o.Value))
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	var inFlight, most atomic.Int32
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	outcomes := Run(context.Background(), items, Options{Concurrency: 3}, func(ctx context.Context, n int) (int, error) {
+		now := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := most.Load()
+			if now <= m || most.CompareAndSwap(m, now) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if n == 4 {
+			return 0, errors.New("out of capacity")
+		}
+		return n * n, nil
+	})
+
+	if most.Load() > 3 {
+		t.Errorf("%d items were in flight at once, want at most 3", most.Load())
+	}
+	if outcomes[2].Value != 9 || outcomes[7].Value != 64 {
+		t.Errorf("outcomes are not in the order of the items: %+v", outcomes)
+	}
+	report := Summarize(outcomes)
+	if report.Succeeded != 7 || report.Failed != 1 || report.Errors[3] == nil {
+		t.Errorf("Summarize() = %+v", report)
+	}
+	if err := report.Err(); err == nil || !strings.Contains(err.Error(), "1 of 8 items failed: item 3: out of capacity") {
+		t.Errorf("Err() = %v", err)
+	}
+	if err := Summarize(outcomes[:3]).Err(); err != nil {
+		t.Errorf("Err() of successes = %v", err)
+	}
+}
+
+func TestRun_stopOnError(t *testing.T) {
+	outcomes := Run(context.Background(), []int{1, 2, 3}, Options{Concurrency: 1, StopOnError: true}, func(ctx context.Context, n int) (int, error) {
+		if n == 1 {
+			return 0, errors.New("failed")
+		}
+		return n, nil
+	})
+	if report := Summarize(outcomes); report.Failed != 1 || report.Skipped != 2 {
+		t.Errorf("Summarize() = %+v, want 1 failed and 2 skipped", report)
+	}
+}
+
+func TestRun_timeout(t *testing.T) {
+	outcomes := Run(context.Background(), []string{"slow"}, Options{Timeout: time.Millisecond}, func(ctx context.Context, _ string) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	})
+	if !errors.Is(outcomes[0].Err, context.DeadlineExceeded) {
+		t.Errorf("Err = %v, want the deadline of the item", outcomes[0].Err)
+	}
+}
@@ -0,0 +1,135 @@
+// Package health serves the liveness and readiness endpoints of the remote transports, so
+// orchestrators such as Kubernetes or App Platform can health-check the server itself.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+const (
+	// LivenessPath answers as long as the process serves HTTP.
+	LivenessPath = "/healthz"
+	// ReadinessPath answers once the server can reach the DigitalOcean API with its token.
+	ReadinessPath = "/readyz"
+	// DefaultTTL is how long the outcome of a readiness check is reused.
+	DefaultTTL = 30 * time.Second
+	// checkTimeout bounds a single readiness check, so a slow API doesn't hold the probe open.
+	checkTimeout = 10 * time.Second
+)
+
+// ErrInvalidToken is reported when the DigitalOcean API rejects the server's token.
+var ErrInvalidToken = errors.New("the DigitalOcean API token is invalid")
+
+// Status is the body of the health endpoints.
+type Status struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Checker runs the readiness check and remembers its outcome for a while, so frequent probes
+// don't cost an API request each.
+type Checker struct {
+	check func(ctx context.Context) error
+	ttl   time.Duration
+	now   func() time.Time
+
+	mu      sync.Mutex
+	checked time.Time
+	err     error
+}
+
+// NewChecker returns a checker that runs check at most once per ttl. A nil check is always ready.
+func NewChecker(check func(ctx context.Context) error, ttl time.Duration) *Checker {
+	return &Checker{check: check, ttl: ttl, now: time.Now}
+}
+
+// Ready returns the error of the last check, running the check again when its outcome has expired.
+// Checks cut short by the caller's context are not remembered.
+func (c *Checker) Ready(ctx context.Context) error {
+	if c.check == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if !c.checked.IsZero() && now.Before(c.checked.Add(c.ttl)) {
+		return c.err
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	err := c.check(checkCtx)
+	if err != nil && ctx.Err() != nil {
+		return err
+	}
+	c.checked, c.err = now, err
+	return err
+}
+
+// AccountCheck returns a readiness check that fetches the account of client's token, which
+// fails when the token was revoked or the API can't be reached.
+func AccountCheck(client *godo.Client) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		_, resp, err := client.Account.Get(ctx)
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+			return ErrInvalidToken
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get account: %w", err)
+		}
+		return nil
+	}
+}
+
+// LivenessHandler reports that the process is up. It does not depend on the DigitalOcean API, so
+// an API outage doesn't get the server restarted.
+func LivenessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethod(w, r) {
+			return
+		}
+		writeStatus(w, r, http.StatusOK, Status{Status: "ok"})
+	}
+}
+
+// ReadinessHandler reports whether the readiness check passes, with 503 Service Unavailable and
+// the reason when it does not.
+func (c *Checker) ReadinessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethod(w, r) {
+			return
+		}
+		if err := c.Ready(r.Context()); err != nil {
+			writeStatus(w, r, http.StatusServiceUnavailable, Status{Status: "unavailable", Error: err.Error()})
+			return
+		}
+		writeStatus(w, r, http.StatusOK, Status{Status: "ok"})
+	}
+}
+
+func allowMethod(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return true
+	}
+	w.Header().Set("Allow", "GET, HEAD")
+	http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	return false
+}
+
+func writeStatus(w http.ResponseWriter, r *http.Request, code int, status Status) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	if r.Method == http.MethodHead {
+		return
+	}
+	_ = json.NewEncoder(w).Encode(status)
+}
@@ -0,0 +1,141 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+func TestLivenessHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	LivenessHandler()(rec, httptest.NewRequest(http.MethodGet, LivenessPath, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	var status Status
+	if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if status.Status != "ok" {
+		t.Fatalf("status = %q, want ok", status.Status)
+	}
+
+	rec = httptest.NewRecorder()
+	LivenessHandler()(rec, httptest.NewRequest(http.MethodPost, LivenessPath, nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("POST status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestReadinessHandler(t *testing.T) {
+	checkErr := errors.New("api unreachable")
+	checker := NewChecker(func(ctx context.Context) error { return checkErr }, time.Minute)
+
+	rec := httptest.NewRecorder()
+	checker.ReadinessHandler()(rec, httptest.NewRequest(http.MethodGet, ReadinessPath, nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+	var status Status
+	if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if status.Status != "unavailable" || status.Error != "api unreachable" {
+		t.Fatalf("body = %+v, want the check error", status)
+	}
+
+	rec = httptest.NewRecorder()
+	NewChecker(nil, time.Minute).ReadinessHandler()(rec, httptest.NewRequest(http.MethodHead, ReadinessPath, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status without a check = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec.Body.Len() != 0 {
+		t.Fatalf("HEAD body = %q, want empty", rec.Body.String())
+	}
+}
+
+func TestChecker_cachesOutcome(t *testing.T) {
+	calls := 0
+	var checkErr error
+	checker := NewChecker(func(ctx context.Context) error {
+		calls++
+		return checkErr
+	}, time.Minute)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	checker.now = func() time.Time { return now }
+
+	if err := checker.Ready(context.Background()); err != nil {
+		t.Fatalf("Ready() error = %v", err)
+	}
+	checkErr = ErrInvalidToken
+	if err := checker.Ready(context.Background()); err != nil {
+		t.Fatalf("Ready() within the ttl error = %v, want the cached outcome", err)
+	}
+	if calls != 1 {
+		t.Fatalf("check called %d times, want 1", calls)
+	}
+
+	now = now.Add(time.Minute)
+	if err := checker.Ready(context.Background()); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Ready() after the ttl error = %v, want %v", err, ErrInvalidToken)
+	}
+	if calls != 2 {
+		t.Fatalf("check called %d times, want 2", calls)
+	}
+}
+
+func TestChecker_doesNotCacheCanceledChecks(t *testing.T) {
+	calls := 0
+	checker := NewChecker(func(ctx context.Context) error {
+		calls++
+		return ctx.Err()
+	}, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := checker.Ready(ctx); err == nil {
+		t.Fatal("Ready() with a canceled context succeeded")
+	}
+	if err := checker.Ready(context.Background()); err != nil {
+		t.Fatalf("Ready() error = %v, want the check to run again", err)
+	}
+	if calls != 2 {
+		t.Fatalf("check called %d times, want 2", calls)
+	}
+}
+
+func TestAccountCheck(t *testing.T) {
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/account" {
+			t.Errorf("path = %q, want /v2/account", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(`{"account":{"uuid":"acc-1","status":"active"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"unauthorized","message":"Unable to authenticate you"}`))
+	}))
+	defer srv.Close()
+
+	client, err := godo.New(srv.Client(), godo.SetBaseURL(srv.URL))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	check := AccountCheck(client)
+	if err := check(context.Background()); err != nil {
+		t.Fatalf("check() error = %v", err)
+	}
+	status = http.StatusUnauthorized
+	if err := check(context.Background()); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("check() error = %v, want %v", err, ErrInvalidToken)
+	}
+}
@@ -0,0 +1,127 @@
+// Package managedtag tags every resource the server creates, so the resources an agent made can
+// be told apart from the rest of the account, e.g. by inventory-list with Tag. The tags are added
+// to the body of the API requests that create taggable resources, whichever tool sends them.
+package managedtag
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// SessionTagPrefix starts the tag naming the MCP session a resource was created in.
+	SessionTagPrefix = "mcp-session:"
+	// maxTagLength is the longest tag name the API accepts.
+	maxTagLength = 255
+)
+
+// validTag matches the tag names the API accepts.
+var validTag = regexp.MustCompile(`^[a-zA-Z0-9_:\-]+$`)
+
+// createPaths match the paths of the POST requests that create resources with a "tags" field
+// naming their own tags. Firewalls are left out: their tags select the droplets they apply to.
+var createPaths = []*regexp.Regexp{
+	regexp.MustCompile(`/v2/droplets$`),
+	regexp.MustCompile(`/v2/volumes$`),
+	regexp.MustCompile(`/v2/volumes/[^/]+/snapshots$`),
+	regexp.MustCompile(`/v2/images$`),
+	regexp.MustCompile(`/v2/load_balancers$`),
+	regexp.MustCompile(`/v2/databases$`),
+	regexp.MustCompile(`/v2/kubernetes/clusters$`),
+	regexp.MustCompile(`/v2/kubernetes/clusters/[^/]+/node_pools$`),
+}
+
+// Validate reports whether tag is a tag name the API accepts.
+func Validate(tag string) error {
+	if len(tag) > maxTagLength || !validTag.MatchString(tag) {
+		return fmt.Errorf("managed tag %q must be at most %d letters, digits, colons, dashes and underscores", tag, maxTagLength)
+	}
+	return nil
+}
+
+// SessionTag returns the tag naming the MCP session sessionID, with the characters tags can't
+// hold replaced by underscores.
+func SessionTag(sessionID string) string {
+	name := SessionTagPrefix + strings.Map(func(r rune) rune {
+		if r < 0x80 && validTag.MatchString(string(r)) {
+			return r
+		}
+		return '_'
+	}, sessionID)
+	if len(name) > maxTagLength {
+		name = name[:maxTagLength]
+	}
+	return name
+}
+
+type transport struct {
+	base       http.RoundTripper
+	tag        string
+	sessionTag bool
+}
+
+// NewTransport wraps base so the resources created through it are tagged with tag and, when
+// sessionTag is set, with the SessionTag of the MCP session of the request.
+func NewTransport(base http.RoundTripper, tag string, sessionTag bool) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base, tag: tag, sessionTag: sessionTag}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost || req.Body == nil || !slices.ContainsFunc(createPaths, func(p *regexp.Regexp) bool { return p.MatchString(req.URL.Path) }) {
+		return t.base.RoundTrip(req)
+	}
+	tags := []string{t.tag}
+	if t.sessionTag {
+		if session := server.ClientSessionFromContext(req.Context()); session != nil && session.SessionID() != "" {
+			tags = append(tags, SessionTag(session.SessionID()))
+		}
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	body = withTags(body, tags)
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+	req.ContentLength = int64(len(body))
+	return t.base.RoundTrip(req)
+}
+
+// withTags adds the tags body doesn't have yet to its "tags" field. A body that isn't a JSON
+// object is returned unchanged.
+func withTags(body []byte, tags []string) []byte {
+	// numbers are kept as they were written, so large IDs don't lose precision.
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var fields map[string]any
+	if dec.Decode(&fields) != nil || fields == nil {
+		return body
+	}
+	existing, _ := fields["tags"].([]any)
+	for _, tag := range tags {
+		if !slices.Contains(existing, any(tag)) {
+			existing = append(existing, tag)
+		}
+	}
+	fields["tags"] = existing
+	tagged, err := json.Marshal(fields)
+	if err != nil {
+		return body
+	}
+	return tagged
+}
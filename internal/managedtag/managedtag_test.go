@@ -0,0 +1,65 @@
+package managedtag
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+type session struct{ id string }
+
+func (s session) Initialize()                                         {}
+func (s session) Initialized() bool                                   { return true }
+func (s session) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (s session) SessionID() string                                   { return s.id }
+
+func TestTransport(t *testing.T) {
+	bodies := map[string]map[string]any{}
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &body)
+		bodies[r.Method+" "+r.URL.Path] = body
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer api.Close()
+
+	client, err := godo.New(&http.Client{Transport: NewTransport(nil, "mcp-managed", true)}, godo.SetBaseURL(api.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := server.NewMCPServer("test", "1.0")
+	ctx := srv.WithContext(context.Background(), session{id: "9b2f/stdio"})
+
+	_, _, _ = client.Droplets.Create(ctx, &godo.DropletCreateRequest{Name: "web", Tags: []string{"web", "mcp-managed"}})
+	_, _, _ = client.Storage.CreateVolume(ctx, &godo.VolumeCreateRequest{Name: "data", SizeGigaBytes: 10})
+	_, _, _ = client.Firewalls.Create(ctx, &godo.FirewallRequest{Name: "fw", Tags: []string{"web"}})
+
+	droplet := bodies["POST /v2/droplets"]
+	if got := droplet["tags"]; len(got.([]any)) != 3 || got.([]any)[2] != "mcp-session:9b2f_stdio" {
+		t.Errorf("droplet tags = %v, want web, mcp-managed and the session tag", got)
+	}
+	if got := bodies["POST /v2/volumes"]["tags"]; len(got.([]any)) != 2 || got.([]any)[0] != "mcp-managed" {
+		t.Errorf("volume tags = %v, want mcp-managed and the session tag", got)
+	}
+	// the tags of a firewall select the droplets it applies to, so they are left alone.
+	if got := bodies["POST /v2/firewalls"]["tags"]; len(got.([]any)) != 1 {
+		t.Errorf("firewall tags = %v, want only web", got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for tag, valid := range map[string]bool{"mcp-managed": true, "team:agents_1": true, "mcp managed": false, "": false, strings.Repeat("a", 256): false} {
+		if err := Validate(tag); (err == nil) != valid {
+			t.Errorf("Validate(%q) = %v, want valid %v", tag, err, valid)
+		}
+	}
+}
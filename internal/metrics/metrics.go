@@ -0,0 +1,238 @@
+// Package metrics counts tool calls and the DigitalOcean API requests they make, and serves the
+// counts in the Prometheus text exposition format, so operators of a shared server can see what
+// agents are doing.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Path is the path the metrics are served at.
+const Path = "/metrics"
+
+// durationBuckets are the upper bounds, in seconds, of the tool call latency histogram. Tools that
+// wait for actions to finish take minutes, so the buckets reach further than the usual defaults.
+var durationBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+type callKey struct {
+	tool    string
+	outcome string
+}
+
+type requestKey struct {
+	tool   string
+	method string
+	code   string
+}
+
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// Metrics holds the counters of one server.
+type Metrics struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	calls     map[callKey]uint64
+	durations map[string]*histogram
+	requests  map[requestKey]uint64
+	// rateLimit and rateRemaining are read from the most recent API response that carried them.
+	rateLimit     float64
+	rateRemaining float64
+	rateObserved  bool
+}
+
+// New returns empty metrics.
+func New() *Metrics {
+	return &Metrics{
+		now:       time.Now,
+		calls:     map[callKey]uint64{},
+		durations: map[string]*histogram{},
+		requests:  map[requestKey]uint64{},
+	}
+}
+
+type toolKey struct{}
+
+// call is what the transport needs to attribute a request to the tool call that made it.
+type call struct {
+	metrics *Metrics
+	tool    string
+}
+
+// Middleware counts each tool call by outcome and records its duration. A call fails when it
+// returns an error or an error result.
+func (m *Metrics) Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		tool := req.Params.Name
+		start := m.now()
+		res, err := next(context.WithValue(ctx, toolKey{}, &call{metrics: m, tool: tool}), req)
+		outcome := "success"
+		if err != nil || (res != nil && res.IsError) {
+			outcome = "error"
+		}
+		m.observeCall(tool, outcome, m.now().Sub(start))
+		return res, err
+	}
+}
+
+func (m *Metrics) observeCall(tool, outcome string, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls[callKey{tool: tool, outcome: outcome}]++
+	h, ok := m.durations[tool]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(durationBuckets))}
+		m.durations[tool] = h
+	}
+	seconds := d.Seconds()
+	for i, bound := range durationBuckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+func (m *Metrics) observeResponse(tool, method string, resp *http.Response) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[requestKey{tool: tool, method: method, code: strconv.Itoa(resp.StatusCode)}]++
+	limit, limitErr := strconv.ParseFloat(resp.Header.Get("Ratelimit-Limit"), 64)
+	remaining, remainingErr := strconv.ParseFloat(resp.Header.Get("Ratelimit-Remaining"), 64)
+	if limitErr == nil && remainingErr == nil {
+		m.rateLimit, m.rateRemaining, m.rateObserved = limit, remaining, true
+	}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps base so the API responses of tool calls are counted by the metrics of the
+// call. It sits below the retry layer, so every attempt is counted.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	c, ok := req.Context().Value(toolKey{}).(*call)
+	if !ok || err != nil {
+		return resp, err
+	}
+	c.metrics.observeResponse(c.tool, req.Method, resp)
+	return resp, nil
+}
+
+// Handler serves the metrics in the Prometheus text exposition format.
+func (m *Metrics) Handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		if r.Method == http.MethodHead {
+			return
+		}
+		m.Write(w)
+	}
+}
+
+// Write writes the metrics in the Prometheus text exposition format, with series in a stable order.
+func (m *Metrics) Write(w io.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fmt.Fprintln(w, "# HELP mcp_tool_calls_total Tool calls by tool and outcome (success or error).")
+	fmt.Fprintln(w, "# TYPE mcp_tool_calls_total counter")
+	calls := make([]callKey, 0, len(m.calls))
+	for k := range m.calls {
+		calls = append(calls, k)
+	}
+	sort.Slice(calls, func(i, j int) bool {
+		if calls[i].tool != calls[j].tool {
+			return calls[i].tool < calls[j].tool
+		}
+		return calls[i].outcome < calls[j].outcome
+	})
+	for _, k := range calls {
+		fmt.Fprintf(w, "mcp_tool_calls_total{tool=%s,outcome=%s} %d\n", quote(k.tool), quote(k.outcome), m.calls[k])
+	}
+
+	fmt.Fprintln(w, "# HELP mcp_tool_call_duration_seconds Duration of tool calls by tool.")
+	fmt.Fprintln(w, "# TYPE mcp_tool_call_duration_seconds histogram")
+	tools := make([]string, 0, len(m.durations))
+	for tool := range m.durations {
+		tools = append(tools, tool)
+	}
+	sort.Strings(tools)
+	for _, tool := range tools {
+		h := m.durations[tool]
+		for i, bound := range durationBuckets {
+			fmt.Fprintf(w, "mcp_tool_call_duration_seconds_bucket{tool=%s,le=%s} %d\n", quote(tool), quote(formatFloat(bound)), h.counts[i])
+		}
+		fmt.Fprintf(w, "mcp_tool_call_duration_seconds_bucket{tool=%s,le=\"+Inf\"} %d\n", quote(tool), h.count)
+		fmt.Fprintf(w, "mcp_tool_call_duration_seconds_sum{tool=%s} %s\n", quote(tool), formatFloat(h.sum))
+		fmt.Fprintf(w, "mcp_tool_call_duration_seconds_count{tool=%s} %d\n", quote(tool), h.count)
+	}
+
+	fmt.Fprintln(w, "# HELP digitalocean_api_requests_total DigitalOcean API requests of tool calls by tool, method and status code.")
+	fmt.Fprintln(w, "# TYPE digitalocean_api_requests_total counter")
+	requests := make([]requestKey, 0, len(m.requests))
+	for k := range m.requests {
+		requests = append(requests, k)
+	}
+	sort.Slice(requests, func(i, j int) bool {
+		a, b := requests[i], requests[j]
+		if a.tool != b.tool {
+			return a.tool < b.tool
+		}
+		if a.method != b.method {
+			return a.method < b.method
+		}
+		return a.code < b.code
+	})
+	for _, k := range requests {
+		fmt.Fprintf(w, "digitalocean_api_requests_total{tool=%s,method=%s,code=%s} %d\n", quote(k.tool), quote(k.method), quote(k.code), m.requests[k])
+	}
+
+	if m.rateObserved {
+		fmt.Fprintln(w, "# HELP digitalocean_api_rate_limit Requests per hour the token of the most recent API response may make.")
+		fmt.Fprintln(w, "# TYPE digitalocean_api_rate_limit gauge")
+		fmt.Fprintf(w, "digitalocean_api_rate_limit %s\n", formatFloat(m.rateLimit))
+		fmt.Fprintln(w, "# HELP digitalocean_api_rate_limit_remaining Requests left in the current window of the token of the most recent API response.")
+		fmt.Fprintln(w, "# TYPE digitalocean_api_rate_limit_remaining gauge")
+		fmt.Fprintf(w, "digitalocean_api_rate_limit_remaining %s\n", formatFloat(m.rateRemaining))
+	}
+}
+
+// quote formats a label value, escaping backslashes, quotes and newlines.
+func quote(v string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v) + `"`
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,112 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func callTool(t *testing.T, m *Metrics, name string, handler func(ctx context.Context) (*mcp.CallToolResult, error)) {
+	t.Helper()
+	req := mcp.CallToolRequest{}
+	req.Params.Name = name
+	_, _ = m.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handler(ctx)
+	})(context.Background(), req)
+}
+
+func TestMiddleware_countsCalls(t *testing.T) {
+	m := New()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m.now = func() time.Time {
+		now = now.Add(300 * time.Millisecond)
+		return now
+	}
+
+	ok := func(ctx context.Context) (*mcp.CallToolResult, error) { return mcp.NewToolResultText("ok"), nil }
+	callTool(t, m, "droplet-list", ok)
+	callTool(t, m, "droplet-list", ok)
+	callTool(t, m, "droplet-list", func(ctx context.Context) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultError("api error"), nil
+	})
+	callTool(t, m, "droplet-get", func(ctx context.Context) (*mcp.CallToolResult, error) {
+		return nil, errors.New("no client")
+	})
+
+	var out strings.Builder
+	m.Write(&out)
+	for _, line := range []string{
+		`mcp_tool_calls_total{tool="droplet-get",outcome="error"} 1`,
+		`mcp_tool_calls_total{tool="droplet-list",outcome="error"} 1`,
+		`mcp_tool_calls_total{tool="droplet-list",outcome="success"} 2`,
+		`mcp_tool_call_duration_seconds_bucket{tool="droplet-list",le="0.25"} 0`,
+		`mcp_tool_call_duration_seconds_bucket{tool="droplet-list",le="0.5"} 3`,
+		`mcp_tool_call_duration_seconds_bucket{tool="droplet-list",le="+Inf"} 3`,
+		`mcp_tool_call_duration_seconds_count{tool="droplet-list"} 3`,
+	} {
+		if !strings.Contains(out.String(), line+"\n") {
+			t.Errorf("metrics do not contain %q:\n%s", line, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "digitalocean_api_rate_limit") {
+		t.Error("rate limit gauges are written before any API response was seen")
+	}
+}
+
+func TestTransport_countsRequestsOfToolCalls(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Ratelimit-Limit", "5000")
+		w.Header().Set("Ratelimit-Remaining", "4321")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	client := &http.Client{Transport: NewTransport(nil)}
+
+	get := func(ctx context.Context, path string) {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+path, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("GET %s error = %v", path, err)
+		}
+		resp.Body.Close()
+	}
+
+	m := New()
+	callTool(t, m, "droplet-get", func(ctx context.Context) (*mcp.CallToolResult, error) {
+		get(ctx, "/ok")
+		get(ctx, "/missing")
+		return mcp.NewToolResultText("ok"), nil
+	})
+	// requests outside of tool calls are not counted.
+	get(context.Background(), "/ok")
+
+	rec := httptest.NewRecorder()
+	m.Handler()(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Fatalf("Content-Type = %q", ct)
+	}
+	for _, line := range []string{
+		`digitalocean_api_requests_total{tool="droplet-get",method="GET",code="200"} 1`,
+		`digitalocean_api_requests_total{tool="droplet-get",method="GET",code="404"} 1`,
+		`digitalocean_api_rate_limit 5000`,
+		`digitalocean_api_rate_limit_remaining 4321`,
+	} {
+		if !strings.Contains(rec.Body.String(), line+"\n") {
+			t.Errorf("metrics do not contain %q:\n%s", line, rec.Body.String())
+		}
+	}
+}
+
+func TestQuote(t *testing.T) {
+	if got := quote("a\"b\\c\nd"); got != `"a\"b\\c\nd"` {
+		t.Fatalf("quote() = %s", got)
+	}
+}
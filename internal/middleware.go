@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -22,6 +23,36 @@ func WithAuthKey(ctx context.Context, auth string) context.Context {
 	return context.WithValue(ctx, AuthKey{}, auth)
 }
 
+// MetaTokenKey is the _meta field of a tools/call request that carries the caller's DigitalOcean
+// API token, for clients that cannot set an Authorization header per user.
+const MetaTokenKey = "digitalocean.com/token"
+
+// BearerToken returns the token of the "Bearer <token>" auth key in the context, or "" when there
+// is none.
+func BearerToken(ctx context.Context) string {
+	const prefix = "Bearer "
+	auth, _ := ctx.Value(AuthKey{}).(string)
+	auth = strings.TrimSpace(auth)
+	if len(auth) <= len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(auth[len(prefix):])
+}
+
+// MetaTokenMiddleware makes the token in the MetaTokenKey field of a tool call's _meta the call's
+// auth key. It takes precedence over the Authorization header, so a gateway holding one connection
+// can call tools with the token of each of its users.
+func MetaTokenMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if meta := req.Params.Meta; meta != nil {
+			if token, _ := meta.AdditionalFields[MetaTokenKey].(string); strings.TrimSpace(token) != "" {
+				ctx = WithAuthKey(ctx, "Bearer "+strings.TrimSpace(token))
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
 // ToolLoggingMiddleware is a middleware that logs tool errors.
 type ToolLoggingMiddleware struct {
 	Logger *slog.Logger
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		auth string
+		want string
+	}{
+		{auth: "Bearer abc", want: "abc"},
+		{auth: "bearer abc ", want: "abc"},
+		{auth: "Basic abc", want: ""},
+		{auth: "Bearer ", want: ""},
+		{auth: "", want: ""},
+	}
+	for _, tc := range tests {
+		if got := BearerToken(WithAuthKey(context.Background(), tc.auth)); got != tc.want {
+			t.Errorf("BearerToken(%q) = %q, want %q", tc.auth, got, tc.want)
+		}
+	}
+	if got := BearerToken(context.Background()); got != "" {
+		t.Errorf("BearerToken() without an auth key = %q, want empty", got)
+	}
+}
+
+func TestMetaTokenMiddleware(t *testing.T) {
+	var got string
+	handler := MetaTokenMiddleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		got = BearerToken(ctx)
+		return mcp.NewToolResultText("ok"), nil
+	})
+	headerCtx := WithAuthKey(context.Background(), "Bearer header-token")
+
+	tests := []struct {
+		name string
+		meta *mcp.Meta
+		want string
+	}{
+		{name: "No meta", want: "header-token"},
+		{name: "Meta without token", meta: &mcp.Meta{AdditionalFields: map[string]any{"other": "x"}}, want: "header-token"},
+		{name: "Meta token", meta: &mcp.Meta{AdditionalFields: map[string]any{MetaTokenKey: " user-token "}}, want: "user-token"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "droplet-list", Meta: tc.meta}}
+			if _, err := handler(headerCtx, req); err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("token = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
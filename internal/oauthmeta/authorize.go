@@ -0,0 +1,269 @@
+package oauthmeta
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidToken is returned by a TokenValidator for tokens that are unknown, expired or revoked.
+var ErrInvalidToken = errors.New("invalid token")
+
+// Principal is the caller a bearer token was issued to.
+type Principal struct {
+	// Subject identifies the caller, e.g. the account UUID or the sub claim.
+	Subject string
+	// Scopes are the scopes granted to the token, when the validator knows them.
+	Scopes []string
+	// Token is the bearer token the caller presented.
+	Token string
+}
+
+// TokenValidator validates the bearer tokens presented to this resource server.
+type TokenValidator interface {
+	Validate(ctx context.Context, token string) (*Principal, error)
+}
+
+// TokenProvider maps an authenticated principal to the DigitalOcean API token its requests use.
+type TokenProvider interface {
+	DOToken(ctx context.Context, p *Principal) (string, error)
+}
+
+type principalKey struct{}
+
+// PrincipalFromContext returns the principal authenticated by RequireValidBearer, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// RequireValidBearer is RequireBearer with token validation. Tokens rejected by v receive an
+// invalid_token challenge; accepted requests reach next with their Authorization header replaced
+// by the DigitalOcean token p maps the principal to, and with the principal in their context.
+func RequireValidBearer(next http.Handler, cfg ChallengeConfig, v TokenValidator, p TokenProvider) http.Handler {
+	return RequireBearer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, err := v.Validate(r.Context(), BearerToken(r))
+		if errors.Is(err, ErrInvalidToken) {
+			w.Header().Set("WWW-Authenticate", challenge(r, cfg)+`, error="invalid_token"`)
+			http.Error(w, "missing or invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			http.Error(w, "failed to validate bearer token", http.StatusServiceUnavailable)
+			return
+		}
+		doToken, err := p.DOToken(r.Context(), principal)
+		if err != nil {
+			http.Error(w, "no DigitalOcean token for this principal", http.StatusForbidden)
+			return
+		}
+
+		r = r.Clone(context.WithValue(r.Context(), principalKey{}, principal))
+		r.Header.Set("Authorization", "Bearer "+doToken)
+		next.ServeHTTP(w, r)
+	}), cfg)
+}
+
+// PassthroughProvider uses the presented token as the DigitalOcean token, which is what tokens
+// issued by DigitalOcean's authorization server are.
+type PassthroughProvider struct{}
+
+// DOToken returns the presented token.
+func (PassthroughProvider) DOToken(ctx context.Context, p *Principal) (string, error) {
+	return p.Token, nil
+}
+
+// StaticProvider maps principal subjects to DigitalOcean tokens, for authorization servers that
+// issue their own tokens.
+type StaticProvider map[string]string
+
+// LoadStaticProvider reads a StaticProvider from a JSON object of subjects to tokens.
+func LoadStaticProvider(path string) (StaticProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token map: %w", err)
+	}
+	var p StaticProvider
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("failed to parse token map %s: %w", path, err)
+	}
+	return p, nil
+}
+
+// DOToken returns the token mapped to the principal's subject.
+func (s StaticProvider) DOToken(ctx context.Context, p *Principal) (string, error) {
+	token, ok := s[p.Subject]
+	if !ok || token == "" {
+		return "", fmt.Errorf("no token for subject %q", p.Subject)
+	}
+	return token, nil
+}
+
+// AccountValidator validates DigitalOcean tokens by fetching the account they belong to.
+type AccountValidator struct {
+	// Endpoint is the DigitalOcean API endpoint, e.g. https://api.digitalocean.com.
+	Endpoint string
+	// Client sends the account requests; http.DefaultClient when nil.
+	Client *http.Client
+}
+
+// Validate returns the account UUID of the token as the principal's subject.
+func (a *AccountValidator) Validate(ctx context.Context, token string) (*Principal, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(a.Endpoint, "/")+"/v2/account", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := httpClient(a.Client).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch account: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return nil, ErrInvalidToken
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("failed to fetch account: status %d", resp.StatusCode)
+	}
+	var body struct {
+		Account struct {
+			UUID string `json:"uuid"`
+		} `json:"account"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode account: %w", err)
+	}
+	return &Principal{Subject: body.Account.UUID, Token: token}, nil
+}
+
+// IntrospectionValidator validates tokens with an RFC 7662 token introspection endpoint.
+type IntrospectionValidator struct {
+	// URL is the introspection endpoint of the authorization server.
+	URL string
+	// ClientID and ClientSecret authenticate this resource server to the endpoint.
+	ClientID     string
+	ClientSecret string
+	// Audience, when set, must be one of the token's audiences.
+	Audience string
+	// Client sends the introspection requests; http.DefaultClient when nil.
+	Client *http.Client
+}
+
+// Validate accepts active tokens, with their sub claim as the principal's subject.
+func (i *IntrospectionValidator) Validate(ctx context.Context, token string) (*Principal, error) {
+	form := url.Values{"token": {token}, "token_type_hint": {"access_token"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, i.URL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if i.ClientID != "" {
+		req.SetBasicAuth(i.ClientID, i.ClientSecret)
+	}
+	resp, err := httpClient(i.Client).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to introspect token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to introspect token: status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Active bool            `json:"active"`
+		Sub    string          `json:"sub"`
+		Scope  string          `json:"scope"`
+		Aud    json.RawMessage `json:"aud"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode introspection response: %w", err)
+	}
+	if !body.Active {
+		return nil, ErrInvalidToken
+	}
+	if i.Audience != "" && !hasAudience(body.Aud, i.Audience) {
+		return nil, ErrInvalidToken
+	}
+	return &Principal{Subject: body.Sub, Scopes: strings.Fields(body.Scope), Token: token}, nil
+}
+
+// hasAudience reports whether the aud claim, a string or an array of strings, contains audience.
+func hasAudience(raw json.RawMessage, audience string) bool {
+	var one string
+	if json.Unmarshal(raw, &one) == nil {
+		return one == audience
+	}
+	var many []string
+	if json.Unmarshal(raw, &many) == nil {
+		for _, a := range many {
+			if a == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func httpClient(c *http.Client) *http.Client {
+	if c == nil {
+		return http.DefaultClient
+	}
+	return c
+}
+
+type cachedPrincipal struct {
+	principal *Principal
+	expires   time.Time
+}
+
+// CachingValidator remembers the tokens its validator accepted for a while, so every request of a
+// session does not cost a round trip to the authorization server. Rejections are not cached.
+type CachingValidator struct {
+	validator TokenValidator
+	ttl       time.Duration
+	now       func() time.Time
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]cachedPrincipal
+}
+
+// NewCachingValidator caches the principals validated by v for ttl.
+func NewCachingValidator(v TokenValidator, ttl time.Duration) *CachingValidator {
+	return &CachingValidator{validator: v, ttl: ttl, now: time.Now, cache: map[[sha256.Size]byte]cachedPrincipal{}}
+}
+
+// Validate returns the cached principal of the token, or validates it.
+func (c *CachingValidator) Validate(ctx context.Context, token string) (*Principal, error) {
+	key := sha256.Sum256([]byte(token))
+	now := c.now()
+	c.mu.Lock()
+	entry, ok := c.cache[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.principal, nil
+	}
+
+	principal, err := c.validator.Validate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, e := range c.cache {
+		if !now.Before(e.expires) {
+			delete(c.cache, k)
+		}
+	}
+	c.cache[key] = cachedPrincipal{principal: principal, expires: now.Add(c.ttl)}
+	return principal, nil
+}
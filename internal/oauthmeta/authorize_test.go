@@ -0,0 +1,214 @@
+package oauthmeta
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeValidator struct {
+	calls     int
+	principal *Principal
+	err       error
+}
+
+func (f *fakeValidator) Validate(ctx context.Context, token string) (*Principal, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	p := *f.principal
+	p.Token = token
+	return &p, nil
+}
+
+func TestRequireValidBearer(t *testing.T) {
+	cfg := ChallengeConfig{Resource: "https://x.example.com"}
+	tests := []struct {
+		name       string
+		validator  *fakeValidator
+		provider   TokenProvider
+		wantStatus int
+		wantAuth   string
+	}{
+		{
+			name:       "Passthrough",
+			validator:  &fakeValidator{principal: &Principal{Subject: "account-1"}},
+			provider:   PassthroughProvider{},
+			wantStatus: http.StatusOK,
+			wantAuth:   "Bearer presented",
+		},
+		{
+			name:       "Mapped token",
+			validator:  &fakeValidator{principal: &Principal{Subject: "alice"}},
+			provider:   StaticProvider{"alice": "dop_v1_alice"},
+			wantStatus: http.StatusOK,
+			wantAuth:   "Bearer dop_v1_alice",
+		},
+		{
+			name:       "Unmapped subject",
+			validator:  &fakeValidator{principal: &Principal{Subject: "bob"}},
+			provider:   StaticProvider{"alice": "dop_v1_alice"},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Invalid token",
+			validator:  &fakeValidator{err: ErrInvalidToken},
+			provider:   PassthroughProvider{},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "Validation unavailable",
+			validator:  &fakeValidator{err: errors.New("connection refused")},
+			provider:   PassthroughProvider{},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotAuth, gotSubject string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotAuth = r.Header.Get("Authorization")
+				if p, ok := PrincipalFromContext(r.Context()); ok {
+					gotSubject = p.Subject
+				}
+			})
+			req := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+			req.Header.Set("Authorization", "Bearer presented")
+			rec := httptest.NewRecorder()
+			RequireValidBearer(next, cfg, tc.validator, tc.provider).ServeHTTP(rec, req)
+
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tc.wantStatus)
+			}
+			if gotAuth != tc.wantAuth {
+				t.Fatalf("Authorization reaching next = %q, want %q", gotAuth, tc.wantAuth)
+			}
+			if tc.wantStatus == http.StatusOK && gotSubject != tc.validator.principal.Subject {
+				t.Fatalf("principal subject = %q, want %q", gotSubject, tc.validator.principal.Subject)
+			}
+			if tc.wantStatus == http.StatusUnauthorized && !strings.Contains(rec.Header().Get("WWW-Authenticate"), `error="invalid_token"`) {
+				t.Fatalf("WWW-Authenticate = %q, want an invalid_token error", rec.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestRequireValidBearer_MissingToken(t *testing.T) {
+	validator := &fakeValidator{principal: &Principal{}}
+	h := RequireValidBearer(http.NotFoundHandler(), ChallengeConfig{Resource: "https://x.example.com"}, validator, PassthroughProvider{})
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/mcp", nil))
+	if rec.Code != http.StatusUnauthorized || validator.calls != 0 {
+		t.Fatalf("status = %d after %d validations, want 401 without validating", rec.Code, validator.calls)
+	}
+}
+
+func TestAccountValidator(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer good":
+			_, _ = w.Write([]byte(`{"account":{"uuid":"account-1"}}`))
+		case "Bearer flaky":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer api.Close()
+	v := &AccountValidator{Endpoint: api.URL}
+
+	p, err := v.Validate(context.Background(), "good")
+	if err != nil || p.Subject != "account-1" || p.Token != "good" {
+		t.Fatalf("Validate(good) = %+v, %v", p, err)
+	}
+	if _, err := v.Validate(context.Background(), "bad"); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Validate(bad) error = %v, want ErrInvalidToken", err)
+	}
+	if _, err := v.Validate(context.Background(), "flaky"); err == nil || errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Validate(flaky) error = %v, want a non-token error", err)
+	}
+}
+
+func TestIntrospectionValidator(t *testing.T) {
+	as := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, secret, _ := r.BasicAuth(); id != "mcp" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = r.ParseForm()
+		switch r.PostForm.Get("token") {
+		case "good":
+			_, _ = w.Write([]byte(`{"active":true,"sub":"alice","scope":"read write","aud":["https://mcp.example.com"]}`))
+		case "other-audience":
+			_, _ = w.Write([]byte(`{"active":true,"sub":"alice","aud":"https://other.example.com"}`))
+		default:
+			_, _ = w.Write([]byte(`{"active":false}`))
+		}
+	}))
+	defer as.Close()
+	v := &IntrospectionValidator{URL: as.URL, ClientID: "mcp", ClientSecret: "secret", Audience: "https://mcp.example.com"}
+
+	p, err := v.Validate(context.Background(), "good")
+	if err != nil || p.Subject != "alice" || len(p.Scopes) != 2 {
+		t.Fatalf("Validate(good) = %+v, %v", p, err)
+	}
+	for _, token := range []string{"other-audience", "revoked"} {
+		if _, err := v.Validate(context.Background(), token); !errors.Is(err, ErrInvalidToken) {
+			t.Fatalf("Validate(%s) error = %v, want ErrInvalidToken", token, err)
+		}
+	}
+}
+
+func TestCachingValidator(t *testing.T) {
+	inner := &fakeValidator{principal: &Principal{Subject: "account-1"}}
+	now := time.Unix(0, 0)
+	v := NewCachingValidator(inner, time.Minute)
+	v.now = func() time.Time { return now }
+
+	for range 3 {
+		if _, err := v.Validate(context.Background(), "good"); err != nil {
+			t.Fatalf("Validate() error = %v", err)
+		}
+	}
+	if inner.calls != 1 {
+		t.Fatalf("validations = %d, want 1 while cached", inner.calls)
+	}
+	now = now.Add(2 * time.Minute)
+	if _, err := v.Validate(context.Background(), "good"); err != nil || inner.calls != 2 {
+		t.Fatalf("validations = %d after expiry (err %v), want 2", inner.calls, err)
+	}
+
+	inner.err = ErrInvalidToken
+	for range 2 {
+		if _, err := v.Validate(context.Background(), "revoked"); !errors.Is(err, ErrInvalidToken) {
+			t.Fatalf("Validate(revoked) error = %v", err)
+		}
+	}
+	if inner.calls != 4 {
+		t.Fatalf("validations = %d, want rejections not to be cached", inner.calls)
+	}
+}
+
+func TestLoadStaticProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.json")
+	if err := os.WriteFile(path, []byte(`{"alice": "dop_v1_alice"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	p, err := LoadStaticProvider(path)
+	if err != nil {
+		t.Fatalf("LoadStaticProvider() error = %v", err)
+	}
+	if token, err := p.DOToken(context.Background(), &Principal{Subject: "alice"}); err != nil || token != "dop_v1_alice" {
+		t.Fatalf("DOToken(alice) = %q, %v", token, err)
+	}
+	if _, err := LoadStaticProvider(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Fatal("expected an error for a missing file")
+	}
+}
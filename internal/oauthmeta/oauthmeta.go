@@ -4,6 +4,8 @@
 //
 // The document is served at WellKnownPath and is used by MCP clients during the
 // OAuth flow to locate the authorization server and supported bearer methods.
+// RequireBearer and RequireValidBearer guard the MCP endpoint, challenging
+// requests without a valid token and mapping callers to DigitalOcean tokens.
 package oauthmeta
 
 import (
@@ -0,0 +1,147 @@
+package pagination
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// AllArg is the boolean argument that requests every page of a list.
+const AllArg = "All"
+
+const (
+	// MaxPages is the number of pages an All call reads at most.
+	MaxPages = 25
+	// allPerPage is the page size of an All call, the largest the API accepts.
+	allPerPage = 200
+)
+
+// AllSummary describes the pages an All call read.
+type AllSummary struct {
+	Pages     int    `json:"pages"`
+	Items     int    `json:"items"`
+	Truncated bool   `json:"truncated"`
+	Message   string `json:"message,omitempty"`
+}
+
+// AllResult is the JSON content appended to the result of an All call.
+type AllResult struct {
+	All AllSummary `json:"all"`
+}
+
+// items returns the elements of a result whose text is a JSON array.
+func items(res *mcp.CallToolResult) ([]any, bool) {
+	if len(res.Content) == 0 {
+		return nil, false
+	}
+	tc, ok := res.Content[0].(mcp.TextContent)
+	if !ok {
+		return nil, false
+	}
+	var list []any
+	if json.Unmarshal([]byte(tc.Text), &list) != nil {
+		return nil, false
+	}
+	return list, true
+}
+
+// fetchAll returns a handler that, when called with All: true, calls next for one page after
+// another and returns their items as one list. perPage is the page size to request, or 0 when
+// the tool does not take PerPage.
+func fetchAll(name string, perPage int, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := req.GetArguments()
+		if _, ok := args[AllArg]; !ok {
+			return next(ctx, req)
+		}
+		base := maps.Clone(args)
+		delete(base, AllArg)
+		if all, _ := args[AllArg].(bool); !all {
+			req.Params.Arguments = base
+			return next(ctx, req)
+		}
+
+		if perPage > 0 {
+			base["PerPage"] = float64(perPage)
+		}
+		all := []any{}
+		summary := AllSummary{}
+		structured := false
+		for page := 1; ; page++ {
+			pageArgs := maps.Clone(base)
+			pageArgs["Page"] = float64(page)
+			req.Params.Arguments = pageArgs
+			obs := &observation{}
+			res, err := next(context.WithValue(ctx, observationKey{}, obs), req)
+			if err != nil || res == nil || res.IsError {
+				return res, err
+			}
+			list, ok := items(res)
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("%s does not support %s: its result is not a list", name, AllArg)), nil
+			}
+			structured = structured || res.StructuredContent != nil
+			all = append(all, list...)
+			summary.Pages++
+
+			more := perPage > 0 && len(list) == perPage
+			obs.mu.Lock()
+			if len(obs.pages) == 1 {
+				more = obs.pages[0].HasMore
+			}
+			obs.mu.Unlock()
+			if !more || len(list) == 0 {
+				break
+			}
+			if summary.Pages == MaxPages {
+				summary.Truncated = true
+				summary.Message = fmt.Sprintf("stopped after %d pages; narrow the list with a filter or continue with Page %d", MaxPages, page+1)
+				break
+			}
+		}
+		summary.Items = len(all)
+
+		jsonItems, err := json.MarshalIndent(all, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: %w", err)
+		}
+		jsonSummary, err := json.MarshalIndent(AllResult{All: summary}, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: %w", err)
+		}
+		res := mcp.NewToolResultText(string(jsonItems))
+		if structured {
+			res.StructuredContent = all
+		}
+		res.Content = append(res.Content, mcp.NewTextContent(string(jsonSummary)))
+		return res, nil
+	}
+}
+
+// allPageSize returns the page size an All call requests from a tool, the largest its PerPage
+// argument allows, or 0 when it takes none.
+func allPageSize(props map[string]any) int {
+	schema, ok := props["PerPage"].(map[string]any)
+	if !ok {
+		return 0
+	}
+	if maximum, ok := schema["maximum"].(float64); ok && maximum >= 1 && maximum < allPerPage {
+		return int(maximum)
+	}
+	return allPerPage
+}
+
+// withAllArg returns tool with the All argument advertised.
+func withAllArg(tool mcp.Tool) mcp.Tool {
+	props := maps.Clone(tool.InputSchema.Properties)
+	props[AllArg] = map[string]any{
+		"type":        "boolean",
+		"description": fmt.Sprintf("Return every page of the list at once, reading at most %d pages, instead of one page", MaxPages),
+	}
+	tool.InputSchema.Properties = props
+	return tool
+}
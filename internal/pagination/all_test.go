@@ -0,0 +1,130 @@
+package pagination
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// listServer serves total droplets, pages of the requested size, and counts the requests it gets.
+func listServer(t *testing.T, total int, requests *int) *godo.Client {
+	t.Helper()
+	var api *httptest.Server
+	api = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		var droplets []godo.Droplet
+		for id := (page-1)*perPage + 1; id <= min(page*perPage, total); id++ {
+			droplets = append(droplets, godo.Droplet{ID: id})
+		}
+		body := map[string]any{"droplets": droplets, "meta": map[string]any{"total": total}}
+		if page*perPage < total {
+			body["links"] = map[string]any{"pages": map[string]any{"next": fmt.Sprintf("%s/v2/droplets?page=%d&per_page=%d", api.URL, page+1, perPage)}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(api.Close)
+	client := godo.NewFromToken("token")
+	if err := godo.SetBaseURL(api.URL)(client); err != nil {
+		t.Fatal(err)
+	}
+	client.HTTPClient.Transport = NewTransport(client.HTTPClient.Transport)
+	return client
+}
+
+func listTool(t *testing.T, client *godo.Client) server.ToolHandlerFunc {
+	t.Helper()
+	s := server.NewMCPServer("test", "1.0.0")
+	s.AddTool(mcp.NewTool("droplet-list", mcp.WithNumber("Page"), mcp.WithNumber("PerPage")),
+		func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			page, _ := req.GetArguments()["Page"].(float64)
+			perPage, _ := req.GetArguments()["PerPage"].(float64)
+			droplets, _, err := client.Droplets.List(ctx, &godo.ListOptions{Page: int(page), PerPage: int(perPage)})
+			if err != nil {
+				return mcp.NewToolResultErrorFromErr("api error", err), nil
+			}
+			data, _ := json.Marshal(droplets)
+			return mcp.NewToolResultText(string(data)), nil
+		})
+	Apply(s)
+	st := s.GetTool("droplet-list")
+	if _, ok := st.Tool.InputSchema.Properties[AllArg]; !ok {
+		t.Fatalf("%s is not advertised", AllArg)
+	}
+	return st.Handler
+}
+
+func call(t *testing.T, handler server.ToolHandlerFunc, args map[string]any) ([]godo.Droplet, *AllSummary) {
+	t.Helper()
+	res, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	if err != nil || res.IsError {
+		t.Fatalf("call failed: %v %+v", err, res)
+	}
+	var droplets []godo.Droplet
+	if err := json.Unmarshal([]byte(res.Content[0].(mcp.TextContent).Text), &droplets); err != nil {
+		t.Fatal(err)
+	}
+	var all AllResult
+	if json.Unmarshal([]byte(res.Content[len(res.Content)-1].(mcp.TextContent).Text), &all) != nil || all.All.Pages == 0 {
+		return droplets, nil
+	}
+	return droplets, &all.All
+}
+
+func TestFetchAll(t *testing.T) {
+	var requests int
+	handler := listTool(t, listServer(t, 450, &requests))
+
+	droplets, summary := call(t, handler, map[string]any{AllArg: true, "PerPage": float64(5)})
+	if len(droplets) != 450 || droplets[449].ID != 450 {
+		t.Fatalf("got %d droplets", len(droplets))
+	}
+	if summary == nil || summary.Pages != 3 || summary.Items != 450 || summary.Truncated {
+		t.Fatalf("summary = %+v", summary)
+	}
+	if requests != 3 {
+		t.Fatalf("requests = %d, want 3", requests)
+	}
+
+	droplets, summary = call(t, handler, map[string]any{AllArg: false, "Page": float64(2), "PerPage": float64(5)})
+	if len(droplets) != 5 || droplets[0].ID != 6 || summary != nil {
+		t.Fatalf("single page = %d droplets, summary %+v", len(droplets), summary)
+	}
+}
+
+func TestFetchAll_cap(t *testing.T) {
+	var requests int
+	handler := listTool(t, listServer(t, (MaxPages+1)*allPerPage, &requests))
+
+	droplets, summary := call(t, handler, map[string]any{AllArg: true})
+	if len(droplets) != MaxPages*allPerPage || requests != MaxPages {
+		t.Fatalf("got %d droplets in %d requests", len(droplets), requests)
+	}
+	if summary == nil || !summary.Truncated || summary.Message == "" {
+		t.Fatalf("summary = %+v", summary)
+	}
+}
+
+func TestFetchAll_notAList(t *testing.T) {
+	s := server.NewMCPServer("test", "1.0.0")
+	s.AddTool(mcp.NewTool("droplet-action", mcp.WithNumber("Page")),
+		func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText(`{"id":1}`), nil
+		})
+	Apply(s)
+
+	res, err := s.GetTool("droplet-action").Handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{AllArg: true}}})
+	if err != nil || !res.IsError {
+		t.Fatalf("expected an error result, got %v %+v", err, res)
+	}
+}
@@ -0,0 +1,185 @@
+// Package pagination tells the caller of a list tool whether more pages exist.
+//
+// List tools return the items of one page, which leaves a model to guess whether it has seen
+// everything. The Transport records the links and meta fields of the paginated responses a tool
+// call receives, and when the call made exactly one such request the Middleware appends its
+// pagination as JSON: the page and page size, the total, the next and last page numbers and the
+// page links. Apply installs the Middleware on the tools that take a page argument; calls that
+// read several pages, such as those made with All: true, get none.
+package pagination
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Pagination describes the page of a list a tool returned.
+type Pagination struct {
+	Page          int         `json:"page"`
+	PerPage       int         `json:"per_page,omitempty"`
+	Total         *int        `json:"total,omitempty"`
+	NextPage      int         `json:"next_page,omitempty"`
+	NextPageToken string      `json:"next_page_token,omitempty"`
+	LastPage      int         `json:"last_page,omitempty"`
+	HasMore       bool        `json:"has_more"`
+	Links         *godo.Pages `json:"links,omitempty"`
+}
+
+// Result is the JSON content appended to the result of a list tool.
+type Result struct {
+	Pagination Pagination `json:"pagination"`
+}
+
+type observationKey struct{}
+
+// observation collects the paginated responses of one tool call.
+type observation struct {
+	mu    sync.Mutex
+	pages []Pagination
+}
+
+func (o *observation) add(p Pagination) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.pages = append(o.pages, p)
+}
+
+// transport records the pagination of list responses received during a tool call.
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps base so the pagination of a list response can be added to the tool result.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	obs, ok := req.Context().Value(observationKey{}).(*observation)
+	if !ok || err != nil || req.Method != http.MethodGet || resp.StatusCode >= 300 || resp.Body == nil {
+		return resp, err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return resp, nil
+	}
+	if p, ok := parse(req.URL, data); ok {
+		obs.add(p)
+	}
+	return resp, nil
+}
+
+// parse derives the pagination of a list response from its links and meta fields.
+func parse(u *url.URL, data []byte) (Pagination, bool) {
+	var body struct {
+		Links *godo.Links `json:"links"`
+		Meta  *godo.Meta  `json:"meta"`
+	}
+	if json.Unmarshal(data, &body) != nil || body.Meta == nil && (body.Links == nil || body.Links.Pages == nil) {
+		return Pagination{}, false
+	}
+
+	p := Pagination{Page: 1}
+	query := u.Query()
+	if page, err := strconv.Atoi(query.Get("page")); err == nil && page > 0 {
+		p.Page = page
+	}
+	if perPage, err := strconv.Atoi(query.Get("per_page")); err == nil && perPage > 0 {
+		p.PerPage = perPage
+	}
+	if body.Meta != nil {
+		total := body.Meta.Total
+		p.Total = &total
+	}
+	if body.Links != nil && body.Links.Pages != nil {
+		pages := body.Links.Pages
+		p.Links = pages
+		p.HasMore = pages.Next != ""
+		p.NextPage = pageParam(pages.Next, "page")
+		p.LastPage = pageParam(pages.Last, "page")
+		if next, err := url.Parse(pages.Next); err == nil {
+			p.NextPageToken = next.Query().Get("page_token")
+		}
+	}
+	if p.LastPage == 0 && !p.HasMore {
+		p.LastPage = p.Page
+	}
+	return p, true
+}
+
+// pageParam returns the integer query parameter name of a page link, or 0.
+func pageParam(link, name string) int {
+	if link == "" {
+		return 0
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return 0
+	}
+	n, _ := strconv.Atoi(u.Query().Get(name))
+	return n
+}
+
+// Middleware appends the pagination of the one list request a successful tool call made to its result.
+func Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		obs := &observation{}
+		res, err := next(context.WithValue(ctx, observationKey{}, obs), req)
+		if err != nil || res == nil || res.IsError {
+			return res, err
+		}
+
+		obs.mu.Lock()
+		defer obs.mu.Unlock()
+		if len(obs.pages) != 1 {
+			return res, nil
+		}
+		jsonResult, err := json.MarshalIndent(Result{Pagination: obs.pages[0]}, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: %w", err)
+		}
+		res.Content = append(res.Content, mcp.NewTextContent(string(jsonResult)))
+		return res, nil
+	}
+}
+
+// pageArgs are the arguments that mark a tool as returning one page of a list.
+var pageArgs = []string{"Page", "PerPage", "PageToken"}
+
+// Apply installs the Middleware on every tool registered with s that takes a page argument, and
+// lets the tools that take Page be called with All: true.
+func Apply(s *server.MCPServer) {
+	var updated []server.ServerTool
+	for name, st := range s.ListTools() {
+		props := st.Tool.InputSchema.Properties
+		if !slices.ContainsFunc(pageArgs, func(name string) bool { _, ok := props[name]; return ok }) {
+			continue
+		}
+		tool, handler := st.Tool, st.Handler
+		if _, ok := props["Page"]; ok && tool.RawInputSchema == nil {
+			tool, handler = withAllArg(tool), fetchAll(name, allPageSize(props), handler)
+		}
+		updated = append(updated, server.ServerTool{Tool: tool, Handler: Middleware(handler)})
+	}
+	s.AddTools(updated...)
+}
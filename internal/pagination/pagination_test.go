@@ -0,0 +1,145 @@
+package pagination
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func setupClient(t *testing.T) *godo.Client {
+	t.Helper()
+	var api *httptest.Server
+	api = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/droplets":
+			_, _ = w.Write([]byte(`{"droplets":[{"id":1},{"id":2}],"links":{"pages":{"next":"` + api.URL +
+				`/v2/droplets?page=3&per_page=2","last":"` + api.URL + `/v2/droplets?page=5&per_page=2"}},"meta":{"total":10}}`))
+		case "/v2/regions":
+			_, _ = w.Write([]byte(`{"regions":[{"slug":"nyc3"}],"links":{},"meta":{"total":1}}`))
+		default:
+			_, _ = w.Write([]byte(`{"droplet":{"id":1}}`))
+		}
+	}))
+	t.Cleanup(api.Close)
+	client := godo.NewFromToken("token")
+	if err := godo.SetBaseURL(api.URL)(client); err != nil {
+		t.Fatal(err)
+	}
+	client.HTTPClient.Transport = NewTransport(client.HTTPClient.Transport)
+	return client
+}
+
+func pagination(t *testing.T, res *mcp.CallToolResult) *Pagination {
+	t.Helper()
+	if len(res.Content) < 2 {
+		return nil
+	}
+	var result Result
+	if err := json.Unmarshal([]byte(res.Content[len(res.Content)-1].(mcp.TextContent).Text), &result); err != nil {
+		t.Fatalf("pagination is not JSON: %v", err)
+	}
+	return &result.Pagination
+}
+
+func TestMiddleware(t *testing.T) {
+	client := setupClient(t)
+	tests := []struct {
+		name    string
+		handler func(ctx context.Context) *mcp.CallToolResult
+		want    *Pagination
+	}{
+		{
+			name: "More pages",
+			handler: func(ctx context.Context) *mcp.CallToolResult {
+				_, _, _ = client.Droplets.List(ctx, &godo.ListOptions{Page: 2, PerPage: 2})
+				return mcp.NewToolResultText("[]")
+			},
+			want: &Pagination{Page: 2, PerPage: 2, NextPage: 3, LastPage: 5, HasMore: true},
+		},
+		{
+			name: "Last page",
+			handler: func(ctx context.Context) *mcp.CallToolResult {
+				_, _, _ = client.Regions.List(ctx, nil)
+				return mcp.NewToolResultText("[]")
+			},
+			want: &Pagination{Page: 1, LastPage: 1},
+		},
+		{
+			name: "Not a list",
+			handler: func(ctx context.Context) *mcp.CallToolResult {
+				_, _, _ = client.Droplets.Get(ctx, 1)
+				return mcp.NewToolResultText("{}")
+			},
+		},
+		{
+			name: "Several pages read",
+			handler: func(ctx context.Context) *mcp.CallToolResult {
+				_, _, _ = client.Regions.List(ctx, nil)
+				_, _, _ = client.Droplets.List(ctx, nil)
+				return mcp.NewToolResultText("[]")
+			},
+		},
+		{
+			name: "Failed call",
+			handler: func(ctx context.Context) *mcp.CallToolResult {
+				_, _, _ = client.Regions.List(ctx, nil)
+				return mcp.NewToolResultError("boom")
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return tc.handler(ctx), nil
+			})
+			res, err := handler(context.Background(), mcp.CallToolRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := pagination(t, res)
+			if tc.want == nil {
+				if got != nil {
+					t.Fatalf("unexpected pagination %+v", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("missing pagination")
+			}
+			if got.Page != tc.want.Page || got.PerPage != tc.want.PerPage || got.NextPage != tc.want.NextPage ||
+				got.LastPage != tc.want.LastPage || got.HasMore != tc.want.HasMore || got.Total == nil {
+				t.Fatalf("pagination = %+v, want %+v with a total", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	observed := map[string]bool{}
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		_, ok := ctx.Value(observationKey{}).(*observation)
+		observed[req.Params.Name] = ok
+		return mcp.NewToolResultText("ok"), nil
+	}
+	s := server.NewMCPServer("test", "1.0.0")
+	s.AddTool(mcp.NewTool("droplet-list", mcp.WithNumber("Page")), handler)
+	s.AddTool(mcp.NewTool("droplet-get", mcp.WithNumber("ID")), handler)
+	Apply(s)
+
+	for name, want := range map[string]bool{"droplet-list": true, "droplet-get": false} {
+		req := mcp.CallToolRequest{Params: mcp.CallToolParams{Name: name}}
+		if _, err := s.GetTool(name).Handler(context.Background(), req); err != nil {
+			t.Fatal(err)
+		}
+		if observed[name] != want {
+			t.Fatalf("%s observed = %v, want %v", name, observed[name], want)
+		}
+	}
+}
@@ -0,0 +1,500 @@
+// Package policy enforces the guardrails an operator configures for the tools that change
+// resources: the largest droplet size, the regions resources may be placed in, images that must
+// not be used and how many resources one MCP session may create. A call that breaks the policy
+// fails with a policy violation before any API request is sent.
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"mcp-digitalocean/internal/dryrun"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// sizesTTL is how long the droplet sizes max-droplet-size is compared with are reused.
+	sizesTTL = time.Hour
+	// sizesPageSize is the page size droplet sizes are listed with.
+	sizesPageSize = 200
+	// planCreateTool drafts a plan, which creates nothing until planApplyTool applies it.
+	planCreateTool = "plan-create"
+	planApplyTool  = "plan-apply"
+)
+
+// placement names the arguments of a tool holding the regions it creates or moves resources in,
+// the droplet sizes and the slugs and IDs of the images it uses. A path is a dot-separated list
+// of argument names, where a name ending in [] stands for each item of a list, e.g.
+// node_pools[].size. A path to a list of strings stands for each of them.
+type placement struct {
+	regions, sizes, images, imageIDs []string
+}
+
+// placements are the placements of the tools the policy checks the arguments of, by name. The
+// regions of other tools, such as the probe regions of uptime checks or the region of an
+// existing volume, don't place resources and aren't checked.
+var placements = map[string]placement{
+	"droplet-create":              {regions: []string{"Region"}, sizes: []string{"Size"}, images: []string{"ImageSlug"}, imageIDs: []string{"ImageID"}},
+	"droplet-create-multi-region": {regions: []string{"Regions"}, sizes: []string{"Size"}, images: []string{"ImageSlug"}, imageIDs: []string{"ImageID"}},
+	"droplet-clone":               {regions: []string{"Region"}, sizes: []string{"Size"}},
+	"droplet-migrate-region":      {regions: []string{"Region"}, sizes: []string{"Size"}},
+	"provision-web-droplet":       {regions: []string{"Region"}, sizes: []string{"Size"}, images: []string{"ImageSlug"}},
+	"snapshot-verify":             {regions: []string{"Region"}, sizes: []string{"Size"}},
+	"resize-droplet":              {sizes: []string{"Size"}},
+	"rebuild-droplet-by-slug":     {images: []string{"ImageSlug"}},
+	"rebuild-droplet":             {imageIDs: []string{"ImageID"}},
+	"restore-droplet":             {imageIDs: []string{"ImageID"}},
+	"image-create":                {regions: []string{"Region"}},
+	"image-action-transfer":       {regions: []string{"Region"}},
+	"volume-create":               {regions: []string{"Region"}},
+	"lb-create":                   {regions: []string{"Region"}},
+	"vpc-create":                  {regions: []string{"Region"}},
+	"reserved-ip-reserve":         {regions: []string{"Region"}},
+	"byoip-prefix-create":         {regions: []string{"Region"}},
+	"partner-attachment-create":   {regions: []string{"Region"}},
+	"nfs-file-share-create":       {regions: []string{"Region"}},
+	"db-cluster-create":           {regions: []string{"region"}},
+	"db-cluster-migrate":          {regions: []string{"region"}},
+	"db-replica-create":           {regions: []string{"region"}},
+	"db-restore-from-backup":      {regions: []string{"region"}},
+	"doks-create-cluster":         {regions: []string{"region"}, sizes: []string{"node_pools[].size"}},
+	"doks-create-nodepool":        {sizes: []string{"node_pool_create_request.size"}},
+	"doks-bootstrap":              {regions: []string{"Region"}, sizes: []string{"NodeSize"}},
+	"apps-create-app-from-spec":   {regions: []string{"spec.region"}},
+	"apps-update":                 {regions: []string{"update.request.spec.region"}},
+	"docr-create":                 {regions: []string{"Region"}},
+	"functions-create-namespace":  {regions: []string{"Region"}},
+	"dedicated-inference-create":  {regions: []string{"Region"}},
+	"genai-custom-models-import":  {regions: []string{"preferred_gpu_region"}},
+	"deploy-static-site":          {regions: []string{"Region"}},
+	planCreateTool: {
+		regions: []string{"Operations[].params.Region"},
+		sizes:   []string{"Operations[].params.Size"},
+		images:  []string{"Operations[].params.ImageSlug"},
+	},
+}
+
+// resourceCounts are the resources a call of the tools that create more than one resource, or
+// whose names don't say they create one, makes. Other tools with a create verb in their name
+// make one.
+var resourceCounts = map[string]func(args *common.Args) int{
+	"droplet-create-multi-region": func(args *common.Args) int { return len(args.Strings("Regions")) },
+	// the droplet, its firewall and its A record, and the SSH key registered for it.
+	"provision-web-droplet": func(args *common.Args) int {
+		if args.String("SSHPublicKey") != "" {
+			return 4
+		}
+		return 3
+	},
+	// the snapshot and the droplet made from it.
+	"droplet-clone":          func(*common.Args) int { return 2 },
+	"droplet-migrate-region": func(*common.Args) int { return 2 },
+	// the temporary droplet, destroyed once it is active.
+	"snapshot-verify":           func(*common.Args) int { return 1 },
+	"doks-bootstrap":            func(*common.Args) int { return 1 },
+	"cert-provision-for-domain": func(*common.Args) int { return 1 },
+	// the certificate, the CDN endpoint and the CNAME record.
+	"deploy-static-site": func(*common.Args) int { return 3 },
+}
+
+// Config is the policy file.
+type Config struct {
+	// MaxDropletSize is the slug of the largest droplet size allowed, e.g. s-4vcpu-8gb. Sizes with
+	// more vCPUs or memory are refused.
+	MaxDropletSize string `yaml:"max-droplet-size"`
+	// AllowedRegions are the regions resources may be created or moved to; any when empty.
+	AllowedRegions []string `yaml:"allowed-regions"`
+	// ForbiddenImages are glob patterns of the slugs, names and distributions of images that must
+	// not be used, e.g. "windows-*".
+	ForbiddenImages []string `yaml:"forbidden-images"`
+	// MaxResourcesPerSession caps the number of create calls one session may make; 0 is no cap.
+	MaxResourcesPerSession int `yaml:"max-resources-per-session"`
+}
+
+// LoadConfig reads a policy file in YAML or JSON. Unknown keys are rejected, so a misspelt
+// guardrail does not silently go unenforced.
+func LoadConfig(file string) (*Config, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+	var cfg Config
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse policy file %s: %w", file, err)
+	}
+	for _, pattern := range cfg.ForbiddenImages {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("policy file %s: forbidden image pattern %q: %w", file, pattern, err)
+		}
+	}
+	if cfg.MaxResourcesPerSession < 0 {
+		return nil, fmt.Errorf("policy file %s: max-resources-per-session must not be negative", file)
+	}
+	return &cfg, nil
+}
+
+// Violation is a tool call the policy refuses.
+type Violation struct {
+	Reason string
+}
+
+func (v *Violation) Error() string {
+	return "policy violation: " + v.Reason
+}
+
+func violationf(format string, args ...any) *Violation {
+	return &Violation{Reason: fmt.Sprintf(format, args...)}
+}
+
+// Enforcer checks the calls of mutating tools against a policy.
+type Enforcer struct {
+	cfg       Config
+	getClient func(ctx context.Context) (*godo.Client, error)
+	now       func() time.Time
+
+	mu        sync.Mutex
+	sizes     []godo.Size
+	sizesAt   time.Time
+	creations map[string]int
+	// plans are the resources the create operations of each drafted plan make, by plan ID.
+	plans map[string]int
+}
+
+// New returns the enforcer of cfg. getClient returns the client droplet sizes and images are
+// looked up with.
+func New(cfg Config, getClient func(ctx context.Context) (*godo.Client, error)) *Enforcer {
+	return &Enforcer{cfg: cfg, getClient: getClient, now: time.Now, creations: map[string]int{}, plans: map[string]int{}}
+}
+
+// Middleware refuses the calls of mutating tools that break the policy, dry runs included, and
+// counts the resources the successful calls of each session create. A plan is counted when it is
+// applied, by its create operations.
+func (e *Enforcer) Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if !dryrun.IsMutating(req.Params.Name) {
+			return next(ctx, req)
+		}
+		session := sessionID(ctx)
+		creates := e.creates(req.Params.Name, req.GetArguments())
+		if limit := e.cfg.MaxResourcesPerSession; creates > 0 && limit > 0 {
+			if created := e.created(session); created >= limit {
+				return mcp.NewToolResultError(violationf("this session has made the %d resources a session may create; clean up or start a new session",
+					limit).Error()), nil
+			} else if created+creates > limit {
+				return mcp.NewToolResultError(violationf("%s creates %d resources, more than the %d of the %d a session may create that are left; clean up or start a new session",
+					req.Params.Name, creates, limit-created, limit).Error()), nil
+			}
+		}
+		if v, err := e.check(ctx, placements[req.Params.Name], req.GetArguments()); err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to check the policy", err), nil
+		} else if v != nil {
+			return mcp.NewToolResultError(v.Error()), nil
+		}
+
+		res, err := next(ctx, req)
+		if err != nil || res == nil || res.IsError || dryrun.Active(ctx) {
+			return res, err
+		}
+		switch req.Params.Name {
+		case planCreateTool:
+			if id, operations, ok := planOf(res); ok {
+				e.mu.Lock()
+				e.plans[id] = createOperations(operations, false)
+				e.mu.Unlock()
+			}
+		case planApplyTool:
+			if _, operations, ok := planOf(res); ok {
+				creates = createOperations(operations, true)
+			}
+			e.mu.Lock()
+			delete(e.plans, req.GetString("PlanID", ""))
+			e.mu.Unlock()
+		}
+		if creates > 0 {
+			e.mu.Lock()
+			e.creations[session] += creates
+			e.mu.Unlock()
+		}
+		return res, err
+	}
+}
+
+func (e *Enforcer) created(session string) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.creations[session]
+}
+
+func sessionID(ctx context.Context) string {
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		return session.SessionID()
+	}
+	return ""
+}
+
+// creates returns how many resources a call of tool with args creates: its resourceCounts, one
+// for another create tool, none for plan-create, and the create operations of the plan for
+// plan-apply. A plan the enforcer didn't see drafted counts as one resource.
+func (e *Enforcer) creates(tool string, args map[string]any) int {
+	switch {
+	case tool == planCreateTool:
+		return 0
+	case tool == planApplyTool:
+		id, _ := args["PlanID"].(string)
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		if n, ok := e.plans[id]; ok {
+			return n
+		}
+		return 1
+	}
+	if count, ok := resourceCounts[tool]; ok {
+		return count(common.NewArgs(mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}}))
+	}
+	if isCreate(tool) {
+		return 1
+	}
+	return 0
+}
+
+// isCreate reports whether the tool or plan operation creates resources, by the create verb in
+// its name.
+func isCreate(name string) bool {
+	return slices.Contains(strings.Split(name, "-"), "create")
+}
+
+// planOperation is an operation of a plan as the plan tools return it.
+type planOperation struct {
+	Type   string `json:"type"`
+	Status string `json:"status"`
+}
+
+// planOf returns the ID and operations of the plan in the result of a plan tool.
+func planOf(res *mcp.CallToolResult) (string, []planOperation, bool) {
+	if len(res.Content) == 0 {
+		return "", nil, false
+	}
+	text, ok := res.Content[0].(mcp.TextContent)
+	if !ok {
+		return "", nil, false
+	}
+	var plan struct {
+		ID         string          `json:"id"`
+		Operations []planOperation `json:"operations"`
+	}
+	if json.Unmarshal([]byte(text.Text), &plan) != nil || plan.ID == "" {
+		return "", nil, false
+	}
+	return plan.ID, plan.Operations, true
+}
+
+// createOperations returns the number of create operations, only those that were applied when
+// applied is set.
+func createOperations(operations []planOperation, applied bool) int {
+	n := 0
+	for _, op := range operations {
+		if isCreate(op.Type) && (!applied || op.Status == "applied") {
+			n++
+		}
+	}
+	return n
+}
+
+// check returns the first violation of the arguments of a call of a tool with placement p.
+func (e *Enforcer) check(ctx context.Context, p placement, args map[string]any) (*Violation, error) {
+	var regions, sizes, imageSlugs []string
+	var imageIDs []int
+	for _, path := range p.regions {
+		regions = append(regions, stringsAt(args, path)...)
+	}
+	for _, path := range p.sizes {
+		sizes = append(sizes, stringsAt(args, path)...)
+	}
+	for _, path := range p.images {
+		imageSlugs = append(imageSlugs, stringsAt(args, path)...)
+	}
+	for _, path := range p.imageIDs {
+		for _, v := range valuesAt(args, path) {
+			// IDs are read like the handlers read them, numeric strings included, as arguments are
+			// only coerced once the call is past the policy.
+			id := common.NewArgs(mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{path: v}}})
+			if n := id.Number(path, 0); id.Err() != nil {
+				return violationf("image ID %v of %s is not a number, so it can't be checked against the forbidden images", v, path), nil
+			} else if n > 0 {
+				imageIDs = append(imageIDs, int(n))
+			}
+		}
+	}
+
+	if len(e.cfg.AllowedRegions) > 0 {
+		for _, region := range regions {
+			if !slices.Contains(e.cfg.AllowedRegions, region) {
+				return violationf("region %s is not allowed; use one of %s", region, strings.Join(e.cfg.AllowedRegions, ", ")), nil
+			}
+		}
+	}
+	for _, slug := range imageSlugs {
+		if pattern, ok := e.forbidden(slug); ok {
+			return violationf("image %s is forbidden (matches %q)", slug, pattern), nil
+		}
+	}
+	if len(imageIDs) > 0 && len(e.cfg.ForbiddenImages) > 0 {
+		client, err := e.getClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range imageIDs {
+			image, _, err := client.Images.GetByID(ctx, id)
+			if err != nil {
+				// an unknown image fails the call itself.
+				continue
+			}
+			for _, name := range []string{image.Slug, image.Name, image.Distribution} {
+				if pattern, ok := e.forbidden(name); ok {
+					return violationf("image %d (%s) is forbidden (matches %q)", id, image.Name, pattern), nil
+				}
+			}
+		}
+	}
+	if e.cfg.MaxDropletSize != "" && len(sizes) > 0 {
+		return e.checkSizes(ctx, sizes)
+	}
+	return nil, nil
+}
+
+// forbidden returns the forbidden image pattern name matches.
+func (e *Enforcer) forbidden(name string) (string, bool) {
+	if name == "" {
+		return "", false
+	}
+	for _, pattern := range e.cfg.ForbiddenImages {
+		if ok, _ := path.Match(pattern, name); ok {
+			return pattern, true
+		}
+	}
+	return "", false
+}
+
+// checkSizes refuses the droplet sizes larger than the max-droplet-size. Sizes that aren't
+// droplet sizes, such as database or load balancer sizes, are left alone.
+func (e *Enforcer) checkSizes(ctx context.Context, slugs []string) (*Violation, error) {
+	sizes, err := e.dropletSizes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	find := func(slug string) (godo.Size, bool) {
+		i := slices.IndexFunc(sizes, func(s godo.Size) bool { return s.Slug == slug })
+		if i < 0 {
+			return godo.Size{}, false
+		}
+		return sizes[i], true
+	}
+	limit, ok := find(e.cfg.MaxDropletSize)
+	if !ok {
+		return violationf("the max-droplet-size %s of the policy is not a droplet size, so no size is allowed", e.cfg.MaxDropletSize), nil
+	}
+	for _, slug := range slugs {
+		size, ok := find(slug)
+		if ok && (size.Vcpus > limit.Vcpus || size.Memory > limit.Memory) {
+			return violationf("size %s (%d vCPUs, %s memory) is larger than the largest size allowed, %s (%d vCPUs, %s memory)",
+				slug, size.Vcpus, memory(size.Memory), limit.Slug, limit.Vcpus, memory(limit.Memory)), nil
+		}
+	}
+	return nil, nil
+}
+
+func memory(mb int) string {
+	if mb%1024 == 0 {
+		return strconv.Itoa(mb/1024) + " GB"
+	}
+	return strconv.Itoa(mb) + " MB"
+}
+
+// dropletSizes returns the droplet sizes, listed at most once per sizesTTL.
+func (e *Enforcer) dropletSizes(ctx context.Context) ([]godo.Size, error) {
+	e.mu.Lock()
+	if e.sizes != nil && e.now().Sub(e.sizesAt) < sizesTTL {
+		sizes := e.sizes
+		e.mu.Unlock()
+		return sizes, nil
+	}
+	e.mu.Unlock()
+
+	client, err := e.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sizes, err := common.ListAll(ctx, sizesPageSize, client.Sizes.List)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	e.sizes, e.sizesAt = sizes, e.now()
+	e.mu.Unlock()
+	return sizes, nil
+}
+
+// valuesAt returns the values at the placement path in v.
+func valuesAt(v any, path string) []any {
+	if path == "" {
+		return []any{v}
+	}
+	key, rest, _ := strings.Cut(path, ".")
+	fields, ok := v.(map[string]any)
+	if !ok {
+		return nil
+	}
+	name, each := strings.CutSuffix(key, "[]")
+	value, ok := fields[name]
+	if !ok {
+		return nil
+	}
+	if !each {
+		return valuesAt(value, rest)
+	}
+	items, _ := value.([]any)
+	var values []any
+	for _, item := range items {
+		values = append(values, valuesAt(item, rest)...)
+	}
+	return values
+}
+
+// stringsAt returns the non-empty strings at the placement path in v.
+func stringsAt(v any, path string) []string {
+	var out []string
+	for _, value := range valuesAt(v, path) {
+		switch value := value.(type) {
+		case string:
+			if value != "" {
+				out = append(out, value)
+			}
+		case []any:
+			for _, item := range value {
+				if s, ok := item.(string); ok && s != "" {
+					out = append(out, s)
+				}
+			}
+		}
+	}
+	return out
+}
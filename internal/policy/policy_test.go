@@ -0,0 +1,242 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func newTestClient(t *testing.T) *godo.Client {
+	t.Helper()
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/sizes":
+			_, _ = w.Write([]byte(`{"sizes": [
+				{"slug": "s-1vcpu-1gb", "vcpus": 1, "memory": 1024},
+				{"slug": "s-4vcpu-8gb", "vcpus": 4, "memory": 8192},
+				{"slug": "m-2vcpu-16gb", "vcpus": 2, "memory": 16384}
+			], "links": {}, "meta": {"total": 3}}`))
+		case "/v2/images/42":
+			_, _ = w.Write([]byte(`{"image": {"id": 42, "name": "Windows Server 2022", "distribution": "windows"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(api.Close)
+	client := godo.NewFromToken("token")
+	if err := godo.SetBaseURL(api.URL)(client); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	write := func(content string) string {
+		file := filepath.Join(dir, "policy.yaml")
+		if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+
+	cfg, err := LoadConfig(write("max-droplet-size: s-4vcpu-8gb\nallowed-regions: [nyc3, ams3]\nforbidden-images: [\"windows*\"]\nmax-resources-per-session: 5\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.MaxDropletSize != "s-4vcpu-8gb" || len(cfg.AllowedRegions) != 2 || cfg.MaxResourcesPerSession != 5 {
+		t.Errorf("LoadConfig() = %+v", cfg)
+	}
+	if _, err := LoadConfig(write("allowed-region: [nyc3]\n")); err == nil {
+		t.Error("a misspelt key was accepted")
+	}
+	if _, err := LoadConfig(write("forbidden-images: [\"[\"]\n")); err == nil {
+		t.Error("a malformed pattern was accepted")
+	}
+}
+
+func TestEnforcer(t *testing.T) {
+	client := newTestClient(t)
+	e := New(Config{
+		MaxDropletSize:         "s-4vcpu-8gb",
+		AllowedRegions:         []string{"nyc3", "ams3"},
+		ForbiddenImages:        []string{"windows*", "centos-*"},
+		MaxResourcesPerSession: 2,
+	}, func(ctx context.Context) (*godo.Client, error) { return client, nil })
+	var calls int
+	handler := e.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls++
+		return mcp.NewToolResultText("done"), nil
+	})
+	call := func(tool string, args map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Name = tool
+		req.Params.Arguments = args
+		res, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	violation := func(res *mcp.CallToolResult) string {
+		if !res.IsError {
+			return ""
+		}
+		return res.Content[0].(mcp.TextContent).Text
+	}
+
+	tests := []struct {
+		name string
+		tool string
+		args map[string]any
+		want string
+	}{
+		{name: "Allowed droplet", tool: "resize-droplet", args: map[string]any{"Size": "s-1vcpu-1gb"}},
+		{name: "Region not allowed", tool: "droplet-create", args: map[string]any{"Region": "sfo3", "Size": "s-1vcpu-1gb"}, want: "region sfo3 is not allowed; use one of nyc3, ams3"},
+		{name: "Regions not allowed", tool: "droplet-create-multi-region", args: map[string]any{"Regions": []any{"nyc3", "sgp1"}, "Size": "s-1vcpu-1gb"}, want: "region sgp1 is not allowed"},
+		{name: "Size too large", tool: "resize-droplet", args: map[string]any{"Size": "m-2vcpu-16gb"}, want: "size m-2vcpu-16gb (2 vCPUs, 16 GB memory) is larger than the largest size allowed, s-4vcpu-8gb"},
+		{name: "Size of a nested node pool", tool: "doks-create-cluster", args: map[string]any{"region": "nyc3", "node_pools": []any{map[string]any{"size": "m-2vcpu-16gb"}}}, want: "larger than the largest size allowed"},
+		{name: "Node size of a bootstrapped cluster", tool: "doks-bootstrap", args: map[string]any{"Region": "nyc3", "NodeSize": "m-2vcpu-16gb"}, want: "larger than the largest size allowed"},
+		{name: "Size of a planned droplet", tool: "plan-create", args: map[string]any{"Operations": []any{map[string]any{"id": "web", "type": "droplet-create", "params": map[string]any{"Region": "nyc3", "Size": "m-2vcpu-16gb"}}}}, want: "larger than the largest size allowed"},
+		{name: "Database sizes are not droplet sizes", tool: "db-cluster-resize", args: map[string]any{"size": "db-s-8vcpu-32gb"}},
+		{name: "Probe regions of uptime checks are not checked", tool: "uptimecheck-update", args: map[string]any{"Regions": []any{"us_east", "eu_west"}}},
+		{name: "Forbidden image slug", tool: "rebuild-droplet-by-slug", args: map[string]any{"ImageSlug": "centos-7-x64"}, want: `image centos-7-x64 is forbidden (matches "centos-*")`},
+		{name: "Forbidden image ID", tool: "rebuild-droplet", args: map[string]any{"ImageID": float64(42)}, want: `image 42 (Windows Server 2022) is forbidden (matches "windows*")`},
+		{name: "Forbidden image ID as a string", tool: "restore-droplet", args: map[string]any{"ImageID": "42"}, want: `image 42 (Windows Server 2022) is forbidden`},
+		{name: "Image ID that is not a number", tool: "droplet-create", args: map[string]any{"Region": "nyc3", "Size": "s-1vcpu-1gb", "ImageID": "windows"}, want: `image ID windows of ImageID is not a number`},
+		{name: "Read-only tools are not checked", tool: "droplet-get", args: map[string]any{"Region": "sfo3"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := violation(call(tc.tool, tc.args)); !strings.Contains(got, tc.want) || (tc.want == "") != (got == "") {
+				t.Errorf("violation = %q, want %q", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("Resources per session", func(t *testing.T) {
+		// the create calls above were refused and don't count, and neither do plans until applied.
+		for i := 0; i < 2; i++ {
+			if got := violation(call("volume-create", map[string]any{"Region": "nyc3"})); got != "" {
+				t.Fatalf("create %d: violation = %q", i, got)
+			}
+		}
+		if got := violation(call("volume-create", map[string]any{"Region": "nyc3"})); !strings.Contains(got, "made the 2 resources a session may create") {
+			t.Errorf("third create: violation = %q", got)
+		}
+	})
+}
+
+func TestEnforcer_plans(t *testing.T) {
+	e := New(Config{MaxResourcesPerSession: 3}, nil)
+	handler := e.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		status := "pending"
+		if req.Params.Name == "plan-apply" {
+			status = "applied"
+		}
+		plan := fmt.Sprintf(`{"id": %q, "status": %q, "operations": [
+			{"id": "web", "type": "droplet-create", "status": %[2]q},
+			{"id": "data", "type": "volume-create", "status": %[2]q},
+			{"id": "attach", "type": "volume-attach", "status": %[2]q}
+		]}`, req.GetString("PlanID", "plan-1"), status)
+		return mcp.NewToolResultText(plan), nil
+	})
+	call := func(tool string, args map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Name = tool
+		req.Params.Arguments = args
+		res, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	// drafting a plan creates nothing, applying it creates its droplet and volume.
+	if res := call("plan-create", nil); res.IsError {
+		t.Fatalf("plan-create refused: %v", res.Content)
+	}
+	if res := call("plan-apply", map[string]any{"PlanID": "plan-1"}); res.IsError {
+		t.Fatalf("plan-apply refused: %v", res.Content)
+	}
+	if got := e.created(""); got != 2 {
+		t.Errorf("created = %d, want 2", got)
+	}
+
+	// a second plan of two creates doesn't fit in the one resource left.
+	call("plan-create", map[string]any{"PlanID": "plan-2"})
+	res := call("plan-apply", map[string]any{"PlanID": "plan-2"})
+	if !res.IsError || !strings.Contains(res.Content[0].(mcp.TextContent).Text, "plan-apply creates 2 resources, more than the 1 of the 3") {
+		t.Errorf("plan-apply over the cap = %v", res.Content)
+	}
+}
+
+func TestEnforcer_composites(t *testing.T) {
+	e := New(Config{MaxResourcesPerSession: 4}, nil)
+	handler := e.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("done"), nil
+	})
+	call := func(tool string, args map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{}
+		req.Params.Name = tool
+		req.Params.Arguments = args
+		res, err := handler(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	// a droplet in each region counts.
+	res := call("droplet-create-multi-region", map[string]any{"Regions": []any{"nyc3", "ams3", "sgp1", "fra1", "lon1"}})
+	if !res.IsError || !strings.Contains(res.Content[0].(mcp.TextContent).Text, "droplet-create-multi-region creates 5 resources") {
+		t.Errorf("five regions over a cap of 4 = %v", res.Content)
+	}
+	// so do the droplet, firewall and record of a web droplet, though the name has no create verb.
+	if res := call("provision-web-droplet", map[string]any{"Name": "web"}); res.IsError {
+		t.Fatalf("provision-web-droplet refused: %v", res.Content)
+	}
+	if got := e.created(""); got != 3 {
+		t.Errorf("created = %d, want 3", got)
+	}
+	// a clone makes a snapshot and a droplet, one more than is left.
+	res = call("droplet-clone", map[string]any{"ID": float64(1)})
+	if !res.IsError || !strings.Contains(res.Content[0].(mcp.TextContent).Text, "droplet-clone creates 2 resources") {
+		t.Errorf("droplet-clone over the cap = %v", res.Content)
+	}
+}
+
+func TestEnforcer_sessions(t *testing.T) {
+	e := New(Config{MaxResourcesPerSession: 1}, nil)
+	handler := e.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("done"), nil
+	})
+	srv := server.NewMCPServer("test", "1.0")
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "vpc-create"
+	for _, id := range []string{"a", "b"} {
+		ctx := srv.WithContext(context.Background(), session{id: id})
+		if res, _ := handler(ctx, req); res.IsError {
+			t.Errorf("session %s was refused its first create", id)
+		}
+	}
+}
+
+type session struct{ id string }
+
+func (s session) Initialize()                                         {}
+func (s session) Initialized() bool                                   { return true }
+func (s session) NotificationChannel() chan<- mcp.JSONRPCNotification { return nil }
+func (s session) SessionID() string                                   { return s.id }
@@ -0,0 +1,266 @@
+// Package profiles lets a local installation work with the API tokens of several accounts.
+//
+// A profile names where one account's token is read from, using the sources of the credentials
+// package. One profile is active at a time and every client handed to the tools is built with its
+// token; the account-profile-use tool switches the active profile for the rest of the session.
+package profiles
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"mcp-digitalocean/internal/credentials"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ListToolName and UseToolName are the names of the profile tools.
+	ListToolName = "account-profile-list"
+	UseToolName  = "account-profile-use"
+)
+
+// Profile says where the token of one account is read from. Tokens themselves are never part of
+// a profile, so the profiles file can be shared and checked in.
+type Profile struct {
+	// Source is the credentials source: env, file, doctl or keyring.
+	Source string `yaml:"source" json:"source"`
+	// Env is the variable of the env source, e.g. DIGITALOCEAN_TOKEN_PRODUCTION.
+	Env string `yaml:"env,omitempty" json:"env,omitempty"`
+	// File is the token file of the file source.
+	File string `yaml:"file,omitempty" json:"file,omitempty"`
+	// DoctlContext is the doctl auth context of the doctl source.
+	DoctlContext string `yaml:"doctl-context,omitempty" json:"doctl_context,omitempty"`
+	// KeyringAccount is the keyring account of the keyring source.
+	KeyringAccount string `yaml:"keyring-account,omitempty" json:"keyring_account,omitempty"`
+}
+
+// Config is the profiles file.
+type Config struct {
+	// Default is the profile that is active at startup.
+	Default  string             `yaml:"default"`
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+// LoadConfig reads a profiles file in YAML or JSON.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profiles file: %w", err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse profiles file %s: %w", path, err)
+	}
+	if len(cfg.Profiles) == 0 {
+		return nil, fmt.Errorf("profiles file %s defines no profiles", path)
+	}
+	for name, p := range cfg.Profiles {
+		// auto would read the same token for every profile.
+		if p.Source == "" || strings.EqualFold(p.Source, "auto") {
+			return nil, fmt.Errorf("profile %q: source must be one of env, file, doctl or keyring", name)
+		}
+		if _, err := credentials.New(p.Source, credentials.Options{}); err != nil {
+			return nil, fmt.Errorf("profile %q: %w", name, err)
+		}
+	}
+	return &cfg, nil
+}
+
+// ClientFactory creates a DigitalOcean client for a token.
+type ClientFactory func(ctx context.Context, token string) (*godo.Client, error)
+
+// Set holds the configured profiles and the active one.
+type Set struct {
+	profiles  map[string]Profile
+	opts      credentials.Options
+	newClient ClientFactory
+
+	mu      sync.Mutex
+	active  string
+	clients map[string]*godo.Client
+}
+
+// New creates the set of profiles in cfg, with active, or cfg.Default when empty, as the active
+// profile. opts locate the files shared by all profiles, like the doctl config.
+func New(cfg *Config, opts credentials.Options, active string, newClient ClientFactory) (*Set, error) {
+	if active == "" {
+		active = cfg.Default
+	}
+	s := &Set{profiles: cfg.Profiles, opts: opts, newClient: newClient, clients: map[string]*godo.Client{}}
+	if active == "" {
+		if len(cfg.Profiles) > 1 {
+			return nil, fmt.Errorf("select one of the profiles %s with --profile or a default in the profiles file", strings.Join(s.Names(), ", "))
+		}
+		active = s.Names()[0]
+	}
+	if _, ok := cfg.Profiles[active]; !ok {
+		return nil, fmt.Errorf("unknown profile %q: use one of %s", active, strings.Join(s.Names(), ", "))
+	}
+	s.active = active
+	return s, nil
+}
+
+// Names returns the sorted profile names.
+func (s *Set) Names() []string {
+	names := make([]string, 0, len(s.profiles))
+	for name := range s.profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Active returns the name of the active profile.
+func (s *Set) Active() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.active
+}
+
+// Token reads the token of the named profile.
+func (s *Set) Token(ctx context.Context, name string) (string, error) {
+	p, ok := s.profiles[name]
+	if !ok {
+		return "", fmt.Errorf("unknown profile %q: use one of %s", name, strings.Join(s.Names(), ", "))
+	}
+	opts := s.opts
+	opts.EnvVar = p.Env
+	if p.File != "" {
+		opts.File = p.File
+	}
+	if p.DoctlContext != "" {
+		opts.DoctlContext = p.DoctlContext
+	}
+	if p.KeyringAccount != "" {
+		opts.KeyringAccount = p.KeyringAccount
+	}
+	token, _, err := credentials.Lookup(ctx, p.Source, opts)
+	if err != nil {
+		return "", fmt.Errorf("profile %q: %w", name, err)
+	}
+	return token, nil
+}
+
+// Client returns the client of the active profile. Clients are created on first use and kept.
+func (s *Set) Client(ctx context.Context) (*godo.Client, error) {
+	return s.client(ctx, s.Active())
+}
+
+func (s *Set) client(ctx context.Context, name string) (*godo.Client, error) {
+	s.mu.Lock()
+	client, ok := s.clients[name]
+	s.mu.Unlock()
+	if ok {
+		return client, nil
+	}
+
+	token, err := s.Token(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	client, err = s.newClient(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clients[name] = client
+	return client, nil
+}
+
+// ProfileInfo is a profile as reported by account-profile-list.
+type ProfileInfo struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+	Profile
+}
+
+// AccountProfile is the result of account-profile-use: the profile and the account it reaches.
+type AccountProfile struct {
+	Profile     string `json:"profile"`
+	AccountUUID string `json:"account_uuid"`
+	Email       string `json:"email"`
+	Team        string `json:"team,omitempty"`
+}
+
+func (s *Set) listProfiles(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	active := s.Active()
+	infos := make([]ProfileInfo, 0, len(s.profiles))
+	for _, name := range s.Names() {
+		infos = append(infos, ProfileInfo{Name: name, Active: name == active, Profile: s.profiles[name]})
+	}
+	jsonResult, err := json.MarshalIndent(infos, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonResult)), nil
+}
+
+// useProfile makes the named profile active once its token has been checked against the API, so a
+// broken profile never replaces a working one.
+func (s *Set) useProfile(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, ok := req.GetArguments()["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Name is required"), nil
+	}
+	if _, ok := s.profiles[name]; !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("unknown profile %q: use one of %s", name, strings.Join(s.Names(), ", "))), nil
+	}
+
+	client, err := s.client(ctx, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("failed to read the profile's token", err), nil
+	}
+	account, _, err := client.Account.Get(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	s.mu.Lock()
+	s.active = name
+	s.mu.Unlock()
+
+	result := AccountProfile{Profile: name, AccountUUID: account.UUID, Email: account.Email}
+	if account.Team != nil {
+		result.Team = account.Team.Name
+	}
+	jsonResult, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonResult)), nil
+}
+
+// Tools returns the account-profile-list and account-profile-use tools.
+func (s *Set) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.listProfiles,
+			Tool: mcp.NewTool(ListToolName,
+				mcp.WithDescription("List the configured account profiles, where each reads its API token from, and which one is active. Every other tool acts on the account of the active profile."),
+				mcp.WithReadOnlyHintAnnotation(true),
+				mcp.WithDestructiveHintAnnotation(false),
+				mcp.WithIdempotentHintAnnotation(true),
+				mcp.WithOpenWorldHintAnnotation(false),
+			),
+		},
+		{
+			Handler: s.useProfile,
+			Tool: mcp.NewTool(UseToolName,
+				mcp.WithDescription("Switch the active account profile, e.g. from staging to production. The profile's token is checked first and the account it belongs to is returned; all following tool calls act on that account."),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the profile to activate, as listed by account-profile-list")),
+				mcp.WithDestructiveHintAnnotation(false),
+				mcp.WithIdempotentHintAnnotation(true),
+			),
+		},
+	}
+}
@@ -0,0 +1,181 @@
+package profiles
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"mcp-digitalocean/internal/credentials"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const profilesYAML = `default: staging
+profiles:
+  staging:
+    source: env
+    env: TEST_TOKEN_STAGING
+  production:
+    source: env
+    env: TEST_TOKEN_PRODUCTION
+  broken:
+    source: env
+    env: TEST_TOKEN_UNSET
+`
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "profiles.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// setupSet returns profiles whose clients reach an API that knows one account per token.
+func setupSet(t *testing.T) *Set {
+	t.Helper()
+	t.Setenv("TEST_TOKEN_STAGING", "staging-token")
+	t.Setenv("TEST_TOKEN_PRODUCTION", "production-token")
+	t.Setenv("TEST_TOKEN_UNSET", "")
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		email := map[string]string{
+			"Bearer staging-token":    "staging@example.com",
+			"Bearer production-token": "production@example.com",
+		}[r.Header.Get("Authorization")]
+		if email == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"account": map[string]any{"uuid": email, "email": email}})
+	}))
+	t.Cleanup(api.Close)
+
+	cfg, err := LoadConfig(writeConfig(t, profilesYAML))
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	set, err := New(cfg, credentials.Options{}, "", func(ctx context.Context, token string) (*godo.Client, error) {
+		client := godo.NewFromToken(token)
+		return client, godo.SetBaseURL(api.URL)(client)
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return set
+}
+
+func callTool(t *testing.T, set *Set, name string, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	for _, tool := range set.Tools() {
+		if tool.Tool.Name == name {
+			req := mcp.CallToolRequest{}
+			req.Params.Name = name
+			req.Params.Arguments = args
+			res, err := tool.Handler(context.Background(), req)
+			if err != nil {
+				t.Fatalf("%s error = %v", name, err)
+			}
+			return res
+		}
+	}
+	t.Fatalf("no tool %s", name)
+	return nil
+}
+
+func activeEmail(t *testing.T, set *Set) string {
+	t.Helper()
+	client, err := set.Client(context.Background())
+	if err != nil {
+		t.Fatalf("Client() error = %v", err)
+	}
+	account, _, err := client.Account.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Account.Get() error = %v", err)
+	}
+	return account.Email
+}
+
+func TestSet_useProfile(t *testing.T) {
+	set := setupSet(t)
+	if got := activeEmail(t, set); got != "staging@example.com" {
+		t.Fatalf("default profile reaches %s", got)
+	}
+
+	res := callTool(t, set, UseToolName, map[string]any{"Name": "production"})
+	if res.IsError {
+		t.Fatalf("account-profile-use failed: %v", res.Content)
+	}
+	var result AccountProfile
+	if err := json.Unmarshal([]byte(res.Content[0].(mcp.TextContent).Text), &result); err != nil {
+		t.Fatal(err)
+	}
+	if result.Profile != "production" || result.Email != "production@example.com" {
+		t.Fatalf("account-profile-use = %+v", result)
+	}
+	if got := activeEmail(t, set); got != "production@example.com" {
+		t.Fatalf("clients reach %s after the switch", got)
+	}
+
+	// profiles without a token or unknown to the set leave the active profile alone.
+	for _, name := range []string{"broken", "qa", ""} {
+		if res := callTool(t, set, UseToolName, map[string]any{"Name": name}); !res.IsError {
+			t.Fatalf("account-profile-use %q succeeded", name)
+		}
+	}
+	if set.Active() != "production" {
+		t.Fatalf("Active() = %s after failed switches", set.Active())
+	}
+}
+
+func TestSet_listProfiles(t *testing.T) {
+	set := setupSet(t)
+	res := callTool(t, set, ListToolName, nil)
+	var infos []ProfileInfo
+	if err := json.Unmarshal([]byte(res.Content[0].(mcp.TextContent).Text), &infos); err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 3 || infos[0].Name != "broken" || infos[2].Name != "staging" || !infos[2].Active || infos[0].Active {
+		t.Fatalf("account-profile-list = %+v", infos)
+	}
+	if strings.Contains(res.Content[0].(mcp.TextContent).Text, "staging-token") {
+		t.Fatal("account-profile-list leaks a token")
+	}
+}
+
+func TestLoadConfig_errors(t *testing.T) {
+	tests := map[string]string{
+		"no profiles":    "default: staging\n",
+		"missing source": "profiles:\n  staging: {env: X}\n",
+		"auto source":    "profiles:\n  staging: {source: auto}\n",
+		"unknown source": "profiles:\n  staging: {source: vault}\n",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := LoadConfig(writeConfig(t, content)); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestNew_activeProfile(t *testing.T) {
+	two := &Config{Profiles: map[string]Profile{"a": {Source: "env"}, "b": {Source: "env"}}}
+	if _, err := New(two, credentials.Options{}, "", nil); err == nil {
+		t.Fatal("expected an error without a default among several profiles")
+	}
+	if _, err := New(two, credentials.Options{}, "c", nil); err == nil {
+		t.Fatal("expected an error for an unknown profile")
+	}
+	one := &Config{Profiles: map[string]Profile{"only": {Source: "env"}}}
+	set, err := New(one, credentials.Options{}, "", nil)
+	if err != nil || set.Active() != "only" {
+		t.Fatalf("New() = %v, %v; want the only profile active", set, err)
+	}
+}
@@ -0,0 +1,144 @@
+// Package ratelimit paces the requests sent to the DigitalOcean API.
+//
+// The API allows a token 5,000 requests an hour and 250 a minute. An agent that lists and polls
+// aggressively can exhaust that in the middle of a workflow, after which every tool fails until
+// the window resets. The Limiter spaces out the requests of each token with a token bucket and
+// caps how many of them are in flight at once, so tools slow down instead of failing.
+package ratelimit
+
+import (
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+)
+
+// maxIdleLimits is the number of tokens tracked before the limits of idle tokens are dropped.
+const maxIdleLimits = 1024
+
+// idleTimeout is how long a token has to be unused before its limits may be dropped. A bucket
+// refills long before that, so a dropped limit is indistinguishable from a kept one.
+const idleTimeout = time.Hour
+
+// Limiter holds the rate and concurrency limits of every token it has seen. The limits of one
+// token are shared by all of its clients, so they hold across tools and sessions.
+type Limiter struct {
+	rate        rate.Limit
+	burst       int
+	maxInFlight int64
+	now         func() time.Time
+
+	mu     sync.Mutex
+	tokens map[[sha256.Size]byte]*tokenLimits
+}
+
+type tokenLimits struct {
+	bucket   *rate.Limiter
+	inFlight *semaphore.Weighted
+	lastUsed time.Time
+}
+
+// New creates a limiter allowing each token requestsPerSecond requests a second with bursts of
+// burst requests, and at most maxInFlight concurrent requests. A requestsPerSecond or maxInFlight
+// of zero or less disables that limit; New returns nil when both are disabled.
+func New(requestsPerSecond float64, burst int, maxInFlight int) *Limiter {
+	if requestsPerSecond <= 0 && maxInFlight <= 0 {
+		return nil
+	}
+	limit := rate.Inf
+	if requestsPerSecond > 0 {
+		limit = rate.Limit(requestsPerSecond)
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &Limiter{
+		rate:        limit,
+		burst:       burst,
+		maxInFlight: int64(maxInFlight),
+		now:         time.Now,
+		tokens:      map[[sha256.Size]byte]*tokenLimits{},
+	}
+}
+
+// limits returns the limits of token, dropping those of long idle tokens when many are tracked.
+func (l *Limiter) limits(token string) *tokenLimits {
+	key := sha256.Sum256([]byte(token))
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if t, ok := l.tokens[key]; ok {
+		t.lastUsed = now
+		return t
+	}
+	if len(l.tokens) >= maxIdleLimits {
+		for k, t := range l.tokens {
+			if now.Sub(t.lastUsed) > idleTimeout {
+				delete(l.tokens, k)
+			}
+		}
+	}
+	t := &tokenLimits{bucket: rate.NewLimiter(l.rate, l.burst), lastUsed: now}
+	if l.maxInFlight > 0 {
+		t.inFlight = semaphore.NewWeighted(l.maxInFlight)
+	}
+	l.tokens[key] = t
+	return t
+}
+
+// transport waits for the limits of its token before each request.
+type transport struct {
+	base   http.RoundTripper
+	limits *tokenLimits
+}
+
+// Transport wraps base so the requests it sends with token are paced by the limiter. It returns
+// base unchanged on a nil Limiter.
+func (l *Limiter) Transport(base http.RoundTripper, token string) http.RoundTripper {
+	if l == nil {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base, limits: l.limits(token)}
+}
+
+// RoundTrip implements http.RoundTripper. A request gives up waiting when its context ends.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limits.bucket.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	if t.limits.inFlight == nil {
+		return t.base.RoundTrip(req)
+	}
+	if err := t.limits.inFlight.Acquire(req.Context(), 1); err != nil {
+		return nil, err
+	}
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		t.limits.inFlight.Release(1)
+		return nil, err
+	}
+	// the request is in flight until its response has been read.
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: func() { t.limits.inFlight.Release(1) }}
+	return resp, nil
+}
+
+// releasingBody releases a slot of the in-flight cap once, when the body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+// Close implements io.Closer.
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,109 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func get(t *testing.T, rt http.RoundTripper, ctx context.Context, url string) error {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.Body.Close()
+}
+
+func TestNew_disabled(t *testing.T) {
+	if l := New(0, 10, 0); l != nil {
+		t.Fatalf("New(0, 10, 0) = %v, want nil", l)
+	}
+	var l *Limiter
+	if rt := l.Transport(http.DefaultTransport, "token"); rt != http.DefaultTransport {
+		t.Fatal("a nil Limiter should return the base transport")
+	}
+}
+
+func TestTransport_rate(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer api.Close()
+	l := New(20, 2, 0)
+
+	rt := l.Transport(nil, "token")
+	start := time.Now()
+	for range 4 {
+		if err := get(t, rt, context.Background(), api.URL); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// two requests are the burst, the other two wait 50ms each.
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Fatalf("4 requests took %s, want them paced", elapsed)
+	}
+
+	// the bucket is shared by every client of the token, but not with other tokens.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := get(t, l.Transport(nil, "other"), ctx, api.URL); err != nil {
+		t.Fatalf("another token waited: %v", err)
+	}
+	for range 2 {
+		_ = get(t, l.Transport(nil, "token"), context.Background(), api.URL)
+	}
+	if err := get(t, l.Transport(nil, "token"), ctx, api.URL); err == nil {
+		t.Fatal("expected a request of the exhausted token to give up at its deadline")
+	}
+}
+
+func TestTransport_maxInFlight(t *testing.T) {
+	var current, peak atomic.Int32
+	release := make(chan struct{})
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		<-release
+	}))
+	defer api.Close()
+	rt := New(0, 0, 2).Transport(nil, "token")
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := get(t, rt, context.Background(), api.URL); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if peak.Load() != 2 {
+		t.Fatalf("peak in-flight requests = %d, want 2", peak.Load())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := get(t, rt, ctx, api.URL); !errors.Is(err, context.Canceled) {
+		t.Fatalf("RoundTrip() error = %v, want the context error", err)
+	}
+}
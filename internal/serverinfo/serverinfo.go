@@ -0,0 +1,183 @@
+// Package serverinfo identifies this server: to the DigitalOcean API, by a User-Agent naming the
+// server's version and the MCP client it serves, and to clients, by the server-version tool.
+package serverinfo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// ToolName is the name of the tool reporting the server version.
+	ToolName = "server-version"
+	// maxClientLength caps the length of the client name and version in the User-Agent.
+	maxClientLength = 64
+)
+
+// Info is the result of the server-version tool.
+type Info struct {
+	Name            string  `json:"name"`
+	Version         string  `json:"version"`
+	ProtocolVersion string  `json:"protocol_version"`
+	GoVersion       string  `json:"go_version"`
+	GodoVersion     string  `json:"godo_version,omitempty"`
+	Transport       string  `json:"transport"`
+	UserAgent       string  `json:"user_agent"`
+	Client          *Client `json:"client,omitempty"`
+}
+
+// Client is the MCP client of the session, as it introduced itself in the initialize request.
+type Client struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// Server reports the version of the running server.
+type Server struct {
+	name, version, transport, userAgent string
+}
+
+// New returns the info of the server name at version, serving over transport. userAgent is the
+// product its API requests are sent with, to which godo and NewTransport add theirs.
+func New(name, version, transport, userAgent string) *Server {
+	return &Server{name: name, version: version, transport: transport, userAgent: userAgent}
+}
+
+// Tools returns the server-version tool.
+func (s *Server) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.serverVersion,
+			Tool: mcp.NewTool(ToolName,
+				mcp.WithDescription("Get the name and version of this MCP server, the MCP protocol version, the Go and godo versions it was built with, its transport, the User-Agent its DigitalOcean API requests are sent with and the MCP client it identified for this session. Include them when reporting a problem."),
+				mcp.WithReadOnlyHintAnnotation(true),
+				mcp.WithDestructiveHintAnnotation(false),
+				mcp.WithIdempotentHintAnnotation(true),
+				mcp.WithOpenWorldHintAnnotation(false),
+			),
+		},
+	}
+}
+
+func (s *Server) serverVersion(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	godoVersion := strings.TrimPrefix(moduleVersion("github.com/digitalocean/godo"), "v")
+	userAgent := s.userAgent
+	if godoVersion != "" {
+		// godo adds its own product to the User-Agent.
+		userAgent += " godo/" + godoVersion
+	}
+	client := ClientFromContext(ctx)
+	info := Info{
+		Name:            s.name,
+		Version:         s.version,
+		ProtocolVersion: mcp.LATEST_PROTOCOL_VERSION,
+		GoVersion:       runtime.Version(),
+		GodoVersion:     godoVersion,
+		Transport:       s.transport,
+		UserAgent:       withClient(userAgent, client),
+		Client:          client,
+	}
+	jsonResult, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonResult)), nil
+}
+
+// moduleVersion returns the version of the module path the binary was built with, or "".
+func moduleVersion(path string) string {
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, dep := range build.Deps {
+		if dep.Path == path {
+			if dep.Replace != nil {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+	return ""
+}
+
+// ClientFromContext returns the client of the MCP session of ctx, or nil when the session is
+// unknown or the client has not introduced itself, e.g. in stateless HTTP requests.
+func ClientFromContext(ctx context.Context) *Client {
+	session, ok := server.ClientSessionFromContext(ctx).(server.SessionWithClientInfo)
+	if !ok {
+		return nil
+	}
+	info := session.GetClientInfo()
+	if info.Name == "" {
+		return nil
+	}
+	return &Client{Name: info.Name, Version: info.Version}
+}
+
+// withClient adds a comment naming client after the first product of userAgent:
+// "mcp-digitalocean/1.0.61 (client claude-ai/0.1.0) godo/1.195.0".
+func withClient(userAgent string, client *Client) string {
+	if client == nil {
+		return userAgent
+	}
+	comment := "(client " + sanitize(client.Name)
+	if client.Version != "" {
+		comment += "/" + sanitize(client.Version)
+	}
+	comment += ")"
+	product, rest, found := strings.Cut(userAgent, " ")
+	if !found {
+		return product + " " + comment
+	}
+	return product + " " + comment + " " + rest
+}
+
+// sanitize keeps a client-supplied value from breaking out of the User-Agent comment.
+func sanitize(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			return '_'
+		case r < ' ' || r > '~':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(s))
+	if len(s) > maxClientLength {
+		s = s[:maxClientLength]
+	}
+	return s
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps base so API requests sent for an MCP session name its client in their
+// User-Agent, e.g. "mcp-digitalocean/1.0.61 (client claude-ai/0.1.0) godo/1.195.0".
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	client := ClientFromContext(req.Context())
+	if client == nil {
+		return t.base.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", withClient(req.Header.Get("User-Agent"), client))
+	return t.base.RoundTrip(req)
+}
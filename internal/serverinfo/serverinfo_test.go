@@ -0,0 +1,71 @@
+package serverinfo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// sessionContext returns a context of an MCP session whose client introduced itself as client.
+func sessionContext(t *testing.T, client mcp.Implementation) context.Context {
+	t.Helper()
+	srv := server.NewMCPServer("test", "0.0.0")
+	session := server.NewInProcessSession("session-1", nil)
+	session.SetClientInfo(client)
+	if err := srv.RegisterSession(context.Background(), session); err != nil {
+		t.Fatalf("RegisterSession() error = %v", err)
+	}
+	return srv.WithContext(context.Background(), session)
+}
+
+func TestTransport_namesTheClientInTheUserAgent(t *testing.T) {
+	var got string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+	}))
+	defer api.Close()
+	client := &http.Client{Transport: NewTransport(nil)}
+
+	for _, tc := range []struct {
+		ctx  context.Context
+		want string
+	}{
+		{context.Background(), "mcp-digitalocean/1.0.0 godo/1.195.0"},
+		{sessionContext(t, mcp.Implementation{Name: "claude-ai", Version: "0.1.0"}), "mcp-digitalocean/1.0.0 (client claude-ai/0.1.0) godo/1.195.0"},
+		{sessionContext(t, mcp.Implementation{Name: "evil) x/1 (\n"}), "mcp-digitalocean/1.0.0 (client evil_ x/1 _) godo/1.195.0"},
+	} {
+		req, _ := http.NewRequestWithContext(tc.ctx, http.MethodGet, api.URL, nil)
+		req.Header.Set("User-Agent", "mcp-digitalocean/1.0.0 godo/1.195.0")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("GET error = %v", err)
+		}
+		resp.Body.Close()
+		if got != tc.want {
+			t.Errorf("User-Agent = %q, want %q", got, tc.want)
+		}
+	}
+}
+
+func TestServerVersion_reportsTheVersionAndClient(t *testing.T) {
+	tool := New("mcp-digitalocean", "1.0.0", "stdio", "mcp-digitalocean/1.0.0").Tools()[0]
+	res, err := tool.Handler(sessionContext(t, mcp.Implementation{Name: "claude-ai", Version: "0.1.0"}), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("server-version error = %v", err)
+	}
+	var info Info
+	if err := json.Unmarshal([]byte(res.Content[0].(mcp.TextContent).Text), &info); err != nil {
+		t.Fatalf("result is not JSON: %v", err)
+	}
+	if info.Version != "1.0.0" || info.Transport != "stdio" || info.GoVersion == "" || info.ProtocolVersion == "" {
+		t.Fatalf("info = %+v", info)
+	}
+	if info.Client == nil || info.Client.Name != "claude-ai" || info.Client.Version != "0.1.0" {
+		t.Fatalf("client = %+v", info.Client)
+	}
+}
@@ -0,0 +1,188 @@
+package sessionstate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+)
+
+// kind is a kind of resource the store records, with how session-cleanup deletes it.
+type kind struct {
+	name string
+	// idKey is the field of the created resource holding its ID; "id" when empty.
+	idKey string
+	// nameKey is the field of the created resource holding its name; "name" when empty.
+	nameKey string
+	// parentArg is the argument of the create call naming the resource the created one belongs
+	// to, such as the cluster of a node pool. Its ID is then recorded as "<parent>/<id>".
+	parentArg string
+	delete    func(ctx context.Context, client *godo.Client, id string) (*godo.Response, error)
+}
+
+func (k kind) idField() string {
+	if k.idKey == "" {
+		return "id"
+	}
+	return k.idKey
+}
+
+func (k kind) nameField() string {
+	if k.nameKey == "" {
+		return "name"
+	}
+	return k.nameKey
+}
+
+// in returns the kind as the flat result of a composite tool reports it, with its ID and name
+// in the idKey and nameKey fields.
+func (k kind) in(idKey, nameKey string) kind {
+	k.idKey, k.nameKey = idKey, nameKey
+	return k
+}
+
+// withIntID adapts the delete function of a resource with a numeric ID.
+func withIntID(del func(ctx context.Context, client *godo.Client, id int) (*godo.Response, error)) func(context.Context, *godo.Client, string) (*godo.Response, error) {
+	return func(ctx context.Context, client *godo.Client, id string) (*godo.Response, error) {
+		n, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, err
+		}
+		return del(ctx, client, n)
+	}
+}
+
+// withParentID adapts the delete function of a resource recorded as "<parent>/<id>".
+func withParentID(del func(ctx context.Context, client *godo.Client, parent, id string) (*godo.Response, error)) func(context.Context, *godo.Client, string) (*godo.Response, error) {
+	return func(ctx context.Context, client *godo.Client, id string) (*godo.Response, error) {
+		parent, child, ok := strings.Cut(id, "/")
+		if !ok {
+			return nil, fmt.Errorf("malformed ID %q", id)
+		}
+		return del(ctx, client, parent, child)
+	}
+}
+
+var (
+	dropletKind = kind{name: "droplet", delete: withIntID(func(ctx context.Context, c *godo.Client, id int) (*godo.Response, error) {
+		return c.Droplets.Delete(ctx, id)
+	})}
+	volumeKind = kind{name: "volume", delete: func(ctx context.Context, c *godo.Client, id string) (*godo.Response, error) {
+		return c.Storage.DeleteVolume(ctx, id)
+	}}
+	snapshotKind = kind{name: "volume_snapshot", delete: func(ctx context.Context, c *godo.Client, id string) (*godo.Response, error) {
+		return c.Storage.DeleteSnapshot(ctx, id)
+	}}
+	imageKind = kind{name: "image", delete: withIntID(func(ctx context.Context, c *godo.Client, id int) (*godo.Response, error) {
+		return c.Images.Delete(ctx, id)
+	})}
+	loadBalancerKind = kind{name: "load_balancer", delete: func(ctx context.Context, c *godo.Client, id string) (*godo.Response, error) {
+		return c.LoadBalancers.Delete(ctx, id)
+	}}
+	firewallKind = kind{name: "firewall", delete: func(ctx context.Context, c *godo.Client, id string) (*godo.Response, error) {
+		return c.Firewalls.Delete(ctx, id)
+	}}
+	vpcKind = kind{name: "vpc", delete: func(ctx context.Context, c *godo.Client, id string) (*godo.Response, error) {
+		return c.VPCs.Delete(ctx, id)
+	}}
+	sshKeyKind = kind{name: "ssh_key", delete: withIntID(func(ctx context.Context, c *godo.Client, id int) (*godo.Response, error) {
+		return c.Keys.DeleteByID(ctx, id)
+	})}
+	domainKind = kind{name: "domain", idKey: "name", delete: func(ctx context.Context, c *godo.Client, name string) (*godo.Response, error) {
+		return c.Domains.Delete(ctx, name)
+	}}
+	domainRecordKind = kind{name: "domain_record", parentArg: "Domain", delete: withParentID(func(ctx context.Context, c *godo.Client, domain, id string) (*godo.Response, error) {
+		n, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, err
+		}
+		return c.Domains.DeleteRecord(ctx, domain, n)
+	})}
+	certificateKind = kind{name: "certificate", delete: func(ctx context.Context, c *godo.Client, id string) (*godo.Response, error) {
+		return c.Certificates.Delete(ctx, id)
+	}}
+	databaseKind = kind{name: "database", delete: func(ctx context.Context, c *godo.Client, id string) (*godo.Response, error) {
+		return c.Databases.Delete(ctx, id)
+	}}
+	databaseReplicaKind = kind{name: "database_replica", idKey: "name", parentArg: "id", delete: withParentID(func(ctx context.Context, c *godo.Client, cluster, name string) (*godo.Response, error) {
+		return c.Databases.DeleteReplica(ctx, cluster, name)
+	})}
+	kubernetesKind = kind{name: "kubernetes_cluster", delete: func(ctx context.Context, c *godo.Client, id string) (*godo.Response, error) {
+		return c.Kubernetes.Delete(ctx, id)
+	}}
+	nodePoolKind = kind{name: "kubernetes_node_pool", parentArg: "cluster_id", delete: withParentID(func(ctx context.Context, c *godo.Client, cluster, id string) (*godo.Response, error) {
+		return c.Kubernetes.DeleteNodePool(ctx, cluster, id)
+	})}
+	appKind = kind{name: "app", delete: func(ctx context.Context, c *godo.Client, id string) (*godo.Response, error) {
+		return c.Apps.Delete(ctx, id)
+	}}
+	uptimeCheckKind = kind{name: "uptime_check", delete: func(ctx context.Context, c *godo.Client, id string) (*godo.Response, error) {
+		return c.UptimeChecks.Delete(ctx, id)
+	}}
+	cdnKind = kind{name: "cdn", delete: func(ctx context.Context, c *godo.Client, id string) (*godo.Response, error) {
+		return c.CDNs.Delete(ctx, id)
+	}}
+)
+
+// createTools are the create tools whose resources are recorded, by name, with the kinds of
+// resource each creates in the order it creates them.
+var createTools = map[string][]kind{
+	"droplet-create":                  {dropletKind},
+	"droplet-create-multi-region":     {dropletKind},
+	"volume-create":                   {volumeKind},
+	"volume-snapshot-create":          {snapshotKind},
+	"image-create":                    {imageKind},
+	"lb-create":                       {loadBalancerKind},
+	"firewall-create":                 {firewallKind},
+	"firewall-create-from-template":   {firewallKind},
+	"vpc-create":                      {vpcKind},
+	"key-create":                      {sshKeyKind},
+	"domain-create":                   {domainKind},
+	"custom-certificate-create":       {certificateKind},
+	"lets-encrypt-certificate-create": {certificateKind},
+	"cert-provision-for-domain":       {certificateKind},
+	"db-cluster-create":               {databaseKind},
+	"db-replica-create":               {databaseReplicaKind},
+	"doks-create-cluster":             {kubernetesKind},
+	"doks-create-nodepool":            {nodePoolKind},
+	"doks-bootstrap":                  {kubernetesKind},
+	"apps-create-app-from-spec":       {appKind},
+	"uptimecheck-create":              {uptimeCheckKind},
+	"spaces-cdn-create":               {cdnKind},
+	"deploy-static-site":              {cdnKind},
+	"provision-web-droplet": {
+		sshKeyKind.in("ssh_key_id", ""),
+		dropletKind.in("droplet_id", "droplet_name"),
+		firewallKind.in("firewall_id", ""),
+		domainRecordKind.in("domain_record_id", ""),
+	},
+	"droplet-clone":          {imageKind.in("snapshot_id", "snapshot_name"), dropletKind.in("droplet_id", "droplet_name")},
+	"droplet-migrate-region": {imageKind.in("snapshot_id", "snapshot_name"), dropletKind.in("droplet_id", "droplet_name")},
+	// the resources of plan-apply are those of its applied operations.
+	"plan-apply": {},
+}
+
+// planOperationKinds are the kinds of resource the operations of a plan create, by operation type.
+var planOperationKinds = map[string]kind{
+	"droplet-create":    dropletKind,
+	"volume-create":     volumeKind,
+	"dns-record-create": domainRecordKind,
+}
+
+func kindsOfTool(tool string) ([]kind, bool) {
+	kinds, ok := createTools[tool]
+	return kinds, ok
+}
+
+func kindNamed(name string) (kind, bool) {
+	for _, kinds := range createTools {
+		for _, k := range kinds {
+			if k.name == name {
+				return k, true
+			}
+		}
+	}
+	return kind{}, false
+}
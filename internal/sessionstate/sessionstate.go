@@ -0,0 +1,285 @@
+// Package sessionstate remembers the resources the tools created for each MCP session in a state
+// file, so an agent run that was interrupted can find what it made with session-resources-list
+// and delete it with session-cleanup, even after the server restarted.
+//
+// The file holds one JSON document and is rewritten, through a temporary file, whenever a
+// resource is recorded or removed. Only the resources created with the same API token are listed
+// and cleaned up for a caller.
+package sessionstate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	middleware "mcp-digitalocean/internal"
+	"mcp-digitalocean/internal/audit"
+	"mcp-digitalocean/internal/dryrun"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// existingMetaKey is the _meta field create tools set when they returned an existing resource
+// instead of creating one, which is not recorded.
+const existingMetaKey = "digitalocean.com/existing"
+
+// Resource is a resource a tool call of a session created.
+type Resource struct {
+	SessionID string   `json:"session_id"`
+	Kind      string   `json:"kind"`
+	ID        string   `json:"id"`
+	Name      string   `json:"name,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+	// Tool is the tool whose call created the resource.
+	Tool      string    `json:"tool"`
+	CreatedAt time.Time `json:"created_at"`
+	// TokenFingerprint tells apart the API tokens resources were created with.
+	TokenFingerprint string `json:"token_fingerprint,omitempty"`
+}
+
+// state is the content of the state file.
+type state struct {
+	Resources []Resource `json:"resources"`
+}
+
+// Store records the resources created by tool calls in a state file.
+type Store struct {
+	path      string
+	getClient func(ctx context.Context) (*godo.Client, error)
+	now       func() time.Time
+
+	mu        sync.Mutex
+	resources []Resource
+}
+
+// Open returns the store of the state file at path, loading the resources it already records.
+// A missing file is created with the first resource. getClient returns the client session-cleanup
+// deletes resources with.
+func Open(path string, getClient func(ctx context.Context) (*godo.Client, error)) (*Store, error) {
+	s := &Store{path: path, getClient: getClient, now: time.Now}
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return s, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to read session state: %w", err)
+	}
+	var st state
+	if err := json.Unmarshal(data, &st); err != nil {
+		return nil, fmt.Errorf("failed to parse session state %s: %w", path, err)
+	}
+	s.resources = st.Resources
+	return s, nil
+}
+
+// owner identifies the session of ctx and the token its calls are made with.
+type owner struct {
+	sessionID   string
+	fingerprint string
+}
+
+func ownerFromContext(ctx context.Context) owner {
+	o := owner{fingerprint: audit.Fingerprint(middleware.BearerToken(ctx))}
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		o.sessionID = session.SessionID()
+	}
+	return o
+}
+
+// Middleware records the resources in the result of every successful call of a create tool of
+// kinds. Dry runs and results holding an existing resource are not recorded.
+func (s *Store) Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		res, err := next(ctx, req)
+		kinds, ok := kindsOfTool(req.Params.Name)
+		if !ok || err != nil || res == nil || res.IsError || dryrun.Active(ctx) || isExisting(res) {
+			return res, err
+		}
+		resources := resourcesOf(res, kinds, req.GetArguments())
+		if len(resources) == 0 {
+			return res, err
+		}
+		o := ownerFromContext(ctx)
+		for i := range resources {
+			resources[i].SessionID, resources[i].Tool, resources[i].CreatedAt, resources[i].TokenFingerprint = o.sessionID, req.Params.Name, s.now().UTC(), o.fingerprint
+		}
+		if saveErr := s.add(resources...); saveErr != nil {
+			res.Content = append(res.Content, mcp.NewTextContent(fmt.Sprintf(
+				"The resources were created, but recording them in the session state failed, so session-cleanup won't find them: %v", saveErr)))
+		}
+		return res, err
+	}
+}
+
+func isExisting(res *mcp.CallToolResult) bool {
+	if res.Meta == nil {
+		return false
+	}
+	existing, _ := res.Meta.AdditionalFields[existingMetaKey].(bool)
+	return existing
+}
+
+// resourcesOf returns the resources of kinds in res, the result of a call with args, whose
+// structured content or first text content is the created resource as JSON, holds it under the
+// kind's name, as cert-provision-for-domain does, lists the resources created under the kind's
+// name in the succeeded items of a batch, as droplet-create-multi-region does, or lists the
+// operations of a plan, as plan-apply does.
+func resourcesOf(res *mcp.CallToolResult, kinds []kind, args map[string]any) []Resource {
+	var data []byte
+	if res.StructuredContent != nil {
+		var err error
+		if data, err = json.Marshal(res.StructuredContent); err != nil {
+			return nil
+		}
+	} else if len(res.Content) > 0 {
+		text, ok := res.Content[0].(mcp.TextContent)
+		if !ok {
+			return nil
+		}
+		data = []byte(text.Text)
+	}
+	var fields map[string]any
+	if json.Unmarshal(data, &fields) != nil {
+		return nil
+	}
+	var resources []Resource
+	for _, k := range kinds {
+		resources = append(resources, resourcesOfKind(fields, k, args)...)
+	}
+	operations, _ := fields["operations"].([]any)
+	for _, op := range operations {
+		op, _ := op.(map[string]any)
+		k, ok := planOperationKinds[fmt.Sprint(op["type"])]
+		if !ok || op["status"] != "applied" {
+			continue
+		}
+		outputs, _ := op["outputs"].(map[string]any)
+		params, _ := op["params"].(map[string]any)
+		if r, ok := resourceOf(outputs, k, params); ok {
+			resources = append(resources, r)
+		}
+	}
+	return resources
+}
+
+// resourcesOfKind returns the resources of kind k in the fields of a result.
+func resourcesOfKind(fields map[string]any, k kind, args map[string]any) []Resource {
+	if r, ok := resourceOf(fields, k, args); ok {
+		return []Resource{r}
+	}
+	if created, ok := fields[k.name].(map[string]any); ok {
+		if r, ok := resourceOf(created, k, args); ok {
+			return []Resource{r}
+		}
+	}
+	succeeded, _ := fields["succeeded"].([]any)
+	var resources []Resource
+	for _, item := range succeeded {
+		item, _ := item.(map[string]any)
+		if created, ok := item[k.name].(map[string]any); ok {
+			if r, ok := resourceOf(created, k, args); ok {
+				resources = append(resources, r)
+			}
+		}
+	}
+	return resources
+}
+
+// resourceOf returns the resource of kind k with fields, created by a call with args.
+func resourceOf(fields map[string]any, k kind, args map[string]any) (Resource, bool) {
+	r := Resource{Kind: k.name}
+	switch id := fields[k.idField()].(type) {
+	case string:
+		r.ID = id
+	case float64:
+		r.ID = strconv.FormatInt(int64(id), 10)
+	}
+	if r.ID == "" {
+		return Resource{}, false
+	}
+	if k.parentArg != "" {
+		parent, _ := args[k.parentArg].(string)
+		if parent == "" {
+			return Resource{}, false
+		}
+		r.ID = parent + "/" + r.ID
+	}
+	r.Name, _ = fields[k.nameField()].(string)
+	tags, _ := fields["tags"].([]any)
+	for _, tag := range tags {
+		if tag, ok := tag.(string); ok {
+			r.Tags = append(r.Tags, tag)
+		}
+	}
+	return r, true
+}
+
+func (s *Store) add(resources ...Resource) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resources = append(s.resources, resources...)
+	return s.save()
+}
+
+// remove forgets the resources of done, e.g. once they were deleted.
+func (s *Store) remove(done []Resource) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resources = slices.DeleteFunc(s.resources, func(r Resource) bool {
+		return slices.ContainsFunc(done, func(d Resource) bool { return sameResource(r, d) })
+	})
+	return s.save()
+}
+
+func sameResource(a, b Resource) bool {
+	return a.Kind == b.Kind && a.ID == b.ID && a.TokenFingerprint == b.TokenFingerprint
+}
+
+// save writes the resources to a temporary file that then replaces the state file, so a crash
+// never leaves a truncated file behind. It is called with s.mu held.
+func (s *Store) save() error {
+	data, err := json.MarshalIndent(state{Resources: s.resources}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal error: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write session state: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write session state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write session state: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to write session state: %w", err)
+	}
+	return nil
+}
+
+// owned returns the resources created with the token of o, of o's session unless all is set, in
+// the order they were created.
+func (s *Store) owned(o owner, all bool) []Resource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var owned []Resource
+	for _, r := range s.resources {
+		if r.TokenFingerprint == o.fingerprint && (all || r.SessionID == o.sessionID) {
+			owned = append(owned, r)
+		}
+	}
+	return owned
+}
@@ -0,0 +1,245 @@
+package sessionstate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func call(t *testing.T, handler server.ToolHandlerFunc, name string, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	req := mcp.CallToolRequest{}
+	req.Params.Name = name
+	req.Params.Arguments = args
+	res, err := handler(context.Background(), req)
+	if err != nil {
+		t.Fatalf("%s: error = %v", name, err)
+	}
+	return res
+}
+
+func created(v any) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		data, _ := json.Marshal(v)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+}
+
+func TestStore_recordsCreatedResources(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	s, err := Open(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call(t, s.Middleware(created(godo.Droplet{ID: 12, Name: "web-1", Tags: []string{"run-7"}})), "droplet-create", nil)
+	call(t, s.Middleware(created(godo.Domain{Name: "example.com"})), "domain-create", nil)
+	// reads, failures and existing resources are not recorded.
+	call(t, s.Middleware(created(godo.Droplet{ID: 13})), "droplet-get", nil)
+	call(t, s.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultError("quota exceeded"), nil
+	}), "volume-create", nil)
+	call(t, s.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		res := mcp.NewToolResultText(`{"id": 14}`)
+		res.Meta = &mcp.Meta{AdditionalFields: map[string]any{existingMetaKey: true}}
+		return res, nil
+	}), "droplet-create", nil)
+
+	// the resources outlive the server.
+	reopened, err := Open(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := call(t, reopened.listResources, ListToolName, nil)
+	list := res.StructuredContent.(List)
+	if len(list.Resources) != 2 {
+		t.Fatalf("listed %+v, want 2 resources", list.Resources)
+	}
+	if r := list.Resources[0]; r.Kind != "droplet" || r.ID != "12" || r.Name != "web-1" || len(r.Tags) != 1 || r.Tool != "droplet-create" {
+		t.Errorf("first resource = %+v", r)
+	}
+	if r := list.Resources[1]; r.Kind != "domain" || r.ID != "example.com" {
+		t.Errorf("second resource = %+v", r)
+	}
+
+	// another session only sees its resources when it asks for all of them.
+	if res := call(t, reopened.listResources, ListToolName, map[string]any{"SessionID": "other"}); len(res.StructuredContent.(List).Resources) != 0 {
+		t.Errorf("session other listed %+v", res.StructuredContent)
+	}
+	if res := call(t, reopened.listResources, ListToolName, map[string]any{"SessionID": "other", "AllSessions": true}); len(res.StructuredContent.(List).Resources) != 2 {
+		t.Errorf("all sessions listed %+v", res.StructuredContent)
+	}
+}
+
+func TestStore_recordsNestedResources(t *testing.T) {
+	s, err := Open(filepath.Join(t.TempDir(), "state.json"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call(t, s.Middleware(created(map[string]any{
+		"succeeded": []any{
+			map[string]any{"region": "nyc3", "droplet": godo.Droplet{ID: 21, Name: "probe-nyc3"}},
+			map[string]any{"region": "ams3", "droplet": godo.Droplet{ID: 22, Name: "probe-ams3"}},
+		},
+		"failed": []any{map[string]any{"item": map[string]any{"region": "sgp1"}, "error": "out of capacity"}},
+	})), "droplet-create-multi-region", nil)
+
+	call(t, s.Middleware(created(map[string]any{
+		"domain":      "example.com",
+		"certificate": godo.Certificate{ID: "cert-1", Name: "example.com-letsencrypt"},
+	})), "cert-provision-for-domain", nil)
+
+	list := call(t, s.listResources, ListToolName, nil).StructuredContent.(List)
+	if len(list.Resources) != 3 || list.Resources[0].ID != "21" || list.Resources[1].Name != "probe-ams3" {
+		t.Errorf("listed %+v, want the droplets of nyc3 and ams3", list.Resources)
+	}
+	if r := list.Resources[2]; r.Kind != "certificate" || r.ID != "cert-1" {
+		t.Errorf("third resource = %+v, want the certificate", r)
+	}
+}
+
+func TestStore_recordsCompositeResources(t *testing.T) {
+	var mu sync.Mutex
+	var deleted []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		deleted = append(deleted, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer api.Close()
+	client := godo.NewFromToken("token")
+	if err := godo.SetBaseURL(api.URL)(client); err != nil {
+		t.Fatal(err)
+	}
+	s, err := Open(filepath.Join(t.TempDir(), "state.json"), func(ctx context.Context) (*godo.Client, error) { return client, nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	call(t, s.Middleware(created(map[string]any{
+		"succeeded": true, "droplet_id": 31, "droplet_name": "web", "firewall_id": "fw-1", "domain_record_id": 41,
+	})), "provision-web-droplet", map[string]any{"Name": "web", "Domain": "example.com"})
+	call(t, s.Middleware(created(map[string]any{
+		"succeeded": true, "source_droplet_id": 31, "snapshot_id": 51, "snapshot_name": "web-clone", "droplet_id": 32, "droplet_name": "web-2",
+	})), "droplet-clone", map[string]any{"ID": 31})
+	call(t, s.Middleware(created(godo.KubernetesNodePool{ID: "pool-1", Name: "workers"})), "doks-create-nodepool", map[string]any{"cluster_id": "k8s-1"})
+	call(t, s.Middleware(created(godo.DatabaseReplica{ID: "replica-1", Name: "read-1"})), "db-replica-create", map[string]any{"id": "db-1", "name": "read-1"})
+	call(t, s.Middleware(created(map[string]any{
+		"id": "plan-1", "status": "applied",
+		"operations": []any{
+			map[string]any{"id": "web", "type": "droplet-create", "status": "applied", "outputs": map[string]any{"id": 33, "name": "app"}},
+			map[string]any{"id": "attach", "type": "volume-attach", "status": "applied", "outputs": map[string]any{"action_id": 7}},
+			map[string]any{"id": "dns", "type": "dns-record-create", "status": "applied", "params": map[string]any{"Domain": "example.org"}, "outputs": map[string]any{"id": 42}},
+		},
+	})), "plan-apply", map[string]any{"PlanID": "plan-1"})
+
+	list := call(t, s.listResources, ListToolName, nil).StructuredContent.(List)
+	var got []string
+	for _, r := range list.Resources {
+		got = append(got, r.Kind+" "+r.ID+" "+r.Name)
+	}
+	want := []string{
+		"droplet 31 web", "firewall fw-1 ", "domain_record example.com/41 ",
+		"image 51 web-clone", "droplet 32 web-2",
+		"kubernetes_node_pool k8s-1/pool-1 workers",
+		"database_replica db-1/read-1 read-1",
+		"droplet 33 app", "domain_record example.org/42 ",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("recorded %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("recorded %q, want %q", got, want)
+			break
+		}
+	}
+
+	call(t, s.cleanup, CleanupToolName, map[string]any{"Kinds": []any{"domain_record", "kubernetes_node_pool", "database_replica"}})
+	wantDeleted := []string{
+		"DELETE /v2/domains/example.org/records/42",
+		"DELETE /v2/databases/db-1/replicas/read-1",
+		"DELETE /v2/kubernetes/clusters/k8s-1/node_pools/pool-1",
+		"DELETE /v2/domains/example.com/records/41",
+	}
+	if len(deleted) != len(wantDeleted) {
+		t.Fatalf("requests = %v, want %v", deleted, wantDeleted)
+	}
+	for i := range wantDeleted {
+		if deleted[i] != wantDeleted[i] {
+			t.Errorf("requests = %v, want %v", deleted, wantDeleted)
+			break
+		}
+	}
+}
+
+func TestStore_cleanup(t *testing.T) {
+	var mu sync.Mutex
+	var deleted []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		deleted = append(deleted, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/v2/volumes/vol-1":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"id": "not_found", "message": "The resource you requested could not be found."}`))
+		case "/v2/vpcs/vpc-1":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"id": "forbidden", "message": "VPC has members"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer api.Close()
+	client := godo.NewFromToken("token")
+	if err := godo.SetBaseURL(api.URL)(client); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "state.json")
+	s, err := Open(path, func(ctx context.Context) (*godo.Client, error) { return client, nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	call(t, s.Middleware(created(godo.VPC{ID: "vpc-1"})), "vpc-create", nil)
+	call(t, s.Middleware(created(godo.Volume{ID: "vol-1"})), "volume-create", nil)
+	call(t, s.Middleware(created(godo.Droplet{ID: 12})), "droplet-create", nil)
+
+	if res := call(t, s.cleanup, CleanupToolName, map[string]any{"Kinds": []any{"server"}}); !res.IsError {
+		t.Error("an unknown kind was accepted")
+	}
+
+	res := call(t, s.cleanup, CleanupToolName, nil)
+	result := res.StructuredContent.(Cleanup)
+	want := []string{"DELETE /v2/droplets/12", "DELETE /v2/volumes/vol-1", "DELETE /v2/vpcs/vpc-1"}
+	if len(deleted) != len(want) {
+		t.Fatalf("requests = %v, want %v", deleted, want)
+	}
+	for i := range want {
+		if deleted[i] != want[i] {
+			t.Errorf("requests = %v, want %v", deleted, want)
+			break
+		}
+	}
+	if len(result.Succeeded) != 1 || len(result.AlreadyGone) != 1 || len(result.Failed) != 1 || result.Failed[0].Item.ID != "vpc-1" {
+		t.Errorf("cleanup = %+v", result)
+	}
+
+	// the VPC that failed to delete stays recorded.
+	reopened, err := Open(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remaining := call(t, reopened.listResources, ListToolName, nil).StructuredContent.(List).Resources; len(remaining) != 1 || remaining[0].ID != "vpc-1" {
+		t.Errorf("remaining = %+v, want the VPC", remaining)
+	}
+}
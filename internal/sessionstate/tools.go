@@ -0,0 +1,155 @@
+package sessionstate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"mcp-digitalocean/internal/dryrun"
+	"mcp-digitalocean/internal/fanout"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// ListToolName is the name of the tool listing the resources of a session.
+	ListToolName = "session-resources-list"
+	// CleanupToolName is the name of the tool deleting the resources of a session.
+	CleanupToolName = "session-cleanup"
+)
+
+// List is the result of session-resources-list.
+type List struct {
+	// SessionID is the session whose resources are listed; empty when all sessions' are.
+	SessionID string     `json:"session_id,omitempty"`
+	Resources []Resource `json:"resources"`
+}
+
+// Cleanup is the result of session-cleanup: the resources deleted and those that failed to
+// delete, which stay recorded.
+type Cleanup struct {
+	fanout.Batch[Resource, Resource]
+	// AlreadyGone are the resources that had been deleted before, e.g. with their delete tool.
+	AlreadyGone []Resource `json:"already_gone"`
+}
+
+// Tools returns the session-resources-list and session-cleanup tools.
+func (s *Store) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.listResources,
+			Tool: mcp.NewTool(ListToolName,
+				mcp.WithDescription("List the resources that create tools made in this MCP session, or in every session with this API token, as recorded in the server's session state file. Use it after an interrupted run to find what it created; session IDs change when a client reconnects, so pass AllSessions then."),
+				mcp.WithString("SessionID", mcp.Description("Session whose resources to list. Defaults to the current session")),
+				mcp.WithBoolean("AllSessions", mcp.DefaultBool(false), mcp.Description("List the resources of every session made with this API token")),
+				mcp.WithReadOnlyHintAnnotation(true),
+				mcp.WithDestructiveHintAnnotation(false),
+				mcp.WithIdempotentHintAnnotation(true),
+				mcp.WithOpenWorldHintAnnotation(false),
+			),
+		},
+		{
+			Handler: s.cleanup,
+			Tool: mcp.NewTool(CleanupToolName,
+				mcp.WithDescription(fmt.Sprintf("Delete the resources that create tools made in this MCP session, or in another or every session with this API token, newest first, and forget them. "+
+					"Resources that fail to delete, e.g. a VPC whose droplets are still being destroyed, stay recorded, so call it again. Recorded kinds: %s.", strings.Join(kindNames(), ", "))),
+				mcp.WithString("SessionID", mcp.Description("Session whose resources to delete. Defaults to the current session")),
+				mcp.WithBoolean("AllSessions", mcp.DefaultBool(false), mcp.Description("Delete the resources of every session made with this API token")),
+				mcp.WithArray("Kinds", mcp.WithStringItems(), mcp.Description("Only delete resources of these kinds, e.g. [\"droplet\", \"volume\"]")),
+				mcp.WithDestructiveHintAnnotation(true),
+			),
+		},
+	}
+}
+
+// selected returns the resources of the session named by the SessionID and AllSessions arguments
+// of req, and that session.
+func (s *Store) selected(ctx context.Context, req mcp.CallToolRequest) ([]Resource, string) {
+	o := ownerFromContext(ctx)
+	if sessionID := strings.TrimSpace(req.GetString("SessionID", "")); sessionID != "" {
+		o.sessionID = sessionID
+	}
+	if req.GetBool("AllSessions", false) {
+		return s.owned(o, true), ""
+	}
+	return s.owned(o, false), o.sessionID
+}
+
+func (s *Store) listResources(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	resources, sessionID := s.selected(ctx, req)
+	if resources == nil {
+		resources = []Resource{}
+	}
+	return structured(List{SessionID: sessionID, Resources: resources})
+}
+
+func (s *Store) cleanup(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	kinds := req.GetStringSlice("Kinds", nil)
+	for _, name := range kinds {
+		if _, ok := kindNamed(name); !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: unknown kind %q; kinds are %s", name, strings.Join(kindNames(), ", "))), nil
+		}
+	}
+	resources, _ := s.selected(ctx, req)
+	if len(kinds) > 0 {
+		resources = slices.DeleteFunc(resources, func(r Resource) bool { return !slices.Contains(kinds, r.Kind) })
+	}
+
+	client, err := s.getClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	// resources are deleted newest first, so e.g. droplets go before the VPC they were created in.
+	result := Cleanup{Batch: fanout.NewBatch[Resource, Resource](), AlreadyGone: []Resource{}}
+	for _, r := range slices.Backward(resources) {
+		k, ok := kindNamed(r.Kind)
+		if !ok {
+			result.Fail(r, errors.New("resources of this kind can't be deleted by session-cleanup"))
+			continue
+		}
+		resp, err := k.delete(ctx, client, r.ID)
+		switch {
+		case err == nil:
+			result.Succeed(r)
+		case resp != nil && resp.StatusCode == http.StatusNotFound:
+			result.AlreadyGone = append(result.AlreadyGone, r)
+		default:
+			result.Fail(r, err)
+		}
+	}
+
+	// a dry run deleted nothing, so the resources stay recorded.
+	if !dryrun.Active(ctx) {
+		if err := s.remove(append(slices.Clone(result.Succeeded), result.AlreadyGone...)); err != nil {
+			return mcp.NewToolResultErrorFromErr("deleted the resources but failed to update the session state", err), nil
+		}
+	}
+	return structured(result)
+}
+
+func kindNames() []string {
+	var names []string
+	for _, kinds := range createTools {
+		for _, k := range kinds {
+			if !slices.Contains(names, k.name) {
+				names = append(names, k.name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func structured(v any) (*mcp.CallToolResult, error) {
+	jsonResult, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultStructured(v, string(jsonResult)), nil
+}
@@ -0,0 +1,174 @@
+package shape
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// DetailArg is the argument that chooses between a summary and the full objects of a list.
+	DetailArg = "Detail"
+	// DetailSummary returns one line per resource.
+	DetailSummary = "summary"
+	// DetailFull returns the full objects.
+	DetailFull = "full"
+
+	// SummaryThreshold is the number of items above which a list is summarized unless the call
+	// passes Detail or Fields.
+	SummaryThreshold = 50
+)
+
+// summaryKeys are the fields a summary line shows, in order, when an item has them.
+var summaryKeys = []string{"id", "uuid", "slug", "name", "status", "state", "region", "size_slug", "type"}
+
+// SummaryItem is a resource as listed in a summary.
+type SummaryItem struct {
+	Line string `json:"line"`
+	URI  string `json:"uri,omitempty"`
+}
+
+// Summary is the structured content of a summarized list.
+type Summary struct {
+	Items   []SummaryItem `json:"items"`
+	Count   int           `json:"count"`
+	Message string        `json:"message"`
+}
+
+// scalar returns v as summary text, taking the slug or name of an object such as a region.
+func scalar(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, v != ""
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return fmt.Sprint(v), true
+	case map[string]any:
+		for _, key := range []string{"slug", "name"} {
+			if s, ok := scalar(v[key]); ok {
+				return s, true
+			}
+		}
+	}
+	return "", false
+}
+
+// summaryLine returns the one-line summary of a list item.
+func summaryLine(item any) string {
+	obj, ok := item.(map[string]any)
+	if !ok {
+		data, _ := json.Marshal(item)
+		return string(data)
+	}
+	var parts []string
+	for _, key := range summaryKeys {
+		if s, ok := scalar(obj[key]); ok {
+			parts = append(parts, key+"="+s)
+		}
+	}
+	if len(parts) == 0 {
+		data, _ := json.Marshal(item)
+		return string(data)
+	}
+	return strings.Join(parts, " ")
+}
+
+// itemURI expands the {key} placeholders of template with the fields of item, or returns ""
+// when item lacks one of them.
+func itemURI(template string, item any) string {
+	obj, ok := item.(map[string]any)
+	if !ok || template == "" {
+		return ""
+	}
+	uri := template
+	for {
+		start := strings.Index(uri, "{")
+		if start < 0 {
+			return uri
+		}
+		end := strings.Index(uri[start:], "}")
+		if end < 0 {
+			return ""
+		}
+		value, ok := scalar(obj[uri[start+1:start+end]])
+		if !ok {
+			return ""
+		}
+		uri = uri[:start] + value + uri[start+end+1:]
+	}
+}
+
+// detail returns a handler that replaces a list result of next with one line per item and a
+// resource link per item that has a URI, when the Detail argument asks for a summary or is absent
+// and the list has more than SummaryThreshold items. template is the URI template of a single
+// item, such as "digitalocean://droplets/{id}", or "" when items have no resource.
+func detail(name, template string, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := req.GetArguments()
+		mode := ""
+		if raw, ok := args[DetailArg]; ok {
+			mode, _ = raw.(string)
+			stripped := maps.Clone(args)
+			delete(stripped, DetailArg)
+			req.Params.Arguments = stripped
+		}
+		if _, ok := args[FieldsArg]; ok && mode == "" {
+			// selected fields are already small.
+			mode = DetailFull
+		}
+
+		res, err := next(ctx, req)
+		if mode == DetailFull || err != nil || res == nil || res.IsError || len(res.Content) == 0 {
+			return res, err
+		}
+		tc, ok := res.Content[0].(mcp.TextContent)
+		if !ok {
+			return res, nil
+		}
+		var list []any
+		decoder := json.NewDecoder(bytes.NewReader([]byte(tc.Text)))
+		decoder.UseNumber()
+		if decoder.Decode(&list) != nil {
+			return res, nil
+		}
+		if mode != DetailSummary && len(list) <= SummaryThreshold {
+			return res, nil
+		}
+
+		summary := Summary{Items: make([]SummaryItem, 0, len(list)), Count: len(list)}
+		var lines []string
+		var links []mcp.Content
+		for _, item := range list {
+			entry := SummaryItem{Line: summaryLine(item), URI: itemURI(template, item)}
+			summary.Items = append(summary.Items, entry)
+			lines = append(lines, entry.Line)
+			if entry.URI != "" {
+				links = append(links, mcp.NewResourceLink(entry.URI, entry.Line, "Full details", "application/json"))
+			}
+		}
+		summary.Message = fmt.Sprintf("%d results of %s summarized; call it with %s: %q for the full objects", len(list), name, DetailArg, DetailFull)
+		if len(links) > 0 {
+			summary.Message += " or read the linked resources"
+		}
+
+		tc.Text = summary.Message + "\n" + strings.Join(lines, "\n")
+		res.Content = slices.Concat([]mcp.Content{tc}, links, res.Content[1:])
+		if res.StructuredContent != nil {
+			res.StructuredContent = summary
+		}
+		return res, nil
+	}
+}
+
+// isList reports whether the tool name has list as one of its dash-separated segments.
+func isList(name string) bool {
+	return slices.Contains(strings.Split(name, "-"), "list")
+}
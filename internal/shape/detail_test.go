@@ -0,0 +1,103 @@
+package shape
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func TestSummaryLine(t *testing.T) {
+	tests := []struct {
+		item string
+		want string
+	}{
+		{`{"id": 123456789, "name": "web-1", "status": "active", "region": {"slug": "nyc3", "name": "New York 3"}, "memory": 1024}`,
+			"id=123456789 name=web-1 status=active region=nyc3"},
+		{`{"slug": "s-1vcpu-1gb", "memory": 1024}`, "slug=s-1vcpu-1gb"},
+		{`{"memory": 1024}`, `{"memory":1024}`},
+		{`"nyc3"`, `"nyc3"`},
+	}
+	for _, tc := range tests {
+		var item any
+		decoder := json.NewDecoder(strings.NewReader(tc.item))
+		decoder.UseNumber()
+		if err := decoder.Decode(&item); err != nil {
+			t.Fatal(err)
+		}
+		if got := summaryLine(item); got != tc.want {
+			t.Fatalf("summaryLine(%s) = %q, want %q", tc.item, got, tc.want)
+		}
+	}
+}
+
+func TestApply_detail(t *testing.T) {
+	listing := func(n int) string {
+		items := make([]map[string]any, n)
+		for i := range items {
+			items[i] = map[string]any{"id": i + 1, "name": fmt.Sprintf("web-%d", i+1), "status": "active"}
+		}
+		data, _ := json.Marshal(items)
+		return string(data)
+	}
+	s := server.NewMCPServer("test", "1.0.0")
+	s.AddTool(mcp.NewTool("droplet-list", mcp.WithNumber("Count")), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if _, ok := req.GetArguments()[DetailArg]; ok {
+			t.Fatalf("%s reached the handler", DetailArg)
+		}
+		count, _ := req.GetArguments()["Count"].(float64)
+		return mcp.NewToolResultText(listing(int(count))), nil
+	})
+	s.AddTool(mcp.NewTool("droplet-get"), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(`{"id": 1}`), nil
+	})
+	Apply(s, false, map[string]string{"droplet-list": "digitalocean://droplets/{id}"})
+
+	if _, ok := s.GetTool("droplet-get").Tool.InputSchema.Properties[DetailArg]; ok {
+		t.Fatalf("%s advertised on a get tool", DetailArg)
+	}
+	list := s.GetTool("droplet-list")
+	tests := []struct {
+		name    string
+		args    map[string]any
+		summary bool
+	}{
+		{name: "Small list", args: map[string]any{"Count": float64(3)}},
+		{name: "Large list", args: map[string]any{"Count": float64(SummaryThreshold + 1)}, summary: true},
+		{name: "Large list in full", args: map[string]any{"Count": float64(SummaryThreshold + 1), DetailArg: DetailFull}},
+		{name: "Large list with fields", args: map[string]any{"Count": float64(SummaryThreshold + 1), FieldsArg: []any{"id"}}},
+		{name: "Small list summarized", args: map[string]any{"Count": float64(3), DetailArg: DetailSummary}, summary: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := list.Handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			if err != nil || res.IsError {
+				t.Fatalf("call failed: %v %+v", err, res)
+			}
+			count := int(tc.args["Count"].(float64))
+			text := res.Content[0].(mcp.TextContent).Text
+			if !tc.summary {
+				var items []any
+				if err := json.Unmarshal([]byte(text), &items); err != nil || len(items) != count || len(res.Content) != 1 {
+					t.Fatalf("expected %d full items, got %q", count, text)
+				}
+				return
+			}
+			lines := strings.Split(text, "\n")
+			if len(lines) != count+1 || lines[1] != "id=1 name=web-1 status=active" {
+				t.Fatalf("summary = %q", text)
+			}
+			if len(res.Content) != count+1 {
+				t.Fatalf("got %d contents, want a link per item", len(res.Content))
+			}
+			link, ok := res.Content[1].(mcp.ResourceLink)
+			if !ok || link.URI != "digitalocean://droplets/1" {
+				t.Fatalf("link = %+v", res.Content[1])
+			}
+		})
+	}
+}
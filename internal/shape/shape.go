@@ -0,0 +1,297 @@
+// Package shape lets the caller of a tool choose how much of the result it receives.
+//
+// Full godo objects are large, and most tasks need a few of their fields. Apply adds a Fields
+// argument to every list and get tool: an array of dot-separated JSON paths such as "id" or
+// "networks.v4". The result keeps only those paths; arrays along a path are traversed, so
+// "networks.v4.ip_address" keeps the address of every IPv4 network of every droplet listed.
+//
+// Apply also adds a Compact argument to every tool, which returns the JSON of the result
+// minified and without null or empty fields. The server can make compact output the default.
+//
+// List tools also take a Detail argument. Lists of more than SummaryThreshold items, or any list
+// called with Detail: "summary", come back as one line per resource and a resource link to the
+// full details of each, instead of hundreds of full objects; Detail: "full" returns them all.
+package shape
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// FieldsArg is the array argument that selects the fields of the result.
+	FieldsArg = "Fields"
+	// CompactArg is the boolean argument that requests compact JSON.
+	CompactArg = "Compact"
+)
+
+// readVerbs are the tool name segments that mark a tool as returning resources.
+var readVerbs = []string{"get", "list"}
+
+// IsRead reports whether the tool name contains a read verb as one of its dash-separated segments.
+func IsRead(name string) bool {
+	for _, segment := range strings.Split(name, "-") {
+		if slices.Contains(readVerbs, segment) {
+			return true
+		}
+	}
+	return false
+}
+
+// tree is a set of paths, keyed by their first segment. A nil subtree keeps the whole value.
+type tree map[string]tree
+
+// parsePaths builds the tree of the given dot-separated paths.
+func parsePaths(paths []string) tree {
+	root := tree{}
+	for _, p := range paths {
+		node := root
+		segments := strings.Split(strings.TrimSpace(p), ".")
+		for i, segment := range segments {
+			sub, seen := node[segment]
+			if seen && sub == nil {
+				// a shorter path already keeps the whole value.
+				break
+			}
+			if i == len(segments)-1 {
+				node[segment] = nil
+				break
+			}
+			if sub == nil {
+				sub = tree{}
+				node[segment] = sub
+			}
+			node = sub
+		}
+	}
+	return root
+}
+
+// Select returns the parts of v, a decoded JSON value, that the dot-separated paths name. Arrays
+// are traversed and values other than objects are kept as they are.
+func Select(v any, paths []string) any {
+	return selectTree(v, parsePaths(paths))
+}
+
+func selectTree(v any, t tree) any {
+	switch v := v.(type) {
+	case []any:
+		selected := make([]any, len(v))
+		for i, item := range v {
+			selected[i] = selectTree(item, t)
+		}
+		return selected
+	case map[string]any:
+		selected := map[string]any{}
+		for key, sub := range t {
+			value, ok := v[key]
+			if !ok {
+				continue
+			}
+			if sub == nil {
+				selected[key] = value
+			} else {
+				selected[key] = selectTree(value, sub)
+			}
+		}
+		return selected
+	default:
+		return v
+	}
+}
+
+// fields returns the paths of the Fields argument.
+func fields(v any) ([]string, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid arguments: %s must be an array of strings", FieldsArg)
+	}
+	paths := make([]string, 0, len(list))
+	for i, item := range list {
+		s, ok := item.(string)
+		if !ok || strings.TrimSpace(s) == "" {
+			return nil, fmt.Errorf("invalid arguments: %s[%d] must be a non-empty string", FieldsArg, i)
+		}
+		paths = append(paths, s)
+	}
+	return paths, nil
+}
+
+// selectFields returns a handler that selects the fields named by the Fields argument from the result of next.
+func selectFields(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := req.GetArguments()
+		raw, ok := args[FieldsArg]
+		if !ok {
+			return next(ctx, req)
+		}
+		stripped := maps.Clone(args)
+		delete(stripped, FieldsArg)
+		req.Params.Arguments = stripped
+		if raw == nil {
+			return next(ctx, req)
+		}
+		paths, err := fields(raw)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		res, err := next(ctx, req)
+		if err != nil || res == nil || res.IsError || len(res.Content) == 0 {
+			return res, err
+		}
+		tc, ok := res.Content[0].(mcp.TextContent)
+		if !ok {
+			return res, nil
+		}
+		var value any
+		if json.Unmarshal([]byte(tc.Text), &value) != nil {
+			return res, nil
+		}
+		selected := Select(value, paths)
+		jsonData, err := json.MarshalIndent(selected, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: %w", err)
+		}
+		tc.Text = string(jsonData)
+		res.Content[0] = tc
+		if res.StructuredContent != nil {
+			res.StructuredContent = selected
+		}
+		return res, nil
+	}
+}
+
+// Compact returns v, a decoded JSON value, without null values, empty strings and empty arrays
+// or objects. Numbers and booleans are kept, so zero and false stay meaningful.
+func Compact(v any) any {
+	switch v := v.(type) {
+	case []any:
+		compacted := make([]any, 0, len(v))
+		for _, item := range v {
+			if item = Compact(item); !empty(item) {
+				compacted = append(compacted, item)
+			}
+		}
+		return compacted
+	case map[string]any:
+		compacted := map[string]any{}
+		for key, value := range v {
+			if value = Compact(value); !empty(value) {
+				compacted[key] = value
+			}
+		}
+		return compacted
+	default:
+		return v
+	}
+}
+
+// empty reports whether a compacted JSON value carries no information.
+func empty(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// compact returns a handler that renders the JSON text of the result of next compactly when the
+// Compact argument, or byDefault when it is absent, asks for it.
+func compact(byDefault bool, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		on := byDefault
+		args := req.GetArguments()
+		if raw, ok := args[CompactArg]; ok {
+			if b, isBool := raw.(bool); isBool {
+				on = b
+			}
+			stripped := maps.Clone(args)
+			delete(stripped, CompactArg)
+			req.Params.Arguments = stripped
+		}
+
+		res, err := next(ctx, req)
+		if !on || err != nil || res == nil || res.IsError {
+			return res, err
+		}
+		for i, c := range res.Content {
+			tc, ok := c.(mcp.TextContent)
+			if !ok {
+				continue
+			}
+			var value any
+			if json.Unmarshal([]byte(tc.Text), &value) != nil {
+				continue
+			}
+			value = Compact(value)
+			jsonData, err := json.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("marshal error: %w", err)
+			}
+			tc.Text = string(jsonData)
+			res.Content[i] = tc
+			if i == 0 && res.StructuredContent != nil {
+				res.StructuredContent = value
+			}
+		}
+		return res, nil
+	}
+}
+
+// Apply adds the Fields argument to every list and get tool registered with s, the Detail argument
+// to every list tool and the Compact argument to every tool. compactByDefault makes output compact
+// unless a call passes Compact: false. itemURIs maps list tool names to the URI template of the
+// resource of one of their items, such as "digitalocean://droplets/{id}".
+func Apply(s *server.MCPServer, compactByDefault bool, itemURIs map[string]string) {
+	var updated []server.ServerTool
+	for name, st := range s.ListTools() {
+		if st.Tool.RawInputSchema != nil {
+			continue
+		}
+		tool, handler := st.Tool, st.Handler
+		props := maps.Clone(tool.InputSchema.Properties)
+		if props == nil {
+			props = map[string]any{}
+		}
+		if IsRead(name) {
+			props[FieldsArg] = map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string"},
+				"description": `Return only these fields of each result, as dot-separated JSON paths such as "id", "name" or "networks.v4"`,
+			}
+			handler = selectFields(handler)
+		}
+		if isList(name) {
+			props[DetailArg] = map[string]any{
+				"type": "string",
+				"enum": []string{DetailSummary, DetailFull},
+				"description": fmt.Sprintf("%q returns one line per resource with a link to its details, %q the full objects; "+
+					"lists of more than %d items are summarized by default", DetailSummary, DetailFull, SummaryThreshold),
+			}
+			handler = detail(name, itemURIs[name], handler)
+		}
+		props[CompactArg] = map[string]any{
+			"type":        "boolean",
+			"description": "Return minified JSON without null or empty fields",
+			"default":     compactByDefault,
+		}
+		tool.InputSchema.Properties = props
+		updated = append(updated, server.ServerTool{Tool: tool, Handler: compact(compactByDefault, handler)})
+	}
+	s.AddTools(updated...)
+}
@@ -0,0 +1,151 @@
+package shape
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const droplets = `[
+  {"id": 1, "name": "web-1", "size": {"slug": "s-1vcpu-1gb", "vcpus": 1},
+   "networks": {"v4": [{"ip_address": "10.0.0.1", "type": "private"}, {"ip_address": "203.0.113.1", "type": "public"}], "v6": []}},
+  {"id": 2, "name": "web-2", "networks": {"v4": []}}
+]`
+
+func decode(t *testing.T, s string) any {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		want  string
+	}{
+		{
+			name:  "Top-level fields",
+			paths: []string{"id", "name"},
+			want:  `[{"id": 1, "name": "web-1"}, {"id": 2, "name": "web-2"}]`,
+		},
+		{
+			name:  "Nested object",
+			paths: []string{"id", "networks.v4"},
+			want: `[{"id": 1, "networks": {"v4": [{"ip_address": "10.0.0.1", "type": "private"}, {"ip_address": "203.0.113.1", "type": "public"}]}},
+			        {"id": 2, "networks": {"v4": []}}]`,
+		},
+		{
+			name:  "Through arrays",
+			paths: []string{"networks.v4.ip_address"},
+			want:  `[{"networks": {"v4": [{"ip_address": "10.0.0.1"}, {"ip_address": "203.0.113.1"}]}}, {"networks": {"v4": []}}]`,
+		},
+		{
+			name:  "Shorter path wins",
+			paths: []string{"size.slug", "size"},
+			want:  `[{"size": {"slug": "s-1vcpu-1gb", "vcpus": 1}}, {}]`,
+		},
+		{
+			name:  "Unknown field",
+			paths: []string{"region.slug"},
+			want:  `[{}, {}]`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Select(decode(t, droplets), tc.paths)
+			if want := decode(t, tc.want); !reflect.DeepEqual(got, want) {
+				t.Fatalf("Select() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	var got map[string]any
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		got = req.GetArguments()
+		return mcp.NewToolResultStructured(decode(t, droplets), droplets), nil
+	}
+	s := server.NewMCPServer("test", "1.0.0")
+	s.AddTool(mcp.NewTool("droplet-list", mcp.WithNumber("Page")), handler)
+	s.AddTool(mcp.NewTool("droplet-delete", mcp.WithNumber("ID")), handler)
+	Apply(s, false, nil)
+
+	if _, ok := s.GetTool("droplet-delete").Tool.InputSchema.Properties[FieldsArg]; ok {
+		t.Fatalf("%s advertised on a mutating tool", FieldsArg)
+	}
+	list := s.GetTool("droplet-list")
+	if _, ok := list.Tool.InputSchema.Properties[FieldsArg]; !ok {
+		t.Fatalf("%s not advertised on a list tool", FieldsArg)
+	}
+
+	res, err := list.Handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"Page": float64(1), FieldsArg: []any{"id"},
+	}}})
+	if err != nil || res.IsError {
+		t.Fatalf("call failed: %v %+v", err, res)
+	}
+	if _, ok := got[FieldsArg]; ok {
+		t.Fatalf("%s reached the handler", FieldsArg)
+	}
+	want := decode(t, `[{"id": 1}, {"id": 2}]`)
+	if text := decode(t, res.Content[0].(mcp.TextContent).Text); !reflect.DeepEqual(text, want) {
+		t.Fatalf("text = %v, want %v", text, want)
+	}
+	if !reflect.DeepEqual(res.StructuredContent, want) {
+		t.Fatalf("structured content = %v, want %v", res.StructuredContent, want)
+	}
+
+	res, err = list.Handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{FieldsArg: "id"}}})
+	if err != nil || !res.IsError {
+		t.Fatalf("expected an error result for a string %s, got %v %+v", FieldsArg, err, res)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	got := Compact(decode(t, `{"id": 1, "name": "", "locked": false, "size": 0, "tags": [], "image": {"slug": null},
+		"networks": {"v4": [{"ip_address": "10.0.0.1", "gateway": ""}], "v6": []}, "kernel": null}`))
+	want := decode(t, `{"id": 1, "locked": false, "size": 0, "networks": {"v4": [{"ip_address": "10.0.0.1"}]}}`)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Compact() = %v, want %v", got, want)
+	}
+}
+
+func TestApply_compact(t *testing.T) {
+	handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("{\n  \"id\": 1,\n  \"tags\": null\n}"), nil
+	}
+	tests := []struct {
+		name      string
+		byDefault bool
+		args      map[string]any
+		want      string
+	}{
+		{name: "Off", args: map[string]any{}, want: "{\n  \"id\": 1,\n  \"tags\": null\n}"},
+		{name: "Per call", args: map[string]any{CompactArg: true}, want: `{"id":1}`},
+		{name: "Server default", byDefault: true, args: map[string]any{}, want: `{"id":1}`},
+		{name: "Per call override", byDefault: true, args: map[string]any{CompactArg: false}, want: "{\n  \"id\": 1,\n  \"tags\": null\n}"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := server.NewMCPServer("test", "1.0.0")
+			s.AddTool(mcp.NewTool("droplet-create"), handler)
+			Apply(s, tc.byDefault, nil)
+			res, err := s.GetTool("droplet-create").Handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := res.Content[0].(mcp.TextContent).Text; got != tc.want {
+				t.Fatalf("text = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+// Package subscriptions implements MCP resource subscriptions on top of polling.
+//
+// mcp-go does not route resources/subscribe and resources/unsubscribe to the server, so the
+// manager answers them in front of the transport. Each subscribed resource is read through the
+// server on an interval, with the subscriber's credentials, and a notifications/resources/updated
+// notification is sent to the subscribing session whenever the content changes. A resource that
+// can no longer be read, e.g. a deleted droplet, counts as a change once.
+package subscriptions
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	middleware "mcp-digitalocean/internal"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// DefaultInterval is how often subscribed resources are polled.
+	DefaultInterval = 15 * time.Second
+	// MaxPerSession bounds the subscriptions of one session.
+	MaxPerSession = 50
+
+	methodSubscribe   = "resources/subscribe"
+	methodUnsubscribe = "resources/unsubscribe"
+
+	// stdioSessionID is the ID mcp-go gives the single stdio session.
+	stdioSessionID = "stdio"
+	// sessionHeader carries the session ID of stateful streamable HTTP clients.
+	sessionHeader = "Mcp-Session-Id"
+)
+
+type subKey struct {
+	session string
+	uri     string
+}
+
+type subscription struct {
+	cancel context.CancelFunc
+}
+
+// Manager keeps the subscriptions of every session and polls the subscribed resources.
+type Manager struct {
+	interval time.Duration
+	logger   *slog.Logger
+
+	mu     sync.Mutex
+	server *server.MCPServer
+	subs   map[subKey]*subscription
+}
+
+// NewManager creates a manager that polls subscribed resources every interval.
+// The server is set with SetServer once it is created.
+func NewManager(interval time.Duration, logger *slog.Logger) *Manager {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Manager{
+		interval: interval,
+		logger:   logger,
+		subs:     map[subKey]*subscription{},
+	}
+}
+
+// SetServer sets the server that resources are read through and notifications are sent with.
+func (m *Manager) SetServer(s *server.MCPServer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.server = s
+}
+
+// Hooks returns server hooks that drop the subscriptions of a session when it ends.
+func (m *Manager) Hooks() *server.Hooks {
+	hooks := &server.Hooks{}
+	hooks.AddOnUnregisterSession(func(ctx context.Context, session server.ClientSession) {
+		m.unsubscribeSession(session.SessionID())
+	})
+	return hooks
+}
+
+// ServerOptions returns the options that advertise subscriptions and clean them up with their session.
+func (m *Manager) ServerOptions() []server.ServerOption {
+	return []server.ServerOption{
+		server.WithResourceCapabilities(true, false),
+		server.WithHooks(m.Hooks()),
+	}
+}
+
+// Subscribe starts polling uri for the session. The resource must be readable. Subscribing
+// twice to the same resource is a no-op.
+func (m *Manager) Subscribe(ctx context.Context, sessionID, uri string) error {
+	key := subKey{session: sessionID, uri: uri}
+	m.mu.Lock()
+	if _, ok := m.subs[key]; ok {
+		m.mu.Unlock()
+		return nil
+	}
+	count := 0
+	for k := range m.subs {
+		if k.session == sessionID {
+			count++
+		}
+	}
+	m.mu.Unlock()
+	if count >= MaxPerSession {
+		return fmt.Errorf("a session can subscribe to at most %d resources", MaxPerSession)
+	}
+
+	state, err := m.read(ctx, uri)
+	if err != nil {
+		return err
+	}
+
+	// polling outlives the subscribe request but keeps its values, such as the caller's credentials.
+	pollCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	m.mu.Lock()
+	if _, ok := m.subs[key]; ok {
+		m.mu.Unlock()
+		cancel()
+		return nil
+	}
+	m.subs[key] = &subscription{cancel: cancel}
+	m.mu.Unlock()
+
+	go m.poll(pollCtx, key, state)
+	return nil
+}
+
+// Unsubscribe stops polling uri for the session.
+func (m *Manager) Unsubscribe(sessionID, uri string) {
+	key := subKey{session: sessionID, uri: uri}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if sub, ok := m.subs[key]; ok {
+		sub.cancel()
+		delete(m.subs, key)
+	}
+}
+
+func (m *Manager) unsubscribeSession(sessionID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, sub := range m.subs {
+		if key.session == sessionID {
+			sub.cancel()
+			delete(m.subs, key)
+		}
+	}
+}
+
+// read reads the resource through the server and returns a fingerprint of its contents.
+func (m *Manager) read(ctx context.Context, uri string) (string, error) {
+	m.mu.Lock()
+	s := m.server
+	m.mu.Unlock()
+	if s == nil {
+		return "", errors.New("subscriptions are not available")
+	}
+
+	message, err := json.Marshal(map[string]any{
+		"jsonrpc": mcp.JSONRPC_VERSION,
+		"id":      1,
+		"method":  string(mcp.MethodResourcesRead),
+		"params":  map[string]any{"uri": uri},
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal error: %w", err)
+	}
+	switch resp := s.HandleMessage(ctx, message).(type) {
+	case mcp.JSONRPCResponse:
+		data, err := json.Marshal(resp.Result)
+		if err != nil {
+			return "", fmt.Errorf("marshal error: %w", err)
+		}
+		sum := sha256.Sum256(data)
+		return hex.EncodeToString(sum[:]), nil
+	case mcp.JSONRPCError:
+		return "", errors.New(resp.Error.Message)
+	default:
+		return "", fmt.Errorf("unexpected response %T", resp)
+	}
+}
+
+// poll reads the resource on every tick and notifies the session when its state changes.
+func (m *Manager) poll(ctx context.Context, key subKey, state string) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		current, err := m.read(ctx, key.uri)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			current = "error: " + err.Error()
+		}
+		if current == state {
+			continue
+		}
+		state = current
+
+		m.mu.Lock()
+		s := m.server
+		m.mu.Unlock()
+		err = s.SendNotificationToSpecificClient(key.session, mcp.MethodNotificationResourceUpdated, map[string]any{"uri": key.uri})
+		if errors.Is(err, server.ErrSessionNotFound) {
+			m.Unsubscribe(key.session, key.uri)
+			return
+		}
+		if err != nil && m.logger != nil {
+			m.logger.Warn("failed to send resource updated notification", "uri", key.uri, "error", err)
+		}
+	}
+}
+
+// handle answers resources/subscribe and resources/unsubscribe requests. It reports false for
+// any other message, which is left to the server.
+func (m *Manager) handle(ctx context.Context, sessionID string, message []byte) (mcp.JSONRPCMessage, bool) {
+	var req struct {
+		ID     mcp.RequestId `json:"id"`
+		Method string        `json:"method"`
+		Params struct {
+			URI string `json:"uri"`
+		} `json:"params"`
+	}
+	if err := json.Unmarshal(message, &req); err != nil || req.ID.IsNil() {
+		return nil, false
+	}
+	if req.Method != methodSubscribe && req.Method != methodUnsubscribe {
+		return nil, false
+	}
+
+	if sessionID == "" {
+		return mcp.NewJSONRPCError(req.ID, mcp.INVALID_REQUEST, "resource subscriptions need a session; they are not available on stateless HTTP", nil), true
+	}
+	if req.Params.URI == "" {
+		return mcp.NewJSONRPCError(req.ID, mcp.INVALID_PARAMS, "uri is required", nil), true
+	}
+
+	if req.Method == methodUnsubscribe {
+		m.Unsubscribe(sessionID, req.Params.URI)
+		return mcp.NewJSONRPCResultResponse(req.ID, mcp.EmptyResult{}), true
+	}
+	if err := m.Subscribe(ctx, sessionID, req.Params.URI); err != nil {
+		return mcp.NewJSONRPCError(req.ID, mcp.INVALID_PARAMS, fmt.Sprintf("cannot subscribe to %s: %v", req.Params.URI, err), nil), true
+	}
+	return mcp.NewJSONRPCResultResponse(req.ID, mcp.EmptyResult{}), true
+}
+
+// lockedWriter serializes writes so responses written by the manager never interleave with
+// the lines written by the stdio server.
+type lockedWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.w.Write(p)
+}
+
+func (w *lockedWriter) writeMessage(msg mcp.JSONRPCMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// WrapStdio returns the reader and writer to pass to the stdio server. Subscription requests
+// read from in are answered on out by the manager; every other line is passed through.
+func (m *Manager) WrapStdio(ctx context.Context, in io.Reader, out io.Writer) (io.Reader, io.Writer) {
+	w := &lockedWriter{w: out}
+	pr, pw := io.Pipe()
+	go func() {
+		reader := bufio.NewReader(in)
+		for {
+			line, err := reader.ReadBytes('\n')
+			if len(bytes.TrimSpace(line)) > 0 {
+				if resp, ok := m.handle(ctx, stdioSessionID, line); ok {
+					if werr := w.writeMessage(resp); werr != nil {
+						_ = pw.CloseWithError(werr)
+						return
+					}
+				} else if _, werr := pw.Write(line); werr != nil {
+					return
+				}
+			}
+			if err != nil {
+				_ = pw.CloseWithError(err)
+				return
+			}
+		}
+	}()
+	return pr, w
+}
+
+// HTTPMiddleware answers subscription requests of stateful streamable HTTP sessions. Stateless
+// clients receive an error, since there is no stream to deliver notifications on.
+func (m *Manager) HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Body == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		if err != nil {
+			http.Error(w, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := middleware.AuthFromRequest(r.Context(), r)
+		resp, ok := m.handle(ctx, r.Header.Get(sessionHeader), body)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+}
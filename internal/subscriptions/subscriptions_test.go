@@ -0,0 +1,266 @@
+package subscriptions
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+type testSession struct {
+	id            string
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (s *testSession) Initialize()       {}
+func (s *testSession) Initialized() bool { return true }
+func (s *testSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return s.notifications
+}
+func (s *testSession) SessionID() string { return s.id }
+
+// droplets is a fake droplet API whose statuses the tests change between polls.
+type droplets struct {
+	mu       sync.Mutex
+	statuses map[string]string
+}
+
+func (d *droplets) set(id, status string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if status == "" {
+		delete(d.statuses, id)
+		return
+	}
+	d.statuses[id] = status
+}
+
+func (d *droplets) read(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	id := strings.TrimPrefix(req.Params.URI, "digitalocean://droplets/")
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	status, ok := d.statuses[id]
+	if !ok {
+		return nil, errors.New("droplet not found")
+	}
+	return []mcp.ResourceContents{mcp.TextResourceContents{URI: req.Params.URI, Text: `{"status":"` + status + `"}`}}, nil
+}
+
+func setupManager(t *testing.T) (*Manager, *server.MCPServer, *droplets, *testSession) {
+	t.Helper()
+	d := &droplets{statuses: map[string]string{"42": "new"}}
+	m := NewManager(5*time.Millisecond, nil)
+	s := server.NewMCPServer("test", "0.0.0", m.ServerOptions()...)
+	s.AddResourceTemplate(mcp.NewResourceTemplate("digitalocean://droplets/{id}", "Droplet"), d.read)
+	m.SetServer(s)
+
+	session := &testSession{id: "session-1", notifications: make(chan mcp.JSONRPCNotification, 10)}
+	if err := s.RegisterSession(context.Background(), session); err != nil {
+		t.Fatalf("RegisterSession() error = %v", err)
+	}
+	t.Cleanup(func() { s.UnregisterSession(context.Background(), session.id) })
+	return m, s, d, session
+}
+
+func request(method, uri string) []byte {
+	data, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      7,
+		"method":  method,
+		"params":  map[string]any{"uri": uri},
+	})
+	return data
+}
+
+func waitForUpdate(t *testing.T, session *testSession, uri string) {
+	t.Helper()
+	select {
+	case n := <-session.notifications:
+		if n.Method != mcp.MethodNotificationResourceUpdated {
+			t.Fatalf("notification method = %q, want %q", n.Method, mcp.MethodNotificationResourceUpdated)
+		}
+		if got := n.Params.AdditionalFields["uri"]; got != uri {
+			t.Fatalf("notification uri = %v, want %s", got, uri)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("no notification for %s", uri)
+	}
+}
+
+func expectNoUpdate(t *testing.T, session *testSession) {
+	t.Helper()
+	select {
+	case n := <-session.notifications:
+		t.Fatalf("unexpected notification %+v", n)
+	case <-time.After(30 * time.Millisecond):
+	}
+}
+
+func TestManager_notifiesOnChange(t *testing.T) {
+	m, _, d, session := setupManager(t)
+	uri := "digitalocean://droplets/42"
+
+	resp, ok := m.handle(context.Background(), session.id, request(methodSubscribe, uri))
+	if !ok {
+		t.Fatal("handle() did not handle resources/subscribe")
+	}
+	if _, isResult := resp.(mcp.JSONRPCResponse); !isResult {
+		t.Fatalf("subscribe response = %+v, want a result", resp)
+	}
+	expectNoUpdate(t, session)
+
+	d.set("42", "active")
+	waitForUpdate(t, session, uri)
+	expectNoUpdate(t, session)
+
+	// deleting the droplet is reported once.
+	d.set("42", "")
+	waitForUpdate(t, session, uri)
+	expectNoUpdate(t, session)
+
+	if _, ok := m.handle(context.Background(), session.id, request(methodUnsubscribe, uri)); !ok {
+		t.Fatal("handle() did not handle resources/unsubscribe")
+	}
+	d.set("42", "active")
+	expectNoUpdate(t, session)
+}
+
+func TestManager_subscribeErrors(t *testing.T) {
+	m, _, _, session := setupManager(t)
+
+	tests := []struct {
+		name      string
+		sessionID string
+		message   []byte
+		code      int
+	}{
+		{name: "No session", sessionID: "", message: request(methodSubscribe, "digitalocean://droplets/42"), code: mcp.INVALID_REQUEST},
+		{name: "Missing uri", sessionID: session.id, message: request(methodSubscribe, ""), code: mcp.INVALID_PARAMS},
+		{name: "Unreadable resource", sessionID: session.id, message: request(methodSubscribe, "digitalocean://droplets/7"), code: mcp.INVALID_PARAMS},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, ok := m.handle(context.Background(), tc.sessionID, tc.message)
+			if !ok {
+				t.Fatal("handle() did not handle the request")
+			}
+			rpcErr, isErr := resp.(mcp.JSONRPCError)
+			if !isErr {
+				t.Fatalf("response = %+v, want an error", resp)
+			}
+			if rpcErr.Error.Code != tc.code {
+				t.Fatalf("error code = %d, want %d", rpcErr.Error.Code, tc.code)
+			}
+		})
+	}
+
+	if _, ok := m.handle(context.Background(), session.id, request("resources/read", "digitalocean://droplets/42")); ok {
+		t.Fatal("handle() handled resources/read")
+	}
+}
+
+func TestManager_sessionEndDropsSubscriptions(t *testing.T) {
+	m, s, _, session := setupManager(t)
+	if err := m.Subscribe(context.Background(), session.id, "digitalocean://droplets/42"); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	s.UnregisterSession(context.Background(), session.id)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.subs) != 0 {
+		t.Fatalf("subscriptions = %d after the session ended, want 0", len(m.subs))
+	}
+}
+
+func TestManager_WrapStdio(t *testing.T) {
+	m, _, _, _ := setupManager(t)
+	inR, inW := io.Pipe()
+	var out strings.Builder
+	var outMu sync.Mutex
+	in, w := m.WrapStdio(context.Background(), inR, writerFunc(func(p []byte) (int, error) {
+		outMu.Lock()
+		defer outMu.Unlock()
+		return out.Write(p)
+	}))
+
+	lines := make(chan string, 2)
+	go func() {
+		scanner := bufio.NewScanner(in)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+
+	go func() {
+		_, _ = inW.Write(append(request(methodSubscribe, "digitalocean://droplets/42"), '\n'))
+		_, _ = inW.Write(append(request("resources/read", "digitalocean://droplets/42"), '\n'))
+		_ = inW.Close()
+	}()
+
+	var forwarded []string
+	for line := range lines {
+		forwarded = append(forwarded, line)
+	}
+	if len(forwarded) != 1 || !strings.Contains(forwarded[0], "resources/read") {
+		t.Fatalf("forwarded = %v, want only the resources/read request", forwarded)
+	}
+
+	if _, err := w.Write([]byte("{}\n")); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	outMu.Lock()
+	defer outMu.Unlock()
+	if !strings.Contains(out.String(), `"id":7,"result":{}`) || !strings.HasSuffix(out.String(), "{}\n") {
+		t.Fatalf("output = %q, want the subscribe result followed by the server's line", out.String())
+	}
+}
+
+func TestManager_HTTPMiddleware(t *testing.T) {
+	m, _, _, session := setupManager(t)
+	var reached []string
+	handler := m.HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		reached = append(reached, string(body))
+	}))
+
+	post := func(sessionID string, body []byte) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(string(body)))
+		if sessionID != "" {
+			r.Header.Set(sessionHeader, sessionID)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, r)
+		return rec
+	}
+
+	rec := post(session.id, request(methodSubscribe, "digitalocean://droplets/42"))
+	if !strings.Contains(rec.Body.String(), `"result":{}`) {
+		t.Fatalf("subscribe body = %s, want an empty result", rec.Body.String())
+	}
+	rec = post("", request(methodSubscribe, "digitalocean://droplets/42"))
+	if !strings.Contains(rec.Body.String(), "need a session") {
+		t.Fatalf("stateless subscribe body = %s, want a session error", rec.Body.String())
+	}
+
+	read := request("resources/read", "digitalocean://droplets/42")
+	post(session.id, read)
+	if len(reached) != 1 || reached[0] != string(read) {
+		t.Fatalf("next handler received %v, want the resources/read body", reached)
+	}
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) { return f(p) }
@@ -0,0 +1,49 @@
+package testhelpers
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"mcp-digitalocean/pkg/registry/common"
+)
+
+// envUpdateExamples makes CheckExample write the example files instead of comparing them.
+const envUpdateExamples = "UPDATE_EXAMPLES"
+
+// CheckExample checks that the example file at path, which a tool embeds with common.WithExample,
+// records the call a test made with args and that returned res. With UPDATE_EXAMPLES=1 set it
+// writes the file instead, so examples are regenerated from the tests' fixtures with
+//
+//	UPDATE_EXAMPLES=1 go test ./pkg/registry/...
+func CheckExample(t testing.TB, path string, args map[string]any, res *mcp.CallToolResult) {
+	t.Helper()
+	if res == nil || res.IsError {
+		t.Fatalf("example call of %s failed: %+v", path, res)
+	}
+	data, err := json.MarshalIndent(common.NewExample(args, res), "", "  ")
+	if err != nil {
+		t.Fatalf("marshal example %s: %v", path, err)
+	}
+	data = append(data, '\n')
+
+	if os.Getenv(envUpdateExamples) == "1" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("create example dir: %v", err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatalf("write example %s: %v", path, err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read example %s: %v (run the tests with %s=1 to write it)", path, err, envUpdateExamples)
+	}
+	if string(want) != string(data) {
+		t.Fatalf("example %s is out of date (run the tests with %s=1 to update it):\n%s", path, envUpdateExamples, data)
+	}
+}
@@ -0,0 +1,103 @@
+// Package toolfilter decides which MCP tools are exposed based on operator
+// supplied enable and disable glob patterns (e.g. "droplet-*", "*-delete").
+package toolfilter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+)
+
+// Config is the on-disk representation of a tool filter. Both lists hold
+// glob patterns as understood by path.Match.
+type Config struct {
+	// Enable restricts the exposed tools to the ones matching at least one
+	// pattern. An empty list enables every tool.
+	Enable []string `json:"enable"`
+	// Disable removes tools matching any pattern. Disable wins over Enable.
+	Disable []string `json:"disable"`
+}
+
+// Filter matches tool names against enable and disable patterns.
+type Filter struct {
+	enable  []string
+	disable []string
+}
+
+// New returns a filter for the given patterns. Blank patterns are ignored and
+// malformed patterns are reported as an error.
+func New(enable, disable []string) (*Filter, error) {
+	f := &Filter{}
+	var err error
+	if f.enable, err = cleanPatterns(enable); err != nil {
+		return nil, err
+	}
+	if f.disable, err = cleanPatterns(disable); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// LoadConfig reads a JSON filter config from file.
+func LoadConfig(file string) (Config, error) {
+	var cfg Config
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read tool filter config: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse tool filter config %s: %w", file, err)
+	}
+	return cfg, nil
+}
+
+// SplitPatterns splits a comma-separated list of patterns as passed on the command line.
+func SplitPatterns(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// Empty reports whether the filter allows every tool.
+func (f *Filter) Empty() bool {
+	return f == nil || (len(f.enable) == 0 && len(f.disable) == 0)
+}
+
+// Allowed reports whether the tool with the given name should be exposed.
+func (f *Filter) Allowed(name string) bool {
+	if f.Empty() {
+		return true
+	}
+	if len(f.enable) > 0 && !matchAny(f.enable, name) {
+		return false
+	}
+	return !matchAny(f.disable, name)
+}
+
+func cleanPatterns(patterns []string) ([]string, error) {
+	var out []string
+	for _, p := range patterns {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("invalid tool pattern %q: %w", p, err)
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		// Patterns were validated in New, so Match cannot fail here.
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
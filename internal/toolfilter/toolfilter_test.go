@@ -0,0 +1,108 @@
+package toolfilter
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFilter_Allowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		enable  []string
+		disable []string
+		allowed map[string]bool
+	}{
+		{
+			name: "empty filter allows everything",
+			allowed: map[string]bool{
+				"droplet-delete": true,
+				"image-list":     true,
+			},
+		},
+		{
+			name:   "enable restricts to matching tools",
+			enable: []string{"droplet-*", "image-*"},
+			allowed: map[string]bool{
+				"droplet-get":   true,
+				"image-list":    true,
+				"domain-create": false,
+			},
+		},
+		{
+			name:    "disable wins over enable",
+			enable:  []string{"droplet-*"},
+			disable: []string{"droplet-delete*"},
+			allowed: map[string]bool{
+				"droplet-get":        true,
+				"droplet-delete":     false,
+				"droplet-delete-tag": false,
+			},
+		},
+		{
+			name:    "disable only",
+			disable: []string{"*-delete", " ", "rebuild-droplet"},
+			allowed: map[string]bool{
+				"image-delete":    false,
+				"rebuild-droplet": false,
+				"image-get":       true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := New(tc.enable, tc.disable)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			for tool, want := range tc.allowed {
+				if got := f.Allowed(tool); got != want {
+					t.Errorf("Allowed(%q) = %v, want %v", tool, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNew_invalidPattern(t *testing.T) {
+	if _, err := New([]string{"droplet-["}, nil); err == nil {
+		t.Fatal("expected error for malformed pattern")
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tools.json")
+	if err := os.WriteFile(file, []byte(`{"enable":["droplet-*"],"disable":["droplet-delete"]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadConfig(file)
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	want := Config{Enable: []string{"droplet-*"}, Disable: []string{"droplet-delete"}}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Fatalf("LoadConfig() = %+v, want %+v", cfg, want)
+	}
+}
+
+func TestLoadConfig_invalidJSON(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tools.json")
+	if err := os.WriteFile(file, []byte(`{`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfig(file); err == nil {
+		t.Fatal("expected error for invalid JSON")
+	}
+}
+
+func TestSplitPatterns(t *testing.T) {
+	if got := SplitPatterns(""); got != nil {
+		t.Fatalf("SplitPatterns(\"\") = %v, want nil", got)
+	}
+	if got := SplitPatterns("a-*,b"); !reflect.DeepEqual(got, []string{"a-*", "b"}) {
+		t.Fatalf("SplitPatterns() = %v", got)
+	}
+}
@@ -0,0 +1,148 @@
+// Package tracing records OpenTelemetry spans of tool calls and of the DigitalOcean API requests
+// they make, and exports them with OTLP, so slow or failing agent workflows can be followed from
+// the tool call down to each API attempt.
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "mcp-digitalocean"
+	// maxStatusLength caps the error text of a failed tool call kept as the span status.
+	maxStatusLength = 256
+
+	toolNameKey      = attribute.Key("mcp.tool.name")
+	argumentsHashKey = attribute.Key("mcp.tool.arguments.hash")
+	requestIDKey     = attribute.Key("digitalocean.request_id")
+)
+
+// Setup makes spans be exported with OTLP over HTTP, to the collector configured by the standard
+// OTEL_EXPORTER_OTLP_* environment variables, and returns the function that flushes the spans
+// still buffered. Until Setup is called, spans are not recorded.
+func Setup(ctx context.Context, serviceName, serviceVersion string) (func(context.Context) error, error) {
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES override the defaults.
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(serviceName), semconv.ServiceVersion(serviceVersion)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe the tracing resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Middleware records a span for each tool call, with the tool name and a hash of the arguments.
+// The arguments themselves may hold secrets and are not recorded; the hash tells repeated calls
+// apart from ones with different arguments.
+func Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		ctx, span := tracer().Start(ctx, "tools/call "+req.Params.Name, trace.WithSpanKind(trace.SpanKindServer))
+		defer span.End()
+		if span.IsRecording() {
+			span.SetAttributes(toolNameKey.String(req.Params.Name), argumentsHashKey.String(argumentsHash(req.GetArguments())))
+		}
+
+		res, err := next(ctx, req)
+		switch {
+		case err != nil:
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		case res != nil && res.IsError:
+			span.SetStatus(codes.Error, errorText(res))
+		}
+		return res, err
+	}
+}
+
+// argumentsHash returns a short hash of the arguments. JSON encoding sorts the keys, so equal
+// arguments hash alike.
+func argumentsHash(args map[string]any) string {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// errorText returns the first text of an error result, shortened to maxStatusLength.
+func errorText(res *mcp.CallToolResult) string {
+	for _, content := range res.Content {
+		if text, ok := content.(mcp.TextContent); ok {
+			if len(text.Text) > maxStatusLength {
+				return text.Text[:maxStatusLength]
+			}
+			return text.Text
+		}
+	}
+	return "tool error"
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// NewTransport wraps base so each API request is recorded as a span of the tool call that made
+// it, with the request ID the API returned for it.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := tracer().Start(req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.ServerAddress(req.URL.Hostname()),
+			semconv.URLPath(req.URL.Path),
+		),
+	)
+	defer span.End()
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if id := resp.Header.Get("X-Request-Id"); id != "" {
+		span.SetAttributes(requestIDKey.String(id))
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	return resp, nil
+}
@@ -0,0 +1,104 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// recordSpans makes the spans of the test be recorded in memory.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestMiddleware_tracesToolCallsAndAPIRequests(t *testing.T) {
+	recorder := recordSpans(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+	client := &http.Client{Transport: NewTransport(nil)}
+
+	handler := Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		apiReq, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/v2/droplets/1", nil)
+		resp, err := client.Do(apiReq)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body.Close()
+		return mcp.NewToolResultError("droplet not found"), nil
+	})
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "droplet-get"
+	req.Params.Arguments = map[string]any{"ID": float64(1)}
+	if _, err := handler(context.Background(), req); err != nil {
+		t.Fatalf("tool call error = %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("recorded %d spans, want 2", len(spans))
+	}
+	apiSpan, toolSpan := spans[0], spans[1]
+
+	if toolSpan.Name() != "tools/call droplet-get" {
+		t.Fatalf("tool span name = %q", toolSpan.Name())
+	}
+	toolAttrs := attributes(toolSpan)
+	if got := toolAttrs[toolNameKey].AsString(); got != "droplet-get" {
+		t.Errorf("tool name = %q", got)
+	}
+	if got := toolAttrs[argumentsHashKey].AsString(); got != argumentsHash(map[string]any{"ID": float64(1)}) || len(got) != 16 {
+		t.Errorf("arguments hash = %q", got)
+	}
+	if toolSpan.Status().Code != codes.Error || toolSpan.Status().Description != "droplet not found" {
+		t.Errorf("tool span status = %+v, want the error result", toolSpan.Status())
+	}
+
+	if apiSpan.Parent().SpanID() != toolSpan.SpanContext().SpanID() {
+		t.Error("API request span is not a child of the tool call span")
+	}
+	apiAttrs := attributes(apiSpan)
+	if got := apiAttrs[requestIDKey].AsString(); got != "req-123" {
+		t.Errorf("request ID = %q, want req-123", got)
+	}
+	if got := apiAttrs["http.response.status_code"].AsInt64(); got != http.StatusNotFound {
+		t.Errorf("status code = %d, want 404", got)
+	}
+	if got := apiAttrs["url.path"].AsString(); got != "/v2/droplets/1" {
+		t.Errorf("url path = %q", got)
+	}
+}
+
+func TestArgumentsHash_ignoresKeyOrder(t *testing.T) {
+	a := argumentsHash(map[string]any{"Name": "web", "Region": "nyc3"})
+	b := argumentsHash(map[string]any{"Region": "nyc3", "Name": "web"})
+	if a != b {
+		t.Fatalf("hashes differ: %q and %q", a, b)
+	}
+	if a == argumentsHash(map[string]any{"Name": "web", "Region": "sfo3"}) {
+		t.Fatal("different arguments hash alike")
+	}
+}
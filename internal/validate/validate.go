@@ -0,0 +1,148 @@
+// Package validate enforces the enum, minimum, maximum and pattern constraints tool schemas declare.
+//
+// Tools declare constraints with mcp.Enum, mcp.Min, mcp.Max and mcp.Pattern so clients can see
+// them, but nothing checked them and a handler would pass an out-of-range page size or an unknown
+// image type on to the API. Apply wraps every tool with constraints so a call that breaks them
+// fails with one error result listing each violation, worded like the other argument errors.
+package validate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// constraint is what the schema of one argument allows.
+type constraint struct {
+	enum    []any
+	minimum *float64
+	maximum *float64
+	pattern *regexp.Regexp
+}
+
+// properties returns the top-level properties of the tool's input schema.
+func properties(tool mcp.Tool) map[string]any {
+	if tool.RawInputSchema == nil {
+		return tool.InputSchema.Properties
+	}
+	var raw struct {
+		Properties map[string]any `json:"properties"`
+	}
+	if json.Unmarshal(tool.RawInputSchema, &raw) != nil {
+		return nil
+	}
+	return raw.Properties
+}
+
+// number returns a schema keyword holding a number.
+func number(v any) *float64 {
+	switch n := v.(type) {
+	case float64:
+		return &n
+	case int:
+		f := float64(n)
+		return &f
+	}
+	return nil
+}
+
+// constraints returns the constrained arguments of the tool's input schema.
+func constraints(tool mcp.Tool) map[string]constraint {
+	result := map[string]constraint{}
+	for name, p := range properties(tool) {
+		schema, ok := p.(map[string]any)
+		if !ok {
+			continue
+		}
+		var c constraint
+		switch enum := schema["enum"].(type) {
+		case []string:
+			for _, v := range enum {
+				c.enum = append(c.enum, v)
+			}
+		case []any:
+			c.enum = enum
+		}
+		c.minimum = number(schema["minimum"])
+		c.maximum = number(schema["maximum"])
+		if pattern, ok := schema["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil {
+				c.pattern = re
+			}
+		}
+		if c.enum != nil || c.minimum != nil || c.maximum != nil || c.pattern != nil {
+			result[name] = c
+		}
+	}
+	return result
+}
+
+// check returns the violation of c by the value of the argument name, or "".
+func (c constraint) check(name string, v any) string {
+	if c.enum != nil && !slices.Contains(c.enum, v) {
+		values := make([]string, len(c.enum))
+		for i, e := range c.enum {
+			values[i] = fmt.Sprint(e)
+		}
+		return fmt.Sprintf("%s must be one of %s, not %v", name, strings.Join(values, ", "), v)
+	}
+	if n, ok := v.(float64); ok {
+		if c.minimum != nil && n < *c.minimum {
+			return fmt.Sprintf("%s must be at least %v, not %v", name, *c.minimum, n)
+		}
+		if c.maximum != nil && n > *c.maximum {
+			return fmt.Sprintf("%s must be at most %v, not %v", name, *c.maximum, n)
+		}
+	}
+	if s, ok := v.(string); ok && c.pattern != nil && !c.pattern.MatchString(s) {
+		return fmt.Sprintf("%s must match %s, not %q", name, c.pattern, s)
+	}
+	return ""
+}
+
+// wrap returns a handler that rejects calls breaking the constraints before calling next.
+func wrap(constraints map[string]constraint, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	names := make([]string, 0, len(constraints))
+	for name := range constraints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		args := req.GetArguments()
+		var problems []string
+		for _, name := range names {
+			v, ok := args[name]
+			if !ok || v == nil {
+				continue
+			}
+			if problem := constraints[name].check(name, v); problem != "" {
+				problems = append(problems, problem)
+			}
+		}
+		if len(problems) > 0 {
+			return mcp.NewToolResultError("invalid arguments: " + strings.Join(problems, "; ")), nil
+		}
+		return next(ctx, req)
+	}
+}
+
+// Apply wraps the handler of every tool registered with s that has constrained arguments.
+func Apply(s *server.MCPServer) {
+	var updated []server.ServerTool
+	for _, st := range s.ListTools() {
+		c := constraints(st.Tool)
+		if len(c) == 0 {
+			continue
+		}
+		updated = append(updated, server.ServerTool{Tool: st.Tool, Handler: wrap(c, st.Handler)})
+	}
+	s.AddTools(updated...)
+}
@@ -0,0 +1,87 @@
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func TestApply(t *testing.T) {
+	s := server.NewMCPServer("test", "1.0.0")
+	s.AddTool(mcp.NewTool("image-list",
+		mcp.WithString("Type", mcp.Enum("distribution", "application", "user")),
+		mcp.WithString("Name", mcp.Pattern("^[a-z0-9-]+$")),
+		mcp.WithNumber("Page", mcp.Min(1)),
+		mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200)),
+	), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	})
+	Apply(s)
+
+	tests := []struct {
+		name string
+		args map[string]any
+		want string
+	}{
+		{
+			name: "Valid",
+			args: map[string]any{"Type": "user", "Name": "web-1", "Page": float64(1), "PerPage": float64(200)},
+			want: "ok",
+		},
+		{
+			name: "No arguments",
+			args: map[string]any{},
+			want: "ok",
+		},
+		{
+			name: "Enum",
+			args: map[string]any{"Type": "snapshot"},
+			want: "invalid arguments: Type must be one of distribution, application, user, not snapshot",
+		},
+		{
+			name: "Pattern",
+			args: map[string]any{"Name": "Web 1"},
+			want: `invalid arguments: Name must match ^[a-z0-9-]+$, not "Web 1"`,
+		},
+		{
+			name: "Range",
+			args: map[string]any{"Page": float64(0), "PerPage": float64(500)},
+			want: "invalid arguments: Page must be at least 1, not 0; PerPage must be at most 200, not 500",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := s.GetTool("image-list").Handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := res.Content[0].(mcp.TextContent).Text; got != tc.want {
+				t.Fatalf("result = %q, want %q", got, tc.want)
+			}
+			if res.IsError != (tc.want != "ok") {
+				t.Fatalf("IsError = %v", res.IsError)
+			}
+		})
+	}
+}
+
+func TestApply_rawSchema(t *testing.T) {
+	s := server.NewMCPServer("test", "1.0.0")
+	s.AddTool(mcp.NewToolWithRawSchema("size-get", "", []byte(`{"type":"object","properties":{"Count":{"type":"integer","enum":[1,2,3]}}}`)),
+		func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText("ok"), nil
+		})
+	Apply(s)
+
+	handler := s.GetTool("size-get").Handler
+	res, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Count": float64(2)}}})
+	if err != nil || res.IsError {
+		t.Fatalf("valid call failed: %v %+v", err, res)
+	}
+	res, err = handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Count": float64(4)}}})
+	if err != nil || !res.IsError {
+		t.Fatalf("invalid call succeeded: %v %+v", err, res)
+	}
+}
@@ -0,0 +1,228 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultActionFollowTimeout = 300
+	maxActionFollowTimeout     = 1800
+	defaultActionFollowPoll    = 5
+	maxActionFollowPoll        = 60
+)
+
+// actionPath matches the path of an action href, e.g. /v2/droplets/123/actions/456,
+// /v2/volumes/<uuid>/actions/456, /v2/reserved_ips/203.0.113.1/actions/456 or /v2/actions/456.
+var actionPath = regexp.MustCompile(`^/v2/(?:([a-z_0-9]+)/([^/]+)/)?actions/([0-9]+)/?$`)
+
+// actionGetters look up an action of a resource by the resource's ID and the action's ID, for the
+// resource types of action hrefs. Other types are looked up by action ID alone.
+var actionGetters = map[string]func(ctx context.Context, client *godo.Client, resourceID string, actionID int) (*godo.Action, error){
+	"droplets": func(ctx context.Context, client *godo.Client, resourceID string, actionID int) (*godo.Action, error) {
+		id, err := strconv.Atoi(resourceID)
+		if err != nil {
+			return nil, fmt.Errorf("droplet ID %q is not a number", resourceID)
+		}
+		action, _, err := client.DropletActions.Get(ctx, id, actionID)
+		return action, err
+	},
+	"images": func(ctx context.Context, client *godo.Client, resourceID string, actionID int) (*godo.Action, error) {
+		id, err := strconv.Atoi(resourceID)
+		if err != nil {
+			return nil, fmt.Errorf("image ID %q is not a number", resourceID)
+		}
+		action, _, err := client.ImageActions.Get(ctx, id, actionID)
+		return action, err
+	},
+	"volumes": func(ctx context.Context, client *godo.Client, resourceID string, actionID int) (*godo.Action, error) {
+		action, _, err := client.StorageActions.Get(ctx, resourceID, actionID)
+		return action, err
+	},
+	"reserved_ips": func(ctx context.Context, client *godo.Client, resourceID string, actionID int) (*godo.Action, error) {
+		action, _, err := client.ReservedIPActions.Get(ctx, resourceID, actionID)
+		return action, err
+	},
+	"floating_ips": func(ctx context.Context, client *godo.Client, resourceID string, actionID int) (*godo.Action, error) {
+		action, _, err := client.ReservedIPActions.Get(ctx, resourceID, actionID)
+		return action, err
+	},
+}
+
+// ActionRef is the action an href points at.
+type ActionRef struct {
+	// ResourceType is the collection of the href, e.g. droplets or volumes; empty for /v2/actions.
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceID   string `json:"resource_id,omitempty"`
+	ActionID     int    `json:"action_id"`
+}
+
+// ActionFollowResult is the outcome of an action-follow call.
+type ActionFollowResult struct {
+	ActionRef
+	Status         string       `json:"status"`
+	Completed      bool         `json:"completed"`
+	Polls          int          `json:"polls"`
+	ElapsedSeconds float64      `json:"elapsed_seconds"`
+	Action         *godo.Action `json:"action,omitempty"`
+}
+
+// ActionFollowTool provides a tool that polls the action an href points at until it finishes.
+type ActionFollowTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+	// unit is the duration of one second of TimeoutSeconds and PollIntervalSeconds.
+	unit time.Duration
+}
+
+// NewActionFollowTool creates a new action follow tool
+func NewActionFollowTool(client func(ctx context.Context) (*godo.Client, error)) *ActionFollowTool {
+	return &ActionFollowTool{client: client, unit: time.Second}
+}
+
+// parseActionHref returns the action an href from the links of a response points at. The href
+// may be a full URL of the API the client talks to, or only its path; URLs of other hosts are
+// refused, so the caller's token is never sent anywhere else.
+func parseActionHref(href string, baseURL *url.URL) (ActionRef, error) {
+	u, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return ActionRef{}, fmt.Errorf("invalid arguments: URI %q is not a URL: %w", href, err)
+	}
+	if u.Host != "" && baseURL != nil && !strings.EqualFold(u.Host, baseURL.Host) {
+		return ActionRef{}, fmt.Errorf("invalid arguments: URI %q is not on %s, the API this server talks to", href, baseURL.Host)
+	}
+	m := actionPath.FindStringSubmatch(u.Path)
+	if m == nil {
+		return ActionRef{}, fmt.Errorf("invalid arguments: URI %q is not an action href; it should look like https://api.digitalocean.com/v2/droplets/123/actions/456", href)
+	}
+	actionID, err := strconv.Atoi(m[3])
+	if err != nil {
+		return ActionRef{}, fmt.Errorf("invalid arguments: action ID %q is not a number", m[3])
+	}
+	resourceID, err := url.PathUnescape(m[2])
+	if err != nil {
+		return ActionRef{}, fmt.Errorf("invalid arguments: resource ID %q is not escaped correctly", m[2])
+	}
+	return ActionRef{ResourceType: m[1], ResourceID: resourceID, ActionID: actionID}, nil
+}
+
+// getAction looks up the action ref points at.
+func getAction(ctx context.Context, client *godo.Client, ref ActionRef) (*godo.Action, error) {
+	if get, ok := actionGetters[ref.ResourceType]; ok {
+		return get(ctx, client, ref.ResourceID, ref.ActionID)
+	}
+	// every action, also of resources without an actions service of their own, is at /v2/actions.
+	action, _, err := client.Actions.Get(ctx, ref.ActionID)
+	return action, err
+}
+
+// followAction polls the action an href points at until it completes or errors, or the timeout
+// passes, sending a progress notification after every poll that found it still in progress.
+func (a *ActionFollowTool) followAction(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	href := args.RequiredString("URI")
+	timeout := args.Number("TimeoutSeconds", defaultActionFollowTimeout)
+	interval := args.Number("PollIntervalSeconds", defaultActionFollowPoll)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var problems []string
+	if timeout < 1 || timeout > maxActionFollowTimeout {
+		problems = append(problems, fmt.Sprintf("TimeoutSeconds must be between 1 and %d", maxActionFollowTimeout))
+	}
+	if interval < 1 || interval > maxActionFollowPoll {
+		problems = append(problems, fmt.Sprintf("PollIntervalSeconds must be between 1 and %d", maxActionFollowPoll))
+	}
+	if len(problems) > 0 {
+		return mcp.NewToolResultError("invalid arguments: " + strings.Join(problems, "; ")), nil
+	}
+
+	client, err := a.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	ref, err := parseActionHref(href, client.BaseURL)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout*float64(a.unit)))
+	defer cancel()
+	ticker := time.NewTicker(time.Duration(interval * float64(a.unit)))
+	defer ticker.Stop()
+
+	result := &ActionFollowResult{ActionRef: ref}
+	start := time.Now()
+	for waiting := true; waiting; {
+		action, err := getAction(waitCtx, client, ref)
+		result.ElapsedSeconds = float64(time.Since(start)) / float64(a.unit)
+		if err != nil && waitCtx.Err() == nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		if err == nil {
+			result.Polls++
+			result.Status = action.Status
+			result.Action = action
+			switch action.Status {
+			case godo.ActionCompleted:
+				result.Completed = true
+				return common.NewToolResultStructured(result)
+			case "errored":
+				res, err := common.NewToolResultStructured(result)
+				if err != nil {
+					return nil, err
+				}
+				res.IsError = true
+				res.Content = append(res.Content, mcp.NewTextContent(fmt.Sprintf("action %d (%s) errored", ref.ActionID, action.Type)))
+				return res, nil
+			}
+			common.NotifyProgress(ctx, req, result.ElapsedSeconds, timeout,
+				fmt.Sprintf("action %d (%s) is %s", ref.ActionID, action.Type, action.Status))
+		}
+		select {
+		case <-waitCtx.Done():
+			waiting = false
+		case <-ticker.C:
+		}
+	}
+	if ctx.Err() != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("stopped following action %d: %v", ref.ActionID, ctx.Err())), nil
+	}
+
+	// the last status is still reported, so the caller can decide whether to follow it again.
+	res, err := common.NewToolResultStructured(result)
+	if err != nil {
+		return nil, err
+	}
+	res.IsError = true
+	res.Content = append(res.Content, mcp.NewTextContent(fmt.Sprintf(
+		"action %d did not complete within %g seconds (last status %q)", ref.ActionID, timeout, result.Status)))
+	return res, nil
+}
+
+// Tools returns the action-follow tool.
+func (a *ActionFollowTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: a.followAction,
+			Tool: mcp.NewTool("action-follow",
+				mcp.WithDescription("Follow an action until it completes: pass the href of an action from the links of a response, e.g. https://api.digitalocean.com/v2/droplets/123/actions/456, of a droplet, image, volume or reserved IP, or /v2/actions/456. Polls the action every PollIntervalSeconds and sends progress notifications; returns the action once it completed, or an error when it errored or is still in progress after TimeoutSeconds."),
+				common.WithOutputSchema[ActionFollowResult](),
+				mcp.WithString("URI", mcp.Required(), mcp.Description("href of the action, or its path, e.g. /v2/volumes/<id>/actions/456")),
+				mcp.WithNumber("TimeoutSeconds", mcp.DefaultNumber(defaultActionFollowTimeout), mcp.Min(1), mcp.Max(maxActionFollowTimeout), mcp.Description("How long to wait before giving up")),
+				mcp.WithNumber("PollIntervalSeconds", mcp.DefaultNumber(defaultActionFollowPoll), mcp.Min(1), mcp.Max(maxActionFollowPoll), mcp.Description("How often to check the action")),
+			),
+		},
+	}
+}
@@ -0,0 +1,189 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParseActionHref(t *testing.T) {
+	base, _ := url.Parse("https://api.digitalocean.com/")
+	tests := []struct {
+		href    string
+		want    ActionRef
+		wantErr string
+	}{
+		{href: "https://api.digitalocean.com/v2/droplets/123/actions/456", want: ActionRef{ResourceType: "droplets", ResourceID: "123", ActionID: 456}},
+		{href: "https://api.digitalocean.com/v2/images/7/actions/8", want: ActionRef{ResourceType: "images", ResourceID: "7", ActionID: 8}},
+		{href: "/v2/volumes/506f78a4-e098-11e5-ad9f-000f53306ae1/actions/72531856", want: ActionRef{ResourceType: "volumes", ResourceID: "506f78a4-e098-11e5-ad9f-000f53306ae1", ActionID: 72531856}},
+		{href: "https://api.digitalocean.com/v2/reserved_ips/203.0.113.1/actions/9", want: ActionRef{ResourceType: "reserved_ips", ResourceID: "203.0.113.1", ActionID: 9}},
+		{href: "https://api.digitalocean.com/v2/actions/10", want: ActionRef{ActionID: 10}},
+		{href: "https://evil.example.com/v2/actions/10", wantErr: "is not on api.digitalocean.com"},
+		{href: "https://api.digitalocean.com/v2/droplets/123", wantErr: "is not an action href"},
+		{href: "https://api.digitalocean.com/v2/droplets/123/actions/abc", wantErr: "is not an action href"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.href, func(t *testing.T) {
+			ref, err := parseActionHref(tc.href, base)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, ref)
+		})
+	}
+}
+
+func TestActionFollowTool_followAction(t *testing.T) {
+	inProgress := func(id int) *godo.Action {
+		return &godo.Action{ID: id, Type: "attach_volume", Status: godo.ActionInProgress}
+	}
+	completed := func(id int) *godo.Action {
+		return &godo.Action{ID: id, Type: "attach_volume", Status: godo.ActionCompleted}
+	}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*godo.Client, *gomock.Controller)
+		expectError string
+		check       func(*testing.T, ActionFollowResult)
+	}{
+		{
+			name: "Volume action completes",
+			args: map[string]any{"URI": "https://api.digitalocean.com/v2/volumes/vol-1/actions/5"},
+			mockSetup: func(c *godo.Client, ctrl *gomock.Controller) {
+				storage := NewMockStorageActionsService(ctrl)
+				gomock.InOrder(
+					storage.EXPECT().Get(gomock.Any(), "vol-1", 5).Return(inProgress(5), nil, nil),
+					storage.EXPECT().Get(gomock.Any(), "vol-1", 5).Return(completed(5), nil, nil),
+				)
+				c.StorageActions = storage
+			},
+			check: func(t *testing.T, r ActionFollowResult) {
+				require.True(t, r.Completed)
+				require.Equal(t, 2, r.Polls)
+				require.Equal(t, "volumes", r.ResourceType)
+				require.Equal(t, godo.ActionCompleted, r.Status)
+			},
+		},
+		{
+			name: "Droplet action",
+			args: map[string]any{"URI": "/v2/droplets/123/actions/6"},
+			mockSetup: func(c *godo.Client, ctrl *gomock.Controller) {
+				droplets := NewMockDropletActionsService(ctrl)
+				droplets.EXPECT().Get(gomock.Any(), 123, 6).Return(completed(6), nil, nil)
+				c.DropletActions = droplets
+			},
+			check: func(t *testing.T, r ActionFollowResult) {
+				require.True(t, r.Completed)
+				require.Equal(t, 6, r.Action.ID)
+			},
+		},
+		{
+			name: "Image action",
+			args: map[string]any{"URI": "https://api.digitalocean.com/v2/images/7/actions/8"},
+			mockSetup: func(c *godo.Client, ctrl *gomock.Controller) {
+				images := NewMockImageActionsService(ctrl)
+				images.EXPECT().Get(gomock.Any(), 7, 8).Return(completed(8), nil, nil)
+				c.ImageActions = images
+			},
+			check: func(t *testing.T, r ActionFollowResult) { require.True(t, r.Completed) },
+		},
+		{
+			name: "Reserved IP action",
+			args: map[string]any{"URI": "https://api.digitalocean.com/v2/reserved_ips/203.0.113.1/actions/9"},
+			mockSetup: func(c *godo.Client, ctrl *gomock.Controller) {
+				reservedIPs := NewMockReservedIPActionsService(ctrl)
+				reservedIPs.EXPECT().Get(gomock.Any(), "203.0.113.1", 9).Return(completed(9), nil, nil)
+				c.ReservedIPActions = reservedIPs
+			},
+			check: func(t *testing.T, r ActionFollowResult) { require.True(t, r.Completed) },
+		},
+		{
+			name: "Other resource types by action ID",
+			args: map[string]any{"URI": "https://api.digitalocean.com/v2/reserved_ipv6/2001:db8::1/actions/11"},
+			mockSetup: func(c *godo.Client, ctrl *gomock.Controller) {
+				actions := NewMockActionsService(ctrl)
+				actions.EXPECT().Get(gomock.Any(), 11).Return(completed(11), nil, nil)
+				c.Actions = actions
+			},
+			check: func(t *testing.T, r ActionFollowResult) { require.Equal(t, "reserved_ipv6", r.ResourceType) },
+		},
+		{
+			name: "Errored action",
+			args: map[string]any{"URI": "https://api.digitalocean.com/v2/actions/12"},
+			mockSetup: func(c *godo.Client, ctrl *gomock.Controller) {
+				actions := NewMockActionsService(ctrl)
+				actions.EXPECT().Get(gomock.Any(), 12).Return(&godo.Action{ID: 12, Type: "resize", Status: "errored"}, nil, nil)
+				c.Actions = actions
+			},
+			expectError: "action 12 (resize) errored",
+		},
+		{
+			name: "Timeout",
+			args: map[string]any{"URI": "https://api.digitalocean.com/v2/actions/13", "TimeoutSeconds": float64(20), "PollIntervalSeconds": float64(5)},
+			mockSetup: func(c *godo.Client, ctrl *gomock.Controller) {
+				actions := NewMockActionsService(ctrl)
+				actions.EXPECT().Get(gomock.Any(), 13).Return(inProgress(13), nil, nil).MinTimes(1)
+				c.Actions = actions
+			},
+			expectError: `action 13 did not complete within 20 seconds (last status "in-progress")`,
+		},
+		{
+			name:        "Other host",
+			args:        map[string]any{"URI": "https://evil.example.com/v2/actions/10"},
+			expectError: "is not on api.digitalocean.com",
+		},
+		{
+			name:        "Missing URI",
+			args:        map[string]any{},
+			expectError: "invalid arguments",
+		},
+		{
+			name:        "Poll interval too long",
+			args:        map[string]any{"URI": "/v2/actions/10", "PollIntervalSeconds": float64(120)},
+			expectError: "PollIntervalSeconds must be between 1 and 60",
+		},
+		{
+			name: "API error",
+			args: map[string]any{"URI": "/v2/actions/14"},
+			mockSetup: func(c *godo.Client, ctrl *gomock.Controller) {
+				actions := NewMockActionsService(ctrl)
+				actions.EXPECT().Get(gomock.Any(), 14).Return(nil, nil, errors.New("not found"))
+				c.Actions = actions
+			},
+			expectError: "api error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := godo.NewFromToken("token")
+			if tc.mockSetup != nil {
+				tc.mockSetup(client, ctrl)
+			}
+			tool := NewActionFollowTool(func(ctx context.Context) (*godo.Client, error) { return client, nil })
+			tool.unit = time.Millisecond
+
+			resp, err := tool.followAction(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			if tc.expectError != "" {
+				require.True(t, resp.IsError)
+				require.Contains(t, resp.Content[len(resp.Content)-1].(mcp.TextContent).Text, tc.expectError)
+				return
+			}
+			require.False(t, resp.IsError)
+			tc.check(t, *resp.StructuredContent.(*ActionFollowResult))
+		})
+	}
+}
@@ -89,8 +89,8 @@ func (a *ActionTools) Tools() []server.ServerTool {
 			Handler: a.listActions,
 			Tool: mcp.NewTool("action-list",
 				mcp.WithDescription("List actions with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultActionsPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultActionsPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultActionsPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultActionsPageSize), mcp.Description("Items per page")),
 			),
 		},
 	}
@@ -65,8 +65,8 @@ func (b *BillingTools) Tools() []server.ServerTool {
 			Handler: b.listBillingHistory,
 			Tool: mcp.NewTool("billing-history-list",
 				mcp.WithDescription("List billing history with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultBillingPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultBillingPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultBillingPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultBillingPageSize), mcp.Description("Items per page")),
 			),
 		},
 	}
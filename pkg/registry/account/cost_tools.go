@@ -0,0 +1,195 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// CostLine is the estimated cost of one part of a planned deployment.
+type CostLine struct {
+	Resource    string  `json:"resource"`
+	Size        string  `json:"size,omitempty"`
+	Quantity    float64 `json:"quantity"`
+	UnitMonthly float64 `json:"unit_monthly_usd"`
+	Monthly     float64 `json:"monthly_usd"`
+	Hourly      float64 `json:"hourly_usd"`
+}
+
+// CostEstimateResult is the estimated cost of a planned deployment.
+type CostEstimateResult struct {
+	Lines       []CostLine `json:"lines"`
+	MonthlyCost float64    `json:"monthly_cost_usd"`
+	HourlyCost  float64    `json:"hourly_cost_usd"`
+	Warnings    []string   `json:"warnings,omitempty"`
+	Note        string     `json:"note"`
+}
+
+// CostTools provides a tool that estimates the cost of resources before they are created.
+type CostTools struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+// NewCostTools creates a new CostTools instance.
+func NewCostTools(client func(ctx context.Context) (*godo.Client, error)) *CostTools {
+	return &CostTools{client: client}
+}
+
+// flatLine returns the cost line of a resource billed at a flat monthly rate per unit, with the
+// hourly cost reaching the monthly cost after hoursPerMonth hours.
+func flatLine(resource, size string, quantity, unitMonthly float64) CostLine {
+	monthly := quantity * unitMonthly
+	return CostLine{
+		Resource:    resource,
+		Size:        size,
+		Quantity:    quantity,
+		UnitMonthly: roundCents(unitMonthly),
+		Monthly:     roundCents(monthly),
+		Hourly:      monthly / hoursPerMonth,
+	}
+}
+
+// estimateCost prices a planned set of droplets, volumes, load balancers and snapshots from the
+// droplet sizes of the API and the published rates of the rest.
+func (c *CostTools) estimateCost(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	type plannedDroplets struct {
+		size    string
+		count   float64
+		backups bool
+	}
+	var planned []plannedDroplets
+	for _, droplet := range args.Objects("Droplets") {
+		planned = append(planned, plannedDroplets{
+			size:    droplet.RequiredString("Size"),
+			count:   droplet.Number("Count", 1),
+			backups: droplet.Bool("Backups", false),
+		})
+	}
+	region := args.String("Region")
+	volumeGB := args.Number("VolumeGB", 0)
+	loadBalancers := args.Number("LoadBalancers", 0)
+	loadBalancerNodes := args.Number("LoadBalancerNodes", 1)
+	snapshotGB := args.Number("SnapshotGB", 0)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	for _, d := range planned {
+		if d.count < 1 {
+			return mcp.NewToolResultError(fmt.Sprintf("Count of %s must be at least 1", d.size)), nil
+		}
+	}
+	if volumeGB < 0 || loadBalancers < 0 || snapshotGB < 0 || loadBalancerNodes < 1 {
+		return mcp.NewToolResultError("VolumeGB, LoadBalancers and SnapshotGB must not be negative, LoadBalancerNodes must be at least 1"), nil
+	}
+	if len(planned) == 0 && volumeGB == 0 && loadBalancers == 0 && snapshotGB == 0 {
+		return mcp.NewToolResultError("nothing to estimate: set Droplets, VolumeGB, LoadBalancers or SnapshotGB"), nil
+	}
+
+	result := &CostEstimateResult{
+		Lines: []CostLine{},
+		Note:  "Estimates in USD from list prices, before credits, discounts and bandwidth overages. Resources are billed by the hour up to their monthly price.",
+	}
+	if len(planned) > 0 {
+		client, err := c.client(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+		}
+		sizes, err := listSizes(ctx, client)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		for _, d := range planned {
+			size, ok := sizes[d.size]
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("unknown droplet size %q; use size-list for the available sizes", d.size)), nil
+			}
+			if !size.Available {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("size %s is not available for new droplets", d.size))
+			} else if region != "" && !slices.Contains(size.Regions, region) {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("size %s is not available in %s", d.size, region))
+			}
+			result.Lines = append(result.Lines, CostLine{
+				Resource:    "droplet",
+				Size:        d.size,
+				Quantity:    d.count,
+				UnitMonthly: size.PriceMonthly,
+				Monthly:     roundCents(size.PriceMonthly * d.count),
+				Hourly:      size.PriceHourly * d.count,
+			})
+			if d.backups {
+				result.Lines = append(result.Lines, flatLine("droplet backups", d.size, d.count, size.PriceMonthly*backupsRate))
+			}
+		}
+	}
+	if volumeGB > 0 {
+		result.Lines = append(result.Lines, flatLine("volume storage (GiB)", "", volumeGB, volumeGBMonthly))
+	}
+	if loadBalancers > 0 {
+		result.Lines = append(result.Lines, flatLine("load balancer", fmt.Sprintf("%g nodes", loadBalancerNodes), loadBalancers, loadBalancerNodes*loadBalancerNodeMonthly))
+	}
+	if snapshotGB > 0 {
+		result.Lines = append(result.Lines, flatLine("snapshot storage (GiB)", "", snapshotGB, snapshotGBMonthly))
+	}
+
+	for _, line := range result.Lines {
+		result.MonthlyCost += line.Monthly
+		result.HourlyCost += line.Hourly
+	}
+	result.MonthlyCost = roundCents(result.MonthlyCost)
+	for i := range result.Lines {
+		result.Lines[i].Hourly = roundHourly(result.Lines[i].Hourly)
+	}
+	result.HourlyCost = roundHourly(result.HourlyCost)
+	return common.NewToolResultStructured(result)
+}
+
+// roundHourly rounds an hourly price to the five decimals the API reports them with.
+func roundHourly(v float64) float64 {
+	return math.Round(v*100000) / 100000
+}
+
+// Tools returns the list of server tools for cost estimates.
+func (c *CostTools) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: c.estimateCost,
+			Tool: mcp.NewTool("cost-estimate",
+				mcp.WithDescription("Estimate the monthly and hourly cost in USD of resources before creating them: droplets by size and count, with or without backups, volume and snapshot storage in GiB, and load balancers by node count. Droplet prices come from the sizes API; the result lists each line, the totals and warnings for sizes that are unavailable, or unavailable in Region."),
+				common.WithOutputSchema[CostEstimateResult](),
+				mcp.WithArray("Droplets", mcp.Description("Planned droplets"), mcp.Items(map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"Size": map[string]any{
+							"type":        "string",
+							"description": "Slug of the droplet size (e.g., s-1vcpu-1gb)",
+						},
+						"Count": map[string]any{
+							"type":        "number",
+							"description": "Number of droplets of this size",
+							"default":     1,
+						},
+						"Backups": map[string]any{
+							"type":        "boolean",
+							"description": "Whether weekly backups are enabled",
+							"default":     false,
+						},
+					},
+					"required": []string{"Size"},
+				})),
+				mcp.WithString("Region", mcp.Description("Slug of the region, to warn about sizes not available there")),
+				mcp.WithNumber("VolumeGB", mcp.Description("Total size of the planned volumes in GiB")),
+				mcp.WithNumber("LoadBalancers", mcp.Description("Number of planned load balancers")),
+				mcp.WithNumber("LoadBalancerNodes", mcp.DefaultNumber(1), mcp.Description("Number of nodes of each load balancer")),
+				mcp.WithNumber("SnapshotGB", mcp.Description("Total size of the planned snapshots in GiB")),
+			),
+		},
+	}
+}
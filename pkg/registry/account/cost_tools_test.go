@@ -0,0 +1,104 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCostTools_estimateCost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sizeList := []godo.Size{
+		{Slug: "s-1vcpu-1gb", PriceMonthly: 6, PriceHourly: 0.00893, Available: true, Regions: []string{"nyc3", "ams3"}},
+		{Slug: "s-2vcpu-4gb", PriceMonthly: 24, PriceHourly: 0.03571, Available: true, Regions: []string{"nyc3"}},
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		listsSizes    bool
+		expectError   bool
+		expectMonthly float64
+		expectLines   int
+		expectWarning bool
+	}{
+		{
+			name: "Droplets, volumes and load balancers",
+			args: map[string]any{
+				"Droplets": []any{
+					map[string]any{"Size": "s-1vcpu-1gb", "Count": float64(3), "Backups": true},
+					map[string]any{"Size": "s-2vcpu-4gb"},
+				},
+				"VolumeGB":          float64(100),
+				"LoadBalancers":     float64(1),
+				"LoadBalancerNodes": float64(2),
+			},
+			listsSizes: true,
+			// 18 + 3.60 backups + 24 + 10 + 24
+			expectMonthly: 79.6,
+			expectLines:   5,
+		},
+		{
+			name:          "Size not in region",
+			args:          map[string]any{"Droplets": []any{map[string]any{"Size": "s-2vcpu-4gb"}}, "Region": "ams3"},
+			listsSizes:    true,
+			expectMonthly: 24,
+			expectLines:   1,
+			expectWarning: true,
+		},
+		{
+			name:          "Storage only needs no API call",
+			args:          map[string]any{"SnapshotGB": float64(50)},
+			expectMonthly: 3,
+			expectLines:   1,
+		},
+		{
+			name:        "Unknown size",
+			args:        map[string]any{"Droplets": []any{map[string]any{"Size": "s-huge"}}},
+			listsSizes:  true,
+			expectError: true,
+		},
+		{
+			name:        "Droplet without size",
+			args:        map[string]any{"Droplets": []any{map[string]any{"Count": float64(2)}}},
+			expectError: true,
+		},
+		{
+			name:        "Nothing to estimate",
+			args:        map[string]any{},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sizes := NewMockSizesService(ctrl)
+			if tc.listsSizes {
+				sizes.EXPECT().List(gomock.Any(), gomock.Any()).Return(sizeList, nil, nil)
+			}
+			tool := NewCostTools(func(ctx context.Context) (*godo.Client, error) {
+				return &godo.Client{Sizes: sizes}, nil
+			})
+			resp, err := tool.estimateCost(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			var result CostEstimateResult
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+			require.Equal(t, tc.expectMonthly, result.MonthlyCost)
+			require.Len(t, result.Lines, tc.expectLines)
+			require.Equal(t, tc.expectWarning, len(result.Warnings) > 0)
+			require.Positive(t, result.HourlyCost)
+		})
+	}
+}
@@ -1,3 +1,3 @@
 package account
 
-//go:generate mockgen -destination=./mocks.go -package account github.com/digitalocean/godo  AccountService,ActionsService,BalanceService,BillingHistoryService,InvoicesService,KeysService
+//go:generate mockgen -destination=./mocks.go -package account github.com/digitalocean/godo  AccountService,ActionsService,DropletActionsService,ImageActionsService,StorageActionsService,ReservedIPActionsService,AppsService,BalanceService,BillingHistoryService,CDNService,CertificatesService,DatabasesService,DomainsService,DropletsService,FirewallsService,InvoicesService,KeysService,KubernetesService,LoadBalancersService,ProjectsService,ReservedIPsService,SizesService,SnapshotsService,StorageService,TagsService,VPCsService
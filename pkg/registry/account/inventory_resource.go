@@ -0,0 +1,237 @@
+package account
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	middleware "mcp-digitalocean/internal"
+	"mcp-digitalocean/internal/fanout"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// InventoryURI is the URI of the account inventory resource.
+	InventoryURI = "account://inventory"
+	// DefaultInventoryTTL is how long a rendered inventory is served before it is refreshed.
+	DefaultInventoryTTL = 5 * time.Minute
+	inventoryPageSize   = 200
+	// listConcurrency is how many kinds of resources the inventory and the orphan audit list at once.
+	listConcurrency = 4
+)
+
+// Inventory is the JSON snapshot rendered by the account://inventory resource.
+type Inventory struct {
+	GeneratedAt string            `json:"generated_at"`
+	Account     *godo.Account     `json:"account"`
+	Counts      map[string]int    `json:"counts"`
+	Resources   map[string]any    `json:"resources"`
+	Errors      map[string]string `json:"errors,omitempty"`
+}
+
+// inventorySection lists every resource of one kind.
+type inventorySection struct {
+	name  string
+	fetch func(ctx context.Context, client *godo.Client) (any, error)
+}
+
+// inventorySections are the resource kinds included in the inventory.
+var inventorySections = []inventorySection{
+	{"droplets", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.Droplets.List)
+	}},
+	{"volumes", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.Volume, *godo.Response, error) {
+			return c.Storage.ListVolumes(ctx, &godo.ListVolumeParams{ListOptions: opt})
+		})
+	}},
+	{"snapshots", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.Snapshots.List)
+	}},
+	{"kubernetes_clusters", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.Kubernetes.List)
+	}},
+	{"databases", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.Databases.List)
+	}},
+	{"apps", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.Apps.List)
+	}},
+	{"load_balancers", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.LoadBalancers.List)
+	}},
+	{"firewalls", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.Firewalls.List)
+	}},
+	{"vpcs", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.VPCs.List)
+	}},
+	{"reserved_ips", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.ReservedIPs.List)
+	}},
+	{"domains", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.Domains.List)
+	}},
+	{"certificates", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.Certificates.List)
+	}},
+	{"cdns", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.CDNs.List)
+	}},
+	{"projects", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.Projects.List)
+	}},
+	{"tags", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.Tags.List)
+	}},
+	{"ssh_keys", func(ctx context.Context, c *godo.Client) (any, error) {
+		return common.ListAll(ctx, inventoryPageSize, c.Keys.List)
+	}},
+}
+
+// inventoryEntry is a rendered inventory for one account.
+type inventoryEntry struct {
+	mu        sync.Mutex
+	data      []byte
+	fetchedAt time.Time
+}
+
+// InventoryResource serves the account://inventory resource. Rendered snapshots are cached
+// per account and refreshed on the first read after they are older than the TTL.
+type InventoryResource struct {
+	client func(ctx context.Context) (*godo.Client, error)
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*inventoryEntry
+}
+
+// NewInventoryResource creates a new account inventory resource
+func NewInventoryResource(client func(ctx context.Context) (*godo.Client, error)) *InventoryResource {
+	return &InventoryResource{
+		client:  client,
+		ttl:     DefaultInventoryTTL,
+		now:     time.Now,
+		entries: map[string]*inventoryEntry{},
+	}
+}
+
+// readInventory returns the cached inventory of the caller's account, refreshing it when stale.
+func (i *InventoryResource) readInventory(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	client, err := i.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	// The account lookup is cheap and keys the cache, so one caller never sees another account's inventory.
+	account, _, err := client.Account.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("api error: %w", err)
+	}
+	entry := i.entry(inventoryKey(ctx, account))
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if entry.data == nil || i.now().Sub(entry.fetchedAt) >= i.ttl {
+		data, err := json.MarshalIndent(i.collect(ctx, client, account), "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: %w", err)
+		}
+		entry.data = data
+		entry.fetchedAt = i.now()
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      req.Params.URI,
+			MIMEType: "application/json",
+			Text:     string(entry.data),
+		},
+	}, nil
+}
+
+// inventoryKey identifies the account and team of an inventory and, in HTTP transport mode, a
+// hash of the caller's credentials, so tokens of different scopes of one account are not served
+// each other's inventory.
+func inventoryKey(ctx context.Context, account *godo.Account) string {
+	key := account.UUID
+	if account.Team != nil {
+		key += "/" + account.Team.UUID
+	}
+	auth, _ := ctx.Value(middleware.AuthKey{}).(string)
+	if auth == "" {
+		return key
+	}
+	h := sha256.Sum256([]byte(auth))
+	return hex.EncodeToString(h[:8]) + ":" + key
+}
+
+// entry returns the cache entry for key, dropping entries that expired long ago.
+func (i *InventoryResource) entry(key string) *inventoryEntry {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for k, e := range i.entries {
+		if k != key && e.mu.TryLock() {
+			if i.now().Sub(e.fetchedAt) >= 2*i.ttl {
+				delete(i.entries, k)
+			}
+			e.mu.Unlock()
+		}
+	}
+	e, ok := i.entries[key]
+	if !ok {
+		e = &inventoryEntry{}
+		i.entries[key] = e
+	}
+	return e
+}
+
+// collect lists every inventory section concurrently. A failing section is reported in Errors
+// rather than failing the whole inventory, since tokens may be scoped to a subset of resources.
+func (i *InventoryResource) collect(ctx context.Context, client *godo.Client, account *godo.Account) *Inventory {
+	inventory := &Inventory{
+		GeneratedAt: i.now().UTC().Format(time.RFC3339),
+		Account:     account,
+		Counts:      map[string]int{},
+		Resources:   map[string]any{},
+	}
+
+	outcomes := fanout.Run(ctx, inventorySections, fanout.Options{Concurrency: listConcurrency}, func(ctx context.Context, section inventorySection) (any, error) {
+		return section.fetch(ctx, client)
+	})
+	for n, section := range inventorySections {
+		if err := outcomes[n].Err; err != nil {
+			if inventory.Errors == nil {
+				inventory.Errors = map[string]string{}
+			}
+			inventory.Errors[section.name] = err.Error()
+			continue
+		}
+		inventory.Resources[section.name] = outcomes[n].Value
+		inventory.Counts[section.name] = reflect.ValueOf(outcomes[n].Value).Len()
+	}
+
+	return inventory
+}
+
+// Resources returns the account inventory resource
+func (i *InventoryResource) Resources() []server.ServerResource {
+	return []server.ServerResource{
+		{
+			Resource: mcp.NewResource(InventoryURI, "Account inventory",
+				mcp.WithResourceDescription("JSON snapshot of the account and all of its major resources (droplets, volumes, snapshots, Kubernetes clusters, databases, apps, load balancers, firewalls, VPCs, reserved IPs, domains, certificates, CDNs, projects, tags and SSH keys). The snapshot is cached and refreshed every few minutes."),
+				mcp.WithMIMEType("application/json"),
+			),
+			Handler: i.readInventory,
+		},
+	}
+}
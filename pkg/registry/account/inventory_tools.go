@@ -0,0 +1,289 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	"mcp-digitalocean/internal/fanout"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// InventoryItem is one resource of an inventory group.
+type InventoryItem struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Region string   `json:"region,omitempty"`
+	Size   string   `json:"size,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+	// MonthlyCost is the estimated monthly cost in USD, absent when it cannot be estimated.
+	MonthlyCost *float64 `json:"monthly_cost_usd,omitempty"`
+}
+
+// InventoryGroup summarizes the resources of one kind.
+type InventoryGroup struct {
+	Kind        string          `json:"kind"`
+	Count       int             `json:"count"`
+	Regions     map[string]int  `json:"regions,omitempty"`
+	MonthlyCost float64         `json:"monthly_cost_usd"`
+	Unpriced    int             `json:"unpriced,omitempty"`
+	Items       []InventoryItem `json:"items,omitempty"`
+	Error       string          `json:"error,omitempty"`
+}
+
+// InventoryListResult is the grouped summary returned by inventory-list.
+type InventoryListResult struct {
+	Groups      []InventoryGroup `json:"groups"`
+	Total       int              `json:"total"`
+	MonthlyCost float64          `json:"monthly_cost_usd"`
+	Note        string           `json:"note"`
+}
+
+// inventoryGroup lists the resources of one kind as inventory items.
+type inventoryGroup struct {
+	kind  string
+	fetch func(ctx context.Context, c *godo.Client, prices sizePrices) ([]InventoryItem, error)
+}
+
+// price returns a monthly cost rounded to cents, or nil when it is not known.
+func price(monthly float64, known bool) *float64 {
+	if !known {
+		return nil
+	}
+	rounded := roundCents(monthly)
+	return &rounded
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// inventoryGroups are the resource kinds inventory-list summarizes, in the order they are reported.
+var inventoryGroups = []inventoryGroup{
+	{"droplets", func(ctx context.Context, c *godo.Client, prices sizePrices) ([]InventoryItem, error) {
+		droplets, err := common.ListAll(ctx, inventoryPageSize, c.Droplets.List)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]InventoryItem, 0, len(droplets))
+		for _, d := range droplets {
+			item := InventoryItem{ID: strconv.Itoa(d.ID), Name: d.Name, Size: d.SizeSlug, Tags: d.Tags}
+			if d.Region != nil {
+				item.Region = d.Region.Slug
+			}
+			if d.Size != nil {
+				item.MonthlyCost = price(d.Size.PriceMonthly, true)
+			} else {
+				monthly, ok := prices[d.SizeSlug]
+				item.MonthlyCost = price(monthly, ok)
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	}},
+	{"volumes", func(ctx context.Context, c *godo.Client, _ sizePrices) ([]InventoryItem, error) {
+		volumes, err := common.ListAll(ctx, inventoryPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.Volume, *godo.Response, error) {
+			return c.Storage.ListVolumes(ctx, &godo.ListVolumeParams{ListOptions: opt})
+		})
+		if err != nil {
+			return nil, err
+		}
+		items := make([]InventoryItem, 0, len(volumes))
+		for _, v := range volumes {
+			item := InventoryItem{ID: v.ID, Name: v.Name, Size: fmt.Sprintf("%d GiB", v.SizeGigaBytes), Tags: v.Tags}
+			if v.Region != nil {
+				item.Region = v.Region.Slug
+			}
+			item.MonthlyCost = price(float64(v.SizeGigaBytes)*volumeGBMonthly, true)
+			items = append(items, item)
+		}
+		return items, nil
+	}},
+	{"load_balancers", func(ctx context.Context, c *godo.Client, _ sizePrices) ([]InventoryItem, error) {
+		lbs, err := common.ListAll(ctx, inventoryPageSize, c.LoadBalancers.List)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]InventoryItem, 0, len(lbs))
+		for _, lb := range lbs {
+			item := InventoryItem{ID: lb.ID, Name: lb.Name, Size: lb.SizeSlug, Tags: lb.Tags}
+			if lb.SizeUnit > 0 {
+				item.Size = fmt.Sprintf("%d nodes", lb.SizeUnit)
+			}
+			if lb.Region != nil {
+				item.Region = lb.Region.Slug
+			}
+			item.MonthlyCost = price(loadBalancerMonthly(lb))
+			items = append(items, item)
+		}
+		return items, nil
+	}},
+	{"databases", func(ctx context.Context, c *godo.Client, _ sizePrices) ([]InventoryItem, error) {
+		databases, err := common.ListAll(ctx, inventoryPageSize, c.Databases.List)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]InventoryItem, 0, len(databases))
+		for _, db := range databases {
+			// The API does not report database prices.
+			items = append(items, InventoryItem{
+				ID:     db.ID,
+				Name:   db.Name,
+				Region: db.RegionSlug,
+				Size:   fmt.Sprintf("%s x%d", db.SizeSlug, db.NumNodes),
+				Tags:   db.Tags,
+			})
+		}
+		return items, nil
+	}},
+	{"kubernetes_clusters", func(ctx context.Context, c *godo.Client, prices sizePrices) ([]InventoryItem, error) {
+		clusters, err := common.ListAll(ctx, inventoryPageSize, c.Kubernetes.List)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]InventoryItem, 0, len(clusters))
+		for _, cluster := range clusters {
+			nodes := 0
+			for _, pool := range cluster.NodePools {
+				nodes += pool.Count
+			}
+			items = append(items, InventoryItem{
+				ID:          cluster.ID,
+				Name:        cluster.Name,
+				Region:      cluster.RegionSlug,
+				Size:        fmt.Sprintf("%d nodes", nodes),
+				Tags:        cluster.Tags,
+				MonthlyCost: price(clusterMonthly(cluster, prices)),
+			})
+		}
+		return items, nil
+	}},
+	{"domains", func(ctx context.Context, c *godo.Client, _ sizePrices) ([]InventoryItem, error) {
+		domains, err := common.ListAll(ctx, inventoryPageSize, c.Domains.List)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]InventoryItem, 0, len(domains))
+		for _, d := range domains {
+			items = append(items, InventoryItem{ID: d.Name, Name: d.Name, MonthlyCost: price(0, true)})
+		}
+		return items, nil
+	}},
+	{"snapshots", func(ctx context.Context, c *godo.Client, _ sizePrices) ([]InventoryItem, error) {
+		snapshots, err := common.ListAll(ctx, inventoryPageSize, c.Snapshots.List)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]InventoryItem, 0, len(snapshots))
+		for _, s := range snapshots {
+			item := InventoryItem{ID: s.ID, Name: s.Name, Size: fmt.Sprintf("%g GiB", s.SizeGigaBytes), Tags: s.Tags}
+			if len(s.Regions) > 0 {
+				item.Region = s.Regions[0]
+			}
+			// snapshots are billed once per region they are stored in.
+			item.MonthlyCost = price(s.SizeGigaBytes*snapshotGBMonthly*float64(max(len(s.Regions), 1)), true)
+			items = append(items, item)
+		}
+		return items, nil
+	}},
+}
+
+// InventoryTools provides a tool that summarizes everything running on the account.
+type InventoryTools struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+// NewInventoryTools creates a new InventoryTools instance.
+func NewInventoryTools(client func(ctx context.Context) (*godo.Client, error)) *InventoryTools {
+	return &InventoryTools{client: client}
+}
+
+// listInventory lists every inventory group concurrently and summarizes each by count, region and
+// estimated monthly cost. A group the token cannot list reports its error instead of failing the
+// whole inventory. With Tag, only the resources carrying the tag are summarized.
+func (i *InventoryTools) listInventory(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	includeItems := args.Bool("IncludeItems", false)
+	tag := args.String("Tag")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := i.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	// Without size prices, droplets still carry theirs; only Kubernetes nodes go unpriced.
+	prices, err := listSizePrices(ctx, client)
+	if err != nil {
+		prices = sizePrices{}
+	}
+
+	result := &InventoryListResult{
+		Groups: make([]InventoryGroup, len(inventoryGroups)),
+		Note:   "Costs are estimates in USD from list prices, before credits, discounts and bandwidth overages. Database prices are not reported by the API and are not included.",
+	}
+	outcomes := fanout.Run(ctx, inventoryGroups, fanout.Options{Concurrency: listConcurrency}, func(ctx context.Context, group inventoryGroup) ([]InventoryItem, error) {
+		return group.fetch(ctx, client, prices)
+	})
+	for n, group := range inventoryGroups {
+		summary := InventoryGroup{Kind: group.kind}
+		items, err := outcomes[n].Value, outcomes[n].Err
+		if err != nil {
+			summary.Error = err.Error()
+			result.Groups[n] = summary
+			continue
+		}
+		if tag != "" {
+			items = slices.DeleteFunc(items, func(item InventoryItem) bool { return !slices.Contains(item.Tags, tag) })
+		}
+		summary.Count = len(items)
+		for _, item := range items {
+			if item.Region != "" {
+				if summary.Regions == nil {
+					summary.Regions = map[string]int{}
+				}
+				summary.Regions[item.Region]++
+			}
+			if item.MonthlyCost == nil {
+				summary.Unpriced++
+			} else {
+				summary.MonthlyCost += *item.MonthlyCost
+			}
+		}
+		summary.MonthlyCost = roundCents(summary.MonthlyCost)
+		if includeItems {
+			summary.Items = items
+		}
+		result.Groups[n] = summary
+	}
+
+	for _, group := range result.Groups {
+		result.Total += group.Count
+		result.MonthlyCost += group.MonthlyCost
+	}
+	result.MonthlyCost = roundCents(result.MonthlyCost)
+	return common.NewToolResultStructured(result)
+}
+
+// Tools returns the list of server tools for the inventory.
+func (i *InventoryTools) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: i.listInventory,
+			Tool: mcp.NewTool("inventory-list",
+				mcp.WithDescription("Answer \"what do I have running?\": lists droplets, volumes, load balancers, databases, Kubernetes clusters, domains and snapshots concurrently and returns, per kind, the count, the count per region and the estimated monthly cost in USD, plus the account total. Database costs are not estimated. Use IncludeItems to also list each resource with its own estimate, and Tag to only count the resources with a tag, such as the --managed-tag of the resources this server created."),
+				common.WithOutputSchema[InventoryListResult](),
+				mcp.WithBoolean("IncludeItems", mcp.DefaultBool(false), mcp.Description("Also list every resource with its ID, name, region, size and estimated monthly cost")),
+				mcp.WithString("Tag", mcp.Description("Only summarize resources carrying this tag, e.g. mcp-managed. Domains have no tags and are left out")),
+			),
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInventoryTools_listInventory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sizes := NewMockSizesService(ctrl)
+	droplets := NewMockDropletsService(ctrl)
+	storage := NewMockStorageService(ctrl)
+	loadBalancers := NewMockLoadBalancersService(ctrl)
+	databases := NewMockDatabasesService(ctrl)
+	kubernetes := NewMockKubernetesService(ctrl)
+	domains := NewMockDomainsService(ctrl)
+	snapshots := NewMockSnapshotsService(ctrl)
+
+	sizes.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Size{
+		{Slug: "s-1vcpu-1gb", PriceMonthly: 6},
+		{Slug: "s-2vcpu-4gb", PriceMonthly: 24},
+	}, nil, nil).Times(3)
+	droplets.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Droplet{
+		{ID: 1, Name: "web-1", Region: &godo.Region{Slug: "nyc3"}, SizeSlug: "s-1vcpu-1gb", Size: &godo.Size{PriceMonthly: 6}, Tags: []string{"mcp-managed"}},
+		{ID: 2, Name: "web-2", Region: &godo.Region{Slug: "ams3"}, SizeSlug: "s-2vcpu-4gb"},
+	}, nil, nil).Times(3)
+	storage.EXPECT().ListVolumes(gomock.Any(), gomock.Any()).Return([]godo.Volume{
+		{ID: "vol-1", Name: "data", Region: &godo.Region{Slug: "nyc3"}, SizeGigaBytes: 100, Tags: []string{"mcp-managed", "db"}},
+	}, nil, nil).Times(3)
+	loadBalancers.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.LoadBalancer{
+		{ID: "lb-1", Name: "web-lb", Region: &godo.Region{Slug: "nyc3"}, SizeUnit: 2},
+	}, nil, nil).Times(3)
+	databases.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Database{
+		{ID: "db-1", Name: "pg", RegionSlug: "nyc3", SizeSlug: "db-s-1vcpu-1gb", NumNodes: 1},
+	}, nil, nil).Times(3)
+	kubernetes.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*godo.KubernetesCluster{
+		{ID: "k8s-1", Name: "prod", RegionSlug: "ams3", HA: true, NodePools: []*godo.KubernetesNodePool{{Size: "s-2vcpu-4gb", Count: 3}}},
+	}, nil, nil).Times(3)
+	domains.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Domain{{Name: "example.com"}}, nil, nil).Times(3)
+	gomock.InOrder(
+		snapshots.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Snapshot{
+			{ID: "snap-1", Name: "backup", Regions: []string{"nyc3", "ams3"}, SizeGigaBytes: 50},
+		}, nil, nil),
+		snapshots.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("forbidden")),
+		snapshots.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Snapshot{{ID: "snap-1", Name: "backup"}}, nil, nil),
+	)
+
+	tool := NewInventoryTools(func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Sizes:         sizes,
+			Droplets:      droplets,
+			Storage:       storage,
+			LoadBalancers: loadBalancers,
+			Databases:     databases,
+			Kubernetes:    kubernetes,
+			Domains:       domains,
+			Snapshots:     snapshots,
+		}, nil
+	})
+	call := func(t *testing.T, args map[string]any) InventoryListResult {
+		t.Helper()
+		resp, err := tool.listInventory(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		require.False(t, resp.IsError)
+		var result InventoryListResult
+		require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+		return result
+	}
+
+	t.Run("Summarizes every group", func(t *testing.T) {
+		result := call(t, map[string]any{"IncludeItems": true})
+		groups := map[string]InventoryGroup{}
+		for _, group := range result.Groups {
+			groups[group.Kind] = group
+		}
+		require.Len(t, groups, 7)
+
+		require.Equal(t, 2, groups["droplets"].Count)
+		require.Equal(t, map[string]int{"nyc3": 1, "ams3": 1}, groups["droplets"].Regions)
+		require.Equal(t, 30.0, groups["droplets"].MonthlyCost)
+		require.Equal(t, 10.0, groups["volumes"].MonthlyCost)
+		require.Equal(t, 24.0, groups["load_balancers"].MonthlyCost)
+		require.Equal(t, 1, groups["databases"].Unpriced)
+		require.Nil(t, groups["databases"].Items[0].MonthlyCost)
+		require.Equal(t, 112.0, groups["kubernetes_clusters"].MonthlyCost)
+		require.Equal(t, 6.0, groups["snapshots"].MonthlyCost)
+		require.Equal(t, 8, result.Total)
+		require.Equal(t, 182.0, result.MonthlyCost)
+	})
+
+	t.Run("Reports a failing group", func(t *testing.T) {
+		result := call(t, map[string]any{})
+		for _, group := range result.Groups {
+			require.Empty(t, group.Items)
+			if group.Kind == "snapshots" {
+				require.Equal(t, "forbidden", group.Error)
+			} else {
+				require.Empty(t, group.Error)
+			}
+		}
+		require.Equal(t, 7, result.Total)
+	})
+
+	t.Run("Only counts resources with the tag", func(t *testing.T) {
+		result := call(t, map[string]any{"Tag": "mcp-managed"})
+		counts := map[string]int{}
+		for _, group := range result.Groups {
+			counts[group.Kind] = group.Count
+		}
+		require.Equal(t, map[string]int{"droplets": 1, "volumes": 1, "load_balancers": 0, "databases": 0, "kubernetes_clusters": 0, "domains": 0, "snapshots": 0}, counts)
+		require.Equal(t, 2, result.Total)
+		require.Equal(t, 16.0, result.MonthlyCost)
+	})
+}
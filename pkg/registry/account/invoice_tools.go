@@ -94,8 +94,8 @@ func (i *InvoiceTools) Tools() []server.ServerTool {
 			Handler: i.listInvoices,
 			Tool: mcp.NewTool("invoice-list",
 				mcp.WithDescription("List invoices with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultInvoicesPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultInvoicesPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultInvoicesPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultInvoicesPageSize), mcp.Description("Items per page")),
 			),
 		},
 		{
@@ -103,8 +103,8 @@ func (i *InvoiceTools) Tools() []server.ServerTool {
 			Tool: mcp.NewTool("get-invoice",
 				mcp.WithDescription("Get a specific invoice"),
 				mcp.WithString("InvoiceUUID", mcp.Required(), mcp.Description("The UUID of the invoice")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultInvoicesPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultInvoicesPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultInvoicesPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultInvoicesPageSize), mcp.Description("Items per page")),
 			),
 		},
 	}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -28,9 +30,12 @@ func NewKeysTool(client func(ctx context.Context) (*godo.Client, error)) *KeysTo
 }
 
 func (k *KeysTool) createKey(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.GetArguments()
-	name := args["Name"].(string)
-	publicKey := args["PublicKey"].(string)
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	publicKey := args.RequiredString("PublicKey")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := k.client(ctx)
 	if err != nil {
@@ -54,7 +59,11 @@ func (k *KeysTool) createKey(ctx context.Context, req mcp.CallToolRequest) (*mcp
 }
 
 func (k *KeysTool) deleteKey(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	keyID := int(req.GetArguments()["ID"].(float64))
+	args := common.NewArgs(req)
+	keyID := int(args.RequiredNumber("ID"))
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := k.client(ctx)
 	if err != nil {
@@ -148,8 +157,8 @@ func (k *KeysTool) Tools() []server.ServerTool {
 			Handler: k.listKeys,
 			Tool: mcp.NewTool("key-list",
 				mcp.WithDescription("List SSH keys with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultKeysPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultKeysPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultKeysPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultKeysPageSize), mcp.Description("Items per page")),
 			),
 		},
 	}
@@ -125,6 +125,27 @@ func TestKeysTool_deleteKey(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name:        "Missing ID",
+			args:        map[string]any{},
+			expectError: true,
+		},
+		{
+			name: "String ID",
+			args: map[string]any{"ID": "789"},
+			mockSetup: func(m *MockKeysService) {
+				m.EXPECT().
+					DeleteByID(gomock.Any(), 789).
+					Return(nil, nil).
+					Times(1)
+			},
+			expectText: "SSH key deleted successfully",
+		},
+		{
+			name:        "Non-numeric ID",
+			args:        map[string]any{"ID": "my-key"},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
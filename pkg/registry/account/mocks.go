@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/digitalocean/godo (interfaces: AccountService,ActionsService,BalanceService,BillingHistoryService,InvoicesService,KeysService)
+// Source: github.com/digitalocean/godo (interfaces: AccountService,ActionsService,DropletActionsService,ImageActionsService,StorageActionsService,ReservedIPActionsService,AppsService,BalanceService,BillingHistoryService,CDNService,CertificatesService,DatabasesService,DomainsService,DropletsService,FirewallsService,InvoicesService,KeysService,KubernetesService,LoadBalancersService,ProjectsService,ReservedIPsService,SizesService,SnapshotsService,StorageService,TagsService,VPCsService)
 //
 // Generated by this command:
 //
-//	mockgen -destination=./mocks.go -package account github.com/digitalocean/godo AccountService,ActionsService,BalanceService,BillingHistoryService,InvoicesService,KeysService
+//	mockgen -destination=./mocks.go -package account github.com/digitalocean/godo AccountService,ActionsService,DropletActionsService,ImageActionsService,StorageActionsService,ReservedIPActionsService,AppsService,BalanceService,BillingHistoryService,CDNService,CertificatesService,DatabasesService,DomainsService,DropletsService,FirewallsService,InvoicesService,KeysService,KubernetesService,LoadBalancersService,ProjectsService,ReservedIPsService,SizesService,SnapshotsService,StorageService,TagsService,VPCsService
 //
 
 // Package account is a generated GoMock package.
@@ -113,336 +113,5652 @@ func (mr *MockActionsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockActionsService)(nil).List), arg0, arg1)
 }
 
+// MockDropletActionsService is a mock of DropletActionsService interface.
+type MockDropletActionsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDropletActionsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDropletActionsServiceMockRecorder is the mock recorder for MockDropletActionsService.
+type MockDropletActionsServiceMockRecorder struct {
+	mock *MockDropletActionsService
+}
+
+// NewMockDropletActionsService creates a new mock instance.
+func NewMockDropletActionsService(ctrl *gomock.Controller) *MockDropletActionsService {
+	mock := &MockDropletActionsService{ctrl: ctrl}
+	mock.recorder = &MockDropletActionsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDropletActionsService) EXPECT() *MockDropletActionsServiceMockRecorder {
+	return m.recorder
+}
+
+// ChangeBackupPolicy mocks base method.
+func (m *MockDropletActionsService) ChangeBackupPolicy(arg0 context.Context, arg1 int, arg2 *godo.DropletBackupPolicyRequest) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeBackupPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ChangeBackupPolicy indicates an expected call of ChangeBackupPolicy.
+func (mr *MockDropletActionsServiceMockRecorder) ChangeBackupPolicy(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBackupPolicy", reflect.TypeOf((*MockDropletActionsService)(nil).ChangeBackupPolicy), arg0, arg1, arg2)
+}
+
+// ChangeKernel mocks base method.
+func (m *MockDropletActionsService) ChangeKernel(arg0 context.Context, arg1, arg2 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeKernel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ChangeKernel indicates an expected call of ChangeKernel.
+func (mr *MockDropletActionsServiceMockRecorder) ChangeKernel(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeKernel", reflect.TypeOf((*MockDropletActionsService)(nil).ChangeKernel), arg0, arg1, arg2)
+}
+
+// DisableBackups mocks base method.
+func (m *MockDropletActionsService) DisableBackups(arg0 context.Context, arg1 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableBackups", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DisableBackups indicates an expected call of DisableBackups.
+func (mr *MockDropletActionsServiceMockRecorder) DisableBackups(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableBackups", reflect.TypeOf((*MockDropletActionsService)(nil).DisableBackups), arg0, arg1)
+}
+
+// DisableBackupsByTag mocks base method.
+func (m *MockDropletActionsService) DisableBackupsByTag(arg0 context.Context, arg1 string) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableBackupsByTag", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DisableBackupsByTag indicates an expected call of DisableBackupsByTag.
+func (mr *MockDropletActionsServiceMockRecorder) DisableBackupsByTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableBackupsByTag", reflect.TypeOf((*MockDropletActionsService)(nil).DisableBackupsByTag), arg0, arg1)
+}
+
+// EnableBackups mocks base method.
+func (m *MockDropletActionsService) EnableBackups(arg0 context.Context, arg1 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableBackups", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnableBackups indicates an expected call of EnableBackups.
+func (mr *MockDropletActionsServiceMockRecorder) EnableBackups(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableBackups", reflect.TypeOf((*MockDropletActionsService)(nil).EnableBackups), arg0, arg1)
+}
+
+// EnableBackupsByTag mocks base method.
+func (m *MockDropletActionsService) EnableBackupsByTag(arg0 context.Context, arg1 string) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableBackupsByTag", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnableBackupsByTag indicates an expected call of EnableBackupsByTag.
+func (mr *MockDropletActionsServiceMockRecorder) EnableBackupsByTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableBackupsByTag", reflect.TypeOf((*MockDropletActionsService)(nil).EnableBackupsByTag), arg0, arg1)
+}
+
+// EnableBackupsWithPolicy mocks base method.
+func (m *MockDropletActionsService) EnableBackupsWithPolicy(arg0 context.Context, arg1 int, arg2 *godo.DropletBackupPolicyRequest) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableBackupsWithPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnableBackupsWithPolicy indicates an expected call of EnableBackupsWithPolicy.
+func (mr *MockDropletActionsServiceMockRecorder) EnableBackupsWithPolicy(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableBackupsWithPolicy", reflect.TypeOf((*MockDropletActionsService)(nil).EnableBackupsWithPolicy), arg0, arg1, arg2)
+}
+
+// EnableIPv6 mocks base method.
+func (m *MockDropletActionsService) EnableIPv6(arg0 context.Context, arg1 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableIPv6", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnableIPv6 indicates an expected call of EnableIPv6.
+func (mr *MockDropletActionsServiceMockRecorder) EnableIPv6(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableIPv6", reflect.TypeOf((*MockDropletActionsService)(nil).EnableIPv6), arg0, arg1)
+}
+
+// EnableIPv6ByTag mocks base method.
+func (m *MockDropletActionsService) EnableIPv6ByTag(arg0 context.Context, arg1 string) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableIPv6ByTag", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnableIPv6ByTag indicates an expected call of EnableIPv6ByTag.
+func (mr *MockDropletActionsServiceMockRecorder) EnableIPv6ByTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableIPv6ByTag", reflect.TypeOf((*MockDropletActionsService)(nil).EnableIPv6ByTag), arg0, arg1)
+}
+
+// EnablePrivateNetworking mocks base method.
+func (m *MockDropletActionsService) EnablePrivateNetworking(arg0 context.Context, arg1 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnablePrivateNetworking", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnablePrivateNetworking indicates an expected call of EnablePrivateNetworking.
+func (mr *MockDropletActionsServiceMockRecorder) EnablePrivateNetworking(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnablePrivateNetworking", reflect.TypeOf((*MockDropletActionsService)(nil).EnablePrivateNetworking), arg0, arg1)
+}
+
+// EnablePrivateNetworkingByTag mocks base method.
+func (m *MockDropletActionsService) EnablePrivateNetworkingByTag(arg0 context.Context, arg1 string) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnablePrivateNetworkingByTag", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnablePrivateNetworkingByTag indicates an expected call of EnablePrivateNetworkingByTag.
+func (mr *MockDropletActionsServiceMockRecorder) EnablePrivateNetworkingByTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnablePrivateNetworkingByTag", reflect.TypeOf((*MockDropletActionsService)(nil).EnablePrivateNetworkingByTag), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockDropletActionsService) Get(arg0 context.Context, arg1, arg2 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDropletActionsServiceMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDropletActionsService)(nil).Get), arg0, arg1, arg2)
+}
+
+// GetByURI mocks base method.
+func (m *MockDropletActionsService) GetByURI(arg0 context.Context, arg1 string) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByURI", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByURI indicates an expected call of GetByURI.
+func (mr *MockDropletActionsServiceMockRecorder) GetByURI(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByURI", reflect.TypeOf((*MockDropletActionsService)(nil).GetByURI), arg0, arg1)
+}
+
+// PasswordReset mocks base method.
+func (m *MockDropletActionsService) PasswordReset(arg0 context.Context, arg1 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PasswordReset indicates an expected call of PasswordReset.
+func (mr *MockDropletActionsServiceMockRecorder) PasswordReset(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordReset", reflect.TypeOf((*MockDropletActionsService)(nil).PasswordReset), arg0, arg1)
+}
+
+// PowerCycle mocks base method.
+func (m *MockDropletActionsService) PowerCycle(arg0 context.Context, arg1 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerCycle", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PowerCycle indicates an expected call of PowerCycle.
+func (mr *MockDropletActionsServiceMockRecorder) PowerCycle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerCycle", reflect.TypeOf((*MockDropletActionsService)(nil).PowerCycle), arg0, arg1)
+}
+
+// PowerCycleByTag mocks base method.
+func (m *MockDropletActionsService) PowerCycleByTag(arg0 context.Context, arg1 string) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerCycleByTag", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PowerCycleByTag indicates an expected call of PowerCycleByTag.
+func (mr *MockDropletActionsServiceMockRecorder) PowerCycleByTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerCycleByTag", reflect.TypeOf((*MockDropletActionsService)(nil).PowerCycleByTag), arg0, arg1)
+}
+
+// PowerOff mocks base method.
+func (m *MockDropletActionsService) PowerOff(arg0 context.Context, arg1 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerOff", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PowerOff indicates an expected call of PowerOff.
+func (mr *MockDropletActionsServiceMockRecorder) PowerOff(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerOff", reflect.TypeOf((*MockDropletActionsService)(nil).PowerOff), arg0, arg1)
+}
+
+// PowerOffByTag mocks base method.
+func (m *MockDropletActionsService) PowerOffByTag(arg0 context.Context, arg1 string) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerOffByTag", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PowerOffByTag indicates an expected call of PowerOffByTag.
+func (mr *MockDropletActionsServiceMockRecorder) PowerOffByTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerOffByTag", reflect.TypeOf((*MockDropletActionsService)(nil).PowerOffByTag), arg0, arg1)
+}
+
+// PowerOn mocks base method.
+func (m *MockDropletActionsService) PowerOn(arg0 context.Context, arg1 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerOn", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PowerOn indicates an expected call of PowerOn.
+func (mr *MockDropletActionsServiceMockRecorder) PowerOn(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerOn", reflect.TypeOf((*MockDropletActionsService)(nil).PowerOn), arg0, arg1)
+}
+
+// PowerOnByTag mocks base method.
+func (m *MockDropletActionsService) PowerOnByTag(arg0 context.Context, arg1 string) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerOnByTag", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PowerOnByTag indicates an expected call of PowerOnByTag.
+func (mr *MockDropletActionsServiceMockRecorder) PowerOnByTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerOnByTag", reflect.TypeOf((*MockDropletActionsService)(nil).PowerOnByTag), arg0, arg1)
+}
+
+// Reboot mocks base method.
+func (m *MockDropletActionsService) Reboot(arg0 context.Context, arg1 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reboot", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Reboot indicates an expected call of Reboot.
+func (mr *MockDropletActionsServiceMockRecorder) Reboot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reboot", reflect.TypeOf((*MockDropletActionsService)(nil).Reboot), arg0, arg1)
+}
+
+// RebuildByImageID mocks base method.
+func (m *MockDropletActionsService) RebuildByImageID(arg0 context.Context, arg1, arg2 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildByImageID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RebuildByImageID indicates an expected call of RebuildByImageID.
+func (mr *MockDropletActionsServiceMockRecorder) RebuildByImageID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildByImageID", reflect.TypeOf((*MockDropletActionsService)(nil).RebuildByImageID), arg0, arg1, arg2)
+}
+
+// RebuildByImageSlug mocks base method.
+func (m *MockDropletActionsService) RebuildByImageSlug(arg0 context.Context, arg1 int, arg2 string) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildByImageSlug", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RebuildByImageSlug indicates an expected call of RebuildByImageSlug.
+func (mr *MockDropletActionsServiceMockRecorder) RebuildByImageSlug(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildByImageSlug", reflect.TypeOf((*MockDropletActionsService)(nil).RebuildByImageSlug), arg0, arg1, arg2)
+}
+
+// Rename mocks base method.
+func (m *MockDropletActionsService) Rename(arg0 context.Context, arg1 int, arg2 string) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockDropletActionsServiceMockRecorder) Rename(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockDropletActionsService)(nil).Rename), arg0, arg1, arg2)
+}
+
+// Resize mocks base method.
+func (m *MockDropletActionsService) Resize(arg0 context.Context, arg1 int, arg2 string, arg3 bool) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resize", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Resize indicates an expected call of Resize.
+func (mr *MockDropletActionsServiceMockRecorder) Resize(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockDropletActionsService)(nil).Resize), arg0, arg1, arg2, arg3)
+}
+
+// Restore mocks base method.
+func (m *MockDropletActionsService) Restore(arg0 context.Context, arg1, arg2 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockDropletActionsServiceMockRecorder) Restore(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockDropletActionsService)(nil).Restore), arg0, arg1, arg2)
+}
+
+// Shutdown mocks base method.
+func (m *MockDropletActionsService) Shutdown(arg0 context.Context, arg1 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Shutdown", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Shutdown indicates an expected call of Shutdown.
+func (mr *MockDropletActionsServiceMockRecorder) Shutdown(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockDropletActionsService)(nil).Shutdown), arg0, arg1)
+}
+
+// ShutdownByTag mocks base method.
+func (m *MockDropletActionsService) ShutdownByTag(arg0 context.Context, arg1 string) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShutdownByTag", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ShutdownByTag indicates an expected call of ShutdownByTag.
+func (mr *MockDropletActionsServiceMockRecorder) ShutdownByTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShutdownByTag", reflect.TypeOf((*MockDropletActionsService)(nil).ShutdownByTag), arg0, arg1)
+}
+
+// Snapshot mocks base method.
+func (m *MockDropletActionsService) Snapshot(arg0 context.Context, arg1 int, arg2 string) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockDropletActionsServiceMockRecorder) Snapshot(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockDropletActionsService)(nil).Snapshot), arg0, arg1, arg2)
+}
+
+// SnapshotByTag mocks base method.
+func (m *MockDropletActionsService) SnapshotByTag(arg0 context.Context, arg1, arg2 string) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotByTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SnapshotByTag indicates an expected call of SnapshotByTag.
+func (mr *MockDropletActionsServiceMockRecorder) SnapshotByTag(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotByTag", reflect.TypeOf((*MockDropletActionsService)(nil).SnapshotByTag), arg0, arg1, arg2)
+}
+
+// MockImageActionsService is a mock of ImageActionsService interface.
+type MockImageActionsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageActionsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockImageActionsServiceMockRecorder is the mock recorder for MockImageActionsService.
+type MockImageActionsServiceMockRecorder struct {
+	mock *MockImageActionsService
+}
+
+// NewMockImageActionsService creates a new mock instance.
+func NewMockImageActionsService(ctrl *gomock.Controller) *MockImageActionsService {
+	mock := &MockImageActionsService{ctrl: ctrl}
+	mock.recorder = &MockImageActionsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageActionsService) EXPECT() *MockImageActionsServiceMockRecorder {
+	return m.recorder
+}
+
+// Convert mocks base method.
+func (m *MockImageActionsService) Convert(arg0 context.Context, arg1 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Convert", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Convert indicates an expected call of Convert.
+func (mr *MockImageActionsServiceMockRecorder) Convert(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Convert", reflect.TypeOf((*MockImageActionsService)(nil).Convert), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockImageActionsService) Get(arg0 context.Context, arg1, arg2 int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockImageActionsServiceMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockImageActionsService)(nil).Get), arg0, arg1, arg2)
+}
+
+// GetByURI mocks base method.
+func (m *MockImageActionsService) GetByURI(arg0 context.Context, arg1 string) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByURI", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByURI indicates an expected call of GetByURI.
+func (mr *MockImageActionsServiceMockRecorder) GetByURI(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByURI", reflect.TypeOf((*MockImageActionsService)(nil).GetByURI), arg0, arg1)
+}
+
+// Transfer mocks base method.
+func (m *MockImageActionsService) Transfer(arg0 context.Context, arg1 int, arg2 *godo.ActionRequest) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockImageActionsServiceMockRecorder) Transfer(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockImageActionsService)(nil).Transfer), arg0, arg1, arg2)
+}
+
+// MockStorageActionsService is a mock of StorageActionsService interface.
+type MockStorageActionsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageActionsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageActionsServiceMockRecorder is the mock recorder for MockStorageActionsService.
+type MockStorageActionsServiceMockRecorder struct {
+	mock *MockStorageActionsService
+}
+
+// NewMockStorageActionsService creates a new mock instance.
+func NewMockStorageActionsService(ctrl *gomock.Controller) *MockStorageActionsService {
+	mock := &MockStorageActionsService{ctrl: ctrl}
+	mock.recorder = &MockStorageActionsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageActionsService) EXPECT() *MockStorageActionsServiceMockRecorder {
+	return m.recorder
+}
+
+// Attach mocks base method.
+func (m *MockStorageActionsService) Attach(ctx context.Context, volumeID string, dropletID int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attach", ctx, volumeID, dropletID)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Attach indicates an expected call of Attach.
+func (mr *MockStorageActionsServiceMockRecorder) Attach(ctx, volumeID, dropletID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockStorageActionsService)(nil).Attach), ctx, volumeID, dropletID)
+}
+
+// DetachByDropletID mocks base method.
+func (m *MockStorageActionsService) DetachByDropletID(ctx context.Context, volumeID string, dropletID int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachByDropletID", ctx, volumeID, dropletID)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DetachByDropletID indicates an expected call of DetachByDropletID.
+func (mr *MockStorageActionsServiceMockRecorder) DetachByDropletID(ctx, volumeID, dropletID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachByDropletID", reflect.TypeOf((*MockStorageActionsService)(nil).DetachByDropletID), ctx, volumeID, dropletID)
+}
+
+// Get mocks base method.
+func (m *MockStorageActionsService) Get(ctx context.Context, volumeID string, actionID int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, volumeID, actionID)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStorageActionsServiceMockRecorder) Get(ctx, volumeID, actionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStorageActionsService)(nil).Get), ctx, volumeID, actionID)
+}
+
+// List mocks base method.
+func (m *MockStorageActionsService) List(ctx context.Context, volumeID string, opt *godo.ListOptions) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, volumeID, opt)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockStorageActionsServiceMockRecorder) List(ctx, volumeID, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockStorageActionsService)(nil).List), ctx, volumeID, opt)
+}
+
+// Resize mocks base method.
+func (m *MockStorageActionsService) Resize(ctx context.Context, volumeID string, sizeGigabytes int, regionSlug string) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resize", ctx, volumeID, sizeGigabytes, regionSlug)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Resize indicates an expected call of Resize.
+func (mr *MockStorageActionsServiceMockRecorder) Resize(ctx, volumeID, sizeGigabytes, regionSlug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockStorageActionsService)(nil).Resize), ctx, volumeID, sizeGigabytes, regionSlug)
+}
+
+// MockReservedIPActionsService is a mock of ReservedIPActionsService interface.
+type MockReservedIPActionsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservedIPActionsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockReservedIPActionsServiceMockRecorder is the mock recorder for MockReservedIPActionsService.
+type MockReservedIPActionsServiceMockRecorder struct {
+	mock *MockReservedIPActionsService
+}
+
+// NewMockReservedIPActionsService creates a new mock instance.
+func NewMockReservedIPActionsService(ctrl *gomock.Controller) *MockReservedIPActionsService {
+	mock := &MockReservedIPActionsService{ctrl: ctrl}
+	mock.recorder = &MockReservedIPActionsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservedIPActionsService) EXPECT() *MockReservedIPActionsServiceMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockReservedIPActionsService) Assign(ctx context.Context, ip string, dropletID int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, ip, dropletID)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockReservedIPActionsServiceMockRecorder) Assign(ctx, ip, dropletID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockReservedIPActionsService)(nil).Assign), ctx, ip, dropletID)
+}
+
+// Get mocks base method.
+func (m *MockReservedIPActionsService) Get(ctx context.Context, ip string, actionID int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ip, actionID)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReservedIPActionsServiceMockRecorder) Get(ctx, ip, actionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReservedIPActionsService)(nil).Get), ctx, ip, actionID)
+}
+
+// List mocks base method.
+func (m *MockReservedIPActionsService) List(ctx context.Context, ip string, opt *godo.ListOptions) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, ip, opt)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockReservedIPActionsServiceMockRecorder) List(ctx, ip, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservedIPActionsService)(nil).List), ctx, ip, opt)
+}
+
+// Unassign mocks base method.
+func (m *MockReservedIPActionsService) Unassign(ctx context.Context, ip string) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, ip)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockReservedIPActionsServiceMockRecorder) Unassign(ctx, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockReservedIPActionsService)(nil).Unassign), ctx, ip)
+}
+
+// MockAppsService is a mock of AppsService interface.
+type MockAppsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAppsServiceMockRecorder is the mock recorder for MockAppsService.
+type MockAppsServiceMockRecorder struct {
+	mock *MockAppsService
+}
+
+// NewMockAppsService creates a new mock instance.
+func NewMockAppsService(ctrl *gomock.Controller) *MockAppsService {
+	mock := &MockAppsService{ctrl: ctrl}
+	mock.recorder = &MockAppsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAppsService) EXPECT() *MockAppsServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelEvent mocks base method.
+func (m *MockAppsService) CancelEvent(ctx context.Context, appID, eventID string) (*godo.Event, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelEvent", ctx, appID, eventID)
+	ret0, _ := ret[0].(*godo.Event)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CancelEvent indicates an expected call of CancelEvent.
+func (mr *MockAppsServiceMockRecorder) CancelEvent(ctx, appID, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelEvent", reflect.TypeOf((*MockAppsService)(nil).CancelEvent), ctx, appID, eventID)
+}
+
+// CancelJobInvocation mocks base method.
+func (m *MockAppsService) CancelJobInvocation(ctx context.Context, appID, jobInvocationID string, opts *godo.CancelJobInvocationOptions) (*godo.JobInvocation, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelJobInvocation", ctx, appID, jobInvocationID, opts)
+	ret0, _ := ret[0].(*godo.JobInvocation)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CancelJobInvocation indicates an expected call of CancelJobInvocation.
+func (mr *MockAppsServiceMockRecorder) CancelJobInvocation(ctx, appID, jobInvocationID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJobInvocation", reflect.TypeOf((*MockAppsService)(nil).CancelJobInvocation), ctx, appID, jobInvocationID, opts)
+}
+
+// Create mocks base method.
+func (m *MockAppsService) Create(ctx context.Context, create *godo.AppCreateRequest) (*godo.App, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, create)
+	ret0, _ := ret[0].(*godo.App)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAppsServiceMockRecorder) Create(ctx, create any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAppsService)(nil).Create), ctx, create)
+}
+
+// CreateDeployment mocks base method.
+func (m *MockAppsService) CreateDeployment(ctx context.Context, appID string, create ...*godo.DeploymentCreateRequest) (*godo.Deployment, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, appID}
+	for _, a := range create {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateDeployment", varargs...)
+	ret0, _ := ret[0].(*godo.Deployment)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateDeployment indicates an expected call of CreateDeployment.
+func (mr *MockAppsServiceMockRecorder) CreateDeployment(ctx, appID any, create ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, appID}, create...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeployment", reflect.TypeOf((*MockAppsService)(nil).CreateDeployment), varargs...)
+}
+
+// Delete mocks base method.
+func (m *MockAppsService) Delete(ctx context.Context, appID string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, appID)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAppsServiceMockRecorder) Delete(ctx, appID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAppsService)(nil).Delete), ctx, appID)
+}
+
+// Detect mocks base method.
+func (m *MockAppsService) Detect(ctx context.Context, detect *godo.DetectRequest) (*godo.DetectResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Detect", ctx, detect)
+	ret0, _ := ret[0].(*godo.DetectResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Detect indicates an expected call of Detect.
+func (mr *MockAppsServiceMockRecorder) Detect(ctx, detect any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detect", reflect.TypeOf((*MockAppsService)(nil).Detect), ctx, detect)
+}
+
+// Get mocks base method.
+func (m *MockAppsService) Get(ctx context.Context, appID string) (*godo.App, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, appID)
+	ret0, _ := ret[0].(*godo.App)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAppsServiceMockRecorder) Get(ctx, appID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAppsService)(nil).Get), ctx, appID)
+}
+
+// GetAppDatabaseConnectionDetails mocks base method.
+func (m *MockAppsService) GetAppDatabaseConnectionDetails(ctx context.Context, appID string) ([]*godo.GetDatabaseConnectionDetailsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppDatabaseConnectionDetails", ctx, appID)
+	ret0, _ := ret[0].([]*godo.GetDatabaseConnectionDetailsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAppDatabaseConnectionDetails indicates an expected call of GetAppDatabaseConnectionDetails.
+func (mr *MockAppsServiceMockRecorder) GetAppDatabaseConnectionDetails(ctx, appID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppDatabaseConnectionDetails", reflect.TypeOf((*MockAppsService)(nil).GetAppDatabaseConnectionDetails), ctx, appID)
+}
+
+// GetAppHealth mocks base method.
+func (m *MockAppsService) GetAppHealth(ctx context.Context, appID string) (*godo.AppHealth, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppHealth", ctx, appID)
+	ret0, _ := ret[0].(*godo.AppHealth)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAppHealth indicates an expected call of GetAppHealth.
+func (mr *MockAppsServiceMockRecorder) GetAppHealth(ctx, appID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppHealth", reflect.TypeOf((*MockAppsService)(nil).GetAppHealth), ctx, appID)
+}
+
+// GetAppInstances mocks base method.
+func (m *MockAppsService) GetAppInstances(ctx context.Context, appID string, opts *godo.GetAppInstancesOpts) ([]*godo.AppInstance, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppInstances", ctx, appID, opts)
+	ret0, _ := ret[0].([]*godo.AppInstance)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAppInstances indicates an expected call of GetAppInstances.
+func (mr *MockAppsServiceMockRecorder) GetAppInstances(ctx, appID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppInstances", reflect.TypeOf((*MockAppsService)(nil).GetAppInstances), ctx, appID, opts)
+}
+
+// GetDeployment mocks base method.
+func (m *MockAppsService) GetDeployment(ctx context.Context, appID, deploymentID string) (*godo.Deployment, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeployment", ctx, appID, deploymentID)
+	ret0, _ := ret[0].(*godo.Deployment)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeployment indicates an expected call of GetDeployment.
+func (mr *MockAppsServiceMockRecorder) GetDeployment(ctx, appID, deploymentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployment", reflect.TypeOf((*MockAppsService)(nil).GetDeployment), ctx, appID, deploymentID)
+}
+
+// GetEvent mocks base method.
+func (m *MockAppsService) GetEvent(ctx context.Context, appID, eventID string) (*godo.Event, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, appID, eventID)
+	ret0, _ := ret[0].(*godo.Event)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockAppsServiceMockRecorder) GetEvent(ctx, appID, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockAppsService)(nil).GetEvent), ctx, appID, eventID)
+}
+
+// GetEventLogs mocks base method.
+func (m *MockAppsService) GetEventLogs(ctx context.Context, appID, eventID string, opts *godo.GetEventLogsOptions) (*godo.AppLogs, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventLogs", ctx, appID, eventID, opts)
+	ret0, _ := ret[0].(*godo.AppLogs)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEventLogs indicates an expected call of GetEventLogs.
+func (mr *MockAppsServiceMockRecorder) GetEventLogs(ctx, appID, eventID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventLogs", reflect.TypeOf((*MockAppsService)(nil).GetEventLogs), ctx, appID, eventID, opts)
+}
+
+// GetExec mocks base method.
+func (m *MockAppsService) GetExec(ctx context.Context, appID, deploymentID, component string) (*godo.AppExec, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExec", ctx, appID, deploymentID, component)
+	ret0, _ := ret[0].(*godo.AppExec)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetExec indicates an expected call of GetExec.
+func (mr *MockAppsServiceMockRecorder) GetExec(ctx, appID, deploymentID, component any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExec", reflect.TypeOf((*MockAppsService)(nil).GetExec), ctx, appID, deploymentID, component)
+}
+
+// GetExecWithOpts mocks base method.
+func (m *MockAppsService) GetExecWithOpts(ctx context.Context, appID, componentName string, opts *godo.AppGetExecOptions) (*godo.AppExec, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExecWithOpts", ctx, appID, componentName, opts)
+	ret0, _ := ret[0].(*godo.AppExec)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetExecWithOpts indicates an expected call of GetExecWithOpts.
+func (mr *MockAppsServiceMockRecorder) GetExecWithOpts(ctx, appID, componentName, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecWithOpts", reflect.TypeOf((*MockAppsService)(nil).GetExecWithOpts), ctx, appID, componentName, opts)
+}
+
+// GetInstanceSize mocks base method.
+func (m *MockAppsService) GetInstanceSize(ctx context.Context, slug string) (*godo.AppInstanceSize, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceSize", ctx, slug)
+	ret0, _ := ret[0].(*godo.AppInstanceSize)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInstanceSize indicates an expected call of GetInstanceSize.
+func (mr *MockAppsServiceMockRecorder) GetInstanceSize(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceSize", reflect.TypeOf((*MockAppsService)(nil).GetInstanceSize), ctx, slug)
+}
+
+// GetJobInvocation mocks base method.
+func (m *MockAppsService) GetJobInvocation(ctx context.Context, appID, jobInvocationId string, opts *godo.GetJobInvocationOptions) (*godo.JobInvocation, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobInvocation", ctx, appID, jobInvocationId, opts)
+	ret0, _ := ret[0].(*godo.JobInvocation)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetJobInvocation indicates an expected call of GetJobInvocation.
+func (mr *MockAppsServiceMockRecorder) GetJobInvocation(ctx, appID, jobInvocationId, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobInvocation", reflect.TypeOf((*MockAppsService)(nil).GetJobInvocation), ctx, appID, jobInvocationId, opts)
+}
+
+// GetJobInvocationLogs mocks base method.
+func (m *MockAppsService) GetJobInvocationLogs(ctx context.Context, appID, jobInvocationId string, opts *godo.GetJobInvocationLogsOptions) (*godo.AppLogs, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobInvocationLogs", ctx, appID, jobInvocationId, opts)
+	ret0, _ := ret[0].(*godo.AppLogs)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetJobInvocationLogs indicates an expected call of GetJobInvocationLogs.
+func (mr *MockAppsServiceMockRecorder) GetJobInvocationLogs(ctx, appID, jobInvocationId, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobInvocationLogs", reflect.TypeOf((*MockAppsService)(nil).GetJobInvocationLogs), ctx, appID, jobInvocationId, opts)
+}
+
+// GetLogs mocks base method.
+func (m *MockAppsService) GetLogs(ctx context.Context, appID, deploymentID, component string, logType godo.AppLogType, follow bool, tailLines int) (*godo.AppLogs, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogs", ctx, appID, deploymentID, component, logType, follow, tailLines)
+	ret0, _ := ret[0].(*godo.AppLogs)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLogs indicates an expected call of GetLogs.
+func (mr *MockAppsServiceMockRecorder) GetLogs(ctx, appID, deploymentID, component, logType, follow, tailLines any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockAppsService)(nil).GetLogs), ctx, appID, deploymentID, component, logType, follow, tailLines)
+}
+
+// GetTier mocks base method.
+func (m *MockAppsService) GetTier(ctx context.Context, slug string) (*godo.AppTier, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTier", ctx, slug)
+	ret0, _ := ret[0].(*godo.AppTier)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTier indicates an expected call of GetTier.
+func (mr *MockAppsServiceMockRecorder) GetTier(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTier", reflect.TypeOf((*MockAppsService)(nil).GetTier), ctx, slug)
+}
+
+// List mocks base method.
+func (m *MockAppsService) List(ctx context.Context, opts *godo.ListOptions) ([]*godo.App, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, opts)
+	ret0, _ := ret[0].([]*godo.App)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockAppsServiceMockRecorder) List(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAppsService)(nil).List), ctx, opts)
+}
+
+// ListAlerts mocks base method.
+func (m *MockAppsService) ListAlerts(ctx context.Context, appID string) ([]*godo.AppAlert, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlerts", ctx, appID)
+	ret0, _ := ret[0].([]*godo.AppAlert)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAlerts indicates an expected call of ListAlerts.
+func (mr *MockAppsServiceMockRecorder) ListAlerts(ctx, appID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlerts", reflect.TypeOf((*MockAppsService)(nil).ListAlerts), ctx, appID)
+}
+
+// ListBuildpacks mocks base method.
+func (m *MockAppsService) ListBuildpacks(ctx context.Context) ([]*godo.Buildpack, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBuildpacks", ctx)
+	ret0, _ := ret[0].([]*godo.Buildpack)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBuildpacks indicates an expected call of ListBuildpacks.
+func (mr *MockAppsServiceMockRecorder) ListBuildpacks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuildpacks", reflect.TypeOf((*MockAppsService)(nil).ListBuildpacks), ctx)
+}
+
+// ListDeployments mocks base method.
+func (m *MockAppsService) ListDeployments(ctx context.Context, appID string, opts *godo.ListOptions) ([]*godo.Deployment, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployments", ctx, appID, opts)
+	ret0, _ := ret[0].([]*godo.Deployment)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeployments indicates an expected call of ListDeployments.
+func (mr *MockAppsServiceMockRecorder) ListDeployments(ctx, appID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployments", reflect.TypeOf((*MockAppsService)(nil).ListDeployments), ctx, appID, opts)
+}
+
+// ListEvents mocks base method.
+func (m *MockAppsService) ListEvents(ctx context.Context, appID string, opts *godo.ListEventsOptions) ([]*godo.Event, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, appID, opts)
+	ret0, _ := ret[0].([]*godo.Event)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockAppsServiceMockRecorder) ListEvents(ctx, appID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockAppsService)(nil).ListEvents), ctx, appID, opts)
+}
+
+// ListInstanceSizes mocks base method.
+func (m *MockAppsService) ListInstanceSizes(ctx context.Context) ([]*godo.AppInstanceSize, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceSizes", ctx)
+	ret0, _ := ret[0].([]*godo.AppInstanceSize)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListInstanceSizes indicates an expected call of ListInstanceSizes.
+func (mr *MockAppsServiceMockRecorder) ListInstanceSizes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceSizes", reflect.TypeOf((*MockAppsService)(nil).ListInstanceSizes), ctx)
+}
+
+// ListJobInvocations mocks base method.
+func (m *MockAppsService) ListJobInvocations(ctx context.Context, appID string, opts *godo.ListJobInvocationsOptions) ([]*godo.JobInvocation, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobInvocations", ctx, appID, opts)
+	ret0, _ := ret[0].([]*godo.JobInvocation)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListJobInvocations indicates an expected call of ListJobInvocations.
+func (mr *MockAppsServiceMockRecorder) ListJobInvocations(ctx, appID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobInvocations", reflect.TypeOf((*MockAppsService)(nil).ListJobInvocations), ctx, appID, opts)
+}
+
+// ListRegions mocks base method.
+func (m *MockAppsService) ListRegions(ctx context.Context) ([]*godo.AppRegion, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegions", ctx)
+	ret0, _ := ret[0].([]*godo.AppRegion)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRegions indicates an expected call of ListRegions.
+func (mr *MockAppsServiceMockRecorder) ListRegions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegions", reflect.TypeOf((*MockAppsService)(nil).ListRegions), ctx)
+}
+
+// ListTiers mocks base method.
+func (m *MockAppsService) ListTiers(ctx context.Context) ([]*godo.AppTier, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTiers", ctx)
+	ret0, _ := ret[0].([]*godo.AppTier)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTiers indicates an expected call of ListTiers.
+func (mr *MockAppsServiceMockRecorder) ListTiers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTiers", reflect.TypeOf((*MockAppsService)(nil).ListTiers), ctx)
+}
+
+// Propose mocks base method.
+func (m *MockAppsService) Propose(ctx context.Context, propose *godo.AppProposeRequest) (*godo.AppProposeResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Propose", ctx, propose)
+	ret0, _ := ret[0].(*godo.AppProposeResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Propose indicates an expected call of Propose.
+func (mr *MockAppsServiceMockRecorder) Propose(ctx, propose any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Propose", reflect.TypeOf((*MockAppsService)(nil).Propose), ctx, propose)
+}
+
+// ResetDatabasePassword mocks base method.
+func (m *MockAppsService) ResetDatabasePassword(ctx context.Context, appID, component string) (*godo.Deployment, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetDatabasePassword", ctx, appID, component)
+	ret0, _ := ret[0].(*godo.Deployment)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ResetDatabasePassword indicates an expected call of ResetDatabasePassword.
+func (mr *MockAppsServiceMockRecorder) ResetDatabasePassword(ctx, appID, component any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetDatabasePassword", reflect.TypeOf((*MockAppsService)(nil).ResetDatabasePassword), ctx, appID, component)
+}
+
+// Restart mocks base method.
+func (m *MockAppsService) Restart(ctx context.Context, appID string, opts *godo.AppRestartRequest) (*godo.Deployment, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restart", ctx, appID, opts)
+	ret0, _ := ret[0].(*godo.Deployment)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Restart indicates an expected call of Restart.
+func (mr *MockAppsServiceMockRecorder) Restart(ctx, appID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockAppsService)(nil).Restart), ctx, appID, opts)
+}
+
+// ToggleDatabaseTrustedSource mocks base method.
+func (m *MockAppsService) ToggleDatabaseTrustedSource(ctx context.Context, appID, component string, opts godo.ToggleDatabaseTrustedSourceOptions) (*godo.ToggleDatabaseTrustedSourceResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToggleDatabaseTrustedSource", ctx, appID, component, opts)
+	ret0, _ := ret[0].(*godo.ToggleDatabaseTrustedSourceResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ToggleDatabaseTrustedSource indicates an expected call of ToggleDatabaseTrustedSource.
+func (mr *MockAppsServiceMockRecorder) ToggleDatabaseTrustedSource(ctx, appID, component, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleDatabaseTrustedSource", reflect.TypeOf((*MockAppsService)(nil).ToggleDatabaseTrustedSource), ctx, appID, component, opts)
+}
+
+// Update mocks base method.
+func (m *MockAppsService) Update(ctx context.Context, appID string, update *godo.AppUpdateRequest) (*godo.App, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, appID, update)
+	ret0, _ := ret[0].(*godo.App)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAppsServiceMockRecorder) Update(ctx, appID, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAppsService)(nil).Update), ctx, appID, update)
+}
+
+// UpdateAlertDestinations mocks base method.
+func (m *MockAppsService) UpdateAlertDestinations(ctx context.Context, appID, alertID string, update *godo.AlertDestinationUpdateRequest) (*godo.AppAlert, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlertDestinations", ctx, appID, alertID, update)
+	ret0, _ := ret[0].(*godo.AppAlert)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateAlertDestinations indicates an expected call of UpdateAlertDestinations.
+func (mr *MockAppsServiceMockRecorder) UpdateAlertDestinations(ctx, appID, alertID, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertDestinations", reflect.TypeOf((*MockAppsService)(nil).UpdateAlertDestinations), ctx, appID, alertID, update)
+}
+
+// UpgradeBuildpack mocks base method.
+func (m *MockAppsService) UpgradeBuildpack(ctx context.Context, appID string, opts godo.UpgradeBuildpackOptions) (*godo.UpgradeBuildpackResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeBuildpack", ctx, appID, opts)
+	ret0, _ := ret[0].(*godo.UpgradeBuildpackResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpgradeBuildpack indicates an expected call of UpgradeBuildpack.
+func (mr *MockAppsServiceMockRecorder) UpgradeBuildpack(ctx, appID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeBuildpack", reflect.TypeOf((*MockAppsService)(nil).UpgradeBuildpack), ctx, appID, opts)
+}
+
 // MockBalanceService is a mock of BalanceService interface.
 type MockBalanceService struct {
 	ctrl     *gomock.Controller
-	recorder *MockBalanceServiceMockRecorder
+	recorder *MockBalanceServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockBalanceServiceMockRecorder is the mock recorder for MockBalanceService.
+type MockBalanceServiceMockRecorder struct {
+	mock *MockBalanceService
+}
+
+// NewMockBalanceService creates a new mock instance.
+func NewMockBalanceService(ctrl *gomock.Controller) *MockBalanceService {
+	mock := &MockBalanceService{ctrl: ctrl}
+	mock.recorder = &MockBalanceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceService) EXPECT() *MockBalanceServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockBalanceService) Get(arg0 context.Context) (*godo.Balance, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*godo.Balance)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBalanceServiceMockRecorder) Get(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBalanceService)(nil).Get), arg0)
+}
+
+// MockBillingHistoryService is a mock of BillingHistoryService interface.
+type MockBillingHistoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBillingHistoryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockBillingHistoryServiceMockRecorder is the mock recorder for MockBillingHistoryService.
+type MockBillingHistoryServiceMockRecorder struct {
+	mock *MockBillingHistoryService
+}
+
+// NewMockBillingHistoryService creates a new mock instance.
+func NewMockBillingHistoryService(ctrl *gomock.Controller) *MockBillingHistoryService {
+	mock := &MockBillingHistoryService{ctrl: ctrl}
+	mock.recorder = &MockBillingHistoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBillingHistoryService) EXPECT() *MockBillingHistoryServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockBillingHistoryService) List(arg0 context.Context, arg1 *godo.ListOptions) (*godo.BillingHistory, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*godo.BillingHistory)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockBillingHistoryServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBillingHistoryService)(nil).List), arg0, arg1)
+}
+
+// MockCDNService is a mock of CDNService interface.
+type MockCDNService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCDNServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCDNServiceMockRecorder is the mock recorder for MockCDNService.
+type MockCDNServiceMockRecorder struct {
+	mock *MockCDNService
+}
+
+// NewMockCDNService creates a new mock instance.
+func NewMockCDNService(ctrl *gomock.Controller) *MockCDNService {
+	mock := &MockCDNService{ctrl: ctrl}
+	mock.recorder = &MockCDNServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCDNService) EXPECT() *MockCDNServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCDNService) Create(arg0 context.Context, arg1 *godo.CDNCreateRequest) (*godo.CDN, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.CDN)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCDNServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCDNService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockCDNService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCDNServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCDNService)(nil).Delete), arg0, arg1)
+}
+
+// FlushCache mocks base method.
+func (m *MockCDNService) FlushCache(arg0 context.Context, arg1 string, arg2 *godo.CDNFlushCacheRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlushCache", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlushCache indicates an expected call of FlushCache.
+func (mr *MockCDNServiceMockRecorder) FlushCache(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushCache", reflect.TypeOf((*MockCDNService)(nil).FlushCache), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockCDNService) Get(arg0 context.Context, arg1 string) (*godo.CDN, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.CDN)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCDNServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCDNService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockCDNService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.CDN, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.CDN)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockCDNServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCDNService)(nil).List), arg0, arg1)
+}
+
+// UpdateCustomDomain mocks base method.
+func (m *MockCDNService) UpdateCustomDomain(arg0 context.Context, arg1 string, arg2 *godo.CDNUpdateCustomDomainRequest) (*godo.CDN, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomDomain", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.CDN)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateCustomDomain indicates an expected call of UpdateCustomDomain.
+func (mr *MockCDNServiceMockRecorder) UpdateCustomDomain(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomDomain", reflect.TypeOf((*MockCDNService)(nil).UpdateCustomDomain), arg0, arg1, arg2)
+}
+
+// UpdateTTL mocks base method.
+func (m *MockCDNService) UpdateTTL(arg0 context.Context, arg1 string, arg2 *godo.CDNUpdateTTLRequest) (*godo.CDN, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTTL", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.CDN)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateTTL indicates an expected call of UpdateTTL.
+func (mr *MockCDNServiceMockRecorder) UpdateTTL(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTTL", reflect.TypeOf((*MockCDNService)(nil).UpdateTTL), arg0, arg1, arg2)
+}
+
+// MockCertificatesService is a mock of CertificatesService interface.
+type MockCertificatesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCertificatesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCertificatesServiceMockRecorder is the mock recorder for MockCertificatesService.
+type MockCertificatesServiceMockRecorder struct {
+	mock *MockCertificatesService
+}
+
+// NewMockCertificatesService creates a new mock instance.
+func NewMockCertificatesService(ctrl *gomock.Controller) *MockCertificatesService {
+	mock := &MockCertificatesService{ctrl: ctrl}
+	mock.recorder = &MockCertificatesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCertificatesService) EXPECT() *MockCertificatesServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCertificatesService) Create(arg0 context.Context, arg1 *godo.CertificateRequest) (*godo.Certificate, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Certificate)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCertificatesServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCertificatesService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockCertificatesService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCertificatesServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCertificatesService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockCertificatesService) Get(arg0 context.Context, arg1 string) (*godo.Certificate, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Certificate)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCertificatesServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCertificatesService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockCertificatesService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Certificate, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Certificate)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockCertificatesServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCertificatesService)(nil).List), arg0, arg1)
+}
+
+// ListByName mocks base method.
+func (m *MockCertificatesService) ListByName(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.Certificate, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Certificate)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByName indicates an expected call of ListByName.
+func (mr *MockCertificatesServiceMockRecorder) ListByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByName", reflect.TypeOf((*MockCertificatesService)(nil).ListByName), arg0, arg1, arg2)
+}
+
+// MockDatabasesService is a mock of DatabasesService interface.
+type MockDatabasesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabasesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDatabasesServiceMockRecorder is the mock recorder for MockDatabasesService.
+type MockDatabasesServiceMockRecorder struct {
+	mock *MockDatabasesService
+}
+
+// NewMockDatabasesService creates a new mock instance.
+func NewMockDatabasesService(ctrl *gomock.Controller) *MockDatabasesService {
+	mock := &MockDatabasesService{ctrl: ctrl}
+	mock.recorder = &MockDatabasesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabasesService) EXPECT() *MockDatabasesServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDatabasesService) Create(arg0 context.Context, arg1 *godo.DatabaseCreateRequest) (*godo.Database, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Database)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDatabasesServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDatabasesService)(nil).Create), arg0, arg1)
+}
+
+// CreateDB mocks base method.
+func (m *MockDatabasesService) CreateDB(arg0 context.Context, arg1 string, arg2 *godo.DatabaseCreateDBRequest) (*godo.DatabaseDB, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDB", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DatabaseDB)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateDB indicates an expected call of CreateDB.
+func (mr *MockDatabasesServiceMockRecorder) CreateDB(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDB", reflect.TypeOf((*MockDatabasesService)(nil).CreateDB), arg0, arg1, arg2)
+}
+
+// CreateKafkaSchemaRegistry mocks base method.
+func (m *MockDatabasesService) CreateKafkaSchemaRegistry(ctx context.Context, databaseID string, createKafkaSchemaRegistry *godo.DatabaseKafkaSchemaRegistryRequest) (*godo.DatabaseKafkaSchemaRegistrySubject, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKafkaSchemaRegistry", ctx, databaseID, createKafkaSchemaRegistry)
+	ret0, _ := ret[0].(*godo.DatabaseKafkaSchemaRegistrySubject)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateKafkaSchemaRegistry indicates an expected call of CreateKafkaSchemaRegistry.
+func (mr *MockDatabasesServiceMockRecorder) CreateKafkaSchemaRegistry(ctx, databaseID, createKafkaSchemaRegistry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKafkaSchemaRegistry", reflect.TypeOf((*MockDatabasesService)(nil).CreateKafkaSchemaRegistry), ctx, databaseID, createKafkaSchemaRegistry)
+}
+
+// CreateLogsink mocks base method.
+func (m *MockDatabasesService) CreateLogsink(ctx context.Context, databaseID string, createLogsink *godo.DatabaseCreateLogsinkRequest) (*godo.DatabaseLogsink, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLogsink", ctx, databaseID, createLogsink)
+	ret0, _ := ret[0].(*godo.DatabaseLogsink)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateLogsink indicates an expected call of CreateLogsink.
+func (mr *MockDatabasesServiceMockRecorder) CreateLogsink(ctx, databaseID, createLogsink any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLogsink", reflect.TypeOf((*MockDatabasesService)(nil).CreateLogsink), ctx, databaseID, createLogsink)
+}
+
+// CreatePool mocks base method.
+func (m *MockDatabasesService) CreatePool(arg0 context.Context, arg1 string, arg2 *godo.DatabaseCreatePoolRequest) (*godo.DatabasePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePool", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DatabasePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePool indicates an expected call of CreatePool.
+func (mr *MockDatabasesServiceMockRecorder) CreatePool(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePool", reflect.TypeOf((*MockDatabasesService)(nil).CreatePool), arg0, arg1, arg2)
+}
+
+// CreateReplica mocks base method.
+func (m *MockDatabasesService) CreateReplica(arg0 context.Context, arg1 string, arg2 *godo.DatabaseCreateReplicaRequest) (*godo.DatabaseReplica, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReplica", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DatabaseReplica)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateReplica indicates an expected call of CreateReplica.
+func (mr *MockDatabasesServiceMockRecorder) CreateReplica(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReplica", reflect.TypeOf((*MockDatabasesService)(nil).CreateReplica), arg0, arg1, arg2)
+}
+
+// CreateTopic mocks base method.
+func (m *MockDatabasesService) CreateTopic(arg0 context.Context, arg1 string, arg2 *godo.DatabaseCreateTopicRequest) (*godo.DatabaseTopic, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTopic", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DatabaseTopic)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateTopic indicates an expected call of CreateTopic.
+func (mr *MockDatabasesServiceMockRecorder) CreateTopic(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTopic", reflect.TypeOf((*MockDatabasesService)(nil).CreateTopic), arg0, arg1, arg2)
+}
+
+// CreateUser mocks base method.
+func (m *MockDatabasesService) CreateUser(arg0 context.Context, arg1 string, arg2 *godo.DatabaseCreateUserRequest) (*godo.DatabaseUser, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DatabaseUser)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockDatabasesServiceMockRecorder) CreateUser(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockDatabasesService)(nil).CreateUser), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockDatabasesService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDatabasesServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDatabasesService)(nil).Delete), arg0, arg1)
+}
+
+// DeleteDB mocks base method.
+func (m *MockDatabasesService) DeleteDB(arg0 context.Context, arg1, arg2 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDB", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDB indicates an expected call of DeleteDB.
+func (mr *MockDatabasesServiceMockRecorder) DeleteDB(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDB", reflect.TypeOf((*MockDatabasesService)(nil).DeleteDB), arg0, arg1, arg2)
+}
+
+// DeleteIndex mocks base method.
+func (m *MockDatabasesService) DeleteIndex(arg0 context.Context, arg1, arg2 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIndex", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIndex indicates an expected call of DeleteIndex.
+func (mr *MockDatabasesServiceMockRecorder) DeleteIndex(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIndex", reflect.TypeOf((*MockDatabasesService)(nil).DeleteIndex), arg0, arg1, arg2)
+}
+
+// DeleteKafkaSchemaRegistry mocks base method.
+func (m *MockDatabasesService) DeleteKafkaSchemaRegistry(ctx context.Context, databaseID, subject string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKafkaSchemaRegistry", ctx, databaseID, subject)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteKafkaSchemaRegistry indicates an expected call of DeleteKafkaSchemaRegistry.
+func (mr *MockDatabasesServiceMockRecorder) DeleteKafkaSchemaRegistry(ctx, databaseID, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKafkaSchemaRegistry", reflect.TypeOf((*MockDatabasesService)(nil).DeleteKafkaSchemaRegistry), ctx, databaseID, subject)
+}
+
+// DeleteLogsink mocks base method.
+func (m *MockDatabasesService) DeleteLogsink(ctx context.Context, databaseID, logsinkID string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogsink", ctx, databaseID, logsinkID)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLogsink indicates an expected call of DeleteLogsink.
+func (mr *MockDatabasesServiceMockRecorder) DeleteLogsink(ctx, databaseID, logsinkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogsink", reflect.TypeOf((*MockDatabasesService)(nil).DeleteLogsink), ctx, databaseID, logsinkID)
+}
+
+// DeletePool mocks base method.
+func (m *MockDatabasesService) DeletePool(arg0 context.Context, arg1, arg2 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePool", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePool indicates an expected call of DeletePool.
+func (mr *MockDatabasesServiceMockRecorder) DeletePool(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePool", reflect.TypeOf((*MockDatabasesService)(nil).DeletePool), arg0, arg1, arg2)
+}
+
+// DeleteReplica mocks base method.
+func (m *MockDatabasesService) DeleteReplica(arg0 context.Context, arg1, arg2 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReplica", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReplica indicates an expected call of DeleteReplica.
+func (mr *MockDatabasesServiceMockRecorder) DeleteReplica(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReplica", reflect.TypeOf((*MockDatabasesService)(nil).DeleteReplica), arg0, arg1, arg2)
+}
+
+// DeleteTopic mocks base method.
+func (m *MockDatabasesService) DeleteTopic(arg0 context.Context, arg1, arg2 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTopic", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTopic indicates an expected call of DeleteTopic.
+func (mr *MockDatabasesServiceMockRecorder) DeleteTopic(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTopic", reflect.TypeOf((*MockDatabasesService)(nil).DeleteTopic), arg0, arg1, arg2)
+}
+
+// DeleteUser mocks base method.
+func (m *MockDatabasesService) DeleteUser(arg0 context.Context, arg1, arg2 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockDatabasesServiceMockRecorder) DeleteUser(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockDatabasesService)(nil).DeleteUser), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockDatabasesService) Get(arg0 context.Context, arg1 string) (*godo.Database, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Database)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDatabasesServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDatabasesService)(nil).Get), arg0, arg1)
+}
+
+// GetAdvancedPostgresSQLConfig mocks base method.
+func (m *MockDatabasesService) GetAdvancedPostgresSQLConfig(arg0 context.Context, arg1 string) (*godo.AdvancedPostgresConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdvancedPostgresSQLConfig", arg0, arg1)
+	ret0, _ := ret[0].(*godo.AdvancedPostgresConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAdvancedPostgresSQLConfig indicates an expected call of GetAdvancedPostgresSQLConfig.
+func (mr *MockDatabasesServiceMockRecorder) GetAdvancedPostgresSQLConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdvancedPostgresSQLConfig", reflect.TypeOf((*MockDatabasesService)(nil).GetAdvancedPostgresSQLConfig), arg0, arg1)
+}
+
+// GetCA mocks base method.
+func (m *MockDatabasesService) GetCA(arg0 context.Context, arg1 string) (*godo.DatabaseCA, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCA", arg0, arg1)
+	ret0, _ := ret[0].(*godo.DatabaseCA)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCA indicates an expected call of GetCA.
+func (mr *MockDatabasesServiceMockRecorder) GetCA(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCA", reflect.TypeOf((*MockDatabasesService)(nil).GetCA), arg0, arg1)
+}
+
+// GetDB mocks base method.
+func (m *MockDatabasesService) GetDB(arg0 context.Context, arg1, arg2 string) (*godo.DatabaseDB, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDB", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DatabaseDB)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDB indicates an expected call of GetDB.
+func (mr *MockDatabasesServiceMockRecorder) GetDB(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDB", reflect.TypeOf((*MockDatabasesService)(nil).GetDB), arg0, arg1, arg2)
+}
+
+// GetEvictionPolicy mocks base method.
+func (m *MockDatabasesService) GetEvictionPolicy(arg0 context.Context, arg1 string) (string, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvictionPolicy", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEvictionPolicy indicates an expected call of GetEvictionPolicy.
+func (mr *MockDatabasesServiceMockRecorder) GetEvictionPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvictionPolicy", reflect.TypeOf((*MockDatabasesService)(nil).GetEvictionPolicy), arg0, arg1)
+}
+
+// GetFirewallRules mocks base method.
+func (m *MockDatabasesService) GetFirewallRules(arg0 context.Context, arg1 string) ([]godo.DatabaseFirewallRule, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFirewallRules", arg0, arg1)
+	ret0, _ := ret[0].([]godo.DatabaseFirewallRule)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFirewallRules indicates an expected call of GetFirewallRules.
+func (mr *MockDatabasesServiceMockRecorder) GetFirewallRules(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRules", reflect.TypeOf((*MockDatabasesService)(nil).GetFirewallRules), arg0, arg1)
+}
+
+// GetKafkaConfig mocks base method.
+func (m *MockDatabasesService) GetKafkaConfig(arg0 context.Context, arg1 string) (*godo.KafkaConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKafkaConfig", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KafkaConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKafkaConfig indicates an expected call of GetKafkaConfig.
+func (mr *MockDatabasesServiceMockRecorder) GetKafkaConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKafkaConfig", reflect.TypeOf((*MockDatabasesService)(nil).GetKafkaConfig), arg0, arg1)
+}
+
+// GetKafkaSchemaRegistry mocks base method.
+func (m *MockDatabasesService) GetKafkaSchemaRegistry(ctx context.Context, databaseID, subject string) (*godo.DatabaseKafkaSchemaRegistrySubject, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKafkaSchemaRegistry", ctx, databaseID, subject)
+	ret0, _ := ret[0].(*godo.DatabaseKafkaSchemaRegistrySubject)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKafkaSchemaRegistry indicates an expected call of GetKafkaSchemaRegistry.
+func (mr *MockDatabasesServiceMockRecorder) GetKafkaSchemaRegistry(ctx, databaseID, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKafkaSchemaRegistry", reflect.TypeOf((*MockDatabasesService)(nil).GetKafkaSchemaRegistry), ctx, databaseID, subject)
+}
+
+// GetKafkaSchemaRegistryConfig mocks base method.
+func (m *MockDatabasesService) GetKafkaSchemaRegistryConfig(ctx context.Context, databaseID string) (*godo.DatabaseKafkaSchemaRegistryConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKafkaSchemaRegistryConfig", ctx, databaseID)
+	ret0, _ := ret[0].(*godo.DatabaseKafkaSchemaRegistryConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKafkaSchemaRegistryConfig indicates an expected call of GetKafkaSchemaRegistryConfig.
+func (mr *MockDatabasesServiceMockRecorder) GetKafkaSchemaRegistryConfig(ctx, databaseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKafkaSchemaRegistryConfig", reflect.TypeOf((*MockDatabasesService)(nil).GetKafkaSchemaRegistryConfig), ctx, databaseID)
+}
+
+// GetKafkaSchemaRegistrySubjectConfig mocks base method.
+func (m *MockDatabasesService) GetKafkaSchemaRegistrySubjectConfig(ctx context.Context, databaseID, subject string) (*godo.DatabaseKafkaSchemaRegistrySubjectConfigResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKafkaSchemaRegistrySubjectConfig", ctx, databaseID, subject)
+	ret0, _ := ret[0].(*godo.DatabaseKafkaSchemaRegistrySubjectConfigResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKafkaSchemaRegistrySubjectConfig indicates an expected call of GetKafkaSchemaRegistrySubjectConfig.
+func (mr *MockDatabasesServiceMockRecorder) GetKafkaSchemaRegistrySubjectConfig(ctx, databaseID, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKafkaSchemaRegistrySubjectConfig", reflect.TypeOf((*MockDatabasesService)(nil).GetKafkaSchemaRegistrySubjectConfig), ctx, databaseID, subject)
+}
+
+// GetLogsink mocks base method.
+func (m *MockDatabasesService) GetLogsink(ctx context.Context, databaseID, logsinkID string) (*godo.DatabaseLogsink, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogsink", ctx, databaseID, logsinkID)
+	ret0, _ := ret[0].(*godo.DatabaseLogsink)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLogsink indicates an expected call of GetLogsink.
+func (mr *MockDatabasesServiceMockRecorder) GetLogsink(ctx, databaseID, logsinkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogsink", reflect.TypeOf((*MockDatabasesService)(nil).GetLogsink), ctx, databaseID, logsinkID)
+}
+
+// GetMetricsCredentials mocks base method.
+func (m *MockDatabasesService) GetMetricsCredentials(arg0 context.Context) (*godo.DatabaseMetricsCredentials, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetricsCredentials", arg0)
+	ret0, _ := ret[0].(*godo.DatabaseMetricsCredentials)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMetricsCredentials indicates an expected call of GetMetricsCredentials.
+func (mr *MockDatabasesServiceMockRecorder) GetMetricsCredentials(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricsCredentials", reflect.TypeOf((*MockDatabasesService)(nil).GetMetricsCredentials), arg0)
+}
+
+// GetMongoDBConfig mocks base method.
+func (m *MockDatabasesService) GetMongoDBConfig(arg0 context.Context, arg1 string) (*godo.MongoDBConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMongoDBConfig", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MongoDBConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMongoDBConfig indicates an expected call of GetMongoDBConfig.
+func (mr *MockDatabasesServiceMockRecorder) GetMongoDBConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMongoDBConfig", reflect.TypeOf((*MockDatabasesService)(nil).GetMongoDBConfig), arg0, arg1)
+}
+
+// GetMySQLConfig mocks base method.
+func (m *MockDatabasesService) GetMySQLConfig(arg0 context.Context, arg1 string) (*godo.MySQLConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMySQLConfig", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MySQLConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMySQLConfig indicates an expected call of GetMySQLConfig.
+func (mr *MockDatabasesServiceMockRecorder) GetMySQLConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMySQLConfig", reflect.TypeOf((*MockDatabasesService)(nil).GetMySQLConfig), arg0, arg1)
+}
+
+// GetOnlineMigrationStatus mocks base method.
+func (m *MockDatabasesService) GetOnlineMigrationStatus(ctx context.Context, databaseID string) (*godo.DatabaseOnlineMigrationStatus, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOnlineMigrationStatus", ctx, databaseID)
+	ret0, _ := ret[0].(*godo.DatabaseOnlineMigrationStatus)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOnlineMigrationStatus indicates an expected call of GetOnlineMigrationStatus.
+func (mr *MockDatabasesServiceMockRecorder) GetOnlineMigrationStatus(ctx, databaseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnlineMigrationStatus", reflect.TypeOf((*MockDatabasesService)(nil).GetOnlineMigrationStatus), ctx, databaseID)
+}
+
+// GetOpensearchConfig mocks base method.
+func (m *MockDatabasesService) GetOpensearchConfig(arg0 context.Context, arg1 string) (*godo.OpensearchConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpensearchConfig", arg0, arg1)
+	ret0, _ := ret[0].(*godo.OpensearchConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOpensearchConfig indicates an expected call of GetOpensearchConfig.
+func (mr *MockDatabasesServiceMockRecorder) GetOpensearchConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpensearchConfig", reflect.TypeOf((*MockDatabasesService)(nil).GetOpensearchConfig), arg0, arg1)
+}
+
+// GetPool mocks base method.
+func (m *MockDatabasesService) GetPool(arg0 context.Context, arg1, arg2 string) (*godo.DatabasePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPool", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DatabasePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPool indicates an expected call of GetPool.
+func (mr *MockDatabasesServiceMockRecorder) GetPool(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPool", reflect.TypeOf((*MockDatabasesService)(nil).GetPool), arg0, arg1, arg2)
+}
+
+// GetPostgreSQLConfig mocks base method.
+func (m *MockDatabasesService) GetPostgreSQLConfig(arg0 context.Context, arg1 string) (*godo.PostgreSQLConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostgreSQLConfig", arg0, arg1)
+	ret0, _ := ret[0].(*godo.PostgreSQLConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPostgreSQLConfig indicates an expected call of GetPostgreSQLConfig.
+func (mr *MockDatabasesServiceMockRecorder) GetPostgreSQLConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostgreSQLConfig", reflect.TypeOf((*MockDatabasesService)(nil).GetPostgreSQLConfig), arg0, arg1)
+}
+
+// GetRedisConfig mocks base method.
+func (m *MockDatabasesService) GetRedisConfig(arg0 context.Context, arg1 string) (*godo.RedisConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedisConfig", arg0, arg1)
+	ret0, _ := ret[0].(*godo.RedisConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRedisConfig indicates an expected call of GetRedisConfig.
+func (mr *MockDatabasesServiceMockRecorder) GetRedisConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedisConfig", reflect.TypeOf((*MockDatabasesService)(nil).GetRedisConfig), arg0, arg1)
+}
+
+// GetReplica mocks base method.
+func (m *MockDatabasesService) GetReplica(arg0 context.Context, arg1, arg2 string) (*godo.DatabaseReplica, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplica", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DatabaseReplica)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReplica indicates an expected call of GetReplica.
+func (mr *MockDatabasesServiceMockRecorder) GetReplica(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplica", reflect.TypeOf((*MockDatabasesService)(nil).GetReplica), arg0, arg1, arg2)
+}
+
+// GetSQLMode mocks base method.
+func (m *MockDatabasesService) GetSQLMode(arg0 context.Context, arg1 string) (string, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSQLMode", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSQLMode indicates an expected call of GetSQLMode.
+func (mr *MockDatabasesServiceMockRecorder) GetSQLMode(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSQLMode", reflect.TypeOf((*MockDatabasesService)(nil).GetSQLMode), arg0, arg1)
+}
+
+// GetStorageAutoscale mocks base method.
+func (m *MockDatabasesService) GetStorageAutoscale(arg0 context.Context, arg1 string) (*godo.DatabaseStorageAutoscale, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageAutoscale", arg0, arg1)
+	ret0, _ := ret[0].(*godo.DatabaseStorageAutoscale)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStorageAutoscale indicates an expected call of GetStorageAutoscale.
+func (mr *MockDatabasesServiceMockRecorder) GetStorageAutoscale(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageAutoscale", reflect.TypeOf((*MockDatabasesService)(nil).GetStorageAutoscale), arg0, arg1)
+}
+
+// GetTopic mocks base method.
+func (m *MockDatabasesService) GetTopic(arg0 context.Context, arg1, arg2 string) (*godo.DatabaseTopic, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopic", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DatabaseTopic)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTopic indicates an expected call of GetTopic.
+func (mr *MockDatabasesServiceMockRecorder) GetTopic(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopic", reflect.TypeOf((*MockDatabasesService)(nil).GetTopic), arg0, arg1, arg2)
+}
+
+// GetUser mocks base method.
+func (m *MockDatabasesService) GetUser(arg0 context.Context, arg1, arg2 string) (*godo.DatabaseUser, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DatabaseUser)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockDatabasesServiceMockRecorder) GetUser(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockDatabasesService)(nil).GetUser), arg0, arg1, arg2)
+}
+
+// GetValkeyConfig mocks base method.
+func (m *MockDatabasesService) GetValkeyConfig(arg0 context.Context, arg1 string) (*godo.ValkeyConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValkeyConfig", arg0, arg1)
+	ret0, _ := ret[0].(*godo.ValkeyConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetValkeyConfig indicates an expected call of GetValkeyConfig.
+func (mr *MockDatabasesServiceMockRecorder) GetValkeyConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValkeyConfig", reflect.TypeOf((*MockDatabasesService)(nil).GetValkeyConfig), arg0, arg1)
+}
+
+// InstallUpdate mocks base method.
+func (m *MockDatabasesService) InstallUpdate(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallUpdate", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallUpdate indicates an expected call of InstallUpdate.
+func (mr *MockDatabasesServiceMockRecorder) InstallUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallUpdate", reflect.TypeOf((*MockDatabasesService)(nil).InstallUpdate), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockDatabasesService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Database, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Database)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockDatabasesServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDatabasesService)(nil).List), arg0, arg1)
+}
+
+// ListBackups mocks base method.
+func (m *MockDatabasesService) ListBackups(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.DatabaseBackup, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackups", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.DatabaseBackup)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBackups indicates an expected call of ListBackups.
+func (mr *MockDatabasesServiceMockRecorder) ListBackups(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackups", reflect.TypeOf((*MockDatabasesService)(nil).ListBackups), arg0, arg1, arg2)
+}
+
+// ListDBs mocks base method.
+func (m *MockDatabasesService) ListDBs(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.DatabaseDB, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDBs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.DatabaseDB)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDBs indicates an expected call of ListDBs.
+func (mr *MockDatabasesServiceMockRecorder) ListDBs(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDBs", reflect.TypeOf((*MockDatabasesService)(nil).ListDBs), arg0, arg1, arg2)
+}
+
+// ListDatabaseEvents mocks base method.
+func (m *MockDatabasesService) ListDatabaseEvents(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.DatabaseEvent, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDatabaseEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.DatabaseEvent)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDatabaseEvents indicates an expected call of ListDatabaseEvents.
+func (mr *MockDatabasesServiceMockRecorder) ListDatabaseEvents(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDatabaseEvents", reflect.TypeOf((*MockDatabasesService)(nil).ListDatabaseEvents), arg0, arg1, arg2)
+}
+
+// ListIndexes mocks base method.
+func (m *MockDatabasesService) ListIndexes(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.DatabaseIndex, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIndexes", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.DatabaseIndex)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListIndexes indicates an expected call of ListIndexes.
+func (mr *MockDatabasesServiceMockRecorder) ListIndexes(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIndexes", reflect.TypeOf((*MockDatabasesService)(nil).ListIndexes), arg0, arg1, arg2)
+}
+
+// ListKafkaSchemaRegistry mocks base method.
+func (m *MockDatabasesService) ListKafkaSchemaRegistry(ctx context.Context, databaseID string, opts *godo.ListOptions) ([]godo.DatabaseKafkaSchemaRegistrySubject, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKafkaSchemaRegistry", ctx, databaseID, opts)
+	ret0, _ := ret[0].([]godo.DatabaseKafkaSchemaRegistrySubject)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListKafkaSchemaRegistry indicates an expected call of ListKafkaSchemaRegistry.
+func (mr *MockDatabasesServiceMockRecorder) ListKafkaSchemaRegistry(ctx, databaseID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKafkaSchemaRegistry", reflect.TypeOf((*MockDatabasesService)(nil).ListKafkaSchemaRegistry), ctx, databaseID, opts)
+}
+
+// ListLogsinks mocks base method.
+func (m *MockDatabasesService) ListLogsinks(ctx context.Context, databaseID string, opts *godo.ListOptions) ([]godo.DatabaseLogsink, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLogsinks", ctx, databaseID, opts)
+	ret0, _ := ret[0].([]godo.DatabaseLogsink)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListLogsinks indicates an expected call of ListLogsinks.
+func (mr *MockDatabasesServiceMockRecorder) ListLogsinks(ctx, databaseID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLogsinks", reflect.TypeOf((*MockDatabasesService)(nil).ListLogsinks), ctx, databaseID, opts)
+}
+
+// ListOptions mocks base method.
+func (m *MockDatabasesService) ListOptions(todo context.Context) (*godo.DatabaseOptions, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOptions", todo)
+	ret0, _ := ret[0].(*godo.DatabaseOptions)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListOptions indicates an expected call of ListOptions.
+func (mr *MockDatabasesServiceMockRecorder) ListOptions(todo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOptions", reflect.TypeOf((*MockDatabasesService)(nil).ListOptions), todo)
+}
+
+// ListPools mocks base method.
+func (m *MockDatabasesService) ListPools(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.DatabasePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPools", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.DatabasePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListPools indicates an expected call of ListPools.
+func (mr *MockDatabasesServiceMockRecorder) ListPools(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPools", reflect.TypeOf((*MockDatabasesService)(nil).ListPools), arg0, arg1, arg2)
+}
+
+// ListReplicas mocks base method.
+func (m *MockDatabasesService) ListReplicas(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.DatabaseReplica, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReplicas", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.DatabaseReplica)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListReplicas indicates an expected call of ListReplicas.
+func (mr *MockDatabasesServiceMockRecorder) ListReplicas(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReplicas", reflect.TypeOf((*MockDatabasesService)(nil).ListReplicas), arg0, arg1, arg2)
+}
+
+// ListTopics mocks base method.
+func (m *MockDatabasesService) ListTopics(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.DatabaseTopic, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopics", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.DatabaseTopic)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTopics indicates an expected call of ListTopics.
+func (mr *MockDatabasesServiceMockRecorder) ListTopics(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopics", reflect.TypeOf((*MockDatabasesService)(nil).ListTopics), arg0, arg1, arg2)
+}
+
+// ListUsers mocks base method.
+func (m *MockDatabasesService) ListUsers(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.DatabaseUser, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.DatabaseUser)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockDatabasesServiceMockRecorder) ListUsers(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockDatabasesService)(nil).ListUsers), arg0, arg1, arg2)
+}
+
+// Migrate mocks base method.
+func (m *MockDatabasesService) Migrate(arg0 context.Context, arg1 string, arg2 *godo.DatabaseMigrateRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Migrate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Migrate indicates an expected call of Migrate.
+func (mr *MockDatabasesServiceMockRecorder) Migrate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockDatabasesService)(nil).Migrate), arg0, arg1, arg2)
+}
+
+// PromoteReplicaToPrimary mocks base method.
+func (m *MockDatabasesService) PromoteReplicaToPrimary(arg0 context.Context, arg1, arg2 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteReplicaToPrimary", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteReplicaToPrimary indicates an expected call of PromoteReplicaToPrimary.
+func (mr *MockDatabasesServiceMockRecorder) PromoteReplicaToPrimary(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteReplicaToPrimary", reflect.TypeOf((*MockDatabasesService)(nil).PromoteReplicaToPrimary), arg0, arg1, arg2)
+}
+
+// ResetUserAuth mocks base method.
+func (m *MockDatabasesService) ResetUserAuth(arg0 context.Context, arg1, arg2 string, arg3 *godo.DatabaseResetUserAuthRequest) (*godo.DatabaseUser, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetUserAuth", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.DatabaseUser)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ResetUserAuth indicates an expected call of ResetUserAuth.
+func (mr *MockDatabasesServiceMockRecorder) ResetUserAuth(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetUserAuth", reflect.TypeOf((*MockDatabasesService)(nil).ResetUserAuth), arg0, arg1, arg2, arg3)
+}
+
+// Resize mocks base method.
+func (m *MockDatabasesService) Resize(arg0 context.Context, arg1 string, arg2 *godo.DatabaseResizeRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resize", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resize indicates an expected call of Resize.
+func (mr *MockDatabasesServiceMockRecorder) Resize(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockDatabasesService)(nil).Resize), arg0, arg1, arg2)
+}
+
+// SetEvictionPolicy mocks base method.
+func (m *MockDatabasesService) SetEvictionPolicy(arg0 context.Context, arg1, arg2 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEvictionPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEvictionPolicy indicates an expected call of SetEvictionPolicy.
+func (mr *MockDatabasesServiceMockRecorder) SetEvictionPolicy(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEvictionPolicy", reflect.TypeOf((*MockDatabasesService)(nil).SetEvictionPolicy), arg0, arg1, arg2)
+}
+
+// SetSQLMode mocks base method.
+func (m *MockDatabasesService) SetSQLMode(arg0 context.Context, arg1 string, arg2 ...string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetSQLMode", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSQLMode indicates an expected call of SetSQLMode.
+func (mr *MockDatabasesServiceMockRecorder) SetSQLMode(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSQLMode", reflect.TypeOf((*MockDatabasesService)(nil).SetSQLMode), varargs...)
+}
+
+// StartOnlineMigration mocks base method.
+func (m *MockDatabasesService) StartOnlineMigration(ctx context.Context, databaseID string, onlineMigrationRequest *godo.DatabaseStartOnlineMigrationRequest) (*godo.DatabaseOnlineMigrationStatus, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartOnlineMigration", ctx, databaseID, onlineMigrationRequest)
+	ret0, _ := ret[0].(*godo.DatabaseOnlineMigrationStatus)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// StartOnlineMigration indicates an expected call of StartOnlineMigration.
+func (mr *MockDatabasesServiceMockRecorder) StartOnlineMigration(ctx, databaseID, onlineMigrationRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartOnlineMigration", reflect.TypeOf((*MockDatabasesService)(nil).StartOnlineMigration), ctx, databaseID, onlineMigrationRequest)
+}
+
+// StopOnlineMigration mocks base method.
+func (m *MockDatabasesService) StopOnlineMigration(ctx context.Context, databaseID, migrationID string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopOnlineMigration", ctx, databaseID, migrationID)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopOnlineMigration indicates an expected call of StopOnlineMigration.
+func (mr *MockDatabasesServiceMockRecorder) StopOnlineMigration(ctx, databaseID, migrationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopOnlineMigration", reflect.TypeOf((*MockDatabasesService)(nil).StopOnlineMigration), ctx, databaseID, migrationID)
+}
+
+// UpdateAdvancedPostgresSQLConfig mocks base method.
+func (m *MockDatabasesService) UpdateAdvancedPostgresSQLConfig(arg0 context.Context, arg1 string, arg2 *godo.AdvancedPostgresConfigUpdate) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAdvancedPostgresSQLConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAdvancedPostgresSQLConfig indicates an expected call of UpdateAdvancedPostgresSQLConfig.
+func (mr *MockDatabasesServiceMockRecorder) UpdateAdvancedPostgresSQLConfig(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAdvancedPostgresSQLConfig", reflect.TypeOf((*MockDatabasesService)(nil).UpdateAdvancedPostgresSQLConfig), arg0, arg1, arg2)
+}
+
+// UpdateFirewallRules mocks base method.
+func (m *MockDatabasesService) UpdateFirewallRules(arg0 context.Context, arg1 string, arg2 *godo.DatabaseUpdateFirewallRulesRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFirewallRules", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFirewallRules indicates an expected call of UpdateFirewallRules.
+func (mr *MockDatabasesServiceMockRecorder) UpdateFirewallRules(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewallRules", reflect.TypeOf((*MockDatabasesService)(nil).UpdateFirewallRules), arg0, arg1, arg2)
+}
+
+// UpdateKafkaConfig mocks base method.
+func (m *MockDatabasesService) UpdateKafkaConfig(arg0 context.Context, arg1 string, arg2 *godo.KafkaConfig) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKafkaConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateKafkaConfig indicates an expected call of UpdateKafkaConfig.
+func (mr *MockDatabasesServiceMockRecorder) UpdateKafkaConfig(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKafkaConfig", reflect.TypeOf((*MockDatabasesService)(nil).UpdateKafkaConfig), arg0, arg1, arg2)
+}
+
+// UpdateKafkaSchemaRegistryConfig mocks base method.
+func (m *MockDatabasesService) UpdateKafkaSchemaRegistryConfig(ctx context.Context, databaseID string, updateKafkaSchemaRegistryConfig *godo.DatabaseKafkaSchemaRegistryConfig) (*godo.DatabaseKafkaSchemaRegistryConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKafkaSchemaRegistryConfig", ctx, databaseID, updateKafkaSchemaRegistryConfig)
+	ret0, _ := ret[0].(*godo.DatabaseKafkaSchemaRegistryConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateKafkaSchemaRegistryConfig indicates an expected call of UpdateKafkaSchemaRegistryConfig.
+func (mr *MockDatabasesServiceMockRecorder) UpdateKafkaSchemaRegistryConfig(ctx, databaseID, updateKafkaSchemaRegistryConfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKafkaSchemaRegistryConfig", reflect.TypeOf((*MockDatabasesService)(nil).UpdateKafkaSchemaRegistryConfig), ctx, databaseID, updateKafkaSchemaRegistryConfig)
+}
+
+// UpdateKafkaSchemaRegistrySubjectConfig mocks base method.
+func (m *MockDatabasesService) UpdateKafkaSchemaRegistrySubjectConfig(ctx context.Context, databaseID, subject string, updateKafkaSchemaRegistrySubjectConfig *godo.DatabaseKafkaSchemaRegistryConfig) (*godo.DatabaseKafkaSchemaRegistrySubjectConfigResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKafkaSchemaRegistrySubjectConfig", ctx, databaseID, subject, updateKafkaSchemaRegistrySubjectConfig)
+	ret0, _ := ret[0].(*godo.DatabaseKafkaSchemaRegistrySubjectConfigResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateKafkaSchemaRegistrySubjectConfig indicates an expected call of UpdateKafkaSchemaRegistrySubjectConfig.
+func (mr *MockDatabasesServiceMockRecorder) UpdateKafkaSchemaRegistrySubjectConfig(ctx, databaseID, subject, updateKafkaSchemaRegistrySubjectConfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKafkaSchemaRegistrySubjectConfig", reflect.TypeOf((*MockDatabasesService)(nil).UpdateKafkaSchemaRegistrySubjectConfig), ctx, databaseID, subject, updateKafkaSchemaRegistrySubjectConfig)
+}
+
+// UpdateLogsink mocks base method.
+func (m *MockDatabasesService) UpdateLogsink(ctx context.Context, databaseID, logsinkID string, updateLogsink *godo.DatabaseUpdateLogsinkRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLogsink", ctx, databaseID, logsinkID, updateLogsink)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLogsink indicates an expected call of UpdateLogsink.
+func (mr *MockDatabasesServiceMockRecorder) UpdateLogsink(ctx, databaseID, logsinkID, updateLogsink any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogsink", reflect.TypeOf((*MockDatabasesService)(nil).UpdateLogsink), ctx, databaseID, logsinkID, updateLogsink)
+}
+
+// UpdateMaintenance mocks base method.
+func (m *MockDatabasesService) UpdateMaintenance(arg0 context.Context, arg1 string, arg2 *godo.DatabaseUpdateMaintenanceRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaintenance", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMaintenance indicates an expected call of UpdateMaintenance.
+func (mr *MockDatabasesServiceMockRecorder) UpdateMaintenance(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaintenance", reflect.TypeOf((*MockDatabasesService)(nil).UpdateMaintenance), arg0, arg1, arg2)
+}
+
+// UpdateMetricsCredentials mocks base method.
+func (m *MockDatabasesService) UpdateMetricsCredentials(arg0 context.Context, arg1 *godo.DatabaseUpdateMetricsCredentialsRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMetricsCredentials", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMetricsCredentials indicates an expected call of UpdateMetricsCredentials.
+func (mr *MockDatabasesServiceMockRecorder) UpdateMetricsCredentials(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMetricsCredentials", reflect.TypeOf((*MockDatabasesService)(nil).UpdateMetricsCredentials), arg0, arg1)
+}
+
+// UpdateMongoDBConfig mocks base method.
+func (m *MockDatabasesService) UpdateMongoDBConfig(arg0 context.Context, arg1 string, arg2 *godo.MongoDBConfig) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMongoDBConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMongoDBConfig indicates an expected call of UpdateMongoDBConfig.
+func (mr *MockDatabasesServiceMockRecorder) UpdateMongoDBConfig(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMongoDBConfig", reflect.TypeOf((*MockDatabasesService)(nil).UpdateMongoDBConfig), arg0, arg1, arg2)
+}
+
+// UpdateMySQLConfig mocks base method.
+func (m *MockDatabasesService) UpdateMySQLConfig(arg0 context.Context, arg1 string, arg2 *godo.MySQLConfig) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMySQLConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMySQLConfig indicates an expected call of UpdateMySQLConfig.
+func (mr *MockDatabasesServiceMockRecorder) UpdateMySQLConfig(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMySQLConfig", reflect.TypeOf((*MockDatabasesService)(nil).UpdateMySQLConfig), arg0, arg1, arg2)
+}
+
+// UpdateOpensearchConfig mocks base method.
+func (m *MockDatabasesService) UpdateOpensearchConfig(arg0 context.Context, arg1 string, arg2 *godo.OpensearchConfig) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOpensearchConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOpensearchConfig indicates an expected call of UpdateOpensearchConfig.
+func (mr *MockDatabasesServiceMockRecorder) UpdateOpensearchConfig(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOpensearchConfig", reflect.TypeOf((*MockDatabasesService)(nil).UpdateOpensearchConfig), arg0, arg1, arg2)
+}
+
+// UpdatePool mocks base method.
+func (m *MockDatabasesService) UpdatePool(arg0 context.Context, arg1, arg2 string, arg3 *godo.DatabaseUpdatePoolRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePool", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePool indicates an expected call of UpdatePool.
+func (mr *MockDatabasesServiceMockRecorder) UpdatePool(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePool", reflect.TypeOf((*MockDatabasesService)(nil).UpdatePool), arg0, arg1, arg2, arg3)
+}
+
+// UpdatePostgreSQLConfig mocks base method.
+func (m *MockDatabasesService) UpdatePostgreSQLConfig(arg0 context.Context, arg1 string, arg2 *godo.PostgreSQLConfig) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePostgreSQLConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePostgreSQLConfig indicates an expected call of UpdatePostgreSQLConfig.
+func (mr *MockDatabasesServiceMockRecorder) UpdatePostgreSQLConfig(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePostgreSQLConfig", reflect.TypeOf((*MockDatabasesService)(nil).UpdatePostgreSQLConfig), arg0, arg1, arg2)
+}
+
+// UpdateRedisConfig mocks base method.
+func (m *MockDatabasesService) UpdateRedisConfig(arg0 context.Context, arg1 string, arg2 *godo.RedisConfig) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRedisConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRedisConfig indicates an expected call of UpdateRedisConfig.
+func (mr *MockDatabasesServiceMockRecorder) UpdateRedisConfig(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRedisConfig", reflect.TypeOf((*MockDatabasesService)(nil).UpdateRedisConfig), arg0, arg1, arg2)
+}
+
+// UpdateStorageAutoscale mocks base method.
+func (m *MockDatabasesService) UpdateStorageAutoscale(arg0 context.Context, arg1 string, arg2 *godo.DatabaseStorageAutoscale) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStorageAutoscale", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStorageAutoscale indicates an expected call of UpdateStorageAutoscale.
+func (mr *MockDatabasesServiceMockRecorder) UpdateStorageAutoscale(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStorageAutoscale", reflect.TypeOf((*MockDatabasesService)(nil).UpdateStorageAutoscale), arg0, arg1, arg2)
+}
+
+// UpdateTopic mocks base method.
+func (m *MockDatabasesService) UpdateTopic(arg0 context.Context, arg1, arg2 string, arg3 *godo.DatabaseUpdateTopicRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTopic", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTopic indicates an expected call of UpdateTopic.
+func (mr *MockDatabasesServiceMockRecorder) UpdateTopic(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTopic", reflect.TypeOf((*MockDatabasesService)(nil).UpdateTopic), arg0, arg1, arg2, arg3)
+}
+
+// UpdateUser mocks base method.
+func (m *MockDatabasesService) UpdateUser(arg0 context.Context, arg1, arg2 string, arg3 *godo.DatabaseUpdateUserRequest) (*godo.DatabaseUser, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.DatabaseUser)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockDatabasesServiceMockRecorder) UpdateUser(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockDatabasesService)(nil).UpdateUser), arg0, arg1, arg2, arg3)
+}
+
+// UpdateValkeyConfig mocks base method.
+func (m *MockDatabasesService) UpdateValkeyConfig(arg0 context.Context, arg1 string, arg2 *godo.ValkeyConfig) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateValkeyConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateValkeyConfig indicates an expected call of UpdateValkeyConfig.
+func (mr *MockDatabasesServiceMockRecorder) UpdateValkeyConfig(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValkeyConfig", reflect.TypeOf((*MockDatabasesService)(nil).UpdateValkeyConfig), arg0, arg1, arg2)
+}
+
+// UpgradeMajorVersion mocks base method.
+func (m *MockDatabasesService) UpgradeMajorVersion(arg0 context.Context, arg1 string, arg2 *godo.UpgradeVersionRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeMajorVersion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeMajorVersion indicates an expected call of UpgradeMajorVersion.
+func (mr *MockDatabasesServiceMockRecorder) UpgradeMajorVersion(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeMajorVersion", reflect.TypeOf((*MockDatabasesService)(nil).UpgradeMajorVersion), arg0, arg1, arg2)
+}
+
+// MockDomainsService is a mock of DomainsService interface.
+type MockDomainsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainsServiceMockRecorder is the mock recorder for MockDomainsService.
+type MockDomainsServiceMockRecorder struct {
+	mock *MockDomainsService
+}
+
+// NewMockDomainsService creates a new mock instance.
+func NewMockDomainsService(ctrl *gomock.Controller) *MockDomainsService {
+	mock := &MockDomainsService{ctrl: ctrl}
+	mock.recorder = &MockDomainsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainsService) EXPECT() *MockDomainsServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDomainsService) Create(arg0 context.Context, arg1 *godo.DomainCreateRequest) (*godo.Domain, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Domain)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDomainsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDomainsService)(nil).Create), arg0, arg1)
+}
+
+// CreateRecord mocks base method.
+func (m *MockDomainsService) CreateRecord(arg0 context.Context, arg1 string, arg2 *godo.DomainRecordEditRequest) (*godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecord", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateRecord indicates an expected call of CreateRecord.
+func (mr *MockDomainsServiceMockRecorder) CreateRecord(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecord", reflect.TypeOf((*MockDomainsService)(nil).CreateRecord), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockDomainsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDomainsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDomainsService)(nil).Delete), arg0, arg1)
+}
+
+// DeleteRecord mocks base method.
+func (m *MockDomainsService) DeleteRecord(arg0 context.Context, arg1 string, arg2 int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecord", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRecord indicates an expected call of DeleteRecord.
+func (mr *MockDomainsServiceMockRecorder) DeleteRecord(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecord", reflect.TypeOf((*MockDomainsService)(nil).DeleteRecord), arg0, arg1, arg2)
+}
+
+// EditRecord mocks base method.
+func (m *MockDomainsService) EditRecord(arg0 context.Context, arg1 string, arg2 int, arg3 *godo.DomainRecordEditRequest) (*godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditRecord", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EditRecord indicates an expected call of EditRecord.
+func (mr *MockDomainsServiceMockRecorder) EditRecord(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditRecord", reflect.TypeOf((*MockDomainsService)(nil).EditRecord), arg0, arg1, arg2, arg3)
+}
+
+// Get mocks base method.
+func (m *MockDomainsService) Get(arg0 context.Context, arg1 string) (*godo.Domain, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Domain)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDomainsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDomainsService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockDomainsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Domain, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Domain)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockDomainsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDomainsService)(nil).List), arg0, arg1)
+}
+
+// Record mocks base method.
+func (m *MockDomainsService) Record(arg0 context.Context, arg1 string, arg2 int) (*godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockDomainsServiceMockRecorder) Record(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockDomainsService)(nil).Record), arg0, arg1, arg2)
+}
+
+// Records mocks base method.
+func (m *MockDomainsService) Records(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Records", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Records indicates an expected call of Records.
+func (mr *MockDomainsServiceMockRecorder) Records(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Records", reflect.TypeOf((*MockDomainsService)(nil).Records), arg0, arg1, arg2)
+}
+
+// RecordsByName mocks base method.
+func (m *MockDomainsService) RecordsByName(arg0 context.Context, arg1, arg2 string, arg3 *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordsByName", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RecordsByName indicates an expected call of RecordsByName.
+func (mr *MockDomainsServiceMockRecorder) RecordsByName(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordsByName", reflect.TypeOf((*MockDomainsService)(nil).RecordsByName), arg0, arg1, arg2, arg3)
+}
+
+// RecordsByType mocks base method.
+func (m *MockDomainsService) RecordsByType(arg0 context.Context, arg1, arg2 string, arg3 *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordsByType", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RecordsByType indicates an expected call of RecordsByType.
+func (mr *MockDomainsServiceMockRecorder) RecordsByType(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordsByType", reflect.TypeOf((*MockDomainsService)(nil).RecordsByType), arg0, arg1, arg2, arg3)
+}
+
+// RecordsByTypeAndName mocks base method.
+func (m *MockDomainsService) RecordsByTypeAndName(arg0 context.Context, arg1, arg2, arg3 string, arg4 *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordsByTypeAndName", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RecordsByTypeAndName indicates an expected call of RecordsByTypeAndName.
+func (mr *MockDomainsServiceMockRecorder) RecordsByTypeAndName(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordsByTypeAndName", reflect.TypeOf((*MockDomainsService)(nil).RecordsByTypeAndName), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MockDropletsService is a mock of DropletsService interface.
+type MockDropletsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDropletsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDropletsServiceMockRecorder is the mock recorder for MockDropletsService.
+type MockDropletsServiceMockRecorder struct {
+	mock *MockDropletsService
+}
+
+// NewMockDropletsService creates a new mock instance.
+func NewMockDropletsService(ctrl *gomock.Controller) *MockDropletsService {
+	mock := &MockDropletsService{ctrl: ctrl}
+	mock.recorder = &MockDropletsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDropletsService) EXPECT() *MockDropletsServiceMockRecorder {
+	return m.recorder
+}
+
+// Actions mocks base method.
+func (m *MockDropletsService) Actions(arg0 context.Context, arg1 int, arg2 *godo.ListOptions) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Actions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Actions indicates an expected call of Actions.
+func (mr *MockDropletsServiceMockRecorder) Actions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Actions", reflect.TypeOf((*MockDropletsService)(nil).Actions), arg0, arg1, arg2)
+}
+
+// Backups mocks base method.
+func (m *MockDropletsService) Backups(arg0 context.Context, arg1 int, arg2 *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backups", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Backups indicates an expected call of Backups.
+func (mr *MockDropletsServiceMockRecorder) Backups(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backups", reflect.TypeOf((*MockDropletsService)(nil).Backups), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockDropletsService) Create(arg0 context.Context, arg1 *godo.DropletCreateRequest) (*godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDropletsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDropletsService)(nil).Create), arg0, arg1)
+}
+
+// CreateMultiple mocks base method.
+func (m *MockDropletsService) CreateMultiple(arg0 context.Context, arg1 *godo.DropletMultiCreateRequest) ([]godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMultiple", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateMultiple indicates an expected call of CreateMultiple.
+func (mr *MockDropletsServiceMockRecorder) CreateMultiple(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultiple", reflect.TypeOf((*MockDropletsService)(nil).CreateMultiple), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockDropletsService) Delete(arg0 context.Context, arg1 int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDropletsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDropletsService)(nil).Delete), arg0, arg1)
+}
+
+// DeleteByTag mocks base method.
+func (m *MockDropletsService) DeleteByTag(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByTag", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByTag indicates an expected call of DeleteByTag.
+func (mr *MockDropletsServiceMockRecorder) DeleteByTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByTag", reflect.TypeOf((*MockDropletsService)(nil).DeleteByTag), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockDropletsService) Get(arg0 context.Context, arg1 int) (*godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDropletsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDropletsService)(nil).Get), arg0, arg1)
+}
+
+// GetBackupPolicy mocks base method.
+func (m *MockDropletsService) GetBackupPolicy(arg0 context.Context, arg1 int) (*godo.DropletBackupPolicy, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackupPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*godo.DropletBackupPolicy)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupPolicy indicates an expected call of GetBackupPolicy.
+func (mr *MockDropletsServiceMockRecorder) GetBackupPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupPolicy", reflect.TypeOf((*MockDropletsService)(nil).GetBackupPolicy), arg0, arg1)
+}
+
+// Kernels mocks base method.
+func (m *MockDropletsService) Kernels(arg0 context.Context, arg1 int, arg2 *godo.ListOptions) ([]godo.Kernel, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Kernels", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Kernel)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Kernels indicates an expected call of Kernels.
+func (mr *MockDropletsServiceMockRecorder) Kernels(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Kernels", reflect.TypeOf((*MockDropletsService)(nil).Kernels), arg0, arg1, arg2)
+}
+
+// List mocks base method.
+func (m *MockDropletsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockDropletsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDropletsService)(nil).List), arg0, arg1)
+}
+
+// ListAssociatedResourcesForDeletion mocks base method.
+func (m *MockDropletsService) ListAssociatedResourcesForDeletion(arg0 context.Context, arg1 int) (*godo.DropletAssociatedResources, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssociatedResourcesForDeletion", arg0, arg1)
+	ret0, _ := ret[0].(*godo.DropletAssociatedResources)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAssociatedResourcesForDeletion indicates an expected call of ListAssociatedResourcesForDeletion.
+func (mr *MockDropletsServiceMockRecorder) ListAssociatedResourcesForDeletion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociatedResourcesForDeletion", reflect.TypeOf((*MockDropletsService)(nil).ListAssociatedResourcesForDeletion), arg0, arg1)
+}
+
+// ListBackupPolicies mocks base method.
+func (m *MockDropletsService) ListBackupPolicies(arg0 context.Context, arg1 *godo.ListOptions) (map[int]*godo.DropletBackupPolicy, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupPolicies", arg0, arg1)
+	ret0, _ := ret[0].(map[int]*godo.DropletBackupPolicy)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBackupPolicies indicates an expected call of ListBackupPolicies.
+func (mr *MockDropletsServiceMockRecorder) ListBackupPolicies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupPolicies", reflect.TypeOf((*MockDropletsService)(nil).ListBackupPolicies), arg0, arg1)
+}
+
+// ListByName mocks base method.
+func (m *MockDropletsService) ListByName(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByName indicates an expected call of ListByName.
+func (mr *MockDropletsServiceMockRecorder) ListByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByName", reflect.TypeOf((*MockDropletsService)(nil).ListByName), arg0, arg1, arg2)
+}
+
+// ListByTag mocks base method.
+func (m *MockDropletsService) ListByTag(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByTag indicates an expected call of ListByTag.
+func (mr *MockDropletsServiceMockRecorder) ListByTag(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTag", reflect.TypeOf((*MockDropletsService)(nil).ListByTag), arg0, arg1, arg2)
+}
+
+// ListSupportedBackupPolicies mocks base method.
+func (m *MockDropletsService) ListSupportedBackupPolicies(arg0 context.Context) ([]*godo.SupportedBackupPolicy, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSupportedBackupPolicies", arg0)
+	ret0, _ := ret[0].([]*godo.SupportedBackupPolicy)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSupportedBackupPolicies indicates an expected call of ListSupportedBackupPolicies.
+func (mr *MockDropletsServiceMockRecorder) ListSupportedBackupPolicies(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedBackupPolicies", reflect.TypeOf((*MockDropletsService)(nil).ListSupportedBackupPolicies), arg0)
+}
+
+// ListWithGPUs mocks base method.
+func (m *MockDropletsService) ListWithGPUs(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithGPUs", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListWithGPUs indicates an expected call of ListWithGPUs.
+func (mr *MockDropletsServiceMockRecorder) ListWithGPUs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithGPUs", reflect.TypeOf((*MockDropletsService)(nil).ListWithGPUs), arg0, arg1)
+}
+
+// Neighbors mocks base method.
+func (m *MockDropletsService) Neighbors(arg0 context.Context, arg1 int) ([]godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Neighbors", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Neighbors indicates an expected call of Neighbors.
+func (mr *MockDropletsServiceMockRecorder) Neighbors(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Neighbors", reflect.TypeOf((*MockDropletsService)(nil).Neighbors), arg0, arg1)
+}
+
+// Snapshots mocks base method.
+func (m *MockDropletsService) Snapshots(arg0 context.Context, arg1 int, arg2 *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshots", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Snapshots indicates an expected call of Snapshots.
+func (mr *MockDropletsServiceMockRecorder) Snapshots(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockDropletsService)(nil).Snapshots), arg0, arg1, arg2)
+}
+
+// MockFirewallsService is a mock of FirewallsService interface.
+type MockFirewallsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFirewallsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockFirewallsServiceMockRecorder is the mock recorder for MockFirewallsService.
+type MockFirewallsServiceMockRecorder struct {
+	mock *MockFirewallsService
+}
+
+// NewMockFirewallsService creates a new mock instance.
+func NewMockFirewallsService(ctrl *gomock.Controller) *MockFirewallsService {
+	mock := &MockFirewallsService{ctrl: ctrl}
+	mock.recorder = &MockFirewallsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFirewallsService) EXPECT() *MockFirewallsServiceMockRecorder {
+	return m.recorder
+}
+
+// AddDroplets mocks base method.
+func (m *MockFirewallsService) AddDroplets(arg0 context.Context, arg1 string, arg2 ...int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddDroplets", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDroplets indicates an expected call of AddDroplets.
+func (mr *MockFirewallsServiceMockRecorder) AddDroplets(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDroplets", reflect.TypeOf((*MockFirewallsService)(nil).AddDroplets), varargs...)
+}
+
+// AddRules mocks base method.
+func (m *MockFirewallsService) AddRules(arg0 context.Context, arg1 string, arg2 *godo.FirewallRulesRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRules", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRules indicates an expected call of AddRules.
+func (mr *MockFirewallsServiceMockRecorder) AddRules(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRules", reflect.TypeOf((*MockFirewallsService)(nil).AddRules), arg0, arg1, arg2)
+}
+
+// AddTags mocks base method.
+func (m *MockFirewallsService) AddTags(arg0 context.Context, arg1 string, arg2 ...string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddTags", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTags indicates an expected call of AddTags.
+func (mr *MockFirewallsServiceMockRecorder) AddTags(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockFirewallsService)(nil).AddTags), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockFirewallsService) Create(arg0 context.Context, arg1 *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Firewall)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFirewallsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFirewallsService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockFirewallsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFirewallsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFirewallsService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockFirewallsService) Get(arg0 context.Context, arg1 string) (*godo.Firewall, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Firewall)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFirewallsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFirewallsService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockFirewallsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Firewall, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Firewall)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockFirewallsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFirewallsService)(nil).List), arg0, arg1)
+}
+
+// ListByDroplet mocks base method.
+func (m *MockFirewallsService) ListByDroplet(arg0 context.Context, arg1 int, arg2 *godo.ListOptions) ([]godo.Firewall, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByDroplet", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Firewall)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByDroplet indicates an expected call of ListByDroplet.
+func (mr *MockFirewallsServiceMockRecorder) ListByDroplet(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDroplet", reflect.TypeOf((*MockFirewallsService)(nil).ListByDroplet), arg0, arg1, arg2)
+}
+
+// RemoveDroplets mocks base method.
+func (m *MockFirewallsService) RemoveDroplets(arg0 context.Context, arg1 string, arg2 ...int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveDroplets", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDroplets indicates an expected call of RemoveDroplets.
+func (mr *MockFirewallsServiceMockRecorder) RemoveDroplets(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDroplets", reflect.TypeOf((*MockFirewallsService)(nil).RemoveDroplets), varargs...)
+}
+
+// RemoveRules mocks base method.
+func (m *MockFirewallsService) RemoveRules(arg0 context.Context, arg1 string, arg2 *godo.FirewallRulesRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRules", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRules indicates an expected call of RemoveRules.
+func (mr *MockFirewallsServiceMockRecorder) RemoveRules(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRules", reflect.TypeOf((*MockFirewallsService)(nil).RemoveRules), arg0, arg1, arg2)
+}
+
+// RemoveTags mocks base method.
+func (m *MockFirewallsService) RemoveTags(arg0 context.Context, arg1 string, arg2 ...string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveTags", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTags indicates an expected call of RemoveTags.
+func (mr *MockFirewallsServiceMockRecorder) RemoveTags(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTags", reflect.TypeOf((*MockFirewallsService)(nil).RemoveTags), varargs...)
+}
+
+// Update mocks base method.
+func (m *MockFirewallsService) Update(arg0 context.Context, arg1 string, arg2 *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Firewall)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockFirewallsServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFirewallsService)(nil).Update), arg0, arg1, arg2)
+}
+
+// MockInvoicesService is a mock of InvoicesService interface.
+type MockInvoicesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoicesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockInvoicesServiceMockRecorder is the mock recorder for MockInvoicesService.
+type MockInvoicesServiceMockRecorder struct {
+	mock *MockInvoicesService
+}
+
+// NewMockInvoicesService creates a new mock instance.
+func NewMockInvoicesService(ctrl *gomock.Controller) *MockInvoicesService {
+	mock := &MockInvoicesService{ctrl: ctrl}
+	mock.recorder = &MockInvoicesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoicesService) EXPECT() *MockInvoicesServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockInvoicesService) Get(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) (*godo.Invoice, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Invoice)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInvoicesServiceMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInvoicesService)(nil).Get), arg0, arg1, arg2)
+}
+
+// GetCSV mocks base method.
+func (m *MockInvoicesService) GetCSV(arg0 context.Context, arg1 string) ([]byte, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCSV", arg0, arg1)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCSV indicates an expected call of GetCSV.
+func (mr *MockInvoicesServiceMockRecorder) GetCSV(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCSV", reflect.TypeOf((*MockInvoicesService)(nil).GetCSV), arg0, arg1)
+}
+
+// GetPDF mocks base method.
+func (m *MockInvoicesService) GetPDF(arg0 context.Context, arg1 string) ([]byte, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPDF", arg0, arg1)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPDF indicates an expected call of GetPDF.
+func (mr *MockInvoicesServiceMockRecorder) GetPDF(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPDF", reflect.TypeOf((*MockInvoicesService)(nil).GetPDF), arg0, arg1)
+}
+
+// GetSummary mocks base method.
+func (m *MockInvoicesService) GetSummary(arg0 context.Context, arg1 string) (*godo.InvoiceSummary, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummary", arg0, arg1)
+	ret0, _ := ret[0].(*godo.InvoiceSummary)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSummary indicates an expected call of GetSummary.
+func (mr *MockInvoicesServiceMockRecorder) GetSummary(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummary", reflect.TypeOf((*MockInvoicesService)(nil).GetSummary), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockInvoicesService) List(arg0 context.Context, arg1 *godo.ListOptions) (*godo.InvoiceList, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(*godo.InvoiceList)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockInvoicesServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInvoicesService)(nil).List), arg0, arg1)
+}
+
+// MockKeysService is a mock of KeysService interface.
+type MockKeysService struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeysServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockKeysServiceMockRecorder is the mock recorder for MockKeysService.
+type MockKeysServiceMockRecorder struct {
+	mock *MockKeysService
+}
+
+// NewMockKeysService creates a new mock instance.
+func NewMockKeysService(ctrl *gomock.Controller) *MockKeysService {
+	mock := &MockKeysService{ctrl: ctrl}
+	mock.recorder = &MockKeysServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeysService) EXPECT() *MockKeysServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockKeysService) Create(arg0 context.Context, arg1 *godo.KeyCreateRequest) (*godo.Key, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Key)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockKeysServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKeysService)(nil).Create), arg0, arg1)
+}
+
+// DeleteByFingerprint mocks base method.
+func (m *MockKeysService) DeleteByFingerprint(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByFingerprint", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByFingerprint indicates an expected call of DeleteByFingerprint.
+func (mr *MockKeysServiceMockRecorder) DeleteByFingerprint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByFingerprint", reflect.TypeOf((*MockKeysService)(nil).DeleteByFingerprint), arg0, arg1)
+}
+
+// DeleteByID mocks base method.
+func (m *MockKeysService) DeleteByID(arg0 context.Context, arg1 int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockKeysServiceMockRecorder) DeleteByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockKeysService)(nil).DeleteByID), arg0, arg1)
+}
+
+// GetByFingerprint mocks base method.
+func (m *MockKeysService) GetByFingerprint(arg0 context.Context, arg1 string) (*godo.Key, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByFingerprint", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Key)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByFingerprint indicates an expected call of GetByFingerprint.
+func (mr *MockKeysServiceMockRecorder) GetByFingerprint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByFingerprint", reflect.TypeOf((*MockKeysService)(nil).GetByFingerprint), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockKeysService) GetByID(arg0 context.Context, arg1 int) (*godo.Key, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Key)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockKeysServiceMockRecorder) GetByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockKeysService)(nil).GetByID), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockKeysService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Key, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Key)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockKeysServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKeysService)(nil).List), arg0, arg1)
+}
+
+// UpdateByFingerprint mocks base method.
+func (m *MockKeysService) UpdateByFingerprint(arg0 context.Context, arg1 string, arg2 *godo.KeyUpdateRequest) (*godo.Key, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByFingerprint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Key)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateByFingerprint indicates an expected call of UpdateByFingerprint.
+func (mr *MockKeysServiceMockRecorder) UpdateByFingerprint(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByFingerprint", reflect.TypeOf((*MockKeysService)(nil).UpdateByFingerprint), arg0, arg1, arg2)
+}
+
+// UpdateByID mocks base method.
+func (m *MockKeysService) UpdateByID(arg0 context.Context, arg1 int, arg2 *godo.KeyUpdateRequest) (*godo.Key, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Key)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateByID indicates an expected call of UpdateByID.
+func (mr *MockKeysServiceMockRecorder) UpdateByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MockKeysService)(nil).UpdateByID), arg0, arg1, arg2)
+}
+
+// MockKubernetesService is a mock of KubernetesService interface.
+type MockKubernetesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockKubernetesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockKubernetesServiceMockRecorder is the mock recorder for MockKubernetesService.
+type MockKubernetesServiceMockRecorder struct {
+	mock *MockKubernetesService
+}
+
+// NewMockKubernetesService creates a new mock instance.
+func NewMockKubernetesService(ctrl *gomock.Controller) *MockKubernetesService {
+	mock := &MockKubernetesService{ctrl: ctrl}
+	mock.recorder = &MockKubernetesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKubernetesService) EXPECT() *MockKubernetesServiceMockRecorder {
+	return m.recorder
+}
+
+// AddRegistry mocks base method.
+func (m *MockKubernetesService) AddRegistry(ctx context.Context, req *godo.KubernetesClusterRegistryRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRegistry", ctx, req)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRegistry indicates an expected call of AddRegistry.
+func (mr *MockKubernetesServiceMockRecorder) AddRegistry(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRegistry", reflect.TypeOf((*MockKubernetesService)(nil).AddRegistry), ctx, req)
+}
+
+// Create mocks base method.
+func (m *MockKubernetesService) Create(arg0 context.Context, arg1 *godo.KubernetesClusterCreateRequest) (*godo.KubernetesCluster, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KubernetesCluster)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockKubernetesServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKubernetesService)(nil).Create), arg0, arg1)
+}
+
+// CreateNodePool mocks base method.
+func (m *MockKubernetesService) CreateNodePool(ctx context.Context, clusterID string, req *godo.KubernetesNodePoolCreateRequest) (*godo.KubernetesNodePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNodePool", ctx, clusterID, req)
+	ret0, _ := ret[0].(*godo.KubernetesNodePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateNodePool indicates an expected call of CreateNodePool.
+func (mr *MockKubernetesServiceMockRecorder) CreateNodePool(ctx, clusterID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNodePool", reflect.TypeOf((*MockKubernetesService)(nil).CreateNodePool), ctx, clusterID, req)
+}
+
+// Delete mocks base method.
+func (m *MockKubernetesService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockKubernetesServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKubernetesService)(nil).Delete), arg0, arg1)
+}
+
+// DeleteDangerous mocks base method.
+func (m *MockKubernetesService) DeleteDangerous(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDangerous", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDangerous indicates an expected call of DeleteDangerous.
+func (mr *MockKubernetesServiceMockRecorder) DeleteDangerous(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDangerous", reflect.TypeOf((*MockKubernetesService)(nil).DeleteDangerous), arg0, arg1)
+}
+
+// DeleteNode mocks base method.
+func (m *MockKubernetesService) DeleteNode(ctx context.Context, clusterID, poolID, nodeID string, req *godo.KubernetesNodeDeleteRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNode", ctx, clusterID, poolID, nodeID, req)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNode indicates an expected call of DeleteNode.
+func (mr *MockKubernetesServiceMockRecorder) DeleteNode(ctx, clusterID, poolID, nodeID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNode", reflect.TypeOf((*MockKubernetesService)(nil).DeleteNode), ctx, clusterID, poolID, nodeID, req)
+}
+
+// DeleteNodePool mocks base method.
+func (m *MockKubernetesService) DeleteNodePool(ctx context.Context, clusterID, poolID string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNodePool", ctx, clusterID, poolID)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNodePool indicates an expected call of DeleteNodePool.
+func (mr *MockKubernetesServiceMockRecorder) DeleteNodePool(ctx, clusterID, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNodePool", reflect.TypeOf((*MockKubernetesService)(nil).DeleteNodePool), ctx, clusterID, poolID)
+}
+
+// DeleteSelective mocks base method.
+func (m *MockKubernetesService) DeleteSelective(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterDeleteSelectiveRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSelective", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSelective indicates an expected call of DeleteSelective.
+func (mr *MockKubernetesServiceMockRecorder) DeleteSelective(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSelective", reflect.TypeOf((*MockKubernetesService)(nil).DeleteSelective), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockKubernetesService) Get(arg0 context.Context, arg1 string) (*godo.KubernetesCluster, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KubernetesCluster)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockKubernetesServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKubernetesService)(nil).Get), arg0, arg1)
+}
+
+// GetClusterStatusMessages mocks base method.
+func (m *MockKubernetesService) GetClusterStatusMessages(ctx context.Context, clusterID string, req *godo.KubernetesGetClusterStatusMessagesRequest) ([]*godo.KubernetesClusterStatusMessage, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterStatusMessages", ctx, clusterID, req)
+	ret0, _ := ret[0].([]*godo.KubernetesClusterStatusMessage)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterStatusMessages indicates an expected call of GetClusterStatusMessages.
+func (mr *MockKubernetesServiceMockRecorder) GetClusterStatusMessages(ctx, clusterID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterStatusMessages", reflect.TypeOf((*MockKubernetesService)(nil).GetClusterStatusMessages), ctx, clusterID, req)
+}
+
+// GetClusterlintResults mocks base method.
+func (m *MockKubernetesService) GetClusterlintResults(ctx context.Context, clusterID string, req *godo.KubernetesGetClusterlintRequest) ([]*godo.ClusterlintDiagnostic, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterlintResults", ctx, clusterID, req)
+	ret0, _ := ret[0].([]*godo.ClusterlintDiagnostic)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterlintResults indicates an expected call of GetClusterlintResults.
+func (mr *MockKubernetesServiceMockRecorder) GetClusterlintResults(ctx, clusterID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterlintResults", reflect.TypeOf((*MockKubernetesService)(nil).GetClusterlintResults), ctx, clusterID, req)
+}
+
+// GetCredentials mocks base method.
+func (m *MockKubernetesService) GetCredentials(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterCredentialsGetRequest) (*godo.KubernetesClusterCredentials, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCredentials", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.KubernetesClusterCredentials)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCredentials indicates an expected call of GetCredentials.
+func (mr *MockKubernetesServiceMockRecorder) GetCredentials(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredentials", reflect.TypeOf((*MockKubernetesService)(nil).GetCredentials), arg0, arg1, arg2)
+}
+
+// GetKubeConfig mocks base method.
+func (m *MockKubernetesService) GetKubeConfig(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterKubeconfigGetRequest) (*godo.KubernetesClusterConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubeConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.KubernetesClusterConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKubeConfig indicates an expected call of GetKubeConfig.
+func (mr *MockKubernetesServiceMockRecorder) GetKubeConfig(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeConfig", reflect.TypeOf((*MockKubernetesService)(nil).GetKubeConfig), arg0, arg1, arg2)
+}
+
+// GetKubeConfigWithExpiry mocks base method.
+func (m *MockKubernetesService) GetKubeConfigWithExpiry(arg0 context.Context, arg1 string, arg2 int64) (*godo.KubernetesClusterConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubeConfigWithExpiry", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.KubernetesClusterConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKubeConfigWithExpiry indicates an expected call of GetKubeConfigWithExpiry.
+func (mr *MockKubernetesServiceMockRecorder) GetKubeConfigWithExpiry(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeConfigWithExpiry", reflect.TypeOf((*MockKubernetesService)(nil).GetKubeConfigWithExpiry), arg0, arg1, arg2)
+}
+
+// GetNodePool mocks base method.
+func (m *MockKubernetesService) GetNodePool(ctx context.Context, clusterID, poolID string) (*godo.KubernetesNodePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodePool", ctx, clusterID, poolID)
+	ret0, _ := ret[0].(*godo.KubernetesNodePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNodePool indicates an expected call of GetNodePool.
+func (mr *MockKubernetesServiceMockRecorder) GetNodePool(ctx, clusterID, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodePool", reflect.TypeOf((*MockKubernetesService)(nil).GetNodePool), ctx, clusterID, poolID)
+}
+
+// GetNodePoolTemplate mocks base method.
+func (m *MockKubernetesService) GetNodePoolTemplate(ctx context.Context, clusterID, nodePoolName string) (*godo.KubernetesNodePoolTemplate, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodePoolTemplate", ctx, clusterID, nodePoolName)
+	ret0, _ := ret[0].(*godo.KubernetesNodePoolTemplate)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNodePoolTemplate indicates an expected call of GetNodePoolTemplate.
+func (mr *MockKubernetesServiceMockRecorder) GetNodePoolTemplate(ctx, clusterID, nodePoolName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodePoolTemplate", reflect.TypeOf((*MockKubernetesService)(nil).GetNodePoolTemplate), ctx, clusterID, nodePoolName)
+}
+
+// GetOptions mocks base method.
+func (m *MockKubernetesService) GetOptions(arg0 context.Context) (*godo.KubernetesOptions, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptions", arg0)
+	ret0, _ := ret[0].(*godo.KubernetesOptions)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOptions indicates an expected call of GetOptions.
+func (mr *MockKubernetesServiceMockRecorder) GetOptions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptions", reflect.TypeOf((*MockKubernetesService)(nil).GetOptions), arg0)
+}
+
+// GetUpgrades mocks base method.
+func (m *MockKubernetesService) GetUpgrades(arg0 context.Context, arg1 string) ([]*godo.KubernetesVersion, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpgrades", arg0, arg1)
+	ret0, _ := ret[0].([]*godo.KubernetesVersion)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUpgrades indicates an expected call of GetUpgrades.
+func (mr *MockKubernetesServiceMockRecorder) GetUpgrades(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpgrades", reflect.TypeOf((*MockKubernetesService)(nil).GetUpgrades), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockKubernetesService) GetUser(arg0 context.Context, arg1 string) (*godo.KubernetesClusterUser, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KubernetesClusterUser)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockKubernetesServiceMockRecorder) GetUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockKubernetesService)(nil).GetUser), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockKubernetesService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]*godo.KubernetesCluster, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]*godo.KubernetesCluster)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockKubernetesServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKubernetesService)(nil).List), arg0, arg1)
+}
+
+// ListAssociatedResourcesForDeletion mocks base method.
+func (m *MockKubernetesService) ListAssociatedResourcesForDeletion(arg0 context.Context, arg1 string) (*godo.KubernetesAssociatedResources, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssociatedResourcesForDeletion", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KubernetesAssociatedResources)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAssociatedResourcesForDeletion indicates an expected call of ListAssociatedResourcesForDeletion.
+func (mr *MockKubernetesServiceMockRecorder) ListAssociatedResourcesForDeletion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociatedResourcesForDeletion", reflect.TypeOf((*MockKubernetesService)(nil).ListAssociatedResourcesForDeletion), arg0, arg1)
+}
+
+// ListNodePools mocks base method.
+func (m *MockKubernetesService) ListNodePools(ctx context.Context, clusterID string, opts *godo.ListOptions) ([]*godo.KubernetesNodePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNodePools", ctx, clusterID, opts)
+	ret0, _ := ret[0].([]*godo.KubernetesNodePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListNodePools indicates an expected call of ListNodePools.
+func (mr *MockKubernetesServiceMockRecorder) ListNodePools(ctx, clusterID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodePools", reflect.TypeOf((*MockKubernetesService)(nil).ListNodePools), ctx, clusterID, opts)
+}
+
+// RecycleNodePoolNodes mocks base method.
+func (m *MockKubernetesService) RecycleNodePoolNodes(ctx context.Context, clusterID, poolID string, req *godo.KubernetesNodePoolRecycleNodesRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecycleNodePoolNodes", ctx, clusterID, poolID, req)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecycleNodePoolNodes indicates an expected call of RecycleNodePoolNodes.
+func (mr *MockKubernetesServiceMockRecorder) RecycleNodePoolNodes(ctx, clusterID, poolID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecycleNodePoolNodes", reflect.TypeOf((*MockKubernetesService)(nil).RecycleNodePoolNodes), ctx, clusterID, poolID, req)
+}
+
+// RemoveRegistry mocks base method.
+func (m *MockKubernetesService) RemoveRegistry(ctx context.Context, req *godo.KubernetesClusterRegistryRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRegistry", ctx, req)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRegistry indicates an expected call of RemoveRegistry.
+func (mr *MockKubernetesServiceMockRecorder) RemoveRegistry(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRegistry", reflect.TypeOf((*MockKubernetesService)(nil).RemoveRegistry), ctx, req)
+}
+
+// RunClusterlint mocks base method.
+func (m *MockKubernetesService) RunClusterlint(ctx context.Context, clusterID string, req *godo.KubernetesRunClusterlintRequest) (string, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunClusterlint", ctx, clusterID, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RunClusterlint indicates an expected call of RunClusterlint.
+func (mr *MockKubernetesServiceMockRecorder) RunClusterlint(ctx, clusterID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunClusterlint", reflect.TypeOf((*MockKubernetesService)(nil).RunClusterlint), ctx, clusterID, req)
+}
+
+// Update mocks base method.
+func (m *MockKubernetesService) Update(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterUpdateRequest) (*godo.KubernetesCluster, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.KubernetesCluster)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockKubernetesServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKubernetesService)(nil).Update), arg0, arg1, arg2)
+}
+
+// UpdateNodePool mocks base method.
+func (m *MockKubernetesService) UpdateNodePool(ctx context.Context, clusterID, poolID string, req *godo.KubernetesNodePoolUpdateRequest) (*godo.KubernetesNodePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodePool", ctx, clusterID, poolID, req)
+	ret0, _ := ret[0].(*godo.KubernetesNodePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateNodePool indicates an expected call of UpdateNodePool.
+func (mr *MockKubernetesServiceMockRecorder) UpdateNodePool(ctx, clusterID, poolID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodePool", reflect.TypeOf((*MockKubernetesService)(nil).UpdateNodePool), ctx, clusterID, poolID, req)
+}
+
+// Upgrade mocks base method.
+func (m *MockKubernetesService) Upgrade(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterUpgradeRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upgrade", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upgrade indicates an expected call of Upgrade.
+func (mr *MockKubernetesServiceMockRecorder) Upgrade(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockKubernetesService)(nil).Upgrade), arg0, arg1, arg2)
+}
+
+// MockLoadBalancersService is a mock of LoadBalancersService interface.
+type MockLoadBalancersService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoadBalancersServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLoadBalancersServiceMockRecorder is the mock recorder for MockLoadBalancersService.
+type MockLoadBalancersServiceMockRecorder struct {
+	mock *MockLoadBalancersService
+}
+
+// NewMockLoadBalancersService creates a new mock instance.
+func NewMockLoadBalancersService(ctrl *gomock.Controller) *MockLoadBalancersService {
+	mock := &MockLoadBalancersService{ctrl: ctrl}
+	mock.recorder = &MockLoadBalancersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoadBalancersService) EXPECT() *MockLoadBalancersServiceMockRecorder {
+	return m.recorder
+}
+
+// AddDroplets mocks base method.
+func (m *MockLoadBalancersService) AddDroplets(ctx context.Context, lbID string, dropletIDs ...int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, lbID}
+	for _, a := range dropletIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddDroplets", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDroplets indicates an expected call of AddDroplets.
+func (mr *MockLoadBalancersServiceMockRecorder) AddDroplets(ctx, lbID any, dropletIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, lbID}, dropletIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDroplets", reflect.TypeOf((*MockLoadBalancersService)(nil).AddDroplets), varargs...)
+}
+
+// AddForwardingRules mocks base method.
+func (m *MockLoadBalancersService) AddForwardingRules(ctx context.Context, lbID string, rules ...godo.ForwardingRule) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, lbID}
+	for _, a := range rules {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddForwardingRules", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddForwardingRules indicates an expected call of AddForwardingRules.
+func (mr *MockLoadBalancersServiceMockRecorder) AddForwardingRules(ctx, lbID any, rules ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, lbID}, rules...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddForwardingRules", reflect.TypeOf((*MockLoadBalancersService)(nil).AddForwardingRules), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockLoadBalancersService) Create(arg0 context.Context, arg1 *godo.LoadBalancerRequest) (*godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLoadBalancersServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLoadBalancersService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockLoadBalancersService) Delete(ctx context.Context, lbID string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, lbID)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLoadBalancersServiceMockRecorder) Delete(ctx, lbID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLoadBalancersService)(nil).Delete), ctx, lbID)
+}
+
+// Get mocks base method.
+func (m *MockLoadBalancersService) Get(arg0 context.Context, arg1 string) (*godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLoadBalancersServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLoadBalancersService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockLoadBalancersService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockLoadBalancersServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoadBalancersService)(nil).List), arg0, arg1)
+}
+
+// ListByNames mocks base method.
+func (m *MockLoadBalancersService) ListByNames(arg0 context.Context, arg1 []string, arg2 *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByNames", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByNames indicates an expected call of ListByNames.
+func (mr *MockLoadBalancersServiceMockRecorder) ListByNames(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByNames", reflect.TypeOf((*MockLoadBalancersService)(nil).ListByNames), arg0, arg1, arg2)
+}
+
+// ListByUUIDs mocks base method.
+func (m *MockLoadBalancersService) ListByUUIDs(arg0 context.Context, arg1 []string, arg2 *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUUIDs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByUUIDs indicates an expected call of ListByUUIDs.
+func (mr *MockLoadBalancersServiceMockRecorder) ListByUUIDs(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUUIDs", reflect.TypeOf((*MockLoadBalancersService)(nil).ListByUUIDs), arg0, arg1, arg2)
+}
+
+// PurgeCache mocks base method.
+func (m *MockLoadBalancersService) PurgeCache(ctx context.Context, lbID string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeCache", ctx, lbID)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeCache indicates an expected call of PurgeCache.
+func (mr *MockLoadBalancersServiceMockRecorder) PurgeCache(ctx, lbID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeCache", reflect.TypeOf((*MockLoadBalancersService)(nil).PurgeCache), ctx, lbID)
+}
+
+// RemoveDroplets mocks base method.
+func (m *MockLoadBalancersService) RemoveDroplets(ctx context.Context, lbID string, dropletIDs ...int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, lbID}
+	for _, a := range dropletIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveDroplets", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDroplets indicates an expected call of RemoveDroplets.
+func (mr *MockLoadBalancersServiceMockRecorder) RemoveDroplets(ctx, lbID any, dropletIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, lbID}, dropletIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDroplets", reflect.TypeOf((*MockLoadBalancersService)(nil).RemoveDroplets), varargs...)
+}
+
+// RemoveForwardingRules mocks base method.
+func (m *MockLoadBalancersService) RemoveForwardingRules(ctx context.Context, lbID string, rules ...godo.ForwardingRule) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, lbID}
+	for _, a := range rules {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveForwardingRules", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveForwardingRules indicates an expected call of RemoveForwardingRules.
+func (mr *MockLoadBalancersServiceMockRecorder) RemoveForwardingRules(ctx, lbID any, rules ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, lbID}, rules...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveForwardingRules", reflect.TypeOf((*MockLoadBalancersService)(nil).RemoveForwardingRules), varargs...)
+}
+
+// Update mocks base method.
+func (m *MockLoadBalancersService) Update(ctx context.Context, lbID string, lbr *godo.LoadBalancerRequest) (*godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, lbID, lbr)
+	ret0, _ := ret[0].(*godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLoadBalancersServiceMockRecorder) Update(ctx, lbID, lbr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLoadBalancersService)(nil).Update), ctx, lbID, lbr)
+}
+
+// MockProjectsService is a mock of ProjectsService interface.
+type MockProjectsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectsServiceMockRecorder is the mock recorder for MockProjectsService.
+type MockProjectsServiceMockRecorder struct {
+	mock *MockProjectsService
+}
+
+// NewMockProjectsService creates a new mock instance.
+func NewMockProjectsService(ctrl *gomock.Controller) *MockProjectsService {
+	mock := &MockProjectsService{ctrl: ctrl}
+	mock.recorder = &MockProjectsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectsService) EXPECT() *MockProjectsServiceMockRecorder {
+	return m.recorder
+}
+
+// AssignResources mocks base method.
+func (m *MockProjectsService) AssignResources(arg0 context.Context, arg1 string, arg2 ...any) ([]godo.ProjectResource, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssignResources", varargs...)
+	ret0, _ := ret[0].([]godo.ProjectResource)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AssignResources indicates an expected call of AssignResources.
+func (mr *MockProjectsServiceMockRecorder) AssignResources(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignResources", reflect.TypeOf((*MockProjectsService)(nil).AssignResources), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockProjectsService) Create(arg0 context.Context, arg1 *godo.CreateProjectRequest) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectsService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockProjectsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProjectsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectsService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockProjectsService) Get(arg0 context.Context, arg1 string) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProjectsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProjectsService)(nil).Get), arg0, arg1)
+}
+
+// GetDefault mocks base method.
+func (m *MockProjectsService) GetDefault(arg0 context.Context) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefault", arg0)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDefault indicates an expected call of GetDefault.
+func (mr *MockProjectsServiceMockRecorder) GetDefault(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefault", reflect.TypeOf((*MockProjectsService)(nil).GetDefault), arg0)
+}
+
+// List mocks base method.
+func (m *MockProjectsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectsService)(nil).List), arg0, arg1)
+}
+
+// ListResources mocks base method.
+func (m *MockProjectsService) ListResources(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.ProjectResource, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.ProjectResource)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockProjectsServiceMockRecorder) ListResources(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockProjectsService)(nil).ListResources), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockProjectsService) Update(arg0 context.Context, arg1 string, arg2 *godo.UpdateProjectRequest) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProjectsServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectsService)(nil).Update), arg0, arg1, arg2)
+}
+
+// MockReservedIPsService is a mock of ReservedIPsService interface.
+type MockReservedIPsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservedIPsServiceMockRecorder
 	isgomock struct{}
 }
 
-// MockBalanceServiceMockRecorder is the mock recorder for MockBalanceService.
-type MockBalanceServiceMockRecorder struct {
-	mock *MockBalanceService
+// MockReservedIPsServiceMockRecorder is the mock recorder for MockReservedIPsService.
+type MockReservedIPsServiceMockRecorder struct {
+	mock *MockReservedIPsService
+}
+
+// NewMockReservedIPsService creates a new mock instance.
+func NewMockReservedIPsService(ctrl *gomock.Controller) *MockReservedIPsService {
+	mock := &MockReservedIPsService{ctrl: ctrl}
+	mock.recorder = &MockReservedIPsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservedIPsService) EXPECT() *MockReservedIPsServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReservedIPsService) Create(arg0 context.Context, arg1 *godo.ReservedIPCreateRequest) (*godo.ReservedIP, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.ReservedIP)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReservedIPsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReservedIPsService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockReservedIPsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReservedIPsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReservedIPsService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockReservedIPsService) Get(arg0 context.Context, arg1 string) (*godo.ReservedIP, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.ReservedIP)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReservedIPsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReservedIPsService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockReservedIPsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.ReservedIP, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.ReservedIP)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockReservedIPsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservedIPsService)(nil).List), arg0, arg1)
+}
+
+// MockSizesService is a mock of SizesService interface.
+type MockSizesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSizesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSizesServiceMockRecorder is the mock recorder for MockSizesService.
+type MockSizesServiceMockRecorder struct {
+	mock *MockSizesService
+}
+
+// NewMockSizesService creates a new mock instance.
+func NewMockSizesService(ctrl *gomock.Controller) *MockSizesService {
+	mock := &MockSizesService{ctrl: ctrl}
+	mock.recorder = &MockSizesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSizesService) EXPECT() *MockSizesServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockSizesService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Size, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Size)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockSizesServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSizesService)(nil).List), arg0, arg1)
+}
+
+// MockSnapshotsService is a mock of SnapshotsService interface.
+type MockSnapshotsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSnapshotsServiceMockRecorder is the mock recorder for MockSnapshotsService.
+type MockSnapshotsServiceMockRecorder struct {
+	mock *MockSnapshotsService
+}
+
+// NewMockSnapshotsService creates a new mock instance.
+func NewMockSnapshotsService(ctrl *gomock.Controller) *MockSnapshotsService {
+	mock := &MockSnapshotsService{ctrl: ctrl}
+	mock.recorder = &MockSnapshotsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshotsService) EXPECT() *MockSnapshotsServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSnapshotsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSnapshotsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSnapshotsService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockSnapshotsService) Get(arg0 context.Context, arg1 string) (*godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSnapshotsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSnapshotsService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockSnapshotsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockSnapshotsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSnapshotsService)(nil).List), arg0, arg1)
+}
+
+// ListDroplet mocks base method.
+func (m *MockSnapshotsService) ListDroplet(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDroplet", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDroplet indicates an expected call of ListDroplet.
+func (mr *MockSnapshotsServiceMockRecorder) ListDroplet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDroplet", reflect.TypeOf((*MockSnapshotsService)(nil).ListDroplet), arg0, arg1)
+}
+
+// ListVolume mocks base method.
+func (m *MockSnapshotsService) ListVolume(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolume", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVolume indicates an expected call of ListVolume.
+func (mr *MockSnapshotsServiceMockRecorder) ListVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolume", reflect.TypeOf((*MockSnapshotsService)(nil).ListVolume), arg0, arg1)
+}
+
+// ListVolumeSnapshotByRegion mocks base method.
+func (m *MockSnapshotsService) ListVolumeSnapshotByRegion(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumeSnapshotByRegion", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVolumeSnapshotByRegion indicates an expected call of ListVolumeSnapshotByRegion.
+func (mr *MockSnapshotsServiceMockRecorder) ListVolumeSnapshotByRegion(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumeSnapshotByRegion", reflect.TypeOf((*MockSnapshotsService)(nil).ListVolumeSnapshotByRegion), arg0, arg1, arg2)
+}
+
+// MockStorageService is a mock of StorageService interface.
+type MockStorageService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageServiceMockRecorder is the mock recorder for MockStorageService.
+type MockStorageServiceMockRecorder struct {
+	mock *MockStorageService
+}
+
+// NewMockStorageService creates a new mock instance.
+func NewMockStorageService(ctrl *gomock.Controller) *MockStorageService {
+	mock := &MockStorageService{ctrl: ctrl}
+	mock.recorder = &MockStorageServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageService) EXPECT() *MockStorageServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockStorageService) CreateSnapshot(arg0 context.Context, arg1 *godo.SnapshotCreateRequest) (*godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockStorageServiceMockRecorder) CreateSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockStorageService)(nil).CreateSnapshot), arg0, arg1)
+}
+
+// CreateVolume mocks base method.
+func (m *MockStorageService) CreateVolume(arg0 context.Context, arg1 *godo.VolumeCreateRequest) (*godo.Volume, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolume", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Volume)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateVolume indicates an expected call of CreateVolume.
+func (mr *MockStorageServiceMockRecorder) CreateVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockStorageService)(nil).CreateVolume), arg0, arg1)
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockStorageService) DeleteSnapshot(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockStorageServiceMockRecorder) DeleteSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockStorageService)(nil).DeleteSnapshot), arg0, arg1)
 }
 
-// NewMockBalanceService creates a new mock instance.
-func NewMockBalanceService(ctrl *gomock.Controller) *MockBalanceService {
-	mock := &MockBalanceService{ctrl: ctrl}
-	mock.recorder = &MockBalanceServiceMockRecorder{mock}
-	return mock
+// DeleteVolume mocks base method.
+func (m *MockStorageService) DeleteVolume(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockBalanceService) EXPECT() *MockBalanceServiceMockRecorder {
-	return m.recorder
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockStorageServiceMockRecorder) DeleteVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockStorageService)(nil).DeleteVolume), arg0, arg1)
 }
 
-// Get mocks base method.
-func (m *MockBalanceService) Get(arg0 context.Context) (*godo.Balance, *godo.Response, error) {
+// GetSnapshot mocks base method.
+func (m *MockStorageService) GetSnapshot(arg0 context.Context, arg1 string) (*godo.Snapshot, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0)
-	ret0, _ := ret[0].(*godo.Balance)
+	ret := m.ctrl.Call(m, "GetSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Snapshot)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// Get indicates an expected call of Get.
-func (mr *MockBalanceServiceMockRecorder) Get(arg0 any) *gomock.Call {
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockStorageServiceMockRecorder) GetSnapshot(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBalanceService)(nil).Get), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockStorageService)(nil).GetSnapshot), arg0, arg1)
 }
 
-// MockBillingHistoryService is a mock of BillingHistoryService interface.
-type MockBillingHistoryService struct {
-	ctrl     *gomock.Controller
-	recorder *MockBillingHistoryServiceMockRecorder
-	isgomock struct{}
+// GetVolume mocks base method.
+func (m *MockStorageService) GetVolume(arg0 context.Context, arg1 string) (*godo.Volume, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Volume)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// MockBillingHistoryServiceMockRecorder is the mock recorder for MockBillingHistoryService.
-type MockBillingHistoryServiceMockRecorder struct {
-	mock *MockBillingHistoryService
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockStorageServiceMockRecorder) GetVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockStorageService)(nil).GetVolume), arg0, arg1)
 }
 
-// NewMockBillingHistoryService creates a new mock instance.
-func NewMockBillingHistoryService(ctrl *gomock.Controller) *MockBillingHistoryService {
-	mock := &MockBillingHistoryService{ctrl: ctrl}
-	mock.recorder = &MockBillingHistoryServiceMockRecorder{mock}
-	return mock
+// ListSnapshots mocks base method.
+func (m *MockStorageService) ListSnapshots(ctx context.Context, volumeID string, opts *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshots", ctx, volumeID, opts)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockBillingHistoryService) EXPECT() *MockBillingHistoryServiceMockRecorder {
-	return m.recorder
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockStorageServiceMockRecorder) ListSnapshots(ctx, volumeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockStorageService)(nil).ListSnapshots), ctx, volumeID, opts)
 }
 
-// List mocks base method.
-func (m *MockBillingHistoryService) List(arg0 context.Context, arg1 *godo.ListOptions) (*godo.BillingHistory, *godo.Response, error) {
+// ListVolumes mocks base method.
+func (m *MockStorageService) ListVolumes(arg0 context.Context, arg1 *godo.ListVolumeParams) ([]godo.Volume, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", arg0, arg1)
-	ret0, _ := ret[0].(*godo.BillingHistory)
+	ret := m.ctrl.Call(m, "ListVolumes", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Volume)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// List indicates an expected call of List.
-func (mr *MockBillingHistoryServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+// ListVolumes indicates an expected call of ListVolumes.
+func (mr *MockStorageServiceMockRecorder) ListVolumes(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBillingHistoryService)(nil).List), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockStorageService)(nil).ListVolumes), arg0, arg1)
 }
 
-// MockInvoicesService is a mock of InvoicesService interface.
-type MockInvoicesService struct {
+// MockTagsService is a mock of TagsService interface.
+type MockTagsService struct {
 	ctrl     *gomock.Controller
-	recorder *MockInvoicesServiceMockRecorder
+	recorder *MockTagsServiceMockRecorder
 	isgomock struct{}
 }
 
-// MockInvoicesServiceMockRecorder is the mock recorder for MockInvoicesService.
-type MockInvoicesServiceMockRecorder struct {
-	mock *MockInvoicesService
+// MockTagsServiceMockRecorder is the mock recorder for MockTagsService.
+type MockTagsServiceMockRecorder struct {
+	mock *MockTagsService
 }
 
-// NewMockInvoicesService creates a new mock instance.
-func NewMockInvoicesService(ctrl *gomock.Controller) *MockInvoicesService {
-	mock := &MockInvoicesService{ctrl: ctrl}
-	mock.recorder = &MockInvoicesServiceMockRecorder{mock}
+// NewMockTagsService creates a new mock instance.
+func NewMockTagsService(ctrl *gomock.Controller) *MockTagsService {
+	mock := &MockTagsService{ctrl: ctrl}
+	mock.recorder = &MockTagsServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockInvoicesService) EXPECT() *MockInvoicesServiceMockRecorder {
+func (m *MockTagsService) EXPECT() *MockTagsServiceMockRecorder {
 	return m.recorder
 }
 
-// Get mocks base method.
-func (m *MockInvoicesService) Get(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) (*godo.Invoice, *godo.Response, error) {
+// Create mocks base method.
+func (m *MockTagsService) Create(arg0 context.Context, arg1 *godo.TagCreateRequest) (*godo.Tag, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*godo.Invoice)
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Tag)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// Get indicates an expected call of Get.
-func (mr *MockInvoicesServiceMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockTagsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInvoicesService)(nil).Get), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTagsService)(nil).Create), arg0, arg1)
 }
 
-// GetCSV mocks base method.
-func (m *MockInvoicesService) GetCSV(arg0 context.Context, arg1 string) ([]byte, *godo.Response, error) {
+// Delete mocks base method.
+func (m *MockTagsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCSV", arg0, arg1)
-	ret0, _ := ret[0].([]byte)
-	ret1, _ := ret[1].(*godo.Response)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetCSV indicates an expected call of GetCSV.
-func (mr *MockInvoicesServiceMockRecorder) GetCSV(arg0, arg1 any) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockTagsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCSV", reflect.TypeOf((*MockInvoicesService)(nil).GetCSV), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagsService)(nil).Delete), arg0, arg1)
 }
 
-// GetPDF mocks base method.
-func (m *MockInvoicesService) GetPDF(arg0 context.Context, arg1 string) ([]byte, *godo.Response, error) {
+// Get mocks base method.
+func (m *MockTagsService) Get(arg0 context.Context, arg1 string) (*godo.Tag, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPDF", arg0, arg1)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Tag)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetPDF indicates an expected call of GetPDF.
-func (mr *MockInvoicesServiceMockRecorder) GetPDF(arg0, arg1 any) *gomock.Call {
+// Get indicates an expected call of Get.
+func (mr *MockTagsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPDF", reflect.TypeOf((*MockInvoicesService)(nil).GetPDF), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTagsService)(nil).Get), arg0, arg1)
 }
 
-// GetSummary mocks base method.
-func (m *MockInvoicesService) GetSummary(arg0 context.Context, arg1 string) (*godo.InvoiceSummary, *godo.Response, error) {
+// List mocks base method.
+func (m *MockTagsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Tag, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSummary", arg0, arg1)
-	ret0, _ := ret[0].(*godo.InvoiceSummary)
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Tag)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetSummary indicates an expected call of GetSummary.
-func (mr *MockInvoicesServiceMockRecorder) GetSummary(arg0, arg1 any) *gomock.Call {
+// List indicates an expected call of List.
+func (mr *MockTagsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummary", reflect.TypeOf((*MockInvoicesService)(nil).GetSummary), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTagsService)(nil).List), arg0, arg1)
 }
 
-// List mocks base method.
-func (m *MockInvoicesService) List(arg0 context.Context, arg1 *godo.ListOptions) (*godo.InvoiceList, *godo.Response, error) {
+// TagResources mocks base method.
+func (m *MockTagsService) TagResources(arg0 context.Context, arg1 string, arg2 *godo.TagResourcesRequest) (*godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", arg0, arg1)
-	ret0, _ := ret[0].(*godo.InvoiceList)
-	ret1, _ := ret[1].(*godo.Response)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "TagResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// List indicates an expected call of List.
-func (mr *MockInvoicesServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+// TagResources indicates an expected call of TagResources.
+func (mr *MockTagsServiceMockRecorder) TagResources(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInvoicesService)(nil).List), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResources", reflect.TypeOf((*MockTagsService)(nil).TagResources), arg0, arg1, arg2)
 }
 
-// MockKeysService is a mock of KeysService interface.
-type MockKeysService struct {
+// UntagResources mocks base method.
+func (m *MockTagsService) UntagResources(arg0 context.Context, arg1 string, arg2 *godo.UntagResourcesRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResources indicates an expected call of UntagResources.
+func (mr *MockTagsServiceMockRecorder) UntagResources(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResources", reflect.TypeOf((*MockTagsService)(nil).UntagResources), arg0, arg1, arg2)
+}
+
+// MockVPCsService is a mock of VPCsService interface.
+type MockVPCsService struct {
 	ctrl     *gomock.Controller
-	recorder *MockKeysServiceMockRecorder
+	recorder *MockVPCsServiceMockRecorder
 	isgomock struct{}
 }
 
-// MockKeysServiceMockRecorder is the mock recorder for MockKeysService.
-type MockKeysServiceMockRecorder struct {
-	mock *MockKeysService
+// MockVPCsServiceMockRecorder is the mock recorder for MockVPCsService.
+type MockVPCsServiceMockRecorder struct {
+	mock *MockVPCsService
 }
 
-// NewMockKeysService creates a new mock instance.
-func NewMockKeysService(ctrl *gomock.Controller) *MockKeysService {
-	mock := &MockKeysService{ctrl: ctrl}
-	mock.recorder = &MockKeysServiceMockRecorder{mock}
+// NewMockVPCsService creates a new mock instance.
+func NewMockVPCsService(ctrl *gomock.Controller) *MockVPCsService {
+	mock := &MockVPCsService{ctrl: ctrl}
+	mock.recorder = &MockVPCsServiceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockKeysService) EXPECT() *MockKeysServiceMockRecorder {
+func (m *MockVPCsService) EXPECT() *MockVPCsServiceMockRecorder {
 	return m.recorder
 }
 
 // Create mocks base method.
-func (m *MockKeysService) Create(arg0 context.Context, arg1 *godo.KeyCreateRequest) (*godo.Key, *godo.Response, error) {
+func (m *MockVPCsService) Create(arg0 context.Context, arg1 *godo.VPCCreateRequest) (*godo.VPC, *godo.Response, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", arg0, arg1)
-	ret0, _ := ret[0].(*godo.Key)
+	ret0, _ := ret[0].(*godo.VPC)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockKeysServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+func (mr *MockVPCsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKeysService)(nil).Create), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVPCsService)(nil).Create), arg0, arg1)
 }
 
-// DeleteByFingerprint mocks base method.
-func (m *MockKeysService) DeleteByFingerprint(arg0 context.Context, arg1 string) (*godo.Response, error) {
+// CreateVPCPeering mocks base method.
+func (m *MockVPCsService) CreateVPCPeering(arg0 context.Context, arg1 *godo.VPCPeeringCreateRequest) (*godo.VPCPeering, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByFingerprint", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateVPCPeering", arg0, arg1)
+	ret0, _ := ret[0].(*godo.VPCPeering)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateVPCPeering indicates an expected call of CreateVPCPeering.
+func (mr *MockVPCsServiceMockRecorder) CreateVPCPeering(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCPeering", reflect.TypeOf((*MockVPCsService)(nil).CreateVPCPeering), arg0, arg1)
+}
+
+// CreateVPCPeeringByVPCID mocks base method.
+func (m *MockVPCsService) CreateVPCPeeringByVPCID(arg0 context.Context, arg1 string, arg2 *godo.VPCPeeringCreateRequestByVPCID) (*godo.VPCPeering, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVPCPeeringByVPCID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.VPCPeering)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateVPCPeeringByVPCID indicates an expected call of CreateVPCPeeringByVPCID.
+func (mr *MockVPCsServiceMockRecorder) CreateVPCPeeringByVPCID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCPeeringByVPCID", reflect.TypeOf((*MockVPCsService)(nil).CreateVPCPeeringByVPCID), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockVPCsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
 	ret0, _ := ret[0].(*godo.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteByFingerprint indicates an expected call of DeleteByFingerprint.
-func (mr *MockKeysServiceMockRecorder) DeleteByFingerprint(arg0, arg1 any) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockVPCsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByFingerprint", reflect.TypeOf((*MockKeysService)(nil).DeleteByFingerprint), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVPCsService)(nil).Delete), arg0, arg1)
 }
 
-// DeleteByID mocks base method.
-func (m *MockKeysService) DeleteByID(arg0 context.Context, arg1 int) (*godo.Response, error) {
+// DeleteVPCPeering mocks base method.
+func (m *MockVPCsService) DeleteVPCPeering(arg0 context.Context, arg1 string) (*godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByID", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteVPCPeering", arg0, arg1)
 	ret0, _ := ret[0].(*godo.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteByID indicates an expected call of DeleteByID.
-func (mr *MockKeysServiceMockRecorder) DeleteByID(arg0, arg1 any) *gomock.Call {
+// DeleteVPCPeering indicates an expected call of DeleteVPCPeering.
+func (mr *MockVPCsServiceMockRecorder) DeleteVPCPeering(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockKeysService)(nil).DeleteByID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPCPeering", reflect.TypeOf((*MockVPCsService)(nil).DeleteVPCPeering), arg0, arg1)
 }
 
-// GetByFingerprint mocks base method.
-func (m *MockKeysService) GetByFingerprint(arg0 context.Context, arg1 string) (*godo.Key, *godo.Response, error) {
+// Get mocks base method.
+func (m *MockVPCsService) Get(arg0 context.Context, arg1 string) (*godo.VPC, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByFingerprint", arg0, arg1)
-	ret0, _ := ret[0].(*godo.Key)
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.VPC)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetByFingerprint indicates an expected call of GetByFingerprint.
-func (mr *MockKeysServiceMockRecorder) GetByFingerprint(arg0, arg1 any) *gomock.Call {
+// Get indicates an expected call of Get.
+func (mr *MockVPCsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByFingerprint", reflect.TypeOf((*MockKeysService)(nil).GetByFingerprint), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVPCsService)(nil).Get), arg0, arg1)
 }
 
-// GetByID mocks base method.
-func (m *MockKeysService) GetByID(arg0 context.Context, arg1 int) (*godo.Key, *godo.Response, error) {
+// GetVPCPeering mocks base method.
+func (m *MockVPCsService) GetVPCPeering(arg0 context.Context, arg1 string) (*godo.VPCPeering, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
-	ret0, _ := ret[0].(*godo.Key)
+	ret := m.ctrl.Call(m, "GetVPCPeering", arg0, arg1)
+	ret0, _ := ret[0].(*godo.VPCPeering)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetByID indicates an expected call of GetByID.
-func (mr *MockKeysServiceMockRecorder) GetByID(arg0, arg1 any) *gomock.Call {
+// GetVPCPeering indicates an expected call of GetVPCPeering.
+func (mr *MockVPCsServiceMockRecorder) GetVPCPeering(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockKeysService)(nil).GetByID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCPeering", reflect.TypeOf((*MockVPCsService)(nil).GetVPCPeering), arg0, arg1)
 }
 
 // List mocks base method.
-func (m *MockKeysService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Key, *godo.Response, error) {
+func (m *MockVPCsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]*godo.VPC, *godo.Response, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", arg0, arg1)
-	ret0, _ := ret[0].([]godo.Key)
+	ret0, _ := ret[0].([]*godo.VPC)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
 // List indicates an expected call of List.
-func (mr *MockKeysServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+func (mr *MockVPCsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKeysService)(nil).List), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVPCsService)(nil).List), arg0, arg1)
 }
 
-// UpdateByFingerprint mocks base method.
-func (m *MockKeysService) UpdateByFingerprint(arg0 context.Context, arg1 string, arg2 *godo.KeyUpdateRequest) (*godo.Key, *godo.Response, error) {
+// ListMembers mocks base method.
+func (m *MockVPCsService) ListMembers(arg0 context.Context, arg1 string, arg2 *godo.VPCListMembersRequest, arg3 *godo.ListOptions) ([]*godo.VPCMember, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateByFingerprint", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*godo.Key)
+	ret := m.ctrl.Call(m, "ListMembers", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*godo.VPCMember)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// UpdateByFingerprint indicates an expected call of UpdateByFingerprint.
-func (mr *MockKeysServiceMockRecorder) UpdateByFingerprint(arg0, arg1, arg2 any) *gomock.Call {
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockVPCsServiceMockRecorder) ListMembers(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByFingerprint", reflect.TypeOf((*MockKeysService)(nil).UpdateByFingerprint), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockVPCsService)(nil).ListMembers), arg0, arg1, arg2, arg3)
 }
 
-// UpdateByID mocks base method.
-func (m *MockKeysService) UpdateByID(arg0 context.Context, arg1 int, arg2 *godo.KeyUpdateRequest) (*godo.Key, *godo.Response, error) {
+// ListVPCPeerings mocks base method.
+func (m *MockVPCsService) ListVPCPeerings(arg0 context.Context, arg1 *godo.ListOptions) ([]*godo.VPCPeering, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateByID", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*godo.Key)
+	ret := m.ctrl.Call(m, "ListVPCPeerings", arg0, arg1)
+	ret0, _ := ret[0].([]*godo.VPCPeering)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// UpdateByID indicates an expected call of UpdateByID.
-func (mr *MockKeysServiceMockRecorder) UpdateByID(arg0, arg1, arg2 any) *gomock.Call {
+// ListVPCPeerings indicates an expected call of ListVPCPeerings.
+func (mr *MockVPCsServiceMockRecorder) ListVPCPeerings(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MockKeysService)(nil).UpdateByID), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCPeerings", reflect.TypeOf((*MockVPCsService)(nil).ListVPCPeerings), arg0, arg1)
+}
+
+// ListVPCPeeringsByVPCID mocks base method.
+func (m *MockVPCsService) ListVPCPeeringsByVPCID(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]*godo.VPCPeering, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVPCPeeringsByVPCID", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*godo.VPCPeering)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVPCPeeringsByVPCID indicates an expected call of ListVPCPeeringsByVPCID.
+func (mr *MockVPCsServiceMockRecorder) ListVPCPeeringsByVPCID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCPeeringsByVPCID", reflect.TypeOf((*MockVPCsService)(nil).ListVPCPeeringsByVPCID), arg0, arg1, arg2)
+}
+
+// Set mocks base method.
+func (m *MockVPCsService) Set(arg0 context.Context, arg1 string, arg2 ...godo.VPCSetField) (*godo.VPC, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Set", varargs...)
+	ret0, _ := ret[0].(*godo.VPC)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockVPCsServiceMockRecorder) Set(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockVPCsService)(nil).Set), varargs...)
+}
+
+// Update mocks base method.
+func (m *MockVPCsService) Update(arg0 context.Context, arg1 string, arg2 *godo.VPCUpdateRequest) (*godo.VPC, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.VPC)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockVPCsServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVPCsService)(nil).Update), arg0, arg1, arg2)
+}
+
+// UpdateVPCPeering mocks base method.
+func (m *MockVPCsService) UpdateVPCPeering(arg0 context.Context, arg1 string, arg2 *godo.VPCPeeringUpdateRequest) (*godo.VPCPeering, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVPCPeering", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.VPCPeering)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateVPCPeering indicates an expected call of UpdateVPCPeering.
+func (mr *MockVPCsServiceMockRecorder) UpdateVPCPeering(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPCPeering", reflect.TypeOf((*MockVPCsService)(nil).UpdateVPCPeering), arg0, arg1, arg2)
+}
+
+// UpdateVPCPeeringByVPCID mocks base method.
+func (m *MockVPCsService) UpdateVPCPeeringByVPCID(arg0 context.Context, arg1, arg2 string, arg3 *godo.VPCPeeringUpdateRequest) (*godo.VPCPeering, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVPCPeeringByVPCID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.VPCPeering)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateVPCPeeringByVPCID indicates an expected call of UpdateVPCPeeringByVPCID.
+func (mr *MockVPCsServiceMockRecorder) UpdateVPCPeeringByVPCID(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPCPeeringByVPCID", reflect.TypeOf((*MockVPCsService)(nil).UpdateVPCPeeringByVPCID), arg0, arg1, arg2, arg3)
 }
@@ -0,0 +1,215 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"mcp-digitalocean/internal/fanout"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const defaultSnapshotMaxAgeDays = 30
+
+// OrphanFinding is a resource that is likely paid for without being used.
+type OrphanFinding struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Region string `json:"region,omitempty"`
+	Reason string `json:"reason"`
+	// MonthlySavings is the estimated monthly cost in USD that deleting the resource saves.
+	MonthlySavings float64 `json:"monthly_savings_usd"`
+}
+
+// AuditOrphansResult lists the likely orphaned resources of the account.
+type AuditOrphansResult struct {
+	Findings       []OrphanFinding   `json:"findings"`
+	Counts         map[string]int    `json:"counts"`
+	MonthlySavings float64           `json:"monthly_savings_usd"`
+	Errors         map[string]string `json:"errors,omitempty"`
+	Note           string            `json:"note"`
+}
+
+// orphanCheck finds the orphans of one kind. Snapshots created before cutoff count as orphans.
+type orphanCheck struct {
+	kind string
+	find func(ctx context.Context, c *godo.Client, cutoff time.Time) ([]OrphanFinding, error)
+}
+
+// orphanChecks are the kinds of orphans audit-orphans looks for, in the order they are reported.
+var orphanChecks = []orphanCheck{
+	{"unattached_volumes", func(ctx context.Context, c *godo.Client, _ time.Time) ([]OrphanFinding, error) {
+		volumes, err := common.ListAll(ctx, inventoryPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.Volume, *godo.Response, error) {
+			return c.Storage.ListVolumes(ctx, &godo.ListVolumeParams{ListOptions: opt})
+		})
+		if err != nil {
+			return nil, err
+		}
+		var findings []OrphanFinding
+		for _, v := range volumes {
+			if len(v.DropletIDs) > 0 {
+				continue
+			}
+			finding := OrphanFinding{
+				ID:             v.ID,
+				Name:           v.Name,
+				Reason:         fmt.Sprintf("%d GiB volume attached to no droplet", v.SizeGigaBytes),
+				MonthlySavings: roundCents(float64(v.SizeGigaBytes) * volumeGBMonthly),
+			}
+			if v.Region != nil {
+				finding.Region = v.Region.Slug
+			}
+			findings = append(findings, finding)
+		}
+		return findings, nil
+	}},
+	{"unassigned_reserved_ips", func(ctx context.Context, c *godo.Client, _ time.Time) ([]OrphanFinding, error) {
+		ips, err := common.ListAll(ctx, inventoryPageSize, c.ReservedIPs.List)
+		if err != nil {
+			return nil, err
+		}
+		var findings []OrphanFinding
+		for _, ip := range ips {
+			if ip.Droplet != nil {
+				continue
+			}
+			finding := OrphanFinding{
+				ID:             ip.IP,
+				Name:           ip.IP,
+				Reason:         "reserved IP assigned to no droplet",
+				MonthlySavings: unassignedReservedIPMonthly,
+			}
+			if ip.Region != nil {
+				finding.Region = ip.Region.Slug
+			}
+			findings = append(findings, finding)
+		}
+		return findings, nil
+	}},
+	{"old_snapshots", func(ctx context.Context, c *godo.Client, cutoff time.Time) ([]OrphanFinding, error) {
+		snapshots, err := common.ListAll(ctx, inventoryPageSize, c.Snapshots.List)
+		if err != nil {
+			return nil, err
+		}
+		var findings []OrphanFinding
+		for _, s := range snapshots {
+			created, err := time.Parse(time.RFC3339, s.Created)
+			if err != nil || !created.Before(cutoff) {
+				continue
+			}
+			finding := OrphanFinding{
+				ID:             s.ID,
+				Name:           s.Name,
+				Reason:         fmt.Sprintf("%s snapshot of %g GiB created %s", s.ResourceType, s.SizeGigaBytes, created.Format(time.DateOnly)),
+				MonthlySavings: roundCents(s.SizeGigaBytes * snapshotGBMonthly * float64(max(len(s.Regions), 1))),
+			}
+			if len(s.Regions) > 0 {
+				finding.Region = s.Regions[0]
+			}
+			findings = append(findings, finding)
+		}
+		return findings, nil
+	}},
+	{"powered_off_droplets", func(ctx context.Context, c *godo.Client, _ time.Time) ([]OrphanFinding, error) {
+		droplets, err := common.ListAll(ctx, inventoryPageSize, c.Droplets.List)
+		if err != nil {
+			return nil, err
+		}
+		var findings []OrphanFinding
+		for _, d := range droplets {
+			if d.Status != "off" {
+				continue
+			}
+			// powered-off droplets are billed in full, since their resources stay reserved.
+			finding := OrphanFinding{
+				ID:     strconv.Itoa(d.ID),
+				Name:   d.Name,
+				Reason: "droplet is powered off but still billed",
+			}
+			if d.Size != nil {
+				finding.MonthlySavings = d.Size.PriceMonthly
+			}
+			if d.Region != nil {
+				finding.Region = d.Region.Slug
+			}
+			findings = append(findings, finding)
+		}
+		return findings, nil
+	}},
+}
+
+// OrphanTools provides a tool that finds resources that are paid for without being used.
+type OrphanTools struct {
+	client func(ctx context.Context) (*godo.Client, error)
+	now    func() time.Time
+}
+
+// NewOrphanTools creates a new OrphanTools instance.
+func NewOrphanTools(client func(ctx context.Context) (*godo.Client, error)) *OrphanTools {
+	return &OrphanTools{client: client, now: time.Now}
+}
+
+// auditOrphans runs every orphan check concurrently. A check the token cannot run is reported in
+// Errors rather than failing the whole audit.
+func (o *OrphanTools) auditOrphans(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	maxAgeDays := args.Number("SnapshotMaxAgeDays", defaultSnapshotMaxAgeDays)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if maxAgeDays < 0 {
+		return mcp.NewToolResultError("SnapshotMaxAgeDays must not be negative"), nil
+	}
+
+	client, err := o.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	cutoff := o.now().Add(-time.Duration(maxAgeDays * 24 * float64(time.Hour)))
+	result := &AuditOrphansResult{
+		Findings: []OrphanFinding{},
+		Counts:   map[string]int{},
+		Note:     "Savings are estimates in USD from list prices. Review each finding before deleting it: a detached volume or an old snapshot may be kept on purpose.",
+	}
+	outcomes := fanout.Run(ctx, orphanChecks, fanout.Options{Concurrency: listConcurrency}, func(ctx context.Context, check orphanCheck) ([]OrphanFinding, error) {
+		return check.find(ctx, client, cutoff)
+	})
+	for n, check := range orphanChecks {
+		if err := outcomes[n].Err; err != nil {
+			if result.Errors == nil {
+				result.Errors = map[string]string{}
+			}
+			result.Errors[check.kind] = err.Error()
+			continue
+		}
+		result.Counts[check.kind] = len(outcomes[n].Value)
+		for _, finding := range outcomes[n].Value {
+			finding.Kind = check.kind
+			result.Findings = append(result.Findings, finding)
+			result.MonthlySavings += finding.MonthlySavings
+		}
+	}
+	result.MonthlySavings = roundCents(result.MonthlySavings)
+	return common.NewToolResultStructured(result)
+}
+
+// Tools returns the list of server tools for the orphan audit.
+func (o *OrphanTools) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: o.auditOrphans,
+			Tool: mcp.NewTool("audit-orphans",
+				mcp.WithDescription("Find resources that are likely paid for without being used: volumes attached to no droplet, reserved IPs assigned to no droplet, snapshots older than SnapshotMaxAgeDays and powered-off droplets, which are still billed. Each finding carries the estimated monthly savings of deleting it, and the result the total. Nothing is deleted."),
+				common.WithOutputSchema[AuditOrphansResult](),
+				mcp.WithNumber("SnapshotMaxAgeDays", mcp.DefaultNumber(defaultSnapshotMaxAgeDays), mcp.Description("Snapshots older than this many days are reported")),
+			),
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOrphanTools_auditOrphans(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := NewMockStorageService(ctrl)
+	reservedIPs := NewMockReservedIPsService(ctrl)
+	snapshots := NewMockSnapshotsService(ctrl)
+	droplets := NewMockDropletsService(ctrl)
+	tool := NewOrphanTools(func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{Storage: storage, ReservedIPs: reservedIPs, Snapshots: snapshots, Droplets: droplets}, nil
+	})
+	tool.now = func() time.Time { return time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC) }
+
+	call := func(t *testing.T, args map[string]any) AuditOrphansResult {
+		t.Helper()
+		resp, err := tool.auditOrphans(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		require.False(t, resp.IsError)
+		var result AuditOrphansResult
+		require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+		return result
+	}
+
+	t.Run("Finds every kind of orphan", func(t *testing.T) {
+		storage.EXPECT().ListVolumes(gomock.Any(), gomock.Any()).Return([]godo.Volume{
+			{ID: "vol-1", Name: "spare", SizeGigaBytes: 50, Region: &godo.Region{Slug: "nyc3"}},
+			{ID: "vol-2", Name: "in-use", SizeGigaBytes: 50, DropletIDs: []int{1}},
+		}, nil, nil)
+		reservedIPs.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.ReservedIP{
+			{IP: "192.0.2.1", Region: &godo.Region{Slug: "nyc3"}},
+			{IP: "192.0.2.2", Droplet: &godo.Droplet{ID: 1}},
+		}, nil, nil)
+		snapshots.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Snapshot{
+			{ID: "snap-1", Name: "old", ResourceType: "droplet", SizeGigaBytes: 20, Regions: []string{"nyc3"}, Created: "2025-05-01T00:00:00Z"},
+			{ID: "snap-2", Name: "recent", ResourceType: "droplet", SizeGigaBytes: 20, Regions: []string{"nyc3"}, Created: "2025-06-25T00:00:00Z"},
+		}, nil, nil)
+		droplets.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Droplet{
+			{ID: 1, Name: "web", Status: "active"},
+			{ID: 2, Name: "stopped", Status: "off", Size: &godo.Size{PriceMonthly: 12}},
+		}, nil, nil)
+
+		result := call(t, map[string]any{})
+		var ids []string
+		for _, finding := range result.Findings {
+			ids = append(ids, finding.Kind+":"+finding.ID)
+		}
+		require.Equal(t, []string{"unattached_volumes:vol-1", "unassigned_reserved_ips:192.0.2.1", "old_snapshots:snap-1", "powered_off_droplets:2"}, ids)
+		// 5 + 5 + 1.20 + 12
+		require.Equal(t, 23.2, result.MonthlySavings)
+		require.Empty(t, result.Errors)
+	})
+
+	t.Run("Reports a failing check", func(t *testing.T) {
+		storage.EXPECT().ListVolumes(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		reservedIPs.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("forbidden"))
+		snapshots.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Snapshot{
+			{ID: "snap-2", Name: "recent", SizeGigaBytes: 20, Created: "2025-06-25T00:00:00Z"},
+		}, nil, nil)
+		droplets.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+
+		result := call(t, map[string]any{"SnapshotMaxAgeDays": float64(1)})
+		require.Len(t, result.Findings, 1)
+		require.Equal(t, "forbidden", result.Errors["unassigned_reserved_ips"])
+		require.NotContains(t, result.Counts, "unassigned_reserved_ips")
+		require.Equal(t, 0, result.Counts["unattached_volumes"])
+	})
+}
@@ -0,0 +1,86 @@
+package account
+
+import (
+	"context"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+)
+
+// List prices in USD of the resources whose price the API does not report. DigitalOcean bills
+// by the hour up to the monthly price, which is reached after hoursPerMonth hours.
+const (
+	hoursPerMonth           = 672
+	volumeGBMonthly         = 0.10
+	snapshotGBMonthly       = 0.06
+	loadBalancerNodeMonthly = 12.0
+	haControlPlaneMonthly   = 40.0
+	// unassignedReservedIPMonthly is charged for a reserved IPv4 address not assigned to a droplet.
+	unassignedReservedIPMonthly = 5.0
+	// backupsRate is the share of a droplet's price that weekly backups add.
+	backupsRate = 0.20
+)
+
+// legacyLoadBalancerSizes are the monthly prices of load balancers sized by slug rather than by
+// node count.
+var legacyLoadBalancerSizes = map[string]float64{
+	"lb-small":  12,
+	"lb-medium": 36,
+	"lb-large":  72,
+}
+
+// sizePrices maps droplet size slugs to their monthly price.
+type sizePrices map[string]float64
+
+// listSizes returns every droplet size by slug.
+func listSizes(ctx context.Context, client *godo.Client) (map[string]godo.Size, error) {
+	sizes, err := common.ListAll(ctx, inventoryPageSize, client.Sizes.List)
+	if err != nil {
+		return nil, err
+	}
+	bySlug := make(map[string]godo.Size, len(sizes))
+	for _, size := range sizes {
+		bySlug[size.Slug] = size
+	}
+	return bySlug, nil
+}
+
+// listSizePrices returns the monthly price of every droplet size.
+func listSizePrices(ctx context.Context, client *godo.Client) (sizePrices, error) {
+	sizes, err := listSizes(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	prices := sizePrices{}
+	for slug, size := range sizes {
+		prices[slug] = size.PriceMonthly
+	}
+	return prices, nil
+}
+
+// loadBalancerMonthly returns the monthly price of a load balancer and whether it is known.
+func loadBalancerMonthly(lb godo.LoadBalancer) (float64, bool) {
+	if lb.SizeUnit > 0 {
+		return float64(lb.SizeUnit) * loadBalancerNodeMonthly, true
+	}
+	price, ok := legacyLoadBalancerSizes[lb.SizeSlug]
+	return price, ok
+}
+
+// clusterMonthly returns the monthly price of a Kubernetes cluster's nodes and, when highly
+// available, its control plane, and whether the price of every node pool is known.
+func clusterMonthly(cluster *godo.KubernetesCluster, prices sizePrices) (float64, bool) {
+	total := 0.0
+	if cluster.HA {
+		total += haControlPlaneMonthly
+	}
+	for _, pool := range cluster.NodePools {
+		price, ok := prices[pool.Size]
+		if !ok {
+			return 0, false
+		}
+		total += price * float64(pool.Count)
+	}
+	return total, true
+}
@@ -0,0 +1,363 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// DefaultSearchTTL is how long the listings a search matches against are reused.
+	DefaultSearchTTL   = time.Minute
+	defaultSearchLimit = 25
+	maxSearchLimit     = 100
+)
+
+// SearchHit is one resource matching a search query.
+type SearchHit struct {
+	URN    string   `json:"urn"`
+	Type   string   `json:"type"`
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Region string   `json:"region,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+	// Engine is the engine of a database cluster, e.g. redis or pg.
+	Engine string `json:"engine,omitempty"`
+	// Matched lists the query terms the resource matched.
+	Matched []string `json:"matched"`
+}
+
+// SearchResult is the result of resource-search.
+type SearchResult struct {
+	Query string      `json:"query"`
+	Hits  []SearchHit `json:"hits"`
+	// Total is the number of matching resources, of which at most Limit are returned.
+	Total    int               `json:"total"`
+	Searched []string          `json:"searched"`
+	ListedAt string            `json:"listed_at"`
+	Errors   map[string]string `json:"errors,omitempty"`
+}
+
+// searchKind lists the resources of one URN type as unmatched search hits.
+type searchKind struct {
+	urnType string
+	fetch   func(ctx context.Context, c *godo.Client) ([]SearchHit, error)
+}
+
+// searchKinds are the resource types resource-search matches, in the order ties are reported.
+var searchKinds = []searchKind{
+	{"droplet", func(ctx context.Context, c *godo.Client) ([]SearchHit, error) {
+		droplets, err := common.ListAll(ctx, inventoryPageSize, c.Droplets.List)
+		if err != nil {
+			return nil, err
+		}
+		hits := make([]SearchHit, 0, len(droplets))
+		for _, d := range droplets {
+			hit := SearchHit{ID: strconv.Itoa(d.ID), Name: d.Name, Tags: d.Tags}
+			if d.Region != nil {
+				hit.Region = d.Region.Slug
+			}
+			hits = append(hits, hit)
+		}
+		return hits, nil
+	}},
+	{"volume", func(ctx context.Context, c *godo.Client) ([]SearchHit, error) {
+		volumes, err := common.ListAll(ctx, inventoryPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.Volume, *godo.Response, error) {
+			return c.Storage.ListVolumes(ctx, &godo.ListVolumeParams{ListOptions: opt})
+		})
+		if err != nil {
+			return nil, err
+		}
+		hits := make([]SearchHit, 0, len(volumes))
+		for _, v := range volumes {
+			hit := SearchHit{ID: v.ID, Name: v.Name, Tags: v.Tags}
+			if v.Region != nil {
+				hit.Region = v.Region.Slug
+			}
+			hits = append(hits, hit)
+		}
+		return hits, nil
+	}},
+	{"dbaas", func(ctx context.Context, c *godo.Client) ([]SearchHit, error) {
+		databases, err := common.ListAll(ctx, inventoryPageSize, c.Databases.List)
+		if err != nil {
+			return nil, err
+		}
+		hits := make([]SearchHit, 0, len(databases))
+		for _, db := range databases {
+			hits = append(hits, SearchHit{ID: db.ID, Name: db.Name, Region: db.RegionSlug, Tags: db.Tags, Engine: db.EngineSlug})
+		}
+		return hits, nil
+	}},
+	{"kubernetes", func(ctx context.Context, c *godo.Client) ([]SearchHit, error) {
+		clusters, err := common.ListAll(ctx, inventoryPageSize, c.Kubernetes.List)
+		if err != nil {
+			return nil, err
+		}
+		hits := make([]SearchHit, 0, len(clusters))
+		for _, cluster := range clusters {
+			hits = append(hits, SearchHit{ID: cluster.ID, Name: cluster.Name, Region: cluster.RegionSlug, Tags: cluster.Tags})
+		}
+		return hits, nil
+	}},
+	{"loadbalancer", func(ctx context.Context, c *godo.Client) ([]SearchHit, error) {
+		lbs, err := common.ListAll(ctx, inventoryPageSize, c.LoadBalancers.List)
+		if err != nil {
+			return nil, err
+		}
+		hits := make([]SearchHit, 0, len(lbs))
+		for _, lb := range lbs {
+			hit := SearchHit{ID: lb.ID, Name: lb.Name, Tags: lb.Tags}
+			if lb.Region != nil {
+				hit.Region = lb.Region.Slug
+			}
+			hits = append(hits, hit)
+		}
+		return hits, nil
+	}},
+	{"domain", func(ctx context.Context, c *godo.Client) ([]SearchHit, error) {
+		domains, err := common.ListAll(ctx, inventoryPageSize, c.Domains.List)
+		if err != nil {
+			return nil, err
+		}
+		hits := make([]SearchHit, 0, len(domains))
+		for _, d := range domains {
+			hits = append(hits, SearchHit{ID: d.Name, Name: d.Name})
+		}
+		return hits, nil
+	}},
+	{"snapshot", func(ctx context.Context, c *godo.Client) ([]SearchHit, error) {
+		snapshots, err := common.ListAll(ctx, inventoryPageSize, c.Snapshots.List)
+		if err != nil {
+			return nil, err
+		}
+		hits := make([]SearchHit, 0, len(snapshots))
+		for _, s := range snapshots {
+			hit := SearchHit{ID: s.ID, Name: s.Name, Tags: s.Tags}
+			if len(s.Regions) > 0 {
+				hit.Region = s.Regions[0]
+			}
+			hits = append(hits, hit)
+		}
+		return hits, nil
+	}},
+}
+
+// searchTypes returns the URN types resource-search matches.
+func searchTypes() []string {
+	types := make([]string, 0, len(searchKinds))
+	for _, kind := range searchKinds {
+		types = append(types, kind.urnType)
+	}
+	return types
+}
+
+// searchEntry holds the listings of one account. Kinds that failed to list are not kept, so the
+// next search retries them.
+type searchEntry struct {
+	mu        sync.Mutex
+	listings  map[string][]SearchHit
+	fetchedAt time.Time
+}
+
+// SearchTools provides the tool that finds resources by name, tag or database engine.
+// Listings are cached per account for the TTL so consecutive searches don't list everything again.
+type SearchTools struct {
+	client func(ctx context.Context) (*godo.Client, error)
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*searchEntry
+}
+
+// NewSearchTools creates a new SearchTools instance.
+func NewSearchTools(client func(ctx context.Context) (*godo.Client, error)) *SearchTools {
+	return &SearchTools{
+		client:  client,
+		ttl:     DefaultSearchTTL,
+		now:     time.Now,
+		entries: map[string]*searchEntry{},
+	}
+}
+
+// searchResources matches the query terms against the names, tags and database engines of the
+// listed resources. A resource matches when it matches any term; hits matching more terms come first.
+func (s *SearchTools) searchResources(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	query := args.RequiredString("Query")
+	types := args.Strings("Types")
+	limit := int(args.Number("Limit", defaultSearchLimit))
+	refresh := args.Bool("Refresh", false)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var problems []string
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		problems = append(problems, "Query must contain at least one term")
+	}
+	known := searchTypes()
+	for _, t := range types {
+		if !slices.Contains(known, t) {
+			problems = append(problems, fmt.Sprintf("type %q can't be searched; supported types are %s", t, strings.Join(known, ", ")))
+		}
+	}
+	if limit < 1 || limit > maxSearchLimit {
+		problems = append(problems, fmt.Sprintf("Limit must be between 1 and %d", maxSearchLimit))
+	}
+	if len(problems) > 0 {
+		return mcp.NewToolResultError("invalid arguments: " + strings.Join(problems, "; ")), nil
+	}
+	if len(types) == 0 {
+		types = known
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	// The cache is keyed by account, so one caller never matches another account's resources.
+	account, _, err := client.Account.Get(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	key := account.UUID
+	if account.Team != nil {
+		key += "/" + account.Team.UUID
+	}
+
+	entry := s.entry(key)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if refresh || s.now().Sub(entry.fetchedAt) >= s.ttl {
+		entry.listings = map[string][]SearchHit{}
+		entry.fetchedAt = s.now()
+	}
+	errs := s.list(ctx, client, entry, types)
+
+	result := &SearchResult{
+		Query:    query,
+		Hits:     []SearchHit{},
+		Searched: types,
+		ListedAt: entry.fetchedAt.UTC().Format(time.RFC3339),
+		Errors:   errs,
+	}
+	for _, kind := range searchKinds {
+		if !slices.Contains(types, kind.urnType) {
+			continue
+		}
+		for _, hit := range entry.listings[kind.urnType] {
+			if matched := matchTerms(hit, terms); len(matched) > 0 {
+				hit.Type = kind.urnType
+				hit.URN = fmt.Sprintf("do:%s:%s", kind.urnType, hit.ID)
+				hit.Matched = matched
+				result.Hits = append(result.Hits, hit)
+			}
+		}
+	}
+	slices.SortStableFunc(result.Hits, func(a, b SearchHit) int { return len(b.Matched) - len(a.Matched) })
+	result.Total = len(result.Hits)
+	if len(result.Hits) > limit {
+		result.Hits = result.Hits[:limit]
+	}
+	return common.NewToolResultStructured(result)
+}
+
+// list fills in the listings of entry missing for types concurrently, and returns the errors of
+// the kinds that could not be listed.
+func (s *SearchTools) list(ctx context.Context, client *godo.Client, entry *searchEntry, types []string) map[string]string {
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs map[string]string
+	)
+	for _, kind := range searchKinds {
+		if _, ok := entry.listings[kind.urnType]; ok || !slices.Contains(types, kind.urnType) {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hits, err := kind.fetch(ctx, client)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if errs == nil {
+					errs = map[string]string{}
+				}
+				errs[kind.urnType] = err.Error()
+				return
+			}
+			entry.listings[kind.urnType] = hits
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+// entry returns the cache entry for key, dropping entries that expired long ago.
+func (s *SearchTools) entry(key string) *searchEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, e := range s.entries {
+		if k != key && e.mu.TryLock() {
+			if s.now().Sub(e.fetchedAt) >= 2*s.ttl {
+				delete(s.entries, k)
+			}
+			e.mu.Unlock()
+		}
+	}
+	e, ok := s.entries[key]
+	if !ok {
+		e = &searchEntry{listings: map[string][]SearchHit{}}
+		s.entries[key] = e
+	}
+	return e
+}
+
+// matchTerms returns the terms that are part of the name, a tag or the engine of hit.
+func matchTerms(hit SearchHit, terms []string) []string {
+	fields := append([]string{strings.ToLower(hit.Name), strings.ToLower(hit.Engine)}, hit.Tags...)
+	var matched []string
+	for _, term := range terms {
+		for _, field := range fields {
+			if field != "" && strings.Contains(strings.ToLower(field), term) {
+				matched = append(matched, term)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// Tools returns the list of server tools for resource search.
+func (s *SearchTools) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.searchResources,
+			Tool: mcp.NewTool("resource-search",
+				mcp.WithDescription("Find resources by a free-text query such as \"staging redis\": each term is matched, case-insensitively, against the names and tags of Droplets, volumes, database clusters (also by engine), Kubernetes clusters, load balancers, domains and snapshots. "+
+					"Returns typed hits with their URNs, most matched terms first. Listings are fetched concurrently and reused for a minute; use Refresh to list again. A type the token cannot list is reported under errors."),
+				common.WithOutputSchema[SearchResult](),
+				mcp.WithString("Query", mcp.Required(), mcp.Description("Terms to match against names, tags and database engines")),
+				mcp.WithArray("Types", mcp.Description("URN types to search, all by default: "+strings.Join(searchTypes(), ", ")),
+					mcp.Items(map[string]any{"type": "string", "enum": searchTypes()})),
+				mcp.WithNumber("Limit", mcp.DefaultNumber(defaultSearchLimit), mcp.Min(1), mcp.Max(maxSearchLimit), mcp.Description("Maximum number of hits to return")),
+				mcp.WithBoolean("Refresh", mcp.DefaultBool(false), mcp.Description("List the resources again instead of reusing listings from the last minute")),
+			),
+		},
+	}
+}
@@ -0,0 +1,104 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func searchForTest(t *testing.T, tool *SearchTools, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	res, err := tool.searchResources(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	return res
+}
+
+func TestSearchTools_searchResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := &inventoryMocks{
+		account:       NewMockAccountService(ctrl),
+		databases:     NewMockDatabasesService(ctrl),
+		domains:       NewMockDomainsService(ctrl),
+		droplets:      NewMockDropletsService(ctrl),
+		kubernetes:    NewMockKubernetesService(ctrl),
+		loadBalancers: NewMockLoadBalancersService(ctrl),
+		snapshots:     NewMockSnapshotsService(ctrl),
+		storage:       NewMockStorageService(ctrl),
+	}
+	tool := NewSearchTools(func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Account:       m.account,
+			Databases:     m.databases,
+			Domains:       m.domains,
+			Droplets:      m.droplets,
+			Kubernetes:    m.kubernetes,
+			LoadBalancers: m.loadBalancers,
+			Snapshots:     m.snapshots,
+			Storage:       m.storage,
+		}, nil
+	})
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tool.now = func() time.Time { return now }
+
+	resp := &godo.Response{}
+	m.account.EXPECT().Get(gomock.Any()).Return(&godo.Account{UUID: "acct-1"}, nil, nil).Times(3)
+	m.droplets.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Droplet{
+		{ID: 1, Name: "web-1", Region: &godo.Region{Slug: "nyc3"}, Tags: []string{"env:staging"}},
+		{ID: 2, Name: "web-2", Tags: []string{"env:prod"}},
+	}, resp, nil).Times(2)
+	m.storage.EXPECT().ListVolumes(gomock.Any(), gomock.Any()).Return([]godo.Volume{{ID: "vol-1", Name: "staging-data"}}, resp, nil).Times(2)
+	m.databases.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Database{
+		{ID: "db-1", Name: "cache-staging", EngineSlug: "redis", RegionSlug: "nyc3"},
+	}, resp, nil).Times(2)
+	m.kubernetes.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, resp, nil).Times(2)
+	m.loadBalancers.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, resp, nil).Times(2)
+	m.domains.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Domain{{Name: "staging.example.com"}}, resp, nil).Times(2)
+	// a failed kind is reported and listed again by the next search of it.
+	m.snapshots.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("forbidden")).Times(2)
+
+	res := searchForTest(t, tool, map[string]any{"Query": "Staging Redis box"})
+	require.False(t, res.IsError)
+	result := res.StructuredContent.(*SearchResult)
+	require.Equal(t, 4, result.Total)
+	require.Equal(t, "forbidden", result.Errors["snapshot"])
+	require.Equal(t, SearchHit{
+		URN: "do:dbaas:db-1", Type: "dbaas", ID: "db-1", Name: "cache-staging", Region: "nyc3", Engine: "redis",
+		Matched: []string{"staging", "redis"},
+	}, result.Hits[0])
+	require.Equal(t, "do:droplet:1", result.Hits[1].URN)
+	require.Equal(t, "do:volume:vol-1", result.Hits[2].URN)
+	require.Equal(t, "do:domain:staging.example.com", result.Hits[3].URN)
+
+	// within the TTL the listings are reused, and Types and Limit narrow the hits.
+	now = now.Add(30 * time.Second)
+	res = searchForTest(t, tool, map[string]any{"Query": "staging", "Types": []any{"droplet", "volume"}, "Limit": float64(1)})
+	result = res.StructuredContent.(*SearchResult)
+	require.Equal(t, 2, result.Total)
+	require.Len(t, result.Hits, 1)
+	require.Equal(t, "do:droplet:1", result.Hits[0].URN)
+
+	res = searchForTest(t, tool, map[string]any{"Query": "prod", "Refresh": true})
+	result = res.StructuredContent.(*SearchResult)
+	require.Equal(t, 1, result.Total)
+	require.Equal(t, "do:droplet:2", result.Hits[0].URN)
+}
+
+func TestSearchTools_invalidArguments(t *testing.T) {
+	tool := NewSearchTools(func(ctx context.Context) (*godo.Client, error) {
+		return nil, errors.New("no client expected")
+	})
+	res := searchForTest(t, tool, map[string]any{"Query": "  ", "Types": []any{"app"}, "Limit": float64(0)})
+	require.True(t, res.IsError)
+	text := res.Content[0].(mcp.TextContent).Text
+	require.Contains(t, text, "Query must contain at least one term")
+	require.Contains(t, text, `type "app" can't be searched`)
+	require.Contains(t, text, "Limit must be between 1")
+}
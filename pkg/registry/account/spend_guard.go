@@ -0,0 +1,307 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"mcp-digitalocean/internal/dryrun"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// SpendConfirmArg is the boolean argument that lets a create call exceeding the spending cap
+	// go ahead, when the guard allows it.
+	SpendConfirmArg = "Confirm"
+	// minElapsed is the least part of the month the month-to-date usage is extrapolated from, so
+	// the projection of the first hours of a month isn't dominated by a single charge.
+	minElapsed = 24 * time.Hour
+	// bootstrapNodeSize and bootstrapNodeCount are the node pool doks-bootstrap creates by default.
+	bootstrapNodeSize  = "s-2vcpu-4gb"
+	bootstrapNodeCount = 3
+)
+
+// spendLookup fetches what the estimators price a call from, each at most once per call.
+type spendLookup struct {
+	ctx    context.Context
+	client *godo.Client
+	prices sizePrices
+}
+
+// sizes returns the monthly prices of the droplet sizes.
+func (l *spendLookup) sizes() (sizePrices, error) {
+	if l.prices == nil {
+		prices, err := listSizePrices(l.ctx, l.client)
+		if err != nil {
+			return nil, err
+		}
+		l.prices = prices
+	}
+	return l.prices, nil
+}
+
+// dropletPrice returns the monthly price of a droplet of size, or of the size of the droplet id
+// when size is empty, as for the copies droplet-clone and droplet-migrate-region make.
+func (l *spendLookup) dropletPrice(size string, id int) (float64, error) {
+	if size == "" && id != 0 {
+		droplet, _, err := l.client.Droplets.Get(l.ctx, id)
+		if err != nil {
+			return 0, err
+		}
+		size = droplet.SizeSlug
+	}
+	prices, err := l.sizes()
+	if err != nil {
+		return 0, err
+	}
+	return prices[size], nil
+}
+
+// spendEstimators return the monthly list price of what a call of each priced create tool adds.
+var spendEstimators = map[string]func(args *common.Args, l *spendLookup) (float64, error){
+	"droplet-create": func(args *common.Args, l *spendLookup) (float64, error) {
+		monthly, err := l.dropletPrice(args.String("Size"), 0)
+		if args.Bool("Backup", false) {
+			monthly += monthly * backupsRate
+		}
+		return monthly, err
+	},
+	"droplet-create-multi-region": func(args *common.Args, l *spendLookup) (float64, error) {
+		monthly, err := l.dropletPrice(args.String("Size"), 0)
+		monthly *= float64(len(args.Strings("Regions")))
+		if args.Bool("Backup", false) {
+			monthly += monthly * backupsRate
+		}
+		return monthly, err
+	},
+	"droplet-clone": func(args *common.Args, l *spendLookup) (float64, error) {
+		return l.dropletPrice(args.String("Size"), int(args.Number("ID", 0)))
+	},
+	"droplet-migrate-region": func(args *common.Args, l *spendLookup) (float64, error) {
+		return l.dropletPrice(args.String("Size"), int(args.Number("ID", 0)))
+	},
+	"provision-web-droplet": func(args *common.Args, l *spendLookup) (float64, error) {
+		return l.dropletPrice(args.String("Size"), 0)
+	},
+	"volume-create": func(args *common.Args, _ *spendLookup) (float64, error) {
+		return args.Number("SizeGigaBytes", 0) * volumeGBMonthly, nil
+	},
+	"lb-create": func(args *common.Args, _ *spendLookup) (float64, error) {
+		return max(args.Number("SizeUnit", 1), 1) * loadBalancerNodeMonthly, nil
+	},
+	"doks-create-cluster": func(args *common.Args, l *spendLookup) (float64, error) {
+		prices, err := l.sizes()
+		if err != nil {
+			return 0, err
+		}
+		monthly := 0.0
+		if args.Bool("ha", false) {
+			monthly += haControlPlaneMonthly
+		}
+		for _, pool := range args.Objects("node_pools") {
+			monthly += prices[pool.String("size")] * pool.Number("count", 1)
+		}
+		return monthly, nil
+	},
+	"doks-create-nodepool": func(args *common.Args, l *spendLookup) (float64, error) {
+		prices, err := l.sizes()
+		if err != nil {
+			return 0, err
+		}
+		pool := args.Object("node_pool_create_request")
+		return prices[pool.String("size")] * pool.Number("count", 1), nil
+	},
+	"doks-bootstrap": func(args *common.Args, l *spendLookup) (float64, error) {
+		prices, err := l.sizes()
+		if err != nil {
+			return 0, err
+		}
+		size := args.String("NodeSize")
+		if size == "" {
+			size = bootstrapNodeSize
+		}
+		return prices[size] * args.Number("NodeCount", bootstrapNodeCount), nil
+	},
+}
+
+// unpricedCreateTools create billable resources whose price the guard can't tell: the API reports
+// no database prices, and a plan can hold any operations. Under a cap their calls are refused,
+// or go ahead with Confirm: true when calls over the cap may be confirmed.
+var unpricedCreateTools = []string{"db-cluster-create", "db-replica-create", "plan-apply"}
+
+// SpendGuard refuses the calls of create tools that would take the projected monthly spend of the
+// account over a cap. The projection is the month-to-date usage of the balance extrapolated to the
+// whole month, plus the monthly list price of what the guard has let through this month, plus the
+// monthly list price of what the call adds. Resources let through are counted in full even once
+// part of their cost shows in the usage, so a run of creates can't slip under the cap while the
+// usage catches up. What was let through is counted per account, as the usage is.
+type SpendGuard struct {
+	limit   float64
+	confirm bool
+	client  func(ctx context.Context) (*godo.Client, error)
+	now     func() time.Time
+
+	mu       sync.Mutex
+	approved map[string]approvedSpend
+}
+
+// approvedSpend is the monthly price of what the guard let through for one account in month.
+type approvedSpend struct {
+	month   string
+	monthly float64
+}
+
+// NewSpendGuard creates a guard capping the projected monthly spend at limit USD. When confirm is
+// set, a call over the cap goes ahead with Confirm: true instead of being refused.
+func NewSpendGuard(limit float64, confirm bool, client func(ctx context.Context) (*godo.Client, error)) *SpendGuard {
+	return &SpendGuard{limit: limit, confirm: confirm, client: client, now: time.Now, approved: map[string]approvedSpend{}}
+}
+
+// Apply notes the cap in the descriptions of the priced and unpriced create tools registered with
+// s and, when calls over the cap may be confirmed, advertises the Confirm argument on them.
+func (g *SpendGuard) Apply(s *server.MCPServer) {
+	var updated []server.ServerTool
+	for name, st := range s.ListTools() {
+		_, priced := spendEstimators[name]
+		if !priced && !slices.Contains(unpricedCreateTools, name) {
+			continue
+		}
+		tool := st.Tool
+		if priced {
+			tool.Description += fmt.Sprintf(" Refused when it would take the projected monthly spend over $%.2f", g.limit)
+		} else {
+			tool.Description += fmt.Sprintf(" Refused while the projected monthly spend is capped at $%.2f, as its price isn't known", g.limit)
+		}
+		if !g.confirm {
+			tool.Description += "."
+		} else {
+			tool.Description += ", unless called with Confirm: true."
+			if tool.RawInputSchema == nil {
+				props := maps.Clone(tool.InputSchema.Properties)
+				if props == nil {
+					props = map[string]any{}
+				}
+				props[SpendConfirmArg] = map[string]any{
+					"type":        "boolean",
+					"description": "Set to true to create the resources even though the projected monthly spend exceeds the cap",
+				}
+				tool.InputSchema.Properties = props
+			}
+		}
+		updated = append(updated, server.ServerTool{Tool: tool, Handler: st.Handler})
+	}
+	s.AddTools(updated...)
+}
+
+// Middleware refuses the calls of priced create tools over the cap and those of unpriced create
+// tools, dry runs included, and adds the price of the successful ones to the account's approved
+// spend of this month.
+func (g *SpendGuard) Middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		estimate, priced := spendEstimators[req.Params.Name]
+		if !priced && !slices.Contains(unpricedCreateTools, req.Params.Name) {
+			return next(ctx, req)
+		}
+		callArgs := req.GetArguments()
+		confirmed, _ := callArgs[SpendConfirmArg].(bool)
+		if g.confirm {
+			callArgs = maps.Clone(callArgs)
+			delete(callArgs, SpendConfirmArg)
+			req.Params.Arguments = callArgs
+		}
+
+		if !priced {
+			if g.confirm && confirmed {
+				return next(ctx, req)
+			}
+			message := fmt.Sprintf("spending cap: %s creates resources whose price isn't known, so it is refused while the projected monthly spend is capped at $%.2f", req.Params.Name, g.limit)
+			if g.confirm {
+				message += fmt.Sprintf("; call it again with %s: true to go ahead anyway", SpendConfirmArg)
+			}
+			return mcp.NewToolResultError(message), nil
+		}
+		client, err := g.client(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+		}
+		monthly, err := estimate(common.NewArgs(req), &spendLookup{ctx: ctx, client: client})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to check the spending cap", err), nil
+		}
+		account, _, err := client.Account.Get(ctx)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to check the spending cap", err), nil
+		}
+		key := account.UUID
+		if account.Team != nil {
+			key += "/" + account.Team.UUID
+		}
+		balance, _, err := client.Balance.Get(ctx)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to check the spending cap", err), nil
+		}
+		usage, _ := strconv.ParseFloat(balance.MonthToDateUsage, 64)
+
+		month, runRate := g.extrapolate(usage)
+		approved := g.approvedIn(key, month)
+		projected := runRate + approved + monthly
+		if projected > g.limit && !(g.confirm && confirmed) {
+			message := fmt.Sprintf("spending cap exceeded: %s adds about $%.2f a month, taking the projected monthly spend to $%.2f ($%.2f extrapolated from the month-to-date usage of $%.2f, $%.2f approved earlier this month), over the cap of $%.2f",
+				req.Params.Name, monthly, projected, runRate, usage, approved, g.limit)
+			if g.confirm {
+				message += fmt.Sprintf("; call it again with %s: true to go ahead anyway", SpendConfirmArg)
+			}
+			return mcp.NewToolResultError(message), nil
+		}
+
+		res, err := next(ctx, req)
+		if err == nil && res != nil && !res.IsError && !dryrun.Active(ctx) && !isExisting(res) {
+			g.mu.Lock()
+			spend := g.approved[key]
+			if spend.month != month {
+				spend = approvedSpend{month: month}
+			}
+			spend.monthly += monthly
+			g.approved[key] = spend
+			g.mu.Unlock()
+		}
+		return res, err
+	}
+}
+
+// extrapolate returns the current month and the spend of the whole month at the rate of the
+// month-to-date usage.
+func (g *SpendGuard) extrapolate(usage float64) (string, float64) {
+	now := g.now().UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	elapsed := max(now.Sub(start), minElapsed)
+	return start.Format("2006-01"), usage * start.AddDate(0, 1, 0).Sub(start).Hours() / elapsed.Hours()
+}
+
+// approvedIn returns the monthly price of what the guard has let through for the account in month.
+func (g *SpendGuard) approvedIn(account, month string) float64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if spend := g.approved[account]; spend.month == month {
+		return spend.monthly
+	}
+	return 0
+}
+
+// isExisting reports whether res is the existing resource a create tool returned instead of
+// creating one.
+func isExisting(res *mcp.CallToolResult) bool {
+	if res.Meta == nil {
+		return false
+	}
+	existing, _ := res.Meta.AdditionalFields[common.ExistingMetaKey].(bool)
+	return existing
+}
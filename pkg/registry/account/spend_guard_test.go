@@ -0,0 +1,138 @@
+package account
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSpendGuard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockBalance := NewMockBalanceService(ctrl)
+	mockSizes := NewMockSizesService(ctrl)
+	mockAccount := NewMockAccountService(ctrl)
+	mockDroplets := NewMockDropletsService(ctrl)
+	// $100 used over the first 10 days of a 30-day month is a run rate of $300.
+	mockBalance.EXPECT().Get(gomock.Any()).Return(&godo.Balance{MonthToDateUsage: "100.00"}, nil, nil).AnyTimes()
+	mockSizes.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Size{
+		{Slug: "s-1vcpu-1gb", PriceMonthly: 6},
+		{Slug: "s-8vcpu-16gb", PriceMonthly: 96},
+	}, &godo.Response{}, nil).AnyTimes()
+	account := &godo.Account{UUID: "acct-1"}
+	mockAccount.EXPECT().Get(gomock.Any()).DoAndReturn(func(ctx context.Context) (*godo.Account, *godo.Response, error) {
+		return account, nil, nil
+	}).AnyTimes()
+	mockDroplets.EXPECT().Get(gomock.Any(), 42).Return(&godo.Droplet{ID: 42, SizeSlug: "s-8vcpu-16gb"}, nil, nil).AnyTimes()
+	client := &godo.Client{Balance: mockBalance, Sizes: mockSizes, Account: mockAccount, Droplets: mockDroplets}
+
+	newGuard := func(confirm bool) (*SpendGuard, *int) {
+		g := NewSpendGuard(400, confirm, func(ctx context.Context) (*godo.Client, error) { return client, nil })
+		g.now = func() time.Time { return time.Date(2026, time.September, 11, 0, 0, 0, 0, time.UTC) }
+		return g, new(int)
+	}
+	call := func(t *testing.T, g *SpendGuard, calls *int, tool string, args map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		handler := g.Middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			*calls++
+			require.NotContains(t, req.GetArguments(), SpendConfirmArg)
+			return mcp.NewToolResultText("created"), nil
+		})
+		req := mcp.CallToolRequest{}
+		req.Params.Name = tool
+		req.Params.Arguments = args
+		res, err := handler(context.Background(), req)
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("Within the cap", func(t *testing.T) {
+		g, calls := newGuard(false)
+		res := call(t, g, calls, "droplet-create", map[string]any{"Size": "s-1vcpu-1gb", "Backup": true})
+		require.False(t, res.IsError)
+		require.Equal(t, 1, *calls)
+		require.InDelta(t, 7.2, g.approvedIn("acct-1", "2026-09"), 0.001)
+	})
+
+	t.Run("Over the cap", func(t *testing.T) {
+		g, calls := newGuard(false)
+		res := call(t, g, calls, "doks-create-cluster", map[string]any{
+			"ha":         true,
+			"node_pools": []any{map[string]any{"size": "s-8vcpu-16gb", "count": float64(1)}},
+		})
+		require.True(t, res.IsError)
+		require.Contains(t, res.Content[0].(mcp.TextContent).Text, "adds about $136.00 a month, taking the projected monthly spend to $436.00")
+		require.NotContains(t, res.Content[0].(mcp.TextContent).Text, "Confirm")
+		require.Zero(t, *calls)
+	})
+
+	t.Run("Approved creates add up", func(t *testing.T) {
+		g, calls := newGuard(false)
+		for i := 0; i < 8; i++ {
+			require.False(t, call(t, g, calls, "volume-create", map[string]any{"SizeGigaBytes": float64(100)}).IsError)
+		}
+		// $300 run rate and $80 of volumes leave no room for another $24 load balancer.
+		res := call(t, g, calls, "lb-create", map[string]any{"SizeUnit": float64(2)})
+		require.True(t, res.IsError)
+		require.Contains(t, res.Content[0].(mcp.TextContent).Text, "$80.00 approved earlier this month")
+		require.Equal(t, 8, *calls)
+	})
+
+	t.Run("Confirmed over the cap", func(t *testing.T) {
+		g, calls := newGuard(true)
+		res := call(t, g, calls, "doks-create-nodepool", map[string]any{"node_pool_create_request": map[string]any{"size": "s-8vcpu-16gb", "count": float64(2)}})
+		require.True(t, res.IsError)
+		require.Contains(t, res.Content[0].(mcp.TextContent).Text, "with Confirm: true")
+
+		res = call(t, g, calls, "doks-create-nodepool", map[string]any{"node_pool_create_request": map[string]any{"size": "s-8vcpu-16gb", "count": float64(2)}, "Confirm": true})
+		require.False(t, res.IsError)
+		require.Equal(t, 1, *calls)
+	})
+
+	t.Run("Approved spend is counted per account", func(t *testing.T) {
+		g, calls := newGuard(false)
+		for i := 0; i < 8; i++ {
+			require.False(t, call(t, g, calls, "volume-create", map[string]any{"SizeGigaBytes": float64(100)}).IsError)
+		}
+		account = &godo.Account{UUID: "acct-2"}
+		defer func() { account = &godo.Account{UUID: "acct-1"} }()
+		require.False(t, call(t, g, calls, "lb-create", map[string]any{"SizeUnit": float64(2)}).IsError)
+		require.InDelta(t, 80, g.approvedIn("acct-1", "2026-09"), 0.001)
+		require.InDelta(t, 24, g.approvedIn("acct-2", "2026-09"), 0.001)
+	})
+
+	t.Run("Copies are priced at the size of their source", func(t *testing.T) {
+		g, calls := newGuard(false)
+		g.limit = 350
+		res := call(t, g, calls, "droplet-clone", map[string]any{"ID": float64(42), "Region": "nyc3"})
+		require.True(t, res.IsError)
+		require.Contains(t, res.Content[0].(mcp.TextContent).Text, "adds about $96.00 a month")
+
+		res = call(t, g, calls, "doks-bootstrap", map[string]any{"Name": "prod", "NodeSize": "s-1vcpu-1gb"})
+		require.False(t, res.IsError)
+		require.InDelta(t, 18, g.approvedIn("acct-1", "2026-09"), 0.001)
+	})
+
+	t.Run("Unpriced create tools fail closed", func(t *testing.T) {
+		g, calls := newGuard(false)
+		res := call(t, g, calls, "db-cluster-create", map[string]any{"name": "pg"})
+		require.True(t, res.IsError)
+		require.Contains(t, res.Content[0].(mcp.TextContent).Text, "price isn't known")
+		require.Zero(t, *calls)
+
+		g, calls = newGuard(true)
+		require.True(t, call(t, g, calls, "plan-apply", map[string]any{"PlanID": "p1"}).IsError)
+		require.False(t, call(t, g, calls, "plan-apply", map[string]any{"PlanID": "p1", "Confirm": true}).IsError)
+		require.Equal(t, 1, *calls)
+	})
+
+	t.Run("Tools without a price are not checked", func(t *testing.T) {
+		g, calls := newGuard(false)
+		g.limit = 1
+		require.False(t, call(t, g, calls, "vpc-create", map[string]any{"Name": "private"}).IsError)
+	})
+}
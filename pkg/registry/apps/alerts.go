@@ -0,0 +1,124 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// listAlerts lists the alerts configured for an app and its components
+func (a *AppPlatformTool) listAlerts(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	appID, ok := req.GetArguments()["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+
+	client, err := a.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	alerts, _, err := client.Apps.ListAlerts(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr(fmt.Sprintf("failed to list alerts for app %s", appID), err), nil
+	}
+	if alerts == nil {
+		alerts = []*godo.AppAlert{}
+	}
+
+	alertsJSON, err := json.MarshalIndent(alerts, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to format alerts response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(alertsJSON)), nil
+}
+
+// updateAlertDestinations sets where an alert of an app is sent. The API replaces both the emails
+// and the Slack webhooks of the alert, so a destination list that isn't passed keeps its current value.
+func (a *AppPlatformTool) updateAlertDestinations(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	alertID, ok := args["AlertID"].(string)
+	if !ok || alertID == "" {
+		return mcp.NewToolResultError("Alert ID is required"), nil
+	}
+
+	var update struct {
+		Emails        *[]string                     `json:"Emails"`
+		SlackWebhooks *[]*godo.AppAlertSlackWebhook `json:"SlackWebhooks"`
+	}
+	jsonBytes, err := json.Marshal(args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request arguments for alert update: %w", err)
+	}
+	if err := json.Unmarshal(jsonBytes, &update); err != nil {
+		return mcp.NewToolResultErrorFromErr("failed to parse alert destinations", err), nil
+	}
+	if update.Emails == nil && update.SlackWebhooks == nil {
+		return mcp.NewToolResultError("At least one of Emails or SlackWebhooks is required"), nil
+	}
+	if update.SlackWebhooks != nil {
+		for _, webhook := range *update.SlackWebhooks {
+			if webhook == nil || webhook.URL == "" || webhook.Channel == "" {
+				return mcp.NewToolResultError("Each Slack webhook needs a URL and a Channel"), nil
+			}
+		}
+	}
+
+	client, err := a.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	destinations := &godo.AlertDestinationUpdateRequest{
+		Emails:        []string{},
+		SlackWebhooks: []*godo.AppAlertSlackWebhook{},
+	}
+	if update.Emails == nil || update.SlackWebhooks == nil {
+		alerts, _, err := client.Apps.ListAlerts(ctx, appID)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("failed to list alerts for app %s", appID), err), nil
+		}
+		var current *godo.AppAlert
+		for _, alert := range alerts {
+			if alert.ID == alertID {
+				current = alert
+				break
+			}
+		}
+		if current == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("App %s has no alert %s", appID, alertID)), nil
+		}
+		if current.Emails != nil {
+			destinations.Emails = current.Emails
+		}
+		if current.SlackWebhooks != nil {
+			destinations.SlackWebhooks = current.SlackWebhooks
+		}
+	}
+	if update.Emails != nil {
+		destinations.Emails = *update.Emails
+	}
+	if update.SlackWebhooks != nil {
+		destinations.SlackWebhooks = *update.SlackWebhooks
+	}
+
+	alert, _, err := client.Apps.UpdateAlertDestinations(ctx, appID, alertID, destinations)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr(fmt.Sprintf("failed to update destinations of alert %s", alertID), err), nil
+	}
+
+	alertJSON, err := json.MarshalIndent(alert, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to format alert response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(alertJSON)), nil
+}
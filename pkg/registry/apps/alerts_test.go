@@ -0,0 +1,170 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListAlerts(t *testing.T) {
+	alerts := []*godo.AppAlert{
+		{ID: "alert-1", Spec: &godo.AppAlertSpec{Rule: godo.AppAlertSpecRule_DeploymentFailed}, Emails: []string{"ops@example.com"}},
+		{ID: "alert-2", ComponentName: "web", Spec: &godo.AppAlertSpec{Rule: godo.AppAlertSpecRule_CPUUtilization, Value: 80}},
+	}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mock        func(app *MockAppsService)
+		expected    []*godo.AppAlert
+		expectError bool
+	}{
+		{
+			name: "Successful list",
+			args: map[string]any{"AppID": "app-123"},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().ListAlerts(gomock.Any(), "app-123").Return(alerts, nil, nil).Times(1)
+			},
+			expected: alerts,
+		},
+		{
+			name: "No alerts",
+			args: map[string]any{"AppID": "app-123"},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().ListAlerts(gomock.Any(), "app-123").Return(nil, nil, nil).Times(1)
+			},
+			expected: []*godo.AppAlert{},
+		},
+		{
+			name:        "Missing AppID",
+			args:        map[string]any{},
+			expectError: true,
+		},
+		{
+			name: "API error",
+			args: map[string]any{"AppID": "app-123"},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().ListAlerts(gomock.Any(), "app-123").Return(nil, nil, fmt.Errorf("api error")).Times(1)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, appService := setupMock(t)
+			tool := &AppPlatformTool{client: client}
+			if tc.mock != nil {
+				tc.mock(appService)
+			}
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.listAlerts(context.Background(), req)
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			equalsToolResult(t, tc.expected, resp)
+		})
+	}
+}
+
+func TestUpdateAlertDestinations(t *testing.T) {
+	current := []*godo.AppAlert{
+		{
+			ID:            "alert-1",
+			Emails:        []string{"ops@example.com"},
+			SlackWebhooks: []*godo.AppAlertSlackWebhook{{URL: "https://hooks.slack.com/a", Channel: "alerts"}},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		listAlerts    bool
+		expectRequest *godo.AlertDestinationUpdateRequest
+		expectError   bool
+	}{
+		{
+			name: "Replaces both destinations",
+			args: map[string]any{
+				"AppID":         "app-123",
+				"AlertID":       "alert-1",
+				"Emails":        []any{"dev@example.com"},
+				"SlackWebhooks": []any{map[string]any{"URL": "https://hooks.slack.com/b", "Channel": "deploys"}},
+			},
+			expectRequest: &godo.AlertDestinationUpdateRequest{
+				Emails:        []string{"dev@example.com"},
+				SlackWebhooks: []*godo.AppAlertSlackWebhook{{URL: "https://hooks.slack.com/b", Channel: "deploys"}},
+			},
+		},
+		{
+			name:       "Keeps the Slack webhooks when only emails are passed",
+			args:       map[string]any{"AppID": "app-123", "AlertID": "alert-1", "Emails": []any{"dev@example.com"}},
+			listAlerts: true,
+			expectRequest: &godo.AlertDestinationUpdateRequest{
+				Emails:        []string{"dev@example.com"},
+				SlackWebhooks: current[0].SlackWebhooks,
+			},
+		},
+		{
+			name:       "Empty list removes the emails",
+			args:       map[string]any{"AppID": "app-123", "AlertID": "alert-1", "Emails": []any{}},
+			listAlerts: true,
+			expectRequest: &godo.AlertDestinationUpdateRequest{
+				Emails:        []string{},
+				SlackWebhooks: current[0].SlackWebhooks,
+			},
+		},
+		{
+			name:        "Unknown alert",
+			args:        map[string]any{"AppID": "app-123", "AlertID": "alert-2", "Emails": []any{"dev@example.com"}},
+			listAlerts:  true,
+			expectError: true,
+		},
+		{
+			name:        "No destinations",
+			args:        map[string]any{"AppID": "app-123", "AlertID": "alert-1"},
+			expectError: true,
+		},
+		{
+			name:        "Slack webhook without channel",
+			args:        map[string]any{"AppID": "app-123", "AlertID": "alert-1", "SlackWebhooks": []any{map[string]any{"URL": "https://hooks.slack.com/b"}}},
+			expectError: true,
+		},
+		{
+			name:        "Missing AlertID",
+			args:        map[string]any{"AppID": "app-123", "Emails": []any{"dev@example.com"}},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, appService := setupMock(t)
+			tool := &AppPlatformTool{client: client}
+			if tc.listAlerts {
+				appService.EXPECT().ListAlerts(gomock.Any(), "app-123").Return(current, nil, nil).Times(1)
+			}
+			if tc.expectRequest != nil {
+				appService.EXPECT().UpdateAlertDestinations(gomock.Any(), "app-123", "alert-1", tc.expectRequest).
+					Return(&godo.AppAlert{ID: "alert-1", Emails: tc.expectRequest.Emails, SlackWebhooks: tc.expectRequest.SlackWebhooks}, nil, nil).Times(1)
+			}
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.updateAlertDestinations(context.Background(), req)
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "alert-1")
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	_ "embed"
 )
 
@@ -19,6 +21,9 @@ var appCreateSchemaJSON []byte
 //go:embed spec/app-update-schema.json
 var appUpdateSchemaJSON []byte
 
+//go:embed examples/apps-create-app-from-spec.json
+var appCreateExample []byte
+
 const (
 	defaultPageSize = 20 // Default page size for listing apps
 	defaultPage     = 1
@@ -346,8 +351,8 @@ func (a *AppPlatformTool) Tools() []server.ServerTool {
 			Handler: a.listApps,
 			Tool: mcp.NewTool("apps-list",
 				mcp.WithDescription("List all applications on DigitalOcean App Platform. By default, we only return a summary of the apps. To get detailed information about an app, use the `apps-get-info` with the app id."),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultPage), mcp.Description("The page number to retrieve (default is 1)")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultPageSize), mcp.Description("The number of items per page (default is 200)")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultPage), mcp.Description("The page number to retrieve (default is 1)")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultPageSize), mcp.Description("The number of items per page (default is 200)")),
 			),
 		},
 		{
@@ -367,10 +372,11 @@ func (a *AppPlatformTool) Tools() []server.ServerTool {
 		},
 		{
 			Handler: a.createAppFromAppSpec,
-			Tool: mcp.NewToolWithRawSchema(
+			Tool: common.NewToolWithRawSchema(
 				"apps-create-app-from-spec",
 				"Creates an application from a given app spec. Within the app spec, a source has to be provided. The source can be a Git repository, a Dockerfile, or a container image.",
 				appCreateSchemaJSON,
+				common.WithExample(appCreateExample),
 			),
 		},
 		{
@@ -393,6 +399,47 @@ func (a *AppPlatformTool) Tools() []server.ServerTool {
 				mcp.WithNumber("TailLines", mcp.DefaultNumber(100), mcp.Description("Number of lines to retrieve from the end of logs (default: 100)")),
 			),
 		},
+		{
+			Handler: a.listAlerts,
+			Tool: mcp.NewTool("apps-alert-list",
+				mcp.WithDescription("Lists the alerts of an application on DigitalOcean App Platform, including app-level alerts (e.g. deployment failures) and component alerts (e.g. CPU or memory utilization), with their rules and destinations."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID")),
+			),
+		},
+		{
+			Handler: a.updateAlertDestinations,
+			Tool: mcp.NewTool("apps-alert-update-destinations",
+				mcp.WithDescription("Sets the email addresses and Slack webhooks an alert of an application is sent to. A destination list that isn't passed keeps its current value; pass an empty list to remove all destinations of that kind. Use apps-alert-list for the alert IDs."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID")),
+				mcp.WithString("AlertID", mcp.Required(), mcp.Description("The alert ID")),
+				mcp.WithArray("Emails", mcp.WithStringItems(), mcp.Description("Email addresses of team members to notify")),
+				mcp.WithArray("SlackWebhooks",
+					mcp.Description("Slack webhooks to notify"),
+					mcp.Items(map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"URL":     map[string]any{"type": "string", "description": "The Slack webhook URL"},
+							"Channel": map[string]any{"type": "string", "description": "The Slack channel name"},
+						},
+						"required": []string{"URL", "Channel"},
+					}),
+				),
+			),
+		},
+		{
+			Handler: a.listTiers,
+			Tool: mcp.NewTool("apps-tier-list",
+				mcp.WithDescription("Lists the App Platform tiers with their egress bandwidth and build minute allowances."),
+			),
+		},
+		{
+			Handler: a.listInstanceSizes,
+			Tool: mcp.NewTool("apps-instance-size-list",
+				mcp.WithDescription("Lists the instance sizes App Platform components can run on, cheapest first, with their CPUs, memory, monthly price and whether they support autoscaling. Use it to right-size the instance_size_slug of a component before apps-update."),
+				mcp.WithString("TierSlug", mcp.Description("Only list the sizes of this tier (optional)")),
+				mcp.WithBoolean("IncludeDeprecated", mcp.DefaultBool(false), mcp.Description("Whether to include sizes that are being deprecated (default: false)")),
+			),
+		},
 	}
 
 	return tools
@@ -5,11 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+
+	"mcp-digitalocean/internal/testhelpers"
 )
 
 type getClientFn func(ctx context.Context) (*godo.Client, error)
@@ -331,6 +334,67 @@ func TestCreateAppFromAppSpec(t *testing.T) {
 	}
 }
 
+func TestCreateAppFromAppSpecExample(t *testing.T) {
+	args := map[string]any{
+		"spec": map[string]any{
+			"name":   "sample-nodejs",
+			"region": "nyc",
+			"services": []any{
+				map[string]any{
+					"name":               "web",
+					"github":             map[string]any{"repo": "digitalocean/sample-nodejs", "branch": "main", "deploy_on_push": true},
+					"environment_slug":   "node-js",
+					"run_command":        "npm start",
+					"http_port":          float64(8080),
+					"instance_size_slug": "apps-s-1vcpu-0.5gb",
+					"instance_count":     float64(1),
+					"envs":               []any{map[string]any{"key": "NODE_ENV", "value": "production", "scope": "RUN_TIME"}},
+				},
+			},
+		},
+	}
+	spec := &godo.AppSpec{
+		Name:   "sample-nodejs",
+		Region: "nyc",
+		Services: []*godo.AppServiceSpec{
+			{
+				Name:             "web",
+				GitHub:           &godo.GitHubSourceSpec{Repo: "digitalocean/sample-nodejs", Branch: "main", DeployOnPush: true},
+				EnvironmentSlug:  "node-js",
+				RunCommand:       "npm start",
+				HTTPPort:         8080,
+				InstanceSizeSlug: "apps-s-1vcpu-0.5gb",
+				InstanceCount:    1,
+				Envs:             []*godo.AppVariableDefinition{{Key: "NODE_ENV", Value: "production", Scope: godo.AppVariableScope_RunTime}},
+			},
+		},
+	}
+	created := time.Date(2026, 3, 2, 17, 3, 29, 0, time.UTC)
+	client, appService := setupMock(t)
+	appService.EXPECT().Create(gomock.Any(), &godo.AppCreateRequest{Spec: spec}).Return(&godo.App{
+		ID:                      "c2a93513-8d9b-4223-9d61-5e7272c81cf5",
+		OwnerUUID:               "a4e16f25-cc69-4e9f-9a5c-6ba7f5e5c3a1",
+		Spec:                    spec,
+		Region:                  &godo.AppRegion{Slug: "nyc", Label: "New York", DataCenters: []string{"nyc1", "nyc3"}},
+		TierSlug:                "basic",
+		CreatedAt:               created,
+		UpdatedAt:               created,
+		LastDeploymentCreatedAt: created,
+		PendingDeployment: &godo.Deployment{
+			ID:                 "92a2b5c1-0e4c-4f5d-9a9b-12f5c6c7e0a3",
+			Phase:              godo.DeploymentPhase_PendingBuild,
+			PhaseLastUpdatedAt: created,
+			CreatedAt:          created,
+			UpdatedAt:          created,
+		},
+	}, nil, nil)
+
+	tool := &AppPlatformTool{client: client}
+	resp, err := tool.createAppFromAppSpec(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	require.NoError(t, err)
+	testhelpers.CheckExample(t, "examples/apps-create-app-from-spec.json", args, resp)
+}
+
 func TestDeleteApp(t *testing.T) {
 	tests := []struct {
 		name         string
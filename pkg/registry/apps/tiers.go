@@ -0,0 +1,76 @@
+package apps
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// listTiers lists the App Platform tiers with their bandwidth and build allowances
+func (a *AppPlatformTool) listTiers(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client, err := a.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	tiers, _, err := client.Apps.ListTiers(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("failed to list app tiers", err), nil
+	}
+	if tiers == nil {
+		tiers = []*godo.AppTier{}
+	}
+
+	tiersJSON, err := json.MarshalIndent(tiers, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to format app tiers response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(tiersJSON)), nil
+}
+
+// listInstanceSizes lists the instance sizes App Platform components can run on, cheapest first.
+// Sizes that are being deprecated are left out unless they are asked for.
+func (a *AppPlatformTool) listInstanceSizes(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	tierSlug, _ := req.GetArguments()["TierSlug"].(string)
+	includeDeprecated, _ := req.GetArguments()["IncludeDeprecated"].(bool)
+
+	client, err := a.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	sizes, _, err := client.Apps.ListInstanceSizes(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("failed to list app instance sizes", err), nil
+	}
+
+	filtered := []*godo.AppInstanceSize{}
+	for _, size := range sizes {
+		if tierSlug != "" && size.TierSlug != tierSlug {
+			continue
+		}
+		if size.DeprecationIntent && !includeDeprecated {
+			continue
+		}
+		filtered = append(filtered, size)
+	}
+	slices.SortStableFunc(filtered, func(x, y *godo.AppInstanceSize) int {
+		xPrice, _ := strconv.ParseFloat(x.USDPerMonth, 64)
+		yPrice, _ := strconv.ParseFloat(y.USDPerMonth, 64)
+		return cmp.Compare(xPrice, yPrice)
+	})
+
+	sizesJSON, err := json.MarshalIndent(filtered, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to format app instance sizes response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(sizesJSON)), nil
+}
@@ -0,0 +1,54 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListInstanceSizes(t *testing.T) {
+	sizes := []*godo.AppInstanceSize{
+		{Slug: "apps-d-1vcpu-1gb", TierSlug: "professional", USDPerMonth: "39.00"},
+		{Slug: "apps-s-1vcpu-0.5gb", TierSlug: "basic", USDPerMonth: "5.00"},
+		{Slug: "apps-s-1vcpu-1gb-fixed", TierSlug: "basic", USDPerMonth: "10.00"},
+		{Slug: "basic-xxs", TierSlug: "basic", USDPerMonth: "5.00", DeprecationIntent: true},
+	}
+
+	tests := []struct {
+		name     string
+		args     map[string]any
+		expected []*godo.AppInstanceSize
+	}{
+		{
+			name:     "Cheapest first without deprecated sizes",
+			args:     map[string]any{},
+			expected: []*godo.AppInstanceSize{sizes[1], sizes[2], sizes[0]},
+		},
+		{
+			name:     "Filtered by tier",
+			args:     map[string]any{"TierSlug": "professional"},
+			expected: []*godo.AppInstanceSize{sizes[0]},
+		},
+		{
+			name:     "Including deprecated sizes",
+			args:     map[string]any{"TierSlug": "basic", "IncludeDeprecated": true},
+			expected: []*godo.AppInstanceSize{sizes[1], sizes[3], sizes[2]},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, appService := setupMock(t)
+			tool := &AppPlatformTool{client: client}
+			appService.EXPECT().ListInstanceSizes(gomock.Any()).Return(sizes, nil, nil).Times(1)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.listInstanceSizes(context.Background(), req)
+			require.NoError(t, err)
+			equalsToolResult(t, tc.expected, resp)
+		})
+	}
+}
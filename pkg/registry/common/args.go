@@ -0,0 +1,233 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Args reads the arguments of a tool call with the types their schema declares. Missing
+// required arguments and values of another type are collected instead of panicking on a type
+// assertion, and Err reports all of them at once so the caller can fix its call in one go.
+type Args struct {
+	values   map[string]any
+	prefix   string
+	problems *[]string
+}
+
+// NewArgs returns the arguments of req.
+func NewArgs(req mcp.CallToolRequest) *Args {
+	return &Args{values: req.GetArguments(), problems: &[]string{}}
+}
+
+// Object returns the fields of the object argument name, reporting their problems under that
+// name. An absent object has no fields.
+func (a *Args) Object(name string) *Args {
+	return a.object(name, a.values[name])
+}
+
+// Objects returns the fields of each element of the array argument name, e.g. a list of rules.
+func (a *Args) Objects(name string) []*Args {
+	list := a.List(name)
+	objects := make([]*Args, 0, len(list))
+	for i, v := range list {
+		objects = append(objects, a.object(fmt.Sprintf("%s[%d]", name, i), v))
+	}
+	return objects
+}
+
+func (a *Args) object(name string, value any) *Args {
+	fields, ok := value.(map[string]any)
+	if !ok && value != nil {
+		a.mistyped(name, "an object", value)
+	}
+	return &Args{values: fields, prefix: a.path(name) + ".", problems: a.problems}
+}
+
+func (a *Args) path(name string) string {
+	return a.prefix + name
+}
+
+func (a *Args) missing(name, kind string) {
+	*a.problems = append(*a.problems, fmt.Sprintf("%s is required (%s)", a.path(name), kind))
+}
+
+func (a *Args) mistyped(name, kind string, value any) {
+	*a.problems = append(*a.problems, fmt.Sprintf("%s must be %s, not %s", a.path(name), kind, jsonType(value)))
+}
+
+// jsonType names the JSON type of a decoded argument value.
+func jsonType(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	case []any:
+		return "an array"
+	case map[string]any:
+		return "an object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// Has reports whether the argument name was passed with a non-null value.
+func (a *Args) Has(name string) bool {
+	v, ok := a.values[name]
+	return ok && v != nil
+}
+
+// String returns the string argument name, or "" when it is absent.
+func (a *Args) String(name string) string {
+	v, ok := a.values[name]
+	if !ok || v == nil {
+		return ""
+	}
+	s, ok := v.(string)
+	if !ok {
+		a.mistyped(name, "a string", v)
+	}
+	return s
+}
+
+// RequiredString returns the string argument name, which must be present and not empty.
+func (a *Args) RequiredString(name string) string {
+	if !a.Has(name) {
+		a.missing(name, "string")
+		return ""
+	}
+	s := a.String(name)
+	if s == "" {
+		if _, ok := a.values[name].(string); ok {
+			a.missing(name, "non-empty string")
+		}
+	}
+	return s
+}
+
+// Number returns the number argument name, or def when it is absent. A numeric string is
+// accepted as its number.
+func (a *Args) Number(name string, def float64) float64 {
+	v, ok := a.values[name]
+	if !ok || v == nil {
+		return def
+	}
+	switch n := v.(type) {
+	case float64:
+		return n
+	case string:
+		// Models often quote IDs, so numeric strings are accepted as numbers.
+		if f, err := strconv.ParseFloat(strings.TrimSpace(n), 64); err == nil {
+			return f
+		}
+	}
+	a.mistyped(name, "a number", v)
+	return def
+}
+
+// RequiredNumber returns the number argument name, which must be present.
+func (a *Args) RequiredNumber(name string) float64 {
+	if !a.Has(name) {
+		a.missing(name, "number")
+		return 0
+	}
+	return a.Number(name, 0)
+}
+
+// Bool returns the boolean argument name, or def when it is absent.
+func (a *Args) Bool(name string, def bool) bool {
+	v, ok := a.values[name]
+	if !ok || v == nil {
+		return def
+	}
+	b, ok := v.(bool)
+	if !ok {
+		a.mistyped(name, "a boolean", v)
+		return def
+	}
+	return b
+}
+
+// RequiredBool returns the boolean argument name, which must be present.
+func (a *Args) RequiredBool(name string) bool {
+	if !a.Has(name) {
+		a.missing(name, "boolean")
+		return false
+	}
+	return a.Bool(name, false)
+}
+
+// List returns the array argument name, or nil when it is absent.
+func (a *Args) List(name string) []any {
+	v, ok := a.values[name]
+	if !ok || v == nil {
+		return nil
+	}
+	if l, ok := v.([]any); ok {
+		return l
+	}
+	// In-process callers may pass typed slices such as []string instead of decoded JSON.
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		a.mistyped(name, "an array", v)
+		return nil
+	}
+	l := make([]any, rv.Len())
+	for i := range l {
+		l[i] = rv.Index(i).Interface()
+	}
+	return l
+}
+
+// RequiredList returns the array argument name, which must be present.
+func (a *Args) RequiredList(name string) []any {
+	if !a.Has(name) {
+		a.missing(name, "array")
+		return nil
+	}
+	return a.List(name)
+}
+
+// Strings returns the string elements of the array argument name, or nil when it is absent.
+func (a *Args) Strings(name string) []string {
+	list := a.List(name)
+	if list == nil {
+		return nil
+	}
+	strs := make([]string, 0, len(list))
+	for i, v := range list {
+		s, ok := v.(string)
+		if !ok {
+			a.mistyped(fmt.Sprintf("%s[%d]", name, i), "a string", v)
+			continue
+		}
+		strs = append(strs, s)
+	}
+	return strs
+}
+
+// RequiredStrings returns the string elements of the array argument name, which must be present.
+func (a *Args) RequiredStrings(name string) []string {
+	if !a.Has(name) {
+		a.missing(name, "array of strings")
+		return nil
+	}
+	return a.Strings(name)
+}
+
+// Err returns an error listing every missing or mistyped argument read so far, or nil.
+func (a *Args) Err() error {
+	if len(*a.problems) == 0 {
+		return nil
+	}
+	return errors.New("invalid arguments: " + strings.Join(*a.problems, "; "))
+}
@@ -0,0 +1,63 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func argsOf(values map[string]any) *Args {
+	return NewArgs(mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: values}})
+}
+
+func TestArgs(t *testing.T) {
+	args := argsOf(map[string]any{
+		"Name":    "web-1",
+		"ID":      float64(42),
+		"Size":    " 25 ",
+		"Backup":  true,
+		"Tags":    []any{"a", "b"},
+		"Regions": []string{"nyc3"},
+		"Rule":    map[string]any{"Protocol": "tcp"},
+	})
+
+	require.Equal(t, "web-1", args.RequiredString("Name"))
+	require.Equal(t, float64(42), args.RequiredNumber("ID"))
+	require.Equal(t, float64(25), args.RequiredNumber("Size"))
+	require.True(t, args.RequiredBool("Backup"))
+	require.Equal(t, []string{"a", "b"}, args.Strings("Tags"))
+	require.Equal(t, []string{"nyc3"}, args.RequiredStrings("Regions"))
+	require.Equal(t, "tcp", args.Object("Rule").RequiredString("Protocol"))
+	require.Equal(t, "", args.String("Missing"))
+	require.Equal(t, float64(10), args.Number("Missing", 10))
+	require.True(t, args.Bool("Missing", true))
+	require.Nil(t, args.List("Missing"))
+	require.False(t, args.Has("Missing"))
+	require.NoError(t, args.Err())
+}
+
+func TestArgs_problems(t *testing.T) {
+	args := argsOf(map[string]any{
+		"ID":    "forty-two",
+		"Name":  "",
+		"Tags":  []any{"a", float64(1)},
+		"Rules": []any{map[string]any{"Protocol": "tcp"}, "udp"},
+	})
+
+	args.RequiredNumber("ID")
+	args.RequiredString("Name")
+	args.RequiredString("Region")
+	args.Strings("Tags")
+	for _, rule := range args.Objects("Rules") {
+		rule.RequiredString("Protocol")
+	}
+
+	require.EqualError(t, args.Err(), "invalid arguments: "+
+		"ID must be a number, not a string; "+
+		"Name is required (non-empty string); "+
+		"Region is required (string); "+
+		"Tags[1] must be a string, not a number; "+
+		"Rules[1] must be an object, not a string; "+
+		"Rules[1].Protocol is required (string)")
+}
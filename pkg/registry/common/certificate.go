@@ -0,0 +1,33 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+// WaitForCertificate polls the certificate id every interval until Let's Encrypt has issued it.
+// It fails when issuing failed or ctx is done first.
+func WaitForCertificate(ctx context.Context, client *godo.Client, id string, interval time.Duration) (*godo.Certificate, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		certificate, _, err := client.Certificates.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		switch certificate.State {
+		case "verified":
+			return certificate, nil
+		case "error":
+			return nil, fmt.Errorf("certificate %s failed to issue; check that the domain's NS records point at DigitalOcean", id)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("certificate %s is still %s: %w", id, certificate.State, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWaitForCertificate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	certificates := NewMockCertificatesService(ctrl)
+	client := &godo.Client{Certificates: certificates}
+
+	gomock.InOrder(
+		certificates.EXPECT().Get(gomock.Any(), "cert-1").Return(&godo.Certificate{ID: "cert-1", State: "pending"}, nil, nil),
+		certificates.EXPECT().Get(gomock.Any(), "cert-1").Return(&godo.Certificate{ID: "cert-1", State: "verified"}, nil, nil),
+	)
+	certificate, err := WaitForCertificate(context.Background(), client, "cert-1", time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, "verified", certificate.State)
+
+	// a certificate that failed to issue is not waited for any longer.
+	certificates.EXPECT().Get(gomock.Any(), "cert-2").Return(&godo.Certificate{ID: "cert-2", State: "error"}, nil, nil)
+	_, err = WaitForCertificate(context.Background(), client, "cert-2", time.Millisecond)
+	require.ErrorContains(t, err, "certificate cert-2 failed to issue")
+
+	// the wait ends with the context.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	certificates.EXPECT().Get(gomock.Any(), "cert-3").Return(&godo.Certificate{ID: "cert-3", State: "pending"}, nil, nil)
+	_, err = WaitForCertificate(ctx, client, "cert-3", time.Hour)
+	require.ErrorIs(t, err, context.Canceled)
+	require.ErrorContains(t, err, "still pending")
+}
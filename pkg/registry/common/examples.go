@@ -0,0 +1,69 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ExampleMetaKey is the _meta field of a tool holding an example call of it.
+const ExampleMetaKey = "digitalocean.com/example"
+
+// maxExampleOutput is how many bytes of the output of an example call are kept.
+const maxExampleOutput = 1000
+
+// Example is an example call of a tool, recorded from the fixtures of its tests.
+type Example struct {
+	Arguments map[string]any `json:"arguments"`
+	// Output is the start of the text the call returned, cut after maxExampleOutput bytes.
+	Output string `json:"output"`
+}
+
+// NewExample returns the example of a call with args that returned res. JSON output is
+// compacted before it is cut, so the example shows as much of it as fits.
+func NewExample(args map[string]any, res *mcp.CallToolResult) Example {
+	var text string
+	for _, content := range res.Content {
+		if tc, ok := content.(mcp.TextContent); ok {
+			text = tc.Text
+			break
+		}
+	}
+	var compact bytes.Buffer
+	if json.Compact(&compact, []byte(text)) == nil {
+		text = compact.String()
+	}
+	if len(text) > maxExampleOutput {
+		cut := maxExampleOutput
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		text = text[:cut] + "..."
+	}
+	return Example{Arguments: args, Output: text}
+}
+
+// WithExample adds the example in data, the JSON of an Example, to the tool: its arguments to
+// the description, which is what models read when they build a call, and the whole example to
+// the ExampleMetaKey field of the tool's _meta. A tool whose example can't be read gets none.
+func WithExample(data []byte) mcp.ToolOption {
+	var example Example
+	err := json.Unmarshal(data, &example)
+	arguments, _ := json.Marshal(example.Arguments)
+	return func(t *mcp.Tool) {
+		if err != nil || len(example.Arguments) == 0 {
+			return
+		}
+		t.Description = strings.TrimRight(t.Description, " ") + "\n\nExample arguments: " + string(arguments)
+		if t.Meta == nil {
+			t.Meta = &mcp.Meta{}
+		}
+		if t.Meta.AdditionalFields == nil {
+			t.Meta.AdditionalFields = map[string]any{}
+		}
+		t.Meta.AdditionalFields[ExampleMetaKey] = example
+	}
+}
@@ -0,0 +1,46 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExample(t *testing.T) {
+	args := map[string]any{"Name": "web-lb"}
+
+	example := NewExample(args, mcp.NewToolResultText("{\n  \"id\": \"lb-1\",\n  \"name\": \"web-lb\"\n}"))
+	require.Equal(t, args, example.Arguments)
+	require.Equal(t, `{"id":"lb-1","name":"web-lb"}`, example.Output)
+
+	// long output is cut, on a rune boundary.
+	long := NewExample(args, mcp.NewToolResultText(strings.Repeat("é", maxExampleOutput)))
+	require.True(t, strings.HasSuffix(long.Output, "..."))
+	require.LessOrEqual(t, len(long.Output), maxExampleOutput+len("..."))
+	require.Equal(t, strings.Repeat("é", maxExampleOutput/2)+"...", long.Output)
+}
+
+func TestWithExample(t *testing.T) {
+	data := []byte(`{"arguments": {"Region": "nyc3", "Name": "web-lb"}, "output": "{\"id\":\"lb-1\"}"}`)
+
+	tool := mcp.NewTool("lb-create", mcp.WithDescription("Create a new Load Balancer"), WithExample(data))
+	require.Equal(t, "Create a new Load Balancer\n\nExample arguments: {\"Name\":\"web-lb\",\"Region\":\"nyc3\"}", tool.Description)
+	require.Equal(t, Example{
+		Arguments: map[string]any{"Region": "nyc3", "Name": "web-lb"},
+		Output:    `{"id":"lb-1"}`,
+	}, tool.Meta.AdditionalFields[ExampleMetaKey])
+
+	// the other _meta fields of the tool are kept.
+	raw := NewToolWithRawSchema("apps-create", "Create an app", []byte(`{"type":"object"}`),
+		func(t *mcp.Tool) { t.Meta = mcp.NewMetaFromMap(map[string]any{"digitalocean.com/existing": true}) },
+		WithExample(data))
+	require.Equal(t, true, raw.Meta.AdditionalFields["digitalocean.com/existing"])
+	require.Contains(t, raw.Meta.AdditionalFields, ExampleMetaKey)
+
+	// a tool whose example can't be read gets none.
+	broken := mcp.NewTool("lb-create", mcp.WithDescription("Create a new Load Balancer"), WithExample([]byte("{")))
+	require.Equal(t, "Create a new Load Balancer", broken.Description)
+	require.Nil(t, broken.Meta)
+}
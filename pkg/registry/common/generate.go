@@ -1,3 +1,3 @@
 package common
 
-//go:generate mockgen -destination=./mocks.go -package common github.com/digitalocean/godo  RegionsService
+//go:generate mockgen -destination=./mocks.go -package common github.com/digitalocean/godo  RegionsService,DropletsService,KubernetesService,TagsService,ProjectsService,AccountService,SizesService,StorageService,ImagesService,CertificatesService
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/digitalocean/godo (interfaces: RegionsService)
+// Source: github.com/digitalocean/godo (interfaces: RegionsService,DropletsService,KubernetesService,TagsService,ProjectsService,AccountService,SizesService,StorageService,ImagesService,CertificatesService)
 //
 // Generated by this command:
 //
-//	mockgen -destination=./mocks.go -package common github.com/digitalocean/godo RegionsService
+//	mockgen -destination=./mocks.go -package common github.com/digitalocean/godo RegionsService,DropletsService,KubernetesService,TagsService,ProjectsService,AccountService,SizesService,StorageService,ImagesService,CertificatesService
 //
 
 // Package common is a generated GoMock package.
@@ -56,3 +56,1565 @@ func (mr *MockRegionsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRegionsService)(nil).List), arg0, arg1)
 }
+
+// MockDropletsService is a mock of DropletsService interface.
+type MockDropletsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDropletsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDropletsServiceMockRecorder is the mock recorder for MockDropletsService.
+type MockDropletsServiceMockRecorder struct {
+	mock *MockDropletsService
+}
+
+// NewMockDropletsService creates a new mock instance.
+func NewMockDropletsService(ctrl *gomock.Controller) *MockDropletsService {
+	mock := &MockDropletsService{ctrl: ctrl}
+	mock.recorder = &MockDropletsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDropletsService) EXPECT() *MockDropletsServiceMockRecorder {
+	return m.recorder
+}
+
+// Actions mocks base method.
+func (m *MockDropletsService) Actions(arg0 context.Context, arg1 int, arg2 *godo.ListOptions) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Actions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Actions indicates an expected call of Actions.
+func (mr *MockDropletsServiceMockRecorder) Actions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Actions", reflect.TypeOf((*MockDropletsService)(nil).Actions), arg0, arg1, arg2)
+}
+
+// Backups mocks base method.
+func (m *MockDropletsService) Backups(arg0 context.Context, arg1 int, arg2 *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backups", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Backups indicates an expected call of Backups.
+func (mr *MockDropletsServiceMockRecorder) Backups(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backups", reflect.TypeOf((*MockDropletsService)(nil).Backups), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockDropletsService) Create(arg0 context.Context, arg1 *godo.DropletCreateRequest) (*godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDropletsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDropletsService)(nil).Create), arg0, arg1)
+}
+
+// CreateMultiple mocks base method.
+func (m *MockDropletsService) CreateMultiple(arg0 context.Context, arg1 *godo.DropletMultiCreateRequest) ([]godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMultiple", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateMultiple indicates an expected call of CreateMultiple.
+func (mr *MockDropletsServiceMockRecorder) CreateMultiple(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultiple", reflect.TypeOf((*MockDropletsService)(nil).CreateMultiple), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockDropletsService) Delete(arg0 context.Context, arg1 int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDropletsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDropletsService)(nil).Delete), arg0, arg1)
+}
+
+// DeleteByTag mocks base method.
+func (m *MockDropletsService) DeleteByTag(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByTag", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByTag indicates an expected call of DeleteByTag.
+func (mr *MockDropletsServiceMockRecorder) DeleteByTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByTag", reflect.TypeOf((*MockDropletsService)(nil).DeleteByTag), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockDropletsService) Get(arg0 context.Context, arg1 int) (*godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDropletsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDropletsService)(nil).Get), arg0, arg1)
+}
+
+// GetBackupPolicy mocks base method.
+func (m *MockDropletsService) GetBackupPolicy(arg0 context.Context, arg1 int) (*godo.DropletBackupPolicy, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackupPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*godo.DropletBackupPolicy)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupPolicy indicates an expected call of GetBackupPolicy.
+func (mr *MockDropletsServiceMockRecorder) GetBackupPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupPolicy", reflect.TypeOf((*MockDropletsService)(nil).GetBackupPolicy), arg0, arg1)
+}
+
+// Kernels mocks base method.
+func (m *MockDropletsService) Kernels(arg0 context.Context, arg1 int, arg2 *godo.ListOptions) ([]godo.Kernel, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Kernels", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Kernel)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Kernels indicates an expected call of Kernels.
+func (mr *MockDropletsServiceMockRecorder) Kernels(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Kernels", reflect.TypeOf((*MockDropletsService)(nil).Kernels), arg0, arg1, arg2)
+}
+
+// List mocks base method.
+func (m *MockDropletsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockDropletsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDropletsService)(nil).List), arg0, arg1)
+}
+
+// ListAssociatedResourcesForDeletion mocks base method.
+func (m *MockDropletsService) ListAssociatedResourcesForDeletion(arg0 context.Context, arg1 int) (*godo.DropletAssociatedResources, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssociatedResourcesForDeletion", arg0, arg1)
+	ret0, _ := ret[0].(*godo.DropletAssociatedResources)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAssociatedResourcesForDeletion indicates an expected call of ListAssociatedResourcesForDeletion.
+func (mr *MockDropletsServiceMockRecorder) ListAssociatedResourcesForDeletion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociatedResourcesForDeletion", reflect.TypeOf((*MockDropletsService)(nil).ListAssociatedResourcesForDeletion), arg0, arg1)
+}
+
+// ListBackupPolicies mocks base method.
+func (m *MockDropletsService) ListBackupPolicies(arg0 context.Context, arg1 *godo.ListOptions) (map[int]*godo.DropletBackupPolicy, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupPolicies", arg0, arg1)
+	ret0, _ := ret[0].(map[int]*godo.DropletBackupPolicy)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListBackupPolicies indicates an expected call of ListBackupPolicies.
+func (mr *MockDropletsServiceMockRecorder) ListBackupPolicies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupPolicies", reflect.TypeOf((*MockDropletsService)(nil).ListBackupPolicies), arg0, arg1)
+}
+
+// ListByName mocks base method.
+func (m *MockDropletsService) ListByName(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByName indicates an expected call of ListByName.
+func (mr *MockDropletsServiceMockRecorder) ListByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByName", reflect.TypeOf((*MockDropletsService)(nil).ListByName), arg0, arg1, arg2)
+}
+
+// ListByTag mocks base method.
+func (m *MockDropletsService) ListByTag(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByTag indicates an expected call of ListByTag.
+func (mr *MockDropletsServiceMockRecorder) ListByTag(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTag", reflect.TypeOf((*MockDropletsService)(nil).ListByTag), arg0, arg1, arg2)
+}
+
+// ListSupportedBackupPolicies mocks base method.
+func (m *MockDropletsService) ListSupportedBackupPolicies(arg0 context.Context) ([]*godo.SupportedBackupPolicy, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSupportedBackupPolicies", arg0)
+	ret0, _ := ret[0].([]*godo.SupportedBackupPolicy)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSupportedBackupPolicies indicates an expected call of ListSupportedBackupPolicies.
+func (mr *MockDropletsServiceMockRecorder) ListSupportedBackupPolicies(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedBackupPolicies", reflect.TypeOf((*MockDropletsService)(nil).ListSupportedBackupPolicies), arg0)
+}
+
+// ListWithGPUs mocks base method.
+func (m *MockDropletsService) ListWithGPUs(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithGPUs", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListWithGPUs indicates an expected call of ListWithGPUs.
+func (mr *MockDropletsServiceMockRecorder) ListWithGPUs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithGPUs", reflect.TypeOf((*MockDropletsService)(nil).ListWithGPUs), arg0, arg1)
+}
+
+// Neighbors mocks base method.
+func (m *MockDropletsService) Neighbors(arg0 context.Context, arg1 int) ([]godo.Droplet, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Neighbors", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Droplet)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Neighbors indicates an expected call of Neighbors.
+func (mr *MockDropletsServiceMockRecorder) Neighbors(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Neighbors", reflect.TypeOf((*MockDropletsService)(nil).Neighbors), arg0, arg1)
+}
+
+// Snapshots mocks base method.
+func (m *MockDropletsService) Snapshots(arg0 context.Context, arg1 int, arg2 *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshots", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Snapshots indicates an expected call of Snapshots.
+func (mr *MockDropletsServiceMockRecorder) Snapshots(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshots", reflect.TypeOf((*MockDropletsService)(nil).Snapshots), arg0, arg1, arg2)
+}
+
+// MockKubernetesService is a mock of KubernetesService interface.
+type MockKubernetesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockKubernetesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockKubernetesServiceMockRecorder is the mock recorder for MockKubernetesService.
+type MockKubernetesServiceMockRecorder struct {
+	mock *MockKubernetesService
+}
+
+// NewMockKubernetesService creates a new mock instance.
+func NewMockKubernetesService(ctrl *gomock.Controller) *MockKubernetesService {
+	mock := &MockKubernetesService{ctrl: ctrl}
+	mock.recorder = &MockKubernetesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKubernetesService) EXPECT() *MockKubernetesServiceMockRecorder {
+	return m.recorder
+}
+
+// AddRegistry mocks base method.
+func (m *MockKubernetesService) AddRegistry(ctx context.Context, req *godo.KubernetesClusterRegistryRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRegistry", ctx, req)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRegistry indicates an expected call of AddRegistry.
+func (mr *MockKubernetesServiceMockRecorder) AddRegistry(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRegistry", reflect.TypeOf((*MockKubernetesService)(nil).AddRegistry), ctx, req)
+}
+
+// Create mocks base method.
+func (m *MockKubernetesService) Create(arg0 context.Context, arg1 *godo.KubernetesClusterCreateRequest) (*godo.KubernetesCluster, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KubernetesCluster)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockKubernetesServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKubernetesService)(nil).Create), arg0, arg1)
+}
+
+// CreateNodePool mocks base method.
+func (m *MockKubernetesService) CreateNodePool(ctx context.Context, clusterID string, req *godo.KubernetesNodePoolCreateRequest) (*godo.KubernetesNodePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNodePool", ctx, clusterID, req)
+	ret0, _ := ret[0].(*godo.KubernetesNodePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateNodePool indicates an expected call of CreateNodePool.
+func (mr *MockKubernetesServiceMockRecorder) CreateNodePool(ctx, clusterID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNodePool", reflect.TypeOf((*MockKubernetesService)(nil).CreateNodePool), ctx, clusterID, req)
+}
+
+// Delete mocks base method.
+func (m *MockKubernetesService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockKubernetesServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKubernetesService)(nil).Delete), arg0, arg1)
+}
+
+// DeleteDangerous mocks base method.
+func (m *MockKubernetesService) DeleteDangerous(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDangerous", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDangerous indicates an expected call of DeleteDangerous.
+func (mr *MockKubernetesServiceMockRecorder) DeleteDangerous(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDangerous", reflect.TypeOf((*MockKubernetesService)(nil).DeleteDangerous), arg0, arg1)
+}
+
+// DeleteNode mocks base method.
+func (m *MockKubernetesService) DeleteNode(ctx context.Context, clusterID, poolID, nodeID string, req *godo.KubernetesNodeDeleteRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNode", ctx, clusterID, poolID, nodeID, req)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNode indicates an expected call of DeleteNode.
+func (mr *MockKubernetesServiceMockRecorder) DeleteNode(ctx, clusterID, poolID, nodeID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNode", reflect.TypeOf((*MockKubernetesService)(nil).DeleteNode), ctx, clusterID, poolID, nodeID, req)
+}
+
+// DeleteNodePool mocks base method.
+func (m *MockKubernetesService) DeleteNodePool(ctx context.Context, clusterID, poolID string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNodePool", ctx, clusterID, poolID)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNodePool indicates an expected call of DeleteNodePool.
+func (mr *MockKubernetesServiceMockRecorder) DeleteNodePool(ctx, clusterID, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNodePool", reflect.TypeOf((*MockKubernetesService)(nil).DeleteNodePool), ctx, clusterID, poolID)
+}
+
+// DeleteSelective mocks base method.
+func (m *MockKubernetesService) DeleteSelective(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterDeleteSelectiveRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSelective", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSelective indicates an expected call of DeleteSelective.
+func (mr *MockKubernetesServiceMockRecorder) DeleteSelective(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSelective", reflect.TypeOf((*MockKubernetesService)(nil).DeleteSelective), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockKubernetesService) Get(arg0 context.Context, arg1 string) (*godo.KubernetesCluster, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KubernetesCluster)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockKubernetesServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKubernetesService)(nil).Get), arg0, arg1)
+}
+
+// GetClusterStatusMessages mocks base method.
+func (m *MockKubernetesService) GetClusterStatusMessages(ctx context.Context, clusterID string, req *godo.KubernetesGetClusterStatusMessagesRequest) ([]*godo.KubernetesClusterStatusMessage, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterStatusMessages", ctx, clusterID, req)
+	ret0, _ := ret[0].([]*godo.KubernetesClusterStatusMessage)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterStatusMessages indicates an expected call of GetClusterStatusMessages.
+func (mr *MockKubernetesServiceMockRecorder) GetClusterStatusMessages(ctx, clusterID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterStatusMessages", reflect.TypeOf((*MockKubernetesService)(nil).GetClusterStatusMessages), ctx, clusterID, req)
+}
+
+// GetClusterlintResults mocks base method.
+func (m *MockKubernetesService) GetClusterlintResults(ctx context.Context, clusterID string, req *godo.KubernetesGetClusterlintRequest) ([]*godo.ClusterlintDiagnostic, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterlintResults", ctx, clusterID, req)
+	ret0, _ := ret[0].([]*godo.ClusterlintDiagnostic)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterlintResults indicates an expected call of GetClusterlintResults.
+func (mr *MockKubernetesServiceMockRecorder) GetClusterlintResults(ctx, clusterID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterlintResults", reflect.TypeOf((*MockKubernetesService)(nil).GetClusterlintResults), ctx, clusterID, req)
+}
+
+// GetCredentials mocks base method.
+func (m *MockKubernetesService) GetCredentials(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterCredentialsGetRequest) (*godo.KubernetesClusterCredentials, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCredentials", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.KubernetesClusterCredentials)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCredentials indicates an expected call of GetCredentials.
+func (mr *MockKubernetesServiceMockRecorder) GetCredentials(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredentials", reflect.TypeOf((*MockKubernetesService)(nil).GetCredentials), arg0, arg1, arg2)
+}
+
+// GetKubeConfig mocks base method.
+func (m *MockKubernetesService) GetKubeConfig(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterKubeconfigGetRequest) (*godo.KubernetesClusterConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubeConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.KubernetesClusterConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKubeConfig indicates an expected call of GetKubeConfig.
+func (mr *MockKubernetesServiceMockRecorder) GetKubeConfig(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeConfig", reflect.TypeOf((*MockKubernetesService)(nil).GetKubeConfig), arg0, arg1, arg2)
+}
+
+// GetKubeConfigWithExpiry mocks base method.
+func (m *MockKubernetesService) GetKubeConfigWithExpiry(arg0 context.Context, arg1 string, arg2 int64) (*godo.KubernetesClusterConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubeConfigWithExpiry", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.KubernetesClusterConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKubeConfigWithExpiry indicates an expected call of GetKubeConfigWithExpiry.
+func (mr *MockKubernetesServiceMockRecorder) GetKubeConfigWithExpiry(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeConfigWithExpiry", reflect.TypeOf((*MockKubernetesService)(nil).GetKubeConfigWithExpiry), arg0, arg1, arg2)
+}
+
+// GetNodePool mocks base method.
+func (m *MockKubernetesService) GetNodePool(ctx context.Context, clusterID, poolID string) (*godo.KubernetesNodePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodePool", ctx, clusterID, poolID)
+	ret0, _ := ret[0].(*godo.KubernetesNodePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNodePool indicates an expected call of GetNodePool.
+func (mr *MockKubernetesServiceMockRecorder) GetNodePool(ctx, clusterID, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodePool", reflect.TypeOf((*MockKubernetesService)(nil).GetNodePool), ctx, clusterID, poolID)
+}
+
+// GetNodePoolTemplate mocks base method.
+func (m *MockKubernetesService) GetNodePoolTemplate(ctx context.Context, clusterID, nodePoolName string) (*godo.KubernetesNodePoolTemplate, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodePoolTemplate", ctx, clusterID, nodePoolName)
+	ret0, _ := ret[0].(*godo.KubernetesNodePoolTemplate)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNodePoolTemplate indicates an expected call of GetNodePoolTemplate.
+func (mr *MockKubernetesServiceMockRecorder) GetNodePoolTemplate(ctx, clusterID, nodePoolName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodePoolTemplate", reflect.TypeOf((*MockKubernetesService)(nil).GetNodePoolTemplate), ctx, clusterID, nodePoolName)
+}
+
+// GetOptions mocks base method.
+func (m *MockKubernetesService) GetOptions(arg0 context.Context) (*godo.KubernetesOptions, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptions", arg0)
+	ret0, _ := ret[0].(*godo.KubernetesOptions)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOptions indicates an expected call of GetOptions.
+func (mr *MockKubernetesServiceMockRecorder) GetOptions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptions", reflect.TypeOf((*MockKubernetesService)(nil).GetOptions), arg0)
+}
+
+// GetUpgrades mocks base method.
+func (m *MockKubernetesService) GetUpgrades(arg0 context.Context, arg1 string) ([]*godo.KubernetesVersion, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpgrades", arg0, arg1)
+	ret0, _ := ret[0].([]*godo.KubernetesVersion)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUpgrades indicates an expected call of GetUpgrades.
+func (mr *MockKubernetesServiceMockRecorder) GetUpgrades(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpgrades", reflect.TypeOf((*MockKubernetesService)(nil).GetUpgrades), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockKubernetesService) GetUser(arg0 context.Context, arg1 string) (*godo.KubernetesClusterUser, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KubernetesClusterUser)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockKubernetesServiceMockRecorder) GetUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockKubernetesService)(nil).GetUser), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockKubernetesService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]*godo.KubernetesCluster, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]*godo.KubernetesCluster)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockKubernetesServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKubernetesService)(nil).List), arg0, arg1)
+}
+
+// ListAssociatedResourcesForDeletion mocks base method.
+func (m *MockKubernetesService) ListAssociatedResourcesForDeletion(arg0 context.Context, arg1 string) (*godo.KubernetesAssociatedResources, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssociatedResourcesForDeletion", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KubernetesAssociatedResources)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAssociatedResourcesForDeletion indicates an expected call of ListAssociatedResourcesForDeletion.
+func (mr *MockKubernetesServiceMockRecorder) ListAssociatedResourcesForDeletion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociatedResourcesForDeletion", reflect.TypeOf((*MockKubernetesService)(nil).ListAssociatedResourcesForDeletion), arg0, arg1)
+}
+
+// ListNodePools mocks base method.
+func (m *MockKubernetesService) ListNodePools(ctx context.Context, clusterID string, opts *godo.ListOptions) ([]*godo.KubernetesNodePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNodePools", ctx, clusterID, opts)
+	ret0, _ := ret[0].([]*godo.KubernetesNodePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListNodePools indicates an expected call of ListNodePools.
+func (mr *MockKubernetesServiceMockRecorder) ListNodePools(ctx, clusterID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodePools", reflect.TypeOf((*MockKubernetesService)(nil).ListNodePools), ctx, clusterID, opts)
+}
+
+// RecycleNodePoolNodes mocks base method.
+func (m *MockKubernetesService) RecycleNodePoolNodes(ctx context.Context, clusterID, poolID string, req *godo.KubernetesNodePoolRecycleNodesRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecycleNodePoolNodes", ctx, clusterID, poolID, req)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecycleNodePoolNodes indicates an expected call of RecycleNodePoolNodes.
+func (mr *MockKubernetesServiceMockRecorder) RecycleNodePoolNodes(ctx, clusterID, poolID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecycleNodePoolNodes", reflect.TypeOf((*MockKubernetesService)(nil).RecycleNodePoolNodes), ctx, clusterID, poolID, req)
+}
+
+// RemoveRegistry mocks base method.
+func (m *MockKubernetesService) RemoveRegistry(ctx context.Context, req *godo.KubernetesClusterRegistryRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRegistry", ctx, req)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRegistry indicates an expected call of RemoveRegistry.
+func (mr *MockKubernetesServiceMockRecorder) RemoveRegistry(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRegistry", reflect.TypeOf((*MockKubernetesService)(nil).RemoveRegistry), ctx, req)
+}
+
+// RunClusterlint mocks base method.
+func (m *MockKubernetesService) RunClusterlint(ctx context.Context, clusterID string, req *godo.KubernetesRunClusterlintRequest) (string, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunClusterlint", ctx, clusterID, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RunClusterlint indicates an expected call of RunClusterlint.
+func (mr *MockKubernetesServiceMockRecorder) RunClusterlint(ctx, clusterID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunClusterlint", reflect.TypeOf((*MockKubernetesService)(nil).RunClusterlint), ctx, clusterID, req)
+}
+
+// Update mocks base method.
+func (m *MockKubernetesService) Update(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterUpdateRequest) (*godo.KubernetesCluster, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.KubernetesCluster)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockKubernetesServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKubernetesService)(nil).Update), arg0, arg1, arg2)
+}
+
+// UpdateNodePool mocks base method.
+func (m *MockKubernetesService) UpdateNodePool(ctx context.Context, clusterID, poolID string, req *godo.KubernetesNodePoolUpdateRequest) (*godo.KubernetesNodePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodePool", ctx, clusterID, poolID, req)
+	ret0, _ := ret[0].(*godo.KubernetesNodePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateNodePool indicates an expected call of UpdateNodePool.
+func (mr *MockKubernetesServiceMockRecorder) UpdateNodePool(ctx, clusterID, poolID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodePool", reflect.TypeOf((*MockKubernetesService)(nil).UpdateNodePool), ctx, clusterID, poolID, req)
+}
+
+// Upgrade mocks base method.
+func (m *MockKubernetesService) Upgrade(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterUpgradeRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upgrade", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upgrade indicates an expected call of Upgrade.
+func (mr *MockKubernetesServiceMockRecorder) Upgrade(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockKubernetesService)(nil).Upgrade), arg0, arg1, arg2)
+}
+
+// MockTagsService is a mock of TagsService interface.
+type MockTagsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTagsServiceMockRecorder is the mock recorder for MockTagsService.
+type MockTagsServiceMockRecorder struct {
+	mock *MockTagsService
+}
+
+// NewMockTagsService creates a new mock instance.
+func NewMockTagsService(ctrl *gomock.Controller) *MockTagsService {
+	mock := &MockTagsService{ctrl: ctrl}
+	mock.recorder = &MockTagsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagsService) EXPECT() *MockTagsServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTagsService) Create(arg0 context.Context, arg1 *godo.TagCreateRequest) (*godo.Tag, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Tag)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTagsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTagsService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockTagsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTagsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagsService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockTagsService) Get(arg0 context.Context, arg1 string) (*godo.Tag, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Tag)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTagsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTagsService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockTagsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Tag, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Tag)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockTagsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTagsService)(nil).List), arg0, arg1)
+}
+
+// TagResources mocks base method.
+func (m *MockTagsService) TagResources(arg0 context.Context, arg1 string, arg2 *godo.TagResourcesRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResources indicates an expected call of TagResources.
+func (mr *MockTagsServiceMockRecorder) TagResources(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResources", reflect.TypeOf((*MockTagsService)(nil).TagResources), arg0, arg1, arg2)
+}
+
+// UntagResources mocks base method.
+func (m *MockTagsService) UntagResources(arg0 context.Context, arg1 string, arg2 *godo.UntagResourcesRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResources indicates an expected call of UntagResources.
+func (mr *MockTagsServiceMockRecorder) UntagResources(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResources", reflect.TypeOf((*MockTagsService)(nil).UntagResources), arg0, arg1, arg2)
+}
+
+// MockProjectsService is a mock of ProjectsService interface.
+type MockProjectsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectsServiceMockRecorder is the mock recorder for MockProjectsService.
+type MockProjectsServiceMockRecorder struct {
+	mock *MockProjectsService
+}
+
+// NewMockProjectsService creates a new mock instance.
+func NewMockProjectsService(ctrl *gomock.Controller) *MockProjectsService {
+	mock := &MockProjectsService{ctrl: ctrl}
+	mock.recorder = &MockProjectsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectsService) EXPECT() *MockProjectsServiceMockRecorder {
+	return m.recorder
+}
+
+// AssignResources mocks base method.
+func (m *MockProjectsService) AssignResources(arg0 context.Context, arg1 string, arg2 ...any) ([]godo.ProjectResource, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssignResources", varargs...)
+	ret0, _ := ret[0].([]godo.ProjectResource)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AssignResources indicates an expected call of AssignResources.
+func (mr *MockProjectsServiceMockRecorder) AssignResources(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignResources", reflect.TypeOf((*MockProjectsService)(nil).AssignResources), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockProjectsService) Create(arg0 context.Context, arg1 *godo.CreateProjectRequest) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectsService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockProjectsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProjectsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectsService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockProjectsService) Get(arg0 context.Context, arg1 string) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProjectsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProjectsService)(nil).Get), arg0, arg1)
+}
+
+// GetDefault mocks base method.
+func (m *MockProjectsService) GetDefault(arg0 context.Context) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefault", arg0)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDefault indicates an expected call of GetDefault.
+func (mr *MockProjectsServiceMockRecorder) GetDefault(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefault", reflect.TypeOf((*MockProjectsService)(nil).GetDefault), arg0)
+}
+
+// List mocks base method.
+func (m *MockProjectsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectsService)(nil).List), arg0, arg1)
+}
+
+// ListResources mocks base method.
+func (m *MockProjectsService) ListResources(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.ProjectResource, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.ProjectResource)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockProjectsServiceMockRecorder) ListResources(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockProjectsService)(nil).ListResources), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockProjectsService) Update(arg0 context.Context, arg1 string, arg2 *godo.UpdateProjectRequest) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProjectsServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectsService)(nil).Update), arg0, arg1, arg2)
+}
+
+// MockAccountService is a mock of AccountService interface.
+type MockAccountService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountServiceMockRecorder is the mock recorder for MockAccountService.
+type MockAccountServiceMockRecorder struct {
+	mock *MockAccountService
+}
+
+// NewMockAccountService creates a new mock instance.
+func NewMockAccountService(ctrl *gomock.Controller) *MockAccountService {
+	mock := &MockAccountService{ctrl: ctrl}
+	mock.recorder = &MockAccountServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountService) EXPECT() *MockAccountServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockAccountService) Get(arg0 context.Context) (*godo.Account, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*godo.Account)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAccountServiceMockRecorder) Get(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAccountService)(nil).Get), arg0)
+}
+
+// MockSizesService is a mock of SizesService interface.
+type MockSizesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSizesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSizesServiceMockRecorder is the mock recorder for MockSizesService.
+type MockSizesServiceMockRecorder struct {
+	mock *MockSizesService
+}
+
+// NewMockSizesService creates a new mock instance.
+func NewMockSizesService(ctrl *gomock.Controller) *MockSizesService {
+	mock := &MockSizesService{ctrl: ctrl}
+	mock.recorder = &MockSizesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSizesService) EXPECT() *MockSizesServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockSizesService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Size, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Size)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockSizesServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSizesService)(nil).List), arg0, arg1)
+}
+
+// MockStorageService is a mock of StorageService interface.
+type MockStorageService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageServiceMockRecorder is the mock recorder for MockStorageService.
+type MockStorageServiceMockRecorder struct {
+	mock *MockStorageService
+}
+
+// NewMockStorageService creates a new mock instance.
+func NewMockStorageService(ctrl *gomock.Controller) *MockStorageService {
+	mock := &MockStorageService{ctrl: ctrl}
+	mock.recorder = &MockStorageServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageService) EXPECT() *MockStorageServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockStorageService) CreateSnapshot(arg0 context.Context, arg1 *godo.SnapshotCreateRequest) (*godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockStorageServiceMockRecorder) CreateSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockStorageService)(nil).CreateSnapshot), arg0, arg1)
+}
+
+// CreateVolume mocks base method.
+func (m *MockStorageService) CreateVolume(arg0 context.Context, arg1 *godo.VolumeCreateRequest) (*godo.Volume, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolume", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Volume)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateVolume indicates an expected call of CreateVolume.
+func (mr *MockStorageServiceMockRecorder) CreateVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockStorageService)(nil).CreateVolume), arg0, arg1)
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockStorageService) DeleteSnapshot(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockStorageServiceMockRecorder) DeleteSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockStorageService)(nil).DeleteSnapshot), arg0, arg1)
+}
+
+// DeleteVolume mocks base method.
+func (m *MockStorageService) DeleteVolume(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockStorageServiceMockRecorder) DeleteVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockStorageService)(nil).DeleteVolume), arg0, arg1)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockStorageService) GetSnapshot(arg0 context.Context, arg1 string) (*godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockStorageServiceMockRecorder) GetSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockStorageService)(nil).GetSnapshot), arg0, arg1)
+}
+
+// GetVolume mocks base method.
+func (m *MockStorageService) GetVolume(arg0 context.Context, arg1 string) (*godo.Volume, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Volume)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockStorageServiceMockRecorder) GetVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockStorageService)(nil).GetVolume), arg0, arg1)
+}
+
+// ListSnapshots mocks base method.
+func (m *MockStorageService) ListSnapshots(ctx context.Context, volumeID string, opts *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshots", ctx, volumeID, opts)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockStorageServiceMockRecorder) ListSnapshots(ctx, volumeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockStorageService)(nil).ListSnapshots), ctx, volumeID, opts)
+}
+
+// ListVolumes mocks base method.
+func (m *MockStorageService) ListVolumes(arg0 context.Context, arg1 *godo.ListVolumeParams) ([]godo.Volume, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumes", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Volume)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVolumes indicates an expected call of ListVolumes.
+func (mr *MockStorageServiceMockRecorder) ListVolumes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockStorageService)(nil).ListVolumes), arg0, arg1)
+}
+
+// MockImagesService is a mock of ImagesService interface.
+type MockImagesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImagesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockImagesServiceMockRecorder is the mock recorder for MockImagesService.
+type MockImagesServiceMockRecorder struct {
+	mock *MockImagesService
+}
+
+// NewMockImagesService creates a new mock instance.
+func NewMockImagesService(ctrl *gomock.Controller) *MockImagesService {
+	mock := &MockImagesService{ctrl: ctrl}
+	mock.recorder = &MockImagesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImagesService) EXPECT() *MockImagesServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockImagesService) Create(arg0 context.Context, arg1 *godo.CustomImageCreateRequest) (*godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockImagesServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockImagesService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockImagesService) Delete(arg0 context.Context, arg1 int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockImagesServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockImagesService)(nil).Delete), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockImagesService) GetByID(arg0 context.Context, arg1 int) (*godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockImagesServiceMockRecorder) GetByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockImagesService)(nil).GetByID), arg0, arg1)
+}
+
+// GetBySlug mocks base method.
+func (m *MockImagesService) GetBySlug(arg0 context.Context, arg1 string) (*godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockImagesServiceMockRecorder) GetBySlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockImagesService)(nil).GetBySlug), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockImagesService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockImagesServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockImagesService)(nil).List), arg0, arg1)
+}
+
+// ListApplication mocks base method.
+func (m *MockImagesService) ListApplication(ctx context.Context, opt *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApplication", ctx, opt)
+	ret0, _ := ret[0].([]godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListApplication indicates an expected call of ListApplication.
+func (mr *MockImagesServiceMockRecorder) ListApplication(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplication", reflect.TypeOf((*MockImagesService)(nil).ListApplication), ctx, opt)
+}
+
+// ListByTag mocks base method.
+func (m *MockImagesService) ListByTag(ctx context.Context, tag string, opt *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTag", ctx, tag, opt)
+	ret0, _ := ret[0].([]godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByTag indicates an expected call of ListByTag.
+func (mr *MockImagesServiceMockRecorder) ListByTag(ctx, tag, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTag", reflect.TypeOf((*MockImagesService)(nil).ListByTag), ctx, tag, opt)
+}
+
+// ListDistribution mocks base method.
+func (m *MockImagesService) ListDistribution(ctx context.Context, opt *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDistribution", ctx, opt)
+	ret0, _ := ret[0].([]godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDistribution indicates an expected call of ListDistribution.
+func (mr *MockImagesServiceMockRecorder) ListDistribution(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDistribution", reflect.TypeOf((*MockImagesService)(nil).ListDistribution), ctx, opt)
+}
+
+// ListUser mocks base method.
+func (m *MockImagesService) ListUser(ctx context.Context, opt *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUser", ctx, opt)
+	ret0, _ := ret[0].([]godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUser indicates an expected call of ListUser.
+func (mr *MockImagesServiceMockRecorder) ListUser(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUser", reflect.TypeOf((*MockImagesService)(nil).ListUser), ctx, opt)
+}
+
+// Update mocks base method.
+func (m *MockImagesService) Update(arg0 context.Context, arg1 int, arg2 *godo.ImageUpdateRequest) (*godo.Image, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Image)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockImagesServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockImagesService)(nil).Update), arg0, arg1, arg2)
+}
+
+// MockCertificatesService is a mock of CertificatesService interface.
+type MockCertificatesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCertificatesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCertificatesServiceMockRecorder is the mock recorder for MockCertificatesService.
+type MockCertificatesServiceMockRecorder struct {
+	mock *MockCertificatesService
+}
+
+// NewMockCertificatesService creates a new mock instance.
+func NewMockCertificatesService(ctrl *gomock.Controller) *MockCertificatesService {
+	mock := &MockCertificatesService{ctrl: ctrl}
+	mock.recorder = &MockCertificatesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCertificatesService) EXPECT() *MockCertificatesServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCertificatesService) Create(arg0 context.Context, arg1 *godo.CertificateRequest) (*godo.Certificate, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Certificate)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCertificatesServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCertificatesService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockCertificatesService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCertificatesServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCertificatesService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockCertificatesService) Get(arg0 context.Context, arg1 string) (*godo.Certificate, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Certificate)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCertificatesServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCertificatesService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockCertificatesService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Certificate, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Certificate)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockCertificatesServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCertificatesService)(nil).List), arg0, arg1)
+}
+
+// ListByName mocks base method.
+func (m *MockCertificatesService) ListByName(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.Certificate, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Certificate)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByName indicates an expected call of ListByName.
+func (mr *MockCertificatesServiceMockRecorder) ListByName(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByName", reflect.TypeOf((*MockCertificatesService)(nil).ListByName), arg0, arg1, arg2)
+}
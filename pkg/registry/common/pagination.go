@@ -0,0 +1,29 @@
+package common
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+)
+
+// ListAll follows pagination until every item of a list endpoint has been read, requesting
+// perPage items per page. It never returns a nil slice, so an empty list renders as [].
+func ListAll[T any](ctx context.Context, perPage int, list func(context.Context, *godo.ListOptions) ([]T, *godo.Response, error)) ([]T, error) {
+	all := []T{}
+	opt := &godo.ListOptions{Page: 1, PerPage: perPage}
+	for {
+		items, resp, err := list(ctx, opt)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			return all, nil
+		}
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+		opt.Page = page + 1
+	}
+}
@@ -0,0 +1,48 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAll(t *testing.T) {
+	var pages []int
+	list := func(ctx context.Context, opt *godo.ListOptions) ([]int, *godo.Response, error) {
+		pages = append(pages, opt.Page)
+		require.Equal(t, 2, opt.PerPage)
+		p := &godo.Pages{}
+		if opt.Page > 1 {
+			p.Prev = fmt.Sprintf("https://api.digitalocean.com/v2/x?page=%d", opt.Page-1)
+		}
+		if opt.Page < 3 {
+			p.Next = fmt.Sprintf("https://api.digitalocean.com/v2/x?page=%d", opt.Page+1)
+			return []int{opt.Page*2 - 1, opt.Page * 2}, &godo.Response{Links: &godo.Links{Pages: p}}, nil
+		}
+		return []int{5}, &godo.Response{Links: &godo.Links{Pages: p}}, nil
+	}
+
+	items, err := ListAll(context.Background(), 2, list)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, items)
+	require.Equal(t, []int{1, 2, 3}, pages)
+}
+
+func TestListAll_error(t *testing.T) {
+	list := func(ctx context.Context, opt *godo.ListOptions) ([]int, *godo.Response, error) {
+		return nil, nil, errors.New("boom")
+	}
+	_, err := ListAll(context.Background(), 2, list)
+	require.EqualError(t, err, "boom")
+
+	empty := func(ctx context.Context, opt *godo.ListOptions) ([]int, *godo.Response, error) {
+		return nil, &godo.Response{}, nil
+	}
+	items, err := ListAll(context.Background(), 2, empty)
+	require.NoError(t, err)
+	require.NotNil(t, items)
+}
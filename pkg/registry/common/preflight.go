@@ -0,0 +1,224 @@
+package common
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+)
+
+const (
+	// catalogPageSize is the page size sizes and regions are listed with for the preflight checks.
+	catalogPageSize = 200
+	// maxVolumeGiB is the largest volume the API creates.
+	maxVolumeGiB = 16 * 1024
+	// dropletLimitIncreaseURL is where a higher droplet limit is requested.
+	dropletLimitIncreaseURL = "https://cloud.digitalocean.com/account/team/droplet_limit_increase"
+	// maxSuggestedSizes caps the larger sizes suggested for an image that doesn't fit.
+	maxSuggestedSizes = 5
+)
+
+// PreflightError is a create call the preflight checks expect the API to refuse. Its message
+// says what to change.
+type PreflightError struct {
+	Reason string
+}
+
+func (e *PreflightError) Error() string {
+	return "preflight check failed: " + e.Reason
+}
+
+func preflightErrorf(format string, args ...any) error {
+	return &PreflightError{Reason: fmt.Sprintf(format, args...)}
+}
+
+// PreflightDroplets checks that count droplets of size can be created in region: the account
+// stays within its droplet limit, the region accepts new droplets and offers the size. It fails
+// with a *PreflightError naming the way out, so the caller doesn't have to decipher the 422 of
+// the create request. Checks whose lookups fail are skipped; the create request still reports
+// what is wrong.
+func PreflightDroplets(ctx context.Context, client *godo.Client, size, region string, count int) error {
+	if account, _, err := client.Account.Get(ctx); err == nil && account.DropletLimit > 0 {
+		if _, resp, err := client.Droplets.List(ctx, &godo.ListOptions{PerPage: 1}); err == nil && resp != nil && resp.Meta != nil {
+			if existing := resp.Meta.Total; existing+count > account.DropletLimit {
+				return preflightErrorf("the account has %d of its %d droplets, so %d more would exceed the droplet limit; delete unused droplets or request a higher limit at %s",
+					existing, account.DropletLimit, count, dropletLimitIncreaseURL)
+			}
+		}
+	}
+
+	regions, err := ListAll(ctx, catalogPageSize, client.Regions.List)
+	if err != nil {
+		return nil
+	}
+	i := slices.IndexFunc(regions, func(r godo.Region) bool { return r.Slug == region })
+	if i < 0 {
+		return preflightErrorf("there is no region %q; use one of %s", region, strings.Join(availableRegions(regions, ""), ", "))
+	}
+	if !regions[i].Available {
+		return preflightErrorf("region %s is not accepting new droplets; use one of %s", region, strings.Join(availableRegions(regions, size), ", "))
+	}
+
+	sizes, err := ListAll(ctx, catalogPageSize, client.Sizes.List)
+	if err != nil {
+		return nil
+	}
+	j := slices.IndexFunc(sizes, func(s godo.Size) bool { return s.Slug == size })
+	switch {
+	case j < 0 && strings.HasPrefix(size, "gpu-"):
+		return preflightErrorf("there is no GPU droplet size %q; list the GPU sizes with gpu-size-list", size)
+	case j < 0:
+		return preflightErrorf("there is no droplet size %q; list the sizes with size-list", size)
+	case !sizes[j].Available:
+		return preflightErrorf("size %s is no longer offered for new droplets; pick another with size-list", size)
+	case sizes[j].GPUInfo != nil && sizes[j].GPUInfo.Count > 0 && (!slices.Contains(sizes[j].Regions, region) || !slices.Contains(regions[i].Sizes, size)):
+		// GPU sizes are only offered in a few regions, so name them rather than every region.
+		others := availableRegions(regions, size)
+		if len(others) == 0 {
+			return preflightErrorf("GPU size %s is out of capacity in every region; pick another with gpu-size-list", size)
+		}
+		return preflightErrorf("GPU size %s is not offered in %s; GPU droplets of this size can only be created in %s; see gpu-size-list for the GPU sizes of each region", size, region, strings.Join(others, ", "))
+	case !slices.Contains(sizes[j].Regions, region) || !slices.Contains(regions[i].Sizes, size):
+		others := availableRegions(regions, size)
+		if len(others) == 0 {
+			return preflightErrorf("size %s is out of capacity in every region; pick another with size-list", size)
+		}
+		return preflightErrorf("size %s is not available in %s; create it in one of %s, or pick another size with size-list", size, region, strings.Join(others, ", "))
+	}
+	return nil
+}
+
+// PreflightImage checks that a droplet of size can be created in region from image: the image
+// exists and is available, is stored in region and fits on the disk of size. Like
+// PreflightDroplets, it fails with a *PreflightError that lists the regions or sizes that would
+// work, and skips checks whose lookups fail.
+func PreflightImage(ctx context.Context, client *godo.Client, image godo.DropletCreateImage, size, region string) error {
+	var found *godo.Image
+	var resp *godo.Response
+	var err error
+	label := image.Slug
+	if image.Slug != "" {
+		found, resp, err = client.Images.GetBySlug(ctx, image.Slug)
+	} else {
+		label = strconv.Itoa(image.ID)
+		found, resp, err = client.Images.GetByID(ctx, image.ID)
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return preflightErrorf("there is no image %s; find a distribution image with image-resolve, or your snapshots with image-list", label)
+		}
+		return nil
+	}
+	if image.Slug == "" && found.Name != "" {
+		label = fmt.Sprintf("%d (%s)", image.ID, found.Name)
+	}
+
+	switch found.Status {
+	case "", "available":
+	case "retired", "deleted":
+		return preflightErrorf("image %s is %s; find a current one with image-resolve or image-list", label, found.Status)
+	default:
+		return preflightErrorf("image %s is not available yet (%s); wait until image-get shows it as available", label, found.Status)
+	}
+
+	if len(found.Regions) > 0 && !slices.Contains(found.Regions, region) {
+		regions, err := ListAll(ctx, catalogPageSize, client.Regions.List)
+		if err != nil {
+			return nil
+		}
+		var others []string
+		for _, slug := range availableRegions(regions, size) {
+			if slices.Contains(found.Regions, slug) {
+				others = append(others, slug)
+			}
+		}
+		transfer := ""
+		if !found.Public {
+			transfer = fmt.Sprintf(", or copy the image to %s with image-action-transfer", region)
+		}
+		if len(others) == 0 {
+			return preflightErrorf("image %s is not available in %s, and none of its regions (%s) offers size %s; pick another size with size-list%s",
+				label, region, strings.Join(found.Regions, ", "), size, transfer)
+		}
+		return preflightErrorf("image %s is not available in %s; create the droplet in one of %s%s", label, region, strings.Join(others, ", "), transfer)
+	}
+
+	if found.MinDiskSize > 0 {
+		sizes, err := ListAll(ctx, catalogPageSize, client.Sizes.List)
+		if err != nil {
+			return nil
+		}
+		j := slices.IndexFunc(sizes, func(s godo.Size) bool { return s.Slug == size })
+		if j < 0 || sizes[j].Disk >= found.MinDiskSize {
+			return nil
+		}
+		var larger []godo.Size
+		for _, s := range sizes {
+			if s.Available && s.Disk >= found.MinDiskSize && slices.Contains(s.Regions, region) {
+				larger = append(larger, s)
+			}
+		}
+		slices.SortStableFunc(larger, func(a, b godo.Size) int { return cmp.Compare(a.PriceMonthly, b.PriceMonthly) })
+		slugs := make([]string, 0, maxSuggestedSizes)
+		for _, s := range larger[:min(len(larger), maxSuggestedSizes)] {
+			slugs = append(slugs, s.Slug)
+		}
+		if len(slugs) == 0 {
+			return preflightErrorf("image %s needs a disk of at least %d GB, but size %s has %d GB and no size in %s has enough; pick another region with size-list",
+				label, found.MinDiskSize, size, sizes[j].Disk, region)
+		}
+		return preflightErrorf("image %s needs a disk of at least %d GB, but size %s has %d GB; use a larger size such as %s",
+			label, found.MinDiskSize, size, sizes[j].Disk, strings.Join(slugs, ", "))
+	}
+	return nil
+}
+
+// PreflightVolume checks that a volume of sizeGiB can be created in region: the size is within
+// the largest volume, the account stays within its volume limit and the region offers block
+// storage. Like PreflightDroplets, it fails with a *PreflightError and skips checks whose lookups
+// fail.
+func PreflightVolume(ctx context.Context, client *godo.Client, sizeGiB int64, region string) error {
+	if sizeGiB > maxVolumeGiB {
+		return preflightErrorf("volumes hold at most %d GiB, not %d; create several volumes instead", maxVolumeGiB, sizeGiB)
+	}
+	if account, _, err := client.Account.Get(ctx); err == nil && account.VolumeLimit > 0 {
+		_, resp, err := client.Storage.ListVolumes(ctx, &godo.ListVolumeParams{ListOptions: &godo.ListOptions{PerPage: 1}})
+		if err == nil && resp != nil && resp.Meta != nil && resp.Meta.Total >= account.VolumeLimit {
+			return preflightErrorf("the account has %d of its %d volumes, so another would exceed the volume limit; delete unused volumes, e.g. those audit-orphans reports, or ask support for a higher limit",
+				resp.Meta.Total, account.VolumeLimit)
+		}
+	}
+
+	regions, err := ListAll(ctx, catalogPageSize, client.Regions.List)
+	if err != nil {
+		return nil
+	}
+	i := slices.IndexFunc(regions, func(r godo.Region) bool { return r.Slug == region })
+	if i < 0 || !regions[i].Available || !slices.Contains(regions[i].Features, "storage") {
+		var storage []string
+		for _, r := range regions {
+			if r.Available && slices.Contains(r.Features, "storage") {
+				storage = append(storage, r.Slug)
+			}
+		}
+		return preflightErrorf("region %q does not offer volumes; use one of %s", region, strings.Join(storage, ", "))
+	}
+	return nil
+}
+
+// availableRegions returns the slugs of the regions accepting new droplets, of size when it is
+// not empty.
+func availableRegions(regions []godo.Region, size string) []string {
+	var slugs []string
+	for _, r := range regions {
+		if r.Available && (size == "" || slices.Contains(r.Sizes, size)) {
+			slugs = append(slugs, r.Slug)
+		}
+	}
+	return slugs
+}
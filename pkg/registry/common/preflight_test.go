@@ -0,0 +1,179 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var preflightRegions = []godo.Region{
+	{Slug: "nyc3", Available: true, Sizes: []string{"s-1vcpu-1gb"}, Features: []string{"storage"}},
+	{Slug: "ams3", Available: true, Sizes: []string{"s-1vcpu-1gb", "g-2vcpu-8gb"}, Features: []string{"storage"}},
+	{Slug: "tor1", Available: true, Sizes: []string{"gpu-h100x1-80gb"}, Features: []string{"storage"}},
+	{Slug: "nyc2", Available: false},
+}
+
+var preflightSizes = []godo.Size{
+	{Slug: "s-1vcpu-1gb", Available: true, Regions: []string{"nyc3", "ams3"}},
+	{Slug: "g-2vcpu-8gb", Available: true, Regions: []string{"ams3"}},
+	{Slug: "s-1vcpu-512mb", Available: false},
+	{Slug: "gpu-h100x1-80gb", Available: true, Regions: []string{"tor1"}, GPUInfo: &godo.GPUInfo{Count: 1, Model: "nvidia_h100"}},
+}
+
+func TestPreflightDroplets(t *testing.T) {
+	tests := []struct {
+		name       string
+		size       string
+		region     string
+		existing   int
+		accountErr error
+		wantErr    string
+	}{
+		{name: "Room in the region", size: "s-1vcpu-1gb", region: "nyc3", existing: 3},
+		{name: "Droplet limit reached", size: "s-1vcpu-1gb", region: "nyc3", existing: 10, wantErr: "exceed the droplet limit"},
+		{name: "Account lookup fails", size: "s-1vcpu-1gb", region: "nyc3", existing: 10, accountErr: errors.New("forbidden")},
+		{name: "Unknown region", size: "s-1vcpu-1gb", region: "xyz1", wantErr: `there is no region "xyz1"; use one of nyc3, ams3, tor1`},
+		{name: "Region closed to new droplets", size: "s-1vcpu-1gb", region: "nyc2", wantErr: "region nyc2 is not accepting new droplets; use one of nyc3, ams3"},
+		{name: "Size not in the region", size: "g-2vcpu-8gb", region: "nyc3", wantErr: "size g-2vcpu-8gb is not available in nyc3; create it in one of ams3"},
+		{name: "GPU size in its region", size: "gpu-h100x1-80gb", region: "tor1"},
+		{name: "GPU size not in the region", size: "gpu-h100x1-80gb", region: "nyc3", wantErr: "GPU size gpu-h100x1-80gb is not offered in nyc3; GPU droplets of this size can only be created in tor1"},
+		{name: "Unknown GPU size", size: "gpu-b200x9", region: "tor1", wantErr: `there is no GPU droplet size "gpu-b200x9"; list the GPU sizes with gpu-size-list`},
+		{name: "Retired size", size: "s-1vcpu-512mb", region: "nyc3", wantErr: "no longer offered"},
+		{name: "Unknown size", size: "s-64vcpu-1gb", region: "nyc3", wantErr: `there is no droplet size "s-64vcpu-1gb"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			account := NewMockAccountService(ctrl)
+			droplets := NewMockDropletsService(ctrl)
+			regions := NewMockRegionsService(ctrl)
+			sizes := NewMockSizesService(ctrl)
+			if tc.accountErr != nil {
+				account.EXPECT().Get(gomock.Any()).Return(nil, nil, tc.accountErr)
+			} else {
+				account.EXPECT().Get(gomock.Any()).Return(&godo.Account{DropletLimit: 10}, nil, nil)
+				droplets.EXPECT().List(gomock.Any(), &godo.ListOptions{PerPage: 1}).Return(nil, &godo.Response{Meta: &godo.Meta{Total: tc.existing}}, nil)
+			}
+			regions.EXPECT().List(gomock.Any(), gomock.Any()).Return(preflightRegions, nil, nil).AnyTimes()
+			sizes.EXPECT().List(gomock.Any(), gomock.Any()).Return(preflightSizes, nil, nil).AnyTimes()
+			client := &godo.Client{Account: account, Droplets: droplets, Regions: regions, Sizes: sizes}
+
+			err := PreflightDroplets(context.Background(), client, tc.size, tc.region, 1)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			var preflight *PreflightError
+			require.ErrorAs(t, err, &preflight)
+			require.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestPreflightImage(t *testing.T) {
+	sizes := []godo.Size{
+		{Slug: "s-1vcpu-1gb", Available: true, Disk: 25, PriceMonthly: 6, Regions: []string{"nyc3", "ams3"}},
+		{Slug: "s-2vcpu-4gb", Available: true, Disk: 80, PriceMonthly: 24, Regions: []string{"nyc3", "ams3"}},
+		{Slug: "s-1vcpu-2gb", Available: true, Disk: 50, PriceMonthly: 12, Regions: []string{"nyc3"}},
+		{Slug: "s-8vcpu-16gb", Available: false, Disk: 320, PriceMonthly: 96, Regions: []string{"nyc3"}},
+	}
+	snapshot := &godo.Image{ID: 7, Name: "web-snapshot", Status: "available", Regions: []string{"ams3"}, MinDiskSize: 25}
+	notFound := &godo.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	tests := []struct {
+		name    string
+		image   godo.DropletCreateImage
+		found   *godo.Image
+		resp    *godo.Response
+		err     error
+		size    string
+		region  string
+		wantErr string
+	}{
+		{name: "Image in the region", image: godo.DropletCreateImage{ID: 7}, found: snapshot, size: "s-1vcpu-1gb", region: "ams3"},
+		{name: "Distribution image", image: godo.DropletCreateImage{Slug: "ubuntu-24-04-x64"}, found: &godo.Image{Slug: "ubuntu-24-04-x64", Status: "available", Public: true, Regions: []string{"nyc3", "ams3"}, MinDiskSize: 7}, size: "s-1vcpu-1gb", region: "nyc3"},
+		{name: "Unknown image", image: godo.DropletCreateImage{Slug: "ubuntu-10-04-x64"}, resp: notFound, err: errors.New("not found"), size: "s-1vcpu-1gb", region: "nyc3",
+			wantErr: "there is no image ubuntu-10-04-x64; find a distribution image with image-resolve"},
+		{name: "Lookup fails", image: godo.DropletCreateImage{ID: 7}, err: errors.New("timeout"), size: "s-1vcpu-1gb", region: "nyc3"},
+		{name: "Retired image", image: godo.DropletCreateImage{Slug: "ubuntu-18-04-x64"}, found: &godo.Image{Slug: "ubuntu-18-04-x64", Status: "retired", Public: true}, size: "s-1vcpu-1gb", region: "nyc3",
+			wantErr: "image ubuntu-18-04-x64 is retired; find a current one with image-resolve or image-list"},
+		{name: "Pending image", image: godo.DropletCreateImage{ID: 7}, found: &godo.Image{ID: 7, Name: "upload", Status: "pending"}, size: "s-1vcpu-1gb", region: "nyc3",
+			wantErr: "image 7 (upload) is not available yet (pending)"},
+		{name: "Image in another region", image: godo.DropletCreateImage{ID: 7}, found: snapshot, size: "s-1vcpu-1gb", region: "nyc3",
+			wantErr: "image 7 (web-snapshot) is not available in nyc3; create the droplet in one of ams3, or copy the image to nyc3 with image-action-transfer"},
+		{name: "Image regions without the size", image: godo.DropletCreateImage{ID: 7}, found: snapshot, size: "gpu-h100x1-80gb", region: "nyc3",
+			wantErr: "image 7 (web-snapshot) is not available in nyc3, and none of its regions (ams3) offers size gpu-h100x1-80gb"},
+		{name: "Public image in another region", image: godo.DropletCreateImage{Slug: "wordpress"}, found: &godo.Image{Slug: "wordpress", Status: "available", Public: true, Regions: []string{"ams3"}}, size: "s-1vcpu-1gb", region: "nyc3",
+			wantErr: "image wordpress is not available in nyc3; create the droplet in one of ams3; "},
+		{name: "Disk too small", image: godo.DropletCreateImage{ID: 8}, found: &godo.Image{ID: 8, Name: "db", Status: "available", Regions: []string{"nyc3"}, MinDiskSize: 40}, size: "s-1vcpu-1gb", region: "nyc3",
+			wantErr: "image 8 (db) needs a disk of at least 40 GB, but size s-1vcpu-1gb has 25 GB; use a larger size such as s-1vcpu-2gb, s-2vcpu-4gb"},
+		{name: "No size large enough", image: godo.DropletCreateImage{ID: 8}, found: &godo.Image{ID: 8, Name: "db", Status: "available", Regions: []string{"ams3"}, MinDiskSize: 100}, size: "s-1vcpu-1gb", region: "ams3",
+			wantErr: "no size in ams3 has enough"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			images := NewMockImagesService(ctrl)
+			regions := NewMockRegionsService(ctrl)
+			sizesSvc := NewMockSizesService(ctrl)
+			if tc.image.Slug != "" {
+				images.EXPECT().GetBySlug(gomock.Any(), tc.image.Slug).Return(tc.found, tc.resp, tc.err)
+			} else {
+				images.EXPECT().GetByID(gomock.Any(), tc.image.ID).Return(tc.found, tc.resp, tc.err)
+			}
+			regions.EXPECT().List(gomock.Any(), gomock.Any()).Return(preflightRegions, nil, nil).AnyTimes()
+			sizesSvc.EXPECT().List(gomock.Any(), gomock.Any()).Return(sizes, nil, nil).AnyTimes()
+			client := &godo.Client{Images: images, Regions: regions, Sizes: sizesSvc}
+
+			err := PreflightImage(context.Background(), client, tc.image, tc.size, tc.region)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			var preflight *PreflightError
+			require.ErrorAs(t, err, &preflight)
+			require.Contains(t, err.Error()+"; ", tc.wantErr)
+		})
+	}
+}
+
+func TestPreflightVolume(t *testing.T) {
+	tests := []struct {
+		name     string
+		sizeGiB  int64
+		region   string
+		existing int
+		wantErr  string
+	}{
+		{name: "Room in the region", sizeGiB: 100, region: "nyc3", existing: 4},
+		{name: "Too large", sizeGiB: 20000, region: "nyc3", wantErr: "volumes hold at most 16384 GiB"},
+		{name: "Volume limit reached", sizeGiB: 100, region: "nyc3", existing: 10, wantErr: "exceed the volume limit"},
+		{name: "Region without block storage", sizeGiB: 100, region: "nyc2", wantErr: `region "nyc2" does not offer volumes; use one of nyc3, ams3, tor1`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			account := NewMockAccountService(ctrl)
+			storage := NewMockStorageService(ctrl)
+			regions := NewMockRegionsService(ctrl)
+			account.EXPECT().Get(gomock.Any()).Return(&godo.Account{VolumeLimit: 10}, nil, nil).AnyTimes()
+			storage.EXPECT().ListVolumes(gomock.Any(), gomock.Any()).Return(nil, &godo.Response{Meta: &godo.Meta{Total: tc.existing}}, nil).AnyTimes()
+			regions.EXPECT().List(gomock.Any(), gomock.Any()).Return(preflightRegions, nil, nil).AnyTimes()
+			client := &godo.Client{Account: account, Storage: storage, Regions: regions}
+
+			err := PreflightVolume(context.Background(), client, tc.sizeGiB, tc.region)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package common
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// NotifyProgress sends a progress notification for req when the client asked for them by
+// setting a progress token. progress must grow with every call; total may be 0 when unknown.
+// A client that went away doesn't fail the tool, so send errors are ignored.
+func NotifyProgress(ctx context.Context, req mcp.CallToolRequest, progress, total float64, message string) {
+	if req.Params.Meta == nil || req.Params.Meta.ProgressToken == nil {
+		return
+	}
+	srv := server.ServerFromContext(ctx)
+	if srv == nil {
+		return
+	}
+	params := map[string]any{
+		"progressToken": req.Params.Meta.ProgressToken,
+		"progress":      progress,
+		"message":       message,
+	}
+	if total > 0 {
+		params["total"] = total
+	}
+	_ = srv.SendNotificationToClient(ctx, "notifications/progress", params)
+}
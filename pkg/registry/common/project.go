@@ -0,0 +1,66 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ProjectArg is the argument of create tools that assigns the new resource to a project.
+const ProjectArg = "Project"
+
+// projectsPageSize is the page size projects are listed with to find one by name.
+const projectsPageSize = 200
+
+// WithProject declares the Project argument of a create tool of kind, e.g. "droplet".
+func WithProject(kind string) mcp.ToolOption {
+	return mcp.WithString(ProjectArg, mcp.Description(fmt.Sprintf(
+		"Name or ID of the project to assign the new %s to. Defaults to the default project.", kind)))
+}
+
+// ResolveProject returns the ID of the project whose ID or name is project. It is called before
+// a resource is created, so an unknown project fails the call without creating anything.
+func ResolveProject(ctx context.Context, client *godo.Client, project string) (string, error) {
+	project = strings.TrimSpace(project)
+	projects, err := ListAll(ctx, projectsPageSize, client.Projects.List)
+	if err != nil {
+		return "", err
+	}
+	var named []godo.Project
+	for _, p := range projects {
+		if p.ID == project {
+			return p.ID, nil
+		}
+		if p.Name == project {
+			named = append(named, p)
+		}
+	}
+	switch len(named) {
+	case 0:
+		return "", fmt.Errorf("no project has the name or ID %q", project)
+	case 1:
+		return named[0].ID, nil
+	}
+	ids := make([]string, len(named))
+	for i, p := range named {
+		ids[i] = p.ID
+	}
+	return "", fmt.Errorf("%d projects are named %q (IDs %s); pass one of the IDs as Project", len(named), project, strings.Join(ids, ", "))
+}
+
+// AssignCreated assigns the resource with urn, which was just created, to the project with ID
+// projectID. When the assignment fails the resource is deleted with undo, so the caller ends up
+// with either a resource in the project or no resource at all; the error says which.
+func AssignCreated(ctx context.Context, client *godo.Client, projectID, urn string, undo func(ctx context.Context) error) error {
+	_, _, err := client.Projects.AssignResources(ctx, projectID, urn)
+	if err == nil {
+		return nil
+	}
+	if undoErr := undo(ctx); undoErr != nil {
+		return fmt.Errorf("%s was created but not assigned to project %s, and deleting it failed too (%v): %w", urn, projectID, undoErr, err)
+	}
+	return fmt.Errorf("%s could not be assigned to project %s and was deleted again: %w", urn, projectID, err)
+}
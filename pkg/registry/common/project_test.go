@@ -0,0 +1,92 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResolveProject(t *testing.T) {
+	projects := []godo.Project{
+		{ID: "p-1", Name: "web"},
+		{ID: "p-2", Name: "staging"},
+		{ID: "p-3", Name: "staging"},
+	}
+	tests := []struct {
+		name        string
+		project     string
+		expected    string
+		expectError string
+	}{
+		{name: "By ID", project: "p-2", expected: "p-2"},
+		{name: "By name", project: " web ", expected: "p-1"},
+		{name: "Ambiguous name", project: "staging", expectError: `2 projects are named "staging" (IDs p-2, p-3)`},
+		{name: "Unknown", project: "prod", expectError: `no project has the name or ID "prod"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProjects := NewMockProjectsService(ctrl)
+			mockProjects.EXPECT().List(gomock.Any(), &godo.ListOptions{Page: 1, PerPage: projectsPageSize}).Return(projects, nil, nil)
+
+			id, err := ResolveProject(context.Background(), &godo.Client{Projects: mockProjects}, tc.project)
+			if tc.expectError != "" {
+				require.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, id)
+		})
+	}
+}
+
+func TestAssignCreated(t *testing.T) {
+	tests := []struct {
+		name        string
+		assignErr   error
+		undoErr     error
+		undone      bool
+		expectError string
+	}{
+		{name: "Assigned"},
+		{
+			name:        "Assignment fails and the resource is deleted",
+			assignErr:   errors.New("forbidden"),
+			undone:      true,
+			expectError: "do:droplet:1 could not be assigned to project p-1 and was deleted again: forbidden",
+		},
+		{
+			name:        "Deleting fails too",
+			assignErr:   errors.New("forbidden"),
+			undoErr:     errors.New("locked"),
+			undone:      true,
+			expectError: "do:droplet:1 was created but not assigned to project p-1, and deleting it failed too (locked): forbidden",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProjects := NewMockProjectsService(ctrl)
+			mockProjects.EXPECT().AssignResources(gomock.Any(), "p-1", "do:droplet:1").Return(nil, nil, tc.assignErr)
+
+			undone := false
+			err := AssignCreated(context.Background(), &godo.Client{Projects: mockProjects}, "p-1", "do:droplet:1", func(ctx context.Context) error {
+				undone = true
+				return tc.undoErr
+			})
+			require.Equal(t, tc.undone, undone)
+			if tc.expectError != "" {
+				require.EqualError(t, err, tc.expectError)
+				require.ErrorIs(t, err, tc.assignErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -67,8 +67,8 @@ func (r *RegionTools) Tools() []server.ServerTool {
 			Tool: mcp.NewTool(
 				"region-list",
 				mcp.WithDescription("List all available regions with features and droplet size availability. Supports pagination."),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultRegionsPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultRegionsPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultRegionsPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultRegionsPageSize), mcp.Description("Items per page")),
 			),
 		},
 	}
@@ -0,0 +1,49 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// NewToolResultStructured returns a tool result that carries v both as structured
+// content and as pretty-printed JSON text. Clients that understand MCP structured
+// output can validate the result against the tool's output schema, while older
+// clients keep receiving the same text payload as before.
+func NewToolResultStructured(v any) (*mcp.CallToolResult, error) {
+	jsonData, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultStructured(v, string(jsonData)), nil
+}
+
+// IfNotExistsArg is the argument of create tools that returns an existing resource of the same
+// name instead of creating another one.
+const IfNotExistsArg = "IfNotExists"
+
+// ExistingMetaKey is the _meta field set on the result of a create tool that returned an existing
+// resource instead of creating one.
+const ExistingMetaKey = "digitalocean.com/existing"
+
+// WithIfNotExists declares the IfNotExists argument of a create tool of kind, e.g. "droplet".
+func WithIfNotExists(kind string) mcp.ToolOption {
+	return mcp.WithBoolean(IfNotExistsArg, mcp.DefaultBool(false), mcp.Description(fmt.Sprintf(
+		"Look up a %[1]s with the same name first and return it, unchanged, instead of creating another %[1]s. Makes retried calls safe.", kind)))
+}
+
+// ExistingResult marks res, the result of a create tool holding an existing resource, so the
+// caller can tell that nothing was created: note is added as text and ExistingMetaKey is set.
+func ExistingResult(res *mcp.CallToolResult, note string) *mcp.CallToolResult {
+	res.Content = append(res.Content, mcp.NewTextContent(note))
+	if res.Meta == nil {
+		res.Meta = &mcp.Meta{}
+	}
+	if res.Meta.AdditionalFields == nil {
+		res.Meta.AdditionalFields = map[string]any{}
+	}
+	res.Meta.AdditionalFields[ExistingMetaKey] = true
+	return res
+}
@@ -0,0 +1,24 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewToolResultStructured(t *testing.T) {
+	action := &godo.Action{ID: 42, Status: "in-progress", Type: "reboot"}
+
+	result, err := NewToolResultStructured(action)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Equal(t, action, result.StructuredContent)
+
+	var out godo.Action
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &out))
+	require.Equal(t, action.ID, out.ID)
+	require.Equal(t, action.Status, out.Status)
+}
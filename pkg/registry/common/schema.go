@@ -0,0 +1,104 @@
+package common
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/invopop/jsonschema"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+var (
+	timestampType = reflect.TypeOf(godo.Timestamp{})
+	timeType      = reflect.TypeOf(time.Time{})
+)
+
+// WithOutputSchema declares the JSON schema of T as the tool's output schema.
+//
+// Unlike mcp.WithOutputSchema, the schema is shaped after how godo types actually
+// serialize: timestamps are date-time strings, nothing is marked required, and every
+// nested property accepts null because nil pointers and slices are marshaled as null.
+func WithOutputSchema[T any]() mcp.ToolOption {
+	var zero T
+	schema := outputSchemaFor(zero)
+	return func(t *mcp.Tool) {
+		if schema != nil {
+			t.RawOutputSchema = schema
+		}
+	}
+}
+
+// NewToolWithRawSchema is mcp.NewToolWithRawSchema, which takes no options, with the options
+// applied to the tool it returns.
+func NewToolWithRawSchema(name, description string, schema json.RawMessage, opts ...mcp.ToolOption) mcp.Tool {
+	tool := mcp.NewToolWithRawSchema(name, description, schema)
+	for _, opt := range opts {
+		opt(&tool)
+	}
+	return tool
+}
+
+// outputSchemaFor reflects v into an MCP compliant output schema. It returns nil when
+// the schema cannot be produced, in which case the tool is left without an output schema.
+func outputSchemaFor(v any) json.RawMessage {
+	reflector := jsonschema.Reflector{
+		Anonymous:                  true,
+		AllowAdditionalProperties:  true,
+		RequiredFromJSONSchemaTags: true,
+		DoNotReference:             true,
+		Mapper: func(t reflect.Type) *jsonschema.Schema {
+			if t == timestampType || t == timeType {
+				return &jsonschema.Schema{Type: "string", Format: "date-time"}
+			}
+			return nil
+		},
+	}
+
+	schema := reflector.Reflect(v)
+	schema.Version = ""
+
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return nil
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil
+	}
+
+	// The MCP spec requires the root of an output schema to be an object.
+	m["type"] = "object"
+	if props, ok := m["properties"].(map[string]any); ok {
+		for _, p := range props {
+			allowNull(p)
+		}
+	}
+
+	out, err := json.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	return out
+}
+
+// allowNull widens the type of a schema node and all of its descendants to also accept null.
+func allowNull(node any) {
+	s, ok := node.(map[string]any)
+	if !ok {
+		return
+	}
+	if t, ok := s["type"].(string); ok {
+		s["type"] = []any{t, "null"}
+	}
+	if props, ok := s["properties"].(map[string]any); ok {
+		for _, p := range props {
+			allowNull(p)
+		}
+	}
+	if items, ok := s["items"]; ok {
+		allowNull(items)
+	}
+}
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithOutputSchema(t *testing.T) {
+	tool := mcp.NewTool("action-get", WithOutputSchema[godo.Action]())
+	require.NotNil(t, tool.RawOutputSchema)
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(tool.RawOutputSchema, &schema))
+	require.Equal(t, "object", schema["type"])
+	require.NotContains(t, schema, "required")
+
+	props := schema["properties"].(map[string]any)
+	startedAt := props["started_at"].(map[string]any)
+	require.Equal(t, []any{"string", "null"}, startedAt["type"])
+	require.Equal(t, "date-time", startedAt["format"])
+
+	region := props["region"].(map[string]any)
+	require.Equal(t, []any{"object", "null"}, region["type"])
+	slug := region["properties"].(map[string]any)["slug"].(map[string]any)
+	require.Equal(t, []any{"string", "null"}, slug["type"])
+}
+
+func TestWithOutputSchema_MarshalsTool(t *testing.T) {
+	tool := mcp.NewTool("droplet-get", WithOutputSchema[godo.Droplet]())
+
+	data, err := json.Marshal(tool)
+	require.NoError(t, err)
+
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(data, &out))
+	require.Contains(t, out, "outputSchema")
+}
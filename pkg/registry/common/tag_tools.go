@@ -0,0 +1,188 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"mcp-digitalocean/internal/fanout"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// tagBatchSize is the number of resources tagged by one TagResources call.
+	tagBatchSize = 50
+	// maxBulkTagResources caps the resources of one tag-apply-bulk call.
+	maxBulkTagResources = 1000
+)
+
+// tagResourceTypes maps the URN types that can be tagged to the resource types of the tags API.
+var tagResourceTypes = map[string]godo.ResourceType{
+	"droplet": godo.DropletResourceType,
+	"image":   godo.ImageResourceType,
+	"volume":  godo.VolumeResourceType,
+	"dbaas":   godo.DatabaseResourceType,
+}
+
+// tagNamePattern matches the tag names the API accepts.
+var tagNamePattern = regexp.MustCompile(`^[A-Za-z0-9:_-]{1,255}$`)
+
+// TagBatch is a batch of resources one tag is applied to by one TagResources call.
+type TagBatch struct {
+	Tag  string   `json:"tag"`
+	URNs []string `json:"urns"`
+}
+
+// BulkTagResult is the result of the tag-apply-bulk tool: the tags it created, the batches that
+// were tagged and the batches that failed. A tag that could not be created fails as one batch of
+// every resource.
+type BulkTagResult struct {
+	Resources int      `json:"resources"`
+	Created   []string `json:"created"`
+	fanout.Batch[TagBatch, TagBatch]
+}
+
+// TagTools provides the tool that tags many resources at once.
+type TagTools struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+// NewTagTools creates a new TagTools instance.
+func NewTagTools(client func(ctx context.Context) (*godo.Client, error)) *TagTools {
+	return &TagTools{client: client}
+}
+
+// applyTagsBulk applies each tag to every resource, creating missing tags first. Resources are
+// tagged in batches of tagBatchSize; a failed batch is reported and the remaining ones are still sent.
+func (t *TagTools) applyTagsBulk(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := NewArgs(req)
+	urns := args.RequiredStrings("URNs")
+	tags := args.RequiredStrings("Tags")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var problems []string
+	if len(urns) == 0 {
+		problems = append(problems, "URNs must name at least one resource")
+	}
+	if len(urns) > maxBulkTagResources {
+		problems = append(problems, fmt.Sprintf("URNs names %d resources, at most %d can be tagged in one call", len(urns), maxBulkTagResources))
+	}
+	if len(tags) == 0 {
+		problems = append(problems, "Tags must name at least one tag")
+	}
+	for _, tag := range tags {
+		if !tagNamePattern.MatchString(tag) {
+			problems = append(problems, fmt.Sprintf("tag %q may only contain letters, numbers, colons, dashes and underscores", tag))
+		}
+	}
+	var resources []godo.Resource
+	var resourceURNs []string
+	for _, urn := range urns {
+		resourceType, id, err := ParseURN(urn)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		apiType, ok := tagResourceTypes[resourceType]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("URN %q: %s resources can't be tagged; supported types are %s", urn, resourceType, strings.Join(taggableTypes(), ", ")))
+			continue
+		}
+		normalized := fmt.Sprintf("do:%s:%s", resourceType, id)
+		if slices.Contains(resourceURNs, normalized) {
+			continue
+		}
+		resources = append(resources, godo.Resource{ID: id, Type: apiType})
+		resourceURNs = append(resourceURNs, normalized)
+	}
+	if len(problems) > 0 {
+		return mcp.NewToolResultError("invalid arguments: " + strings.Join(problems, "; ")), nil
+	}
+
+	client, err := t.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	result := BulkTagResult{Resources: len(resources), Created: []string{}, Batch: fanout.NewBatch[TagBatch, TagBatch]()}
+	for _, tag := range slices.Compact(slices.Sorted(slices.Values(tags))) {
+		created, err := ensureTag(ctx, client, tag)
+		if err != nil {
+			result.Fail(TagBatch{Tag: tag, URNs: resourceURNs}, fmt.Errorf("failed to create tag: %w", err))
+			continue
+		}
+		if created {
+			result.Created = append(result.Created, tag)
+		}
+		for start := 0; start < len(resources); start += tagBatchSize {
+			end := min(start+tagBatchSize, len(resources))
+			batch := TagBatch{Tag: tag, URNs: resourceURNs[start:end]}
+			if _, err := client.Tags.TagResources(ctx, tag, &godo.TagResourcesRequest{Resources: resources[start:end]}); err != nil {
+				result.Fail(batch, err)
+				continue
+			}
+			result.Succeed(batch)
+		}
+	}
+
+	res, err := NewToolResultStructured(result)
+	if err != nil {
+		return nil, err
+	}
+	// a partly applied call is still reported in full, so the failed batches can be retried.
+	res.IsError = len(result.Failed) > 0
+	return res, nil
+}
+
+// ensureTag creates tag unless it exists, and reports whether it was created.
+func ensureTag(ctx context.Context, client *godo.Client, tag string) (bool, error) {
+	_, resp, err := client.Tags.Get(ctx, tag)
+	if err == nil {
+		return false, nil
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		return false, err
+	}
+	if _, _, err := client.Tags.Create(ctx, &godo.TagCreateRequest{Name: tag}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// taggableTypes returns the sorted URN types tag-apply-bulk accepts.
+func taggableTypes() []string {
+	types := make([]string, 0, len(tagResourceTypes))
+	for t := range tagResourceTypes {
+		types = append(types, t)
+	}
+	slices.Sort(types)
+	return types
+}
+
+// Tools returns the list of server tools for tags.
+func (t *TagTools) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: t.applyTagsBulk,
+			Tool: mcp.NewTool(
+				"tag-apply-bulk",
+				mcp.WithDescription(fmt.Sprintf("Apply one or more tags to many resources, given by URNs such as do:droplet:123, in batches of %d. "+
+					"Missing tags are created. Supported types: %s. Reports the tags created, the batches tagged under succeeded and "+
+					"the batches that failed with their errors under failed; the call is an error if any batch failed.", tagBatchSize, strings.Join(taggableTypes(), ", "))),
+				WithOutputSchema[BulkTagResult](),
+				mcp.WithArray("URNs", mcp.Required(), mcp.Description(fmt.Sprintf("URNs of the resources to tag, at most %d", maxBulkTagResources)),
+					mcp.Items(map[string]any{"type": "string", "pattern": `^do:[A-Za-z]+:.+$`})),
+				mcp.WithArray("Tags", mcp.Required(), mcp.Description("Names of the tags to apply, e.g. env:prod"),
+					mcp.Items(map[string]any{"type": "string"})),
+			),
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTagToolsWithMock(tags *MockTagsService) *TagTools {
+	return NewTagTools(func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{Tags: tags}, nil
+	})
+}
+
+func callApplyTagsBulk(t *testing.T, tool *TagTools, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	res, err := tool.applyTagsBulk(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	return res
+}
+
+func TestTagTools_applyTagsBulk(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tags := NewMockTagsService(ctrl)
+	tool := setupTagToolsWithMock(tags)
+
+	urns := []any{"do:volume:vol-1", "do:Image:7"}
+	for i := 1; i <= tagBatchSize; i++ {
+		urns = append(urns, fmt.Sprintf("do:droplet:%d", i))
+	}
+	// duplicates are tagged once.
+	urns = append(urns, "do:droplet:1")
+
+	notFound := &godo.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	tags.EXPECT().Get(gomock.Any(), "env:prod").Return(&godo.Tag{Name: "env:prod"}, &godo.Response{}, nil)
+	tags.EXPECT().Get(gomock.Any(), "team").Return(nil, notFound, errors.New("not found"))
+	tags.EXPECT().Create(gomock.Any(), &godo.TagCreateRequest{Name: "team"}).Return(&godo.Tag{Name: "team"}, &godo.Response{}, nil)
+
+	var batches [][]godo.Resource
+	tags.EXPECT().TagResources(gomock.Any(), "env:prod", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, req *godo.TagResourcesRequest) (*godo.Response, error) {
+			batches = append(batches, req.Resources)
+			return &godo.Response{}, nil
+		}).Times(2)
+	// the second batch of team fails; the first is still reported as tagged.
+	tags.EXPECT().TagResources(gomock.Any(), "team", gomock.Any()).Return(&godo.Response{}, nil)
+	tags.EXPECT().TagResources(gomock.Any(), "team", gomock.Any()).Return(nil, errors.New("droplet 50 not found"))
+
+	res := callApplyTagsBulk(t, tool, map[string]any{"URNs": urns, "Tags": []any{"team", "env:prod", "team"}})
+	require.True(t, res.IsError)
+	result := res.StructuredContent.(BulkTagResult)
+	require.Equal(t, tagBatchSize+2, result.Resources)
+	require.Equal(t, []string{"team"}, result.Created)
+
+	require.Len(t, batches[0], tagBatchSize)
+	require.Equal(t, godo.Resource{ID: "vol-1", Type: godo.VolumeResourceType}, batches[0][0])
+	require.Equal(t, godo.Resource{ID: "7", Type: godo.ImageResourceType}, batches[0][1])
+	require.Len(t, batches[1], 2)
+
+	require.Len(t, result.Succeeded, 3)
+	require.Equal(t, "env:prod", result.Succeeded[0].Tag)
+	require.Len(t, result.Succeeded[0].URNs, tagBatchSize)
+	require.Equal(t, "env:prod", result.Succeeded[1].Tag)
+	require.Len(t, result.Succeeded[1].URNs, 2)
+	require.Equal(t, "team", result.Succeeded[2].Tag)
+	require.Len(t, result.Succeeded[2].URNs, tagBatchSize)
+
+	require.Len(t, result.Failed, 1)
+	require.Equal(t, TagBatch{Tag: "team", URNs: []string{"do:droplet:49", "do:droplet:50"}}, result.Failed[0].Item)
+	require.Equal(t, "droplet 50 not found", result.Failed[0].Error)
+}
+
+func TestTagTools_applyTagsBulk_invalidArguments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tool := setupTagToolsWithMock(NewMockTagsService(ctrl))
+
+	res := callApplyTagsBulk(t, tool, map[string]any{
+		"URNs": []any{"do:droplet:1", "do:kubernetes:abc", "droplet:2"},
+		"Tags": []any{"has space"},
+	})
+	require.True(t, res.IsError)
+	text := res.Content[0].(mcp.TextContent).Text
+	require.Contains(t, text, `tag "has space"`)
+	require.Contains(t, text, "kubernetes resources can't be tagged")
+	require.Contains(t, text, `URN "droplet:2" is not of the form`)
+
+	res = callApplyTagsBulk(t, tool, map[string]any{"URNs": []any{}, "Tags": []any{"env"}})
+	require.True(t, res.IsError)
+}
@@ -0,0 +1,160 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// errURNID reports a URN whose ID is malformed for its type.
+var errURNID = errors.New("invalid URN ID")
+
+// urnGetter fetches the resource a URN of one type refers to.
+type urnGetter func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error)
+
+// urnGetters maps the resource types of DigitalOcean URNs, as in do:<type>:<id>, to the get call
+// of the resource.
+var urnGetters = map[string]urnGetter{
+	"droplet": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		dropletID, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: droplet ID %q is not a number", errURNID, id)
+		}
+		return client.Droplets.Get(ctx, dropletID)
+	},
+	"image": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		imageID, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: image ID %q is not a number", errURNID, id)
+		}
+		return client.Images.GetByID(ctx, imageID)
+	},
+	"volume": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		return client.Storage.GetVolume(ctx, id)
+	},
+	"snapshot": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		return client.Snapshots.Get(ctx, id)
+	},
+	"floatingip": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		return client.ReservedIPs.Get(ctx, id)
+	},
+	"reservedip": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		return client.ReservedIPs.Get(ctx, id)
+	},
+	"loadbalancer": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		return client.LoadBalancers.Get(ctx, id)
+	},
+	"domain": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		return client.Domains.Get(ctx, id)
+	},
+	"firewall": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		return client.Firewalls.Get(ctx, id)
+	},
+	"vpc": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		return client.VPCs.Get(ctx, id)
+	},
+	"kubernetes": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		return client.Kubernetes.Get(ctx, id)
+	},
+	"dbaas": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		return client.Databases.Get(ctx, id)
+	},
+	"app": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		return client.Apps.Get(ctx, id)
+	},
+	"project": func(ctx context.Context, client *godo.Client, id string) (any, *godo.Response, error) {
+		return client.Projects.Get(ctx, id)
+	},
+}
+
+// ResolvedURN is a URN together with the resource it refers to.
+type ResolvedURN struct {
+	URN      string `json:"urn"`
+	Type     string `json:"type"`
+	ID       string `json:"id"`
+	Resource any    `json:"resource"`
+}
+
+// URNTypes returns the sorted resource types that resolve-urn supports.
+func URNTypes() []string {
+	types := make([]string, 0, len(urnGetters))
+	for t := range urnGetters {
+		types = append(types, t)
+	}
+	slices.Sort(types)
+	return types
+}
+
+// ParseURN splits a DigitalOcean URN of the form do:<type>:<id> into its type and ID.
+func ParseURN(urn string) (string, string, error) {
+	parts := strings.SplitN(strings.TrimSpace(urn), ":", 3)
+	if len(parts) != 3 || parts[0] != "do" || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("URN %q is not of the form do:<type>:<id>", urn)
+	}
+	return strings.ToLower(parts[1]), parts[2], nil
+}
+
+// URNTools provides the tool that follows URN references between resources.
+type URNTools struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+// NewURNTools creates a new URNTools instance.
+func NewURNTools(client func(ctx context.Context) (*godo.Client, error)) *URNTools {
+	return &URNTools{client: client}
+}
+
+// resolveURN fetches the resource a URN refers to with the get call of its type.
+func (u *URNTools) resolveURN(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := NewArgs(req)
+	urn := args.RequiredString("URN")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	resourceType, id, err := ParseURN(urn)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	get, ok := urnGetters[resourceType]
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("URN type %q is not supported; supported types are %s",
+			resourceType, strings.Join(URNTypes(), ", "))), nil
+	}
+
+	client, err := u.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	resource, _, err := get(ctx, client, id)
+	if errors.Is(err, errURNID) {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return NewToolResultStructured(ResolvedURN{URN: urn, Type: resourceType, ID: id, Resource: resource})
+}
+
+// Tools returns the list of server tools for URNs.
+func (u *URNTools) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: u.resolveURN,
+			Tool: mcp.NewTool(
+				"resolve-urn",
+				mcp.WithDescription("Fetch the resource a DigitalOcean URN such as do:droplet:123 or do:kubernetes:<uuid> refers to, "+
+					"e.g. to follow the resources of a project or firewall. Supported types: "+strings.Join(URNTypes(), ", ")),
+				WithOutputSchema[ResolvedURN](),
+				mcp.WithString("URN", mcp.Required(), mcp.Pattern(`^do:[A-Za-z]+:.+$`), mcp.Description("URN of the resource, of the form do:<type>:<id>")),
+			),
+		},
+	}
+}
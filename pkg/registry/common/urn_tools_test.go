@@ -0,0 +1,110 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParseURN(t *testing.T) {
+	resourceType, id, err := ParseURN("do:Droplet:123")
+	require.NoError(t, err)
+	require.Equal(t, "droplet", resourceType)
+	require.Equal(t, "123", id)
+
+	// IDs such as reserved IPs and domains keep any colons after the type.
+	_, id, err = ParseURN("do:reservedip:2001:db8::1")
+	require.NoError(t, err)
+	require.Equal(t, "2001:db8::1", id)
+
+	for _, urn := range []string{"droplet:123", "do:droplet", "do::123", "aws:droplet:123"} {
+		_, _, err := ParseURN(urn)
+		require.Error(t, err, urn)
+	}
+}
+
+func TestURNTools_resolveURN(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		urn         string
+		mockSetup   func(*MockDropletsService, *MockKubernetesService)
+		expectType  string
+		expectError bool
+	}{
+		{
+			name: "Droplet",
+			urn:  "do:droplet:123",
+			mockSetup: func(d *MockDropletsService, k *MockKubernetesService) {
+				d.EXPECT().Get(gomock.Any(), 123).Return(&godo.Droplet{ID: 123, Name: "web-1"}, nil, nil).Times(1)
+			},
+			expectType: "droplet",
+		},
+		{
+			name: "Kubernetes cluster",
+			urn:  "do:kubernetes:3b5e1f0a-uuid",
+			mockSetup: func(d *MockDropletsService, k *MockKubernetesService) {
+				k.EXPECT().Get(gomock.Any(), "3b5e1f0a-uuid").Return(&godo.KubernetesCluster{ID: "3b5e1f0a-uuid", Name: "prod"}, nil, nil).Times(1)
+			},
+			expectType: "kubernetes",
+		},
+		{
+			name:        "Non-numeric droplet ID",
+			urn:         "do:droplet:web-1",
+			expectError: true,
+		},
+		{
+			name:        "Unsupported type",
+			urn:         "do:space:my-bucket",
+			expectError: true,
+		},
+		{
+			name:        "Malformed URN",
+			urn:         "droplet-123",
+			expectError: true,
+		},
+		{
+			name: "API error",
+			urn:  "do:droplet:456",
+			mockSetup: func(d *MockDropletsService, k *MockKubernetesService) {
+				d.EXPECT().Get(gomock.Any(), 456).Return(nil, nil, errors.New("not found")).Times(1)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDroplets := NewMockDropletsService(ctrl)
+			mockKubernetes := NewMockKubernetesService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockDroplets, mockKubernetes)
+			}
+			tool := NewURNTools(func(ctx context.Context) (*godo.Client, error) {
+				return &godo.Client{Droplets: mockDroplets, Kubernetes: mockKubernetes}, nil
+			})
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"URN": tc.urn}}}
+			resp, err := tool.resolveURN(context.Background(), req)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			var out ResolvedURN
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &out))
+			require.Equal(t, tc.urn, out.URN)
+			require.Equal(t, tc.expectType, out.Type)
+			require.NotNil(t, out.Resource)
+		})
+	}
+}
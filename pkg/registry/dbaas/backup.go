@@ -0,0 +1,188 @@
+package dbaas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// backupsPageSize is the page size the backups of a cluster are listed with.
+const backupsPageSize = 200
+
+// pitrEngines are the engines whose clusters can be restored to any point in time since their
+// oldest backup, not only to the time of a backup.
+var pitrEngines = []string{"pg", "mysql"}
+
+// BackupList is the result of db-backup-list: the backups of a cluster, newest first, and the
+// times it can be restored to.
+type BackupList struct {
+	ClusterID            string                `json:"cluster_id"`
+	ClusterName          string                `json:"cluster_name"`
+	Engine               string                `json:"engine"`
+	PointInTimeRecovery  bool                  `json:"point_in_time_recovery"`
+	EarliestRestorePoint *time.Time            `json:"earliest_restore_point,omitempty"`
+	Backups              []godo.DatabaseBackup `json:"backups"`
+}
+
+type BackupTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+func NewBackupTool(client func(ctx context.Context) (*godo.Client, error)) *BackupTool {
+	return &BackupTool{
+		client: client,
+	}
+}
+
+func (s *BackupTool) listBackups(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["id"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Cluster id is required"), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	cluster, _, err := client.Databases.Get(ctx, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	backups, err := common.ListAll(ctx, backupsPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.DatabaseBackup, *godo.Response, error) {
+		return client.Databases.ListBackups(ctx, id, opt)
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	slices.SortFunc(backups, func(a, b godo.DatabaseBackup) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	result := BackupList{
+		ClusterID:           cluster.ID,
+		ClusterName:         cluster.Name,
+		Engine:              cluster.EngineSlug,
+		PointInTimeRecovery: slices.Contains(pitrEngines, cluster.EngineSlug),
+		Backups:             backups,
+	}
+	if len(backups) > 0 {
+		result.EarliestRestorePoint = &backups[len(backups)-1].CreatedAt
+	}
+	jsonResult, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonResult)), nil
+}
+
+// restoreFromBackup forks a new cluster from a backup of an existing one. The new cluster gets
+// the engine and version of the source and, unless they are passed, its region, size and number
+// of nodes. The source cluster is left unchanged.
+func (s *BackupTool) restoreFromBackup(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Cluster id is required"), nil
+	}
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Name of the new cluster is required"), nil
+	}
+	backupCreatedAt, _ := args["backup_created_at"].(string)
+	if backupCreatedAt != "" {
+		if _, err := time.Parse(time.RFC3339, backupCreatedAt); err != nil {
+			return mcp.NewToolResultError("backup_created_at must be an RFC 3339 timestamp (e.g., 2025-06-01T12:00:00Z)"), nil
+		}
+	}
+	region, _ := args["region"].(string)
+	size, _ := args["size"].(string)
+	numNodes, _ := args["num_nodes"].(float64)
+	privateNetworkUUID, _ := args["private_network_uuid"].(string)
+	tags := []string{}
+	if tagsRaw, ok := args["tags"].(string); ok && tagsRaw != "" {
+		for _, t := range strings.Split(tagsRaw, ",") {
+			t = strings.TrimSpace(t)
+			if t != "" {
+				tags = append(tags, t)
+			}
+		}
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	source, _, err := client.Databases.Get(ctx, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if region == "" {
+		region = source.RegionSlug
+	}
+	if size == "" {
+		size = source.SizeSlug
+	}
+	if numNodes <= 0 {
+		numNodes = float64(source.NumNodes)
+	}
+
+	cluster, _, err := client.Databases.Create(ctx, &godo.DatabaseCreateRequest{
+		Name:               name,
+		EngineSlug:         source.EngineSlug,
+		Version:            source.VersionSlug,
+		Region:             region,
+		SizeSlug:           size,
+		NumNodes:           int(numNodes),
+		PrivateNetworkUUID: privateNetworkUUID,
+		Tags:               tags,
+		BackupRestore: &godo.DatabaseBackupRestore{
+			DatabaseName:    source.Name,
+			BackupCreatedAt: backupCreatedAt,
+		},
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonCluster, err := json.MarshalIndent(cluster, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonCluster)), nil
+}
+
+func (s *BackupTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.listBackups,
+			Tool: mcp.NewTool("db-backup-list",
+				mcp.WithDescription("List all backups of a database cluster, newest first, with the earliest time it can be restored to. PostgreSQL and MySQL clusters can be restored to any point in time since then, other engines only to the time of a backup."),
+				mcp.WithString("id", mcp.Required(), mcp.Description("The id of the cluster")),
+			),
+		},
+		{
+			Handler: s.restoreFromBackup,
+			Tool: mcp.NewTool("db-restore-from-backup",
+				mcp.WithDescription("Create a new database cluster from a backup of an existing one, optionally at a point in time. The new cluster runs the engine and version of the source, which is left unchanged."),
+				mcp.WithString("id", mcp.Required(), mcp.Description("The id of the cluster to restore from")),
+				mcp.WithString("name", mcp.Required(), mcp.Description("The name of the new cluster")),
+				mcp.WithString("backup_created_at", mcp.Description("RFC 3339 timestamp to restore to: the created_at of a backup from db-backup-list or, for PostgreSQL and MySQL, any time since the earliest restore point. Defaults to the latest backup")),
+				mcp.WithString("region", mcp.Description("The region slug of the new cluster. Defaults to the region of the source")),
+				mcp.WithString("size", mcp.Description("The size slug of the new cluster. Defaults to the size of the source")),
+				mcp.WithNumber("num_nodes", mcp.Description("The number of nodes of the new cluster. Defaults to that of the source")),
+				mcp.WithString("private_network_uuid", mcp.Description("The VPC of the new cluster (optional)")),
+				mcp.WithString("tags", mcp.Description("Comma-separated tags to apply to the new cluster")),
+			),
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package dbaas
+
+import (
+	"context"
+	"encoding/json"
+	"mcp-digitalocean/pkg/registry/dbaas/mocks"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBackupTool_listBackups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	older := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	mockDB.EXPECT().Get(gomock.Any(), "abc").Return(&godo.Database{ID: "abc", Name: "prod", EngineSlug: "pg"}, nil, nil)
+	mockDB.EXPECT().ListBackups(gomock.Any(), "abc", gomock.Any()).Return([]godo.DatabaseBackup{{CreatedAt: older}, {CreatedAt: newer}}, nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	bt := &BackupTool{client: client}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc"}}}
+	res, err := bt.listBackups(context.Background(), req)
+	assert.NoError(t, err)
+	var result BackupList
+	assert.NoError(t, json.Unmarshal([]byte(getText(res)), &result))
+	assert.True(t, result.PointInTimeRecovery)
+	assert.Equal(t, newer, result.Backups[0].CreatedAt)
+	assert.Equal(t, older, *result.EarliestRestorePoint)
+	// Error case: missing id
+	reqMissing := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{}}}
+	res, err = bt.listBackups(context.Background(), reqMissing)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Cluster id is required")
+}
+
+func TestBackupTool_restoreFromBackup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	source := &godo.Database{ID: "abc", Name: "prod", EngineSlug: "pg", VersionSlug: "16", RegionSlug: "nyc1", SizeSlug: "db-s-2vcpu-4gb", NumNodes: 2}
+	mockDB.EXPECT().Get(gomock.Any(), "abc").Return(source, nil, nil)
+	mockDB.EXPECT().Create(gomock.Any(), &godo.DatabaseCreateRequest{
+		Name:       "prod-restored",
+		EngineSlug: "pg",
+		Version:    "16",
+		Region:     "nyc1",
+		SizeSlug:   "db-s-1vcpu-2gb",
+		NumNodes:   2,
+		Tags:       []string{},
+		BackupRestore: &godo.DatabaseBackupRestore{
+			DatabaseName:    "prod",
+			BackupCreatedAt: "2025-06-01T12:00:00Z",
+		},
+	}).Return(&godo.Database{ID: "def", Name: "prod-restored"}, nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	bt := &BackupTool{client: client}
+	args := map[string]interface{}{"id": "abc", "name": "prod-restored", "size": "db-s-1vcpu-2gb", "backup_created_at": "2025-06-01T12:00:00Z"}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}}
+	res, err := bt.restoreFromBackup(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "prod-restored")
+	// Error case: invalid timestamp
+	reqInvalid := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc", "name": "x", "backup_created_at": "yesterday"}}}
+	res, err = bt.restoreFromBackup(context.Background(), reqInvalid)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "backup_created_at must be an RFC 3339 timestamp")
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -159,6 +161,10 @@ func (s *ClusterTool) resizeCluster(ctx context.Context, req mcp.CallToolRequest
 		storageSizeMib = uint64(ssm)
 	}
 
+	if size == "" && numNodes <= 0 && storageSizeMib == 0 {
+		return mcp.NewToolResultError("At least one of size, num_nodes, or storage_size_mib is required"), nil
+	}
+
 	resizeReq := &godo.DatabaseResizeRequest{}
 	if size != "" {
 		resizeReq.SizeSlug = size
@@ -181,6 +187,64 @@ func (s *ClusterTool) resizeCluster(ctx context.Context, req mcp.CallToolRequest
 	return mcp.NewToolResultText("Cluster resize initiated successfully"), nil
 }
 
+func (s *ClusterTool) migrateCluster(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Cluster id is required"), nil
+	}
+	region, ok := args["region"].(string)
+	if !ok || region == "" {
+		return mcp.NewToolResultError("Region is required"), nil
+	}
+	privateNetworkUUID, _ := args["private_network_uuid"].(string)
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	_, err = client.Databases.Migrate(ctx, id, &godo.DatabaseMigrateRequest{
+		Region:             region,
+		PrivateNetworkUUID: privateNetworkUUID,
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Cluster migration initiated successfully"), nil
+}
+
+var maintenanceDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+func (s *ClusterTool) updateMaintenanceWindow(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Cluster id is required"), nil
+	}
+	day, _ := args["day"].(string)
+	day = strings.ToLower(day)
+	if !slices.Contains(maintenanceDays, day) {
+		return mcp.NewToolResultError("day must be a day of the week (e.g., sunday)"), nil
+	}
+	hour, _ := args["hour"].(string)
+	if _, err := time.Parse("15:04", hour); err != nil {
+		return mcp.NewToolResultError("hour must be a UTC time in 24-hour HH:MM format (e.g., 03:00)"), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	_, err = client.Databases.UpdateMaintenance(ctx, id, &godo.DatabaseUpdateMaintenanceRequest{
+		Day:  day,
+		Hour: hour,
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Maintenance window updated to %s at %s UTC", day, hour)), nil
+}
+
 func (s *ClusterTool) getCA(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	id, ok := req.GetArguments()["id"].(string)
 	if !ok || id == "" {
@@ -431,6 +495,24 @@ func (s *ClusterTool) Tools() []server.ServerTool {
 				mcp.WithNumber("storage_size_mib", mcp.Description("The new storage size in MiB")),
 			),
 		},
+		{
+			Handler: s.migrateCluster,
+			Tool: mcp.NewTool("db-cluster-migrate",
+				mcp.WithDescription("Migrate a database cluster to another region. The cluster stays available during the migration, but its connection details may change."),
+				mcp.WithString("id", mcp.Required(), mcp.Description("The id of the cluster to migrate")),
+				mcp.WithString("region", mcp.Required(), mcp.Description("The region slug to migrate the cluster to (e.g., nyc3)")),
+				mcp.WithString("private_network_uuid", mcp.Description("The VPC of the cluster in the new region (optional)")),
+			),
+		},
+		{
+			Handler: s.updateMaintenanceWindow,
+			Tool: mcp.NewTool("db-maintenance-window-update",
+				mcp.WithDescription("Update the weekly maintenance window of a database cluster, in which updates are applied."),
+				mcp.WithString("id", mcp.Required(), mcp.Description("The id of the cluster")),
+				mcp.WithString("day", mcp.Required(), mcp.Enum(maintenanceDays...), mcp.Description("The day of the week of the maintenance window")),
+				mcp.WithString("hour", mcp.Required(), mcp.Description("The UTC start time of the maintenance window in 24-hour HH:MM format (e.g., 03:00)")),
+			),
+		},
 		{
 			Handler: s.listBackups,
 			Tool: mcp.NewTool("db-cluster-list-backups",
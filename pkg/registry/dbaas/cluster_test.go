@@ -163,6 +163,63 @@ func TestClusterTool_resizeCluster(t *testing.T) {
 	res, err = ct.resizeCluster(context.Background(), reqMissing)
 	assert.NoError(t, err)
 	assert.Contains(t, getText(res), "Cluster id is required")
+	// Error case: nothing to resize
+	reqEmpty := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc"}}}
+	res, err = ct.resizeCluster(context.Background(), reqEmpty)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "At least one of size, num_nodes, or storage_size_mib is required")
+}
+
+func TestClusterTool_migrateCluster(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	mockDB.EXPECT().Migrate(gomock.Any(), "abc", &godo.DatabaseMigrateRequest{Region: "nyc3"}).Return(nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	ct := &ClusterTool{client: client}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc", "region": "nyc3"}}}
+	res, err := ct.migrateCluster(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Cluster migration initiated successfully")
+	// Error case: missing region
+	reqMissing := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc"}}}
+	res, err = ct.migrateCluster(context.Background(), reqMissing)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Region is required")
+}
+
+func TestClusterTool_updateMaintenanceWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	mockDB.EXPECT().UpdateMaintenance(gomock.Any(), "abc", &godo.DatabaseUpdateMaintenanceRequest{Day: "sunday", Hour: "03:00"}).Return(nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	ct := &ClusterTool{client: client}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc", "day": "Sunday", "hour": "03:00"}}}
+	res, err := ct.updateMaintenanceWindow(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Maintenance window updated to sunday at 03:00 UTC")
+	// Error cases: invalid day and hour
+	reqDay := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc", "day": "someday", "hour": "03:00"}}}
+	res, err = ct.updateMaintenanceWindow(context.Background(), reqDay)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "day must be a day of the week")
+	reqHour := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc", "day": "sunday", "hour": "3am"}}}
+	res, err = ct.updateMaintenanceWindow(context.Background(), reqHour)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "hour must be a UTC time")
 }
 
 func TestClusterTool_getCA(t *testing.T) {
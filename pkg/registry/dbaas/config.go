@@ -0,0 +1,173 @@
+package dbaas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// engineConfig reads and updates the configuration of clusters of one engine.
+type engineConfig struct {
+	get func(ctx context.Context, db godo.DatabasesService, id string) (any, error)
+	// parse decodes a configuration patch, rejecting settings the engine doesn't have, and
+	// returns the call that applies it.
+	parse func(config []byte) (func(ctx context.Context, db godo.DatabasesService, id string) error, error)
+}
+
+// newEngineConfig builds the engineConfig of an engine from its godo methods.
+func newEngineConfig[T any](
+	get func(godo.DatabasesService, context.Context, string) (*T, *godo.Response, error),
+	update func(godo.DatabasesService, context.Context, string, *T) (*godo.Response, error),
+) engineConfig {
+	return engineConfig{
+		get: func(ctx context.Context, db godo.DatabasesService, id string) (any, error) {
+			cfg, _, err := get(db, ctx, id)
+			return cfg, err
+		},
+		parse: func(config []byte) (func(ctx context.Context, db godo.DatabasesService, id string) error, error) {
+			var cfg T
+			dec := json.NewDecoder(bytes.NewReader(config))
+			dec.DisallowUnknownFields()
+			if err := dec.Decode(&cfg); err != nil {
+				return nil, err
+			}
+			return func(ctx context.Context, db godo.DatabasesService, id string) error {
+				_, err := update(db, ctx, id, &cfg)
+				return err
+			}, nil
+		},
+	}
+}
+
+// engineConfigs are the engines with a configuration, by engine slug.
+var engineConfigs = map[string]engineConfig{
+	"pg":         newEngineConfig(godo.DatabasesService.GetPostgreSQLConfig, godo.DatabasesService.UpdatePostgreSQLConfig),
+	"mysql":      newEngineConfig(godo.DatabasesService.GetMySQLConfig, godo.DatabasesService.UpdateMySQLConfig),
+	"redis":      newEngineConfig(godo.DatabasesService.GetRedisConfig, godo.DatabasesService.UpdateRedisConfig),
+	"valkey":     newEngineConfig(godo.DatabasesService.GetValkeyConfig, godo.DatabasesService.UpdateValkeyConfig),
+	"mongodb":    newEngineConfig(godo.DatabasesService.GetMongoDBConfig, godo.DatabasesService.UpdateMongoDBConfig),
+	"opensearch": newEngineConfig(godo.DatabasesService.GetOpensearchConfig, godo.DatabasesService.UpdateOpensearchConfig),
+	"kafka":      newEngineConfig(godo.DatabasesService.GetKafkaConfig, godo.DatabasesService.UpdateKafkaConfig),
+}
+
+// ClusterConfig is the result of db-config-get.
+type ClusterConfig struct {
+	ClusterID string `json:"cluster_id"`
+	Engine    string `json:"engine"`
+	Config    any    `json:"config"`
+}
+
+// ConfigTool reads and patches the configuration of a cluster of any engine, choosing the
+// engine's API from the cluster.
+type ConfigTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+func NewConfigTool(client func(ctx context.Context) (*godo.Client, error)) *ConfigTool {
+	return &ConfigTool{
+		client: client,
+	}
+}
+
+// clusterEngine returns the engine slug of a cluster and its configuration API.
+func clusterEngine(ctx context.Context, db godo.DatabasesService, id string) (string, engineConfig, *mcp.CallToolResult) {
+	cluster, _, err := db.Get(ctx, id)
+	if err != nil {
+		return "", engineConfig{}, mcp.NewToolResultErrorFromErr("api error", err)
+	}
+	engine, ok := engineConfigs[cluster.EngineSlug]
+	if !ok {
+		engines := slices.Sorted(maps.Keys(engineConfigs))
+		return "", engineConfig{}, mcp.NewToolResultError(fmt.Sprintf("%s clusters have no configuration; supported engines: %s", cluster.EngineSlug, strings.Join(engines, ", ")))
+	}
+	return cluster.EngineSlug, engine, nil
+}
+
+func (s *ConfigTool) getConfig(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["id"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Cluster id is required"), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	slug, engine, errResult := clusterEngine(ctx, client.Databases, id)
+	if errResult != nil {
+		return errResult, nil
+	}
+	cfg, err := engine.get(ctx, client.Databases, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonCfg, err := json.MarshalIndent(ClusterConfig{ClusterID: id, Engine: slug, Config: cfg}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonCfg)), nil
+}
+
+func (s *ConfigTool) updateConfig(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Cluster id is required"), nil
+	}
+	cfgMap, ok := args["config"].(map[string]any)
+	if !ok || len(cfgMap) == 0 {
+		return mcp.NewToolResultError("Missing or empty 'config' object"), nil
+	}
+	cfgBytes, err := json.Marshal(cfgMap)
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	slug, engine, errResult := clusterEngine(ctx, client.Databases, id)
+	if errResult != nil {
+		return errResult, nil
+	}
+	update, err := engine.parse(cfgBytes)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid %s config object: %s", slug, err)), nil
+	}
+	if err := update(ctx, client.Databases, id); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	keys := slices.Sorted(maps.Keys(cfgMap))
+	return mcp.NewToolResultText(fmt.Sprintf("%s config updated successfully: %s", slug, strings.Join(keys, ", "))), nil
+}
+
+func (s *ConfigTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.getConfig,
+			Tool: mcp.NewTool("db-config-get",
+				mcp.WithDescription("Get the engine configuration of a database cluster of any engine (PostgreSQL, MySQL, Redis, Valkey, MongoDB, OpenSearch or Kafka)."),
+				mcp.WithString("id", mcp.Required(), mcp.Description("The cluster UUID")),
+			),
+		},
+		{
+			Handler: s.updateConfig,
+			Tool: mcp.NewTool("db-config-update",
+				mcp.WithDescription("Patch the engine configuration of a database cluster of any engine. Only the settings passed are changed; settings the engine doesn't have are rejected before the API is called. Use db-config-get for the setting names."),
+				mcp.WithString("id", mcp.Required(), mcp.Description("The cluster UUID")),
+				mcp.WithObject("config", mcp.Required(), mcp.Description("The settings to change, by their db-config-get names (e.g., {\"timezone\": \"UTC\"})")),
+			),
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package dbaas
+
+import (
+	"context"
+	"mcp-digitalocean/pkg/registry/dbaas/mocks"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestConfigTool_getConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	mockDB.EXPECT().Get(gomock.Any(), "abc").Return(&godo.Database{EngineSlug: "valkey"}, nil, nil)
+	mockDB.EXPECT().GetValkeyConfig(gomock.Any(), "abc").Return(&godo.ValkeyConfig{ValkeyMaxmemoryPolicy: godo.PtrTo("allkeys-lru")}, nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	ct := &ConfigTool{client: client}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc"}}}
+	res, err := ct.getConfig(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), `"engine": "valkey"`)
+	assert.Contains(t, getText(res), "allkeys-lru")
+}
+
+func TestConfigTool_updateConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	mockDB.EXPECT().Get(gomock.Any(), "abc").Return(&godo.Database{EngineSlug: "pg"}, nil, nil).Times(3)
+	mockDB.EXPECT().UpdatePostgreSQLConfig(gomock.Any(), "abc", &godo.PostgreSQLConfig{MaxParallelWorkers: godo.PtrTo(8), Timezone: godo.PtrTo("UTC")}).Return(nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	ct := &ConfigTool{client: client}
+	args := map[string]interface{}{"id": "abc", "config": map[string]interface{}{"max_parallel_workers": float64(8), "timezone": "UTC"}}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}}
+	res, err := ct.updateConfig(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "pg config updated successfully: max_parallel_workers, timezone")
+	// Error case: setting of another engine
+	reqUnknown := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc", "config": map[string]interface{}{"redis_timeout": float64(300)}}}}
+	res, err = ct.updateConfig(context.Background(), reqUnknown)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Invalid pg config object")
+	// Error case: mistyped setting
+	reqType := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc", "config": map[string]interface{}{"max_parallel_workers": "lots"}}}}
+	res, err = ct.updateConfig(context.Background(), reqType)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Invalid pg config object")
+	// Error case: missing config
+	reqMissing := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc"}}}
+	res, err = ct.updateConfig(context.Background(), reqMissing)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Missing or empty 'config' object")
+}
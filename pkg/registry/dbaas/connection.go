@@ -0,0 +1,144 @@
+package dbaas
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ConnectionDetails is how to connect to a database cluster, as returned by db-connection-get.
+// Password is only set, and only part of URI, when it was asked for.
+type ConnectionDetails struct {
+	ClusterID string `json:"cluster_id"`
+	Engine    string `json:"engine"`
+	Network   string `json:"network"`
+	Host      string `json:"host"`
+	Port      int    `json:"port"`
+	User      string `json:"user"`
+	Database  string `json:"database,omitempty"`
+	SSL       bool   `json:"ssl"`
+	URI       string `json:"uri,omitempty"`
+	Password  string `json:"password,omitempty"`
+	// PasswordRedacted is set when the password was left out because include_password was not set.
+	PasswordRedacted bool `json:"password_redacted,omitempty"`
+}
+
+type ConnectionTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+func NewConnectionTool(client func(ctx context.Context) (*godo.Client, error)) *ConnectionTool {
+	return &ConnectionTool{
+		client: client,
+	}
+}
+
+// connectionURI returns uri with the credentials of user and, when it is not empty, password, and
+// with database when it is not empty.
+func connectionURI(uri, user, password, database string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	u.User = url.User(user)
+	if password != "" {
+		u.User = url.UserPassword(user, password)
+	}
+	if database != "" {
+		u.Path = "/" + database
+	}
+	return u.String(), nil
+}
+
+func (s *ConnectionTool) getConnection(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	id := args.RequiredString("id")
+	network := args.String("network")
+	user := args.String("user")
+	database := args.String("database")
+	includePassword := args.Bool("include_password", false)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if network == "" {
+		network = "public"
+	}
+	if network != "public" && network != "private" {
+		return mcp.NewToolResultError("network must be public or private"), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	cluster, _, err := client.Databases.Get(ctx, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	conn := cluster.Connection
+	if network == "private" {
+		conn = cluster.PrivateConnection
+	}
+	if conn == nil {
+		return mcp.NewToolResultError(fmt.Sprintf("cluster %s has no %s connection", id, network)), nil
+	}
+
+	details := &ConnectionDetails{
+		ClusterID: cluster.ID,
+		Engine:    cluster.EngineSlug,
+		Network:   network,
+		Host:      conn.Host,
+		Port:      conn.Port,
+		User:      conn.User,
+		Database:  conn.Database,
+		SSL:       conn.SSL,
+	}
+	password := conn.Password
+	// the connection holds the admin user's credentials; another user's come from the user itself.
+	if user != "" && user != conn.User {
+		dbUser, _, err := client.Databases.GetUser(ctx, id, user)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		details.User, password = dbUser.Name, dbUser.Password
+	}
+	if database != "" {
+		details.Database = database
+	}
+	if includePassword {
+		details.Password = password
+	} else {
+		details.PasswordRedacted = password != ""
+		password = ""
+	}
+	if conn.URI != "" {
+		if details.URI, err = connectionURI(conn.URI, details.User, password, database); err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to parse the connection URI", err), nil
+		}
+	}
+	return common.NewToolResultStructured(details)
+}
+
+func (s *ConnectionTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.getConnection,
+			Tool: mcp.NewTool("db-connection-get",
+				mcp.WithDescription("Get the host, port, user, database and connection URI of a database cluster. The password is left out, also from the URI, unless include_password is true; only ask for it when it has to be handed to something that connects, since it stays in the conversation."),
+				common.WithOutputSchema[ConnectionDetails](),
+				mcp.WithString("id", mcp.Required(), mcp.Description("The cluster ID")),
+				mcp.WithString("network", mcp.Enum("public", "private"), mcp.DefaultString("public"), mcp.Description("public for the public host, or private for the host inside the cluster's VPC")),
+				mcp.WithString("user", mcp.Description("Database user to connect as (default: the admin user)")),
+				mcp.WithString("database", mcp.Description("Database to connect to (default: the cluster's default database)")),
+				mcp.WithBoolean("include_password", mcp.DefaultBool(false), mcp.Description("Include the user's password in the result and the URI")),
+			),
+		},
+	}
+}
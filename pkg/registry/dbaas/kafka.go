@@ -12,6 +12,32 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// topicConfigProperties is the schema of the configuration of a Kafka topic.
+var topicConfigProperties = map[string]any{
+	"cleanup_policy":                      map[string]any{"type": "string"},
+	"compression_type":                    map[string]any{"type": "string"},
+	"delete_retention_ms":                 map[string]any{"type": "integer"},
+	"flush_messages":                      map[string]any{"type": "integer"},
+	"flush_ms":                            map[string]any{"type": "integer"},
+	"index_interval_bytes":                map[string]any{"type": "integer"},
+	"max_compaction_lag_ms":               map[string]any{"type": "integer"},
+	"max_message_bytes":                   map[string]any{"type": "integer"},
+	"message_down_conversion_enable":      map[string]any{"type": "boolean"},
+	"message_format_version":              map[string]any{"type": "string"},
+	"message_timestamp_difference_max_ms": map[string]any{"type": "integer"},
+	"message_timestamp_type":              map[string]any{"type": "string"},
+	"min_cleanable_dirty_ratio":           map[string]any{"type": "number"},
+	"min_compaction_lag_ms":               map[string]any{"type": "integer"},
+	"min_insync_replicas":                 map[string]any{"type": "integer"},
+	"preallocate":                         map[string]any{"type": "boolean"},
+	"retention_bytes":                     map[string]any{"type": "integer"},
+	"retention_ms":                        map[string]any{"type": "integer"},
+	"segment_bytes":                       map[string]any{"type": "integer"},
+	"segment_index_bytes":                 map[string]any{"type": "integer"},
+	"segment_jitter_ms":                   map[string]any{"type": "integer"},
+	"segment_ms":                          map[string]any{"type": "integer"},
+}
+
 type KafkaTool struct {
 	client func(ctx context.Context) (*godo.Client, error)
 }
@@ -306,30 +332,7 @@ func (s *KafkaTool) Tools() []server.ServerTool {
 				mcp.WithString("replication_factor", mcp.Description("Replication factor")),
 				mcp.WithObject("config",
 					mcp.Description("Kafka topic configuration (optional)"),
-					mcp.Properties(map[string]any{
-						"cleanup_policy":                      map[string]any{"type": "string"},
-						"compression_type":                    map[string]any{"type": "string"},
-						"delete_retention_ms":                 map[string]any{"type": "integer"},
-						"flush_messages":                      map[string]any{"type": "integer"},
-						"flush_ms":                            map[string]any{"type": "integer"},
-						"index_interval_bytes":                map[string]any{"type": "integer"},
-						"max_compaction_lag_ms":               map[string]any{"type": "integer"},
-						"max_message_bytes":                   map[string]any{"type": "integer"},
-						"message_down_conversion_enable":      map[string]any{"type": "boolean"},
-						"message_format_version":              map[string]any{"type": "string"},
-						"message_timestamp_difference_max_ms": map[string]any{"type": "integer"},
-						"message_timestamp_type":              map[string]any{"type": "string"},
-						"min_cleanable_dirty_ratio":           map[string]any{"type": "number"},
-						"min_compaction_lag_ms":               map[string]any{"type": "integer"},
-						"min_insync_replicas":                 map[string]any{"type": "integer"},
-						"preallocate":                         map[string]any{"type": "boolean"},
-						"retention_bytes":                     map[string]any{"type": "integer"},
-						"retention_ms":                        map[string]any{"type": "integer"},
-						"segment_bytes":                       map[string]any{"type": "integer"},
-						"segment_index_bytes":                 map[string]any{"type": "integer"},
-						"segment_jitter_ms":                   map[string]any{"type": "integer"},
-						"segment_ms":                          map[string]any{"type": "integer"},
-					}),
+					mcp.Properties(topicConfigProperties),
 				),
 			),
 		},
@@ -359,30 +362,7 @@ func (s *KafkaTool) Tools() []server.ServerTool {
 				mcp.WithString("replication_factor", mcp.Description("Replication factor")),
 				mcp.WithObject("config",
 					mcp.Description("Kafka topic configuration (optional)"),
-					mcp.Properties(map[string]any{
-						"cleanup_policy":                      map[string]any{"type": "string"},
-						"compression_type":                    map[string]any{"type": "string"},
-						"delete_retention_ms":                 map[string]any{"type": "integer"},
-						"flush_messages":                      map[string]any{"type": "integer"},
-						"flush_ms":                            map[string]any{"type": "integer"},
-						"index_interval_bytes":                map[string]any{"type": "integer"},
-						"max_compaction_lag_ms":               map[string]any{"type": "integer"},
-						"max_message_bytes":                   map[string]any{"type": "integer"},
-						"message_down_conversion_enable":      map[string]any{"type": "boolean"},
-						"message_format_version":              map[string]any{"type": "string"},
-						"message_timestamp_difference_max_ms": map[string]any{"type": "integer"},
-						"message_timestamp_type":              map[string]any{"type": "string"},
-						"min_cleanable_dirty_ratio":           map[string]any{"type": "number"},
-						"min_compaction_lag_ms":               map[string]any{"type": "integer"},
-						"min_insync_replicas":                 map[string]any{"type": "integer"},
-						"preallocate":                         map[string]any{"type": "boolean"},
-						"retention_bytes":                     map[string]any{"type": "integer"},
-						"retention_ms":                        map[string]any{"type": "integer"},
-						"segment_bytes":                       map[string]any{"type": "integer"},
-						"segment_index_bytes":                 map[string]any{"type": "integer"},
-						"segment_jitter_ms":                   map[string]any{"type": "integer"},
-						"segment_ms":                          map[string]any{"type": "integer"},
-					}),
+					mcp.Properties(topicConfigProperties),
 				),
 			),
 		},
@@ -0,0 +1,226 @@
+package dbaas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+type ReplicaTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+func NewReplicaTool(client func(ctx context.Context) (*godo.Client, error)) *ReplicaTool {
+	return &ReplicaTool{
+		client: client,
+	}
+}
+
+// replicaArgs returns the cluster id and replica name arguments of a replica tool.
+func replicaArgs(args map[string]any) (string, string, *mcp.CallToolResult) {
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return "", "", mcp.NewToolResultError("Cluster id is required")
+	}
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return "", "", mcp.NewToolResultError("Replica name is required")
+	}
+	return id, name, nil
+}
+
+func (s *ReplicaTool) createReplica(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, name, errResult := replicaArgs(args)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	region, _ := args["region"].(string)
+	size, _ := args["size"].(string)
+	privateNetworkUUID, _ := args["private_network_uuid"].(string)
+	var tags []string
+	if tagsStr, ok := args["tags"].(string); ok && tagsStr != "" {
+		for _, tag := range strings.Split(tagsStr, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	storageSizeMib := uint64(0)
+	if ssm, ok := args["storage_size_mib"].(float64); ok {
+		storageSizeMib = uint64(ssm)
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	// the API requires the region and size of a replica, which default to those of the primary.
+	if region == "" || size == "" {
+		primary, _, err := client.Databases.Get(ctx, id)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		if region == "" {
+			region = primary.RegionSlug
+		}
+		if size == "" {
+			size = primary.SizeSlug
+		}
+	}
+
+	replica, _, err := client.Databases.CreateReplica(ctx, id, &godo.DatabaseCreateReplicaRequest{
+		Name:               name,
+		Region:             region,
+		Size:               size,
+		PrivateNetworkUUID: privateNetworkUUID,
+		Tags:               tags,
+		StorageSizeMib:     storageSizeMib,
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonReplica, err := json.MarshalIndent(replica, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonReplica)), nil
+}
+
+func (s *ReplicaTool) listReplicas(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["id"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Cluster id is required"), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	replicas, _, err := client.Databases.ListReplicas(ctx, id, nil)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonReplicas, err := json.MarshalIndent(replicas, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonReplicas)), nil
+}
+
+func (s *ReplicaTool) getReplica(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, name, errResult := replicaArgs(req.GetArguments())
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	replica, _, err := client.Databases.GetReplica(ctx, id, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonReplica, err := json.MarshalIndent(replica, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonReplica)), nil
+}
+
+func (s *ReplicaTool) deleteReplica(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, name, errResult := replicaArgs(req.GetArguments())
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	_, err = client.Databases.DeleteReplica(ctx, id, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Replica deleted successfully"), nil
+}
+
+// promoteReplica fails a cluster over to one of its replicas, which becomes a standalone
+// primary cluster.
+func (s *ReplicaTool) promoteReplica(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, name, errResult := replicaArgs(req.GetArguments())
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	_, err = client.Databases.PromoteReplicaToPrimary(ctx, id, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Replica promotion to primary initiated successfully"), nil
+}
+
+func (s *ReplicaTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.createReplica,
+			Tool: mcp.NewTool("db-replica-create",
+				mcp.WithDescription("Create a read-only replica of a database cluster. Replicas are supported for PostgreSQL and MySQL clusters."),
+				mcp.WithString("id", mcp.Required(), mcp.Description("The id of the primary cluster")),
+				mcp.WithString("name", mcp.Required(), mcp.Description("The name of the replica")),
+				mcp.WithString("region", mcp.Description("The region slug of the replica (e.g., nyc1). Defaults to the region of the primary")),
+				mcp.WithString("size", mcp.Description("The size slug of the replica (e.g., db-s-2vcpu-4gb). Defaults to the size of the primary")),
+				mcp.WithString("private_network_uuid", mcp.Description("The VPC of the replica (optional)")),
+				mcp.WithString("tags", mcp.Description("Comma-separated tags to apply to the replica")),
+				mcp.WithNumber("storage_size_mib", mcp.Description("The storage size of the replica in MiB (optional)")),
+			),
+		},
+		{
+			Handler: s.listReplicas,
+			Tool: mcp.NewTool("db-replica-list",
+				mcp.WithDescription("List the read-only replicas of a database cluster"),
+				mcp.WithString("id", mcp.Required(), mcp.Description("The id of the primary cluster")),
+			),
+		},
+		{
+			Handler: s.getReplica,
+			Tool: mcp.NewTool("db-replica-get",
+				mcp.WithDescription("Get a read-only replica of a database cluster by its name"),
+				mcp.WithString("id", mcp.Required(), mcp.Description("The id of the primary cluster")),
+				mcp.WithString("name", mcp.Required(), mcp.Description("The name of the replica")),
+			),
+		},
+		{
+			Handler: s.deleteReplica,
+			Tool: mcp.NewTool("db-replica-delete",
+				mcp.WithDescription("Delete a read-only replica of a database cluster by its name"),
+				mcp.WithString("id", mcp.Required(), mcp.Description("The id of the primary cluster")),
+				mcp.WithString("name", mcp.Required(), mcp.Description("The name of the replica")),
+			),
+		},
+		{
+			Handler: s.promoteReplica,
+			Tool: mcp.NewTool("db-replica-promote",
+				mcp.WithDescription("Promote a read-only replica to a standalone primary cluster, e.g. to fail over from a primary that is unavailable. The replica stops replicating from the primary and accepts writes."),
+				mcp.WithString("id", mcp.Required(), mcp.Description("The id of the primary cluster")),
+				mcp.WithString("name", mcp.Required(), mcp.Description("The name of the replica to promote")),
+			),
+		},
+	}
+}
@@ -0,0 +1,124 @@
+package dbaas
+
+import (
+	"context"
+	"mcp-digitalocean/pkg/registry/dbaas/mocks"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReplicaTool_createReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	mockDB.EXPECT().Get(gomock.Any(), "abc").Return(&godo.Database{RegionSlug: "nyc1", SizeSlug: "db-s-1vcpu-1gb"}, nil, nil)
+	mockDB.EXPECT().CreateReplica(gomock.Any(), "abc", &godo.DatabaseCreateReplicaRequest{
+		Name:   "read-1",
+		Region: "ams3",
+		Size:   "db-s-1vcpu-1gb",
+		Tags:   []string{"prod", "read"},
+	}).Return(&godo.DatabaseReplica{Name: "read-1", Region: "ams3"}, nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	rt := &ReplicaTool{client: client}
+	args := map[string]interface{}{"id": "abc", "name": "read-1", "region": "ams3", "tags": "prod, read"}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}}
+	res, err := rt.createReplica(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "read-1")
+	// Error case: missing name
+	reqMissing := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc"}}}
+	res, err = rt.createReplica(context.Background(), reqMissing)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Replica name is required")
+}
+
+func TestReplicaTool_listReplicas(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	mockDB.EXPECT().ListReplicas(gomock.Any(), "abc", gomock.Any()).Return([]godo.DatabaseReplica{{Name: "read-1"}, {Name: "read-2"}}, nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	rt := &ReplicaTool{client: client}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc"}}}
+	res, err := rt.listReplicas(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "read-2")
+}
+
+func TestReplicaTool_getReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	mockDB.EXPECT().GetReplica(gomock.Any(), "abc", "read-1").Return(&godo.DatabaseReplica{Name: "read-1", Status: "online"}, nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	rt := &ReplicaTool{client: client}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc", "name": "read-1"}}}
+	res, err := rt.getReplica(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "online")
+}
+
+func TestReplicaTool_deleteReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	mockDB.EXPECT().DeleteReplica(gomock.Any(), "abc", "read-1").Return(nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	rt := &ReplicaTool{client: client}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc", "name": "read-1"}}}
+	res, err := rt.deleteReplica(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Replica deleted successfully")
+	// Error case: missing id
+	reqMissing := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"name": "read-1"}}}
+	res, err = rt.deleteReplica(context.Background(), reqMissing)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Cluster id is required")
+}
+
+func TestReplicaTool_promoteReplica(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	mockDB.EXPECT().PromoteReplicaToPrimary(gomock.Any(), "abc", "read-1").Return(nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	rt := &ReplicaTool{client: client}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc", "name": "read-1"}}}
+	res, err := rt.promoteReplica(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Replica promotion to primary initiated successfully")
+}
@@ -0,0 +1,172 @@
+package dbaas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// TopicTool manages the topics of Kafka clusters. Unlike the db-cluster-*-topic tools, it
+// checks that the cluster runs Kafka first, so other clusters get a clear error.
+type TopicTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+func NewTopicTool(client func(ctx context.Context) (*godo.Client, error)) *TopicTool {
+	return &TopicTool{
+		client: client,
+	}
+}
+
+// requireKafka returns an error result unless the cluster id runs Kafka.
+func requireKafka(ctx context.Context, db godo.DatabasesService, id string) *mcp.CallToolResult {
+	cluster, _, err := db.Get(ctx, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err)
+	}
+	if cluster.EngineSlug != "kafka" {
+		return mcp.NewToolResultError(fmt.Sprintf("cluster %s runs %s; topics only exist on kafka clusters", id, cluster.EngineSlug))
+	}
+	return nil
+}
+
+func (s *TopicTool) createTopic(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Cluster id is required"), nil
+	}
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Topic name is required"), nil
+	}
+
+	createReq := &godo.DatabaseCreateTopicRequest{Name: name}
+	if pc, ok := args["partition_count"].(float64); ok && pc > 0 {
+		createReq.PartitionCount = godo.PtrTo(uint32(pc))
+	}
+	if rf, ok := args["replication_factor"].(float64); ok && rf > 0 {
+		createReq.ReplicationFactor = godo.PtrTo(uint32(rf))
+	}
+	if cfgMap, ok := args["config"].(map[string]any); ok && len(cfgMap) > 0 {
+		cfgBytes, err := json.Marshal(cfgMap)
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: %w", err)
+		}
+		var cfg godo.TopicConfig
+		dec := json.NewDecoder(bytes.NewReader(cfgBytes))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&cfg); err != nil {
+			return mcp.NewToolResultError("Invalid config object: " + err.Error()), nil
+		}
+		createReq.Config = &cfg
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	if errResult := requireKafka(ctx, client.Databases, id); errResult != nil {
+		return errResult, nil
+	}
+	topic, _, err := client.Databases.CreateTopic(ctx, id, createReq)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonTopic, err := json.MarshalIndent(topic, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonTopic)), nil
+}
+
+func (s *TopicTool) listTopics(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["id"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Cluster id is required"), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	if errResult := requireKafka(ctx, client.Databases, id); errResult != nil {
+		return errResult, nil
+	}
+	topics, _, err := client.Databases.ListTopics(ctx, id, nil)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if topics == nil {
+		topics = []godo.DatabaseTopic{}
+	}
+	jsonTopics, err := json.MarshalIndent(topics, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonTopics)), nil
+}
+
+func (s *TopicTool) deleteTopic(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, ok := args["id"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Cluster id is required"), nil
+	}
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Topic name is required"), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	if errResult := requireKafka(ctx, client.Databases, id); errResult != nil {
+		return errResult, nil
+	}
+	_, err = client.Databases.DeleteTopic(ctx, id, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Topic %s deleted successfully", name)), nil
+}
+
+func (s *TopicTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.createTopic,
+			Tool: mcp.NewTool("db-topic-create",
+				mcp.WithDescription("Create a topic in a Kafka cluster. Settings the topic config doesn't have are rejected before the API is called."),
+				mcp.WithString("id", mcp.Required(), mcp.Description("Kafka cluster UUID")),
+				mcp.WithString("name", mcp.Required(), mcp.Description("Topic name")),
+				mcp.WithNumber("partition_count", mcp.Description("Number of partitions (optional)")),
+				mcp.WithNumber("replication_factor", mcp.Description("Number of nodes each partition is replicated to (optional)")),
+				mcp.WithObject("config",
+					mcp.Description("Kafka topic configuration (optional)"),
+					mcp.Properties(topicConfigProperties),
+				),
+			),
+		},
+		{
+			Handler: s.listTopics,
+			Tool: mcp.NewTool("db-topic-list",
+				mcp.WithDescription("List the topics of a Kafka cluster with their partition counts and replication factors."),
+				mcp.WithString("id", mcp.Required(), mcp.Description("Kafka cluster UUID")),
+			),
+		},
+		{
+			Handler: s.deleteTopic,
+			Tool: mcp.NewTool("db-topic-delete",
+				mcp.WithDescription("Delete a topic of a Kafka cluster and the messages in it."),
+				mcp.WithString("id", mcp.Required(), mcp.Description("Kafka cluster UUID")),
+				mcp.WithString("name", mcp.Required(), mcp.Description("Topic name")),
+			),
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package dbaas
+
+import (
+	"context"
+	"mcp-digitalocean/pkg/registry/dbaas/mocks"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTopicTool_createTopic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	mockDB.EXPECT().Get(gomock.Any(), "abc").Return(&godo.Database{EngineSlug: "kafka"}, nil, nil)
+	mockDB.EXPECT().CreateTopic(gomock.Any(), "abc", &godo.DatabaseCreateTopicRequest{
+		Name:           "events",
+		PartitionCount: godo.PtrTo(uint32(6)),
+		Config:         &godo.TopicConfig{RetentionMS: godo.PtrTo(int64(86400000))},
+	}).Return(&godo.DatabaseTopic{Name: "events"}, nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	tt := &TopicTool{client: client}
+	args := map[string]interface{}{"id": "abc", "name": "events", "partition_count": float64(6), "config": map[string]interface{}{"retention_ms": float64(86400000)}}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}}
+	res, err := tt.createTopic(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "events")
+	// Error case: unknown config setting
+	reqInvalid := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc", "name": "events", "config": map[string]interface{}{"retention": float64(1)}}}}
+	res, err = tt.createTopic(context.Background(), reqInvalid)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Invalid config object")
+}
+
+func TestTopicTool_listTopics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	mockDB.EXPECT().Get(gomock.Any(), "abc").Return(&godo.Database{EngineSlug: "kafka"}, nil, nil)
+	mockDB.EXPECT().ListTopics(gomock.Any(), "abc", gomock.Any()).Return([]godo.DatabaseTopic{{Name: "events"}, {Name: "logs"}}, nil, nil)
+	mockDB.EXPECT().Get(gomock.Any(), "pg-1").Return(&godo.Database{EngineSlug: "pg"}, nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	tt := &TopicTool{client: client}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc"}}}
+	res, err := tt.listTopics(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "logs")
+	// Error case: not a Kafka cluster
+	reqPg := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "pg-1"}}}
+	res, err = tt.listTopics(context.Background(), reqPg)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "topics only exist on kafka clusters")
+}
+
+func TestTopicTool_deleteTopic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	mockDB.EXPECT().Get(gomock.Any(), "abc").Return(&godo.Database{EngineSlug: "kafka"}, nil, nil)
+	mockDB.EXPECT().DeleteTopic(gomock.Any(), "abc", "events").Return(nil, nil)
+
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Databases: mockDB,
+		}, nil
+	}
+
+	tt := &TopicTool{client: client}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]interface{}{"id": "abc", "name": "events"}}}
+	res, err := tt.deleteTopic(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "Topic events deleted successfully")
+}
@@ -248,8 +248,8 @@ func (d *DedicatedInferenceTool) Tools() []server.ServerTool {
 				mcp.WithDescription("List Dedicated Inference instances (ListDedicatedInferenceV2) with optional filters and pagination."),
 				mcp.WithString("Region", mcp.Description("Filter by region slug (e.g. nyc2)")),
 				mcp.WithString("Name", mcp.Description("Filter by instance name")),
-				mcp.WithNumber("Page", mcp.Description("Page number for pagination")),
-				mcp.WithNumber("PerPage", mcp.Description("Number of items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.Description("Page number for pagination")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.Description("Number of items per page")),
 			),
 		},
 		{
@@ -186,8 +186,8 @@ func (g *GarbageCollectionTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool("docr-garbage-collection-list",
 				mcp.WithDescription("List garbage collections for a container registry"),
 				mcp.WithString("RegistryName", mcp.Required(), mcp.Description("Name of the container registry")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultGCPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultGCPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultGCPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultGCPageSize), mcp.Description("Items per page")),
 			),
 		},
 		{
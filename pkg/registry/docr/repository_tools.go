@@ -242,8 +242,8 @@ func (r *RepositoryTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool("docr-repository-list",
 				mcp.WithDescription("List repositories in a container registry"),
 				mcp.WithString("RegistryName", mcp.Required(), mcp.Description("Name of the container registry")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultRepoPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultRepoPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultRepoPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultRepoPageSize), mcp.Description("Items per page")),
 				mcp.WithString("PageToken", mcp.Description("Token for paginating through results")),
 			),
 		},
@@ -253,8 +253,8 @@ func (r *RepositoryTool) Tools() []server.ServerTool {
 				mcp.WithDescription("List tags for a repository in a container registry"),
 				mcp.WithString("RegistryName", mcp.Required(), mcp.Description("Name of the container registry")),
 				mcp.WithString("Repository", mcp.Required(), mcp.Description("Name of the repository")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultRepoPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultRepoPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultRepoPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultRepoPageSize), mcp.Description("Items per page")),
 			),
 		},
 		{
@@ -272,8 +272,8 @@ func (r *RepositoryTool) Tools() []server.ServerTool {
 				mcp.WithDescription("List manifests for a repository in a container registry"),
 				mcp.WithString("RegistryName", mcp.Required(), mcp.Description("Name of the container registry")),
 				mcp.WithString("Repository", mcp.Required(), mcp.Description("Name of the repository")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultRepoPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultRepoPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultRepoPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultRepoPageSize), mcp.Description("Items per page")),
 			),
 		},
 		{
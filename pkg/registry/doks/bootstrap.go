@@ -0,0 +1,318 @@
+package doks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"mcp-digitalocean/internal/dryrun"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultBootstrapNodeSize  = "s-2vcpu-4gb"
+	defaultBootstrapNodeCount = 3
+	defaultBootstrapTimeout   = 900
+	defaultBootstrapPoll      = 10 * time.Second
+	// kubernetesOneClickType is the type of the 1-Click apps installed on clusters.
+	kubernetesOneClickType = "kubernetes"
+)
+
+// BootstrapStep is the outcome of one step of doks-bootstrap.
+type BootstrapStep struct {
+	Step   string `json:"step"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// BootstrapResult is the outcome of doks-bootstrap. A cluster that was created is kept when a
+// later step fails; Steps says which ones are left to retry.
+type BootstrapResult struct {
+	DryRun    bool   `json:"dry_run,omitempty"`
+	Succeeded bool   `json:"succeeded"`
+	Name      string `json:"name"`
+	Region    string `json:"region"`
+	Version   string `json:"version"`
+	// Cluster is the cluster as of the last step, once it was created.
+	Cluster  *godo.KubernetesCluster `json:"kubernetes_cluster,omitempty"`
+	Registry string                  `json:"registry,omitempty"`
+	Apps     []string                `json:"apps,omitempty"`
+	// Kubeconfig is the cluster's kubeconfig, unless it was written to KubeconfigPath.
+	Kubeconfig     string          `json:"kubeconfig,omitempty"`
+	KubeconfigPath string          `json:"kubeconfig_path,omitempty"`
+	Steps          []BootstrapStep `json:"steps"`
+	Error          string          `json:"error,omitempty"`
+}
+
+func (r *BootstrapResult) step(name, status, detail string) {
+	r.Steps = append(r.Steps, BootstrapStep{Step: name, Status: status, Detail: detail})
+}
+
+// BootstrapTool provides a composite tool that sets up a cluster the way most first clusters are:
+// created, able to pull from the account's container registry and running a few 1-Click apps.
+type BootstrapTool struct {
+	client       func(ctx context.Context) (*godo.Client, error)
+	fileDir      string
+	pollInterval time.Duration
+}
+
+// NewBootstrapTool creates a cluster bootstrap tool that may write kubeconfigs below fileDir, or
+// nowhere if it is empty.
+func NewBootstrapTool(client func(ctx context.Context) (*godo.Client, error), fileDir string) *BootstrapTool {
+	return &BootstrapTool{client: client, fileDir: fileDir, pollInterval: defaultBootstrapPoll}
+}
+
+// bootstrapVersion returns the slug of version among the versions the API offers, the newest for
+// latest or an empty version.
+func bootstrapVersion(options *godo.KubernetesOptions, version string) (string, error) {
+	if len(options.Versions) == 0 {
+		return "", errors.New("the API offers no Kubernetes versions")
+	}
+	if version == "" || version == "latest" {
+		return options.Versions[0].Slug, nil
+	}
+	var slugs []string
+	for _, v := range options.Versions {
+		if v.Slug == version || v.KubernetesVersion == version {
+			return v.Slug, nil
+		}
+		slugs = append(slugs, v.Slug)
+	}
+	return "", fmt.Errorf("version %s is not offered; use latest or one of %s", version, strings.Join(slugs, ", "))
+}
+
+// checkBootstrapOptions checks that clusters can be created in region with nodes of size.
+func checkBootstrapOptions(options *godo.KubernetesOptions, region, size string) error {
+	if !slices.ContainsFunc(options.Regions, func(r *godo.KubernetesRegion) bool { return r.Slug == region }) {
+		var slugs []string
+		for _, r := range options.Regions {
+			slugs = append(slugs, r.Slug)
+		}
+		return fmt.Errorf("clusters cannot be created in region %s; use one of %s", region, strings.Join(slugs, ", "))
+	}
+	if !slices.ContainsFunc(options.Sizes, func(s *godo.KubernetesNodeSize) bool { return s.Slug == size }) {
+		return fmt.Errorf("node size %s is not offered for clusters; list the node sizes with doks-list-options", size)
+	}
+	return nil
+}
+
+// checkBootstrapApps checks that apps are Kubernetes 1-Click apps.
+func checkBootstrapApps(ctx context.Context, client *godo.Client, apps []string) error {
+	available, _, err := client.OneClick.List(ctx, kubernetesOneClickType)
+	if err != nil {
+		return fmt.Errorf("failed to list 1-Click apps: %w", err)
+	}
+	var unknown []string
+	for _, app := range apps {
+		if !slices.ContainsFunc(available, func(o *godo.OneClick) bool { return o.Slug == app }) {
+			unknown = append(unknown, app)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("%s are not Kubernetes 1-Click apps; list them with 1-click-list of Type kubernetes", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// waitForRunning polls the cluster until it is running and returns it.
+func (b *BootstrapTool) waitForRunning(ctx context.Context, client *godo.Client, id string) (*godo.KubernetesCluster, error) {
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+	for {
+		cluster, _, err := client.Kubernetes.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if cluster.Status != nil {
+			switch cluster.Status.State {
+			case godo.KubernetesClusterStatusRunning:
+				return cluster, nil
+			case godo.KubernetesClusterStatusError, godo.KubernetesClusterStatusInvalid, godo.KubernetesClusterStatusDeleted:
+				return nil, fmt.Errorf("cluster %s is %s: %s", id, cluster.Status.State, cluster.Status.Message)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("cluster %s is not running yet: %w", id, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// bootstrap creates a cluster, waits until it is running, links the account's container registry,
+// installs 1-Click apps and returns its kubeconfig. Everything is checked before the cluster is
+// created, so a mistake fails without leaving a cluster behind.
+func (b *BootstrapTool) bootstrap(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	region := args.RequiredString("Region")
+	version := args.String("Version")
+	nodeSize := args.String("NodeSize")
+	nodeCount := int(args.Number("NodeCount", defaultBootstrapNodeCount))
+	tags := args.Strings("Tags")
+	vpc := args.String("VPCUUID")
+	linkRegistry := args.Bool("LinkRegistry", true)
+	apps := args.Strings("Apps")
+	outputPath := args.String("OutputPath")
+	timeoutSeconds := args.Number("TimeoutSeconds", defaultBootstrapTimeout)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if nodeSize == "" {
+		nodeSize = defaultBootstrapNodeSize
+	}
+	if nodeCount < 1 {
+		return mcp.NewToolResultError("invalid arguments: NodeCount must be at least 1"), nil
+	}
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = defaultBootstrapTimeout
+	}
+	if outputPath != "" && b.fileDir == "" {
+		return mcp.NewToolResultError("writing kubeconfigs to files is disabled; the server operator enables it with --kubeconfig-dir"), nil
+	}
+
+	client, err := b.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	options, _, err := client.Kubernetes.GetOptions(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if version, err = bootstrapVersion(options, version); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if err := checkBootstrapOptions(options, region, nodeSize); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	result := &BootstrapResult{Name: name, Region: region, Version: version, Apps: apps, Steps: []BootstrapStep{}}
+	if linkRegistry {
+		registry, resp, err := client.Registry.Get(ctx)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return mcp.NewToolResultError("the account has no container registry; create one with docr-create, or pass LinkRegistry: false"), nil
+		}
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		result.Registry = registry.Name
+	}
+	if len(apps) > 0 {
+		if err := checkBootstrapApps(ctx, client, apps); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
+	if dryrun.Active(ctx) {
+		dryrun.Planned(ctx)
+		result.DryRun = true
+		result.step("cluster create", "planned", fmt.Sprintf("%s %s in %s with %d %s nodes", name, version, region, nodeCount, nodeSize))
+		if linkRegistry {
+			result.step("registry link", "planned", result.Registry)
+		}
+		if len(apps) > 0 {
+			result.step("apps install", "planned", strings.Join(apps, ", "))
+		}
+		result.step("kubeconfig", "planned", "")
+		return common.NewToolResultStructured(result)
+	}
+
+	// fail records the failed step. The cluster, if created, is kept: it takes minutes to create
+	// and the remaining steps can be retried on it.
+	fail := func(step string, err error) (*mcp.CallToolResult, error) {
+		result.step(step, "failed", err.Error())
+		result.Error = fmt.Sprintf("%s: %v", step, err)
+		res, err := common.NewToolResultStructured(result)
+		if err != nil {
+			return nil, err
+		}
+		res.IsError = result.Cluster == nil
+		return res, nil
+	}
+
+	cluster, _, err := client.Kubernetes.Create(ctx, &godo.KubernetesClusterCreateRequest{
+		Name:        name,
+		RegionSlug:  region,
+		VersionSlug: version,
+		Tags:        tags,
+		VPCUUID:     vpc,
+		NodePools: []*godo.KubernetesNodePoolCreateRequest{
+			{Name: name + "-default-pool", Size: nodeSize, Count: nodeCount},
+		},
+	})
+	if err != nil {
+		return fail("cluster create", err)
+	}
+	result.Cluster = cluster
+	result.step("cluster create", "done", cluster.ID)
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds*float64(time.Second)))
+	running, err := b.waitForRunning(waitCtx, client, cluster.ID)
+	cancel()
+	if err != nil {
+		return fail("wait for running", err)
+	}
+	result.Cluster = running
+	result.step("wait for running", "done", running.Endpoint)
+
+	if linkRegistry {
+		if _, err := client.Kubernetes.AddRegistry(ctx, &godo.KubernetesClusterRegistryRequest{ClusterUUIDs: []string{cluster.ID}}); err != nil {
+			return fail("registry link", err)
+		}
+		result.step("registry link", "done", result.Registry)
+	}
+
+	if len(apps) > 0 {
+		installed, _, err := client.OneClick.InstallKubernetes(ctx, &godo.InstallKubernetesAppsRequest{ClusterUUID: cluster.ID, Slugs: apps})
+		if err != nil {
+			return fail("apps install", err)
+		}
+		result.step("apps install", "done", installed.Message)
+	}
+
+	kubeconfig, _, err := client.Kubernetes.GetKubeConfig(ctx, cluster.ID, nil)
+	if err != nil {
+		return fail("kubeconfig", err)
+	}
+	if outputPath == "" {
+		result.Kubeconfig = string(kubeconfig.KubeconfigYAML)
+	} else if result.KubeconfigPath, err = writeKubeconfig(b.fileDir, outputPath, kubeconfig.KubeconfigYAML, false); err != nil {
+		return fail("kubeconfig", err)
+	}
+	result.step("kubeconfig", "done", result.KubeconfigPath)
+
+	result.Succeeded = true
+	return common.NewToolResultStructured(result)
+}
+
+// Tools returns the doks-bootstrap tool.
+func (b *BootstrapTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: b.bootstrap,
+			Tool: mcp.NewTool("doks-bootstrap",
+				mcp.WithDescription("Set up a Kubernetes cluster in one call: creates it with one node pool, waits until it is running, links the account's container registry so workloads can pull from it, installs 1-Click apps and returns its kubeconfig. The region, version, node size, registry and apps are checked before the cluster is created. If a later step fails, the cluster is kept and the result reports the failed step, which can be retried with doks-*, 1-click-kubernetes-app-install or doks-credentials-get."),
+				common.WithOutputSchema[BootstrapResult](),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the cluster")),
+				mcp.WithString("Region", mcp.Required(), mcp.Description("Slug of the region (e.g., nyc1)")),
+				mcp.WithString("Version", mcp.DefaultString("latest"), mcp.Description("Kubernetes version slug, e.g. 1.33.1-do.0, or latest")),
+				mcp.WithString("NodeSize", mcp.DefaultString(defaultBootstrapNodeSize), mcp.Description("Droplet size slug of the nodes")),
+				mcp.WithNumber("NodeCount", mcp.DefaultNumber(defaultBootstrapNodeCount), mcp.Min(1), mcp.Description("Number of nodes")),
+				mcp.WithArray("Tags", mcp.Description("Tags to apply to the cluster"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithString("VPCUUID", mcp.Description("VPC to create the cluster in, instead of the region's default VPC")),
+				mcp.WithBoolean("LinkRegistry", mcp.DefaultBool(true), mcp.Description("Link the account's container registry to the cluster")),
+				mcp.WithArray("Apps", mcp.Description("Slugs of Kubernetes 1-Click apps to install, e.g. ingress-nginx, cert-manager or monitoring"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithString("OutputPath", mcp.Description("File to write the kubeconfig to instead of returning it, relative to or below the server's --kubeconfig-dir")),
+				mcp.WithNumber("TimeoutSeconds", mcp.DefaultNumber(defaultBootstrapTimeout), mcp.Description("How long to wait for the cluster to be running")),
+			),
+		},
+	}
+}
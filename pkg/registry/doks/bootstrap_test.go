@@ -0,0 +1,138 @@
+package doks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBootstrapTool_bootstrap(t *testing.T) {
+	options := &godo.KubernetesOptions{
+		Versions: []*godo.KubernetesVersion{{Slug: "1.33.1-do.0", KubernetesVersion: "1.33.1"}, {Slug: "1.32.5-do.0", KubernetesVersion: "1.32.5"}},
+		Regions:  []*godo.KubernetesRegion{{Slug: "nyc1"}, {Slug: "ams3"}},
+		Sizes:    []*godo.KubernetesNodeSize{{Slug: "s-2vcpu-4gb"}, {Slug: "s-4vcpu-8gb"}},
+	}
+	type mocks struct {
+		kubernetes *MockKubernetesService
+		registry   *MockRegistryService
+		oneClick   *MockOneClickService
+	}
+	setup := func(t *testing.T, fileDir string) (*BootstrapTool, mocks) {
+		ctrl := gomock.NewController(t)
+		m := mocks{NewMockKubernetesService(ctrl), NewMockRegistryService(ctrl), NewMockOneClickService(ctrl)}
+		m.kubernetes.EXPECT().GetOptions(gomock.Any()).Return(options, nil, nil)
+		tool := NewBootstrapTool(func(ctx context.Context) (*godo.Client, error) {
+			return &godo.Client{Kubernetes: m.kubernetes, Registry: m.registry, OneClick: m.oneClick}, nil
+		}, fileDir)
+		tool.pollInterval = time.Millisecond
+		return tool, m
+	}
+	call := func(t *testing.T, tool *BootstrapTool, args map[string]any) (*mcp.CallToolResult, *BootstrapResult) {
+		t.Helper()
+		resp, err := tool.bootstrap(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		result, _ := resp.StructuredContent.(*BootstrapResult)
+		return resp, result
+	}
+	expectRunning := func(m mocks) {
+		m.kubernetes.EXPECT().Create(gomock.Any(), &godo.KubernetesClusterCreateRequest{
+			Name: "dev", RegionSlug: "nyc1", VersionSlug: "1.33.1-do.0",
+			NodePools: []*godo.KubernetesNodePoolCreateRequest{{Name: "dev-default-pool", Size: "s-2vcpu-4gb", Count: 3}},
+		}).Return(&godo.KubernetesCluster{ID: "k8s-1", Name: "dev"}, nil, nil)
+		gomock.InOrder(
+			m.kubernetes.EXPECT().Get(gomock.Any(), "k8s-1").Return(&godo.KubernetesCluster{ID: "k8s-1", Status: &godo.KubernetesClusterStatus{State: godo.KubernetesClusterStatusProvisioning}}, nil, nil),
+			m.kubernetes.EXPECT().Get(gomock.Any(), "k8s-1").Return(&godo.KubernetesCluster{ID: "k8s-1", Name: "dev", Endpoint: "https://k8s-1.k8s.ondigitalocean.com", Status: &godo.KubernetesClusterStatus{State: godo.KubernetesClusterStatusRunning}}, nil, nil),
+		)
+	}
+	oneClicks := []*godo.OneClick{{Slug: "ingress-nginx", Type: "kubernetes"}, {Slug: "cert-manager", Type: "kubernetes"}}
+
+	t.Run("Creates, links, installs and returns the kubeconfig", func(t *testing.T) {
+		tool, m := setup(t, "")
+		m.registry.EXPECT().Get(gomock.Any()).Return(&godo.Registry{Name: "acme"}, nil, nil)
+		m.oneClick.EXPECT().List(gomock.Any(), "kubernetes").Return(oneClicks, nil, nil)
+		expectRunning(m)
+		m.kubernetes.EXPECT().AddRegistry(gomock.Any(), &godo.KubernetesClusterRegistryRequest{ClusterUUIDs: []string{"k8s-1"}}).Return(nil, nil)
+		m.oneClick.EXPECT().InstallKubernetes(gomock.Any(), &godo.InstallKubernetesAppsRequest{ClusterUUID: "k8s-1", Slugs: []string{"ingress-nginx", "cert-manager"}}).
+			Return(&godo.InstallKubernetesAppsResponse{Message: "Successfully kicked off addon job."}, nil, nil)
+		m.kubernetes.EXPECT().GetKubeConfig(gomock.Any(), "k8s-1", nil).Return(&godo.KubernetesClusterConfig{KubeconfigYAML: []byte("apiVersion: v1\n")}, nil, nil)
+
+		resp, result := call(t, tool, map[string]any{"Name": "dev", "Region": "nyc1", "Apps": []any{"ingress-nginx", "cert-manager"}})
+		require.False(t, resp.IsError)
+		require.True(t, result.Succeeded)
+		require.Equal(t, "acme", result.Registry)
+		require.Equal(t, "https://k8s-1.k8s.ondigitalocean.com", result.Cluster.Endpoint)
+		require.Equal(t, "apiVersion: v1\n", result.Kubeconfig)
+		var steps []string
+		for _, step := range result.Steps {
+			steps = append(steps, step.Step+" "+step.Status)
+		}
+		require.Equal(t, []string{"cluster create done", "wait for running done", "registry link done", "apps install done", "kubeconfig done"}, steps)
+	})
+
+	t.Run("Writes the kubeconfig to a file", func(t *testing.T) {
+		dir := t.TempDir()
+		tool, m := setup(t, dir)
+		expectRunning(m)
+		m.kubernetes.EXPECT().GetKubeConfig(gomock.Any(), "k8s-1", nil).Return(&godo.KubernetesClusterConfig{KubeconfigYAML: []byte("apiVersion: v1\n")}, nil, nil)
+
+		_, result := call(t, tool, map[string]any{"Name": "dev", "Region": "nyc1", "LinkRegistry": false, "OutputPath": "dev.yaml"})
+		require.True(t, result.Succeeded)
+		require.Empty(t, result.Kubeconfig)
+		require.Equal(t, filepath.Join(dir, "dev.yaml"), result.KubeconfigPath)
+		data, err := os.ReadFile(result.KubeconfigPath)
+		require.NoError(t, err)
+		require.Equal(t, "apiVersion: v1\n", string(data))
+	})
+
+	t.Run("Keeps the cluster when a later step fails", func(t *testing.T) {
+		tool, m := setup(t, "")
+		m.registry.EXPECT().Get(gomock.Any()).Return(&godo.Registry{Name: "acme"}, nil, nil)
+		expectRunning(m)
+		m.kubernetes.EXPECT().AddRegistry(gomock.Any(), gomock.Any()).Return(nil, errors.New("registry is being deleted"))
+
+		resp, result := call(t, tool, map[string]any{"Name": "dev", "Region": "nyc1"})
+		require.False(t, resp.IsError)
+		require.False(t, result.Succeeded)
+		require.Equal(t, "k8s-1", result.Cluster.ID)
+		require.Equal(t, "registry link: registry is being deleted", result.Error)
+	})
+
+	t.Run("No registry fails before creating", func(t *testing.T) {
+		tool, m := setup(t, "")
+		m.registry.EXPECT().Get(gomock.Any()).Return(nil, &godo.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errors.New("not found"))
+
+		resp, _ := call(t, tool, map[string]any{"Name": "dev", "Region": "nyc1"})
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "has no container registry")
+	})
+
+	t.Run("Unknown app fails before creating", func(t *testing.T) {
+		tool, m := setup(t, "")
+		m.oneClick.EXPECT().List(gomock.Any(), "kubernetes").Return(oneClicks, nil, nil)
+
+		resp, _ := call(t, tool, map[string]any{"Name": "dev", "Region": "nyc1", "LinkRegistry": false, "Apps": []any{"ingress-nginx", "wordpress"}})
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "wordpress are not Kubernetes 1-Click apps")
+	})
+
+	t.Run("Version and region are checked", func(t *testing.T) {
+		tool, _ := setup(t, "")
+		resp, _ := call(t, tool, map[string]any{"Name": "dev", "Region": "nyc1", "Version": "1.29"})
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "version 1.29 is not offered")
+
+		tool, _ = setup(t, "")
+		resp, _ = call(t, tool, map[string]any{"Name": "dev", "Region": "sfo2", "Version": "1.32.5"})
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "clusters cannot be created in region sfo2")
+	})
+}
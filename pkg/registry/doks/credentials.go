@@ -0,0 +1,192 @@
+package doks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ClusterCredentials is the result of doks-credentials-get. When the kubeconfig was written to
+// a file, Kubeconfig is left empty and Path says where it is.
+type ClusterCredentials struct {
+	ClusterID  string     `json:"cluster_id"`
+	Format     string     `json:"format"`
+	Server     string     `json:"server,omitempty"`
+	Kubeconfig string     `json:"kubeconfig,omitempty"`
+	Token      string     `json:"token,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	Path       string     `json:"path,omitempty"`
+}
+
+// CredentialsTool fetches cluster credentials and optionally writes kubeconfigs to files below
+// fileDir. Writing is disabled when fileDir is empty.
+type CredentialsTool struct {
+	client  func(ctx context.Context) (*godo.Client, error)
+	fileDir string
+	now     func() time.Time
+}
+
+// NewCredentialsTool creates a cluster credentials tool that may write kubeconfigs below
+// fileDir, or nowhere if it is empty.
+func NewCredentialsTool(client func(ctx context.Context) (*godo.Client, error), fileDir string) *CredentialsTool {
+	return &CredentialsTool{client: client, fileDir: fileDir, now: time.Now}
+}
+
+// writeKubeconfig writes data to path, relative to or below dir, readable only by its owner.
+// Paths that leave dir, including through symlinks, are refused, as is replacing an existing file
+// unless overwrite is set.
+func writeKubeconfig(dir, path string, data []byte, overwrite bool) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	rel := path
+	if filepath.IsAbs(path) {
+		if rel, err = filepath.Rel(dir, path); err != nil {
+			return "", fmt.Errorf("%s is not below %s", path, dir)
+		}
+	}
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("%s is not below %s", path, dir)
+	}
+
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return "", err
+	}
+	defer root.Close()
+
+	if parent := filepath.Dir(rel); parent != "." {
+		if err := root.MkdirAll(parent, 0o700); err != nil {
+			return "", err
+		}
+	}
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !overwrite {
+		flags |= os.O_EXCL
+	}
+	f, err := root.OpenFile(rel, flags, 0o600)
+	if errors.Is(err, fs.ErrExist) {
+		return "", fmt.Errorf("%s already exists; pass Overwrite: true to replace it", path)
+	}
+	if err != nil {
+		return "", err
+	}
+	// a replaced file keeps its mode, so tighten it in case it was readable by others.
+	if err := f.Chmod(0o600); err != nil {
+		f.Close()
+		return "", err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, rel), nil
+}
+
+func (c *CredentialsTool) getCredentials(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	clusterID := args.RequiredString("ClusterID")
+	format := args.String("Format")
+	expirySeconds := int64(args.Number("ExpirySeconds", 0))
+	outputPath := args.String("OutputPath")
+	overwrite := args.Bool("Overwrite", false)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if format == "" {
+		format = "kubeconfig"
+	}
+	if format != "kubeconfig" && format != "token" {
+		return mcp.NewToolResultError(fmt.Sprintf("unknown Format %q; use kubeconfig or token", format)), nil
+	}
+	if expirySeconds < 0 {
+		return mcp.NewToolResultError("ExpirySeconds must not be negative"), nil
+	}
+	if outputPath != "" {
+		if c.fileDir == "" {
+			return mcp.NewToolResultError("writing credentials to files is disabled; the server operator enables it with --kubeconfig-dir"), nil
+		}
+		if format != "kubeconfig" {
+			return mcp.NewToolResultError("OutputPath is only supported with Format kubeconfig"), nil
+		}
+	}
+
+	client, err := c.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	result := &ClusterCredentials{ClusterID: clusterID, Format: format}
+	if format == "token" {
+		credsReq := &godo.KubernetesClusterCredentialsGetRequest{}
+		if expirySeconds > 0 {
+			seconds := int(expirySeconds)
+			credsReq.ExpirySeconds = &seconds
+		}
+		creds, _, err := client.Kubernetes.GetCredentials(ctx, clusterID, credsReq)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		result.Server = creds.Server
+		result.Token = creds.Token
+		if !creds.ExpiresAt.IsZero() {
+			result.ExpiresAt = &creds.ExpiresAt
+		}
+		return common.NewToolResultStructured(result)
+	}
+
+	var kubeconfig *godo.KubernetesClusterConfig
+	if expirySeconds > 0 {
+		kubeconfig, _, err = client.Kubernetes.GetKubeConfigWithExpiry(ctx, clusterID, expirySeconds)
+	} else {
+		kubeconfig, _, err = client.Kubernetes.GetKubeConfig(ctx, clusterID, nil)
+	}
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if expirySeconds > 0 {
+		expiresAt := c.now().Add(time.Duration(expirySeconds) * time.Second).UTC()
+		result.ExpiresAt = &expiresAt
+	}
+	if outputPath == "" {
+		result.Kubeconfig = string(kubeconfig.KubeconfigYAML)
+		return common.NewToolResultStructured(result)
+	}
+	result.Path, err = writeKubeconfig(c.fileDir, outputPath, kubeconfig.KubeconfigYAML, overwrite)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("failed to write kubeconfig", err), nil
+	}
+	return common.NewToolResultStructured(result)
+}
+
+// Tools returns the cluster credentials tools.
+func (c *CredentialsTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: c.getCredentials,
+			Tool: mcp.NewTool("doks-credentials-get",
+				mcp.WithDescription("Get a kubeconfig or bearer token for a DigitalOcean Kubernetes cluster that expires after ExpirySeconds. With OutputPath, the kubeconfig is written to a file readable only by the server's user instead of being returned, so kubectl can use it; the server must be started with --kubeconfig-dir, and the file must be below that directory. A returned kubeconfig or token is redacted unless IncludeSecrets is true."),
+				mcp.WithString("ClusterID", mcp.Required(), mcp.Description("The ID of the Kubernetes cluster")),
+				mcp.WithString("Format", mcp.DefaultString("kubeconfig"), mcp.Enum("kubeconfig", "token"), mcp.Description("kubeconfig for a complete kubeconfig, or token for the server URL and a bearer token")),
+				mcp.WithNumber("ExpirySeconds", mcp.Min(0), mcp.Description("Seconds until the credentials expire. When 0 or omitted, the API default of 7 days applies")),
+				mcp.WithString("OutputPath", mcp.Description("File to write the kubeconfig to, relative to or below the server's --kubeconfig-dir. Only supported with Format kubeconfig")),
+				mcp.WithBoolean("Overwrite", mcp.DefaultBool(false), mcp.Description("Replace OutputPath if it already exists")),
+				common.WithOutputSchema[ClusterCredentials](),
+			),
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package doks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteKubeconfig(t *testing.T) {
+	dir := t.TempDir()
+	outside := t.TempDir()
+	require.NoError(t, os.Symlink(outside, filepath.Join(dir, "escape")))
+
+	path, err := writeKubeconfig(dir, "clusters/prod.yaml", []byte("a"), false)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "clusters", "prod.yaml"), path)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	_, err = writeKubeconfig(dir, path, []byte("b"), false)
+	require.ErrorContains(t, err, "already exists")
+	_, err = writeKubeconfig(dir, path, []byte("b"), true)
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "b", string(data))
+
+	for _, p := range []string{"../prod.yaml", filepath.Join(outside, "prod.yaml"), "escape/prod.yaml"} {
+		_, err := writeKubeconfig(dir, p, []byte("a"), false)
+		require.Error(t, err, p)
+	}
+	entries, err := os.ReadDir(outside)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
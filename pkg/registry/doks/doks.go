@@ -10,6 +10,8 @@ import (
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	_ "embed"
 )
 
@@ -19,6 +21,9 @@ var clusterCreateSchemaJSON []byte
 //go:embed spec/node-pool-create-schema.json
 var nodePoolCreateSchemaJSON []byte
 
+//go:embed examples/doks-create-cluster.json
+var clusterCreateExample []byte
+
 type DoksTool struct {
 	client func(ctx context.Context) (*godo.Client, error)
 }
@@ -804,14 +809,15 @@ func (d *DoksTool) Tools() []server.ServerTool {
 			Handler: d.listDOKSClusters,
 			Tool: mcp.NewTool("doks-list-clusters",
 				mcp.WithDescription("List all DigitalOcean Kubernetes clusters"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number of the results to fetch")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Number of items returned per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number of the results to fetch")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Number of items returned per page")),
 			),
 		},
 		{
 			Handler: d.createDOKSCluster,
-			Tool: mcp.NewToolWithRawSchema("doks-create-cluster",
+			Tool: common.NewToolWithRawSchema("doks-create-cluster",
 				"Create a new DigitalOcean Kubernetes cluster", clusterCreateSchemaJSON,
+				common.WithExample(clusterCreateExample),
 			),
 		},
 		{
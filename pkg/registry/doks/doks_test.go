@@ -0,0 +1,88 @@
+package doks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"mcp-digitalocean/internal/testhelpers"
+)
+
+func TestDoksTool_createDOKSClusterExample(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	args := map[string]any{
+		"name":    "prod-cluster",
+		"region":  "nyc1",
+		"version": "1.31.1-do.0",
+		"tags":    []any{"production"},
+		"node_pools": []any{
+			map[string]any{
+				"name":       "workers",
+				"size":       "s-2vcpu-4gb",
+				"count":      float64(3),
+				"auto_scale": true,
+				"min_nodes":  float64(3),
+				"max_nodes":  float64(6),
+			},
+		},
+		"maintenance_policy": map[string]any{"start_time": "04:00"},
+		"auto_upgrade":       true,
+		"surge_upgrade":      true,
+	}
+	created := time.Date(2026, 3, 2, 17, 3, 29, 0, time.UTC)
+	kubernetes := NewMockKubernetesService(ctrl)
+	kubernetes.EXPECT().
+		Create(gomock.Any(), &godo.KubernetesClusterCreateRequest{
+			Name:        "prod-cluster",
+			RegionSlug:  "nyc1",
+			VersionSlug: "1.31.1-do.0",
+			Tags:        []string{"production"},
+			NodePools: []*godo.KubernetesNodePoolCreateRequest{
+				{Name: "workers", Size: "s-2vcpu-4gb", Count: 3, AutoScale: true, MinNodes: 3, MaxNodes: 6},
+			},
+			MaintenancePolicy: &godo.KubernetesMaintenancePolicy{StartTime: "04:00"},
+			AutoUpgrade:       true,
+			SurgeUpgrade:      true,
+		}).
+		Return(&godo.KubernetesCluster{
+			ID:            "bd5f5959-5e1e-4205-a714-a914373942af",
+			Name:          "prod-cluster",
+			RegionSlug:    "nyc1",
+			VersionSlug:   "1.31.1-do.0",
+			ClusterSubnet: "10.244.0.0/16",
+			ServiceSubnet: "10.245.0.0/16",
+			VPCUUID:       "c33931f2-a26a-4e61-b85c-4e95a2ec431b",
+			Tags:          []string{"production", "k8s", "k8s:bd5f5959-5e1e-4205-a714-a914373942af"},
+			NodePools: []*godo.KubernetesNodePool{
+				{
+					ID:        "cdda885e-7663-40c8-bc74-3a036c66545d",
+					Name:      "workers",
+					Size:      "s-2vcpu-4gb",
+					Count:     3,
+					AutoScale: true,
+					MinNodes:  3,
+					MaxNodes:  6,
+				},
+			},
+			MaintenancePolicy: &godo.KubernetesMaintenancePolicy{StartTime: "04:00", Duration: "4h0m0s", Day: godo.KubernetesMaintenanceDayAny},
+			AutoUpgrade:       true,
+			SurgeUpgrade:      true,
+			Status:            &godo.KubernetesClusterStatus{State: godo.KubernetesClusterStatusProvisioning, Message: "provisioning"},
+			CreatedAt:         created,
+			UpdatedAt:         created,
+		}, nil, nil)
+
+	tool := NewDoksTool(func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{Kubernetes: kubernetes}, nil
+	})
+	resp, err := tool.createDOKSCluster(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	require.NoError(t, err)
+	testhelpers.CheckExample(t, "examples/doks-create-cluster.json", args, resp)
+}
@@ -0,0 +1,850 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/digitalocean/godo (interfaces: KubernetesService,RegistryService,OneClickService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks.go -package doks github.com/digitalocean/godo KubernetesService,RegistryService,OneClickService
+//
+
+// Package doks is a generated GoMock package.
+package doks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	godo "github.com/digitalocean/godo"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockKubernetesService is a mock of KubernetesService interface.
+type MockKubernetesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockKubernetesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockKubernetesServiceMockRecorder is the mock recorder for MockKubernetesService.
+type MockKubernetesServiceMockRecorder struct {
+	mock *MockKubernetesService
+}
+
+// NewMockKubernetesService creates a new mock instance.
+func NewMockKubernetesService(ctrl *gomock.Controller) *MockKubernetesService {
+	mock := &MockKubernetesService{ctrl: ctrl}
+	mock.recorder = &MockKubernetesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKubernetesService) EXPECT() *MockKubernetesServiceMockRecorder {
+	return m.recorder
+}
+
+// AddRegistry mocks base method.
+func (m *MockKubernetesService) AddRegistry(ctx context.Context, req *godo.KubernetesClusterRegistryRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRegistry", ctx, req)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRegistry indicates an expected call of AddRegistry.
+func (mr *MockKubernetesServiceMockRecorder) AddRegistry(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRegistry", reflect.TypeOf((*MockKubernetesService)(nil).AddRegistry), ctx, req)
+}
+
+// Create mocks base method.
+func (m *MockKubernetesService) Create(arg0 context.Context, arg1 *godo.KubernetesClusterCreateRequest) (*godo.KubernetesCluster, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KubernetesCluster)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockKubernetesServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKubernetesService)(nil).Create), arg0, arg1)
+}
+
+// CreateNodePool mocks base method.
+func (m *MockKubernetesService) CreateNodePool(ctx context.Context, clusterID string, req *godo.KubernetesNodePoolCreateRequest) (*godo.KubernetesNodePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNodePool", ctx, clusterID, req)
+	ret0, _ := ret[0].(*godo.KubernetesNodePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateNodePool indicates an expected call of CreateNodePool.
+func (mr *MockKubernetesServiceMockRecorder) CreateNodePool(ctx, clusterID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNodePool", reflect.TypeOf((*MockKubernetesService)(nil).CreateNodePool), ctx, clusterID, req)
+}
+
+// Delete mocks base method.
+func (m *MockKubernetesService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockKubernetesServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKubernetesService)(nil).Delete), arg0, arg1)
+}
+
+// DeleteDangerous mocks base method.
+func (m *MockKubernetesService) DeleteDangerous(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDangerous", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDangerous indicates an expected call of DeleteDangerous.
+func (mr *MockKubernetesServiceMockRecorder) DeleteDangerous(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDangerous", reflect.TypeOf((*MockKubernetesService)(nil).DeleteDangerous), arg0, arg1)
+}
+
+// DeleteNode mocks base method.
+func (m *MockKubernetesService) DeleteNode(ctx context.Context, clusterID, poolID, nodeID string, req *godo.KubernetesNodeDeleteRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNode", ctx, clusterID, poolID, nodeID, req)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNode indicates an expected call of DeleteNode.
+func (mr *MockKubernetesServiceMockRecorder) DeleteNode(ctx, clusterID, poolID, nodeID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNode", reflect.TypeOf((*MockKubernetesService)(nil).DeleteNode), ctx, clusterID, poolID, nodeID, req)
+}
+
+// DeleteNodePool mocks base method.
+func (m *MockKubernetesService) DeleteNodePool(ctx context.Context, clusterID, poolID string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNodePool", ctx, clusterID, poolID)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNodePool indicates an expected call of DeleteNodePool.
+func (mr *MockKubernetesServiceMockRecorder) DeleteNodePool(ctx, clusterID, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNodePool", reflect.TypeOf((*MockKubernetesService)(nil).DeleteNodePool), ctx, clusterID, poolID)
+}
+
+// DeleteSelective mocks base method.
+func (m *MockKubernetesService) DeleteSelective(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterDeleteSelectiveRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSelective", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSelective indicates an expected call of DeleteSelective.
+func (mr *MockKubernetesServiceMockRecorder) DeleteSelective(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSelective", reflect.TypeOf((*MockKubernetesService)(nil).DeleteSelective), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockKubernetesService) Get(arg0 context.Context, arg1 string) (*godo.KubernetesCluster, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KubernetesCluster)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockKubernetesServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKubernetesService)(nil).Get), arg0, arg1)
+}
+
+// GetClusterStatusMessages mocks base method.
+func (m *MockKubernetesService) GetClusterStatusMessages(ctx context.Context, clusterID string, req *godo.KubernetesGetClusterStatusMessagesRequest) ([]*godo.KubernetesClusterStatusMessage, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterStatusMessages", ctx, clusterID, req)
+	ret0, _ := ret[0].([]*godo.KubernetesClusterStatusMessage)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterStatusMessages indicates an expected call of GetClusterStatusMessages.
+func (mr *MockKubernetesServiceMockRecorder) GetClusterStatusMessages(ctx, clusterID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterStatusMessages", reflect.TypeOf((*MockKubernetesService)(nil).GetClusterStatusMessages), ctx, clusterID, req)
+}
+
+// GetClusterlintResults mocks base method.
+func (m *MockKubernetesService) GetClusterlintResults(ctx context.Context, clusterID string, req *godo.KubernetesGetClusterlintRequest) ([]*godo.ClusterlintDiagnostic, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterlintResults", ctx, clusterID, req)
+	ret0, _ := ret[0].([]*godo.ClusterlintDiagnostic)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterlintResults indicates an expected call of GetClusterlintResults.
+func (mr *MockKubernetesServiceMockRecorder) GetClusterlintResults(ctx, clusterID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterlintResults", reflect.TypeOf((*MockKubernetesService)(nil).GetClusterlintResults), ctx, clusterID, req)
+}
+
+// GetCredentials mocks base method.
+func (m *MockKubernetesService) GetCredentials(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterCredentialsGetRequest) (*godo.KubernetesClusterCredentials, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCredentials", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.KubernetesClusterCredentials)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCredentials indicates an expected call of GetCredentials.
+func (mr *MockKubernetesServiceMockRecorder) GetCredentials(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredentials", reflect.TypeOf((*MockKubernetesService)(nil).GetCredentials), arg0, arg1, arg2)
+}
+
+// GetKubeConfig mocks base method.
+func (m *MockKubernetesService) GetKubeConfig(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterKubeconfigGetRequest) (*godo.KubernetesClusterConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubeConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.KubernetesClusterConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKubeConfig indicates an expected call of GetKubeConfig.
+func (mr *MockKubernetesServiceMockRecorder) GetKubeConfig(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeConfig", reflect.TypeOf((*MockKubernetesService)(nil).GetKubeConfig), arg0, arg1, arg2)
+}
+
+// GetKubeConfigWithExpiry mocks base method.
+func (m *MockKubernetesService) GetKubeConfigWithExpiry(arg0 context.Context, arg1 string, arg2 int64) (*godo.KubernetesClusterConfig, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubeConfigWithExpiry", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.KubernetesClusterConfig)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKubeConfigWithExpiry indicates an expected call of GetKubeConfigWithExpiry.
+func (mr *MockKubernetesServiceMockRecorder) GetKubeConfigWithExpiry(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeConfigWithExpiry", reflect.TypeOf((*MockKubernetesService)(nil).GetKubeConfigWithExpiry), arg0, arg1, arg2)
+}
+
+// GetNodePool mocks base method.
+func (m *MockKubernetesService) GetNodePool(ctx context.Context, clusterID, poolID string) (*godo.KubernetesNodePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodePool", ctx, clusterID, poolID)
+	ret0, _ := ret[0].(*godo.KubernetesNodePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNodePool indicates an expected call of GetNodePool.
+func (mr *MockKubernetesServiceMockRecorder) GetNodePool(ctx, clusterID, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodePool", reflect.TypeOf((*MockKubernetesService)(nil).GetNodePool), ctx, clusterID, poolID)
+}
+
+// GetNodePoolTemplate mocks base method.
+func (m *MockKubernetesService) GetNodePoolTemplate(ctx context.Context, clusterID, nodePoolName string) (*godo.KubernetesNodePoolTemplate, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodePoolTemplate", ctx, clusterID, nodePoolName)
+	ret0, _ := ret[0].(*godo.KubernetesNodePoolTemplate)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNodePoolTemplate indicates an expected call of GetNodePoolTemplate.
+func (mr *MockKubernetesServiceMockRecorder) GetNodePoolTemplate(ctx, clusterID, nodePoolName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodePoolTemplate", reflect.TypeOf((*MockKubernetesService)(nil).GetNodePoolTemplate), ctx, clusterID, nodePoolName)
+}
+
+// GetOptions mocks base method.
+func (m *MockKubernetesService) GetOptions(arg0 context.Context) (*godo.KubernetesOptions, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptions", arg0)
+	ret0, _ := ret[0].(*godo.KubernetesOptions)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOptions indicates an expected call of GetOptions.
+func (mr *MockKubernetesServiceMockRecorder) GetOptions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptions", reflect.TypeOf((*MockKubernetesService)(nil).GetOptions), arg0)
+}
+
+// GetUpgrades mocks base method.
+func (m *MockKubernetesService) GetUpgrades(arg0 context.Context, arg1 string) ([]*godo.KubernetesVersion, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpgrades", arg0, arg1)
+	ret0, _ := ret[0].([]*godo.KubernetesVersion)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUpgrades indicates an expected call of GetUpgrades.
+func (mr *MockKubernetesServiceMockRecorder) GetUpgrades(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpgrades", reflect.TypeOf((*MockKubernetesService)(nil).GetUpgrades), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockKubernetesService) GetUser(arg0 context.Context, arg1 string) (*godo.KubernetesClusterUser, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KubernetesClusterUser)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockKubernetesServiceMockRecorder) GetUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockKubernetesService)(nil).GetUser), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockKubernetesService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]*godo.KubernetesCluster, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]*godo.KubernetesCluster)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockKubernetesServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKubernetesService)(nil).List), arg0, arg1)
+}
+
+// ListAssociatedResourcesForDeletion mocks base method.
+func (m *MockKubernetesService) ListAssociatedResourcesForDeletion(arg0 context.Context, arg1 string) (*godo.KubernetesAssociatedResources, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssociatedResourcesForDeletion", arg0, arg1)
+	ret0, _ := ret[0].(*godo.KubernetesAssociatedResources)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAssociatedResourcesForDeletion indicates an expected call of ListAssociatedResourcesForDeletion.
+func (mr *MockKubernetesServiceMockRecorder) ListAssociatedResourcesForDeletion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssociatedResourcesForDeletion", reflect.TypeOf((*MockKubernetesService)(nil).ListAssociatedResourcesForDeletion), arg0, arg1)
+}
+
+// ListNodePools mocks base method.
+func (m *MockKubernetesService) ListNodePools(ctx context.Context, clusterID string, opts *godo.ListOptions) ([]*godo.KubernetesNodePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNodePools", ctx, clusterID, opts)
+	ret0, _ := ret[0].([]*godo.KubernetesNodePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListNodePools indicates an expected call of ListNodePools.
+func (mr *MockKubernetesServiceMockRecorder) ListNodePools(ctx, clusterID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodePools", reflect.TypeOf((*MockKubernetesService)(nil).ListNodePools), ctx, clusterID, opts)
+}
+
+// RecycleNodePoolNodes mocks base method.
+func (m *MockKubernetesService) RecycleNodePoolNodes(ctx context.Context, clusterID, poolID string, req *godo.KubernetesNodePoolRecycleNodesRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecycleNodePoolNodes", ctx, clusterID, poolID, req)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecycleNodePoolNodes indicates an expected call of RecycleNodePoolNodes.
+func (mr *MockKubernetesServiceMockRecorder) RecycleNodePoolNodes(ctx, clusterID, poolID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecycleNodePoolNodes", reflect.TypeOf((*MockKubernetesService)(nil).RecycleNodePoolNodes), ctx, clusterID, poolID, req)
+}
+
+// RemoveRegistry mocks base method.
+func (m *MockKubernetesService) RemoveRegistry(ctx context.Context, req *godo.KubernetesClusterRegistryRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRegistry", ctx, req)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRegistry indicates an expected call of RemoveRegistry.
+func (mr *MockKubernetesServiceMockRecorder) RemoveRegistry(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRegistry", reflect.TypeOf((*MockKubernetesService)(nil).RemoveRegistry), ctx, req)
+}
+
+// RunClusterlint mocks base method.
+func (m *MockKubernetesService) RunClusterlint(ctx context.Context, clusterID string, req *godo.KubernetesRunClusterlintRequest) (string, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunClusterlint", ctx, clusterID, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RunClusterlint indicates an expected call of RunClusterlint.
+func (mr *MockKubernetesServiceMockRecorder) RunClusterlint(ctx, clusterID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunClusterlint", reflect.TypeOf((*MockKubernetesService)(nil).RunClusterlint), ctx, clusterID, req)
+}
+
+// Update mocks base method.
+func (m *MockKubernetesService) Update(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterUpdateRequest) (*godo.KubernetesCluster, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.KubernetesCluster)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockKubernetesServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKubernetesService)(nil).Update), arg0, arg1, arg2)
+}
+
+// UpdateNodePool mocks base method.
+func (m *MockKubernetesService) UpdateNodePool(ctx context.Context, clusterID, poolID string, req *godo.KubernetesNodePoolUpdateRequest) (*godo.KubernetesNodePool, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodePool", ctx, clusterID, poolID, req)
+	ret0, _ := ret[0].(*godo.KubernetesNodePool)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateNodePool indicates an expected call of UpdateNodePool.
+func (mr *MockKubernetesServiceMockRecorder) UpdateNodePool(ctx, clusterID, poolID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodePool", reflect.TypeOf((*MockKubernetesService)(nil).UpdateNodePool), ctx, clusterID, poolID, req)
+}
+
+// Upgrade mocks base method.
+func (m *MockKubernetesService) Upgrade(arg0 context.Context, arg1 string, arg2 *godo.KubernetesClusterUpgradeRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upgrade", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upgrade indicates an expected call of Upgrade.
+func (mr *MockKubernetesServiceMockRecorder) Upgrade(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockKubernetesService)(nil).Upgrade), arg0, arg1, arg2)
+}
+
+// MockRegistryService is a mock of RegistryService interface.
+type MockRegistryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRegistryServiceMockRecorder is the mock recorder for MockRegistryService.
+type MockRegistryServiceMockRecorder struct {
+	mock *MockRegistryService
+}
+
+// NewMockRegistryService creates a new mock instance.
+func NewMockRegistryService(ctrl *gomock.Controller) *MockRegistryService {
+	mock := &MockRegistryService{ctrl: ctrl}
+	mock.recorder = &MockRegistryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistryService) EXPECT() *MockRegistryServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRegistryService) Create(arg0 context.Context, arg1 *godo.RegistryCreateRequest) (*godo.Registry, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Registry)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRegistryServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRegistryService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockRegistryService) Delete(arg0 context.Context) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRegistryServiceMockRecorder) Delete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRegistryService)(nil).Delete), arg0)
+}
+
+// DeleteManifest mocks base method.
+func (m *MockRegistryService) DeleteManifest(arg0 context.Context, arg1, arg2, arg3 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManifest", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManifest indicates an expected call of DeleteManifest.
+func (mr *MockRegistryServiceMockRecorder) DeleteManifest(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManifest", reflect.TypeOf((*MockRegistryService)(nil).DeleteManifest), arg0, arg1, arg2, arg3)
+}
+
+// DeleteTag mocks base method.
+func (m *MockRegistryService) DeleteTag(arg0 context.Context, arg1, arg2, arg3 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockRegistryServiceMockRecorder) DeleteTag(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockRegistryService)(nil).DeleteTag), arg0, arg1, arg2, arg3)
+}
+
+// DockerCredentials mocks base method.
+func (m *MockRegistryService) DockerCredentials(arg0 context.Context, arg1 *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DockerCredentials", arg0, arg1)
+	ret0, _ := ret[0].(*godo.DockerCredentials)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DockerCredentials indicates an expected call of DockerCredentials.
+func (mr *MockRegistryServiceMockRecorder) DockerCredentials(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DockerCredentials", reflect.TypeOf((*MockRegistryService)(nil).DockerCredentials), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockRegistryService) Get(arg0 context.Context) (*godo.Registry, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*godo.Registry)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRegistryServiceMockRecorder) Get(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRegistryService)(nil).Get), arg0)
+}
+
+// GetGarbageCollection mocks base method.
+func (m *MockRegistryService) GetGarbageCollection(arg0 context.Context, arg1 string) (*godo.GarbageCollection, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGarbageCollection", arg0, arg1)
+	ret0, _ := ret[0].(*godo.GarbageCollection)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGarbageCollection indicates an expected call of GetGarbageCollection.
+func (mr *MockRegistryServiceMockRecorder) GetGarbageCollection(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGarbageCollection", reflect.TypeOf((*MockRegistryService)(nil).GetGarbageCollection), arg0, arg1)
+}
+
+// GetOptions mocks base method.
+func (m *MockRegistryService) GetOptions(arg0 context.Context) (*godo.RegistryOptions, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptions", arg0)
+	ret0, _ := ret[0].(*godo.RegistryOptions)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOptions indicates an expected call of GetOptions.
+func (mr *MockRegistryServiceMockRecorder) GetOptions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptions", reflect.TypeOf((*MockRegistryService)(nil).GetOptions), arg0)
+}
+
+// GetSubscription mocks base method.
+func (m *MockRegistryService) GetSubscription(arg0 context.Context) (*godo.RegistrySubscription, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", arg0)
+	ret0, _ := ret[0].(*godo.RegistrySubscription)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockRegistryServiceMockRecorder) GetSubscription(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockRegistryService)(nil).GetSubscription), arg0)
+}
+
+// ListGarbageCollections mocks base method.
+func (m *MockRegistryService) ListGarbageCollections(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]*godo.GarbageCollection, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGarbageCollections", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*godo.GarbageCollection)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListGarbageCollections indicates an expected call of ListGarbageCollections.
+func (mr *MockRegistryServiceMockRecorder) ListGarbageCollections(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGarbageCollections", reflect.TypeOf((*MockRegistryService)(nil).ListGarbageCollections), arg0, arg1, arg2)
+}
+
+// ListRepositories mocks base method.
+func (m *MockRegistryService) ListRepositories(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]*godo.Repository, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositories", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*godo.Repository)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRepositories indicates an expected call of ListRepositories.
+func (mr *MockRegistryServiceMockRecorder) ListRepositories(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositories", reflect.TypeOf((*MockRegistryService)(nil).ListRepositories), arg0, arg1, arg2)
+}
+
+// ListRepositoriesV2 mocks base method.
+func (m *MockRegistryService) ListRepositoriesV2(arg0 context.Context, arg1 string, arg2 *godo.TokenListOptions) ([]*godo.RepositoryV2, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoriesV2", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*godo.RepositoryV2)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRepositoriesV2 indicates an expected call of ListRepositoriesV2.
+func (mr *MockRegistryServiceMockRecorder) ListRepositoriesV2(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoriesV2", reflect.TypeOf((*MockRegistryService)(nil).ListRepositoriesV2), arg0, arg1, arg2)
+}
+
+// ListRepositoryManifests mocks base method.
+func (m *MockRegistryService) ListRepositoryManifests(arg0 context.Context, arg1, arg2 string, arg3 *godo.ListOptions) ([]*godo.RepositoryManifest, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoryManifests", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*godo.RepositoryManifest)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRepositoryManifests indicates an expected call of ListRepositoryManifests.
+func (mr *MockRegistryServiceMockRecorder) ListRepositoryManifests(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoryManifests", reflect.TypeOf((*MockRegistryService)(nil).ListRepositoryManifests), arg0, arg1, arg2, arg3)
+}
+
+// ListRepositoryTags mocks base method.
+func (m *MockRegistryService) ListRepositoryTags(arg0 context.Context, arg1, arg2 string, arg3 *godo.ListOptions) ([]*godo.RepositoryTag, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoryTags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*godo.RepositoryTag)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRepositoryTags indicates an expected call of ListRepositoryTags.
+func (mr *MockRegistryServiceMockRecorder) ListRepositoryTags(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoryTags", reflect.TypeOf((*MockRegistryService)(nil).ListRepositoryTags), arg0, arg1, arg2, arg3)
+}
+
+// StartGarbageCollection mocks base method.
+func (m *MockRegistryService) StartGarbageCollection(arg0 context.Context, arg1 string, arg2 ...*godo.StartGarbageCollectionRequest) (*godo.GarbageCollection, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartGarbageCollection", varargs...)
+	ret0, _ := ret[0].(*godo.GarbageCollection)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// StartGarbageCollection indicates an expected call of StartGarbageCollection.
+func (mr *MockRegistryServiceMockRecorder) StartGarbageCollection(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartGarbageCollection", reflect.TypeOf((*MockRegistryService)(nil).StartGarbageCollection), varargs...)
+}
+
+// UpdateGarbageCollection mocks base method.
+func (m *MockRegistryService) UpdateGarbageCollection(arg0 context.Context, arg1, arg2 string, arg3 *godo.UpdateGarbageCollectionRequest) (*godo.GarbageCollection, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGarbageCollection", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.GarbageCollection)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateGarbageCollection indicates an expected call of UpdateGarbageCollection.
+func (mr *MockRegistryServiceMockRecorder) UpdateGarbageCollection(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGarbageCollection", reflect.TypeOf((*MockRegistryService)(nil).UpdateGarbageCollection), arg0, arg1, arg2, arg3)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockRegistryService) UpdateSubscription(arg0 context.Context, arg1 *godo.RegistrySubscriptionUpdateRequest) (*godo.RegistrySubscription, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*godo.RegistrySubscription)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockRegistryServiceMockRecorder) UpdateSubscription(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockRegistryService)(nil).UpdateSubscription), arg0, arg1)
+}
+
+// ValidateName mocks base method.
+func (m *MockRegistryService) ValidateName(arg0 context.Context, arg1 *godo.RegistryValidateNameRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateName", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateName indicates an expected call of ValidateName.
+func (mr *MockRegistryServiceMockRecorder) ValidateName(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateName", reflect.TypeOf((*MockRegistryService)(nil).ValidateName), arg0, arg1)
+}
+
+// MockOneClickService is a mock of OneClickService interface.
+type MockOneClickService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOneClickServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockOneClickServiceMockRecorder is the mock recorder for MockOneClickService.
+type MockOneClickServiceMockRecorder struct {
+	mock *MockOneClickService
+}
+
+// NewMockOneClickService creates a new mock instance.
+func NewMockOneClickService(ctrl *gomock.Controller) *MockOneClickService {
+	mock := &MockOneClickService{ctrl: ctrl}
+	mock.recorder = &MockOneClickServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOneClickService) EXPECT() *MockOneClickServiceMockRecorder {
+	return m.recorder
+}
+
+// InstallKubernetes mocks base method.
+func (m *MockOneClickService) InstallKubernetes(arg0 context.Context, arg1 *godo.InstallKubernetesAppsRequest) (*godo.InstallKubernetesAppsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallKubernetes", arg0, arg1)
+	ret0, _ := ret[0].(*godo.InstallKubernetesAppsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InstallKubernetes indicates an expected call of InstallKubernetes.
+func (mr *MockOneClickServiceMockRecorder) InstallKubernetes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallKubernetes", reflect.TypeOf((*MockOneClickService)(nil).InstallKubernetes), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockOneClickService) List(arg0 context.Context, arg1 string) ([]*godo.OneClick, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]*godo.OneClick)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockOneClickServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOneClickService)(nil).List), arg0, arg1)
+}
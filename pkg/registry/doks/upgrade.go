@@ -0,0 +1,248 @@
+package doks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// UpgradeOptions are the version and maintenance settings of a cluster and the versions it can
+// be upgraded to, oldest first.
+type UpgradeOptions struct {
+	ClusterID         string                            `json:"cluster_id"`
+	Name              string                            `json:"name"`
+	CurrentVersion    string                            `json:"current_version"`
+	AutoUpgrade       bool                              `json:"auto_upgrade"`
+	SurgeUpgrade      bool                              `json:"surge_upgrade"`
+	MaintenancePolicy *godo.KubernetesMaintenancePolicy `json:"maintenance_policy,omitempty"`
+	Upgrades          []*godo.KubernetesVersion         `json:"upgrades"`
+}
+
+// ClusterUpgradeResult is the result of doks-cluster-upgrade.
+type ClusterUpgradeResult struct {
+	ClusterID   string `json:"cluster_id"`
+	FromVersion string `json:"from_version"`
+	ToVersion   string `json:"to_version"`
+	Message     string `json:"message"`
+}
+
+// MaintenanceWindowResult is the maintenance settings of a cluster after
+// doks-maintenance-window-update.
+type MaintenanceWindowResult struct {
+	ClusterID         string                            `json:"cluster_id"`
+	AutoUpgrade       bool                              `json:"auto_upgrade"`
+	MaintenancePolicy *godo.KubernetesMaintenancePolicy `json:"maintenance_policy,omitempty"`
+}
+
+// startTimePattern matches the HH:MM UTC start times of maintenance windows.
+var startTimePattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// UpgradeTool upgrades clusters and manages when DigitalOcean maintains them.
+type UpgradeTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+// NewUpgradeTool creates a cluster upgrade tool.
+func NewUpgradeTool(client func(ctx context.Context) (*godo.Client, error)) *UpgradeTool {
+	return &UpgradeTool{client: client}
+}
+
+func (u *UpgradeTool) upgradeOptions(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	clusterID := args.RequiredString("ClusterID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := u.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	cluster, _, err := client.Kubernetes.Get(ctx, clusterID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	upgrades, _, err := client.Kubernetes.GetUpgrades(ctx, clusterID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if upgrades == nil {
+		upgrades = []*godo.KubernetesVersion{}
+	}
+
+	return common.NewToolResultStructured(&UpgradeOptions{
+		ClusterID:         cluster.ID,
+		Name:              cluster.Name,
+		CurrentVersion:    cluster.VersionSlug,
+		AutoUpgrade:       cluster.AutoUpgrade,
+		SurgeUpgrade:      cluster.SurgeUpgrade,
+		MaintenancePolicy: cluster.MaintenancePolicy,
+		Upgrades:          upgrades,
+	})
+}
+
+// upgradeCluster upgrades a cluster to one of the versions the API offers it, the newest unless
+// VersionSlug names another, so a typo fails before the upgrade is requested.
+func (u *UpgradeTool) upgradeCluster(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	clusterID := args.RequiredString("ClusterID")
+	version := args.String("VersionSlug")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := u.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	cluster, _, err := client.Kubernetes.Get(ctx, clusterID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	upgrades, _, err := client.Kubernetes.GetUpgrades(ctx, clusterID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if len(upgrades) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("cluster %s runs %s and has no upgrades available", clusterID, cluster.VersionSlug)), nil
+	}
+
+	target := upgrades[len(upgrades)-1].Slug
+	if version != "" && version != "latest" {
+		slugs := make([]string, 0, len(upgrades))
+		for _, upgrade := range upgrades {
+			slugs = append(slugs, upgrade.Slug)
+		}
+		found := false
+		for _, upgrade := range upgrades {
+			// "1.31" selects the newest patch release of that minor version.
+			if upgrade.Slug == version || strings.HasPrefix(upgrade.KubernetesVersion, version+".") {
+				target, found = upgrade.Slug, true
+			}
+		}
+		if !found {
+			return mcp.NewToolResultError(fmt.Sprintf("cluster %s cannot be upgraded from %s to %s; available versions: %s", clusterID, cluster.VersionSlug, version, strings.Join(slugs, ", "))), nil
+		}
+	}
+
+	if _, err := client.Kubernetes.Upgrade(ctx, clusterID, &godo.KubernetesClusterUpgradeRequest{VersionSlug: target}); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return common.NewToolResultStructured(&ClusterUpgradeResult{
+		ClusterID:   clusterID,
+		FromVersion: cluster.VersionSlug,
+		ToVersion:   target,
+		Message:     fmt.Sprintf("upgrade of cluster %s from %s to %s started; follow it with doks-get-cluster", clusterID, cluster.VersionSlug, target),
+	})
+}
+
+// updateMaintenanceWindow changes the day and start time of a cluster's maintenance window and
+// whether patch upgrades are applied in it. Settings that are not passed keep their value.
+func (u *UpgradeTool) updateMaintenanceWindow(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	clusterID := args.RequiredString("ClusterID")
+	day := args.String("Day")
+	startTime := args.String("StartTime")
+	setAutoUpgrade := args.Has("AutoUpgrade")
+	autoUpgrade := args.Bool("AutoUpgrade", false)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if day == "" && startTime == "" && !setAutoUpgrade {
+		return mcp.NewToolResultError("pass at least one of Day, StartTime and AutoUpgrade"), nil
+	}
+	var policyDay godo.KubernetesMaintenancePolicyDay
+	if day != "" {
+		var err error
+		if policyDay, err = godo.KubernetesMaintenanceToDay(day); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("unknown Day %q; use any or a day of the week", day)), nil
+		}
+	}
+	if startTime != "" && !startTimePattern.MatchString(startTime) {
+		return mcp.NewToolResultError(fmt.Sprintf("StartTime %q must be a UTC time such as 04:00", startTime)), nil
+	}
+
+	client, err := u.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	cluster, _, err := client.Kubernetes.Get(ctx, clusterID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	policy := &godo.KubernetesMaintenancePolicy{}
+	if cluster.MaintenancePolicy != nil {
+		*policy = *cluster.MaintenancePolicy
+	}
+	if day != "" {
+		policy.Day = policyDay
+	}
+	if startTime != "" {
+		policy.StartTime = startTime
+	}
+	if !setAutoUpgrade {
+		autoUpgrade = cluster.AutoUpgrade
+	}
+
+	updated, _, err := client.Kubernetes.Update(ctx, clusterID, &godo.KubernetesClusterUpdateRequest{
+		Name:              cluster.Name,
+		MaintenancePolicy: policy,
+		AutoUpgrade:       &autoUpgrade,
+		SurgeUpgrade:      cluster.SurgeUpgrade,
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return common.NewToolResultStructured(&MaintenanceWindowResult{
+		ClusterID:         clusterID,
+		AutoUpgrade:       updated.AutoUpgrade,
+		MaintenancePolicy: updated.MaintenancePolicy,
+	})
+}
+
+// Tools returns the cluster upgrade and maintenance tools.
+func (u *UpgradeTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: u.upgradeOptions,
+			Tool: mcp.NewTool("doks-upgrade-options",
+				mcp.WithDescription("Get the Kubernetes version of a DigitalOcean Kubernetes cluster, the versions it can be upgraded to and its maintenance window and auto upgrade settings"),
+				mcp.WithString("ClusterID", mcp.Required(), mcp.Description("The ID of the Kubernetes cluster")),
+				common.WithOutputSchema[UpgradeOptions](),
+				mcp.WithReadOnlyHintAnnotation(true),
+				mcp.WithDestructiveHintAnnotation(false),
+				mcp.WithIdempotentHintAnnotation(true),
+			),
+		},
+		{
+			Handler: u.upgradeCluster,
+			Tool: mcp.NewTool("doks-cluster-upgrade",
+				mcp.WithDescription("Upgrade a DigitalOcean Kubernetes cluster to one of the versions doks-upgrade-options lists. Nodes are replaced one after another, so workloads without replicas restart"),
+				mcp.WithString("ClusterID", mcp.Required(), mcp.Description("The ID of the Kubernetes cluster")),
+				mcp.WithString("VersionSlug", mcp.Description("Version slug (e.g. 1.31.1-do.0), a minor version (e.g. 1.31) for its newest release, or latest. Defaults to the newest available version")),
+				common.WithOutputSchema[ClusterUpgradeResult](),
+			),
+		},
+		{
+			Handler: u.updateMaintenanceWindow,
+			Tool: mcp.NewTool("doks-maintenance-window-update",
+				mcp.WithDescription("Change when DigitalOcean maintains a Kubernetes cluster and whether patch upgrades are applied automatically in that window. Settings that are not passed are kept"),
+				mcp.WithString("ClusterID", mcp.Required(), mcp.Description("The ID of the Kubernetes cluster")),
+				mcp.WithString("Day", mcp.Enum("any", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"), mcp.Description("Day of the maintenance window")),
+				mcp.WithString("StartTime", mcp.Description("UTC start time of the maintenance window in HH:MM (e.g. 04:00)")),
+				mcp.WithBoolean("AutoUpgrade", mcp.Description("Apply patch upgrades automatically in the maintenance window")),
+				common.WithOutputSchema[MaintenanceWindowResult](),
+			),
+		},
+	}
+}
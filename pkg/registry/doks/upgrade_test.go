@@ -0,0 +1,173 @@
+package doks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupUpgradeToolWithMock(kubernetes *MockKubernetesService) *UpgradeTool {
+	return NewUpgradeTool(func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{Kubernetes: kubernetes}, nil
+	})
+}
+
+func TestUpgradeTool_upgradeCluster(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster := &godo.KubernetesCluster{ID: "k8s-1", Name: "prod", VersionSlug: "1.30.4-do.0"}
+	upgrades := []*godo.KubernetesVersion{
+		{Slug: "1.30.5-do.0", KubernetesVersion: "1.30.5"},
+		{Slug: "1.31.0-do.0", KubernetesVersion: "1.31.0"},
+		{Slug: "1.31.1-do.0", KubernetesVersion: "1.31.1"},
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		upgrades      []*godo.KubernetesVersion
+		expectUpgrade string
+		expectError   bool
+	}{
+		{
+			name:          "Defaults to the newest version",
+			args:          map[string]any{"ClusterID": "k8s-1"},
+			upgrades:      upgrades,
+			expectUpgrade: "1.31.1-do.0",
+		},
+		{
+			name:          "Exact slug",
+			args:          map[string]any{"ClusterID": "k8s-1", "VersionSlug": "1.30.5-do.0"},
+			upgrades:      upgrades,
+			expectUpgrade: "1.30.5-do.0",
+		},
+		{
+			name:          "Minor version selects its newest release",
+			args:          map[string]any{"ClusterID": "k8s-1", "VersionSlug": "1.31"},
+			upgrades:      upgrades,
+			expectUpgrade: "1.31.1-do.0",
+		},
+		{
+			name:        "Version not offered",
+			args:        map[string]any{"ClusterID": "k8s-1", "VersionSlug": "1.32"},
+			upgrades:    upgrades,
+			expectError: true,
+		},
+		{
+			name:        "No upgrades available",
+			args:        map[string]any{"ClusterID": "k8s-1"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockKubernetes := NewMockKubernetesService(ctrl)
+			mockKubernetes.EXPECT().Get(gomock.Any(), "k8s-1").Return(cluster, nil, nil)
+			mockKubernetes.EXPECT().GetUpgrades(gomock.Any(), "k8s-1").Return(tc.upgrades, nil, nil)
+			if tc.expectUpgrade != "" {
+				mockKubernetes.EXPECT().Upgrade(gomock.Any(), "k8s-1", &godo.KubernetesClusterUpgradeRequest{VersionSlug: tc.expectUpgrade}).Return(nil, nil)
+			}
+			tool := setupUpgradeToolWithMock(mockKubernetes)
+			resp, err := tool.upgradeCluster(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			var result ClusterUpgradeResult
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+			require.Equal(t, "1.30.4-do.0", result.FromVersion)
+			require.Equal(t, tc.expectUpgrade, result.ToVersion)
+		})
+	}
+}
+
+func TestUpgradeTool_updateMaintenanceWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cluster := &godo.KubernetesCluster{
+		ID:                "k8s-1",
+		Name:              "prod",
+		AutoUpgrade:       true,
+		SurgeUpgrade:      true,
+		MaintenancePolicy: &godo.KubernetesMaintenancePolicy{StartTime: "00:00", Duration: "4h0m0s", Day: godo.KubernetesMaintenanceDayAny},
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		expectRequest *godo.KubernetesClusterUpdateRequest
+		expectError   bool
+	}{
+		{
+			name: "Keeps the settings not passed",
+			args: map[string]any{"ClusterID": "k8s-1", "Day": "sunday"},
+			expectRequest: &godo.KubernetesClusterUpdateRequest{
+				Name:              "prod",
+				MaintenancePolicy: &godo.KubernetesMaintenancePolicy{StartTime: "00:00", Duration: "4h0m0s", Day: godo.KubernetesMaintenanceDaySunday},
+				AutoUpgrade:       godo.PtrTo(true),
+				SurgeUpgrade:      true,
+			},
+		},
+		{
+			name: "Disables auto upgrades",
+			args: map[string]any{"ClusterID": "k8s-1", "StartTime": "04:30", "AutoUpgrade": false},
+			expectRequest: &godo.KubernetesClusterUpdateRequest{
+				Name:              "prod",
+				MaintenancePolicy: &godo.KubernetesMaintenancePolicy{StartTime: "04:30", Duration: "4h0m0s", Day: godo.KubernetesMaintenanceDayAny},
+				AutoUpgrade:       godo.PtrTo(false),
+				SurgeUpgrade:      true,
+			},
+		},
+		{
+			name:        "Nothing to change",
+			args:        map[string]any{"ClusterID": "k8s-1"},
+			expectError: true,
+		},
+		{
+			name:        "Invalid start time",
+			args:        map[string]any{"ClusterID": "k8s-1", "StartTime": "4am"},
+			expectError: true,
+		},
+		{
+			name:        "Invalid day",
+			args:        map[string]any{"ClusterID": "k8s-1", "Day": "someday"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockKubernetes := NewMockKubernetesService(ctrl)
+			if tc.expectRequest != nil {
+				mockKubernetes.EXPECT().Get(gomock.Any(), "k8s-1").Return(cluster, nil, nil)
+				mockKubernetes.EXPECT().Update(gomock.Any(), "k8s-1", tc.expectRequest).Return(&godo.KubernetesCluster{
+					ID:                "k8s-1",
+					AutoUpgrade:       *tc.expectRequest.AutoUpgrade,
+					MaintenancePolicy: tc.expectRequest.MaintenancePolicy,
+				}, nil, nil)
+			}
+			tool := setupUpgradeToolWithMock(mockKubernetes)
+			resp, err := tool.updateMaintenanceWindow(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			var result MaintenanceWindowResult
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+			require.Equal(t, *tc.expectRequest.AutoUpgrade, result.AutoUpgrade)
+			require.Equal(t, tc.expectRequest.MaintenancePolicy.StartTime, result.MaintenancePolicy.StartTime)
+		})
+	}
+}
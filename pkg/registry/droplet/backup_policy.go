@@ -0,0 +1,265 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// backupPolicyArgs reads the Plan, Weekday and Hour arguments into a backup policy request, or
+// returns nil when none of them is given. Weekdays are accepted in any case and spelled out, e.g.
+// "monday", and are sent as the API's three-letter names.
+func backupPolicyArgs(args *common.Args) *godo.DropletBackupPolicyRequest {
+	plan := strings.ToLower(strings.TrimSpace(args.String("Plan")))
+	weekday := strings.ToUpper(strings.TrimSpace(args.String("Weekday")))
+	if len(weekday) > 3 {
+		weekday = weekday[:3]
+	}
+	if plan == "" && weekday == "" && !args.Has("Hour") {
+		return nil
+	}
+	policy := &godo.DropletBackupPolicyRequest{Plan: plan, Weekday: weekday}
+	if args.Has("Hour") {
+		hour := args.Number("Hour", 0)
+		if hour != math.Trunc(hour) {
+			// never a window start, so validation reports it with the valid hours.
+			hour = -1
+		}
+		h := int(hour)
+		policy.Hour = &h
+	}
+	return policy
+}
+
+// validateBackupPolicy checks policy against the plans the API supports and returns the problems
+// found, naming the valid values.
+func validateBackupPolicy(policy *godo.DropletBackupPolicyRequest, supported []*godo.SupportedBackupPolicy) []string {
+	names := make([]string, 0, len(supported))
+	for _, s := range supported {
+		names = append(names, s.Name)
+	}
+	if policy.Plan == "" {
+		return []string{fmt.Sprintf("Plan is required with Weekday or Hour; supported plans are %s", strings.Join(names, ", "))}
+	}
+	i := slices.IndexFunc(supported, func(s *godo.SupportedBackupPolicy) bool { return s.Name == policy.Plan })
+	if i < 0 {
+		return []string{fmt.Sprintf("Plan %q is not supported; supported plans are %s", policy.Plan, strings.Join(names, ", "))}
+	}
+	plan := supported[i]
+
+	var problems []string
+	switch {
+	case policy.Weekday == "":
+	case len(plan.PossibleDays) == 0:
+		problems = append(problems, fmt.Sprintf("the %s plan does not take a Weekday", plan.Name))
+	case !slices.Contains(plan.PossibleDays, policy.Weekday):
+		problems = append(problems, fmt.Sprintf("Weekday %q is not valid for the %s plan; valid days are %s", policy.Weekday, plan.Name, strings.Join(plan.PossibleDays, ", ")))
+	}
+	if policy.Hour != nil && !slices.Contains(plan.PossibleWindowStarts, *policy.Hour) {
+		starts := make([]string, 0, len(plan.PossibleWindowStarts))
+		for _, h := range plan.PossibleWindowStarts {
+			starts = append(starts, strconv.Itoa(h))
+		}
+		problems = append(problems, fmt.Sprintf("Hour must be the UTC start of a %d-hour backup window of the %s plan: one of %s",
+			plan.WindowLengthHours, plan.Name, strings.Join(starts, ", ")))
+	}
+	return problems
+}
+
+// runWithBackupPolicy validates the backup policy of args against the supported policies and runs
+// op with it on the droplet with the request's ID.
+func (da *DropletActionsTool) runWithBackupPolicy(ctx context.Context, args *common.Args, op func(ctx context.Context, client *godo.Client, dropletID int, policy *godo.DropletBackupPolicyRequest) (*godo.Action, *godo.Response, error)) (*mcp.CallToolResult, error) {
+	dropletID := args.RequiredNumber("ID")
+	policy := backupPolicyArgs(args)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if policy == nil {
+		policy = &godo.DropletBackupPolicyRequest{}
+	}
+
+	client, err := da.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	supported, _, err := client.Droplets.ListSupportedBackupPolicies(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if problems := validateBackupPolicy(policy, supported); len(problems) > 0 {
+		return mcp.NewToolResultError("invalid arguments: " + strings.Join(problems, "; ")), nil
+	}
+
+	action, _, err := op(ctx, client, int(dropletID), policy)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return common.NewToolResultStructured(action)
+}
+
+// withBackupPolicyArgs declares the Plan, Weekday and Hour arguments of a backup policy.
+func withBackupPolicyArgs(planRequired bool) []mcp.ToolOption {
+	planOpts := []mcp.PropertyOption{mcp.Description("Backup plan, e.g. daily or weekly")}
+	if planRequired {
+		planOpts = append(planOpts, mcp.Required())
+	}
+	return []mcp.ToolOption{
+		mcp.WithString("Plan", planOpts...),
+		mcp.WithString("Weekday", mcp.Description("Day of weekly backups, e.g. SUN or monday")),
+		mcp.WithNumber("Hour", mcp.Min(0), mcp.Max(23), mcp.Description("UTC hour the backup window starts, e.g. 0, 4, 8, 12, 16 or 20")),
+	}
+}
+
+// backupCostRates are the shares of a droplet's monthly price that backups of each plan cost.
+var backupCostRates = map[string]float64{"weekly": 0.2, "daily": 0.3}
+
+// regionUTCOffsets are the standard time UTC offsets, in hours, of the datacenter regions. They
+// place the recommended backup window in the region's night, ignoring daylight saving time.
+var regionUTCOffsets = map[string]int{
+	"nyc": -5, "tor": -5, "atl": -5, "sfo": -8,
+	"lon": 0, "ams": 1, "fra": 1,
+	"blr": 5, "sgp": 8, "syd": 10,
+}
+
+// backupLocalHour is the local hour the recommended backup window should start at.
+const backupLocalHour = 2
+
+// BackupPlanOptions are the valid weekdays and hours of one backup plan.
+type BackupPlanOptions struct {
+	Plan                 string   `json:"plan"`
+	Weekdays             []string `json:"weekdays,omitempty"`
+	Hours                []int    `json:"hours"`
+	WindowLengthHours    int      `json:"window_length_hours"`
+	RetentionPeriodDays  int      `json:"retention_period_days"`
+	EstimatedMonthlyCost float64  `json:"estimated_monthly_cost,omitempty"`
+}
+
+// BackupPolicyChoice is a valid combination of the Plan, Weekday and Hour arguments.
+type BackupPolicyChoice struct {
+	Plan    string `json:"plan"`
+	Weekday string `json:"weekday,omitempty"`
+	Hour    int    `json:"hour"`
+}
+
+// BackupPolicyRecommendation is the result of backup-policy-recommend.
+type BackupPolicyRecommendation struct {
+	DropletID      int                             `json:"droplet_id"`
+	Region         string                          `json:"region"`
+	Size           string                          `json:"size"`
+	BackupsEnabled bool                            `json:"backups_enabled"`
+	Current        *godo.DropletBackupPolicyConfig `json:"current,omitempty"`
+	Plans          []BackupPlanOptions             `json:"plans"`
+	Recommended    *BackupPolicyChoice             `json:"recommended,omitempty"`
+	Reason         string                          `json:"reason,omitempty"`
+}
+
+// nightWindowStart returns the window start of starts closest to backupLocalHour in region, and
+// whether the region's offset is known. Unknown regions get the first window start.
+func nightWindowStart(region string, starts []int) (int, bool) {
+	if len(starts) == 0 {
+		return 0, false
+	}
+	offset, ok := regionUTCOffsets[strings.TrimRight(region, "0123456789")]
+	if !ok {
+		return starts[0], false
+	}
+	target := ((backupLocalHour-offset)%24 + 24) % 24
+	best := starts[0]
+	for _, h := range starts[1:] {
+		if hourDistance(h, target) < hourDistance(best, target) {
+			best = h
+		}
+	}
+	return best, true
+}
+
+// hourDistance is the number of hours between two hours of the day, across midnight.
+func hourDistance(a, b int) int {
+	d := (a - b + 24) % 24
+	return min(d, 24-d)
+}
+
+// recommendBackupPolicy lists the valid backup policies of a droplet and recommends one whose
+// window falls in the night of the droplet's region.
+func (da *DropletActionsTool) recommendBackupPolicy(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	dropletID := int(args.RequiredNumber("ID"))
+	plan := strings.ToLower(strings.TrimSpace(args.String("Plan")))
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := da.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	droplet, _, err := client.Droplets.Get(ctx, dropletID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	supported, _, err := client.Droplets.ListSupportedBackupPolicies(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	result := &BackupPolicyRecommendation{DropletID: dropletID, Size: droplet.SizeSlug, Plans: []BackupPlanOptions{}}
+	if droplet.Region != nil {
+		result.Region = droplet.Region.Slug
+	}
+	if slices.Contains(droplet.Features, "backups") {
+		policy, _, err := client.Droplets.GetBackupPolicy(ctx, dropletID)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		result.BackupsEnabled = policy.BackupEnabled
+		result.Current = policy.BackupPolicy
+	}
+
+	var chosen *godo.SupportedBackupPolicy
+	for _, s := range supported {
+		options := BackupPlanOptions{
+			Plan:                s.Name,
+			Weekdays:            s.PossibleDays,
+			Hours:               s.PossibleWindowStarts,
+			WindowLengthHours:   s.WindowLengthHours,
+			RetentionPeriodDays: s.RetentionPeriodDays,
+		}
+		if rate, ok := backupCostRates[s.Name]; ok && droplet.Size != nil {
+			options.EstimatedMonthlyCost = math.Round(droplet.Size.PriceMonthly*rate*100) / 100
+		}
+		result.Plans = append(result.Plans, options)
+		// weekly backups are the cheaper plan, so they are recommended unless Plan asks otherwise.
+		if (plan != "" && s.Name == plan) || (plan == "" && (chosen == nil || s.Name == "weekly")) {
+			chosen = s
+		}
+	}
+	if chosen == nil {
+		names := make([]string, 0, len(supported))
+		for _, s := range supported {
+			names = append(names, s.Name)
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: Plan %q is not supported; supported plans are %s", plan, strings.Join(names, ", "))), nil
+	}
+
+	hour, local := nightWindowStart(result.Region, chosen.PossibleWindowStarts)
+	result.Recommended = &BackupPolicyChoice{Plan: chosen.Name, Hour: hour}
+	if len(chosen.PossibleDays) > 0 {
+		result.Recommended.Weekday = chosen.PossibleDays[0]
+	}
+	if local {
+		result.Reason = fmt.Sprintf("the %d:00 UTC window starts nearest %d:00 local time in %s", hour, backupLocalHour, result.Region)
+	} else {
+		result.Reason = fmt.Sprintf("the local time of region %q is unknown, so the first window start is used", result.Region)
+	}
+	return common.NewToolResultStructured(result)
+}
@@ -0,0 +1,276 @@
+package droplet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var supportedBackupPolicies = []*godo.SupportedBackupPolicy{
+	{Name: "daily", PossibleWindowStarts: []int{0, 4, 8, 12, 16, 20}, WindowLengthHours: 4, RetentionPeriodDays: 7},
+	{Name: "weekly", PossibleWindowStarts: []int{0, 4, 8, 12, 16, 20}, WindowLengthHours: 4, RetentionPeriodDays: 28,
+		PossibleDays: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+func hourPtr(h int) *int { return &h }
+
+func TestDropletActionsTool_enableBackupsWithPolicy(t *testing.T) {
+	testAction := &godo.Action{ID: 1004, Status: "in-progress"}
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockDropletsService, *MockDropletActionsService)
+		expectError string
+	}{
+		{
+			name: "Weekly policy",
+			args: map[string]any{"ID": float64(123), "Plan": "Weekly", "Weekday": "monday", "Hour": float64(8)},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService) {
+				d.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+				a.EXPECT().
+					EnableBackupsWithPolicy(gomock.Any(), 123, &godo.DropletBackupPolicyRequest{Plan: "weekly", Weekday: "MON", Hour: hourPtr(8)}).
+					Return(testAction, nil, nil)
+			},
+		},
+		{
+			name: "Daily policy without an hour",
+			args: map[string]any{"ID": float64(123), "Plan": "daily"},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService) {
+				d.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+				a.EXPECT().
+					EnableBackupsWithPolicy(gomock.Any(), 123, &godo.DropletBackupPolicyRequest{Plan: "daily"}).
+					Return(testAction, nil, nil)
+			},
+		},
+		{
+			name: "Invalid weekday and hour",
+			args: map[string]any{"ID": float64(123), "Plan": "weekly", "Weekday": "funday", "Hour": float64(3)},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService) {
+				d.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+			},
+			expectError: `invalid arguments: Weekday "FUN" is not valid for the weekly plan; valid days are SUN, MON, TUE, WED, THU, FRI, SAT; ` +
+				"Hour must be the UTC start of a 4-hour backup window of the weekly plan: one of 0, 4, 8, 12, 16, 20",
+		},
+		{
+			name: "Weekday on a daily plan",
+			args: map[string]any{"ID": float64(123), "Plan": "daily", "Weekday": "SUN"},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService) {
+				d.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+			},
+			expectError: "invalid arguments: the daily plan does not take a Weekday",
+		},
+		{
+			name: "Weekday without a plan",
+			args: map[string]any{"ID": float64(123), "Weekday": "SUN"},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService) {
+				d.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+			},
+			expectError: "invalid arguments: Plan is required with Weekday or Hour; supported plans are daily, weekly",
+		},
+		{
+			name: "Unsupported plan",
+			args: map[string]any{"ID": float64(123), "Plan": "hourly"},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService) {
+				d.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+			},
+			expectError: `invalid arguments: Plan "hourly" is not supported; supported plans are daily, weekly`,
+		},
+		{
+			name: "Supported policies API error",
+			args: map[string]any{"ID": float64(123), "Plan": "daily"},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService) {
+				d.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(nil, nil, errors.New("unavailable"))
+			},
+			expectError: "api error: unavailable",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDroplets := NewMockDropletsService(ctrl)
+			mockActions := NewMockDropletActionsService(ctrl)
+			tc.mockSetup(mockDroplets, mockActions)
+			tool := setupByTagToolWithMocks(mockDroplets, mockActions)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.enableBackups(context.Background(), req)
+			require.NoError(t, err)
+			if tc.expectError != "" {
+				require.True(t, resp.IsError)
+				require.Equal(t, tc.expectError, resp.Content[0].(mcp.TextContent).Text)
+				return
+			}
+			require.False(t, resp.IsError)
+			require.Equal(t, testAction, resp.StructuredContent)
+		})
+	}
+}
+
+func TestDropletActionsTool_changeBackupPolicy(t *testing.T) {
+	testAction := &godo.Action{ID: 1005, Status: "completed"}
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockDropletsService, *MockDropletActionsService)
+		expectError bool
+	}{
+		{
+			name: "Change to a weekly policy",
+			args: map[string]any{"ID": float64(123), "Plan": "weekly", "Weekday": "SAT", "Hour": float64(20)},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService) {
+				d.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+				a.EXPECT().
+					ChangeBackupPolicy(gomock.Any(), 123, &godo.DropletBackupPolicyRequest{Plan: "weekly", Weekday: "SAT", Hour: hourPtr(20)}).
+					Return(testAction, nil, nil)
+			},
+		},
+		{
+			name: "Missing plan",
+			args: map[string]any{"ID": float64(123)},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService) {
+				d.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "Fractional hour",
+			args: map[string]any{"ID": float64(123), "Plan": "daily", "Hour": float64(4.5)},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService) {
+				d.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "API error",
+			args: map[string]any{"ID": float64(456), "Plan": "daily", "Hour": float64(0)},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService) {
+				d.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+				a.EXPECT().
+					ChangeBackupPolicy(gomock.Any(), 456, &godo.DropletBackupPolicyRequest{Plan: "daily", Hour: hourPtr(0)}).
+					Return(nil, nil, errors.New("backups are not enabled"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDroplets := NewMockDropletsService(ctrl)
+			mockActions := NewMockDropletActionsService(ctrl)
+			tc.mockSetup(mockDroplets, mockActions)
+			tool := setupByTagToolWithMocks(mockDroplets, mockActions)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.changeBackupPolicy(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, resp.IsError)
+			if tc.expectError {
+				return
+			}
+			require.Equal(t, testAction, resp.StructuredContent)
+		})
+	}
+}
+
+func TestDropletActionsTool_recommendBackupPolicy(t *testing.T) {
+	web := &godo.Droplet{ID: 1, SizeSlug: "s-1vcpu-1gb", Size: &godo.Size{PriceMonthly: 6}, Region: &godo.Region{Slug: "nyc3"}}
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockDropletsService)
+		expectError bool
+		recommended *BackupPolicyChoice
+		current     bool
+	}{
+		{
+			name: "Weekly at night in the droplet's region",
+			args: map[string]any{"ID": float64(1)},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().Get(gomock.Any(), 1).Return(web, nil, nil)
+				m.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+			},
+			// 2:00 in New York is 7:00 UTC, nearest the 8:00 window.
+			recommended: &BackupPolicyChoice{Plan: "weekly", Weekday: "SUN", Hour: 8},
+		},
+		{
+			name: "Daily plan with the current policy",
+			args: map[string]any{"ID": float64(2), "Plan": "Daily"},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().Get(gomock.Any(), 2).Return(&godo.Droplet{ID: 2, Features: []string{"backups"}, Region: &godo.Region{Slug: "sgp1"}}, nil, nil)
+				m.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+				m.EXPECT().GetBackupPolicy(gomock.Any(), 2).Return(&godo.DropletBackupPolicy{
+					DropletID: 2, BackupEnabled: true, BackupPolicy: &godo.DropletBackupPolicyConfig{Plan: "weekly", Weekday: "MON", Hour: 0},
+				}, nil, nil)
+			},
+			// 2:00 in Singapore is 18:00 UTC, as close to the 16:00 as to the 20:00 window.
+			recommended: &BackupPolicyChoice{Plan: "daily", Hour: 16},
+			current:     true,
+		},
+		{
+			name: "Unsupported plan",
+			args: map[string]any{"ID": float64(1), "Plan": "hourly"},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().Get(gomock.Any(), 1).Return(web, nil, nil)
+				m.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "API error",
+			args: map[string]any{"ID": float64(3)},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().Get(gomock.Any(), 3).Return(nil, nil, errors.New("not found"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDroplets := NewMockDropletsService(ctrl)
+			tc.mockSetup(mockDroplets)
+			tool := setupByTagToolWithMocks(mockDroplets, NewMockDropletActionsService(ctrl))
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.recommendBackupPolicy(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, resp.IsError)
+			if tc.expectError {
+				return
+			}
+			result := resp.StructuredContent.(*BackupPolicyRecommendation)
+			require.Equal(t, tc.recommended, result.Recommended)
+			require.Len(t, result.Plans, 2)
+			require.Equal(t, tc.current, result.BackupsEnabled)
+			require.Equal(t, tc.current, result.Current != nil)
+		})
+	}
+}
+
+func TestDropletActionsTool_recommendBackupPolicyCosts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDroplets := NewMockDropletsService(ctrl)
+	mockDroplets.EXPECT().Get(gomock.Any(), 1).
+		Return(&godo.Droplet{ID: 1, Size: &godo.Size{PriceMonthly: 12}, Region: &godo.Region{Slug: "mars1"}}, nil, nil)
+	mockDroplets.EXPECT().ListSupportedBackupPolicies(gomock.Any()).Return(supportedBackupPolicies, nil, nil)
+	tool := setupByTagToolWithMocks(mockDroplets, NewMockDropletActionsService(ctrl))
+
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"ID": float64(1)}}}
+	resp, err := tool.recommendBackupPolicy(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	result := resp.StructuredContent.(*BackupPolicyRecommendation)
+	require.Equal(t, 3.6, result.Plans[0].EstimatedMonthlyCost)
+	require.Equal(t, 2.4, result.Plans[1].EstimatedMonthlyCost)
+	require.Equal(t, 0, result.Recommended.Hour)
+	require.Contains(t, result.Reason, "unknown")
+}
@@ -0,0 +1,228 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultBandwidthDays = 30
+	// bytesPerGiB converts transferred bytes to the GiB transfer is billed in.
+	bytesPerGiB = 1 << 30
+)
+
+// DropletTransfer is the data a droplet moved over one network interface.
+type DropletTransfer struct {
+	InboundGiB  float64 `json:"inbound_gib"`
+	OutboundGiB float64 `json:"outbound_gib"`
+}
+
+// DropletBandwidth summarizes the transfer of a single droplet.
+type DropletBandwidth struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Region string `json:"region,omitempty"`
+	// IncludedTransferTiB is the monthly transfer allowance the droplet adds to the account's pool.
+	IncludedTransferTiB float64          `json:"included_transfer_tib,omitempty"`
+	Public              DropletTransfer  `json:"public"`
+	Private             *DropletTransfer `json:"private,omitempty"`
+	Error               string           `json:"error,omitempty"`
+}
+
+// BandwidthReport is the result of the droplet-bandwidth-report tool.
+type BandwidthReport struct {
+	WindowStart string `json:"window_start"`
+	WindowEnd   string `json:"window_end"`
+	// TotalPublicOutboundGiB is the public outbound transfer of all droplets, the part of
+	// droplet transfer that counts against the transfer pool.
+	TotalPublicOutboundGiB float64            `json:"total_public_outbound_gib"`
+	TotalPublicInboundGiB  float64            `json:"total_public_inbound_gib"`
+	Droplets               []DropletBandwidth `json:"droplets"`
+}
+
+// BandwidthReportTool aggregates droplet bandwidth metrics into transfer totals.
+type BandwidthReportTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+	now    func() time.Time
+}
+
+// NewBandwidthReportTool creates a new bandwidth report tool
+func NewBandwidthReportTool(client func(ctx context.Context) (*godo.Client, error)) *BandwidthReportTool {
+	return &BandwidthReportTool{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// bandwidthReport reports the inbound and outbound transfer of a droplet, or all droplets carrying
+// a tag, over a look-back window, with the droplets that sent the most public traffic first.
+func (b *BandwidthReportTool) bandwidthReport(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	dropletID, hasID := args["ID"].(float64)
+	tag, _ := args["Tag"].(string)
+	if hasID == (tag != "") {
+		return mcp.NewToolResultError("exactly one of ID or Tag must be provided"), nil
+	}
+	days, ok := args["Days"].(float64)
+	if !ok || days <= 0 {
+		days = defaultBandwidthDays
+	}
+	includePrivate, _ := args["IncludePrivate"].(bool)
+
+	client, err := b.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	var droplets []godo.Droplet
+	if hasID {
+		droplet, _, err := client.Droplets.Get(ctx, int(dropletID))
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		droplets = []godo.Droplet{*droplet}
+	} else {
+		droplets, err = listDropletsByTag(ctx, client, tag)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+	}
+
+	end := b.now().UTC()
+	start := end.Add(-time.Duration(days * float64(24*time.Hour)))
+	report := &BandwidthReport{
+		WindowStart: start.Format(time.RFC3339),
+		WindowEnd:   end.Format(time.RFC3339),
+		Droplets:    make([]DropletBandwidth, 0, len(droplets)),
+	}
+
+	for _, d := range droplets {
+		entry := DropletBandwidth{ID: d.ID, Name: d.Name}
+		if d.Region != nil {
+			entry.Region = d.Region.Slug
+		}
+		if d.Size != nil {
+			entry.IncludedTransferTiB = d.Size.Transfer
+		}
+
+		public, err := dropletTransfer(ctx, client, d.ID, "public", start, end)
+		if err != nil {
+			entry.Error = fmt.Sprintf("failed to read bandwidth metrics: %v", err)
+		}
+		entry.Public = public
+		if includePrivate && err == nil {
+			private, err := dropletTransfer(ctx, client, d.ID, "private", start, end)
+			if err != nil {
+				entry.Error = fmt.Sprintf("failed to read bandwidth metrics: %v", err)
+			} else {
+				entry.Private = &private
+			}
+		}
+
+		report.TotalPublicInboundGiB += entry.Public.InboundGiB
+		report.TotalPublicOutboundGiB += entry.Public.OutboundGiB
+		report.Droplets = append(report.Droplets, entry)
+	}
+	slices.SortStableFunc(report.Droplets, func(x, y DropletBandwidth) int {
+		switch {
+		case x.Public.OutboundGiB > y.Public.OutboundGiB:
+			return -1
+		case x.Public.OutboundGiB < y.Public.OutboundGiB:
+			return 1
+		}
+		return 0
+	})
+	report.TotalPublicInboundGiB = roundGiB(report.TotalPublicInboundGiB)
+	report.TotalPublicOutboundGiB = roundGiB(report.TotalPublicOutboundGiB)
+
+	return common.NewToolResultStructured(report)
+}
+
+// dropletTransfer returns the data the droplet received and sent over a network interface
+// between start and end.
+func dropletTransfer(ctx context.Context, client *godo.Client, dropletID int, iface string, start, end time.Time) (DropletTransfer, error) {
+	var transfer DropletTransfer
+	for _, direction := range []string{"inbound", "outbound"} {
+		resp, _, err := client.Monitoring.GetDropletBandwidth(ctx, &godo.DropletBandwidthMetricsRequest{
+			DropletMetricsRequest: godo.DropletMetricsRequest{
+				HostID: strconv.Itoa(dropletID),
+				Start:  start,
+				End:    end,
+			},
+			Interface: iface,
+			Direction: direction,
+		})
+		if err != nil {
+			return transfer, err
+		}
+		var bytes float64
+		for _, stream := range resp.Data.Result {
+			bytes += transferredBytes(stream.Values)
+		}
+		if direction == "inbound" {
+			transfer.InboundGiB = roundGiB(bytes / bytesPerGiB)
+		} else {
+			transfer.OutboundGiB = roundGiB(bytes / bytesPerGiB)
+		}
+	}
+	return transfer, nil
+}
+
+// transferredBytes integrates bandwidth samples, which are rates in megabits per second, into
+// the bytes transferred between the first and the last sample. Intervals much longer than the
+// typical sample interval are gaps in the monitoring data and are not counted.
+func transferredBytes(values []metrics.SamplePair) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	intervals := make([]time.Duration, 0, len(values)-1)
+	for i := 1; i < len(values); i++ {
+		intervals = append(intervals, values[i].Timestamp.Sub(values[i-1].Timestamp))
+	}
+	sorted := slices.Clone(intervals)
+	slices.Sort(sorted)
+	step := sorted[len(sorted)/2]
+
+	var bytes float64
+	for i, interval := range intervals {
+		if interval > monitoringGapFactor*step {
+			continue
+		}
+		mbps := (float64(values[i].Value) + float64(values[i+1].Value)) / 2
+		bytes += mbps * 1e6 / 8 * interval.Seconds()
+	}
+	return bytes
+}
+
+// roundGiB rounds a transfer amount to three decimals, about a MiB.
+func roundGiB(gib float64) float64 {
+	return math.Round(gib*1000) / 1000
+}
+
+// Tools returns a list of tool functions
+func (b *BandwidthReportTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: b.bandwidthReport,
+			Tool: mcp.NewTool("droplet-bandwidth-report",
+				mcp.WithDescription("Report how much data a droplet, or every droplet with a tag, received and sent over a look-back window, in GiB, from its bandwidth monitoring metrics. Droplets are sorted by public outbound transfer, which is the traffic that counts against the account's transfer pool, so the top entries are the ones to look at in egress cost investigations. Exactly one of ID or Tag must be provided."),
+				common.WithOutputSchema[BandwidthReport](),
+				mcp.WithNumber("ID", mcp.Description("ID of a single droplet to report on")),
+				mcp.WithString("Tag", mcp.Description("Tag of the droplets to report on")),
+				mcp.WithNumber("Days", mcp.DefaultNumber(defaultBandwidthDays), mcp.Description("Size of the look-back window in days")),
+				mcp.WithBoolean("IncludePrivate", mcp.DefaultBool(false), mcp.Description("Whether to also report transfer over the private (VPC) interface, which is not billed")),
+			),
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package droplet
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupBandwidthReportToolWithMocks(droplets *MockDropletsService, monitoring *MockMonitoringService, now time.Time) *BandwidthReportTool {
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Droplets:   droplets,
+			Monitoring: monitoring,
+		}, nil
+	}
+	tool := NewBandwidthReportTool(client)
+	tool.now = func() time.Time { return now }
+	return tool
+}
+
+// bandwidthSamples returns a metrics response with a sample of mbps every five minutes for an hour
+// ending at end, leaving out the samples in skip.
+func bandwidthSamples(end time.Time, mbps float64, skip ...int) *godo.MetricsResponse {
+	var values []metrics.SamplePair
+	for i := 0; i <= 12; i++ {
+		if i > 0 && i < 12 && slices.Contains(skip, i) {
+			continue
+		}
+		ts := end.Add(-time.Hour + time.Duration(i)*5*time.Minute)
+		values = append(values, metrics.SamplePair{Timestamp: metrics.TimeFromUnix(ts.Unix()), Value: metrics.SampleValue(mbps)})
+	}
+	return &godo.MetricsResponse{Data: godo.MetricsData{Result: []metrics.SampleStream{{Values: values}}}}
+}
+
+func bandwidthRequest(dropletID string, iface, direction string) *godo.DropletBandwidthMetricsRequest {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	return &godo.DropletBandwidthMetricsRequest{
+		DropletMetricsRequest: godo.DropletMetricsRequest{HostID: dropletID, Start: now.Add(-24 * time.Hour), End: now},
+		Interface:             iface,
+		Direction:             direction,
+	}
+}
+
+func TestBandwidthReportTool_bandwidthReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockDropletsService, *MockMonitoringService)
+		expectError bool
+		check       func(*testing.T, *BandwidthReport)
+	}{
+		{
+			name: "Sorts droplets of a tag by public outbound transfer",
+			args: map[string]any{"Tag": "web", "Days": float64(1)},
+			mockSetup: func(d *MockDropletsService, m *MockMonitoringService) {
+				d.EXPECT().ListByTag(gomock.Any(), "web", &godo.ListOptions{Page: 1, PerPage: 100}).
+					Return([]godo.Droplet{
+						{ID: 1, Name: "web-1", Size: &godo.Size{Transfer: 1}},
+						{ID: 2, Name: "web-2", Size: &godo.Size{Transfer: 2}},
+					}, &godo.Response{}, nil)
+				// 8 Mbps is 1 MB/s: 3.6 GB over the hour of samples.
+				m.EXPECT().GetDropletBandwidth(gomock.Any(), bandwidthRequest("1", "public", "inbound")).Return(bandwidthSamples(now, 8), nil, nil)
+				m.EXPECT().GetDropletBandwidth(gomock.Any(), bandwidthRequest("1", "public", "outbound")).Return(bandwidthSamples(now, 8), nil, nil)
+				m.EXPECT().GetDropletBandwidth(gomock.Any(), bandwidthRequest("2", "public", "inbound")).Return(bandwidthSamples(now, 8), nil, nil)
+				m.EXPECT().GetDropletBandwidth(gomock.Any(), bandwidthRequest("2", "public", "outbound")).Return(bandwidthSamples(now, 16), nil, nil)
+			},
+			check: func(t *testing.T, report *BandwidthReport) {
+				require.Len(t, report.Droplets, 2)
+				require.Equal(t, 2, report.Droplets[0].ID)
+				require.Equal(t, 6.706, report.Droplets[0].Public.OutboundGiB)
+				require.Equal(t, 3.353, report.Droplets[0].Public.InboundGiB)
+				require.Equal(t, float64(2), report.Droplets[0].IncludedTransferTiB)
+				require.Nil(t, report.Droplets[0].Private)
+				require.Equal(t, 1, report.Droplets[1].ID)
+				require.Equal(t, 3.353, report.Droplets[1].Public.OutboundGiB)
+				require.Equal(t, 10.059, report.TotalPublicOutboundGiB)
+				require.Equal(t, 6.706, report.TotalPublicInboundGiB)
+			},
+		},
+		{
+			name: "Skips gaps in the samples and reports private transfer",
+			args: map[string]any{"ID": float64(1), "Days": float64(1), "IncludePrivate": true},
+			mockSetup: func(d *MockDropletsService, m *MockMonitoringService) {
+				d.EXPECT().Get(gomock.Any(), 1).Return(&godo.Droplet{ID: 1, Name: "web-1"}, nil, nil)
+				// Samples 4 to 8 are missing: the 30 minute gap is not counted, leaving 30 minutes.
+				m.EXPECT().GetDropletBandwidth(gomock.Any(), bandwidthRequest("1", "public", "inbound")).Return(bandwidthSamples(now, 8, 4, 5, 6, 7, 8), nil, nil)
+				m.EXPECT().GetDropletBandwidth(gomock.Any(), bandwidthRequest("1", "public", "outbound")).Return(bandwidthSamples(now, 0), nil, nil)
+				m.EXPECT().GetDropletBandwidth(gomock.Any(), bandwidthRequest("1", "private", "inbound")).Return(bandwidthSamples(now, 8), nil, nil)
+				m.EXPECT().GetDropletBandwidth(gomock.Any(), bandwidthRequest("1", "private", "outbound")).Return(bandwidthSamples(now, 8), nil, nil)
+			},
+			check: func(t *testing.T, report *BandwidthReport) {
+				require.Len(t, report.Droplets, 1)
+				require.Equal(t, 1.676, report.Droplets[0].Public.InboundGiB)
+				require.Equal(t, float64(0), report.Droplets[0].Public.OutboundGiB)
+				require.NotNil(t, report.Droplets[0].Private)
+				require.Equal(t, 3.353, report.Droplets[0].Private.InboundGiB)
+			},
+		},
+		{
+			name: "Reports metric errors per droplet",
+			args: map[string]any{"ID": float64(1), "Days": float64(1)},
+			mockSetup: func(d *MockDropletsService, m *MockMonitoringService) {
+				d.EXPECT().Get(gomock.Any(), 1).Return(&godo.Droplet{ID: 1, Name: "web-1"}, nil, nil)
+				m.EXPECT().GetDropletBandwidth(gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("metrics unavailable"))
+			},
+			check: func(t *testing.T, report *BandwidthReport) {
+				require.Len(t, report.Droplets, 1)
+				require.Contains(t, report.Droplets[0].Error, "metrics unavailable")
+			},
+		},
+		{
+			name:        "Neither ID nor Tag",
+			args:        map[string]any{},
+			expectError: true,
+		},
+		{
+			name:        "Both ID and Tag",
+			args:        map[string]any{"ID": float64(1), "Tag": "web"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDroplets := NewMockDropletsService(ctrl)
+			mockMonitoring := NewMockMonitoringService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockDroplets, mockMonitoring)
+			}
+			tool := setupBandwidthReportToolWithMocks(mockDroplets, mockMonitoring, now)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.bandwidthReport(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.False(t, resp.IsError)
+			tc.check(t, resp.StructuredContent.(*BandwidthReport))
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mcp-digitalocean/internal/fanout"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	defaultByTagConcurrency = 5
+	maxByTagConcurrency     = 25
+	defaultByTagChunkSize   = 50
+	dropletsPageSize        = 100
+	// byTagActionTimeout bounds the request of each droplet action, so a request that hangs doesn't
+	// hold on to one of the Concurrency slots.
+	byTagActionTimeout = 2 * time.Minute
+)
+
+// ByTagDroplet is a droplet a by-tag operation acted on.
+type ByTagDroplet struct {
+	DropletID   int    `json:"droplet_id"`
+	DropletName string `json:"droplet_name"`
+}
+
+// ByTagAction is the action a by-tag operation started on a droplet.
+type ByTagAction struct {
+	ByTagDroplet
+	Action *godo.Action `json:"action"`
+}
+
+// ByTagResult aggregates the per-droplet outcomes of a by-tag operation: the actions started, the
+// droplets that failed with their errors, and the droplets skipped after a failure.
+type ByTagResult struct {
+	Tag         string `json:"tag"`
+	Total       int    `json:"total"`
+	Chunks      int    `json:"chunks"`
+	ChunkSize   int    `json:"chunk_size"`
+	Concurrency int    `json:"concurrency"`
+	fanout.Batch[ByTagAction, ByTagDroplet]
+}
+
+// dropletActionFn runs a droplet action against a single droplet.
+type dropletActionFn func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error)
+
+// byTagTool builds a by-tag tool definition with the chunking and concurrency arguments shared by
+// every by-tag tool. Extra options are appended after the shared ones.
+func byTagTool(name, description, tagDescription string, opts ...mcp.ToolOption) mcp.Tool {
+	return mcp.NewTool(name, append([]mcp.ToolOption{
+		mcp.WithDescription(description + ". The action runs per droplet in chunks with bounded concurrency, and the result lists the actions started under succeeded, the droplets that failed with their errors under failed, and the droplets not attempted after a failure under skipped."),
+		common.WithOutputSchema[ByTagResult](),
+		mcp.WithString("Tag", mcp.Required(), mcp.Description(tagDescription)),
+		mcp.WithNumber("ChunkSize", mcp.DefaultNumber(defaultByTagChunkSize), mcp.Min(1), mcp.Description("Number of droplets processed per chunk. A chunk finishes before the next one starts")),
+		mcp.WithNumber("Concurrency", mcp.DefaultNumber(defaultByTagConcurrency), mcp.Min(1), mcp.Max(maxByTagConcurrency), mcp.Description("Maximum number of droplet actions in flight at once")),
+		mcp.WithBoolean("StopOnFailure", mcp.DefaultBool(false), mcp.Description("Skip the remaining chunks once any droplet in a chunk fails")),
+	}, opts...)...)
+}
+
+// runByTag lists the droplets carrying the request's Tag and runs op against each of them in chunks,
+// with at most Concurrency actions in flight, returning the batch of per-droplet outcomes.
+func (da *DropletActionsTool) runByTag(ctx context.Context, req mcp.CallToolRequest, op dropletActionFn) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	tag := args.RequiredString("Tag")
+	chunkSize := int(args.Number("ChunkSize", defaultByTagChunkSize))
+	concurrency := int(args.Number("Concurrency", defaultByTagConcurrency))
+	stopOnFailure := args.Bool("StopOnFailure", false)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if chunkSize < 1 {
+		chunkSize = defaultByTagChunkSize
+	}
+	if concurrency < 1 {
+		concurrency = defaultByTagConcurrency
+	}
+	concurrency = min(concurrency, maxByTagConcurrency)
+
+	client, err := da.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	droplets, err := listDropletsByTag(ctx, client, tag)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	result := &ByTagResult{
+		Tag:         tag,
+		Total:       len(droplets),
+		ChunkSize:   chunkSize,
+		Concurrency: concurrency,
+		Batch:       fanout.NewBatch[ByTagAction, ByTagDroplet](),
+	}
+	targets := make([]ByTagDroplet, len(droplets))
+	for i, d := range droplets {
+		targets[i] = ByTagDroplet{DropletID: d.ID, DropletName: d.Name}
+	}
+
+	for start := 0; start < len(targets); start += chunkSize {
+		chunk := targets[start:min(start+chunkSize, len(targets))]
+		if (stopOnFailure && len(result.Failed) > 0) || ctx.Err() != nil {
+			for _, d := range chunk {
+				result.Skip(d)
+			}
+			continue
+		}
+		result.Chunks++
+
+		outcomes := fanout.Run(ctx, chunk, fanout.Options{Concurrency: concurrency, Timeout: byTagActionTimeout},
+			func(ctx context.Context, d ByTagDroplet) (*godo.Action, error) {
+				action, _, err := op(ctx, client, d.DropletID)
+				return action, err
+			})
+		fanout.Collect(&result.Batch, chunk, outcomes, func(d ByTagDroplet, action *godo.Action) ByTagAction {
+			return ByTagAction{ByTagDroplet: d, Action: action}
+		})
+	}
+
+	return common.NewToolResultStructured(result)
+}
+
+// listDropletsByTag returns every droplet carrying tag, following pagination.
+func listDropletsByTag(ctx context.Context, client *godo.Client, tag string) ([]godo.Droplet, error) {
+	return common.ListAll(ctx, dropletsPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+		return client.Droplets.ListByTag(ctx, tag, opt)
+	})
+}
@@ -0,0 +1,281 @@
+package droplet
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupByTagToolWithMocks(droplets *MockDropletsService, actions *MockDropletActionsService) *DropletActionsTool {
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{Droplets: droplets, DropletActions: actions}, nil
+	}
+
+	return NewDropletActionsTool(client)
+}
+
+func taggedDroplets(n int) []godo.Droplet {
+	droplets := make([]godo.Droplet, n)
+	for i := range droplets {
+		droplets[i] = godo.Droplet{ID: i + 1, Name: "web"}
+	}
+	return droplets
+}
+
+func TestDropletActionsTool_byTagHandlers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	action := &godo.Action{ID: 3001, Status: "in-progress"}
+	tests := []struct {
+		name      string
+		handler   func(*DropletActionsTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args      map[string]any
+		mockSetup func(*MockDropletActionsService, int)
+	}{
+		{
+			name: "power cycle",
+			handler: func(da *DropletActionsTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return da.powerCycleByTag
+			},
+			mockSetup: func(m *MockDropletActionsService, id int) {
+				m.EXPECT().PowerCycle(gomock.Any(), id).Return(action, nil, nil)
+			},
+		},
+		{
+			name: "power on",
+			handler: func(da *DropletActionsTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return da.powerOnByTag
+			},
+			mockSetup: func(m *MockDropletActionsService, id int) {
+				m.EXPECT().PowerOn(gomock.Any(), id).Return(action, nil, nil)
+			},
+		},
+		{
+			name: "power off",
+			handler: func(da *DropletActionsTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return da.powerOffByTag
+			},
+			mockSetup: func(m *MockDropletActionsService, id int) {
+				m.EXPECT().PowerOff(gomock.Any(), id).Return(action, nil, nil)
+			},
+		},
+		{
+			name: "shutdown",
+			handler: func(da *DropletActionsTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return da.shutdownByTag
+			},
+			mockSetup: func(m *MockDropletActionsService, id int) {
+				m.EXPECT().Shutdown(gomock.Any(), id).Return(action, nil, nil)
+			},
+		},
+		{
+			name: "enable backups",
+			handler: func(da *DropletActionsTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return da.enableBackupsByTag
+			},
+			mockSetup: func(m *MockDropletActionsService, id int) {
+				m.EXPECT().EnableBackups(gomock.Any(), id).Return(action, nil, nil)
+			},
+		},
+		{
+			name: "disable backups",
+			handler: func(da *DropletActionsTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return da.disableBackupsByTag
+			},
+			mockSetup: func(m *MockDropletActionsService, id int) {
+				m.EXPECT().DisableBackups(gomock.Any(), id).Return(action, nil, nil)
+			},
+		},
+		{
+			name: "snapshot",
+			handler: func(da *DropletActionsTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return da.snapshotByTag
+			},
+			args: map[string]any{"Name": "snap-by-tag"},
+			mockSetup: func(m *MockDropletActionsService, id int) {
+				m.EXPECT().Snapshot(gomock.Any(), id, "snap-by-tag").Return(action, nil, nil)
+			},
+		},
+		{
+			name: "enable IPv6",
+			handler: func(da *DropletActionsTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return da.enableIPv6ByTag
+			},
+			mockSetup: func(m *MockDropletActionsService, id int) {
+				m.EXPECT().EnableIPv6(gomock.Any(), id).Return(action, nil, nil)
+			},
+		},
+		{
+			name: "enable private networking",
+			handler: func(da *DropletActionsTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return da.enablePrivateNetworkingByTag
+			},
+			mockSetup: func(m *MockDropletActionsService, id int) {
+				m.EXPECT().EnablePrivateNetworking(gomock.Any(), id).Return(action, nil, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDroplets := NewMockDropletsService(ctrl)
+			mockActions := NewMockDropletActionsService(ctrl)
+			mockDroplets.EXPECT().ListByTag(gomock.Any(), "web", gomock.Any()).Return(taggedDroplets(2), &godo.Response{}, nil)
+			tc.mockSetup(mockActions, 1)
+			tc.mockSetup(mockActions, 2)
+
+			args := map[string]any{"Tag": "web"}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			tool := setupByTagToolWithMocks(mockDroplets, mockActions)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}}
+			resp, err := tc.handler(tool)(context.Background(), req)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.False(t, resp.IsError)
+			result := resp.StructuredContent.(*ByTagResult)
+			require.Equal(t, 2, result.Total)
+			require.Len(t, result.Succeeded, 2)
+			require.Equal(t, action.ID, result.Succeeded[0].Action.ID)
+		})
+	}
+}
+
+func TestDropletActionsTool_runByTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		droplets    int
+		listErr     error
+		failIDs     map[int]bool
+		expectError bool
+		check       func(*testing.T, *ByTagResult)
+	}{
+		{
+			name:     "Chunks droplets and aggregates failures",
+			args:     map[string]any{"Tag": "web", "ChunkSize": float64(2), "Concurrency": float64(2)},
+			droplets: 5,
+			failIDs:  map[int]bool{3: true},
+			check: func(t *testing.T, r *ByTagResult) {
+				require.Equal(t, 5, r.Total)
+				require.Equal(t, 3, r.Chunks)
+				require.Len(t, r.Succeeded, 4)
+				require.Len(t, r.Failed, 1)
+				require.Equal(t, 3, r.Failed[0].Item.DropletID)
+				require.Contains(t, r.Failed[0].Error, "unprocessable")
+			},
+		},
+		{
+			name:     "Stops after a failing chunk",
+			args:     map[string]any{"Tag": "web", "ChunkSize": float64(2), "StopOnFailure": true},
+			droplets: 5,
+			failIDs:  map[int]bool{1: true},
+			check: func(t *testing.T, r *ByTagResult) {
+				require.Equal(t, 1, r.Chunks)
+				require.Len(t, r.Succeeded, 1)
+				require.Len(t, r.Failed, 1)
+				require.Len(t, r.Skipped, 3)
+				require.Equal(t, 5, r.Skipped[2].DropletID)
+			},
+		},
+		{
+			name:     "Concurrency is capped",
+			args:     map[string]any{"Tag": "web", "Concurrency": float64(1000)},
+			droplets: 1,
+			check: func(t *testing.T, r *ByTagResult) {
+				require.Equal(t, maxByTagConcurrency, r.Concurrency)
+				require.Equal(t, defaultByTagChunkSize, r.ChunkSize)
+			},
+		},
+		{
+			name:     "No droplets with tag",
+			args:     map[string]any{"Tag": "web"},
+			droplets: 0,
+			check: func(t *testing.T, r *ByTagResult) {
+				require.Equal(t, 0, r.Total)
+				require.Empty(t, r.Succeeded)
+				require.NotNil(t, r.Failed)
+			},
+		},
+		{
+			name:        "List error",
+			args:        map[string]any{"Tag": "web"},
+			listErr:     errors.New("api error"),
+			expectError: true,
+		},
+		{
+			name:        "Missing tag",
+			args:        map[string]any{},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDroplets := NewMockDropletsService(ctrl)
+			if tc.args["Tag"] != nil {
+				mockDroplets.EXPECT().ListByTag(gomock.Any(), "web", gomock.Any()).Return(taggedDroplets(tc.droplets), &godo.Response{}, tc.listErr)
+			}
+
+			var inFlight, maxInFlight atomic.Int32
+			op := func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+				n := inFlight.Add(1)
+				defer inFlight.Add(-1)
+				for {
+					m := maxInFlight.Load()
+					if n <= m || maxInFlight.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				if tc.failIDs[dropletID] {
+					return nil, nil, errors.New("unprocessable entity")
+				}
+				return &godo.Action{ID: dropletID}, nil, nil
+			}
+
+			tool := setupByTagToolWithMocks(mockDroplets, nil)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.runByTag(context.Background(), req, op)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.False(t, resp.IsError)
+			result := resp.StructuredContent.(*ByTagResult)
+			require.LessOrEqual(t, int(maxInFlight.Load()), result.Concurrency)
+			tc.check(t, result)
+		})
+	}
+}
+
+func TestDropletActionsTool_runByTag_mistypedArgs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Mistyped arguments are refused before the droplets are listed.
+	tool := setupByTagToolWithMocks(NewMockDropletsService(ctrl), nil)
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Tag": "web", "ChunkSize": "lots", "StopOnFailure": "yes"}}}
+	resp, err := tool.runByTag(context.Background(), req, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		t.Fatal("no droplet action should run")
+		return nil, nil, nil
+	})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+	text := resp.Content[0].(mcp.TextContent).Text
+	require.Contains(t, text, "ChunkSize must be a number")
+	require.Contains(t, text, "StopOnFailure must be a boolean")
+}
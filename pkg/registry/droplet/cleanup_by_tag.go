@@ -0,0 +1,327 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"mcp-digitalocean/internal/fanout"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultCleanupPoll          = 5 * time.Second
+	defaultCleanupDetachTimeout = 5 * time.Minute
+)
+
+// The kinds of resource cleanup-by-tag deletes, in the order they are deleted: whatever points
+// at a droplet goes before it, and volumes go once the deleted droplets have released them.
+const (
+	cleanupDomainRecord = "domain_record"
+	cleanupLoadBalancer = "load_balancer"
+	cleanupFirewall     = "firewall"
+	cleanupDroplet      = "droplet"
+	cleanupVolume       = "volume"
+	cleanupSnapshot     = "snapshot"
+)
+
+var cleanupOrder = []string{cleanupDomainRecord, cleanupLoadBalancer, cleanupFirewall, cleanupDroplet, cleanupVolume, cleanupSnapshot}
+
+// CleanupItem is a resource cleanup-by-tag found, and what became of it.
+type CleanupItem struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+
+	// domain is the domain of a DNS record.
+	domain string
+	// dropletIDs are the droplets a volume is attached to.
+	dropletIDs []int
+}
+
+// CleanupByTagResult lists the resources carrying a tag, or deleted because of it. Once executed,
+// the batch holds the resources deleted, those that failed and those skipped as still in use.
+type CleanupByTagResult struct {
+	Tag       string        `json:"tag"`
+	Executed  bool          `json:"executed"`
+	Resources []CleanupItem `json:"resources"`
+	Message   string        `json:"message"`
+	fanout.Batch[CleanupItem, CleanupItem]
+}
+
+// CleanupByTagTool provides a tool that tears down every resource carrying a tag.
+type CleanupByTagTool struct {
+	client        func(ctx context.Context) (*godo.Client, error)
+	pollInterval  time.Duration
+	detachTimeout time.Duration
+}
+
+// NewCleanupByTagTool creates a new cleanup by tag tool
+func NewCleanupByTagTool(client func(ctx context.Context) (*godo.Client, error)) *CleanupByTagTool {
+	return &CleanupByTagTool{
+		client:        client,
+		pollInterval:  defaultCleanupPoll,
+		detachTimeout: defaultCleanupDetachTimeout,
+	}
+}
+
+// publicAddresses returns the public IPv4 and IPv6 addresses of a droplet.
+func publicAddresses(droplet godo.Droplet) []string {
+	var addresses []string
+	if droplet.Networks == nil {
+		return nil
+	}
+	for _, network := range droplet.Networks.V4 {
+		if network.Type == "public" {
+			addresses = append(addresses, network.IPAddress)
+		}
+	}
+	for _, network := range droplet.Networks.V6 {
+		if network.Type == "public" {
+			addresses = append(addresses, network.IPAddress)
+		}
+	}
+	return addresses
+}
+
+// collect finds the resources carrying tag: droplets, volumes, load balancers, firewalls and
+// snapshots tagged with it, load balancers targeting it, and, with dns, the A and AAAA records
+// pointing at the addresses of the tagged droplets and load balancers. Items are in deletion order.
+func (c *CleanupByTagTool) collect(ctx context.Context, client *godo.Client, tag string, dns bool) ([]CleanupItem, error) {
+	droplets, err := common.ListAll(ctx, dropletsPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+		return client.Droplets.ListByTag(ctx, tag, opt)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("droplets: %w", err)
+	}
+	loadBalancers, err := common.ListAll(ctx, dropletsPageSize, client.LoadBalancers.List)
+	if err != nil {
+		return nil, fmt.Errorf("load balancers: %w", err)
+	}
+	firewalls, err := common.ListAll(ctx, dropletsPageSize, client.Firewalls.List)
+	if err != nil {
+		return nil, fmt.Errorf("firewalls: %w", err)
+	}
+	volumes, err := common.ListAll(ctx, dropletsPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.Volume, *godo.Response, error) {
+		return client.Storage.ListVolumes(ctx, &godo.ListVolumeParams{ListOptions: opt})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("volumes: %w", err)
+	}
+	snapshots, err := common.ListAll(ctx, dropletsPageSize, client.Snapshots.List)
+	if err != nil {
+		return nil, fmt.Errorf("snapshots: %w", err)
+	}
+
+	var items, lbItems, firewallItems, dropletItems, volumeItems, snapshotItems []CleanupItem
+	var addresses []string
+	for _, droplet := range droplets {
+		dropletItems = append(dropletItems, CleanupItem{Kind: cleanupDroplet, ID: strconv.Itoa(droplet.ID), Name: droplet.Name})
+		addresses = append(addresses, publicAddresses(droplet)...)
+	}
+	for _, lb := range loadBalancers {
+		if lb.Tag == tag || slices.Contains(lb.Tags, tag) {
+			lbItems = append(lbItems, CleanupItem{Kind: cleanupLoadBalancer, ID: lb.ID, Name: lb.Name})
+			if lb.IP != "" {
+				addresses = append(addresses, lb.IP)
+			}
+		}
+	}
+	for _, firewall := range firewalls {
+		if slices.Contains(firewall.Tags, tag) {
+			firewallItems = append(firewallItems, CleanupItem{Kind: cleanupFirewall, ID: firewall.ID, Name: firewall.Name})
+		}
+	}
+	for _, volume := range volumes {
+		if slices.Contains(volume.Tags, tag) {
+			volumeItems = append(volumeItems, CleanupItem{Kind: cleanupVolume, ID: volume.ID, Name: volume.Name, dropletIDs: volume.DropletIDs})
+		}
+	}
+	for _, snapshot := range snapshots {
+		if slices.Contains(snapshot.Tags, tag) {
+			snapshotItems = append(snapshotItems, CleanupItem{Kind: cleanupSnapshot, ID: snapshot.ID, Name: snapshot.Name})
+		}
+	}
+
+	if dns && len(addresses) > 0 {
+		domains, err := common.ListAll(ctx, dropletsPageSize, client.Domains.List)
+		if err != nil {
+			return nil, fmt.Errorf("domains: %w", err)
+		}
+		for _, domain := range domains {
+			records, err := common.ListAll(ctx, dropletsPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+				return client.Domains.Records(ctx, domain.Name, opt)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("records of %s: %w", domain.Name, err)
+			}
+			for _, record := range records {
+				if (record.Type == "A" || record.Type == "AAAA") && slices.Contains(addresses, record.Data) {
+					items = append(items, CleanupItem{
+						Kind:   cleanupDomainRecord,
+						ID:     strconv.Itoa(record.ID),
+						Name:   fmt.Sprintf("%s.%s %s %s", record.Name, domain.Name, record.Type, record.Data),
+						domain: domain.Name,
+					})
+				}
+			}
+		}
+	}
+	return slices.Concat(items, lbItems, firewallItems, dropletItems, volumeItems, snapshotItems), nil
+}
+
+// waitForDetach polls a volume until it is attached to no droplet.
+func (c *CleanupByTagTool) waitForDetach(ctx context.Context, client *godo.Client, volumeID string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.detachTimeout)
+	defer cancel()
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+	for {
+		volume, _, err := client.Storage.GetVolume(ctx, volumeID)
+		if err != nil {
+			return err
+		}
+		if len(volume.DropletIDs) == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("volume is still attached to droplets %v: %w", volume.DropletIDs, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// remove deletes one resource.
+func (c *CleanupByTagTool) remove(ctx context.Context, client *godo.Client, item CleanupItem) error {
+	var err error
+	switch item.Kind {
+	case cleanupDomainRecord:
+		id, _ := strconv.Atoi(item.ID)
+		_, err = client.Domains.DeleteRecord(ctx, item.domain, id)
+	case cleanupLoadBalancer:
+		_, err = client.LoadBalancers.Delete(ctx, item.ID)
+	case cleanupFirewall:
+		_, err = client.Firewalls.Delete(ctx, item.ID)
+	case cleanupDroplet:
+		id, _ := strconv.Atoi(item.ID)
+		_, err = client.Droplets.Delete(ctx, id)
+	case cleanupVolume:
+		if len(item.dropletIDs) > 0 {
+			if err := c.waitForDetach(ctx, client, item.ID); err != nil {
+				return err
+			}
+		}
+		_, err = client.Storage.DeleteVolume(ctx, item.ID)
+	case cleanupSnapshot:
+		_, err = client.Snapshots.Delete(ctx, item.ID)
+	}
+	return err
+}
+
+// cleanupByTag lists the resources carrying a tag and, when called with Confirm: true, deletes
+// them in dependency-safe order. A failed deletion does not stop the others.
+func (c *CleanupByTagTool) cleanupByTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	tag := args.RequiredString("Tag")
+	confirmed := args.Bool("Confirm", false)
+	dns := args.Bool("IncludeDNS", true)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := c.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	items, err := c.collect(ctx, client, tag, dns)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	result := &CleanupByTagResult{Tag: tag, Resources: items, Batch: fanout.NewBatch[CleanupItem, CleanupItem]()}
+	if len(items) == 0 {
+		result.Resources = []CleanupItem{}
+		result.Message = fmt.Sprintf("no resources carry the tag %s", tag)
+		return common.NewToolResultStructured(result)
+	}
+
+	if !confirmed {
+		for i := range result.Resources {
+			result.Resources[i].Status = "pending"
+		}
+		result.Message = fmt.Sprintf("nothing was deleted; call cleanup-by-tag again with Confirm: true to delete these %d resources", len(items))
+		return common.NewToolResultStructured(result)
+	}
+
+	result.Executed = true
+	deleted := map[int]bool{}
+	// the resources of a kind are deleted concurrently, a kind once the kinds before it are done.
+	for _, kind := range cleanupOrder {
+		var batch []*CleanupItem
+		for i := range result.Resources {
+			item := &result.Resources[i]
+			if item.Kind != kind {
+				continue
+			}
+			if item.Kind == cleanupVolume {
+				// a volume attached to a droplet that is kept cannot be deleted.
+				for _, id := range item.dropletIDs {
+					if !deleted[id] {
+						item.Status = "skipped"
+						item.Reason = fmt.Sprintf("attached to droplet %d, which does not carry the tag", id)
+						break
+					}
+				}
+				if item.Status == "skipped" {
+					result.Skip(*item)
+					continue
+				}
+			}
+			batch = append(batch, item)
+		}
+		outcomes := fanout.Run(ctx, batch, fanout.Options{}, func(ctx context.Context, item *CleanupItem) (struct{}, error) {
+			return struct{}{}, c.remove(ctx, client, *item)
+		})
+		for n, item := range batch {
+			if err := outcomes[n].Err; err != nil {
+				item.Status = "failed"
+				item.Reason = err.Error()
+				result.Fail(*item, err)
+				continue
+			}
+			item.Status = "deleted"
+			result.Succeed(*item)
+			if item.Kind == cleanupDroplet {
+				id, _ := strconv.Atoi(item.ID)
+				deleted[id] = true
+			}
+		}
+	}
+	result.Message = fmt.Sprintf("deleted %d of %d resources", len(result.Succeeded), len(items))
+	return common.NewToolResultStructured(result)
+}
+
+// Tools returns a list of tool functions
+func (c *CleanupByTagTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: c.cleanupByTag,
+			Tool: mcp.NewTool("cleanup-by-tag",
+				mcp.WithDescription("Tear down an environment by tag: lists the droplets, volumes, load balancers, firewalls and snapshots carrying the tag, load balancers targeting it, and the DNS A and AAAA records pointing at the tagged droplets and load balancers. Without Confirm it only shows what would be deleted. With Confirm: true it deletes them in dependency-safe order: DNS records, load balancers, firewalls, droplets, then volumes once detached, then snapshots."),
+				common.WithOutputSchema[CleanupByTagResult](),
+				mcp.WithString("Tag", mcp.Required(), mcp.Description("Tag of the resources to delete")),
+				mcp.WithBoolean("Confirm", mcp.DefaultBool(false), mcp.Description("Delete the resources listed. Without it, the tool only lists them")),
+				mcp.WithBoolean("IncludeDNS", mcp.DefaultBool(true), mcp.Description("Also delete the A and AAAA records pointing at the tagged droplets and load balancers")),
+			),
+		},
+	}
+}
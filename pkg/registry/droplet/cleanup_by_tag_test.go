@@ -0,0 +1,209 @@
+package droplet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"mcp-digitalocean/internal/confirm"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type cleanupMocks struct {
+	droplets      *MockDropletsService
+	loadBalancers *MockLoadBalancersService
+	firewalls     *MockFirewallsService
+	storage       *MockStorageService
+	snapshots     *MockSnapshotsService
+	domains       *MockDomainsService
+}
+
+func setupCleanupByTagToolWithMocks(m cleanupMocks) *CleanupByTagTool {
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Droplets:      m.droplets,
+			LoadBalancers: m.loadBalancers,
+			Firewalls:     m.firewalls,
+			Storage:       m.storage,
+			Snapshots:     m.snapshots,
+			Domains:       m.domains,
+		}, nil
+	}
+	tool := NewCleanupByTagTool(client)
+	tool.pollInterval = time.Millisecond
+	return tool
+}
+
+func TestCleanupByTagTool_cleanupByTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newMocks := func() cleanupMocks {
+		return cleanupMocks{
+			droplets:      NewMockDropletsService(ctrl),
+			loadBalancers: NewMockLoadBalancersService(ctrl),
+			firewalls:     NewMockFirewallsService(ctrl),
+			storage:       NewMockStorageService(ctrl),
+			snapshots:     NewMockSnapshotsService(ctrl),
+			domains:       NewMockDomainsService(ctrl),
+		}
+	}
+	listed := func(m cleanupMocks) {
+		m.droplets.EXPECT().ListByTag(gomock.Any(), "test-env", gomock.Any()).Return([]godo.Droplet{{
+			ID: 10, Name: "web",
+			Networks: &godo.Networks{V4: []godo.NetworkV4{{IPAddress: "203.0.113.10", Type: "public"}, {IPAddress: "10.0.0.2", Type: "private"}}},
+		}}, nil, nil)
+		m.loadBalancers.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.LoadBalancer{
+			{ID: "lb-1", Name: "web-lb", Tag: "test-env", IP: "203.0.113.20"},
+			{ID: "lb-2", Name: "other-lb", Tag: "prod"},
+		}, nil, nil)
+		m.firewalls.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Firewall{
+			{ID: "fw-1", Name: "web-fw", Tags: []string{"test-env"}},
+			{ID: "fw-2", Name: "prod-fw", Tags: []string{"prod"}},
+		}, nil, nil)
+		m.storage.EXPECT().ListVolumes(gomock.Any(), gomock.Any()).Return([]godo.Volume{
+			{ID: "vol-1", Name: "data", Tags: []string{"test-env"}, DropletIDs: []int{10}},
+			{ID: "vol-2", Name: "kept", Tags: []string{"test-env"}, DropletIDs: []int{99}},
+		}, nil, nil)
+		m.snapshots.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Snapshot{
+			{ID: "snap-1", Name: "web-snap", Tags: []string{"test-env"}},
+			{ID: "snap-2", Name: "other"},
+		}, nil, nil)
+		m.domains.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Domain{{Name: "example.com"}}, nil, nil)
+		m.domains.EXPECT().Records(gomock.Any(), "example.com", gomock.Any()).Return([]godo.DomainRecord{
+			{ID: 1, Type: "A", Name: "www", Data: "203.0.113.10"},
+			{ID: 2, Type: "A", Name: "lb", Data: "203.0.113.20"},
+			{ID: 3, Type: "A", Name: "api", Data: "198.51.100.1"},
+			{ID: 4, Type: "TXT", Name: "www", Data: "203.0.113.10"},
+		}, nil, nil)
+	}
+	call := func(t *testing.T, m cleanupMocks, args map[string]any) (*mcp.CallToolResult, CleanupByTagResult) {
+		t.Helper()
+		tool := setupCleanupByTagToolWithMocks(m)
+		resp, err := tool.cleanupByTag(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		var result CleanupByTagResult
+		if !resp.IsError {
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+		}
+		return resp, result
+	}
+	kinds := func(result CleanupByTagResult) []string {
+		var ids []string
+		for _, item := range result.Resources {
+			ids = append(ids, item.Kind+":"+item.ID+":"+item.Status)
+		}
+		return ids
+	}
+
+	t.Run("Preview without Confirm", func(t *testing.T) {
+		m := newMocks()
+		listed(m)
+		resp, result := call(t, m, map[string]any{"Tag": "test-env"})
+		require.False(t, resp.IsError)
+		require.False(t, result.Executed)
+		require.Equal(t, []string{
+			"domain_record:1:pending", "domain_record:2:pending",
+			"load_balancer:lb-1:pending", "firewall:fw-1:pending", "droplet:10:pending",
+			"volume:vol-1:pending", "volume:vol-2:pending", "snapshot:snap-1:pending",
+		}, kinds(result))
+	})
+
+	t.Run("Deletes in dependency order", func(t *testing.T) {
+		m := newMocks()
+		listed(m)
+		// the two records are deleted concurrently, both before the load balancer.
+		record1 := m.domains.EXPECT().DeleteRecord(gomock.Any(), "example.com", 1).Return(nil, nil)
+		record2 := m.domains.EXPECT().DeleteRecord(gomock.Any(), "example.com", 2).Return(nil, nil)
+		gomock.InOrder(
+			m.loadBalancers.EXPECT().Delete(gomock.Any(), "lb-1").Return(nil, nil).After(record1).After(record2),
+			m.firewalls.EXPECT().Delete(gomock.Any(), "fw-1").Return(nil, errors.New("in use")),
+			m.droplets.EXPECT().Delete(gomock.Any(), 10).Return(nil, nil),
+			m.storage.EXPECT().GetVolume(gomock.Any(), "vol-1").Return(&godo.Volume{ID: "vol-1", DropletIDs: []int{10}}, nil, nil),
+			m.storage.EXPECT().GetVolume(gomock.Any(), "vol-1").Return(&godo.Volume{ID: "vol-1"}, nil, nil),
+			m.storage.EXPECT().DeleteVolume(gomock.Any(), "vol-1").Return(nil, nil),
+			m.snapshots.EXPECT().Delete(gomock.Any(), "snap-1").Return(nil, nil),
+		)
+		resp, result := call(t, m, map[string]any{"Tag": "test-env", "Confirm": true})
+		require.False(t, resp.IsError)
+		require.True(t, result.Executed)
+		require.Equal(t, []string{
+			"domain_record:1:deleted", "domain_record:2:deleted",
+			"load_balancer:lb-1:deleted", "firewall:fw-1:failed", "droplet:10:deleted",
+			"volume:vol-1:deleted", "volume:vol-2:skipped", "snapshot:snap-1:deleted",
+		}, kinds(result))
+		require.Len(t, result.Succeeded, 6)
+		require.Len(t, result.Failed, 1)
+		require.Equal(t, "fw-1", result.Failed[0].Item.ID)
+		require.Equal(t, "in use", result.Failed[0].Error)
+		require.Len(t, result.Skipped, 1)
+		require.Equal(t, "vol-2", result.Skipped[0].ID)
+	})
+
+	t.Run("Confirmed through the confirm guard", func(t *testing.T) {
+		m := newMocks()
+		m.droplets.EXPECT().ListByTag(gomock.Any(), "test-env", gomock.Any()).Return([]godo.Droplet{{ID: 10, Name: "web"}}, nil, nil)
+		m.loadBalancers.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		m.firewalls.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		m.storage.EXPECT().ListVolumes(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		m.snapshots.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		m.droplets.EXPECT().Delete(gomock.Any(), 10).Return(nil, nil)
+
+		tool := setupCleanupByTagToolWithMocks(m)
+		s := server.NewMCPServer("test", "0.0.0")
+		s.AddTools(tool.Tools()...)
+		guard, err := confirm.NewGuard(0)
+		require.NoError(t, err)
+		guard.Apply(s)
+		handler := guard.Middleware(tool.cleanupByTag)
+		request := func(args map[string]any) mcp.CallToolRequest {
+			return mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "cleanup-by-tag", Arguments: args}}
+		}
+
+		// without Confirm the guard previews the call and the tool doesn't run.
+		resp, err := handler(context.Background(), request(map[string]any{"Tag": "test-env", "IncludeDNS": false}))
+		require.NoError(t, err)
+		require.IsType(t, confirm.Preview{}, resp.StructuredContent)
+
+		// Confirm: true reaches the tool, which deletes what it lists.
+		resp, err = handler(context.Background(), request(map[string]any{"Tag": "test-env", "IncludeDNS": false, "Confirm": true}))
+		require.NoError(t, err)
+		require.False(t, resp.IsError)
+		result := resp.StructuredContent.(*CleanupByTagResult)
+		require.True(t, result.Executed)
+		require.Len(t, result.Succeeded, 1)
+	})
+
+	t.Run("Nothing carries the tag", func(t *testing.T) {
+		m := newMocks()
+		m.droplets.EXPECT().ListByTag(gomock.Any(), "empty", gomock.Any()).Return(nil, nil, nil)
+		m.loadBalancers.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		m.firewalls.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		m.storage.EXPECT().ListVolumes(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		m.snapshots.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+		resp, result := call(t, m, map[string]any{"Tag": "empty", "Confirm": true})
+		require.False(t, resp.IsError)
+		require.Empty(t, result.Resources)
+		require.False(t, result.Executed)
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		m := newMocks()
+		m.droplets.EXPECT().ListByTag(gomock.Any(), "test-env", gomock.Any()).Return(nil, nil, errors.New("boom"))
+		resp, _ := call(t, m, map[string]any{"Tag": "test-env"})
+		require.True(t, resp.IsError)
+	})
+
+	t.Run("Missing tag", func(t *testing.T) {
+		resp, _ := call(t, newMocks(), map[string]any{})
+		require.True(t, resp.IsError)
+	})
+}
@@ -0,0 +1,262 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultCloneTimeout = 3600
+	defaultClonePoll    = 10 * time.Second
+)
+
+// DropletCloneResult is the outcome of droplet-clone.
+type DropletCloneResult struct {
+	Succeeded       bool            `json:"succeeded"`
+	SourceDropletID int             `json:"source_droplet_id"`
+	SnapshotID      int             `json:"snapshot_id,omitempty"`
+	SnapshotName    string          `json:"snapshot_name"`
+	DropletID       int             `json:"droplet_id,omitempty"`
+	DropletName     string          `json:"droplet_name"`
+	Region          string          `json:"region"`
+	Size            string          `json:"size"`
+	PublicIPv4      string          `json:"public_ipv4,omitempty"`
+	Steps           []OperationStep `json:"steps"`
+	Error           string          `json:"error,omitempty"`
+}
+
+// cloneRun carries out the steps a clone shares with a region migration, recording each of them.
+type cloneRun struct {
+	client   *godo.Client
+	interval time.Duration
+	steps    []OperationStep
+}
+
+func (c *cloneRun) done(step string, actionID int, detail string) {
+	c.steps = append(c.steps, OperationStep{Step: step, Status: "done", ActionID: actionID, Detail: detail})
+}
+
+func (c *cloneRun) failed(step string, actionID int, err error) error {
+	c.steps = append(c.steps, OperationStep{Step: step, Status: "failed", ActionID: actionID, Detail: err.Error()})
+	return fmt.Errorf("%s: %w", step, err)
+}
+
+// snapshot snapshots the droplet, waits for the snapshot action and returns the new snapshot image.
+func (c *cloneRun) snapshot(ctx context.Context, dropletID int, name string) (*godo.Image, error) {
+	action, _, err := c.client.DropletActions.Snapshot(ctx, dropletID, name)
+	if err != nil {
+		return nil, c.failed("snapshot", 0, err)
+	}
+	if _, err := waitForAction(ctx, c.interval, func(ctx context.Context) (*godo.Action, *godo.Response, error) {
+		return c.client.DropletActions.Get(ctx, dropletID, action.ID)
+	}); err != nil {
+		return nil, c.failed("snapshot", action.ID, err)
+	}
+
+	snapshots, err := common.ListAll(ctx, dropletsPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.Image, *godo.Response, error) {
+		return c.client.Droplets.Snapshots(ctx, dropletID, opt)
+	})
+	if err != nil {
+		return nil, c.failed("snapshot", action.ID, err)
+	}
+	var image *godo.Image
+	for i := range snapshots {
+		// the newest snapshot of that name is the one just taken.
+		if snapshots[i].Name == name && (image == nil || snapshots[i].ID > image.ID) {
+			image = &snapshots[i]
+		}
+	}
+	if image == nil {
+		return nil, c.failed("snapshot", action.ID, fmt.Errorf("snapshot %q of droplet %d not found", name, dropletID))
+	}
+	c.done("snapshot", action.ID, fmt.Sprintf("snapshot %d", image.ID))
+	return image, nil
+}
+
+// transfer makes the image available in region, transferring it when it is not yet.
+func (c *cloneRun) transfer(ctx context.Context, image *godo.Image, region string) error {
+	if slices.Contains(image.Regions, region) {
+		return nil
+	}
+	action, _, err := c.client.ImageActions.Transfer(ctx, image.ID, &godo.ActionRequest{"type": "transfer", "region": region})
+	if err != nil {
+		return c.failed("image transfer", 0, err)
+	}
+	if _, err := waitForAction(ctx, c.interval, func(ctx context.Context) (*godo.Action, *godo.Response, error) {
+		return c.client.ImageActions.Get(ctx, image.ID, action.ID)
+	}); err != nil {
+		return c.failed("image transfer", action.ID, err)
+	}
+	c.done("image transfer", action.ID, fmt.Sprintf("image %d to %s", image.ID, region))
+	return nil
+}
+
+// create creates a droplet from the image and waits until it is active.
+func (c *cloneRun) create(ctx context.Context, createReq *godo.DropletCreateRequest) (*godo.Droplet, error) {
+	created, _, err := c.client.Droplets.Create(ctx, createReq)
+	if err != nil {
+		return nil, c.failed("droplet create", 0, err)
+	}
+	if err := waitForDropletStatus(ctx, c.client, created.ID, dropletStatusActive, c.interval); err != nil {
+		return created, c.failed("droplet create", 0, err)
+	}
+	droplet, _, err := c.client.Droplets.Get(ctx, created.ID)
+	if err != nil {
+		return created, c.failed("droplet create", 0, err)
+	}
+	c.done("droplet create", 0, fmt.Sprintf("droplet %d", droplet.ID))
+	return droplet, nil
+}
+
+// cloneRequest returns the create request of a copy of source from image, keeping its tags,
+// monitoring, backups and IPv6 settings.
+func cloneRequest(source *godo.Droplet, image *godo.Image, name, region, size string) *godo.DropletCreateRequest {
+	return &godo.DropletCreateRequest{
+		Name:       name,
+		Region:     region,
+		Size:       size,
+		Image:      godo.DropletCreateImage{ID: image.ID},
+		Tags:       source.Tags,
+		Monitoring: slices.Contains(source.Features, "monitoring"),
+		Backups:    slices.Contains(source.Features, "backups"),
+		IPv6:       slices.Contains(source.Features, "ipv6"),
+	}
+}
+
+// DropletCloneTool provides composite tools that copy a droplet through a snapshot.
+type DropletCloneTool struct {
+	client       func(ctx context.Context) (*godo.Client, error)
+	pollInterval time.Duration
+	now          func() time.Time
+}
+
+// NewDropletCloneTool creates a new droplet clone tool
+func NewDropletCloneTool(client func(ctx context.Context) (*godo.Client, error)) *DropletCloneTool {
+	return &DropletCloneTool{
+		client:       client,
+		pollInterval: defaultClonePoll,
+		now:          time.Now,
+	}
+}
+
+// cloneDroplet snapshots a droplet, transfers the snapshot when the target region differs and
+// creates a new droplet from it. The snapshot is kept, so a failed clone can be retried from it.
+func (dc *DropletCloneTool) cloneDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	sourceID := args.RequiredNumber("ID")
+	name := args.String("Name")
+	region := args.String("Region")
+	size := args.String("Size")
+	snapshotName := args.String("SnapshotName")
+	timeoutSeconds := args.Number("TimeoutSeconds", defaultCloneTimeout)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = defaultCloneTimeout
+	}
+
+	client, err := dc.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	source, _, err := client.Droplets.Get(ctx, int(sourceID))
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if name == "" {
+		name = source.Name + "-clone"
+	}
+	if region == "" && source.Region != nil {
+		region = source.Region.Slug
+	}
+	if size == "" {
+		size = source.SizeSlug
+	}
+	if snapshotName == "" {
+		snapshotName = fmt.Sprintf("%s-clone-%d", source.Name, dc.now().Unix())
+	}
+
+	result := &DropletCloneResult{
+		SourceDropletID: source.ID,
+		SnapshotName:    snapshotName,
+		DropletName:     name,
+		Region:          region,
+		Size:            size,
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds*float64(time.Second)))
+	defer cancel()
+	run := &cloneRun{client: client, interval: dc.pollInterval}
+	err = func() error {
+		image, err := run.snapshot(ctx, source.ID, snapshotName)
+		if err != nil {
+			return err
+		}
+		result.SnapshotID = image.ID
+		if err := run.transfer(ctx, image, region); err != nil {
+			return err
+		}
+		droplet, err := run.create(ctx, cloneRequest(source, image, name, region, size))
+		if droplet != nil {
+			result.DropletID = droplet.ID
+			result.PublicIPv4, _ = droplet.PublicIPv4()
+		}
+		return err
+	}()
+	result.Steps = run.steps
+	if err == nil {
+		result.Succeeded = true
+		return common.NewToolResultStructured(result)
+	}
+	result.Error = err.Error()
+	res, err := common.NewToolResultStructured(result)
+	if err != nil {
+		return nil, err
+	}
+	res.IsError = true
+	return res, nil
+}
+
+// Tools returns a list of tool functions
+func (dc *DropletCloneTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: dc.cloneDroplet,
+			Tool: mcp.NewTool("droplet-clone",
+				mcp.WithDescription("Clone a droplet: snapshots it, waits for the snapshot, transfers the snapshot when the target region differs, and creates a new droplet from it with the same tags, monitoring, backups and IPv6 settings. The result reports each step and its action ID. The snapshot is kept and billed until deleted."),
+				common.WithOutputSchema[DropletCloneResult](),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to clone")),
+				mcp.WithString("Name", mcp.Description("Name of the new droplet. Defaults to the source name with a -clone suffix")),
+				mcp.WithString("Region", mcp.Description("Slug of the region of the new droplet. Defaults to the region of the source")),
+				mcp.WithString("Size", mcp.Description("Slug of the size of the new droplet. Defaults to the size of the source; its disk must fit the snapshot")),
+				mcp.WithString("SnapshotName", mcp.Description("Name of the snapshot. Defaults to the source name with a -clone suffix and a timestamp")),
+				mcp.WithNumber("TimeoutSeconds", mcp.DefaultNumber(defaultCloneTimeout), mcp.Description("How long the whole clone may take, including the snapshot and transfer")),
+			),
+		},
+		{
+			Handler: dc.migrateRegion,
+			Tool: mcp.NewTool("droplet-migrate-region",
+				mcp.WithDescription("Migrate a droplet to another region: snapshots it, transfers the snapshot, creates the replacement from it, and optionally assigns a reserved IP in the target region and re-points the A records of the droplet at the replacement. The result reports each step and its action ID; with DryRun it is the plan of the steps. The source droplet and the snapshot are kept."),
+				common.WithOutputSchema[MigrateRegionResult](),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to migrate")),
+				mcp.WithString("Region", mcp.Required(), mcp.Description("Slug of the target region (e.g., ams3)")),
+				mcp.WithString("Name", mcp.Description("Name of the replacement. Defaults to the name of the source")),
+				mcp.WithString("Size", mcp.Description("Slug of the size of the replacement. Defaults to the size of the source")),
+				mcp.WithString("SnapshotName", mcp.Description("Name of the snapshot. Defaults to the source name with a -migrate suffix and a timestamp")),
+				mcp.WithString("ReservedIP", mcp.Description("Reserved IP in the target region to assign to the replacement")),
+				mcp.WithString("Domain", mcp.Description("Domain whose A records pointing at the source should be re-pointed at the replacement")),
+				mcp.WithString("RecordName", mcp.Description("Name of the A records within Domain, e.g. www, or @ for the domain itself. Defaults to @ when Domain is given")),
+				mcp.WithNumber("TimeoutSeconds", mcp.DefaultNumber(defaultCloneTimeout), mcp.Description("How long the whole migration may take, including the snapshot and transfer")),
+			),
+		},
+	}
+}
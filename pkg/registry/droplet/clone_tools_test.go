@@ -0,0 +1,160 @@
+package droplet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupDropletCloneToolWithMocks(droplets *MockDropletsService, actions *MockDropletActionsService, imageActions *MockImageActionsService) *DropletCloneTool {
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Droplets:       droplets,
+			DropletActions: actions,
+			ImageActions:   imageActions,
+		}, nil
+	}
+	tool := NewDropletCloneTool(client)
+	tool.pollInterval = time.Millisecond
+	tool.now = func() time.Time { return time.Unix(1700000000, 0) }
+	return tool
+}
+
+func TestDropletCloneTool_cloneDroplet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	source := &godo.Droplet{
+		ID: 10, Name: "web", SizeSlug: "s-1vcpu-1gb", Region: &godo.Region{Slug: "nyc3"},
+		Tags: []string{"prod"}, Features: []string{"monitoring", "ipv6"},
+	}
+	const snapshotName = "web-clone-1700000000"
+	snapshotTaken := func(d *MockDropletsService, a *MockDropletActionsService) {
+		a.EXPECT().Snapshot(gomock.Any(), 10, snapshotName).Return(&godo.Action{ID: 501, Status: "in-progress"}, nil, nil)
+		gomock.InOrder(
+			a.EXPECT().Get(gomock.Any(), 10, 501).Return(&godo.Action{ID: 501, Status: "in-progress"}, nil, nil),
+			a.EXPECT().Get(gomock.Any(), 10, 501).Return(&godo.Action{ID: 501, Status: "completed"}, nil, nil),
+		)
+		d.EXPECT().Snapshots(gomock.Any(), 10, gomock.Any()).Return([]godo.Image{
+			{ID: 900, Name: "older", Regions: []string{"nyc3"}},
+			{ID: 901, Name: snapshotName, Regions: []string{"nyc3"}},
+		}, nil, nil)
+	}
+
+	tests := []struct {
+		name            string
+		args            map[string]any
+		mockSetup       func(*MockDropletsService, *MockDropletActionsService, *MockImageActionsService)
+		expectError     bool
+		expectSucceeded bool
+		expectSteps     []string
+	}{
+		{
+			name: "Same region",
+			args: map[string]any{"ID": float64(10)},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService, i *MockImageActionsService) {
+				d.EXPECT().Get(gomock.Any(), 10).Return(source, nil, nil)
+				snapshotTaken(d, a)
+				d.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *godo.DropletCreateRequest) (*godo.Droplet, *godo.Response, error) {
+					require.Equal(t, "web-clone", req.Name)
+					require.Equal(t, "nyc3", req.Region)
+					require.Equal(t, "s-1vcpu-1gb", req.Size)
+					require.Equal(t, godo.DropletCreateImage{ID: 901}, req.Image)
+					require.Equal(t, []string{"prod"}, req.Tags)
+					require.True(t, req.Monitoring)
+					require.True(t, req.IPv6)
+					require.False(t, req.Backups)
+					return &godo.Droplet{ID: 11, Status: "new"}, nil, nil
+				})
+				d.EXPECT().Get(gomock.Any(), 11).Return(&godo.Droplet{ID: 11, Status: "active"}, nil, nil).Times(2)
+			},
+			expectSucceeded: true,
+			expectSteps:     []string{"snapshot", "droplet create"},
+		},
+		{
+			name: "Other region transfers the snapshot",
+			args: map[string]any{"ID": float64(10), "Region": "ams3", "Size": "s-2vcpu-2gb", "Name": "web-eu"},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService, i *MockImageActionsService) {
+				d.EXPECT().Get(gomock.Any(), 10).Return(source, nil, nil)
+				snapshotTaken(d, a)
+				i.EXPECT().Transfer(gomock.Any(), 901, &godo.ActionRequest{"type": "transfer", "region": "ams3"}).Return(&godo.Action{ID: 601, Status: "in-progress"}, nil, nil)
+				i.EXPECT().Get(gomock.Any(), 901, 601).Return(&godo.Action{ID: 601, Status: "completed"}, nil, nil)
+				d.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *godo.DropletCreateRequest) (*godo.Droplet, *godo.Response, error) {
+					require.Equal(t, "web-eu", req.Name)
+					require.Equal(t, "ams3", req.Region)
+					require.Equal(t, "s-2vcpu-2gb", req.Size)
+					return &godo.Droplet{ID: 12, Status: "new"}, nil, nil
+				})
+				d.EXPECT().Get(gomock.Any(), 12).Return(&godo.Droplet{ID: 12, Status: "active"}, nil, nil).Times(2)
+			},
+			expectSucceeded: true,
+			expectSteps:     []string{"snapshot", "image transfer", "droplet create"},
+		},
+		{
+			name: "Snapshot action errors",
+			args: map[string]any{"ID": float64(10)},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService, i *MockImageActionsService) {
+				d.EXPECT().Get(gomock.Any(), 10).Return(source, nil, nil)
+				a.EXPECT().Snapshot(gomock.Any(), 10, snapshotName).Return(&godo.Action{ID: 501, Status: "in-progress"}, nil, nil)
+				a.EXPECT().Get(gomock.Any(), 10, 501).Return(&godo.Action{ID: 501, Type: "snapshot", Status: "errored"}, nil, nil)
+			},
+			expectSteps: []string{"snapshot"},
+		},
+		{
+			name: "Source not found",
+			args: map[string]any{"ID": float64(10)},
+			mockSetup: func(d *MockDropletsService, a *MockDropletActionsService, i *MockImageActionsService) {
+				d.EXPECT().Get(gomock.Any(), 10).Return(nil, nil, errors.New("not found"))
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing ID",
+			args:        map[string]any{},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDroplets := NewMockDropletsService(ctrl)
+			mockActions := NewMockDropletActionsService(ctrl)
+			mockImageActions := NewMockImageActionsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockDroplets, mockActions, mockImageActions)
+			}
+			tool := setupDropletCloneToolWithMocks(mockDroplets, mockActions, mockImageActions)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.cloneDroplet(context.Background(), req)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.Equal(t, !tc.expectSucceeded, resp.IsError)
+			var result DropletCloneResult
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+			require.Equal(t, tc.expectSucceeded, result.Succeeded)
+			var steps []string
+			for _, step := range result.Steps {
+				steps = append(steps, step.Step)
+			}
+			require.Equal(t, tc.expectSteps, steps)
+			require.Equal(t, 501, result.Steps[0].ActionID)
+			if tc.expectSucceeded {
+				require.Equal(t, 901, result.SnapshotID)
+				require.NotZero(t, result.DropletID)
+			} else {
+				require.NotEmpty(t, result.Error)
+			}
+		})
+	}
+}
@@ -3,9 +3,10 @@ package droplet
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -23,563 +24,243 @@ func NewDropletActionsTool(client func(ctx context.Context) (*godo.Client, error
 	}
 }
 
-// rebootDroplet reboots a droplet
-func (da *DropletActionsTool) rebootDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
+// runOnID runs op against the resource whose ID is the required number argument key, once the
+// other arguments read from args are valid, and returns what op returned as structured content.
+// The droplet, image and image action handlers share it for their argument errors, client lookup
+// and API errors.
+func runOnID[T any](ctx context.Context, getClient func(ctx context.Context) (*godo.Client, error), args *common.Args, key string,
+	op func(ctx context.Context, client *godo.Client, id int) (T, *godo.Response, error)) (*mcp.CallToolResult, error) {
+	id := args.RequiredNumber(key)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
-	client, err := da.client(ctx)
+	client, err := getClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
 	}
 
-	action, _, err := client.DropletActions.Reboot(ctx, int(dropletID))
+	result, _, err := op(ctx, client, int(id))
 	if err != nil {
 		return mcp.NewToolResultErrorFromErr("api error", err), nil
 	}
 
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
+	return common.NewToolResultStructured(result)
+}
 
-	return mcp.NewToolResultText(string(jsonAction)), nil
+// runOnDroplet runs op against the droplet with the request's ID once the other arguments read
+// from args are valid, returning the resulting action.
+func (da *DropletActionsTool) runOnDroplet(ctx context.Context, args *common.Args, op dropletActionFn) (*mcp.CallToolResult, error) {
+	return runOnID(ctx, da.client, args, "ID", op)
+}
+
+// rebootDroplet reboots a droplet
+func (da *DropletActionsTool) rebootDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return da.runOnDroplet(ctx, common.NewArgs(req), func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.Reboot(ctx, dropletID)
+	})
 }
 
 // passwordResetDroplet resets the password for a droplet
 func (da *DropletActionsTool) passwordResetDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.PasswordReset(ctx, int(dropletID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	return da.runOnDroplet(ctx, common.NewArgs(req), func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.PasswordReset(ctx, dropletID)
+	})
 }
 
 // RebuildByImageSlugDroplet rebuilds a droplet using an image slug
 func (da *DropletActionsTool) rebuildByImageSlugDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-	imageSlug := req.GetArguments()["ImageSlug"].(string)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.RebuildByImageSlug(ctx, int(dropletID), imageSlug)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	args := common.NewArgs(req)
+	imageSlug := args.RequiredString("ImageSlug")
+	return da.runOnDroplet(ctx, args, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.RebuildByImageSlug(ctx, dropletID, imageSlug)
+	})
 }
 
 // powerCycleByTag power cycles droplets by tag
 func (da *DropletActionsTool) powerCycleByTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	tag := req.GetArguments()["Tag"].(string)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	actions, _, err := client.DropletActions.PowerCycleByTag(ctx, tag)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonActions, err := json.MarshalIndent(actions, "", "  ")
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("marshal error", err), nil
-	}
-
-	return mcp.NewToolResultText(string(jsonActions)), nil
+	return da.runByTag(ctx, req, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.PowerCycle(ctx, dropletID)
+	})
 }
 
 // powerOnByTag powers on droplets by tag
 func (da *DropletActionsTool) powerOnByTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	tag := req.GetArguments()["Tag"].(string)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	actions, _, err := client.DropletActions.PowerOnByTag(ctx, tag)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonActions, err := json.MarshalIndent(actions, "", "  ")
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("marshal error", err), nil
-	}
-
-	return mcp.NewToolResultText(string(jsonActions)), nil
+	return da.runByTag(ctx, req, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.PowerOn(ctx, dropletID)
+	})
 }
 
 // powerOffByTag powers off droplets by tag
 func (da *DropletActionsTool) powerOffByTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	tag := req.GetArguments()["Tag"].(string)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	actions, _, err := client.DropletActions.PowerOffByTag(ctx, tag)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonActions, err := json.MarshalIndent(actions, "", "  ")
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("marshal error", err), nil
-	}
-
-	return mcp.NewToolResultText(string(jsonActions)), nil
+	return da.runByTag(ctx, req, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.PowerOff(ctx, dropletID)
+	})
 }
 
 // shutdownByTag shuts down droplets by tag
 func (da *DropletActionsTool) shutdownByTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	tag := req.GetArguments()["Tag"].(string)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	actions, _, err := client.DropletActions.ShutdownByTag(ctx, tag)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonActions, err := json.MarshalIndent(actions, "", "  ")
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("marshal error", err), nil
-	}
-
-	return mcp.NewToolResultText(string(jsonActions)), nil
+	return da.runByTag(ctx, req, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.Shutdown(ctx, dropletID)
+	})
 }
 
 // enableBackupsByTag enables backups on droplets by tag
 func (da *DropletActionsTool) enableBackupsByTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	tag := req.GetArguments()["Tag"].(string)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	actions, _, err := client.DropletActions.EnableBackupsByTag(ctx, tag)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonActions, err := json.MarshalIndent(actions, "", "  ")
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("marshal error", err), nil
-	}
-
-	return mcp.NewToolResultText(string(jsonActions)), nil
+	return da.runByTag(ctx, req, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.EnableBackups(ctx, dropletID)
+	})
 }
 
 // disableBackupsByTag disables backups on droplets by tag
 func (da *DropletActionsTool) disableBackupsByTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	tag := req.GetArguments()["Tag"].(string)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	actions, _, err := client.DropletActions.DisableBackupsByTag(ctx, tag)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonActions, err := json.MarshalIndent(actions, "", "  ")
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("marshal error", err), nil
-	}
-
-	return mcp.NewToolResultText(string(jsonActions)), nil
+	return da.runByTag(ctx, req, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.DisableBackups(ctx, dropletID)
+	})
 }
 
 // snapshotByTag takes a snapshot of droplets by tag
 func (da *DropletActionsTool) snapshotByTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	tag := req.GetArguments()["Tag"].(string)
-	name := req.GetArguments()["Name"].(string)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	actions, _, err := client.DropletActions.SnapshotByTag(ctx, tag, name)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	name, _ := req.GetArguments()["Name"].(string)
+	if name == "" {
+		return mcp.NewToolResultError("Name is required"), nil
 	}
 
-	jsonActions, err := json.MarshalIndent(actions, "", "  ")
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("marshal error", err), nil
-	}
-
-	return mcp.NewToolResultText(string(jsonActions)), nil
+	return da.runByTag(ctx, req, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.Snapshot(ctx, dropletID, name)
+	})
 }
 
 // enableIPv6ByTag enables IPv6 on droplets by tag
 func (da *DropletActionsTool) enableIPv6ByTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	tag := req.GetArguments()["Tag"].(string)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	actions, _, err := client.DropletActions.EnableIPv6ByTag(ctx, tag)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonActions, err := json.MarshalIndent(actions, "", "  ")
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("marshal error", err), nil
-	}
-
-	return mcp.NewToolResultText(string(jsonActions)), nil
+	return da.runByTag(ctx, req, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.EnableIPv6(ctx, dropletID)
+	})
 }
 
 // enablePrivateNetworkingByTag enables private networking on droplets by tag
 func (da *DropletActionsTool) enablePrivateNetworkingByTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	tag := req.GetArguments()["Tag"].(string)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	actions, _, err := client.DropletActions.EnablePrivateNetworkingByTag(ctx, tag)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonActions, err := json.MarshalIndent(actions, "", "  ")
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("marshal error", err), nil
-	}
-
-	return mcp.NewToolResultText(string(jsonActions)), nil
+	return da.runByTag(ctx, req, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.EnablePrivateNetworking(ctx, dropletID)
+	})
 }
 
 // powerCycleDroplet power cycles a droplet
 func (da *DropletActionsTool) powerCycleDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.PowerCycle(ctx, int(dropletID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	return da.runOnDroplet(ctx, common.NewArgs(req), func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.PowerCycle(ctx, dropletID)
+	})
 }
 
 // powerOnDroplet powers on a droplet
 func (da *DropletActionsTool) powerOnDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.PowerOn(ctx, int(dropletID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	return da.runOnDroplet(ctx, common.NewArgs(req), func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.PowerOn(ctx, dropletID)
+	})
 }
 
 // powerOffDroplet powers off a droplet
 func (da *DropletActionsTool) powerOffDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.PowerOff(ctx, int(dropletID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	return da.runOnDroplet(ctx, common.NewArgs(req), func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.PowerOff(ctx, dropletID)
+	})
 }
 
 // shutdownDroplet shuts down a droplet
 func (da *DropletActionsTool) shutdownDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.Shutdown(ctx, int(dropletID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	return da.runOnDroplet(ctx, common.NewArgs(req), func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.Shutdown(ctx, dropletID)
+	})
 }
 
 // restoreDroplet restores a droplet to a backup image
 func (da *DropletActionsTool) restoreDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-	imageID := req.GetArguments()["ImageID"].(float64)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.Restore(ctx, int(dropletID), int(imageID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	args := common.NewArgs(req)
+	imageID := args.RequiredNumber("ImageID")
+	return da.runOnDroplet(ctx, args, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.Restore(ctx, dropletID, int(imageID))
+	})
 }
 
 // resizeDroplet resizes a droplet
 func (da *DropletActionsTool) resizeDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-	size := req.GetArguments()["Size"].(string)
-	resizeDisk, _ := req.GetArguments()["ResizeDisk"].(bool) // Defaults to false
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.Resize(ctx, int(dropletID), size, resizeDisk)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	args := common.NewArgs(req)
+	size := args.RequiredString("Size")
+	resizeDisk := args.Bool("ResizeDisk", false)
+	return da.runOnDroplet(ctx, args, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.Resize(ctx, dropletID, size, resizeDisk)
+	})
 }
 
 // rebuildDroplet rebuilds a droplet using a provided image
 func (da *DropletActionsTool) rebuildDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-	imageID := req.GetArguments()["ImageID"].(float64)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.RebuildByImageID(ctx, int(dropletID), int(imageID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	args := common.NewArgs(req)
+	imageID := args.RequiredNumber("ImageID")
+	return da.runOnDroplet(ctx, args, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.RebuildByImageID(ctx, dropletID, int(imageID))
+	})
 }
 
 // renameDroplet renames a droplet
 func (da *DropletActionsTool) renameDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-	name := req.GetArguments()["Name"].(string)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.Rename(ctx, int(dropletID), name)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	return da.runOnDroplet(ctx, args, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.Rename(ctx, dropletID, name)
+	})
 }
 
 // changeKernel changes a droplet's kernel
 func (da *DropletActionsTool) changeKernel(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-	kernelID := req.GetArguments()["KernelID"].(float64)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.ChangeKernel(ctx, int(dropletID), int(kernelID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	args := common.NewArgs(req)
+	kernelID := args.RequiredNumber("KernelID")
+	return da.runOnDroplet(ctx, args, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.ChangeKernel(ctx, dropletID, int(kernelID))
+	})
 }
 
 // enableIPv6 enables IPv6 on a droplet
 func (da *DropletActionsTool) enableIPv6(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.EnableIPv6(ctx, int(dropletID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	return da.runOnDroplet(ctx, common.NewArgs(req), func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.EnableIPv6(ctx, dropletID)
+	})
 }
 
-// enableBackups enables backups on a droplet
+// enableBackups enables backups on a droplet, with the backup policy of the Plan, Weekday and
+// Hour arguments when any is given
 func (da *DropletActionsTool) enableBackups(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.EnableBackups(ctx, int(dropletID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
+	args := common.NewArgs(req)
+	if backupPolicyArgs(args) != nil {
+		return da.runWithBackupPolicy(ctx, args, func(ctx context.Context, client *godo.Client, dropletID int, policy *godo.DropletBackupPolicyRequest) (*godo.Action, *godo.Response, error) {
+			return client.DropletActions.EnableBackupsWithPolicy(ctx, dropletID, policy)
+		})
+	}
+	return da.runOnDroplet(ctx, args, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.EnableBackups(ctx, dropletID)
+	})
+}
 
-	return mcp.NewToolResultText(string(jsonAction)), nil
+// changeBackupPolicy changes the backup policy of a droplet with backups enabled
+func (da *DropletActionsTool) changeBackupPolicy(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return da.runWithBackupPolicy(ctx, common.NewArgs(req), func(ctx context.Context, client *godo.Client, dropletID int, policy *godo.DropletBackupPolicyRequest) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.ChangeBackupPolicy(ctx, dropletID, policy)
+	})
 }
 
 // disableBackups disables backups on a droplet
 func (da *DropletActionsTool) disableBackups(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.DisableBackups(ctx, int(dropletID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	return da.runOnDroplet(ctx, common.NewArgs(req), func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.DisableBackups(ctx, dropletID)
+	})
 }
 
 // snapshotDroplet creates a snapshot of a droplet
 func (da *DropletActionsTool) snapshotDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
-	name := req.GetArguments()["Name"].(string)
-
-	client, err := da.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.DropletActions.Snapshot(ctx, int(dropletID), name)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	return da.runOnDroplet(ctx, args, func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error) {
+		return client.DropletActions.Snapshot(ctx, dropletID, name)
+	})
 }
 
 // Tools returns a list of tool functions
@@ -589,6 +270,7 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.rebootDroplet,
 			Tool: mcp.NewTool("reboot-droplet",
 				mcp.WithDescription("Reboot a droplet"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to reboot")),
 			),
 		},
@@ -596,6 +278,7 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.passwordResetDroplet,
 			Tool: mcp.NewTool("reset-droplet-password",
 				mcp.WithDescription("Reset password for a droplet"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
 			),
 		},
@@ -603,78 +286,52 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.rebuildByImageSlugDroplet,
 			Tool: mcp.NewTool("rebuild-droplet-by-slug",
 				mcp.WithDescription("Rebuild a droplet using an image slug"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to rebuild")),
 				mcp.WithString("ImageSlug", mcp.Required(), mcp.Description("Slug of the image to rebuild from")),
 			),
 		},
 		{
 			Handler: da.powerCycleByTag,
-			Tool: mcp.NewTool("power-cycle-droplets-tag",
-				mcp.WithDescription("Power cycle droplets by tag"),
-				mcp.WithString("Tag", mcp.Required(), mcp.Description("Tag of the droplets to power cycle")),
-			),
+			Tool:    byTagTool("power-cycle-droplets-tag", "Power cycle droplets by tag", "Tag of the droplets to power cycle"),
 		},
 		{
 			Handler: da.powerOnByTag,
-			Tool: mcp.NewTool("power-on-droplets-tag",
-				mcp.WithDescription("Power on droplets by tag"),
-				mcp.WithString("Tag", mcp.Required(), mcp.Description("Tag of the droplets to power on")),
-			),
+			Tool:    byTagTool("power-on-droplets-tag", "Power on droplets by tag", "Tag of the droplets to power on"),
 		},
 		{
 			Handler: da.powerOffByTag,
-			Tool: mcp.NewTool("power-off-droplets-tag",
-				mcp.WithDescription("Power off droplets by tag"),
-				mcp.WithString("Tag", mcp.Required(), mcp.Description("Tag of the droplets to power off")),
-			),
+			Tool:    byTagTool("power-off-droplets-tag", "Power off droplets by tag", "Tag of the droplets to power off"),
 		},
 		{
 			Handler: da.shutdownByTag,
-			Tool: mcp.NewTool("shutdown-droplets-tag",
-				mcp.WithDescription("Shutdown droplets by tag"),
-				mcp.WithString("Tag", mcp.Required(), mcp.Description("Tag of the droplets to shutdown")),
-			),
+			Tool:    byTagTool("shutdown-droplets-tag", "Shutdown droplets by tag", "Tag of the droplets to shutdown"),
 		},
 		{
 			Handler: da.enableBackupsByTag,
-			Tool: mcp.NewTool("enable-backups-droplets-tag",
-				mcp.WithDescription("Enable backups on droplets by tag"),
-				mcp.WithString("Tag", mcp.Required(), mcp.Description("Tag of the droplets")),
-			),
+			Tool:    byTagTool("enable-backups-droplets-tag", "Enable backups on droplets by tag", "Tag of the droplets"),
 		},
 		{
 			Handler: da.disableBackupsByTag,
-			Tool: mcp.NewTool("disable-backups-droplets-tag",
-				mcp.WithDescription("Disable backups on droplets by tag"),
-				mcp.WithString("Tag", mcp.Required(), mcp.Description("Tag of the droplets")),
-			),
+			Tool:    byTagTool("disable-backups-droplets-tag", "Disable backups on droplets by tag", "Tag of the droplets"),
 		},
 		{
 			Handler: da.snapshotByTag,
-			Tool: mcp.NewTool("snapshot-droplets-tag",
-				mcp.WithDescription("Take a snapshot of droplets by tag"),
-				mcp.WithString("Tag", mcp.Required(), mcp.Description("Tag of the droplets")),
-				mcp.WithString("Name", mcp.Required(), mcp.Description("Name for the snapshot")),
-			),
+			Tool:    byTagTool("snapshot-droplets-tag", "Take a snapshot of droplets by tag", "Tag of the droplets", mcp.WithString("Name", mcp.Required(), mcp.Description("Name for the snapshot"))),
 		},
 		{
 			Handler: da.enableIPv6ByTag,
-			Tool: mcp.NewTool("enable-ipv6-droplets-tag",
-				mcp.WithDescription("Enable IPv6 on droplets by tag"),
-				mcp.WithString("Tag", mcp.Required(), mcp.Description("Tag of the droplets")),
-			),
+			Tool:    byTagTool("enable-ipv6-droplets-tag", "Enable IPv6 on droplets by tag", "Tag of the droplets"),
 		},
 		{
 			Handler: da.enablePrivateNetworkingByTag,
-			Tool: mcp.NewTool("enable-private-net-droplets-tag",
-				mcp.WithDescription("Enable private networking on droplets by tag"),
-				mcp.WithString("Tag", mcp.Required(), mcp.Description("Tag of the droplets")),
-			),
+			Tool:    byTagTool("enable-private-net-droplets-tag", "Enable private networking on droplets by tag", "Tag of the droplets"),
 		},
 		{
 			Handler: da.powerCycleDroplet,
 			Tool: mcp.NewTool("power-cycle-droplet",
 				mcp.WithDescription("Power cycle a droplet"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to power cycle")),
 			),
 		},
@@ -682,6 +339,7 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.powerOnDroplet,
 			Tool: mcp.NewTool("power-on-droplet",
 				mcp.WithDescription("Power on a droplet"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to power on")),
 			),
 		},
@@ -689,6 +347,7 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.powerOffDroplet,
 			Tool: mcp.NewTool("power-off-droplet",
 				mcp.WithDescription("Power off a droplet"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to power off")),
 			),
 		},
@@ -696,6 +355,7 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.shutdownDroplet,
 			Tool: mcp.NewTool("shutdown-droplet",
 				mcp.WithDescription("Shutdown a droplet"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to shutdown")),
 			),
 		},
@@ -703,6 +363,7 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.restoreDroplet,
 			Tool: mcp.NewTool("restore-droplet",
 				mcp.WithDescription("Restore a droplet from a backup/snapshot"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to restore")),
 				mcp.WithNumber("ImageID", mcp.Required(), mcp.Description("ID of the backup/snapshot image")),
 			),
@@ -711,6 +372,7 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.resizeDroplet,
 			Tool: mcp.NewTool("resize-droplet",
 				mcp.WithDescription("Resize a droplet"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to resize")),
 				mcp.WithString("Size", mcp.Required(), mcp.Description("Slug of the new size (e.g., s-1vcpu-1gb)")),
 				mcp.WithBoolean("ResizeDisk", mcp.DefaultBool(false), mcp.Description("Whether to resize the disk")),
@@ -720,6 +382,7 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.rebuildDroplet,
 			Tool: mcp.NewTool("rebuild-droplet",
 				mcp.WithDescription("Rebuild a droplet from an image"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to rebuild")),
 				mcp.WithNumber("ImageID", mcp.Required(), mcp.Description("ID of the image to rebuild from")),
 			),
@@ -728,6 +391,7 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.renameDroplet,
 			Tool: mcp.NewTool("rename-droplet",
 				mcp.WithDescription("Rename a droplet"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to rename")),
 				mcp.WithString("Name", mcp.Required(), mcp.Description("New name for the droplet")),
 			),
@@ -736,6 +400,7 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.changeKernel,
 			Tool: mcp.NewTool("change-kernel-droplet",
 				mcp.WithDescription("Change a droplet's kernel"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
 				mcp.WithNumber("KernelID", mcp.Required(), mcp.Description("ID of the kernel to switch to")),
 			),
@@ -744,20 +409,41 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.enableIPv6,
 			Tool: mcp.NewTool("enable-ipv6-droplet",
 				mcp.WithDescription("Enable IPv6 on a droplet"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
 			),
 		},
 		{
 			Handler: da.enableBackups,
-			Tool: mcp.NewTool("enable-backups-droplet",
-				mcp.WithDescription("Enable backups on a droplet"),
+			Tool: mcp.NewTool("enable-backups-droplet", append([]mcp.ToolOption{
+				mcp.WithDescription("Enable backups on a droplet. Without Plan, Weekday and Hour the default policy is used; otherwise they are checked against the supported backup policies first."),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
+			}, withBackupPolicyArgs(false)...)...),
+		},
+		{
+			Handler: da.recommendBackupPolicy,
+			Tool: mcp.NewTool("backup-policy-recommend",
+				mcp.WithDescription("List the valid backup plans, weekdays and hours for a droplet, with the estimated monthly cost of each plan and the droplet's current policy, "+
+					"and recommend a policy whose window falls in the night of the droplet's region. Use it to pick the Plan, Weekday and Hour of enable-backups-droplet or change-backup-policy-droplet."),
+				common.WithOutputSchema[BackupPolicyRecommendation](),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
+				mcp.WithString("Plan", mcp.Description("Backup plan to recommend a policy of, e.g. daily; defaults to weekly")),
 			),
 		},
+		{
+			Handler: da.changeBackupPolicy,
+			Tool: mcp.NewTool("change-backup-policy-droplet", append([]mcp.ToolOption{
+				mcp.WithDescription("Change the backup plan, day and hour of a droplet with backups enabled. The policy is checked against the supported backup policies first."),
+				common.WithOutputSchema[godo.Action](),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
+			}, withBackupPolicyArgs(true)...)...),
+		},
 		{
 			Handler: da.disableBackups,
 			Tool: mcp.NewTool("disable-backups-droplet",
 				mcp.WithDescription("Disable backups on a droplet"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
 			),
 		},
@@ -765,6 +451,7 @@ func (da *DropletActionsTool) Tools() []server.ServerTool {
 			Handler: da.snapshotDroplet,
 			Tool: mcp.NewTool("snapshot-droplet",
 				mcp.WithDescription("Take a snapshot of a droplet"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
 				mcp.WithString("Name", mcp.Required(), mcp.Description("Name for the snapshot")),
 			),
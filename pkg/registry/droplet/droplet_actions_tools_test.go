@@ -70,528 +70,6 @@ func TestDropletActionsTool_rebootDroplet(t *testing.T) {
 	}
 }
 
-func TestDropletActionsTool_powerCycleByTag(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	testActions := []godo.Action{{ID: 3001, Status: "in-progress"}}
-	tests := []struct {
-		name        string
-		args        map[string]any
-		mockSetup   func(*MockDropletActionsService)
-		expectError bool
-	}{
-		{
-			name: "Successful power cycle by tag",
-			args: map[string]any{"Tag": "tag1"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					PowerCycleByTag(gomock.Any(), "tag1").
-					Return(testActions, nil, nil).
-					Times(1)
-			},
-		},
-		{
-			name: "API error",
-			args: map[string]any{"Tag": "fail-tag"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					PowerCycleByTag(gomock.Any(), "fail-tag").
-					Return(nil, nil, errors.New("api error")).
-					Times(1)
-			},
-			expectError: true,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			mockActions := NewMockDropletActionsService(ctrl)
-			if tc.mockSetup != nil {
-				tc.mockSetup(mockActions)
-			}
-			tool := setupDropletActionsToolWithMocks(mockActions)
-			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
-			resp, err := tool.powerCycleByTag(context.Background(), req)
-			if tc.expectError {
-				require.NotNil(t, resp)
-				require.True(t, resp.IsError)
-				return
-			}
-			require.NoError(t, err)
-			require.NotNil(t, resp)
-			require.False(t, resp.IsError)
-			var outActions []godo.Action
-			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outActions))
-			require.Equal(t, testActions[0].ID, outActions[0].ID)
-		})
-	}
-}
-
-func TestDropletActionsTool_powerOnByTag(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	testActions := []godo.Action{{ID: 3002, Status: "in-progress"}}
-	tests := []struct {
-		name        string
-		args        map[string]any
-		mockSetup   func(*MockDropletActionsService)
-		expectError bool
-	}{
-		{
-			name: "Successful power on by tag",
-			args: map[string]any{"Tag": "tag2"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					PowerOnByTag(gomock.Any(), "tag2").
-					Return(testActions, nil, nil).
-					Times(1)
-			},
-		},
-		{
-			name: "API error",
-			args: map[string]any{"Tag": "fail-tag"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					PowerOnByTag(gomock.Any(), "fail-tag").
-					Return(nil, nil, errors.New("api error")).
-					Times(1)
-			},
-			expectError: true,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			mockActions := NewMockDropletActionsService(ctrl)
-			if tc.mockSetup != nil {
-				tc.mockSetup(mockActions)
-			}
-			tool := setupDropletActionsToolWithMocks(mockActions)
-			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
-			resp, err := tool.powerOnByTag(context.Background(), req)
-			if tc.expectError {
-				require.NotNil(t, resp)
-				require.True(t, resp.IsError)
-				return
-			}
-			require.NoError(t, err)
-			require.NotNil(t, resp)
-			require.False(t, resp.IsError)
-			var outActions []godo.Action
-			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outActions))
-			require.Equal(t, testActions[0].ID, outActions[0].ID)
-		})
-	}
-}
-
-func TestDropletActionsTool_powerOffByTag(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	testActions := []godo.Action{{ID: 3003, Status: "in-progress"}}
-	tests := []struct {
-		name        string
-		args        map[string]any
-		mockSetup   func(*MockDropletActionsService)
-		expectError bool
-	}{
-		{
-			name: "Successful power off by tag",
-			args: map[string]any{"Tag": "tag3"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					PowerOffByTag(gomock.Any(), "tag3").
-					Return(testActions, nil, nil).
-					Times(1)
-			},
-		},
-		{
-			name: "API error",
-			args: map[string]any{"Tag": "fail-tag"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					PowerOffByTag(gomock.Any(), "fail-tag").
-					Return(nil, nil, errors.New("api error")).
-					Times(1)
-			},
-			expectError: true,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			mockActions := NewMockDropletActionsService(ctrl)
-			if tc.mockSetup != nil {
-				tc.mockSetup(mockActions)
-			}
-			tool := setupDropletActionsToolWithMocks(mockActions)
-			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
-			resp, err := tool.powerOffByTag(context.Background(), req)
-			if tc.expectError {
-				require.NotNil(t, resp)
-				require.True(t, resp.IsError)
-				return
-			}
-			require.NoError(t, err)
-			require.NotNil(t, resp)
-			require.False(t, resp.IsError)
-			var outActions []godo.Action
-			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outActions))
-			require.Equal(t, testActions[0].ID, outActions[0].ID)
-		})
-	}
-}
-
-func TestDropletActionsTool_shutdownByTag(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	testActions := []godo.Action{{ID: 3004, Status: "in-progress"}}
-	tests := []struct {
-		name        string
-		args        map[string]any
-		mockSetup   func(*MockDropletActionsService)
-		expectError bool
-	}{
-		{
-			name: "Successful shutdown by tag",
-			args: map[string]any{"Tag": "tag4"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					ShutdownByTag(gomock.Any(), "tag4").
-					Return(testActions, nil, nil).
-					Times(1)
-			},
-		},
-		{
-			name: "API error",
-			args: map[string]any{"Tag": "fail-tag"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					ShutdownByTag(gomock.Any(), "fail-tag").
-					Return(nil, nil, errors.New("api error")).
-					Times(1)
-			},
-			expectError: true,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			mockActions := NewMockDropletActionsService(ctrl)
-			if tc.mockSetup != nil {
-				tc.mockSetup(mockActions)
-			}
-			tool := setupDropletActionsToolWithMocks(mockActions)
-			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
-			resp, err := tool.shutdownByTag(context.Background(), req)
-			if tc.expectError {
-				require.NotNil(t, resp)
-				require.True(t, resp.IsError)
-				return
-			}
-			require.NoError(t, err)
-			require.NotNil(t, resp)
-			require.False(t, resp.IsError)
-			var outActions []godo.Action
-			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outActions))
-			require.Equal(t, testActions[0].ID, outActions[0].ID)
-		})
-	}
-}
-
-func TestDropletActionsTool_enableBackupsByTag(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	testActions := []godo.Action{{ID: 3005, Status: "in-progress"}}
-	tests := []struct {
-		name        string
-		args        map[string]any
-		mockSetup   func(*MockDropletActionsService)
-		expectError bool
-	}{
-		{
-			name: "Successful enable backups by tag",
-			args: map[string]any{"Tag": "tag5"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					EnableBackupsByTag(gomock.Any(), "tag5").
-					Return(testActions, nil, nil).
-					Times(1)
-			},
-		},
-		{
-			name: "API error",
-			args: map[string]any{"Tag": "fail-tag"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					EnableBackupsByTag(gomock.Any(), "fail-tag").
-					Return(nil, nil, errors.New("api error")).
-					Times(1)
-			},
-			expectError: true,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			mockActions := NewMockDropletActionsService(ctrl)
-			if tc.mockSetup != nil {
-				tc.mockSetup(mockActions)
-			}
-			tool := setupDropletActionsToolWithMocks(mockActions)
-			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
-			resp, err := tool.enableBackupsByTag(context.Background(), req)
-			if tc.expectError {
-				require.NotNil(t, resp)
-				require.True(t, resp.IsError)
-				return
-			}
-			require.NoError(t, err)
-			require.NotNil(t, resp)
-			require.False(t, resp.IsError)
-			var outActions []godo.Action
-			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outActions))
-			require.Equal(t, testActions[0].ID, outActions[0].ID)
-		})
-	}
-}
-
-func TestDropletActionsTool_disableBackupsByTag(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	testActions := []godo.Action{{ID: 3006, Status: "in-progress"}}
-	tests := []struct {
-		name        string
-		args        map[string]any
-		mockSetup   func(*MockDropletActionsService)
-		expectError bool
-	}{
-		{
-			name: "Successful disable backups by tag",
-			args: map[string]any{"Tag": "tag6"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					DisableBackupsByTag(gomock.Any(), "tag6").
-					Return(testActions, nil, nil).
-					Times(1)
-			},
-		},
-		{
-			name: "API error",
-			args: map[string]any{"Tag": "fail-tag"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					DisableBackupsByTag(gomock.Any(), "fail-tag").
-					Return(nil, nil, errors.New("api error")).
-					Times(1)
-			},
-			expectError: true,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			mockActions := NewMockDropletActionsService(ctrl)
-			if tc.mockSetup != nil {
-				tc.mockSetup(mockActions)
-			}
-			tool := setupDropletActionsToolWithMocks(mockActions)
-			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
-			resp, err := tool.disableBackupsByTag(context.Background(), req)
-			if tc.expectError {
-				require.NotNil(t, resp)
-				require.True(t, resp.IsError)
-				return
-			}
-			require.NoError(t, err)
-			require.NotNil(t, resp)
-			require.False(t, resp.IsError)
-			var outActions []godo.Action
-			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outActions))
-			require.Equal(t, testActions[0].ID, outActions[0].ID)
-		})
-	}
-}
-
-func TestDropletActionsTool_snapshotByTag(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	testActions := []godo.Action{{ID: 3007, Status: "in-progress"}}
-	tests := []struct {
-		name        string
-		args        map[string]any
-		mockSetup   func(*MockDropletActionsService)
-		expectError bool
-	}{
-		{
-			name: "Successful snapshot by tag",
-			args: map[string]any{"Tag": "tag7", "Name": "snap-by-tag"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					SnapshotByTag(gomock.Any(), "tag7", "snap-by-tag").
-					Return(testActions, nil, nil).
-					Times(1)
-			},
-		},
-		{
-			name: "API error",
-			args: map[string]any{"Tag": "fail-tag", "Name": "fail"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					SnapshotByTag(gomock.Any(), "fail-tag", "fail").
-					Return(nil, nil, errors.New("api error")).
-					Times(1)
-			},
-			expectError: true,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			mockActions := NewMockDropletActionsService(ctrl)
-			if tc.mockSetup != nil {
-				tc.mockSetup(mockActions)
-			}
-			tool := setupDropletActionsToolWithMocks(mockActions)
-			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
-			resp, err := tool.snapshotByTag(context.Background(), req)
-			if tc.expectError {
-				require.NotNil(t, resp)
-				require.True(t, resp.IsError)
-				return
-			}
-			require.NoError(t, err)
-			require.NotNil(t, resp)
-			require.False(t, resp.IsError)
-			var outActions []godo.Action
-			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outActions))
-			require.Equal(t, testActions[0].ID, outActions[0].ID)
-		})
-	}
-}
-
-func TestDropletActionsTool_enableIPv6ByTag(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	testActions := []godo.Action{{ID: 3008, Status: "in-progress"}}
-	tests := []struct {
-		name        string
-		args        map[string]any
-		mockSetup   func(*MockDropletActionsService)
-		expectError bool
-	}{
-		{
-			name: "Successful enable IPv6 by tag",
-			args: map[string]any{"Tag": "tag8"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					EnableIPv6ByTag(gomock.Any(), "tag8").
-					Return(testActions, nil, nil).
-					Times(1)
-			},
-		},
-		{
-			name: "API error",
-			args: map[string]any{"Tag": "fail-tag"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					EnableIPv6ByTag(gomock.Any(), "fail-tag").
-					Return(nil, nil, errors.New("api error")).
-					Times(1)
-			},
-			expectError: true,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			mockActions := NewMockDropletActionsService(ctrl)
-			if tc.mockSetup != nil {
-				tc.mockSetup(mockActions)
-			}
-			tool := setupDropletActionsToolWithMocks(mockActions)
-			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
-			resp, err := tool.enableIPv6ByTag(context.Background(), req)
-			if tc.expectError {
-				require.NotNil(t, resp)
-				require.True(t, resp.IsError)
-				return
-			}
-			require.NoError(t, err)
-			require.NotNil(t, resp)
-			require.False(t, resp.IsError)
-			var outActions []godo.Action
-			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outActions))
-			require.Equal(t, testActions[0].ID, outActions[0].ID)
-		})
-	}
-}
-
-func TestDropletActionsTool_enablePrivateNetworkingByTag(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	testActions := []godo.Action{{ID: 3009, Status: "in-progress"}}
-	tests := []struct {
-		name        string
-		args        map[string]any
-		mockSetup   func(*MockDropletActionsService)
-		expectError bool
-	}{
-		{
-			name: "Successful enable private networking by tag",
-			args: map[string]any{"Tag": "tag9"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					EnablePrivateNetworkingByTag(gomock.Any(), "tag9").
-					Return(testActions, nil, nil).
-					Times(1)
-			},
-		},
-		{
-			name: "API error",
-			args: map[string]any{"Tag": "fail-tag"},
-			mockSetup: func(m *MockDropletActionsService) {
-				m.EXPECT().
-					EnablePrivateNetworkingByTag(gomock.Any(), "fail-tag").
-					Return(nil, nil, errors.New("api error")).
-					Times(1)
-			},
-			expectError: true,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			mockActions := NewMockDropletActionsService(ctrl)
-			if tc.mockSetup != nil {
-				tc.mockSetup(mockActions)
-			}
-			tool := setupDropletActionsToolWithMocks(mockActions)
-			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
-			resp, err := tool.enablePrivateNetworkingByTag(context.Background(), req)
-			if tc.expectError {
-				require.NotNil(t, resp)
-				require.True(t, resp.IsError)
-				return
-			}
-			require.NoError(t, err)
-			require.NotNil(t, resp)
-			require.False(t, resp.IsError)
-			var outActions []godo.Action
-			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outActions))
-			require.Equal(t, testActions[0].ID, outActions[0].ID)
-		})
-	}
-}
-
 func TestDropletActionsTool_powerCycleDroplet(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -3,7 +3,14 @@ package droplet
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
 
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -22,56 +29,122 @@ func NewDropletTool(client func(ctx context.Context) (*godo.Client, error)) *Dro
 	}
 }
 
-// CreateDroplet creates a new droplet
-func (d *DropletTool) createDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.GetArguments()
-	dropletName := args["Name"].(string)
-	size := args["Size"].(string)
-	region := args["Region"].(string)
-	backup, _ := args["Backup"].(bool)         // Defaults to false
-	monitoring, _ := args["Monitoring"].(bool) // Defaults to false
+const (
+	// expectedActiveSeconds is how long a new droplet typically takes to become active, and
+	// expectedGPUActiveSeconds how long a GPU droplet does.
+	expectedActiveSeconds    = 60
+	expectedGPUActiveSeconds = 300
+)
+
+// DropletNextSteps tells the caller of droplet-create what happens next and how to wait for it.
+type DropletNextSteps struct {
+	// ActionID is the ID of the create action, which droplet-action reports on.
+	ActionID              int    `json:"action_id,omitempty"`
+	ExpectedActiveSeconds int    `json:"expected_active_seconds,omitempty"`
+	Wait                  string `json:"wait"`
+}
+
+// DropletCreateResult is the droplet droplet-create created, or found with IfNotExists, and what
+// to do next.
+type DropletCreateResult struct {
+	godo.Droplet
+	NextSteps DropletNextSteps `json:"next_steps"`
+}
+
+// dropletCreateResult returns the result of creating droplet. The create action is taken from
+// the links of resp; a droplet that already exists has none.
+func dropletCreateResult(droplet *godo.Droplet, resp *godo.Response) DropletCreateResult {
+	result := DropletCreateResult{Droplet: *droplet}
+	if resp != nil && resp.Links != nil {
+		for _, action := range resp.Links.Actions {
+			if action.Rel == "create" {
+				result.NextSteps.ActionID = action.ID
+			}
+		}
+	}
+	if dropletReached(droplet, dropletStatusActive) {
+		result.NextSteps.Wait = fmt.Sprintf("Droplet %d is already active; no need to wait.", droplet.ID)
+		return result
+	}
+	result.NextSteps.ExpectedActiveSeconds = expectedActiveSeconds
+	if strings.HasPrefix(droplet.SizeSlug, "gpu-") || droplet.Size != nil && droplet.Size.GPUInfo != nil {
+		result.NextSteps.ExpectedActiveSeconds = expectedGPUActiveSeconds
+	}
+	status := droplet.Status
+	if status == "" {
+		status = "new"
+	}
+	result.NextSteps.Wait = fmt.Sprintf("Droplet %d is %s and typically becomes active within %d seconds. Call droplet-wait with ID %d once to wait until it is active and has its IP addresses, instead of polling droplet-get.",
+		droplet.ID, status, result.NextSteps.ExpectedActiveSeconds, droplet.ID)
+	return result
+}
 
-	imageID, hasID := args["ImageID"].(float64)
-	imageSlug, hasSlug := args["ImageSlug"].(string)
-	hasSlug = hasSlug && imageSlug != ""
+// dropletSSHKeys returns the SSH keys of a droplet create request from a list of key IDs and fingerprints.
+func dropletSSHKeys(list []any) ([]godo.DropletCreateSSHKey, error) {
+	var sshKeys []godo.DropletCreateSSHKey
+	for _, key := range list {
+		switch v := key.(type) {
+		case float64:
+			sshKeys = append(sshKeys, godo.DropletCreateSSHKey{ID: int(v)})
+		case string:
+			sshKeys = append(sshKeys, godo.DropletCreateSSHKey{Fingerprint: v})
+		default:
+			return nil, errors.New("invalid arguments: SSHKeys must contain key IDs or fingerprints")
+		}
+	}
+	return sshKeys, nil
+}
 
+// dropletImage returns the image of a droplet create request from the ImageID or ImageSlug
+// argument, exactly one of which must be set.
+func dropletImage(args *common.Args) (godo.DropletCreateImage, error) {
+	imageID := args.Number("ImageID", 0)
+	imageSlug := args.String("ImageSlug")
+	hasID := args.Has("ImageID")
+	hasSlug := imageSlug != ""
 	if !hasID && !hasSlug {
-		return mcp.NewToolResultError("exactly one of ImageID or ImageSlug must be provided"), nil
+		return godo.DropletCreateImage{}, errors.New("exactly one of ImageID or ImageSlug must be provided")
 	}
 	if hasID && hasSlug {
-		return mcp.NewToolResultError("exactly one of ImageID or ImageSlug must be provided, not both"), nil
+		return godo.DropletCreateImage{}, errors.New("exactly one of ImageID or ImageSlug must be provided, not both")
 	}
-
-	var image godo.DropletCreateImage
 	if hasSlug {
-		image = godo.DropletCreateImage{Slug: imageSlug}
-	} else {
-		image = godo.DropletCreateImage{ID: int(imageID)}
+		return godo.DropletCreateImage{Slug: imageSlug}, nil
 	}
+	return godo.DropletCreateImage{ID: int(imageID)}, nil
+}
 
-	// Handle SSH keys if provided
-	var sshKeys []godo.DropletCreateSSHKey
-	if sshKeysRaw, ok := args["SSHKeys"]; ok && sshKeysRaw != nil {
-		sshKeysList := sshKeysRaw.([]interface{})
-		for _, key := range sshKeysList {
-			switch v := key.(type) {
-			case float64:
-				sshKeys = append(sshKeys, godo.DropletCreateSSHKey{ID: int(v)})
-			case string:
-				sshKeys = append(sshKeys, godo.DropletCreateSSHKey{Fingerprint: v})
-			}
-		}
+// CreateDroplet creates a new droplet
+func (d *DropletTool) createDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	dropletName := args.RequiredString("Name")
+	size := args.RequiredString("Size")
+	region := args.RequiredString("Region")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	backup := args.Bool("Backup", false)
+	monitoring := args.Bool("Monitoring", false)
+	image, imageErr := dropletImage(args)
+	userData, userDataErr := dropletUserData(args)
+	sshKeysList := args.List("SSHKeys")
+	tags := args.Strings("Tags")
+	ifNotExists := args.Bool(common.IfNotExistsArg, false)
+	project := args.String(common.ProjectArg)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	// Handle tags if provided
-	var tags []string
-	if tagsRaw, ok := args["Tags"]; ok && tagsRaw != nil {
-		tagsList := tagsRaw.([]interface{})
-		for _, tag := range tagsList {
-			if tagStr, ok := tag.(string); ok {
-				tags = append(tags, tagStr)
-			}
-		}
+	if imageErr != nil {
+		return mcp.NewToolResultError(imageErr.Error()), nil
+	}
+	if userDataErr != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: %s", userDataErr)), nil
+	}
+
+	sshKeys, err := dropletSSHKeys(sshKeysList)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	// Create the droplet
@@ -84,6 +157,7 @@ func (d *DropletTool) createDroplet(ctx context.Context, req mcp.CallToolRequest
 		Monitoring: monitoring,
 		SSHKeys:    sshKeys,
 		Tags:       tags,
+		UserData:   userData,
 	}
 
 	client, err := d.client(ctx)
@@ -91,20 +165,68 @@ func (d *DropletTool) createDroplet(ctx context.Context, req mcp.CallToolRequest
 		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
 	}
 
-	droplet, _, err := client.Droplets.Create(ctx, dropletCreateRequest)
+	if ifNotExists {
+		existing, err := common.ListAll(ctx, dropletsPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+			return client.Droplets.ListByName(ctx, dropletName, opt)
+		})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		switch len(existing) {
+		case 0:
+		case 1:
+			res, err := common.NewToolResultStructured(dropletCreateResult(&existing[0], nil))
+			if err != nil {
+				return nil, err
+			}
+			return common.ExistingResult(res, fmt.Sprintf("Droplet %d named %q already exists; no droplet was created.",
+				existing[0].ID, dropletName)), nil
+		default:
+			ids := make([]string, len(existing))
+			for i, droplet := range existing {
+				ids[i] = strconv.Itoa(droplet.ID)
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("%d droplets are named %q (IDs %s); use droplet-get with one of them instead of IfNotExists",
+				len(existing), dropletName, strings.Join(ids, ", "))), nil
+		}
+	}
+
+	if err := common.PreflightDroplets(ctx, client, size, region, 1); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if err := common.PreflightImage(ctx, client, image, size, region); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var projectID string
+	if project != "" {
+		if projectID, err = common.ResolveProject(ctx, client, project); err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+	}
+
+	droplet, resp, err := client.Droplets.Create(ctx, dropletCreateRequest)
 	if err != nil {
 		return mcp.NewToolResultErrorFromErr("droplet create", err), nil
 	}
-	jsonDroplet, err := json.MarshalIndent(droplet, "", "  ")
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("json marshal", err), nil
+	if projectID != "" {
+		if err := common.AssignCreated(ctx, client, projectID, droplet.URN(), func(ctx context.Context) error {
+			_, err := client.Droplets.Delete(ctx, droplet.ID)
+			return err
+		}); err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
 	}
-	return mcp.NewToolResultText(string(jsonDroplet)), nil
+	return common.NewToolResultStructured(dropletCreateResult(droplet, resp))
 }
 
 // deleteDroplet deletes a droplet
 func (d *DropletTool) deleteDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
+	args := common.NewArgs(req)
+	dropletID := args.RequiredNumber("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := d.client(ctx)
 	if err != nil {
@@ -120,7 +242,11 @@ func (d *DropletTool) deleteDroplet(ctx context.Context, req mcp.CallToolRequest
 
 // getDropletNeighbors gets a droplet's neighbors
 func (d *DropletTool) getDropletNeighbors(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
+	args := common.NewArgs(req)
+	dropletID := args.RequiredNumber("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := d.client(ctx)
 	if err != nil {
@@ -142,7 +268,11 @@ func (d *DropletTool) getDropletNeighbors(ctx context.Context, req mcp.CallToolR
 
 // enablePrivateNetworking enables private networking on a droplet
 func (d *DropletTool) enablePrivateNetworking(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
+	args := common.NewArgs(req)
+	dropletID := args.RequiredNumber("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := d.client(ctx)
 	if err != nil {
@@ -154,17 +284,16 @@ func (d *DropletTool) enablePrivateNetworking(ctx context.Context, req mcp.CallT
 		return mcp.NewToolResultErrorFromErr("api error", err), nil
 	}
 
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	return common.NewToolResultStructured(action)
 }
 
 // getDropletKernels gets available kernels for a droplet
 func (d *DropletTool) getDropletKernels(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	dropletID := req.GetArguments()["ID"].(float64)
+	args := common.NewArgs(req)
+	dropletID := args.RequiredNumber("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	// Use list options to get all kernels
 	opt := &godo.ListOptions{
@@ -197,6 +326,15 @@ func (d *DropletTool) getDropletByID(ctx context.Context, req mcp.CallToolReques
 		return mcp.NewToolResultError("Droplet ID is required"), nil
 	}
 
+	args := common.NewArgs(req)
+	expand := args.Strings("Expand")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if err := expandArg(expand); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	client, err := d.client(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
@@ -206,11 +344,11 @@ func (d *DropletTool) getDropletByID(ctx context.Context, req mcp.CallToolReques
 	if err != nil {
 		return mcp.NewToolResultErrorFromErr("api error", err), nil
 	}
-	jsonData, err := json.MarshalIndent(droplet, "", "  ")
+	expanded, err := expandDroplet(ctx, client, droplet, expand)
 	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
 	}
-	return mcp.NewToolResultText(string(jsonData)), nil
+	return common.NewToolResultStructured(expanded)
 }
 
 // getDropletBackupPolicy returns the backup policy for a droplet.
@@ -230,11 +368,7 @@ func (d *DropletTool) getDropletBackupPolicy(ctx context.Context, req mcp.CallTo
 		return mcp.NewToolResultErrorFromErr("api error", err), nil
 	}
 
-	jsonData, err := json.MarshalIndent(policy, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-	return mcp.NewToolResultText(string(jsonData)), nil
+	return common.NewToolResultStructured(policy)
 }
 
 func (d *DropletTool) getDropletActionByID(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -256,13 +390,102 @@ func (d *DropletTool) getDropletActionByID(ctx context.Context, req mcp.CallTool
 	if err != nil {
 		return mcp.NewToolResultErrorFromErr("api error", err), nil
 	}
-	jsonData, err := json.MarshalIndent(action, "", "  ")
+	return common.NewToolResultStructured(action)
+}
+
+// matchesPublicIPv4 reports whether one of the public IPv4 addresses of droplet is ip.
+func matchesPublicIPv4(droplet godo.Droplet, ip string) bool {
+	if droplet.Networks == nil {
+		return false
+	}
+	for _, network := range droplet.Networks.V4 {
+		if network.Type == "public" && network.IPAddress == ip {
+			return true
+		}
+	}
+	return false
+}
+
+// findDroplet resolves a droplet from its exact name, a prefix of its name or one of its public
+// IPv4 addresses. It fails unless exactly one droplet matches, naming the candidates when several do.
+func (d *DropletTool) findDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	name := strings.TrimSpace(args.String("Name"))
+	prefix := args.Bool("Prefix", false)
+	ip := strings.TrimSpace(args.String("PublicIPv4"))
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if (name == "") == (ip == "") {
+		return mcp.NewToolResultError("exactly one of Name or PublicIPv4 must be provided"), nil
+	}
+	if ip != "" {
+		if parsed := net.ParseIP(ip); parsed == nil || parsed.To4() == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("PublicIPv4 %q is not an IPv4 address", ip)), nil
+		}
+	}
+
+	client, err := d.client(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	var droplets []godo.Droplet
+	var criterion string
+	switch {
+	case ip != "":
+		criterion = fmt.Sprintf("with public IPv4 %s", ip)
+		droplets, err = common.ListAll(ctx, dropletsPageSize, client.Droplets.List)
+	case prefix:
+		criterion = fmt.Sprintf("whose name starts with %q", name)
+		droplets, err = common.ListAll(ctx, dropletsPageSize, client.Droplets.List)
+	default:
+		// the API filters by exact name.
+		criterion = fmt.Sprintf("named %q", name)
+		droplets, err = common.ListAll(ctx, dropletsPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+			return client.Droplets.ListByName(ctx, name, opt)
+		})
+	}
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	var matches []godo.Droplet
+	for _, droplet := range droplets {
+		switch {
+		case ip != "":
+			if matchesPublicIPv4(droplet, ip) {
+				matches = append(matches, droplet)
+			}
+		case prefix:
+			if strings.HasPrefix(droplet.Name, name) {
+				matches = append(matches, droplet)
+			}
+		default:
+			if droplet.Name == name {
+				matches = append(matches, droplet)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return mcp.NewToolResultError(fmt.Sprintf("no droplet %s", criterion)), nil
+	case 1:
+		return common.NewToolResultStructured(&matches[0])
+	default:
+		candidates := make([]string, len(matches))
+		for i, droplet := range matches {
+			candidates[i] = fmt.Sprintf("%s (ID %d)", droplet.Name, droplet.ID)
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("%d droplets %s: %s; use a more specific name or the ID",
+			len(matches), criterion, strings.Join(candidates, ", "))), nil
 	}
-	return mcp.NewToolResultText(string(jsonData)), nil
 }
 
+// dropletFeatureMonitoring is the feature of droplets that run the monitoring agent.
+const dropletFeatureMonitoring = "monitoring"
+
 // getDroplets lists all droplets for a user
 func (d *DropletTool) getDroplets(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	page, ok := req.GetArguments()["Page"].(float64)
@@ -305,6 +528,7 @@ func (d *DropletTool) getDroplets(ctx context.Context, req mcp.CallToolRequest)
 			"next_backup_window": droplet.NextBackupWindow,
 			"snapshot_ids":       droplet.SnapshotIDs,
 			"features":           droplet.Features,
+			"monitoring":         slices.Contains(droplet.Features, dropletFeatureMonitoring),
 			"locked":             droplet.Locked,
 			"status":             droplet.Status,
 			"networks":           droplet.Networks,
@@ -324,12 +548,48 @@ func (d *DropletTool) getDroplets(ctx context.Context, req mcp.CallToolRequest)
 	return mcp.NewToolResultText(string(jsonData)), nil
 }
 
+// GPUDroplet is a droplet with GPUs, as listed by droplet-list-gpus.
+type GPUDroplet struct {
+	DropletSummary
+	GPUs     int    `json:"gpus"`
+	GPUModel string `json:"gpu_model,omitempty"`
+}
+
+// GPUDropletList is the result of droplet-list-gpus.
+type GPUDropletList struct {
+	Droplets []GPUDroplet `json:"droplets"`
+}
+
+// listDropletsWithGPUs lists the droplets of the account that have GPUs.
+func (d *DropletTool) listDropletsWithGPUs(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client, err := d.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	droplets, err := common.ListAll(ctx, dropletsPageSize, client.Droplets.ListWithGPUs)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	result := GPUDropletList{Droplets: make([]GPUDroplet, len(droplets))}
+	for i, droplet := range droplets {
+		result.Droplets[i] = GPUDroplet{DropletSummary: summarizeDroplet(droplet)}
+		if droplet.Size != nil && droplet.Size.GPUInfo != nil {
+			result.Droplets[i].GPUs = droplet.Size.GPUInfo.Count
+			result.Droplets[i].GPUModel = droplet.Size.GPUInfo.Model
+		}
+	}
+	return common.NewToolResultStructured(result)
+}
+
 func (d *DropletTool) Tools() []server.ServerTool {
 	tools := []server.ServerTool{
 		{
 			Handler: d.createDroplet,
 			Tool: mcp.NewTool("droplet-create",
-				mcp.WithDescription("Create a new droplet. Supports standard distribution images via ImageID and 1-click marketplace app images via ImageSlug. Exactly one of ImageID or ImageSlug must be provided."),
+				mcp.WithDescription("Create a new droplet. Supports standard distribution images via ImageID and 1-click marketplace app images via ImageSlug. Exactly one of ImageID or ImageSlug must be provided. For a GPU droplet, pick a GPU size and one of its regions with gpu-size-list. The result is the new droplet with next_steps: the ID of the create action, how long the droplet typically takes to become active, and how to wait for it with droplet-wait."),
+				common.WithOutputSchema[DropletCreateResult](),
 				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the droplet")),
 				mcp.WithString("Size", mcp.Required(), mcp.Description("Slug of the droplet size (e.g., s-1vcpu-1gb)")),
 				mcp.WithNumber("ImageID", mcp.Description("Numeric ID of the image to use. Mutually exclusive with ImageSlug.")),
@@ -339,6 +599,10 @@ func (d *DropletTool) Tools() []server.ServerTool {
 				mcp.WithBoolean("Monitoring", mcp.DefaultBool(false), mcp.Description("Whether to enable monitoring")),
 				mcp.WithArray("SSHKeys", mcp.Description("Array of SSH key IDs (numbers) or fingerprints (strings) to add to the droplet"), mcp.Items(map[string]any{"type": "string"})),
 				mcp.WithArray("Tags", mcp.Description("Array of tag names to apply to the droplet"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithString("UserData", mcp.Description("cloud-init user data run on first boot, e.g. a #cloud-config document. Mutually exclusive with UserDataRef.")),
+				mcp.WithString("UserDataRef", mcp.Description("ref returned by userdata-render, whose user data is rendered again and used. Mutually exclusive with UserData.")),
+				common.WithIfNotExists("droplet"),
+				common.WithProject("droplet"),
 			),
 		},
 		{
@@ -352,6 +616,7 @@ func (d *DropletTool) Tools() []server.ServerTool {
 			Handler: d.enablePrivateNetworking,
 			Tool: mcp.NewTool("droplet-enable-private-net",
 				mcp.WithDescription("Enable private networking on a droplet"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
 			),
 		},
@@ -365,14 +630,27 @@ func (d *DropletTool) Tools() []server.ServerTool {
 		{
 			Handler: d.getDropletByID,
 			Tool: mcp.NewTool("droplet-get",
-				mcp.WithDescription("Get a droplet by its ID"),
+				mcp.WithDescription("Get a droplet by its ID. With Expand, the result also includes related resources: the firewalls applying to the droplet, the load balancers targeting it, its VPC and its attached volumes"),
+				common.WithOutputSchema[ExpandedDroplet](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("Droplet ID")),
+				mcp.WithArray("Expand", mcp.Description("Related resources to include: firewalls, load_balancers, vpc, volumes"), mcp.Items(map[string]any{"type": "string", "enum": expandable})),
+			),
+		},
+		{
+			Handler: d.findDroplet,
+			Tool: mcp.NewTool("droplet-find",
+				mcp.WithDescription("Find a droplet by its name, a prefix of its name or its public IPv4 address, for when the ID is not known. Exactly one of Name or PublicIPv4 must be provided, and exactly one droplet must match"),
+				common.WithOutputSchema[godo.Droplet](),
+				mcp.WithString("Name", mcp.Description("Name of the droplet, e.g. a hostname from logs")),
+				mcp.WithBoolean("Prefix", mcp.DefaultBool(false), mcp.Description("Match droplets whose name starts with Name instead of equalling it")),
+				mcp.WithString("PublicIPv4", mcp.Description("Public IPv4 address of the droplet, including a reserved IP assigned to it")),
 			),
 		},
 		{
 			Handler: d.getDropletBackupPolicy,
 			Tool: mcp.NewTool("droplet-backup-policy",
 				mcp.WithDescription("Get a droplet's backup policy"),
+				common.WithOutputSchema[godo.DropletBackupPolicy](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("Droplet ID")),
 			),
 		},
@@ -380,6 +658,7 @@ func (d *DropletTool) Tools() []server.ServerTool {
 			Handler: d.getDropletActionByID,
 			Tool: mcp.NewTool("droplet-action",
 				mcp.WithDescription("Get a droplet action by droplet ID and action ID"),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("DropletID", mcp.Required(), mcp.Description("Droplet ID")),
 				mcp.WithNumber("ActionID", mcp.Required(), mcp.Description("Action ID")),
 			),
@@ -388,8 +667,15 @@ func (d *DropletTool) Tools() []server.ServerTool {
 			Handler: d.getDroplets,
 			Tool: mcp.NewTool("droplet-list",
 				mcp.WithDescription("List all droplets for the user. Supports pagination."),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(50), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(50), mcp.Description("Items per page")),
+			),
+		},
+		{
+			Handler: d.listDropletsWithGPUs,
+			Tool: mcp.NewTool("droplet-list-gpus",
+				mcp.WithDescription("List the droplets with GPUs, with their status, region, size, IP addresses and GPU count and model."),
+				common.WithOutputSchema[GPUDropletList](),
 			),
 		},
 	}
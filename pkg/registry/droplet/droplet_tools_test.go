@@ -6,6 +6,8 @@ import (
 	"errors"
 	"testing"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/require"
@@ -22,6 +24,34 @@ func setupDropletToolWithMocks(droplets *MockDropletsService, actions *MockDropl
 	return NewDropletTool(client)
 }
 
+// withPassingPreflight adds to c the services the preflight checks of droplet-create look at,
+// answering that the account has room for droplets of s-1vcpu-1gb in nyc1 and nyc3, from images
+// available in every region.
+func withPassingPreflight(ctrl *gomock.Controller, c *godo.Client) *godo.Client {
+	account := NewMockAccountService(ctrl)
+	account.EXPECT().Get(gomock.Any()).Return(&godo.Account{DropletLimit: 25}, nil, nil).AnyTimes()
+	regions := NewMockRegionsService(ctrl)
+	regions.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Region{
+		{Slug: "nyc1", Available: true, Sizes: []string{"s-1vcpu-1gb"}},
+		{Slug: "nyc3", Available: true, Sizes: []string{"s-1vcpu-1gb"}},
+	}, nil, nil).AnyTimes()
+	sizes := NewMockSizesService(ctrl)
+	sizes.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Size{
+		{Slug: "s-1vcpu-1gb", Available: true, Regions: []string{"nyc1", "nyc3"}},
+	}, nil, nil).AnyTimes()
+	if droplets, ok := c.Droplets.(*MockDropletsService); ok {
+		droplets.EXPECT().List(gomock.Any(), &godo.ListOptions{PerPage: 1}).Return(nil, &godo.Response{Meta: &godo.Meta{Total: 3}}, nil).AnyTimes()
+	}
+	if c.Images == nil {
+		images := NewMockImagesService(ctrl)
+		images.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(&godo.Image{Status: "available"}, nil, nil).AnyTimes()
+		images.EXPECT().GetBySlug(gomock.Any(), gomock.Any()).Return(&godo.Image{Status: "available"}, nil, nil).AnyTimes()
+		c.Images = images
+	}
+	c.Account, c.Regions, c.Sizes = account, regions, sizes
+	return c
+}
+
 func TestDropletTool_createDroplet(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -35,6 +65,7 @@ func TestDropletTool_createDroplet(t *testing.T) {
 		args        map[string]any
 		mockSetup   func(*MockDropletsService)
 		expectError bool
+		existing    bool
 	}{
 		{
 			name: "Successful create",
@@ -109,6 +140,106 @@ func TestDropletTool_createDroplet(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			name: "Successful create with rendered user data",
+			args: map[string]any{
+				"Name":        "web",
+				"Size":        "s-1vcpu-1gb",
+				"ImageSlug":   "ubuntu-24-04-x64",
+				"Region":      "nyc1",
+				"UserDataRef": "userdata://nginx?server_name=example.com",
+			},
+			mockSetup: func(m *MockDropletsService) {
+				rendered, _ := renderUserData("nginx", map[string]string{"server_name": "example.com"})
+				m.EXPECT().
+					Create(gomock.Any(), &godo.DropletCreateRequest{
+						Name:     "web",
+						Region:   "nyc1",
+						Size:     "s-1vcpu-1gb",
+						Image:    godo.DropletCreateImage{Slug: "ubuntu-24-04-x64"},
+						UserData: rendered.UserData,
+					}).
+					Return(testDroplet, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "UserData and UserDataRef",
+			args: map[string]any{
+				"Name":        "web",
+				"Size":        "s-1vcpu-1gb",
+				"ImageSlug":   "ubuntu-24-04-x64",
+				"Region":      "nyc1",
+				"UserData":    "#!/bin/sh",
+				"UserDataRef": "userdata://docker",
+			},
+			expectError: true,
+		},
+		{
+			name: "IfNotExists returns the existing droplet",
+			args: map[string]any{
+				"Name":        "test-droplet",
+				"Size":        "s-1vcpu-1gb",
+				"ImageID":     float64(456),
+				"Region":      "nyc1",
+				"IfNotExists": true,
+			},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().
+					ListByName(gomock.Any(), "test-droplet", gomock.Any()).
+					Return([]godo.Droplet{*testDroplet}, &godo.Response{}, nil).
+					Times(1)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			existing: true,
+		},
+		{
+			name: "IfNotExists creates a missing droplet",
+			args: map[string]any{
+				"Name":        "test-droplet",
+				"Size":        "s-1vcpu-1gb",
+				"ImageID":     float64(456),
+				"Region":      "nyc1",
+				"IfNotExists": true,
+			},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().
+					ListByName(gomock.Any(), "test-droplet", gomock.Any()).
+					Return(nil, &godo.Response{}, nil).
+					Times(1)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Return(testDroplet, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "IfNotExists fails when several droplets have the name",
+			args: map[string]any{
+				"Name":        "test-droplet",
+				"Size":        "s-1vcpu-1gb",
+				"ImageID":     float64(456),
+				"Region":      "nyc1",
+				"IfNotExists": true,
+			},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().
+					ListByName(gomock.Any(), "test-droplet", gomock.Any()).
+					Return([]godo.Droplet{{ID: 1, Name: "test-droplet"}, {ID: 2, Name: "test-droplet"}}, &godo.Response{}, nil).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name: "Preflight failure creates nothing",
+			args: map[string]any{
+				"Name":      "test-droplet",
+				"Size":      "s-1vcpu-1gb",
+				"ImageSlug": "ubuntu-24-04-x64",
+				"Region":    "sfo9",
+			},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectError: true,
+		},
 		{
 			name: "Error when neither ImageID nor ImageSlug provided",
 			args: map[string]any{
@@ -140,7 +271,9 @@ func TestDropletTool_createDroplet(t *testing.T) {
 			if tc.mockSetup != nil {
 				tc.mockSetup(mockDroplets)
 			}
-			tool := setupDropletToolWithMocks(mockDroplets, mockActions)
+			tool := NewDropletTool(func(ctx context.Context) (*godo.Client, error) {
+				return withPassingPreflight(ctrl, &godo.Client{Droplets: mockDroplets, DropletActions: mockActions}), nil
+			})
 			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
 			resp, err := tool.createDroplet(context.Background(), req)
 			if tc.expectError {
@@ -151,6 +284,11 @@ func TestDropletTool_createDroplet(t *testing.T) {
 			require.NoError(t, err)
 			require.NotNil(t, resp)
 			require.False(t, resp.IsError)
+			if tc.existing {
+				require.Equal(t, true, resp.Meta.AdditionalFields[common.ExistingMetaKey])
+			} else {
+				require.Nil(t, resp.Meta)
+			}
 		})
 	}
 }
@@ -163,15 +301,22 @@ func TestDropletTool_getDropletByID(t *testing.T) {
 		ID:   123,
 		Name: "test-droplet",
 	}
+	monitoredDroplet := &godo.Droplet{
+		ID:       124,
+		Name:     "monitored-droplet",
+		Features: []string{"monitoring", "ipv6"},
+	}
 	tests := []struct {
 		name        string
 		args        map[string]any
 		mockSetup   func(*MockDropletsService)
+		expected    *ExpandedDroplet
 		expectError bool
 	}{
 		{
-			name: "Successful get",
-			args: map[string]any{"ID": float64(123)},
+			name:     "Successful get",
+			args:     map[string]any{"ID": float64(123)},
+			expected: &ExpandedDroplet{Droplet: *testDroplet},
 			mockSetup: func(m *MockDropletsService) {
 				m.EXPECT().
 					Get(gomock.Any(), 123).
@@ -179,6 +324,17 @@ func TestDropletTool_getDropletByID(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			name:     "Monitored droplet",
+			args:     map[string]any{"ID": float64(124)},
+			expected: &ExpandedDroplet{Droplet: *monitoredDroplet, Monitoring: true},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().
+					Get(gomock.Any(), 124).
+					Return(monitoredDroplet, nil, nil).
+					Times(1)
+			},
+		},
 		{
 			name: "API error",
 			args: map[string]any{"ID": float64(456)},
@@ -216,9 +372,11 @@ func TestDropletTool_getDropletByID(t *testing.T) {
 			require.NoError(t, err)
 			require.NotNil(t, resp)
 			require.False(t, resp.IsError)
-			var outDroplet godo.Droplet
+			var outDroplet ExpandedDroplet
 			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outDroplet))
-			require.Equal(t, testDroplet.ID, outDroplet.ID)
+			require.Equal(t, tc.expected.ID, outDroplet.ID)
+			require.Equal(t, tc.expected.Monitoring, outDroplet.Monitoring)
+			require.Equal(t, tc.expected, resp.StructuredContent)
 		})
 	}
 }
@@ -430,7 +588,7 @@ func TestDropletTool_getDroplets(t *testing.T) {
 			out := outDroplets[0]
 			// Check that all expected fields are present
 			for _, field := range []string{
-				"id", "name", "memory", "vcpus", "disk", "region", "image", "size", "size_slug", "backup_ids", "next_backup_window", "snapshot_ids", "features", "locked", "status", "networks", "created_at", "kernel", "tags", "volume_ids", "vpc_uuid",
+				"id", "name", "memory", "vcpus", "disk", "region", "image", "size", "size_slug", "backup_ids", "next_backup_window", "snapshot_ids", "features", "monitoring", "locked", "status", "networks", "created_at", "kernel", "tags", "volume_ids", "vpc_uuid",
 			} {
 				require.Contains(t, out, field)
 			}
@@ -438,6 +596,283 @@ func TestDropletTool_getDroplets(t *testing.T) {
 			require.Equal(t, float64(testDroplet.ID), out["id"])
 			require.Equal(t, testDroplet.Name, out["name"])
 			require.Equal(t, testDroplet.SizeSlug, out["size_slug"])
+			require.Equal(t, false, out["monitoring"])
+		})
+	}
+}
+
+func TestDropletTool_listDropletsWithGPUs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDroplets := NewMockDropletsService(ctrl)
+	mockDroplets.EXPECT().ListWithGPUs(gomock.Any(), gomock.Any()).Return([]godo.Droplet{{
+		ID:       7,
+		Name:     "trainer",
+		Status:   "active",
+		Region:   &godo.Region{Slug: "tor1"},
+		SizeSlug: "gpu-h100x8-640gb",
+		Size:     &godo.Size{Slug: "gpu-h100x8-640gb", GPUInfo: &godo.GPUInfo{Count: 8, Model: "nvidia_h100"}},
+	}}, &godo.Response{}, nil)
+	tool := setupDropletToolWithMocks(mockDroplets, nil)
+
+	resp, err := tool.listDropletsWithGPUs(context.Background(), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	list := resp.StructuredContent.(GPUDropletList)
+	require.Len(t, list.Droplets, 1)
+	require.Equal(t, "tor1", list.Droplets[0].Region)
+	require.Equal(t, 8, list.Droplets[0].GPUs)
+	require.Equal(t, "nvidia_h100", list.Droplets[0].GPUModel)
+}
+
+func TestDropletTool_createGPUDropletInWrongRegion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	account := NewMockAccountService(ctrl)
+	account.EXPECT().Get(gomock.Any()).Return(&godo.Account{}, nil, nil)
+	regions := NewMockRegionsService(ctrl)
+	regions.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Region{
+		{Slug: "nyc3", Available: true, Sizes: []string{"s-1vcpu-1gb"}},
+		{Slug: "tor1", Available: true, Sizes: []string{"gpu-h100x1-80gb"}},
+	}, nil, nil)
+	sizes := NewMockSizesService(ctrl)
+	sizes.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Size{
+		{Slug: "gpu-h100x1-80gb", Available: true, Regions: []string{"tor1"}, GPUInfo: &godo.GPUInfo{Count: 1}},
+	}, nil, nil)
+	tool := NewDropletTool(func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{Account: account, Regions: regions, Sizes: sizes}, nil
+	})
+
+	resp, err := tool.createDroplet(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"Name": "trainer", "Size": "gpu-h100x1-80gb", "Region": "nyc3", "ImageSlug": "gpu-h100x1-base",
+	}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+	require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "can only be created in tor1")
+}
+
+func TestDropletCreateResult(t *testing.T) {
+	links := &godo.Response{Links: &godo.Links{Actions: []godo.LinkAction{{ID: 99, Rel: "create", HREF: "https://api.digitalocean.com/v2/actions/99"}}}}
+	tests := []struct {
+		name    string
+		droplet *godo.Droplet
+		resp    *godo.Response
+		want    DropletNextSteps
+	}{
+		{
+			name:    "New droplet",
+			droplet: &godo.Droplet{ID: 7, Status: "new", SizeSlug: "s-1vcpu-1gb"},
+			resp:    links,
+			want: DropletNextSteps{ActionID: 99, ExpectedActiveSeconds: 60,
+				Wait: "Droplet 7 is new and typically becomes active within 60 seconds. Call droplet-wait with ID 7 once to wait until it is active and has its IP addresses, instead of polling droplet-get."},
+		},
+		{
+			name:    "GPU droplet",
+			droplet: &godo.Droplet{ID: 8, Status: "new", SizeSlug: "gpu-h100x1-80gb"},
+			resp:    links,
+			want: DropletNextSteps{ActionID: 99, ExpectedActiveSeconds: 300,
+				Wait: "Droplet 8 is new and typically becomes active within 300 seconds. Call droplet-wait with ID 8 once to wait until it is active and has its IP addresses, instead of polling droplet-get."},
+		},
+		{
+			name:    "Existing active droplet",
+			droplet: &godo.Droplet{ID: 9, Status: "active", Networks: &godo.Networks{V4: []godo.NetworkV4{{IPAddress: "203.0.113.9", Type: "public"}}}},
+			want:    DropletNextSteps{Wait: "Droplet 9 is already active; no need to wait."},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := dropletCreateResult(tc.droplet, tc.resp)
+			require.Equal(t, *tc.droplet, result.Droplet)
+			require.Equal(t, tc.want, result.NextSteps)
+		})
+	}
+
+	// the droplet's fields stay at the top level of the result.
+	data, err := json.Marshal(dropletCreateResult(&godo.Droplet{ID: 7, Name: "web-1"}, links))
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, float64(7), decoded["id"])
+	require.Equal(t, "web-1", decoded["name"])
+	require.Equal(t, float64(99), decoded["next_steps"].(map[string]any)["action_id"])
+}
+
+func TestDropletTool_createDropletFromImageInOtherRegion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	images := NewMockImagesService(ctrl)
+	images.EXPECT().GetByID(gomock.Any(), 456).Return(&godo.Image{ID: 456, Name: "web-snapshot", Status: "available", Regions: []string{"nyc3"}}, nil, nil)
+	mockDroplets := NewMockDropletsService(ctrl)
+	tool := NewDropletTool(func(ctx context.Context) (*godo.Client, error) {
+		return withPassingPreflight(ctrl, &godo.Client{Droplets: mockDroplets, Images: images}), nil
+	})
+
+	resp, err := tool.createDroplet(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"Name": "web-2", "Size": "s-1vcpu-1gb", "Region": "nyc1", "ImageID": float64(456),
+	}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+	require.Equal(t, "preflight check failed: image 456 (web-snapshot) is not available in nyc1; create the droplet in one of nyc3, or copy the image to nyc1 with image-action-transfer",
+		resp.Content[0].(mcp.TextContent).Text)
+}
+
+func TestDropletTool_findDroplet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	web1 := godo.Droplet{ID: 1, Name: "web-1", Networks: &godo.Networks{V4: []godo.NetworkV4{
+		{IPAddress: "10.0.0.1", Type: "private"},
+		{IPAddress: "203.0.113.1", Type: "public"},
+	}}}
+	web2 := godo.Droplet{ID: 2, Name: "web-2", Networks: &godo.Networks{V4: []godo.NetworkV4{{IPAddress: "203.0.113.2", Type: "public"}}}}
+	db := godo.Droplet{ID: 3, Name: "db-1"}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockDropletsService)
+		expectID    int
+		expectError bool
+	}{
+		{
+			name: "Exact name",
+			args: map[string]any{"Name": "web-1"},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().ListByName(gomock.Any(), "web-1", gomock.Any()).Return([]godo.Droplet{web1}, nil, nil).Times(1)
+			},
+			expectID: 1,
+		},
+		{
+			name: "Name prefix",
+			args: map[string]any{"Name": "db", "Prefix": true},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Droplet{web1, web2, db}, nil, nil).Times(1)
+			},
+			expectID: 3,
+		},
+		{
+			name: "Ambiguous prefix",
+			args: map[string]any{"Name": "web", "Prefix": true},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Droplet{web1, web2, db}, nil, nil).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name: "Public IPv4",
+			args: map[string]any{"PublicIPv4": "203.0.113.2"},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Droplet{web1, web2, db}, nil, nil).Times(1)
+			},
+			expectID: 2,
+		},
+		{
+			name: "Private address does not match",
+			args: map[string]any{"PublicIPv4": "10.0.0.1"},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Droplet{web1, web2, db}, nil, nil).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Invalid address",
+			args:        map[string]any{"PublicIPv4": "web-1"},
+			expectError: true,
+		},
+		{
+			name:        "Name and address",
+			args:        map[string]any{"Name": "web-1", "PublicIPv4": "203.0.113.1"},
+			expectError: true,
+		},
+		{
+			name: "API error",
+			args: map[string]any{"Name": "web-1"},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().ListByName(gomock.Any(), "web-1", gomock.Any()).Return(nil, nil, errors.New("api error")).Times(1)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDroplets := NewMockDropletsService(ctrl)
+			mockActions := NewMockDropletActionsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockDroplets)
+			}
+			tool := setupDropletToolWithMocks(mockDroplets, mockActions)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.findDroplet(context.Background(), req)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			var outDroplet godo.Droplet
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outDroplet))
+			require.Equal(t, tc.expectID, outDroplet.ID)
+		})
+	}
+}
+
+func TestDropletTool_createDropletInProject(t *testing.T) {
+	createArgs := map[string]any{"Name": "web-1", "Size": "s-1vcpu-1gb", "Region": "nyc3", "ImageSlug": "ubuntu-24-04-x64", "Project": "web"}
+	projects := []godo.Project{{ID: "p-1", Name: "web"}}
+	created := &godo.Droplet{ID: 7, Name: "web-1"}
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockDropletsService, *MockProjectsService)
+		expectError bool
+	}{
+		{
+			name: "Assigned after create",
+			args: createArgs,
+			mockSetup: func(d *MockDropletsService, p *MockProjectsService) {
+				p.EXPECT().List(gomock.Any(), gomock.Any()).Return(projects, nil, nil)
+				d.EXPECT().Create(gomock.Any(), gomock.Any()).Return(created, nil, nil)
+				p.EXPECT().AssignResources(gomock.Any(), "p-1", "do:droplet:7").Return(nil, nil, nil)
+			},
+		},
+		{
+			name: "Unknown project creates nothing",
+			args: map[string]any{"Name": "web-1", "Size": "s-1vcpu-1gb", "Region": "nyc3", "ImageSlug": "ubuntu-24-04-x64", "Project": "prod"},
+			mockSetup: func(d *MockDropletsService, p *MockProjectsService) {
+				p.EXPECT().List(gomock.Any(), gomock.Any()).Return(projects, nil, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "Failed assignment deletes the droplet",
+			args: createArgs,
+			mockSetup: func(d *MockDropletsService, p *MockProjectsService) {
+				p.EXPECT().List(gomock.Any(), gomock.Any()).Return(projects, nil, nil)
+				d.EXPECT().Create(gomock.Any(), gomock.Any()).Return(created, nil, nil)
+				p.EXPECT().AssignResources(gomock.Any(), "p-1", "do:droplet:7").Return(nil, nil, errors.New("forbidden"))
+				d.EXPECT().Delete(gomock.Any(), 7).Return(nil, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDroplets := NewMockDropletsService(ctrl)
+			mockProjects := NewMockProjectsService(ctrl)
+			tc.mockSetup(mockDroplets, mockProjects)
+			tool := NewDropletTool(func(ctx context.Context) (*godo.Client, error) {
+				return withPassingPreflight(ctrl, &godo.Client{Droplets: mockDroplets, Projects: mockProjects}), nil
+			})
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.createDroplet(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, resp.IsError)
+			if tc.expectError {
+				return
+			}
+			require.Equal(t, *created, resp.StructuredContent.(DropletCreateResult).Droplet)
 		})
 	}
 }
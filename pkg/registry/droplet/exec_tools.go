@@ -0,0 +1,272 @@
+package droplet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"mcp-digitalocean/internal/dryrun"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	defaultExecTimeout = 60
+	maxExecTimeout     = 3600
+	// maxExecOutput is how much of each of stdout and stderr is returned.
+	maxExecOutput = 64 << 10
+	sshPort       = "22"
+)
+
+// ExecResult is the outcome of a command run by droplet-exec.
+type ExecResult struct {
+	DryRun    bool   `json:"dry_run,omitempty"`
+	DropletID int    `json:"droplet_id"`
+	Host      string `json:"host"`
+	User      string `json:"user"`
+	Command   string `json:"command"`
+	// ExitCode is the exit status of the command; -1 when it did not exit, e.g. on a timeout.
+	ExitCode        int    `json:"exit_code"`
+	Stdout          string `json:"stdout"`
+	Stderr          string `json:"stderr"`
+	StdoutTruncated bool   `json:"stdout_truncated,omitempty"`
+	StderrTruncated bool   `json:"stderr_truncated,omitempty"`
+	TimedOut        bool   `json:"timed_out,omitempty"`
+	DurationMs      int64  `json:"duration_ms"`
+	// HostKey is the SHA256 fingerprint of the host key the droplet presented.
+	HostKey string `json:"host_key,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// cappedBuffer keeps the first limit bytes written to it and reports whether more were dropped.
+type cappedBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.buf.Len(); len(p) > room {
+		b.buf.Write(p[:max(room, 0)])
+		b.truncated = true
+	} else {
+		b.buf.Write(p)
+	}
+	return len(p), nil
+}
+
+// pinnedHostKeys accepts the first host key each address presents and only that key afterwards,
+// for the lifetime of the server. It is used when no known_hosts file is configured.
+type pinnedHostKeys struct {
+	mu   sync.Mutex
+	keys map[string]ssh.PublicKey
+}
+
+func (p *pinnedHostKeys) check(hostname string, _ net.Addr, key ssh.PublicKey) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pinned, ok := p.keys[hostname]
+	if !ok {
+		p.keys[hostname] = key
+		return nil
+	}
+	if !bytes.Equal(pinned.Marshal(), key.Marshal()) {
+		return fmt.Errorf("host key of %s changed from %s to %s since the first connection; if the droplet was rebuilt, restart the server to accept the new key", hostname, ssh.FingerprintSHA256(pinned), ssh.FingerprintSHA256(key))
+	}
+	return nil
+}
+
+// DropletExecTool provides a tool that runs commands on droplets over SSH with the server's key.
+type DropletExecTool struct {
+	client   func(ctx context.Context) (*godo.Client, error)
+	signer   ssh.Signer
+	hostKeys ssh.HostKeyCallback
+	dial     func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// NewDropletExecTool creates a droplet exec tool authenticating with the unencrypted private key
+// in keyFile. Host keys are checked against knownHostsFile or, when it is empty, pinned on first use.
+func NewDropletExecTool(client func(ctx context.Context) (*godo.Client, error), keyFile, knownHostsFile string) (*DropletExecTool, error) {
+	key, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SSH private key: %w", err)
+	}
+	signer, err := ssh.ParsePrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SSH private key %s: %w", keyFile, err)
+	}
+	hostKeys := (&pinnedHostKeys{keys: map[string]ssh.PublicKey{}}).check
+	if knownHostsFile != "" {
+		if hostKeys, err = knownhosts.New(knownHostsFile); err != nil {
+			return nil, fmt.Errorf("failed to read known hosts: %w", err)
+		}
+	}
+	return &DropletExecTool{client: client, signer: signer, hostKeys: hostKeys, dial: (&net.Dialer{}).DialContext}, nil
+}
+
+// execAddress returns the IPv4 address of droplet on the public or private network.
+func execAddress(droplet *godo.Droplet, network string) (string, error) {
+	var ip string
+	var err error
+	if network == "private" {
+		ip, err = droplet.PrivateIPv4()
+	} else {
+		ip, err = droplet.PublicIPv4()
+	}
+	if err != nil {
+		return "", err
+	}
+	if ip == "" {
+		return "", fmt.Errorf("droplet %d has no %s IPv4 address", droplet.ID, network)
+	}
+	return ip, nil
+}
+
+// run runs command on host as user until it exits or ctx is done, capturing its output.
+func (e *DropletExecTool) run(ctx context.Context, result *ExecResult) error {
+	address := net.JoinHostPort(result.Host, sshPort)
+	conn, err := e.dial(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	// the handshake is bounded by ctx too.
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+	config := &ssh.ClientConfig{
+		User: result.User,
+		Auth: []ssh.AuthMethod{ssh.PublicKeys(e.signer)},
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			result.HostKey = ssh.FingerprintSHA256(key)
+			return e.hostKeys(hostname, remote, key)
+		},
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, address, config)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	client := ssh.NewClient(c, chans, reqs)
+	defer client.Close()
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	stdout := &cappedBuffer{limit: maxExecOutput}
+	stderr := &cappedBuffer{limit: maxExecOutput}
+	session.Stdout, session.Stderr = stdout, stderr
+	err = session.Run(result.Command)
+	result.Stdout, result.StdoutTruncated = stdout.buf.String(), stdout.truncated
+	result.Stderr, result.StderrTruncated = stderr.buf.String(), stderr.truncated
+
+	var exitErr *ssh.ExitError
+	switch {
+	case err == nil:
+		result.ExitCode = 0
+	case errors.As(err, &exitErr):
+		result.ExitCode = exitErr.ExitStatus()
+	case ctx.Err() != nil:
+		return ctx.Err()
+	default:
+		return err
+	}
+	return nil
+}
+
+// execCommand runs a shell command on a droplet over SSH and returns its exit code and output.
+func (e *DropletExecTool) execCommand(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	id := args.RequiredNumber("ID")
+	command := args.RequiredString("Command")
+	user := args.String("User")
+	network := args.String("Network")
+	timeout := args.Number("TimeoutSeconds", defaultExecTimeout)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if user == "" {
+		user = "root"
+	}
+	if network == "" {
+		network = "public"
+	}
+	if network != "public" && network != "private" {
+		return mcp.NewToolResultError("invalid arguments: Network must be public or private"), nil
+	}
+	if timeout < 1 || timeout > maxExecTimeout {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: TimeoutSeconds must be between 1 and %d", maxExecTimeout)), nil
+	}
+
+	client, err := e.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	droplet, _, err := client.Droplets.Get(ctx, int(id))
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	host, err := execAddress(droplet, network)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	result := &ExecResult{DropletID: droplet.ID, Host: host, User: user, Command: command, ExitCode: -1}
+
+	// the command does not go through the API, so a dry run has nothing to intercept.
+	if dryrun.Active(ctx) {
+		dryrun.Planned(ctx)
+		result.DryRun = true
+		result.Message = fmt.Sprintf("Dry run: the command was not run. It would run as %s on %s.", user, host)
+		return common.NewToolResultStructured(result)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout*float64(time.Second)))
+	defer cancel()
+	start := time.Now()
+	err = e.run(runCtx, result)
+	result.DurationMs = time.Since(start).Milliseconds()
+	if errors.Is(err, context.DeadlineExceeded) {
+		result.TimedOut = true
+		result.Message = fmt.Sprintf("the command did not finish within %s seconds and was stopped", strconv.FormatFloat(timeout, 'f', -1, 64))
+	} else if err != nil {
+		return mcp.NewToolResultErrorFromErr(fmt.Sprintf("failed to run the command on %s as %s", host, user), err), nil
+	}
+
+	res, err := common.NewToolResultStructured(result)
+	if err != nil {
+		return nil, err
+	}
+	res.IsError = result.ExitCode != 0
+	return res, nil
+}
+
+// Tools returns the droplet-exec tool.
+func (e *DropletExecTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: e.execCommand,
+			Tool: mcp.NewTool("droplet-exec",
+				mcp.WithDescription("Run a shell command on a droplet over SSH with the server's SSH key and return its exit code, stdout and stderr, for setup steps the API cannot do, e.g. installing packages or checking a service. The key must be authorized on the droplet, e.g. by adding its public key when creating it. Each stream returns at most 64 KiB; the command is stopped after TimeoutSeconds. Prefer API tools where one exists."),
+				common.WithOutputSchema[ExecResult](),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
+				mcp.WithString("Command", mcp.Required(), mcp.Description("Command to run with the user's login shell, e.g. systemctl is-active nginx")),
+				mcp.WithString("User", mcp.DefaultString("root"), mcp.Description("User to log in as")),
+				mcp.WithString("Network", mcp.Enum("public", "private"), mcp.DefaultString("public"), mcp.Description("Network whose IPv4 address to connect to. private needs the server to run inside the droplet's VPC")),
+				mcp.WithNumber("TimeoutSeconds", mcp.DefaultNumber(defaultExecTimeout), mcp.Min(1), mcp.Max(maxExecTimeout), mcp.Description("How long the command may run")),
+			),
+		},
+	}
+}
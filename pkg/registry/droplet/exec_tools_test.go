@@ -0,0 +1,177 @@
+package droplet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/ssh"
+)
+
+// serveSSH runs an SSH server accepting clientKey that answers each exec request with run.
+func serveSSH(t *testing.T, clientKey ssh.PublicKey, run func(command string, ch ssh.Channel) uint32) (string, ssh.PublicKey) {
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostSigner, err := ssh.NewSignerFromKey(hostPriv)
+	require.NoError(t, err)
+	config := &ssh.ServerConfig{PublicKeyCallback: func(meta ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+		if meta.User() != "root" || string(key.Marshal()) != string(clientKey.Marshal()) {
+			return nil, ssh.ErrNoAuth
+		}
+		return nil, nil
+	}}
+	config.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, chans, reqs, err := ssh.NewServerConn(conn, config)
+				if err != nil {
+					return
+				}
+				go ssh.DiscardRequests(reqs)
+				for newChan := range chans {
+					ch, requests, _ := newChan.Accept()
+					go func() {
+						for req := range requests {
+							if req.Type != "exec" {
+								req.Reply(false, nil)
+								continue
+							}
+							req.Reply(true, nil)
+							command := string(req.Payload[4:])
+							status := run(command, ch)
+							ch.SendRequest("exit-status", false, binary.BigEndian.AppendUint32(nil, status))
+							ch.Close()
+						}
+					}()
+				}
+			}()
+		}
+	}()
+	return listener.Addr().String(), hostSigner.PublicKey()
+}
+
+func writeClientKey(t *testing.T) (string, ssh.PublicKey) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "id_ed25519")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(block), 0o600))
+	signer, err := ssh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+	return path, signer.PublicKey()
+}
+
+func TestDropletExecTool_execCommand(t *testing.T) {
+	keyFile, clientKey := writeClientKey(t)
+	address, hostKey := serveSSH(t, clientKey, func(command string, ch ssh.Channel) uint32 {
+		switch command {
+		case "sleep":
+			time.Sleep(2 * time.Second)
+			return 0
+		case "big":
+			ch.Write([]byte(strings.Repeat("x", maxExecOutput+10)))
+			return 0
+		case "fail":
+			ch.Stderr().Write([]byte("no such unit\n"))
+			return 3
+		}
+		ch.Write([]byte("ran " + command + "\n"))
+		return 0
+	})
+
+	setup := func(t *testing.T) *DropletExecTool {
+		ctrl := gomock.NewController(t)
+		droplets := NewMockDropletsService(ctrl)
+		droplets.EXPECT().Get(gomock.Any(), 10).Return(&godo.Droplet{ID: 10, Networks: &godo.Networks{
+			V4: []godo.NetworkV4{{IPAddress: "203.0.113.10", Type: "public"}},
+		}}, nil, nil).AnyTimes()
+		tool, err := NewDropletExecTool(func(ctx context.Context) (*godo.Client, error) { return &godo.Client{Droplets: droplets}, nil }, keyFile, "")
+		require.NoError(t, err)
+		tool.dial = func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, address)
+		}
+		return tool
+	}
+	call := func(t *testing.T, tool *DropletExecTool, args map[string]any) (*mcp.CallToolResult, *ExecResult) {
+		t.Helper()
+		args["ID"] = float64(10)
+		resp, err := tool.execCommand(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		result, _ := resp.StructuredContent.(*ExecResult)
+		return resp, result
+	}
+
+	t.Run("Runs and captures output", func(t *testing.T) {
+		resp, result := call(t, setup(t), map[string]any{"Command": "uptime"})
+		require.False(t, resp.IsError)
+		require.Equal(t, 0, result.ExitCode)
+		require.Equal(t, "ran uptime\n", result.Stdout)
+		require.Equal(t, "203.0.113.10", result.Host)
+		require.Equal(t, ssh.FingerprintSHA256(hostKey), result.HostKey)
+	})
+
+	t.Run("Non-zero exit", func(t *testing.T) {
+		resp, result := call(t, setup(t), map[string]any{"Command": "fail"})
+		require.True(t, resp.IsError)
+		require.Equal(t, 3, result.ExitCode)
+		require.Equal(t, "no such unit\n", result.Stderr)
+	})
+
+	t.Run("Truncates output", func(t *testing.T) {
+		_, result := call(t, setup(t), map[string]any{"Command": "big"})
+		require.Len(t, result.Stdout, maxExecOutput)
+		require.True(t, result.StdoutTruncated)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		resp, result := call(t, setup(t), map[string]any{"Command": "sleep", "TimeoutSeconds": float64(1)})
+		require.True(t, resp.IsError)
+		require.True(t, result.TimedOut)
+		require.Equal(t, -1, result.ExitCode)
+	})
+
+	t.Run("Wrong user", func(t *testing.T) {
+		resp, _ := call(t, setup(t), map[string]any{"Command": "uptime", "User": "deploy"})
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "as deploy")
+	})
+}
+
+func TestPinnedHostKeys(t *testing.T) {
+	pinned := &pinnedHostKeys{keys: map[string]ssh.PublicKey{}}
+	newKey := func() ssh.PublicKey {
+		pub, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		key, err := ssh.NewPublicKey(pub)
+		require.NoError(t, err)
+		return key
+	}
+	first, second := newKey(), newKey()
+
+	require.NoError(t, pinned.check("203.0.113.10:22", nil, first))
+	require.NoError(t, pinned.check("203.0.113.10:22", nil, first))
+	require.ErrorContains(t, pinned.check("203.0.113.10:22", nil, second), "host key of 203.0.113.10:22 changed")
+	require.NoError(t, pinned.check("203.0.113.11:22", nil, second))
+}
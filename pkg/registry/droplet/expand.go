@@ -0,0 +1,98 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+)
+
+// The relationships droplet-get can expand.
+const (
+	expandFirewalls     = "firewalls"
+	expandLoadBalancers = "load_balancers"
+	expandVPC           = "vpc"
+	expandVolumes       = "volumes"
+)
+
+var expandable = []string{expandFirewalls, expandLoadBalancers, expandVPC, expandVolumes}
+
+// ExpandedDroplet is a droplet with the resources related to it that droplet-get was asked to
+// expand. A relationship that was not expanded is left out; one that was is listed even if empty.
+type ExpandedDroplet struct {
+	godo.Droplet
+	// Monitoring reports whether the droplet runs the monitoring agent.
+	Monitoring bool `json:"monitoring"`
+	// Firewalls are the firewalls applying to the droplet, by its ID or one of its tags.
+	Firewalls []godo.Firewall `json:"firewalls,omitzero"`
+	// LoadBalancers are the load balancers targeting the droplet, by its ID or one of its tags.
+	LoadBalancers []godo.LoadBalancer `json:"load_balancers,omitzero"`
+	VPC           *godo.VPC           `json:"vpc,omitempty"`
+	// Volumes are the volumes attached to the droplet.
+	Volumes []godo.Volume `json:"volumes,omitzero"`
+}
+
+// expandArg validates the relationships named by the Expand argument.
+func expandArg(expand []string) error {
+	for _, e := range expand {
+		if !slices.Contains(expandable, e) {
+			return fmt.Errorf("invalid arguments: cannot expand %q; Expand takes %s", e, strings.Join(expandable, ", "))
+		}
+	}
+	return nil
+}
+
+// expandDroplet looks up the resources related to droplet named by expand.
+func expandDroplet(ctx context.Context, client *godo.Client, droplet *godo.Droplet, expand []string) (*ExpandedDroplet, error) {
+	expanded := &ExpandedDroplet{Droplet: *droplet, Monitoring: slices.Contains(droplet.Features, dropletFeatureMonitoring)}
+	targets := func(ids []int, tags []string) bool {
+		return slices.Contains(ids, droplet.ID) || slices.ContainsFunc(tags, func(tag string) bool { return slices.Contains(droplet.Tags, tag) })
+	}
+
+	if slices.Contains(expand, expandFirewalls) {
+		firewalls, err := common.ListAll(ctx, dropletsPageSize, client.Firewalls.List)
+		if err != nil {
+			return nil, fmt.Errorf("firewalls: %w", err)
+		}
+		expanded.Firewalls = []godo.Firewall{}
+		for _, firewall := range firewalls {
+			if targets(firewall.DropletIDs, firewall.Tags) {
+				expanded.Firewalls = append(expanded.Firewalls, firewall)
+			}
+		}
+	}
+	if slices.Contains(expand, expandLoadBalancers) {
+		loadBalancers, err := common.ListAll(ctx, dropletsPageSize, client.LoadBalancers.List)
+		if err != nil {
+			return nil, fmt.Errorf("load balancers: %w", err)
+		}
+		expanded.LoadBalancers = []godo.LoadBalancer{}
+		for _, lb := range loadBalancers {
+			if targets(lb.DropletIDs, []string{lb.Tag}) {
+				expanded.LoadBalancers = append(expanded.LoadBalancers, lb)
+			}
+		}
+	}
+	if slices.Contains(expand, expandVPC) && droplet.VPCUUID != "" {
+		vpc, _, err := client.VPCs.Get(ctx, droplet.VPCUUID)
+		if err != nil {
+			return nil, fmt.Errorf("vpc %s: %w", droplet.VPCUUID, err)
+		}
+		expanded.VPC = vpc
+	}
+	if slices.Contains(expand, expandVolumes) {
+		expanded.Volumes = []godo.Volume{}
+		for _, id := range droplet.VolumeIDs {
+			volume, _, err := client.Storage.GetVolume(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("volume %s: %w", id, err)
+			}
+			expanded.Volumes = append(expanded.Volumes, *volume)
+		}
+	}
+	return expanded, nil
+}
@@ -0,0 +1,92 @@
+package droplet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDropletTool_getDropletByID_expand(t *testing.T) {
+	droplet := &godo.Droplet{ID: 10, Name: "web-1", Tags: []string{"web"}, VPCUUID: "vpc-1", VolumeIDs: []string{"vol-1"}}
+
+	newTool := func(ctrl *gomock.Controller) (*DropletTool, *godo.Client) {
+		droplets := NewMockDropletsService(ctrl)
+		droplets.EXPECT().Get(gomock.Any(), 10).Return(droplet, nil, nil)
+		client := &godo.Client{
+			Droplets:      droplets,
+			Firewalls:     NewMockFirewallsService(ctrl),
+			LoadBalancers: NewMockLoadBalancersService(ctrl),
+			VPCs:          NewMockVPCsService(ctrl),
+			Storage:       NewMockStorageService(ctrl),
+		}
+		return NewDropletTool(func(ctx context.Context) (*godo.Client, error) { return client, nil }), client
+	}
+	call := func(t *testing.T, tool *DropletTool, expand ...any) *mcp.CallToolResult {
+		t.Helper()
+		req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"ID": float64(10), "Expand": expand}}}
+		resp, err := tool.getDropletByID(context.Background(), req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("Every relationship", func(t *testing.T) {
+		tool, client := newTool(gomock.NewController(t))
+		client.Firewalls.(*MockFirewallsService).EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Firewall{
+			{ID: "fw-tag", Tags: []string{"web"}},
+			{ID: "fw-id", DropletIDs: []int{10}},
+			{ID: "fw-other", DropletIDs: []int{11}, Tags: []string{"db"}},
+		}, &godo.Response{}, nil)
+		client.LoadBalancers.(*MockLoadBalancersService).EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.LoadBalancer{
+			{ID: "lb-tag", Tag: "web"},
+			{ID: "lb-other", DropletIDs: []int{11}},
+		}, &godo.Response{}, nil)
+		client.VPCs.(*MockVPCsService).EXPECT().Get(gomock.Any(), "vpc-1").Return(&godo.VPC{ID: "vpc-1", IPRange: "10.10.0.0/20"}, nil, nil)
+		client.Storage.(*MockStorageService).EXPECT().GetVolume(gomock.Any(), "vol-1").Return(&godo.Volume{ID: "vol-1", SizeGigaBytes: 100}, nil, nil)
+
+		resp := call(t, tool, "firewalls", "load_balancers", "vpc", "volumes")
+		require.False(t, resp.IsError)
+		expanded := resp.StructuredContent.(*ExpandedDroplet)
+		require.Equal(t, 10, expanded.ID)
+		require.Len(t, expanded.Firewalls, 2)
+		require.Equal(t, "fw-id", expanded.Firewalls[1].ID)
+		require.Len(t, expanded.LoadBalancers, 1)
+		require.Equal(t, "10.10.0.0/20", expanded.VPC.IPRange)
+		require.Equal(t, int64(100), expanded.Volumes[0].SizeGigaBytes)
+	})
+
+	t.Run("Only what was asked for", func(t *testing.T) {
+		tool, client := newTool(gomock.NewController(t))
+		client.LoadBalancers.(*MockLoadBalancersService).EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, &godo.Response{}, nil)
+
+		resp := call(t, tool, "load_balancers")
+		require.False(t, resp.IsError)
+		var fields map[string]any
+		require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &fields))
+		require.Equal(t, []any{}, fields["load_balancers"])
+		require.NotContains(t, fields, "firewalls")
+		require.NotContains(t, fields, "vpc")
+		require.Equal(t, "web-1", fields["name"])
+	})
+
+	t.Run("Lookup error", func(t *testing.T) {
+		tool, client := newTool(gomock.NewController(t))
+		client.VPCs.(*MockVPCsService).EXPECT().Get(gomock.Any(), "vpc-1").Return(nil, nil, errors.New("not found"))
+
+		resp := call(t, tool, "vpc")
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "vpc vpc-1: not found")
+	})
+
+	t.Run("Unknown relationship", func(t *testing.T) {
+		tool := NewDropletTool(func(ctx context.Context) (*godo.Client, error) { return &godo.Client{}, nil })
+		resp := call(t, tool, "kernels")
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, `cannot expand "kernels"`)
+	})
+}
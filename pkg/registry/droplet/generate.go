@@ -1,3 +1,3 @@
 package droplet
 
-//go:generate mockgen -destination=./mocks.go -package droplet github.com/digitalocean/godo  DropletsService,DropletActionsService,SizesService,ImagesService,ImageActionsService
+//go:generate mockgen -destination=./mocks.go -package droplet github.com/digitalocean/godo  DropletsService,DropletActionsService,SizesService,ImagesService,ImageActionsService,MonitoringService,RegionsService,FirewallsService,DomainsService,KeysService,ReservedIPsService,ReservedIPActionsService,StorageService,LoadBalancersService,SnapshotsService,ProjectsService,AccountService,VPCsService
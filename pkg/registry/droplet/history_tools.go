@@ -0,0 +1,206 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultHistoryLimit = 50
+	maxHistoryLimit     = 500
+	historyPageSize     = 100
+)
+
+// historyEvents are the past tense of the droplet action types, as the history shows them.
+var historyEvents = map[string]string{
+	"create":                    "created",
+	"destroy":                   "destroyed",
+	"resize":                    "resized",
+	"snapshot":                  "snapshotted",
+	"backup":                    "backed up",
+	"power_off":                 "powered off",
+	"power_on":                  "powered on",
+	"shutdown":                  "shut down",
+	"reboot":                    "rebooted",
+	"power_cycle":               "power cycled",
+	"rebuild":                   "rebuilt",
+	"restore":                   "restored",
+	"rename":                    "renamed",
+	"password_reset":            "password reset",
+	"enable_backups":            "backups enabled",
+	"disable_backups":           "backups disabled",
+	"enable_ipv6":               "IPv6 enabled",
+	"enable_private_networking": "private networking enabled",
+	"kernel_change":             "kernel changed",
+	"change_backup_policy":      "backup policy changed",
+}
+
+// stopActionTypes are the action types that leave a droplet off, and startActionTypes those that
+// bring it back.
+var (
+	stopActionTypes  = []string{"power_off", "shutdown"}
+	startActionTypes = []string{"power_on", "power_cycle", "reboot"}
+)
+
+// HistoryEvent is one action in the history of a droplet.
+type HistoryEvent struct {
+	ActionID int    `json:"action_id"`
+	Type     string `json:"type"`
+	Event    string `json:"event"`
+	Status   string `json:"status"`
+	// StartedAt is when the action started, and DurationSeconds how long it took once completed.
+	StartedAt       string `json:"started_at,omitempty"`
+	DurationSeconds *int   `json:"duration_seconds,omitempty"`
+	// OffForSeconds is, for an action that stopped the droplet, how long it stayed off before the
+	// next action started it again.
+	OffForSeconds *int `json:"off_for_seconds,omitempty"`
+}
+
+// DropletHistory is the result of droplet-history.
+type DropletHistory struct {
+	DropletID int `json:"droplet_id"`
+	// Events are the most recent actions, oldest first.
+	Events []HistoryEvent `json:"events"`
+	// Truncated is set when older actions were left out because of Limit.
+	Truncated bool `json:"truncated,omitempty"`
+	// Summary is the events as one line each, e.g. "2025-06-01 12:00 UTC  resized (took 1m30s)".
+	Summary string `json:"summary"`
+}
+
+// DropletHistoryTool summarizes the action history of a droplet.
+type DropletHistoryTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+// NewDropletHistoryTool creates a new droplet history tool
+func NewDropletHistoryTool(client func(ctx context.Context) (*godo.Client, error)) *DropletHistoryTool {
+	return &DropletHistoryTool{client: client}
+}
+
+// seconds returns d in whole seconds.
+func seconds(d time.Duration) *int {
+	s := int(d.Round(time.Second) / time.Second)
+	return &s
+}
+
+// historyEvent names an action type as the history shows it.
+func historyEvent(actionType string) string {
+	if event, ok := historyEvents[actionType]; ok {
+		return event
+	}
+	return strings.ReplaceAll(actionType, "_", " ")
+}
+
+// dropletHistory returns the history of the actions, given oldest first.
+func dropletHistory(dropletID int, actions []godo.Action) DropletHistory {
+	history := DropletHistory{DropletID: dropletID, Events: make([]HistoryEvent, 0, len(actions))}
+	lines := make([]string, 0, len(actions))
+	for i, a := range actions {
+		event := HistoryEvent{ActionID: a.ID, Type: a.Type, Event: historyEvent(a.Type), Status: a.Status}
+		if a.StartedAt != nil {
+			event.StartedAt = a.StartedAt.UTC().Format(time.RFC3339)
+			if a.Status == godo.ActionCompleted && a.CompletedAt != nil {
+				event.DurationSeconds = seconds(a.CompletedAt.Sub(a.StartedAt.Time))
+			}
+		}
+		if slices.Contains(stopActionTypes, a.Type) && a.Status == godo.ActionCompleted && a.CompletedAt != nil {
+			for _, next := range actions[i+1:] {
+				if slices.Contains(startActionTypes, next.Type) && next.StartedAt != nil {
+					event.OffForSeconds = seconds(next.StartedAt.Sub(a.CompletedAt.Time))
+					break
+				}
+			}
+		}
+		history.Events = append(history.Events, event)
+		lines = append(lines, historyLine(a, event))
+	}
+	history.Summary = strings.Join(lines, "\n")
+	return history
+}
+
+// historyLine renders an event as one line of the summary.
+func historyLine(a godo.Action, event HistoryEvent) string {
+	when := "unknown time"
+	if a.StartedAt != nil {
+		when = a.StartedAt.UTC().Format("2006-01-02 15:04") + " UTC"
+	}
+	var details []string
+	switch {
+	case event.DurationSeconds != nil:
+		details = append(details, "took "+(time.Duration(*event.DurationSeconds)*time.Second).String())
+	case a.Status != godo.ActionCompleted:
+		details = append(details, strings.ReplaceAll(a.Status, "-", " "))
+	}
+	if event.OffForSeconds != nil {
+		details = append(details, "off for "+(time.Duration(*event.OffForSeconds)*time.Second).String())
+	}
+	if len(details) == 0 {
+		return when + "  " + event.Event
+	}
+	return fmt.Sprintf("%s  %s (%s)", when, event.Event, strings.Join(details, ", "))
+}
+
+// getHistory lists the most recent actions of a droplet, up to Limit, and summarizes them oldest first.
+func (h *DropletHistoryTool) getHistory(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	dropletID := int(args.RequiredNumber("ID"))
+	limit := int(args.Number("Limit", defaultHistoryLimit))
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if limit < 1 || limit > maxHistoryLimit {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: Limit must be between 1 and %d", maxHistoryLimit)), nil
+	}
+
+	client, err := h.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	// actions are listed newest first, so the first pages hold the most recent ones. Listing stops
+	// once more than limit actions were read, which is enough to tell the history was truncated.
+	read := 0
+	actions, err := common.ListAll(ctx, min(limit+1, historyPageSize), func(ctx context.Context, opt *godo.ListOptions) ([]godo.Action, *godo.Response, error) {
+		page, resp, err := client.Droplets.Actions(ctx, dropletID, opt)
+		if read += len(page); read > limit {
+			resp = nil
+		}
+		return page, resp, err
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	truncated := len(actions) > limit
+	if truncated {
+		actions = actions[:limit]
+	}
+	slices.Reverse(actions)
+
+	history := dropletHistory(dropletID, actions)
+	history.Truncated = truncated
+	return common.NewToolResultStructured(history)
+}
+
+// Tools returns the droplet-history tool.
+func (h *DropletHistoryTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: h.getHistory,
+			Tool: mcp.NewTool("droplet-history",
+				mcp.WithDescription("Summarize what happened to a droplet: its actions, such as created, resized, snapshotted or powered off, oldest first, with how long each took and how long the droplet stayed off after a power off or shutdown. The summary has one line per action; events has the same as structured data."),
+				common.WithOutputSchema[DropletHistory](),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
+				mcp.WithNumber("Limit", mcp.DefaultNumber(defaultHistoryLimit), mcp.Min(1), mcp.Max(maxHistoryLimit), mcp.Description("Number of most recent actions to summarize")),
+			),
+		},
+	}
+}
@@ -0,0 +1,136 @@
+package droplet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// completedAction is an action that started at start and took took.
+func completedAction(id int, actionType string, start time.Time, took time.Duration) godo.Action {
+	return godo.Action{
+		ID:          id,
+		Type:        actionType,
+		Status:      godo.ActionCompleted,
+		StartedAt:   &godo.Timestamp{Time: start},
+		CompletedAt: &godo.Timestamp{Time: start.Add(took)},
+	}
+}
+
+func TestDropletHistoryTool_getHistory(t *testing.T) {
+	start := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	// the API lists the newest action first.
+	actions := []godo.Action{
+		{ID: 6, Type: "snapshot", Status: godo.ActionInProgress, StartedAt: &godo.Timestamp{Time: start.Add(50 * time.Hour)}},
+		completedAction(5, "power_on", start.Add(26*time.Hour), 10*time.Second),
+		completedAction(4, "resize", start.Add(25*time.Hour), 90*time.Second),
+		completedAction(3, "power_off", start.Add(24*time.Hour), 20*time.Second),
+		{ID: 2, Type: "reboot", Status: "errored", StartedAt: &godo.Timestamp{Time: start.Add(time.Hour)}},
+		completedAction(1, "create", start, 45*time.Second),
+	}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockDropletsService)
+		expectError string
+		check       func(*testing.T, DropletHistory)
+	}{
+		{
+			name: "Whole history",
+			args: map[string]any{"ID": float64(7)},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().Actions(gomock.Any(), 7, &godo.ListOptions{Page: 1, PerPage: 51}).Return(actions, &godo.Response{}, nil)
+			},
+			check: func(t *testing.T, h DropletHistory) {
+				require.Equal(t, 7, h.DropletID)
+				require.False(t, h.Truncated)
+				require.Len(t, h.Events, 6)
+				require.Equal(t, "created", h.Events[0].Event)
+				require.Equal(t, 45, *h.Events[0].DurationSeconds)
+				require.Equal(t, "powered off", h.Events[2].Event)
+				require.Equal(t, 2*3600-20, *h.Events[2].OffForSeconds)
+				require.Nil(t, h.Events[5].DurationSeconds)
+				require.Equal(t, `2026-03-01 09:00 UTC  created (took 45s)
+2026-03-01 10:00 UTC  rebooted (errored)
+2026-03-02 09:00 UTC  powered off (took 20s, off for 1h59m40s)
+2026-03-02 10:00 UTC  resized (took 1m30s)
+2026-03-02 11:00 UTC  powered on (took 10s)
+2026-03-03 11:00 UTC  snapshotted (in progress)`, h.Summary)
+			},
+		},
+		{
+			name: "Most recent actions",
+			args: map[string]any{"ID": float64(7), "Limit": float64(2)},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().Actions(gomock.Any(), 7, &godo.ListOptions{Page: 1, PerPage: 3}).Return(actions[:3], &godo.Response{}, nil)
+			},
+			check: func(t *testing.T, h DropletHistory) {
+				require.True(t, h.Truncated)
+				require.Len(t, h.Events, 2)
+				require.Equal(t, 5, h.Events[0].ActionID)
+				require.Equal(t, 6, h.Events[1].ActionID)
+			},
+		},
+		{
+			name: "Several pages",
+			args: map[string]any{"ID": float64(7), "Limit": float64(200)},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().Actions(gomock.Any(), 7, &godo.ListOptions{Page: 1, PerPage: 100}).
+					Return(actions[:3], &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Next: "https://api.digitalocean.com/v2/droplets/7/actions?page=2", Last: "https://api.digitalocean.com/v2/droplets/7/actions?page=2"}}}, nil)
+				m.EXPECT().Actions(gomock.Any(), 7, &godo.ListOptions{Page: 2, PerPage: 100}).Return(actions[3:], &godo.Response{}, nil)
+			},
+			check: func(t *testing.T, h DropletHistory) {
+				require.False(t, h.Truncated)
+				require.Len(t, h.Events, 6)
+				require.Equal(t, 1, h.Events[0].ActionID)
+			},
+		},
+		{
+			name:        "Missing ID",
+			args:        map[string]any{},
+			expectError: "invalid arguments",
+		},
+		{
+			name:        "Limit too large",
+			args:        map[string]any{"ID": float64(7), "Limit": float64(1000)},
+			expectError: "Limit must be between 1 and 500",
+		},
+		{
+			name: "API error",
+			args: map[string]any{"ID": float64(7)},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().Actions(gomock.Any(), 7, gomock.Any()).Return(nil, nil, errors.New("not found"))
+			},
+			expectError: "api error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDroplets := NewMockDropletsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockDroplets)
+			}
+			tool := NewDropletHistoryTool(func(ctx context.Context) (*godo.Client, error) {
+				return &godo.Client{Droplets: mockDroplets}, nil
+			})
+			resp, err := tool.getHistory(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			if tc.expectError != "" {
+				require.True(t, resp.IsError)
+				require.Contains(t, resp.Content[0].(mcp.TextContent).Text, tc.expectError)
+				return
+			}
+			require.False(t, resp.IsError)
+			tc.check(t, resp.StructuredContent.(DropletHistory))
+		})
+	}
+}
@@ -2,8 +2,8 @@ package droplet
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+
+	"mcp-digitalocean/pkg/registry/common"
 
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -22,90 +22,30 @@ func NewImageActionsTool(client func(ctx context.Context) (*godo.Client, error))
 
 // transferImage triggers a transfer action for an image to a new region.
 func (ia *ImageActionsTool) transferImage(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	imageID, ok := req.GetArguments()["ID"].(float64)
-	if !ok {
-		return mcp.NewToolResultError("ID is required"), nil
-	}
-	region, ok := req.GetArguments()["Region"].(string)
-	if !ok || region == "" {
-		return mcp.NewToolResultError("Region is required"), nil
-	}
-
-	client, err := ia.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	transferRequest := &godo.ActionRequest{
-		"type":   "transfer",
-		"region": region,
-	}
-
-	action, _, err := client.ImageActions.Transfer(ctx, int(imageID), transferRequest)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	args := common.NewArgs(req)
+	region := args.RequiredString("Region")
+	return runOnID(ctx, ia.client, args, "ID", func(ctx context.Context, client *godo.Client, imageID int) (*godo.Action, *godo.Response, error) {
+		return client.ImageActions.Transfer(ctx, imageID, &godo.ActionRequest{
+			"type":   "transfer",
+			"region": region,
+		})
+	})
 }
 
 // convertImageToSnapshot converts a backup into a snapshot.
 func (ia *ImageActionsTool) convertImageToSnapshot(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	imageID, ok := req.GetArguments()["ID"].(float64)
-	if !ok {
-		return mcp.NewToolResultError("ID is required"), nil
-	}
-
-	client, err := ia.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.ImageActions.Convert(ctx, int(imageID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	return runOnID(ctx, ia.client, common.NewArgs(req), "ID", func(ctx context.Context, client *godo.Client, imageID int) (*godo.Action, *godo.Response, error) {
+		return client.ImageActions.Convert(ctx, imageID)
+	})
 }
 
 // getImageAction retrieves the status of an image action.
 func (ia *ImageActionsTool) getImageAction(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	imageID, ok := req.GetArguments()["ImageID"].(float64)
-	if !ok {
-		return mcp.NewToolResultError("ImageID is required"), nil
-	}
-	actionID, ok := req.GetArguments()["ActionID"].(float64)
-	if !ok {
-		return mcp.NewToolResultError("ActionID is required"), nil
-	}
-
-	client, err := ia.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	action, _, err := client.ImageActions.Get(ctx, int(imageID), int(actionID))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonAction, err := json.MarshalIndent(action, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonAction)), nil
+	args := common.NewArgs(req)
+	actionID := args.RequiredNumber("ActionID")
+	return runOnID(ctx, ia.client, args, "ImageID", func(ctx context.Context, client *godo.Client, imageID int) (*godo.Action, *godo.Response, error) {
+		return client.ImageActions.Get(ctx, imageID, int(actionID))
+	})
 }
 
 // Tools returns the list of server tools for image actions.
@@ -116,6 +56,7 @@ func (ia *ImageActionsTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool(
 				"image-action-transfer",
 				mcp.WithDescription("Transfer an image to another region."),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the image to transfer")),
 				mcp.WithString("Region", mcp.Required(), mcp.Description("Region slug to transfer to (e.g., nyc3)")),
 			),
@@ -125,6 +66,7 @@ func (ia *ImageActionsTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool(
 				"image-action-convert",
 				mcp.WithDescription("Convert an image (backup) to a snapshot."),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the image to convert")),
 			),
 		},
@@ -133,6 +75,7 @@ func (ia *ImageActionsTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool(
 				"image-action-get",
 				mcp.WithDescription("Retrieve the status of an image action."),
+				common.WithOutputSchema[godo.Action](),
 				mcp.WithNumber("ImageID", mcp.Required(), mcp.Description("ID of the image")),
 				mcp.WithNumber("ActionID", mcp.Required(), mcp.Description("ID of the action")),
 			),
@@ -0,0 +1,269 @@
+package droplet
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// ImageAliasesURI is the resource listing the human names of the current distribution images.
+	ImageAliasesURI = ResourceScheme + "image-aliases"
+	// imageAliasTTL is how long the distribution images are reused. They change when a release
+	// comes out or an old one is retired, so an hour keeps the aliases current.
+	imageAliasTTL = time.Hour
+)
+
+// familySynonyms maps the names people use for a distribution to the family of its slugs.
+var familySynonyms = map[string]string{
+	"ubuntu":        "ubuntu",
+	"debian":        "debian",
+	"fedora":        "fedora",
+	"centos":        "centos-stream",
+	"centos stream": "centos-stream",
+	"centos-stream": "centos-stream",
+	"rocky":         "rockylinux",
+	"rocky linux":   "rockylinux",
+	"rockylinux":    "rockylinux",
+	"alma":          "almalinux",
+	"alma linux":    "almalinux",
+	"almalinux":     "almalinux",
+}
+
+var (
+	// distributionSlug splits the slug of a distribution image into its family and version, e.g.
+	// ubuntu-24-04-x64 into ubuntu and 24-04.
+	distributionSlug = regexp.MustCompile(`^([a-z]+(?:-[a-z]+)*)-([0-9]+(?:-[0-9]+)*)-x64$`)
+	aliasVersion     = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*$`)
+)
+
+// ImageAlias is a current distribution image with the name it is known by.
+type ImageAlias struct {
+	Alias        string `json:"alias"`
+	Slug         string `json:"slug"`
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Distribution string `json:"distribution"`
+	Family       string `json:"family"`
+	Version      string `json:"version"`
+	LTS          bool   `json:"lts,omitempty"`
+	// Latest marks the newest version of its family, and LatestLTS the newest LTS release.
+	Latest    bool `json:"latest,omitempty"`
+	LatestLTS bool `json:"latest_lts,omitempty"`
+}
+
+// ResolvedImage is the result of image-resolve.
+type ResolvedImage struct {
+	Query string `json:"query"`
+	ImageAlias
+}
+
+// compareVersions orders dotted versions numerically, so 24.04 comes after 9.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		x, _ := strconv.Atoi(as[i])
+		y, _ := strconv.Atoi(bs[i])
+		if c := cmp.Compare(x, y); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(as), len(bs))
+}
+
+// hasVersionPrefix reports whether version starts with the components of prefix, e.g. 24.04 with 24.
+func hasVersionPrefix(version, prefix string) bool {
+	vs, ps := strings.Split(version, "."), strings.Split(prefix, ".")
+	if len(ps) > len(vs) {
+		return false
+	}
+	for i, p := range ps {
+		x, _ := strconv.Atoi(vs[i])
+		y, _ := strconv.Atoi(p)
+		if x != y {
+			return false
+		}
+	}
+	return true
+}
+
+// imageAliases names the x64 distribution images by family and version, newest first within
+// each family, and marks the latest ones.
+func imageAliases(images []godo.Image) []ImageAlias {
+	var aliases []ImageAlias
+	for _, image := range images {
+		m := distributionSlug.FindStringSubmatch(image.Slug)
+		if m == nil {
+			continue
+		}
+		aliases = append(aliases, ImageAlias{
+			Slug:         image.Slug,
+			ID:           image.ID,
+			Name:         image.Name,
+			Distribution: image.Distribution,
+			Family:       m[1],
+			Version:      strings.ReplaceAll(m[2], "-", "."),
+			LTS:          strings.Contains(image.Name, "LTS"),
+		})
+	}
+	slices.SortFunc(aliases, func(a, b ImageAlias) int {
+		return cmp.Or(cmp.Compare(a.Family, b.Family), compareVersions(b.Version, a.Version))
+	})
+	for i := range aliases {
+		a := &aliases[i]
+		a.Alias = a.Distribution + " " + a.Version
+		if a.Distribution == "" {
+			a.Alias = a.Family + " " + a.Version
+		}
+		a.Latest = i == 0 || aliases[i-1].Family != a.Family
+		a.LatestLTS = a.LTS && !slices.ContainsFunc(aliases[:i], func(b ImageAlias) bool { return b.Family == a.Family && b.LTS })
+	}
+	return aliases
+}
+
+// resolveImageAlias returns the image a human name stands for: a slug, "<distribution>
+// <version>" where the version may be partial, or "latest <distribution>", optionally with LTS.
+// Without a version the latest release matches.
+func resolveImageAlias(aliases []ImageAlias, query string) (*ImageAlias, error) {
+	q := strings.ToLower(strings.Join(strings.Fields(query), " "))
+	for _, a := range aliases {
+		if a.Slug == q {
+			return &a, nil
+		}
+	}
+
+	var lts bool
+	var version string
+	var words []string
+	for _, word := range strings.Fields(strings.NewReplacer("-", " ", "(", " ", ")", " ").Replace(q)) {
+		switch {
+		case word == "lts":
+			lts = true
+		case word == "latest" || word == "newest" || word == "current" || word == "x64":
+		case aliasVersion.MatchString(word) && version == "":
+			version = word
+		case aliasVersion.MatchString(word):
+			// the parts of a version written like a slug, e.g. 24 04.
+			version += "." + word
+		default:
+			words = append(words, word)
+		}
+	}
+	family, ok := familySynonyms[strings.Join(words, " ")]
+	if !ok {
+		var families []string
+		for _, a := range aliases {
+			if !slices.Contains(families, a.Family) {
+				families = append(families, a.Family)
+			}
+		}
+		return nil, fmt.Errorf("%q names no distribution; the distribution images are of %s", query, strings.Join(families, ", "))
+	}
+	// aliases are newest first, so the first match is the latest release matching the query.
+	for _, a := range aliases {
+		if a.Family == family && (!lts || a.LTS) && (version == "" || hasVersionPrefix(a.Version, version)) {
+			return &a, nil
+		}
+	}
+	return nil, fmt.Errorf("no current %s image matches %q; see %s for the current ones", family, query, ImageAliasesURI)
+}
+
+// ImageAliasCatalog maps human names of distribution images, such as "latest Ubuntu LTS" or
+// "Debian 12", to their current slugs and IDs. Distribution images are the same for every
+// account, so one list is kept for all callers and refreshed after imageAliasTTL.
+type ImageAliasCatalog struct {
+	client func(ctx context.Context) (*godo.Client, error)
+	now    func() time.Time
+
+	mu      sync.Mutex
+	aliases []ImageAlias
+	expires time.Time
+}
+
+// NewImageAliasCatalog creates a new image alias catalog
+func NewImageAliasCatalog(client func(ctx context.Context) (*godo.Client, error)) *ImageAliasCatalog {
+	return &ImageAliasCatalog{client: client, now: time.Now}
+}
+
+// list returns the aliases of the current distribution images.
+func (c *ImageAliasCatalog) list(ctx context.Context) ([]ImageAlias, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.aliases != nil && c.now().Before(c.expires) {
+		return c.aliases, nil
+	}
+	client, err := c.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	images, err := common.ListAll(ctx, resourcePageSize, client.Images.ListDistribution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list distribution images: %w", err)
+	}
+	c.aliases, c.expires = imageAliases(images), c.now().Add(imageAliasTTL)
+	return c.aliases, nil
+}
+
+func (c *ImageAliasCatalog) resolve(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	query := args.RequiredString("Name")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	aliases, err := c.list(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	alias, err := resolveImageAlias(aliases, query)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return common.NewToolResultStructured(ResolvedImage{Query: query, ImageAlias: *alias})
+}
+
+func (c *ImageAliasCatalog) readAliases(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	aliases, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return jsonContents(req.Params.URI, aliases)
+}
+
+// Tools returns the image-resolve tool.
+func (c *ImageAliasCatalog) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: c.resolve,
+			Tool: mcp.NewTool("image-resolve",
+				mcp.WithDescription("Resolve a human name of a distribution image, e.g. \"latest Ubuntu LTS\", \"Ubuntu 24.04\", \"Debian 12\" or \"latest Fedora\", to the slug and ID of the current image, to pass as ImageSlug or ImageID of droplet-create instead of remembering a slug that may have been retired. Without a version the latest release matches; a partial version such as \"Ubuntu 24\" matches its newest release."),
+				common.WithOutputSchema[ResolvedImage](),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the image, e.g. latest Ubuntu LTS, Debian 12, Rocky Linux 9 or an image slug")),
+			),
+		},
+	}
+}
+
+// Resources returns the image aliases resource.
+func (c *ImageAliasCatalog) Resources() []server.ServerResource {
+	return []server.ServerResource{
+		{
+			Resource: mcp.NewResource(ImageAliasesURI, "Image aliases",
+				mcp.WithResourceDescription("The current distribution images by name, e.g. Ubuntu 24.04, with their slug and ID, newest first per distribution, marking the latest and latest LTS releases."),
+				mcp.WithMIMEType("application/json"),
+			),
+			Handler: c.readAliases,
+		},
+	}
+}
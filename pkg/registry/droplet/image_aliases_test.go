@@ -0,0 +1,86 @@
+package droplet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var distributionImages = []godo.Image{
+	{ID: 1, Slug: "ubuntu-22-04-x64", Name: "22.04 (LTS) x64", Distribution: "Ubuntu"},
+	{ID: 2, Slug: "ubuntu-24-04-x64", Name: "24.04 (LTS) x64", Distribution: "Ubuntu"},
+	{ID: 3, Slug: "ubuntu-24-10-x64", Name: "24.10 x64", Distribution: "Ubuntu"},
+	{ID: 4, Slug: "debian-11-x64", Name: "11 x64", Distribution: "Debian"},
+	{ID: 5, Slug: "debian-12-x64", Name: "12 x64", Distribution: "Debian"},
+	{ID: 6, Slug: "centos-stream-9-x64", Name: "Stream 9 x64", Distribution: "CentOS"},
+	{ID: 7, Slug: "rockylinux-9-x64", Name: "9 x64", Distribution: "Rocky Linux"},
+	{ID: 8, Slug: "gpu-h100x1-base", Name: "AI/ML Ready", Distribution: "Ubuntu"},
+}
+
+func TestResolveImageAlias(t *testing.T) {
+	aliases := imageAliases(distributionImages)
+	for query, slug := range map[string]string{
+		"latest Ubuntu LTS":  "ubuntu-24-04-x64",
+		"Ubuntu LTS":         "ubuntu-24-04-x64",
+		"ubuntu":             "ubuntu-24-10-x64",
+		"latest ubuntu":      "ubuntu-24-10-x64",
+		"Ubuntu 22.04":       "ubuntu-22-04-x64",
+		"ubuntu 24":          "ubuntu-24-10-x64",
+		"Ubuntu 24.04 (LTS)": "ubuntu-24-04-x64",
+		"ubuntu-22-04":       "ubuntu-22-04-x64",
+		"Debian 12":          "debian-12-x64",
+		"debian":             "debian-12-x64",
+		"CentOS Stream 9":    "centos-stream-9-x64",
+		"rocky linux":        "rockylinux-9-x64",
+		"debian-11-x64":      "debian-11-x64",
+	} {
+		alias, err := resolveImageAlias(aliases, query)
+		require.NoError(t, err, query)
+		require.Equal(t, slug, alias.Slug, query)
+	}
+
+	_, err := resolveImageAlias(aliases, "Debian 10")
+	require.ErrorContains(t, err, "no current debian image")
+	_, err = resolveImageAlias(aliases, "Windows Server")
+	require.ErrorContains(t, err, "names no distribution")
+}
+
+func TestImageAliases(t *testing.T) {
+	aliases := imageAliases(distributionImages)
+	require.Len(t, aliases, 7)
+	require.Equal(t, ImageAlias{Alias: "Ubuntu 24.10", Slug: "ubuntu-24-10-x64", ID: 3, Name: "24.10 x64", Distribution: "Ubuntu", Family: "ubuntu", Version: "24.10", Latest: true}, aliases[4])
+	require.True(t, aliases[5].LatestLTS)
+	require.False(t, aliases[6].LatestLTS)
+}
+
+func TestImageAliasCatalog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	images := NewMockImagesService(ctrl)
+	images.EXPECT().ListDistribution(gomock.Any(), gomock.Any()).Return(distributionImages, &godo.Response{}, nil).Times(2)
+	catalog := NewImageAliasCatalog(func(ctx context.Context) (*godo.Client, error) { return &godo.Client{Images: images}, nil })
+	now := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+	catalog.now = func() time.Time { return now }
+
+	resolve := func(name string) *mcp.CallToolResult {
+		resp, err := catalog.resolve(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Name": name}}})
+		require.NoError(t, err)
+		return resp
+	}
+	resp := resolve("latest Ubuntu LTS")
+	require.False(t, resp.IsError)
+	require.Equal(t, 2, resp.StructuredContent.(ResolvedImage).ID)
+
+	// served from the catalog until it expires.
+	require.True(t, resolve("Fedora 41").IsError)
+	contents, err := catalog.readAliases(context.Background(), mcp.ReadResourceRequest{Params: mcp.ReadResourceParams{URI: ImageAliasesURI}})
+	require.NoError(t, err)
+	require.Contains(t, contents[0].(mcp.TextResourceContents).Text, `"alias": "Debian 12"`)
+
+	now = now.Add(imageAliasTTL)
+	require.False(t, resolve("debian").IsError)
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -93,27 +95,9 @@ func (i *ImageTool) listImages(ctx context.Context, req mcp.CallToolRequest) (*m
 
 // getImageByID retrieves a specific image by its numeric ID.
 func (i *ImageTool) getImageByID(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	id, ok := req.GetArguments()["ID"].(float64)
-	if !ok {
-		return mcp.NewToolResultError("Image ID is required"), nil
-	}
-
-	client, err := i.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	image, _, err := client.Images.GetByID(ctx, int(id))
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonData, err := json.MarshalIndent(image, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonData)), nil
+	return runOnID(ctx, i.client, common.NewArgs(req), "ID", func(ctx context.Context, client *godo.Client, id int) (*godo.Image, *godo.Response, error) {
+		return client.Images.GetByID(ctx, id)
+	})
 }
 
 // createImage creates a new custom image from a URL.
@@ -160,52 +144,25 @@ func (i *ImageTool) createImage(ctx context.Context, req mcp.CallToolRequest) (*
 		return mcp.NewToolResultErrorFromErr("api error", err), nil
 	}
 
-	jsonData, err := json.MarshalIndent(image, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonData)), nil
+	return common.NewToolResultStructured(image)
 }
 
 // updateImage updates an image's name.
 func (i *ImageTool) updateImage(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	id, ok := req.GetArguments()["ID"].(float64)
-	if !ok {
-		return mcp.NewToolResultError("Image ID is required"), nil
-	}
-	name, ok := req.GetArguments()["Name"].(string)
-	if !ok || name == "" {
-		return mcp.NewToolResultError("Name is required"), nil
-	}
-
-	client, err := i.client(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
-	}
-
-	updateReq := &godo.ImageUpdateRequest{
-		Name: name,
-	}
-
-	image, _, err := client.Images.Update(ctx, int(id), updateReq)
-	if err != nil {
-		return mcp.NewToolResultErrorFromErr("api error", err), nil
-	}
-
-	jsonData, err := json.MarshalIndent(image, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: %w", err)
-	}
-
-	return mcp.NewToolResultText(string(jsonData)), nil
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	return runOnID(ctx, i.client, args, "ID", func(ctx context.Context, client *godo.Client, id int) (*godo.Image, *godo.Response, error) {
+		return client.Images.Update(ctx, id, &godo.ImageUpdateRequest{Name: name})
+	})
 }
 
-// deleteImage deletes an image/snapshot by its numeric ID.
+// deleteImage deletes an image/snapshot by its numeric ID. Its result is a message rather than
+// the image, so it reads its arguments with common.Args like droplet-delete instead of runOnID.
 func (i *ImageTool) deleteImage(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	id, ok := req.GetArguments()["ID"].(float64)
-	if !ok {
-		return mcp.NewToolResultError("ID is required"), nil
+	args := common.NewArgs(req)
+	id := args.RequiredNumber("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	client, err := i.client(ctx)
@@ -229,9 +186,9 @@ func (i *ImageTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool(
 				"image-list",
 				mcp.WithDescription("List available images (snapshots, backups, distributions, applications)."),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultImagesPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultImagesPageSize), mcp.Description("Items per page")),
-				mcp.WithString("Type", mcp.Description("Filter by type: 'distribution', 'application', 'user' (snapshots/backups). If omitted, lists all.")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultImagesPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultImagesPageSize), mcp.Description("Items per page")),
+				mcp.WithString("Type", mcp.Enum("distribution", "application", "user"), mcp.Description("Filter by type: 'distribution', 'application', 'user' (snapshots/backups). If omitted, lists all.")),
 			),
 		},
 		{
@@ -239,6 +196,7 @@ func (i *ImageTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool(
 				"image-get",
 				mcp.WithDescription("Get a specific image by its numeric ID."),
+				common.WithOutputSchema[godo.Image](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("Image ID")),
 			),
 		},
@@ -247,6 +205,7 @@ func (i *ImageTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool(
 				"image-create",
 				mcp.WithDescription("Create a custom image from a URL (e.g. QCOW2, ISO)."),
+				common.WithOutputSchema[godo.Image](),
 				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the new image")),
 				mcp.WithString("Url", mcp.Required(), mcp.Description("URL to import the image from")),
 				mcp.WithString("Region", mcp.Required(), mcp.Description("Region slug (e.g. nyc3)")),
@@ -260,6 +219,7 @@ func (i *ImageTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool(
 				"image-update",
 				mcp.WithDescription("Update an image's name."),
+				common.WithOutputSchema[godo.Image](),
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("Image ID")),
 				mcp.WithString("Name", mcp.Required(), mcp.Description("New name for the image")),
 			),
@@ -0,0 +1,232 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mcp-digitalocean/internal/dryrun"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// MigrateRegionResult is the outcome, or with DryRun the plan, of droplet-migrate-region.
+type MigrateRegionResult struct {
+	DryRun          bool            `json:"dry_run,omitempty"`
+	Succeeded       bool            `json:"succeeded"`
+	SourceDropletID int             `json:"source_droplet_id"`
+	SourceRegion    string          `json:"source_region"`
+	Region          string          `json:"region"`
+	Size            string          `json:"size"`
+	SnapshotName    string          `json:"snapshot_name"`
+	SnapshotID      int             `json:"snapshot_id,omitempty"`
+	DropletID       int             `json:"droplet_id,omitempty"`
+	DropletName     string          `json:"droplet_name"`
+	PublicIPv4      string          `json:"public_ipv4,omitempty"`
+	ReservedIP      string          `json:"reserved_ip,omitempty"`
+	DomainRecordIDs []int           `json:"domain_record_ids,omitempty"`
+	Steps           []OperationStep `json:"steps"`
+	Message         string          `json:"message,omitempty"`
+	Error           string          `json:"error,omitempty"`
+}
+
+// planned records a step a dry run would take.
+func (c *cloneRun) planned(step, detail string) {
+	c.steps = append(c.steps, OperationStep{Step: step, Status: "planned", Detail: detail})
+}
+
+// repointRecords finds the A records named recordName in domain that point at ip, or every A
+// record of that name when ip is empty.
+func repointRecords(ctx context.Context, client *godo.Client, domain, recordName, ip string) ([]godo.DomainRecord, error) {
+	records, err := common.ListAll(ctx, dropletsPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+		return client.Domains.RecordsByType(ctx, domain, "A", opt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	var matched []godo.DomainRecord
+	for _, record := range records {
+		if record.Name == recordName && (ip == "" || record.Data == ip) {
+			matched = append(matched, record)
+		}
+	}
+	return matched, nil
+}
+
+// migrateRegion moves a droplet to another region: it snapshots the droplet, transfers the snapshot,
+// creates the replacement from it and optionally assigns a reserved IP to it and re-points DNS
+// records at it. The source droplet and the snapshot are kept. With DryRun, the lookups still run
+// and the result is the plan of the steps.
+func (dc *DropletCloneTool) migrateRegion(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	sourceID := args.RequiredNumber("ID")
+	region := args.RequiredString("Region")
+	name := args.String("Name")
+	size := args.String("Size")
+	snapshotName := args.String("SnapshotName")
+	reservedIP := args.String("ReservedIP")
+	domain := args.String("Domain")
+	recordName := args.String("RecordName")
+	timeoutSeconds := args.Number("TimeoutSeconds", defaultCloneTimeout)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if recordName != "" && domain == "" {
+		return mcp.NewToolResultError("RecordName requires Domain"), nil
+	}
+	if domain != "" && recordName == "" {
+		recordName = defaultProvisionRecord
+	}
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = defaultCloneTimeout
+	}
+
+	client, err := dc.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	source, _, err := client.Droplets.Get(ctx, int(sourceID))
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	sourceRegion := ""
+	if source.Region != nil {
+		sourceRegion = source.Region.Slug
+	}
+	if sourceRegion == region {
+		return mcp.NewToolResultError(fmt.Sprintf("droplet %d is already in region %s", source.ID, region)), nil
+	}
+	if name == "" {
+		name = source.Name
+	}
+	if size == "" {
+		size = source.SizeSlug
+	}
+	if snapshotName == "" {
+		snapshotName = fmt.Sprintf("%s-migrate-%d", source.Name, dc.now().Unix())
+	}
+
+	if reservedIP != "" {
+		rip, _, err := client.ReservedIPs.Get(ctx, reservedIP)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		// reserved IPs can only be assigned to droplets in their own region.
+		if rip.Region == nil || rip.Region.Slug != region {
+			ripRegion := ""
+			if rip.Region != nil {
+				ripRegion = rip.Region.Slug
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("reserved IP %s is in region %s and cannot be assigned to a droplet in %s; reserve an IP in %s instead",
+				reservedIP, ripRegion, region, region)), nil
+		}
+	}
+	var records []godo.DomainRecord
+	if domain != "" {
+		sourceIP, _ := source.PublicIPv4()
+		records, err = repointRecords(ctx, client, domain, recordName, sourceIP)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		if len(records) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("no A record %s in %s points at droplet %d (%s)", recordName, domain, source.ID, sourceIP)), nil
+		}
+	}
+
+	result := &MigrateRegionResult{
+		SourceDropletID: source.ID,
+		SourceRegion:    sourceRegion,
+		Region:          region,
+		Size:            size,
+		SnapshotName:    snapshotName,
+		DropletName:     name,
+		ReservedIP:      reservedIP,
+		Message:         fmt.Sprintf("droplet %d and the snapshot are kept; delete them once the replacement is verified", source.ID),
+	}
+	for _, record := range records {
+		result.DomainRecordIDs = append(result.DomainRecordIDs, record.ID)
+	}
+	run := &cloneRun{client: client, interval: dc.pollInterval}
+
+	if dryrun.Active(ctx) {
+		dryrun.Planned(ctx)
+		run.planned("snapshot", fmt.Sprintf("snapshot droplet %d as %q", source.ID, snapshotName))
+		run.planned("image transfer", fmt.Sprintf("transfer the snapshot from %s to %s", sourceRegion, region))
+		run.planned("droplet create", fmt.Sprintf("create %s (%s) in %s from the snapshot", name, size, region))
+		if reservedIP != "" {
+			run.planned("reserved ip assign", fmt.Sprintf("assign %s to the new droplet", reservedIP))
+		}
+		for _, record := range records {
+			run.planned("domain record update", fmt.Sprintf("point %s record %d (%s) at the new droplet", domain, record.ID, record.Data))
+		}
+		result.DryRun = true
+		result.Steps = run.steps
+		result.Message = "Dry run: no changes were made. " + result.Message
+		return common.NewToolResultStructured(result)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds*float64(time.Second)))
+	defer cancel()
+	err = func() error {
+		image, err := run.snapshot(ctx, source.ID, snapshotName)
+		if err != nil {
+			return err
+		}
+		result.SnapshotID = image.ID
+		if err := run.transfer(ctx, image, region); err != nil {
+			return err
+		}
+		droplet, err := run.create(ctx, cloneRequest(source, image, name, region, size))
+		if droplet != nil {
+			result.DropletID = droplet.ID
+		}
+		if err != nil {
+			return err
+		}
+		ip, err := droplet.PublicIPv4()
+		if err != nil {
+			return run.failed("droplet create", 0, err)
+		}
+		result.PublicIPv4 = ip
+
+		if reservedIP != "" {
+			action, _, err := client.ReservedIPActions.Assign(ctx, reservedIP, droplet.ID)
+			if err != nil {
+				return run.failed("reserved ip assign", 0, err)
+			}
+			if _, err := waitForAction(ctx, dc.pollInterval, func(ctx context.Context) (*godo.Action, *godo.Response, error) {
+				return client.ReservedIPActions.Get(ctx, reservedIP, action.ID)
+			}); err != nil {
+				return run.failed("reserved ip assign", action.ID, err)
+			}
+			run.done("reserved ip assign", action.ID, fmt.Sprintf("%s to droplet %d", reservedIP, droplet.ID))
+		}
+		for _, record := range records {
+			if _, _, err := client.Domains.EditRecord(ctx, domain, record.ID, &godo.DomainRecordEditRequest{
+				Type: record.Type,
+				Name: record.Name,
+				Data: ip,
+				TTL:  record.TTL,
+			}); err != nil {
+				return run.failed("domain record update", 0, err)
+			}
+			run.done("domain record update", 0, fmt.Sprintf("%s record %d from %s to %s", domain, record.ID, record.Data, ip))
+		}
+		return nil
+	}()
+	result.Steps = run.steps
+	if err == nil {
+		result.Succeeded = true
+		return common.NewToolResultStructured(result)
+	}
+	result.Error = err.Error()
+	res, err := common.NewToolResultStructured(result)
+	if err != nil {
+		return nil, err
+	}
+	res.IsError = true
+	return res, nil
+}
@@ -0,0 +1,187 @@
+package droplet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"mcp-digitalocean/internal/dryrun"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type migrateMocks struct {
+	droplets          *MockDropletsService
+	actions           *MockDropletActionsService
+	imageActions      *MockImageActionsService
+	reservedIPs       *MockReservedIPsService
+	reservedIPActions *MockReservedIPActionsService
+	domains           *MockDomainsService
+}
+
+func setupMigrateRegionToolWithMocks(m migrateMocks) *DropletCloneTool {
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Droplets:          m.droplets,
+			DropletActions:    m.actions,
+			ImageActions:      m.imageActions,
+			ReservedIPs:       m.reservedIPs,
+			ReservedIPActions: m.reservedIPActions,
+			Domains:           m.domains,
+		}, nil
+	}
+	tool := NewDropletCloneTool(client)
+	tool.pollInterval = time.Millisecond
+	tool.now = func() time.Time { return time.Unix(1700000000, 0) }
+	return tool
+}
+
+func TestDropletCloneTool_migrateRegion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	source := &godo.Droplet{
+		ID: 10, Name: "web", SizeSlug: "s-1vcpu-1gb", Region: &godo.Region{Slug: "nyc3"},
+		Networks: &godo.Networks{V4: []godo.NetworkV4{{IPAddress: "203.0.113.10", Type: "public"}}},
+	}
+	replacement := &godo.Droplet{
+		ID: 20, Name: "web", Status: "active",
+		Networks: &godo.Networks{V4: []godo.NetworkV4{{IPAddress: "198.51.100.20", Type: "public"}}},
+	}
+	lookups := func(m migrateMocks) {
+		m.droplets.EXPECT().Get(gomock.Any(), 10).Return(source, nil, nil)
+		m.reservedIPs.EXPECT().Get(gomock.Any(), "192.0.2.1").Return(&godo.ReservedIP{IP: "192.0.2.1", Region: &godo.Region{Slug: "ams3"}}, nil, nil)
+		m.domains.EXPECT().RecordsByType(gomock.Any(), "example.com", "A", gomock.Any()).Return([]godo.DomainRecord{
+			{ID: 1, Type: "A", Name: "www", Data: "203.0.113.10", TTL: 300},
+			{ID: 2, Type: "A", Name: "www", Data: "203.0.113.99", TTL: 300},
+			{ID: 3, Type: "A", Name: "@", Data: "203.0.113.10", TTL: 300},
+		}, nil, nil)
+	}
+	args := map[string]any{"ID": float64(10), "Region": "ams3", "ReservedIP": "192.0.2.1", "Domain": "example.com", "RecordName": "www"}
+
+	newMocks := func() migrateMocks {
+		return migrateMocks{
+			droplets:          NewMockDropletsService(ctrl),
+			actions:           NewMockDropletActionsService(ctrl),
+			imageActions:      NewMockImageActionsService(ctrl),
+			reservedIPs:       NewMockReservedIPsService(ctrl),
+			reservedIPActions: NewMockReservedIPActionsService(ctrl),
+			domains:           NewMockDomainsService(ctrl),
+		}
+	}
+	call := func(t *testing.T, m migrateMocks, handler func(*DropletCloneTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), args map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		tool := setupMigrateRegionToolWithMocks(m)
+		resp, err := handler(tool)(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "droplet-migrate-region", Arguments: args}})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		return resp
+	}
+	direct := func(tool *DropletCloneTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return tool.migrateRegion
+	}
+
+	t.Run("Migrates and re-points the IP and record", func(t *testing.T) {
+		m := newMocks()
+		lookups(m)
+		m.actions.EXPECT().Snapshot(gomock.Any(), 10, "web-migrate-1700000000").Return(&godo.Action{ID: 501}, nil, nil)
+		m.actions.EXPECT().Get(gomock.Any(), 10, 501).Return(&godo.Action{ID: 501, Status: "completed"}, nil, nil)
+		m.droplets.EXPECT().Snapshots(gomock.Any(), 10, gomock.Any()).Return([]godo.Image{{ID: 901, Name: "web-migrate-1700000000", Regions: []string{"nyc3"}}}, nil, nil)
+		m.imageActions.EXPECT().Transfer(gomock.Any(), 901, gomock.Any()).Return(&godo.Action{ID: 601}, nil, nil)
+		m.imageActions.EXPECT().Get(gomock.Any(), 901, 601).Return(&godo.Action{ID: 601, Status: "completed"}, nil, nil)
+		m.droplets.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *godo.DropletCreateRequest) (*godo.Droplet, *godo.Response, error) {
+			require.Equal(t, "ams3", req.Region)
+			require.Equal(t, "web", req.Name)
+			return &godo.Droplet{ID: 20, Status: "new"}, nil, nil
+		})
+		m.droplets.EXPECT().Get(gomock.Any(), 20).Return(replacement, nil, nil).Times(2)
+		m.reservedIPActions.EXPECT().Assign(gomock.Any(), "192.0.2.1", 20).Return(&godo.Action{ID: 701}, nil, nil)
+		m.reservedIPActions.EXPECT().Get(gomock.Any(), "192.0.2.1", 701).Return(&godo.Action{ID: 701, Status: "completed"}, nil, nil)
+		m.domains.EXPECT().EditRecord(gomock.Any(), "example.com", 1, &godo.DomainRecordEditRequest{
+			Type: "A", Name: "www", Data: "198.51.100.20", TTL: 300,
+		}).Return(&godo.DomainRecord{ID: 1}, nil, nil)
+
+		resp := call(t, m, direct, args)
+		require.False(t, resp.IsError)
+		var result MigrateRegionResult
+		require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+		require.True(t, result.Succeeded, result.Error)
+		require.Equal(t, 20, result.DropletID)
+		require.Equal(t, []int{1}, result.DomainRecordIDs)
+		var actionIDs []int
+		for _, step := range result.Steps {
+			actionIDs = append(actionIDs, step.ActionID)
+		}
+		require.Equal(t, []int{501, 601, 0, 701, 0}, actionIDs)
+	})
+
+	t.Run("Failed step is an error result", func(t *testing.T) {
+		m := newMocks()
+		lookups(m)
+		m.actions.EXPECT().Snapshot(gomock.Any(), 10, "web-migrate-1700000000").Return(nil, nil, errors.New("droplet is locked"))
+
+		resp := call(t, m, direct, args)
+		require.True(t, resp.IsError)
+		var result MigrateRegionResult
+		require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+		require.False(t, result.Succeeded)
+		require.Contains(t, result.Error, "droplet is locked")
+		require.Equal(t, "snapshot", result.Steps[0].Step)
+		require.Equal(t, "failed", result.Steps[0].Status)
+	})
+
+	t.Run("Dry run returns the plan", func(t *testing.T) {
+		m := newMocks()
+		lookups(m)
+		withDryRun := func(tool *DropletCloneTool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return dryrun.Middleware(tool.migrateRegion)
+		}
+		dryArgs := map[string]any{dryrun.Arg: true}
+		for k, v := range args {
+			dryArgs[k] = v
+		}
+
+		resp := call(t, m, withDryRun, dryArgs)
+		require.False(t, resp.IsError)
+		var result MigrateRegionResult
+		require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+		require.True(t, result.DryRun)
+		require.False(t, result.Succeeded)
+		var steps []string
+		for _, step := range result.Steps {
+			require.Equal(t, "planned", step.Status)
+			steps = append(steps, step.Step)
+		}
+		require.Equal(t, []string{"snapshot", "image transfer", "droplet create", "reserved ip assign", "domain record update"}, steps)
+	})
+
+	t.Run("Reserved IP in another region", func(t *testing.T) {
+		m := newMocks()
+		m.droplets.EXPECT().Get(gomock.Any(), 10).Return(source, nil, nil)
+		m.reservedIPs.EXPECT().Get(gomock.Any(), "192.0.2.1").Return(&godo.ReservedIP{IP: "192.0.2.1", Region: &godo.Region{Slug: "nyc3"}}, nil, nil)
+		resp := call(t, m, direct, args)
+		require.True(t, resp.IsError)
+	})
+
+	t.Run("Already in the region", func(t *testing.T) {
+		m := newMocks()
+		m.droplets.EXPECT().Get(gomock.Any(), 10).Return(source, nil, nil)
+		resp := call(t, m, direct, map[string]any{"ID": float64(10), "Region": "nyc3"})
+		require.True(t, resp.IsError)
+	})
+
+	t.Run("No record points at the droplet", func(t *testing.T) {
+		m := newMocks()
+		m.droplets.EXPECT().Get(gomock.Any(), 10).Return(source, nil, nil)
+		m.domains.EXPECT().RecordsByType(gomock.Any(), "example.com", "A", gomock.Any()).Return([]godo.DomainRecord{
+			{ID: 2, Type: "A", Name: "www", Data: "203.0.113.99"},
+		}, nil, nil)
+		resp := call(t, m, direct, map[string]any{"ID": float64(10), "Region": "ams3", "Domain": "example.com", "RecordName": "www"})
+		require.True(t, resp.IsError)
+	})
+}
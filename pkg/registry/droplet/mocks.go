@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/digitalocean/godo (interfaces: DropletsService,DropletActionsService,SizesService,ImagesService,ImageActionsService)
+// Source: github.com/digitalocean/godo (interfaces: DropletsService,DropletActionsService,SizesService,ImagesService,ImageActionsService,MonitoringService,RegionsService,FirewallsService,DomainsService,KeysService,ReservedIPsService,ReservedIPActionsService,StorageService,LoadBalancersService,SnapshotsService,ProjectsService,AccountService,VPCsService)
 //
 // Generated by this command:
 //
-//	mockgen -destination=./mocks.go -package droplet github.com/digitalocean/godo DropletsService,DropletActionsService,SizesService,ImagesService,ImageActionsService
+//	mockgen -destination=./mocks.go -package droplet github.com/digitalocean/godo DropletsService,DropletActionsService,SizesService,ImagesService,ImageActionsService,MonitoringService,RegionsService,FirewallsService,DomainsService,KeysService,ReservedIPsService,ReservedIPActionsService,StorageService,LoadBalancersService,SnapshotsService,ProjectsService,AccountService,VPCsService
 //
 
 // Package droplet is a generated GoMock package.
@@ -1141,3 +1141,2660 @@ func (mr *MockImageActionsServiceMockRecorder) Transfer(arg0, arg1, arg2 any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockImageActionsService)(nil).Transfer), arg0, arg1, arg2)
 }
+
+// MockMonitoringService is a mock of MonitoringService interface.
+type MockMonitoringService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMonitoringServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMonitoringServiceMockRecorder is the mock recorder for MockMonitoringService.
+type MockMonitoringServiceMockRecorder struct {
+	mock *MockMonitoringService
+}
+
+// NewMockMonitoringService creates a new mock instance.
+func NewMockMonitoringService(ctrl *gomock.Controller) *MockMonitoringService {
+	mock := &MockMonitoringService{ctrl: ctrl}
+	mock.recorder = &MockMonitoringServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMonitoringService) EXPECT() *MockMonitoringServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateAlertPolicy mocks base method.
+func (m *MockMonitoringService) CreateAlertPolicy(arg0 context.Context, arg1 *godo.AlertPolicyCreateRequest) (*godo.AlertPolicy, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*godo.AlertPolicy)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateAlertPolicy indicates an expected call of CreateAlertPolicy.
+func (mr *MockMonitoringServiceMockRecorder) CreateAlertPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertPolicy", reflect.TypeOf((*MockMonitoringService)(nil).CreateAlertPolicy), arg0, arg1)
+}
+
+// DeleteAlertPolicy mocks base method.
+func (m *MockMonitoringService) DeleteAlertPolicy(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAlertPolicy indicates an expected call of DeleteAlertPolicy.
+func (mr *MockMonitoringServiceMockRecorder) DeleteAlertPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertPolicy", reflect.TypeOf((*MockMonitoringService)(nil).DeleteAlertPolicy), arg0, arg1)
+}
+
+// GetAlertPolicy mocks base method.
+func (m *MockMonitoringService) GetAlertPolicy(arg0 context.Context, arg1 string) (*godo.AlertPolicy, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlertPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*godo.AlertPolicy)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAlertPolicy indicates an expected call of GetAlertPolicy.
+func (mr *MockMonitoringServiceMockRecorder) GetAlertPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertPolicy", reflect.TypeOf((*MockMonitoringService)(nil).GetAlertPolicy), arg0, arg1)
+}
+
+// GetDbaasMysqlCpuUsage mocks base method.
+func (m *MockMonitoringService) GetDbaasMysqlCpuUsage(ctx context.Context, args *godo.DbaasMysqlCpuUsageRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDbaasMysqlCpuUsage", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDbaasMysqlCpuUsage indicates an expected call of GetDbaasMysqlCpuUsage.
+func (mr *MockMonitoringServiceMockRecorder) GetDbaasMysqlCpuUsage(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDbaasMysqlCpuUsage", reflect.TypeOf((*MockMonitoringService)(nil).GetDbaasMysqlCpuUsage), ctx, args)
+}
+
+// GetDbaasMysqlDiskUsage mocks base method.
+func (m *MockMonitoringService) GetDbaasMysqlDiskUsage(ctx context.Context, args *godo.DbaasMysqlDiskUsageRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDbaasMysqlDiskUsage", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDbaasMysqlDiskUsage indicates an expected call of GetDbaasMysqlDiskUsage.
+func (mr *MockMonitoringServiceMockRecorder) GetDbaasMysqlDiskUsage(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDbaasMysqlDiskUsage", reflect.TypeOf((*MockMonitoringService)(nil).GetDbaasMysqlDiskUsage), ctx, args)
+}
+
+// GetDbaasMysqlIndexVsSequentialReads mocks base method.
+func (m *MockMonitoringService) GetDbaasMysqlIndexVsSequentialReads(ctx context.Context, args *godo.DbaasMysqlServiceMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDbaasMysqlIndexVsSequentialReads", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDbaasMysqlIndexVsSequentialReads indicates an expected call of GetDbaasMysqlIndexVsSequentialReads.
+func (mr *MockMonitoringServiceMockRecorder) GetDbaasMysqlIndexVsSequentialReads(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDbaasMysqlIndexVsSequentialReads", reflect.TypeOf((*MockMonitoringService)(nil).GetDbaasMysqlIndexVsSequentialReads), ctx, args)
+}
+
+// GetDbaasMysqlLoad mocks base method.
+func (m *MockMonitoringService) GetDbaasMysqlLoad(ctx context.Context, args *godo.DbaasMysqlLoadRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDbaasMysqlLoad", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDbaasMysqlLoad indicates an expected call of GetDbaasMysqlLoad.
+func (mr *MockMonitoringServiceMockRecorder) GetDbaasMysqlLoad(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDbaasMysqlLoad", reflect.TypeOf((*MockMonitoringService)(nil).GetDbaasMysqlLoad), ctx, args)
+}
+
+// GetDbaasMysqlMemoryUsage mocks base method.
+func (m *MockMonitoringService) GetDbaasMysqlMemoryUsage(ctx context.Context, args *godo.DbaasMysqlMemoryUsageRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDbaasMysqlMemoryUsage", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDbaasMysqlMemoryUsage indicates an expected call of GetDbaasMysqlMemoryUsage.
+func (mr *MockMonitoringServiceMockRecorder) GetDbaasMysqlMemoryUsage(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDbaasMysqlMemoryUsage", reflect.TypeOf((*MockMonitoringService)(nil).GetDbaasMysqlMemoryUsage), ctx, args)
+}
+
+// GetDbaasMysqlOpRates mocks base method.
+func (m *MockMonitoringService) GetDbaasMysqlOpRates(ctx context.Context, args *godo.DbaasMysqlOpRatesRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDbaasMysqlOpRates", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDbaasMysqlOpRates indicates an expected call of GetDbaasMysqlOpRates.
+func (mr *MockMonitoringServiceMockRecorder) GetDbaasMysqlOpRates(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDbaasMysqlOpRates", reflect.TypeOf((*MockMonitoringService)(nil).GetDbaasMysqlOpRates), ctx, args)
+}
+
+// GetDbaasMysqlSchemaLatency mocks base method.
+func (m *MockMonitoringService) GetDbaasMysqlSchemaLatency(ctx context.Context, args *godo.DbaasMysqlSchemaLatencyRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDbaasMysqlSchemaLatency", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDbaasMysqlSchemaLatency indicates an expected call of GetDbaasMysqlSchemaLatency.
+func (mr *MockMonitoringServiceMockRecorder) GetDbaasMysqlSchemaLatency(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDbaasMysqlSchemaLatency", reflect.TypeOf((*MockMonitoringService)(nil).GetDbaasMysqlSchemaLatency), ctx, args)
+}
+
+// GetDbaasMysqlSchemaThroughput mocks base method.
+func (m *MockMonitoringService) GetDbaasMysqlSchemaThroughput(ctx context.Context, args *godo.DbaasMysqlSchemaThroughputRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDbaasMysqlSchemaThroughput", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDbaasMysqlSchemaThroughput indicates an expected call of GetDbaasMysqlSchemaThroughput.
+func (mr *MockMonitoringServiceMockRecorder) GetDbaasMysqlSchemaThroughput(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDbaasMysqlSchemaThroughput", reflect.TypeOf((*MockMonitoringService)(nil).GetDbaasMysqlSchemaThroughput), ctx, args)
+}
+
+// GetDbaasMysqlThreadsActive mocks base method.
+func (m *MockMonitoringService) GetDbaasMysqlThreadsActive(ctx context.Context, args *godo.DbaasMysqlServiceMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDbaasMysqlThreadsActive", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDbaasMysqlThreadsActive indicates an expected call of GetDbaasMysqlThreadsActive.
+func (mr *MockMonitoringServiceMockRecorder) GetDbaasMysqlThreadsActive(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDbaasMysqlThreadsActive", reflect.TypeOf((*MockMonitoringService)(nil).GetDbaasMysqlThreadsActive), ctx, args)
+}
+
+// GetDbaasMysqlThreadsConnected mocks base method.
+func (m *MockMonitoringService) GetDbaasMysqlThreadsConnected(ctx context.Context, args *godo.DbaasMysqlServiceMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDbaasMysqlThreadsConnected", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDbaasMysqlThreadsConnected indicates an expected call of GetDbaasMysqlThreadsConnected.
+func (mr *MockMonitoringServiceMockRecorder) GetDbaasMysqlThreadsConnected(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDbaasMysqlThreadsConnected", reflect.TypeOf((*MockMonitoringService)(nil).GetDbaasMysqlThreadsConnected), ctx, args)
+}
+
+// GetDbaasMysqlThreadsCreatedRate mocks base method.
+func (m *MockMonitoringService) GetDbaasMysqlThreadsCreatedRate(ctx context.Context, args *godo.DbaasMysqlServiceMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDbaasMysqlThreadsCreatedRate", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDbaasMysqlThreadsCreatedRate indicates an expected call of GetDbaasMysqlThreadsCreatedRate.
+func (mr *MockMonitoringServiceMockRecorder) GetDbaasMysqlThreadsCreatedRate(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDbaasMysqlThreadsCreatedRate", reflect.TypeOf((*MockMonitoringService)(nil).GetDbaasMysqlThreadsCreatedRate), ctx, args)
+}
+
+// GetDropletAvailableMemory mocks base method.
+func (m *MockMonitoringService) GetDropletAvailableMemory(arg0 context.Context, arg1 *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletAvailableMemory", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDropletAvailableMemory indicates an expected call of GetDropletAvailableMemory.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletAvailableMemory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletAvailableMemory", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletAvailableMemory), arg0, arg1)
+}
+
+// GetDropletBandwidth mocks base method.
+func (m *MockMonitoringService) GetDropletBandwidth(arg0 context.Context, arg1 *godo.DropletBandwidthMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletBandwidth", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDropletBandwidth indicates an expected call of GetDropletBandwidth.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletBandwidth(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletBandwidth", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletBandwidth), arg0, arg1)
+}
+
+// GetDropletCPU mocks base method.
+func (m *MockMonitoringService) GetDropletCPU(arg0 context.Context, arg1 *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletCPU", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDropletCPU indicates an expected call of GetDropletCPU.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletCPU(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletCPU", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletCPU), arg0, arg1)
+}
+
+// GetDropletCachedMemory mocks base method.
+func (m *MockMonitoringService) GetDropletCachedMemory(arg0 context.Context, arg1 *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletCachedMemory", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDropletCachedMemory indicates an expected call of GetDropletCachedMemory.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletCachedMemory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletCachedMemory", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletCachedMemory), arg0, arg1)
+}
+
+// GetDropletFilesystemFree mocks base method.
+func (m *MockMonitoringService) GetDropletFilesystemFree(arg0 context.Context, arg1 *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletFilesystemFree", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDropletFilesystemFree indicates an expected call of GetDropletFilesystemFree.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletFilesystemFree(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletFilesystemFree", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletFilesystemFree), arg0, arg1)
+}
+
+// GetDropletFilesystemSize mocks base method.
+func (m *MockMonitoringService) GetDropletFilesystemSize(arg0 context.Context, arg1 *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletFilesystemSize", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDropletFilesystemSize indicates an expected call of GetDropletFilesystemSize.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletFilesystemSize(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletFilesystemSize", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletFilesystemSize), arg0, arg1)
+}
+
+// GetDropletFreeMemory mocks base method.
+func (m *MockMonitoringService) GetDropletFreeMemory(arg0 context.Context, arg1 *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletFreeMemory", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDropletFreeMemory indicates an expected call of GetDropletFreeMemory.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletFreeMemory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletFreeMemory", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletFreeMemory), arg0, arg1)
+}
+
+// GetDropletLoad1 mocks base method.
+func (m *MockMonitoringService) GetDropletLoad1(arg0 context.Context, arg1 *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletLoad1", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDropletLoad1 indicates an expected call of GetDropletLoad1.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletLoad1(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletLoad1", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletLoad1), arg0, arg1)
+}
+
+// GetDropletLoad15 mocks base method.
+func (m *MockMonitoringService) GetDropletLoad15(arg0 context.Context, arg1 *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletLoad15", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDropletLoad15 indicates an expected call of GetDropletLoad15.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletLoad15(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletLoad15", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletLoad15), arg0, arg1)
+}
+
+// GetDropletLoad5 mocks base method.
+func (m *MockMonitoringService) GetDropletLoad5(arg0 context.Context, arg1 *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletLoad5", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDropletLoad5 indicates an expected call of GetDropletLoad5.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletLoad5(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletLoad5", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletLoad5), arg0, arg1)
+}
+
+// GetDropletTotalMemory mocks base method.
+func (m *MockMonitoringService) GetDropletTotalMemory(arg0 context.Context, arg1 *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletTotalMemory", arg0, arg1)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDropletTotalMemory indicates an expected call of GetDropletTotalMemory.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletTotalMemory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletTotalMemory", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletTotalMemory), arg0, arg1)
+}
+
+// GetLoadBalancerDropletsConnections mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsConnections(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsConnections", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerDropletsConnections indicates an expected call of GetLoadBalancerDropletsConnections.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsConnections(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsConnections", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsConnections), ctx, args)
+}
+
+// GetLoadBalancerDropletsDowntime mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsDowntime(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsDowntime", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerDropletsDowntime indicates an expected call of GetLoadBalancerDropletsDowntime.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsDowntime(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsDowntime", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsDowntime), ctx, args)
+}
+
+// GetLoadBalancerDropletsHealthChecks mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsHealthChecks(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsHealthChecks", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerDropletsHealthChecks indicates an expected call of GetLoadBalancerDropletsHealthChecks.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsHealthChecks(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsHealthChecks", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsHealthChecks), ctx, args)
+}
+
+// GetLoadBalancerDropletsHttpResponseTime50P mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsHttpResponseTime50P(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsHttpResponseTime50P", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerDropletsHttpResponseTime50P indicates an expected call of GetLoadBalancerDropletsHttpResponseTime50P.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsHttpResponseTime50P(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsHttpResponseTime50P", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsHttpResponseTime50P), ctx, args)
+}
+
+// GetLoadBalancerDropletsHttpResponseTime95P mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsHttpResponseTime95P(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsHttpResponseTime95P", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerDropletsHttpResponseTime95P indicates an expected call of GetLoadBalancerDropletsHttpResponseTime95P.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsHttpResponseTime95P(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsHttpResponseTime95P", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsHttpResponseTime95P), ctx, args)
+}
+
+// GetLoadBalancerDropletsHttpResponseTime99P mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsHttpResponseTime99P(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsHttpResponseTime99P", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerDropletsHttpResponseTime99P indicates an expected call of GetLoadBalancerDropletsHttpResponseTime99P.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsHttpResponseTime99P(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsHttpResponseTime99P", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsHttpResponseTime99P), ctx, args)
+}
+
+// GetLoadBalancerDropletsHttpResponseTimeAvg mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsHttpResponseTimeAvg(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsHttpResponseTimeAvg", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerDropletsHttpResponseTimeAvg indicates an expected call of GetLoadBalancerDropletsHttpResponseTimeAvg.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsHttpResponseTimeAvg(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsHttpResponseTimeAvg", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsHttpResponseTimeAvg), ctx, args)
+}
+
+// GetLoadBalancerDropletsHttpResponses mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsHttpResponses(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsHttpResponses", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerDropletsHttpResponses indicates an expected call of GetLoadBalancerDropletsHttpResponses.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsHttpResponses(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsHttpResponses", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsHttpResponses), ctx, args)
+}
+
+// GetLoadBalancerDropletsHttpSessionDuration50P mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsHttpSessionDuration50P(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsHttpSessionDuration50P", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerDropletsHttpSessionDuration50P indicates an expected call of GetLoadBalancerDropletsHttpSessionDuration50P.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsHttpSessionDuration50P(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsHttpSessionDuration50P", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsHttpSessionDuration50P), ctx, args)
+}
+
+// GetLoadBalancerDropletsHttpSessionDuration95P mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsHttpSessionDuration95P(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsHttpSessionDuration95P", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerDropletsHttpSessionDuration95P indicates an expected call of GetLoadBalancerDropletsHttpSessionDuration95P.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsHttpSessionDuration95P(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsHttpSessionDuration95P", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsHttpSessionDuration95P), ctx, args)
+}
+
+// GetLoadBalancerDropletsHttpSessionDurationAvg mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsHttpSessionDurationAvg(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsHttpSessionDurationAvg", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerDropletsHttpSessionDurationAvg indicates an expected call of GetLoadBalancerDropletsHttpSessionDurationAvg.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsHttpSessionDurationAvg(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsHttpSessionDurationAvg", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsHttpSessionDurationAvg), ctx, args)
+}
+
+// GetLoadBalancerDropletsQueueSize mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsQueueSize(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsQueueSize", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerDropletsQueueSize indicates an expected call of GetLoadBalancerDropletsQueueSize.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsQueueSize(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsQueueSize", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsQueueSize), ctx, args)
+}
+
+// GetLoadBalancerFrontendConnectionsCurrent mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendConnectionsCurrent(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendConnectionsCurrent", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendConnectionsCurrent indicates an expected call of GetLoadBalancerFrontendConnectionsCurrent.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendConnectionsCurrent(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendConnectionsCurrent", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendConnectionsCurrent), ctx, args)
+}
+
+// GetLoadBalancerFrontendConnectionsLimit mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendConnectionsLimit(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendConnectionsLimit", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendConnectionsLimit indicates an expected call of GetLoadBalancerFrontendConnectionsLimit.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendConnectionsLimit(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendConnectionsLimit", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendConnectionsLimit), ctx, args)
+}
+
+// GetLoadBalancerFrontendCpuUtilization mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendCpuUtilization(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendCpuUtilization", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendCpuUtilization indicates an expected call of GetLoadBalancerFrontendCpuUtilization.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendCpuUtilization(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendCpuUtilization", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendCpuUtilization), ctx, args)
+}
+
+// GetLoadBalancerFrontendFirewallDroppedBytes mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendFirewallDroppedBytes(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendFirewallDroppedBytes", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendFirewallDroppedBytes indicates an expected call of GetLoadBalancerFrontendFirewallDroppedBytes.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendFirewallDroppedBytes(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendFirewallDroppedBytes", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendFirewallDroppedBytes), ctx, args)
+}
+
+// GetLoadBalancerFrontendFirewallDroppedPackets mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendFirewallDroppedPackets(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendFirewallDroppedPackets", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendFirewallDroppedPackets indicates an expected call of GetLoadBalancerFrontendFirewallDroppedPackets.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendFirewallDroppedPackets(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendFirewallDroppedPackets", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendFirewallDroppedPackets), ctx, args)
+}
+
+// GetLoadBalancerFrontendHttpRequestsPerSecond mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendHttpRequestsPerSecond(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendHttpRequestsPerSecond", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendHttpRequestsPerSecond indicates an expected call of GetLoadBalancerFrontendHttpRequestsPerSecond.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendHttpRequestsPerSecond(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendHttpRequestsPerSecond", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendHttpRequestsPerSecond), ctx, args)
+}
+
+// GetLoadBalancerFrontendHttpResponses mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendHttpResponses(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendHttpResponses", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendHttpResponses indicates an expected call of GetLoadBalancerFrontendHttpResponses.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendHttpResponses(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendHttpResponses", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendHttpResponses), ctx, args)
+}
+
+// GetLoadBalancerFrontendNetworkThroughputHttp mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendNetworkThroughputHttp(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendNetworkThroughputHttp", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendNetworkThroughputHttp indicates an expected call of GetLoadBalancerFrontendNetworkThroughputHttp.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendNetworkThroughputHttp(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendNetworkThroughputHttp", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendNetworkThroughputHttp), ctx, args)
+}
+
+// GetLoadBalancerFrontendNetworkThroughputTcp mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendNetworkThroughputTcp(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendNetworkThroughputTcp", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendNetworkThroughputTcp indicates an expected call of GetLoadBalancerFrontendNetworkThroughputTcp.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendNetworkThroughputTcp(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendNetworkThroughputTcp", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendNetworkThroughputTcp), ctx, args)
+}
+
+// GetLoadBalancerFrontendNetworkThroughputUdp mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendNetworkThroughputUdp(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendNetworkThroughputUdp", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendNetworkThroughputUdp indicates an expected call of GetLoadBalancerFrontendNetworkThroughputUdp.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendNetworkThroughputUdp(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendNetworkThroughputUdp", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendNetworkThroughputUdp), ctx, args)
+}
+
+// GetLoadBalancerFrontendNlbTcpNetworkThroughput mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendNlbTcpNetworkThroughput(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendNlbTcpNetworkThroughput", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendNlbTcpNetworkThroughput indicates an expected call of GetLoadBalancerFrontendNlbTcpNetworkThroughput.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendNlbTcpNetworkThroughput(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendNlbTcpNetworkThroughput", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendNlbTcpNetworkThroughput), ctx, args)
+}
+
+// GetLoadBalancerFrontendNlbUdpNetworkThroughput mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendNlbUdpNetworkThroughput(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendNlbUdpNetworkThroughput", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendNlbUdpNetworkThroughput indicates an expected call of GetLoadBalancerFrontendNlbUdpNetworkThroughput.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendNlbUdpNetworkThroughput(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendNlbUdpNetworkThroughput", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendNlbUdpNetworkThroughput), ctx, args)
+}
+
+// GetLoadBalancerFrontendTlsConnectionsCurrent mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendTlsConnectionsCurrent(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendTlsConnectionsCurrent", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendTlsConnectionsCurrent indicates an expected call of GetLoadBalancerFrontendTlsConnectionsCurrent.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendTlsConnectionsCurrent(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendTlsConnectionsCurrent", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendTlsConnectionsCurrent), ctx, args)
+}
+
+// GetLoadBalancerFrontendTlsConnectionsExceedingRateLimit mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendTlsConnectionsExceedingRateLimit(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendTlsConnectionsExceedingRateLimit", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendTlsConnectionsExceedingRateLimit indicates an expected call of GetLoadBalancerFrontendTlsConnectionsExceedingRateLimit.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendTlsConnectionsExceedingRateLimit(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendTlsConnectionsExceedingRateLimit", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendTlsConnectionsExceedingRateLimit), ctx, args)
+}
+
+// GetLoadBalancerFrontendTlsConnectionsLimit mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendTlsConnectionsLimit(ctx context.Context, args *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendTlsConnectionsLimit", ctx, args)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerFrontendTlsConnectionsLimit indicates an expected call of GetLoadBalancerFrontendTlsConnectionsLimit.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendTlsConnectionsLimit(ctx, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendTlsConnectionsLimit", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendTlsConnectionsLimit), ctx, args)
+}
+
+// ListAlertPolicies mocks base method.
+func (m *MockMonitoringService) ListAlertPolicies(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.AlertPolicy, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertPolicies", arg0, arg1)
+	ret0, _ := ret[0].([]godo.AlertPolicy)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAlertPolicies indicates an expected call of ListAlertPolicies.
+func (mr *MockMonitoringServiceMockRecorder) ListAlertPolicies(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertPolicies", reflect.TypeOf((*MockMonitoringService)(nil).ListAlertPolicies), arg0, arg1)
+}
+
+// UpdateAlertPolicy mocks base method.
+func (m *MockMonitoringService) UpdateAlertPolicy(arg0 context.Context, arg1 string, arg2 *godo.AlertPolicyUpdateRequest) (*godo.AlertPolicy, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlertPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.AlertPolicy)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateAlertPolicy indicates an expected call of UpdateAlertPolicy.
+func (mr *MockMonitoringServiceMockRecorder) UpdateAlertPolicy(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertPolicy", reflect.TypeOf((*MockMonitoringService)(nil).UpdateAlertPolicy), arg0, arg1, arg2)
+}
+
+// MockRegionsService is a mock of RegionsService interface.
+type MockRegionsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegionsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRegionsServiceMockRecorder is the mock recorder for MockRegionsService.
+type MockRegionsServiceMockRecorder struct {
+	mock *MockRegionsService
+}
+
+// NewMockRegionsService creates a new mock instance.
+func NewMockRegionsService(ctrl *gomock.Controller) *MockRegionsService {
+	mock := &MockRegionsService{ctrl: ctrl}
+	mock.recorder = &MockRegionsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegionsService) EXPECT() *MockRegionsServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockRegionsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Region, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Region)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockRegionsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRegionsService)(nil).List), arg0, arg1)
+}
+
+// MockFirewallsService is a mock of FirewallsService interface.
+type MockFirewallsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFirewallsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockFirewallsServiceMockRecorder is the mock recorder for MockFirewallsService.
+type MockFirewallsServiceMockRecorder struct {
+	mock *MockFirewallsService
+}
+
+// NewMockFirewallsService creates a new mock instance.
+func NewMockFirewallsService(ctrl *gomock.Controller) *MockFirewallsService {
+	mock := &MockFirewallsService{ctrl: ctrl}
+	mock.recorder = &MockFirewallsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFirewallsService) EXPECT() *MockFirewallsServiceMockRecorder {
+	return m.recorder
+}
+
+// AddDroplets mocks base method.
+func (m *MockFirewallsService) AddDroplets(arg0 context.Context, arg1 string, arg2 ...int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddDroplets", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDroplets indicates an expected call of AddDroplets.
+func (mr *MockFirewallsServiceMockRecorder) AddDroplets(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDroplets", reflect.TypeOf((*MockFirewallsService)(nil).AddDroplets), varargs...)
+}
+
+// AddRules mocks base method.
+func (m *MockFirewallsService) AddRules(arg0 context.Context, arg1 string, arg2 *godo.FirewallRulesRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRules", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRules indicates an expected call of AddRules.
+func (mr *MockFirewallsServiceMockRecorder) AddRules(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRules", reflect.TypeOf((*MockFirewallsService)(nil).AddRules), arg0, arg1, arg2)
+}
+
+// AddTags mocks base method.
+func (m *MockFirewallsService) AddTags(arg0 context.Context, arg1 string, arg2 ...string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddTags", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTags indicates an expected call of AddTags.
+func (mr *MockFirewallsServiceMockRecorder) AddTags(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockFirewallsService)(nil).AddTags), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockFirewallsService) Create(arg0 context.Context, arg1 *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Firewall)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFirewallsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFirewallsService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockFirewallsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFirewallsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFirewallsService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockFirewallsService) Get(arg0 context.Context, arg1 string) (*godo.Firewall, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Firewall)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFirewallsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFirewallsService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockFirewallsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Firewall, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Firewall)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockFirewallsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFirewallsService)(nil).List), arg0, arg1)
+}
+
+// ListByDroplet mocks base method.
+func (m *MockFirewallsService) ListByDroplet(arg0 context.Context, arg1 int, arg2 *godo.ListOptions) ([]godo.Firewall, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByDroplet", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Firewall)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByDroplet indicates an expected call of ListByDroplet.
+func (mr *MockFirewallsServiceMockRecorder) ListByDroplet(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDroplet", reflect.TypeOf((*MockFirewallsService)(nil).ListByDroplet), arg0, arg1, arg2)
+}
+
+// RemoveDroplets mocks base method.
+func (m *MockFirewallsService) RemoveDroplets(arg0 context.Context, arg1 string, arg2 ...int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveDroplets", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDroplets indicates an expected call of RemoveDroplets.
+func (mr *MockFirewallsServiceMockRecorder) RemoveDroplets(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDroplets", reflect.TypeOf((*MockFirewallsService)(nil).RemoveDroplets), varargs...)
+}
+
+// RemoveRules mocks base method.
+func (m *MockFirewallsService) RemoveRules(arg0 context.Context, arg1 string, arg2 *godo.FirewallRulesRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRules", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRules indicates an expected call of RemoveRules.
+func (mr *MockFirewallsServiceMockRecorder) RemoveRules(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRules", reflect.TypeOf((*MockFirewallsService)(nil).RemoveRules), arg0, arg1, arg2)
+}
+
+// RemoveTags mocks base method.
+func (m *MockFirewallsService) RemoveTags(arg0 context.Context, arg1 string, arg2 ...string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveTags", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTags indicates an expected call of RemoveTags.
+func (mr *MockFirewallsServiceMockRecorder) RemoveTags(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTags", reflect.TypeOf((*MockFirewallsService)(nil).RemoveTags), varargs...)
+}
+
+// Update mocks base method.
+func (m *MockFirewallsService) Update(arg0 context.Context, arg1 string, arg2 *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Firewall)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockFirewallsServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFirewallsService)(nil).Update), arg0, arg1, arg2)
+}
+
+// MockDomainsService is a mock of DomainsService interface.
+type MockDomainsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainsServiceMockRecorder is the mock recorder for MockDomainsService.
+type MockDomainsServiceMockRecorder struct {
+	mock *MockDomainsService
+}
+
+// NewMockDomainsService creates a new mock instance.
+func NewMockDomainsService(ctrl *gomock.Controller) *MockDomainsService {
+	mock := &MockDomainsService{ctrl: ctrl}
+	mock.recorder = &MockDomainsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainsService) EXPECT() *MockDomainsServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDomainsService) Create(arg0 context.Context, arg1 *godo.DomainCreateRequest) (*godo.Domain, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Domain)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDomainsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDomainsService)(nil).Create), arg0, arg1)
+}
+
+// CreateRecord mocks base method.
+func (m *MockDomainsService) CreateRecord(arg0 context.Context, arg1 string, arg2 *godo.DomainRecordEditRequest) (*godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecord", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateRecord indicates an expected call of CreateRecord.
+func (mr *MockDomainsServiceMockRecorder) CreateRecord(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecord", reflect.TypeOf((*MockDomainsService)(nil).CreateRecord), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockDomainsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDomainsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDomainsService)(nil).Delete), arg0, arg1)
+}
+
+// DeleteRecord mocks base method.
+func (m *MockDomainsService) DeleteRecord(arg0 context.Context, arg1 string, arg2 int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecord", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRecord indicates an expected call of DeleteRecord.
+func (mr *MockDomainsServiceMockRecorder) DeleteRecord(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecord", reflect.TypeOf((*MockDomainsService)(nil).DeleteRecord), arg0, arg1, arg2)
+}
+
+// EditRecord mocks base method.
+func (m *MockDomainsService) EditRecord(arg0 context.Context, arg1 string, arg2 int, arg3 *godo.DomainRecordEditRequest) (*godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditRecord", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EditRecord indicates an expected call of EditRecord.
+func (mr *MockDomainsServiceMockRecorder) EditRecord(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditRecord", reflect.TypeOf((*MockDomainsService)(nil).EditRecord), arg0, arg1, arg2, arg3)
+}
+
+// Get mocks base method.
+func (m *MockDomainsService) Get(arg0 context.Context, arg1 string) (*godo.Domain, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Domain)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDomainsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDomainsService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockDomainsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Domain, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Domain)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockDomainsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDomainsService)(nil).List), arg0, arg1)
+}
+
+// Record mocks base method.
+func (m *MockDomainsService) Record(arg0 context.Context, arg1 string, arg2 int) (*godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockDomainsServiceMockRecorder) Record(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockDomainsService)(nil).Record), arg0, arg1, arg2)
+}
+
+// Records mocks base method.
+func (m *MockDomainsService) Records(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Records", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Records indicates an expected call of Records.
+func (mr *MockDomainsServiceMockRecorder) Records(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Records", reflect.TypeOf((*MockDomainsService)(nil).Records), arg0, arg1, arg2)
+}
+
+// RecordsByName mocks base method.
+func (m *MockDomainsService) RecordsByName(arg0 context.Context, arg1, arg2 string, arg3 *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordsByName", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RecordsByName indicates an expected call of RecordsByName.
+func (mr *MockDomainsServiceMockRecorder) RecordsByName(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordsByName", reflect.TypeOf((*MockDomainsService)(nil).RecordsByName), arg0, arg1, arg2, arg3)
+}
+
+// RecordsByType mocks base method.
+func (m *MockDomainsService) RecordsByType(arg0 context.Context, arg1, arg2 string, arg3 *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordsByType", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RecordsByType indicates an expected call of RecordsByType.
+func (mr *MockDomainsServiceMockRecorder) RecordsByType(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordsByType", reflect.TypeOf((*MockDomainsService)(nil).RecordsByType), arg0, arg1, arg2, arg3)
+}
+
+// RecordsByTypeAndName mocks base method.
+func (m *MockDomainsService) RecordsByTypeAndName(arg0 context.Context, arg1, arg2, arg3 string, arg4 *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordsByTypeAndName", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RecordsByTypeAndName indicates an expected call of RecordsByTypeAndName.
+func (mr *MockDomainsServiceMockRecorder) RecordsByTypeAndName(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordsByTypeAndName", reflect.TypeOf((*MockDomainsService)(nil).RecordsByTypeAndName), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MockKeysService is a mock of KeysService interface.
+type MockKeysService struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeysServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockKeysServiceMockRecorder is the mock recorder for MockKeysService.
+type MockKeysServiceMockRecorder struct {
+	mock *MockKeysService
+}
+
+// NewMockKeysService creates a new mock instance.
+func NewMockKeysService(ctrl *gomock.Controller) *MockKeysService {
+	mock := &MockKeysService{ctrl: ctrl}
+	mock.recorder = &MockKeysServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeysService) EXPECT() *MockKeysServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockKeysService) Create(arg0 context.Context, arg1 *godo.KeyCreateRequest) (*godo.Key, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Key)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockKeysServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKeysService)(nil).Create), arg0, arg1)
+}
+
+// DeleteByFingerprint mocks base method.
+func (m *MockKeysService) DeleteByFingerprint(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByFingerprint", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByFingerprint indicates an expected call of DeleteByFingerprint.
+func (mr *MockKeysServiceMockRecorder) DeleteByFingerprint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByFingerprint", reflect.TypeOf((*MockKeysService)(nil).DeleteByFingerprint), arg0, arg1)
+}
+
+// DeleteByID mocks base method.
+func (m *MockKeysService) DeleteByID(arg0 context.Context, arg1 int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockKeysServiceMockRecorder) DeleteByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockKeysService)(nil).DeleteByID), arg0, arg1)
+}
+
+// GetByFingerprint mocks base method.
+func (m *MockKeysService) GetByFingerprint(arg0 context.Context, arg1 string) (*godo.Key, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByFingerprint", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Key)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByFingerprint indicates an expected call of GetByFingerprint.
+func (mr *MockKeysServiceMockRecorder) GetByFingerprint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByFingerprint", reflect.TypeOf((*MockKeysService)(nil).GetByFingerprint), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockKeysService) GetByID(arg0 context.Context, arg1 int) (*godo.Key, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Key)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockKeysServiceMockRecorder) GetByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockKeysService)(nil).GetByID), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockKeysService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Key, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Key)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockKeysServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKeysService)(nil).List), arg0, arg1)
+}
+
+// UpdateByFingerprint mocks base method.
+func (m *MockKeysService) UpdateByFingerprint(arg0 context.Context, arg1 string, arg2 *godo.KeyUpdateRequest) (*godo.Key, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByFingerprint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Key)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateByFingerprint indicates an expected call of UpdateByFingerprint.
+func (mr *MockKeysServiceMockRecorder) UpdateByFingerprint(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByFingerprint", reflect.TypeOf((*MockKeysService)(nil).UpdateByFingerprint), arg0, arg1, arg2)
+}
+
+// UpdateByID mocks base method.
+func (m *MockKeysService) UpdateByID(arg0 context.Context, arg1 int, arg2 *godo.KeyUpdateRequest) (*godo.Key, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Key)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateByID indicates an expected call of UpdateByID.
+func (mr *MockKeysServiceMockRecorder) UpdateByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MockKeysService)(nil).UpdateByID), arg0, arg1, arg2)
+}
+
+// MockReservedIPsService is a mock of ReservedIPsService interface.
+type MockReservedIPsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservedIPsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockReservedIPsServiceMockRecorder is the mock recorder for MockReservedIPsService.
+type MockReservedIPsServiceMockRecorder struct {
+	mock *MockReservedIPsService
+}
+
+// NewMockReservedIPsService creates a new mock instance.
+func NewMockReservedIPsService(ctrl *gomock.Controller) *MockReservedIPsService {
+	mock := &MockReservedIPsService{ctrl: ctrl}
+	mock.recorder = &MockReservedIPsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservedIPsService) EXPECT() *MockReservedIPsServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReservedIPsService) Create(arg0 context.Context, arg1 *godo.ReservedIPCreateRequest) (*godo.ReservedIP, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.ReservedIP)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReservedIPsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReservedIPsService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockReservedIPsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReservedIPsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReservedIPsService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockReservedIPsService) Get(arg0 context.Context, arg1 string) (*godo.ReservedIP, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.ReservedIP)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReservedIPsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReservedIPsService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockReservedIPsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.ReservedIP, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.ReservedIP)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockReservedIPsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservedIPsService)(nil).List), arg0, arg1)
+}
+
+// MockReservedIPActionsService is a mock of ReservedIPActionsService interface.
+type MockReservedIPActionsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservedIPActionsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockReservedIPActionsServiceMockRecorder is the mock recorder for MockReservedIPActionsService.
+type MockReservedIPActionsServiceMockRecorder struct {
+	mock *MockReservedIPActionsService
+}
+
+// NewMockReservedIPActionsService creates a new mock instance.
+func NewMockReservedIPActionsService(ctrl *gomock.Controller) *MockReservedIPActionsService {
+	mock := &MockReservedIPActionsService{ctrl: ctrl}
+	mock.recorder = &MockReservedIPActionsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservedIPActionsService) EXPECT() *MockReservedIPActionsServiceMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockReservedIPActionsService) Assign(ctx context.Context, ip string, dropletID int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, ip, dropletID)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockReservedIPActionsServiceMockRecorder) Assign(ctx, ip, dropletID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockReservedIPActionsService)(nil).Assign), ctx, ip, dropletID)
+}
+
+// Get mocks base method.
+func (m *MockReservedIPActionsService) Get(ctx context.Context, ip string, actionID int) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, ip, actionID)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReservedIPActionsServiceMockRecorder) Get(ctx, ip, actionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReservedIPActionsService)(nil).Get), ctx, ip, actionID)
+}
+
+// List mocks base method.
+func (m *MockReservedIPActionsService) List(ctx context.Context, ip string, opt *godo.ListOptions) ([]godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, ip, opt)
+	ret0, _ := ret[0].([]godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockReservedIPActionsServiceMockRecorder) List(ctx, ip, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservedIPActionsService)(nil).List), ctx, ip, opt)
+}
+
+// Unassign mocks base method.
+func (m *MockReservedIPActionsService) Unassign(ctx context.Context, ip string) (*godo.Action, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, ip)
+	ret0, _ := ret[0].(*godo.Action)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockReservedIPActionsServiceMockRecorder) Unassign(ctx, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockReservedIPActionsService)(nil).Unassign), ctx, ip)
+}
+
+// MockStorageService is a mock of StorageService interface.
+type MockStorageService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageServiceMockRecorder is the mock recorder for MockStorageService.
+type MockStorageServiceMockRecorder struct {
+	mock *MockStorageService
+}
+
+// NewMockStorageService creates a new mock instance.
+func NewMockStorageService(ctrl *gomock.Controller) *MockStorageService {
+	mock := &MockStorageService{ctrl: ctrl}
+	mock.recorder = &MockStorageServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageService) EXPECT() *MockStorageServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockStorageService) CreateSnapshot(arg0 context.Context, arg1 *godo.SnapshotCreateRequest) (*godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockStorageServiceMockRecorder) CreateSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockStorageService)(nil).CreateSnapshot), arg0, arg1)
+}
+
+// CreateVolume mocks base method.
+func (m *MockStorageService) CreateVolume(arg0 context.Context, arg1 *godo.VolumeCreateRequest) (*godo.Volume, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolume", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Volume)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateVolume indicates an expected call of CreateVolume.
+func (mr *MockStorageServiceMockRecorder) CreateVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockStorageService)(nil).CreateVolume), arg0, arg1)
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockStorageService) DeleteSnapshot(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockStorageServiceMockRecorder) DeleteSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockStorageService)(nil).DeleteSnapshot), arg0, arg1)
+}
+
+// DeleteVolume mocks base method.
+func (m *MockStorageService) DeleteVolume(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockStorageServiceMockRecorder) DeleteVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockStorageService)(nil).DeleteVolume), arg0, arg1)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockStorageService) GetSnapshot(arg0 context.Context, arg1 string) (*godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockStorageServiceMockRecorder) GetSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockStorageService)(nil).GetSnapshot), arg0, arg1)
+}
+
+// GetVolume mocks base method.
+func (m *MockStorageService) GetVolume(arg0 context.Context, arg1 string) (*godo.Volume, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Volume)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockStorageServiceMockRecorder) GetVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockStorageService)(nil).GetVolume), arg0, arg1)
+}
+
+// ListSnapshots mocks base method.
+func (m *MockStorageService) ListSnapshots(ctx context.Context, volumeID string, opts *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshots", ctx, volumeID, opts)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockStorageServiceMockRecorder) ListSnapshots(ctx, volumeID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockStorageService)(nil).ListSnapshots), ctx, volumeID, opts)
+}
+
+// ListVolumes mocks base method.
+func (m *MockStorageService) ListVolumes(arg0 context.Context, arg1 *godo.ListVolumeParams) ([]godo.Volume, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumes", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Volume)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVolumes indicates an expected call of ListVolumes.
+func (mr *MockStorageServiceMockRecorder) ListVolumes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockStorageService)(nil).ListVolumes), arg0, arg1)
+}
+
+// MockLoadBalancersService is a mock of LoadBalancersService interface.
+type MockLoadBalancersService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoadBalancersServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLoadBalancersServiceMockRecorder is the mock recorder for MockLoadBalancersService.
+type MockLoadBalancersServiceMockRecorder struct {
+	mock *MockLoadBalancersService
+}
+
+// NewMockLoadBalancersService creates a new mock instance.
+func NewMockLoadBalancersService(ctrl *gomock.Controller) *MockLoadBalancersService {
+	mock := &MockLoadBalancersService{ctrl: ctrl}
+	mock.recorder = &MockLoadBalancersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoadBalancersService) EXPECT() *MockLoadBalancersServiceMockRecorder {
+	return m.recorder
+}
+
+// AddDroplets mocks base method.
+func (m *MockLoadBalancersService) AddDroplets(ctx context.Context, lbID string, dropletIDs ...int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, lbID}
+	for _, a := range dropletIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddDroplets", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDroplets indicates an expected call of AddDroplets.
+func (mr *MockLoadBalancersServiceMockRecorder) AddDroplets(ctx, lbID any, dropletIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, lbID}, dropletIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDroplets", reflect.TypeOf((*MockLoadBalancersService)(nil).AddDroplets), varargs...)
+}
+
+// AddForwardingRules mocks base method.
+func (m *MockLoadBalancersService) AddForwardingRules(ctx context.Context, lbID string, rules ...godo.ForwardingRule) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, lbID}
+	for _, a := range rules {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddForwardingRules", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddForwardingRules indicates an expected call of AddForwardingRules.
+func (mr *MockLoadBalancersServiceMockRecorder) AddForwardingRules(ctx, lbID any, rules ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, lbID}, rules...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddForwardingRules", reflect.TypeOf((*MockLoadBalancersService)(nil).AddForwardingRules), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockLoadBalancersService) Create(arg0 context.Context, arg1 *godo.LoadBalancerRequest) (*godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLoadBalancersServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLoadBalancersService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockLoadBalancersService) Delete(ctx context.Context, lbID string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, lbID)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLoadBalancersServiceMockRecorder) Delete(ctx, lbID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLoadBalancersService)(nil).Delete), ctx, lbID)
+}
+
+// Get mocks base method.
+func (m *MockLoadBalancersService) Get(arg0 context.Context, arg1 string) (*godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLoadBalancersServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLoadBalancersService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockLoadBalancersService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockLoadBalancersServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoadBalancersService)(nil).List), arg0, arg1)
+}
+
+// ListByNames mocks base method.
+func (m *MockLoadBalancersService) ListByNames(arg0 context.Context, arg1 []string, arg2 *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByNames", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByNames indicates an expected call of ListByNames.
+func (mr *MockLoadBalancersServiceMockRecorder) ListByNames(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByNames", reflect.TypeOf((*MockLoadBalancersService)(nil).ListByNames), arg0, arg1, arg2)
+}
+
+// ListByUUIDs mocks base method.
+func (m *MockLoadBalancersService) ListByUUIDs(arg0 context.Context, arg1 []string, arg2 *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUUIDs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByUUIDs indicates an expected call of ListByUUIDs.
+func (mr *MockLoadBalancersServiceMockRecorder) ListByUUIDs(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUUIDs", reflect.TypeOf((*MockLoadBalancersService)(nil).ListByUUIDs), arg0, arg1, arg2)
+}
+
+// PurgeCache mocks base method.
+func (m *MockLoadBalancersService) PurgeCache(ctx context.Context, lbID string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeCache", ctx, lbID)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeCache indicates an expected call of PurgeCache.
+func (mr *MockLoadBalancersServiceMockRecorder) PurgeCache(ctx, lbID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeCache", reflect.TypeOf((*MockLoadBalancersService)(nil).PurgeCache), ctx, lbID)
+}
+
+// RemoveDroplets mocks base method.
+func (m *MockLoadBalancersService) RemoveDroplets(ctx context.Context, lbID string, dropletIDs ...int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, lbID}
+	for _, a := range dropletIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveDroplets", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDroplets indicates an expected call of RemoveDroplets.
+func (mr *MockLoadBalancersServiceMockRecorder) RemoveDroplets(ctx, lbID any, dropletIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, lbID}, dropletIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDroplets", reflect.TypeOf((*MockLoadBalancersService)(nil).RemoveDroplets), varargs...)
+}
+
+// RemoveForwardingRules mocks base method.
+func (m *MockLoadBalancersService) RemoveForwardingRules(ctx context.Context, lbID string, rules ...godo.ForwardingRule) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, lbID}
+	for _, a := range rules {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveForwardingRules", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveForwardingRules indicates an expected call of RemoveForwardingRules.
+func (mr *MockLoadBalancersServiceMockRecorder) RemoveForwardingRules(ctx, lbID any, rules ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, lbID}, rules...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveForwardingRules", reflect.TypeOf((*MockLoadBalancersService)(nil).RemoveForwardingRules), varargs...)
+}
+
+// Update mocks base method.
+func (m *MockLoadBalancersService) Update(ctx context.Context, lbID string, lbr *godo.LoadBalancerRequest) (*godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, lbID, lbr)
+	ret0, _ := ret[0].(*godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLoadBalancersServiceMockRecorder) Update(ctx, lbID, lbr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLoadBalancersService)(nil).Update), ctx, lbID, lbr)
+}
+
+// MockSnapshotsService is a mock of SnapshotsService interface.
+type MockSnapshotsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSnapshotsServiceMockRecorder is the mock recorder for MockSnapshotsService.
+type MockSnapshotsServiceMockRecorder struct {
+	mock *MockSnapshotsService
+}
+
+// NewMockSnapshotsService creates a new mock instance.
+func NewMockSnapshotsService(ctrl *gomock.Controller) *MockSnapshotsService {
+	mock := &MockSnapshotsService{ctrl: ctrl}
+	mock.recorder = &MockSnapshotsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshotsService) EXPECT() *MockSnapshotsServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSnapshotsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSnapshotsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSnapshotsService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockSnapshotsService) Get(arg0 context.Context, arg1 string) (*godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSnapshotsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSnapshotsService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockSnapshotsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockSnapshotsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSnapshotsService)(nil).List), arg0, arg1)
+}
+
+// ListDroplet mocks base method.
+func (m *MockSnapshotsService) ListDroplet(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDroplet", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDroplet indicates an expected call of ListDroplet.
+func (mr *MockSnapshotsServiceMockRecorder) ListDroplet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDroplet", reflect.TypeOf((*MockSnapshotsService)(nil).ListDroplet), arg0, arg1)
+}
+
+// ListVolume mocks base method.
+func (m *MockSnapshotsService) ListVolume(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolume", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVolume indicates an expected call of ListVolume.
+func (mr *MockSnapshotsServiceMockRecorder) ListVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolume", reflect.TypeOf((*MockSnapshotsService)(nil).ListVolume), arg0, arg1)
+}
+
+// ListVolumeSnapshotByRegion mocks base method.
+func (m *MockSnapshotsService) ListVolumeSnapshotByRegion(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumeSnapshotByRegion", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVolumeSnapshotByRegion indicates an expected call of ListVolumeSnapshotByRegion.
+func (mr *MockSnapshotsServiceMockRecorder) ListVolumeSnapshotByRegion(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumeSnapshotByRegion", reflect.TypeOf((*MockSnapshotsService)(nil).ListVolumeSnapshotByRegion), arg0, arg1, arg2)
+}
+
+// MockProjectsService is a mock of ProjectsService interface.
+type MockProjectsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectsServiceMockRecorder is the mock recorder for MockProjectsService.
+type MockProjectsServiceMockRecorder struct {
+	mock *MockProjectsService
+}
+
+// NewMockProjectsService creates a new mock instance.
+func NewMockProjectsService(ctrl *gomock.Controller) *MockProjectsService {
+	mock := &MockProjectsService{ctrl: ctrl}
+	mock.recorder = &MockProjectsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectsService) EXPECT() *MockProjectsServiceMockRecorder {
+	return m.recorder
+}
+
+// AssignResources mocks base method.
+func (m *MockProjectsService) AssignResources(arg0 context.Context, arg1 string, arg2 ...any) ([]godo.ProjectResource, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssignResources", varargs...)
+	ret0, _ := ret[0].([]godo.ProjectResource)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AssignResources indicates an expected call of AssignResources.
+func (mr *MockProjectsServiceMockRecorder) AssignResources(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignResources", reflect.TypeOf((*MockProjectsService)(nil).AssignResources), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockProjectsService) Create(arg0 context.Context, arg1 *godo.CreateProjectRequest) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectsService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockProjectsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProjectsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectsService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockProjectsService) Get(arg0 context.Context, arg1 string) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProjectsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProjectsService)(nil).Get), arg0, arg1)
+}
+
+// GetDefault mocks base method.
+func (m *MockProjectsService) GetDefault(arg0 context.Context) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefault", arg0)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDefault indicates an expected call of GetDefault.
+func (mr *MockProjectsServiceMockRecorder) GetDefault(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefault", reflect.TypeOf((*MockProjectsService)(nil).GetDefault), arg0)
+}
+
+// List mocks base method.
+func (m *MockProjectsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectsService)(nil).List), arg0, arg1)
+}
+
+// ListResources mocks base method.
+func (m *MockProjectsService) ListResources(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.ProjectResource, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.ProjectResource)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockProjectsServiceMockRecorder) ListResources(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockProjectsService)(nil).ListResources), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockProjectsService) Update(arg0 context.Context, arg1 string, arg2 *godo.UpdateProjectRequest) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProjectsServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectsService)(nil).Update), arg0, arg1, arg2)
+}
+
+// MockAccountService is a mock of AccountService interface.
+type MockAccountService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountServiceMockRecorder is the mock recorder for MockAccountService.
+type MockAccountServiceMockRecorder struct {
+	mock *MockAccountService
+}
+
+// NewMockAccountService creates a new mock instance.
+func NewMockAccountService(ctrl *gomock.Controller) *MockAccountService {
+	mock := &MockAccountService{ctrl: ctrl}
+	mock.recorder = &MockAccountServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountService) EXPECT() *MockAccountServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockAccountService) Get(arg0 context.Context) (*godo.Account, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*godo.Account)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAccountServiceMockRecorder) Get(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAccountService)(nil).Get), arg0)
+}
+
+// MockVPCsService is a mock of VPCsService interface.
+type MockVPCsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVPCsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockVPCsServiceMockRecorder is the mock recorder for MockVPCsService.
+type MockVPCsServiceMockRecorder struct {
+	mock *MockVPCsService
+}
+
+// NewMockVPCsService creates a new mock instance.
+func NewMockVPCsService(ctrl *gomock.Controller) *MockVPCsService {
+	mock := &MockVPCsService{ctrl: ctrl}
+	mock.recorder = &MockVPCsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVPCsService) EXPECT() *MockVPCsServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockVPCsService) Create(arg0 context.Context, arg1 *godo.VPCCreateRequest) (*godo.VPC, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.VPC)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockVPCsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVPCsService)(nil).Create), arg0, arg1)
+}
+
+// CreateVPCPeering mocks base method.
+func (m *MockVPCsService) CreateVPCPeering(arg0 context.Context, arg1 *godo.VPCPeeringCreateRequest) (*godo.VPCPeering, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVPCPeering", arg0, arg1)
+	ret0, _ := ret[0].(*godo.VPCPeering)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateVPCPeering indicates an expected call of CreateVPCPeering.
+func (mr *MockVPCsServiceMockRecorder) CreateVPCPeering(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCPeering", reflect.TypeOf((*MockVPCsService)(nil).CreateVPCPeering), arg0, arg1)
+}
+
+// CreateVPCPeeringByVPCID mocks base method.
+func (m *MockVPCsService) CreateVPCPeeringByVPCID(arg0 context.Context, arg1 string, arg2 *godo.VPCPeeringCreateRequestByVPCID) (*godo.VPCPeering, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVPCPeeringByVPCID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.VPCPeering)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateVPCPeeringByVPCID indicates an expected call of CreateVPCPeeringByVPCID.
+func (mr *MockVPCsServiceMockRecorder) CreateVPCPeeringByVPCID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCPeeringByVPCID", reflect.TypeOf((*MockVPCsService)(nil).CreateVPCPeeringByVPCID), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockVPCsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockVPCsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVPCsService)(nil).Delete), arg0, arg1)
+}
+
+// DeleteVPCPeering mocks base method.
+func (m *MockVPCsService) DeleteVPCPeering(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVPCPeering", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVPCPeering indicates an expected call of DeleteVPCPeering.
+func (mr *MockVPCsServiceMockRecorder) DeleteVPCPeering(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPCPeering", reflect.TypeOf((*MockVPCsService)(nil).DeleteVPCPeering), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockVPCsService) Get(arg0 context.Context, arg1 string) (*godo.VPC, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.VPC)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockVPCsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVPCsService)(nil).Get), arg0, arg1)
+}
+
+// GetVPCPeering mocks base method.
+func (m *MockVPCsService) GetVPCPeering(arg0 context.Context, arg1 string) (*godo.VPCPeering, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVPCPeering", arg0, arg1)
+	ret0, _ := ret[0].(*godo.VPCPeering)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVPCPeering indicates an expected call of GetVPCPeering.
+func (mr *MockVPCsServiceMockRecorder) GetVPCPeering(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCPeering", reflect.TypeOf((*MockVPCsService)(nil).GetVPCPeering), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockVPCsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]*godo.VPC, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]*godo.VPC)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockVPCsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVPCsService)(nil).List), arg0, arg1)
+}
+
+// ListMembers mocks base method.
+func (m *MockVPCsService) ListMembers(arg0 context.Context, arg1 string, arg2 *godo.VPCListMembersRequest, arg3 *godo.ListOptions) ([]*godo.VPCMember, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*godo.VPCMember)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockVPCsServiceMockRecorder) ListMembers(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockVPCsService)(nil).ListMembers), arg0, arg1, arg2, arg3)
+}
+
+// ListVPCPeerings mocks base method.
+func (m *MockVPCsService) ListVPCPeerings(arg0 context.Context, arg1 *godo.ListOptions) ([]*godo.VPCPeering, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVPCPeerings", arg0, arg1)
+	ret0, _ := ret[0].([]*godo.VPCPeering)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVPCPeerings indicates an expected call of ListVPCPeerings.
+func (mr *MockVPCsServiceMockRecorder) ListVPCPeerings(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCPeerings", reflect.TypeOf((*MockVPCsService)(nil).ListVPCPeerings), arg0, arg1)
+}
+
+// ListVPCPeeringsByVPCID mocks base method.
+func (m *MockVPCsService) ListVPCPeeringsByVPCID(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]*godo.VPCPeering, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVPCPeeringsByVPCID", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*godo.VPCPeering)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVPCPeeringsByVPCID indicates an expected call of ListVPCPeeringsByVPCID.
+func (mr *MockVPCsServiceMockRecorder) ListVPCPeeringsByVPCID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCPeeringsByVPCID", reflect.TypeOf((*MockVPCsService)(nil).ListVPCPeeringsByVPCID), arg0, arg1, arg2)
+}
+
+// Set mocks base method.
+func (m *MockVPCsService) Set(arg0 context.Context, arg1 string, arg2 ...godo.VPCSetField) (*godo.VPC, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Set", varargs...)
+	ret0, _ := ret[0].(*godo.VPC)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockVPCsServiceMockRecorder) Set(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockVPCsService)(nil).Set), varargs...)
+}
+
+// Update mocks base method.
+func (m *MockVPCsService) Update(arg0 context.Context, arg1 string, arg2 *godo.VPCUpdateRequest) (*godo.VPC, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.VPC)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockVPCsServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVPCsService)(nil).Update), arg0, arg1, arg2)
+}
+
+// UpdateVPCPeering mocks base method.
+func (m *MockVPCsService) UpdateVPCPeering(arg0 context.Context, arg1 string, arg2 *godo.VPCPeeringUpdateRequest) (*godo.VPCPeering, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVPCPeering", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.VPCPeering)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateVPCPeering indicates an expected call of UpdateVPCPeering.
+func (mr *MockVPCsServiceMockRecorder) UpdateVPCPeering(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPCPeering", reflect.TypeOf((*MockVPCsService)(nil).UpdateVPCPeering), arg0, arg1, arg2)
+}
+
+// UpdateVPCPeeringByVPCID mocks base method.
+func (m *MockVPCsService) UpdateVPCPeeringByVPCID(arg0 context.Context, arg1, arg2 string, arg3 *godo.VPCPeeringUpdateRequest) (*godo.VPCPeering, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVPCPeeringByVPCID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.VPCPeering)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateVPCPeeringByVPCID indicates an expected call of UpdateVPCPeeringByVPCID.
+func (mr *MockVPCsServiceMockRecorder) UpdateVPCPeeringByVPCID(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPCPeeringByVPCID", reflect.TypeOf((*MockVPCsService)(nil).UpdateVPCPeeringByVPCID), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,159 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"mcp-digitalocean/internal/fanout"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MultiRegionTarget is a region droplet-create-multi-region creates a droplet in, and its name.
+type MultiRegionTarget struct {
+	Region string `json:"region"`
+	Name   string `json:"name"`
+}
+
+// MultiRegionDroplet is the droplet droplet-create-multi-region created in one region.
+type MultiRegionDroplet struct {
+	MultiRegionTarget
+	Droplet *godo.Droplet `json:"droplet"`
+}
+
+// MultiRegionCreateResult is the outcome of droplet-create-multi-region: the droplets created and
+// the regions that failed, each in the order the regions were given.
+type MultiRegionCreateResult struct {
+	Size string `json:"size"`
+	fanout.Batch[MultiRegionDroplet, MultiRegionTarget]
+}
+
+// DropletMultiRegionTool provides a tool that creates the same droplet in several regions.
+type DropletMultiRegionTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+// NewDropletMultiRegionTool creates a new DropletMultiRegionTool.
+func NewDropletMultiRegionTool(client func(ctx context.Context) (*godo.Client, error)) *DropletMultiRegionTool {
+	return &DropletMultiRegionTool{client: client}
+}
+
+// createMultiRegion creates a droplet named <Name>-<region> in each of the regions concurrently.
+// A region whose droplet fails doesn't stop the others; the droplets created are kept.
+func (t *DropletMultiRegionTool) createMultiRegion(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	size := args.RequiredString("Size")
+	regions := args.RequiredStrings("Regions")
+	image, imageErr := dropletImage(args)
+	backup := args.Bool("Backup", false)
+	monitoring := args.Bool("Monitoring", false)
+	sshKeysList := args.List("SSHKeys")
+	tags := args.Strings("Tags")
+	project := args.String(common.ProjectArg)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if imageErr != nil {
+		return mcp.NewToolResultError(imageErr.Error()), nil
+	}
+	if len(regions) == 0 {
+		return mcp.NewToolResultError("invalid arguments: Regions must name at least one region"), nil
+	}
+	for i, region := range regions {
+		if slices.Contains(regions[:i], region) {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: region %s is listed more than once", region)), nil
+		}
+	}
+	sshKeys, err := dropletSSHKeys(sshKeysList)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := t.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	var projectID string
+	if project != "" {
+		if projectID, err = common.ResolveProject(ctx, client, project); err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+	}
+
+	targets := make([]MultiRegionTarget, len(regions))
+	for i, region := range regions {
+		targets[i] = MultiRegionTarget{Region: region, Name: name + "-" + region}
+	}
+	outcomes := fanout.Run(ctx, targets, fanout.Options{Concurrency: len(targets)}, func(ctx context.Context, target MultiRegionTarget) (*godo.Droplet, error) {
+		// the droplet limit is checked for all regions at once, so no region gets a droplet that
+		// leaves the others without room.
+		if err := common.PreflightDroplets(ctx, client, size, target.Region, len(targets)); err != nil {
+			return nil, err
+		}
+		if err := common.PreflightImage(ctx, client, image, size, target.Region); err != nil {
+			return nil, err
+		}
+		droplet, _, err := client.Droplets.Create(ctx, &godo.DropletCreateRequest{
+			Name:       target.Name,
+			Size:       size,
+			Image:      image,
+			Region:     target.Region,
+			Backups:    backup,
+			Monitoring: monitoring,
+			SSHKeys:    sshKeys,
+			Tags:       tags,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if projectID != "" {
+			if err := common.AssignCreated(ctx, client, projectID, droplet.URN(), func(ctx context.Context) error {
+				_, err := client.Droplets.Delete(ctx, droplet.ID)
+				return err
+			}); err != nil {
+				return nil, err
+			}
+		}
+		return droplet, nil
+	})
+
+	result := MultiRegionCreateResult{Size: size, Batch: fanout.NewBatch[MultiRegionDroplet, MultiRegionTarget]()}
+	fanout.Collect(&result.Batch, targets, outcomes, func(target MultiRegionTarget, droplet *godo.Droplet) MultiRegionDroplet {
+		return MultiRegionDroplet{MultiRegionTarget: target, Droplet: droplet}
+	})
+	res, err := common.NewToolResultStructured(result)
+	if err != nil {
+		return nil, err
+	}
+	// a call that created nothing failed; one that created some droplets did not.
+	res.IsError = len(result.Succeeded) == 0
+	return res, nil
+}
+
+// Tools returns the list of server tools for multi-region droplets.
+func (t *DropletMultiRegionTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: t.createMultiRegion,
+			Tool: mcp.NewTool("droplet-create-multi-region",
+				mcp.WithDescription("Create the same droplet in each of several regions concurrently, e.g. for latency tests or edge deployments. Each droplet is named <Name>-<region>. A region that fails doesn't stop the others and the droplets created are kept; the result lists the droplets created under succeeded and the regions that failed with their errors under failed, so only those need retrying. Exactly one of ImageID or ImageSlug must be provided."),
+				common.WithOutputSchema[MultiRegionCreateResult](),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the droplets, which is suffixed with the region of each")),
+				mcp.WithString("Size", mcp.Required(), mcp.Description("Slug of the droplet size (e.g., s-1vcpu-1gb)")),
+				mcp.WithArray("Regions", mcp.Required(), mcp.Description("Slugs of the regions to create a droplet in (e.g., nyc3, ams3, sgp1)"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithNumber("ImageID", mcp.Description("Numeric ID of the image to use. Mutually exclusive with ImageSlug.")),
+				mcp.WithString("ImageSlug", mcp.Description("Slug of the image to use (e.g., ubuntu-22-04-x64). Mutually exclusive with ImageID.")),
+				mcp.WithBoolean("Backup", mcp.DefaultBool(false), mcp.Description("Whether to enable backups")),
+				mcp.WithBoolean("Monitoring", mcp.DefaultBool(false), mcp.Description("Whether to enable monitoring")),
+				mcp.WithArray("SSHKeys", mcp.Description("Array of SSH key IDs (numbers) or fingerprints (strings) to add to the droplets"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithArray("Tags", mcp.Description("Array of tag names to apply to the droplets"), mcp.Items(map[string]any{"type": "string"})),
+				common.WithProject("droplets"),
+			),
+		},
+	}
+}
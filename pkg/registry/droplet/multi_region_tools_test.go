@@ -0,0 +1,90 @@
+package droplet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDropletMultiRegionTool_createMultiRegion(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]any
+		mockSetup     func(*MockDropletsService)
+		expectError   bool
+		expectCreated int
+		expectFailed  int
+	}{
+		{
+			name: "Every region",
+			args: map[string]any{"Name": "probe", "Size": "s-1vcpu-1gb", "ImageSlug": "ubuntu-22-04-x64", "Regions": []any{"nyc1", "nyc3"}},
+			mockSetup: func(d *MockDropletsService) {
+				for i, region := range []string{"nyc1", "nyc3"} {
+					d.EXPECT().Create(gomock.Any(), &godo.DropletCreateRequest{
+						Name: "probe-" + region, Size: "s-1vcpu-1gb", Region: region, Image: godo.DropletCreateImage{Slug: "ubuntu-22-04-x64"},
+					}).Return(&godo.Droplet{ID: 100 + i, Name: "probe-" + region}, nil, nil)
+				}
+			},
+			expectCreated: 2,
+		},
+		{
+			name: "One region fails",
+			args: map[string]any{"Name": "probe", "Size": "s-1vcpu-1gb", "ImageID": float64(7), "Regions": []any{"nyc1", "nyc3"}},
+			mockSetup: func(d *MockDropletsService) {
+				d.EXPECT().Create(gomock.Any(), gomock.Cond(func(r *godo.DropletCreateRequest) bool { return r.Region == "nyc1" })).
+					Return(&godo.Droplet{ID: 100, Name: "probe-nyc1"}, nil, nil)
+				d.EXPECT().Create(gomock.Any(), gomock.Cond(func(r *godo.DropletCreateRequest) bool { return r.Region == "nyc3" })).
+					Return(nil, nil, errors.New("region is out of capacity"))
+			},
+			expectCreated: 1,
+			expectFailed:  1,
+		},
+		{
+			name:         "Preflight failure creates nothing",
+			args:         map[string]any{"Name": "probe", "Size": "s-1vcpu-1gb", "ImageSlug": "ubuntu-22-04-x64", "Regions": []any{"xyz1"}},
+			expectError:  true,
+			expectFailed: 1,
+		},
+		{
+			name:        "Duplicate region",
+			args:        map[string]any{"Name": "probe", "Size": "s-1vcpu-1gb", "ImageSlug": "ubuntu-22-04-x64", "Regions": []any{"nyc1", "nyc1"}},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			droplets := NewMockDropletsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(droplets)
+			}
+			client := withPassingPreflight(ctrl, &godo.Client{Droplets: droplets})
+			tool := NewDropletMultiRegionTool(func(ctx context.Context) (*godo.Client, error) { return client, nil })
+
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = tc.args
+			res, err := tool.createMultiRegion(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, res.IsError)
+			if res.StructuredContent == nil {
+				return
+			}
+			result := res.StructuredContent.(MultiRegionCreateResult)
+			require.Len(t, result.Succeeded, tc.expectCreated)
+			require.Len(t, result.Failed, tc.expectFailed)
+			for _, created := range result.Succeeded {
+				require.Equal(t, created.Name, created.Droplet.Name)
+			}
+			for _, failed := range result.Failed {
+				require.Equal(t, "probe-"+failed.Item.Region, failed.Item.Name)
+				require.NotEmpty(t, failed.Error)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package droplet
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultPlacementLimit = 10
+	maxPlacementLimit     = 50
+	// volumesFeature is the region feature of block storage volumes.
+	volumesFeature = "storage"
+)
+
+// datacenter is where the regions of a datacenter city are, and the names a proximity hint may
+// use for it: its city, country and area.
+type datacenter struct {
+	lat, lon float64
+	names    []string
+}
+
+// datacenters is keyed by the letters of the region slugs, e.g. nyc for nyc1, nyc2 and nyc3.
+var datacenters = map[string]datacenter{
+	"nyc": {40.71, -74.01, []string{"new york", "us", "usa", "united states", "north america", "america"}},
+	"sfo": {37.77, -122.42, []string{"san francisco", "us", "usa", "united states", "north america", "america"}},
+	"atl": {33.75, -84.39, []string{"atlanta", "us", "usa", "united states", "north america", "america"}},
+	"ric": {37.54, -77.44, []string{"richmond", "us", "usa", "united states", "north america", "america"}},
+	"tor": {43.65, -79.38, []string{"toronto", "canada", "north america", "america"}},
+	"ams": {52.37, 4.90, []string{"amsterdam", "netherlands", "europe", "eu"}},
+	"lon": {51.51, -0.13, []string{"london", "uk", "united kingdom", "europe"}},
+	"fra": {50.11, 8.68, []string{"frankfurt", "germany", "europe", "eu"}},
+	"sgp": {1.35, 103.82, []string{"singapore", "asia", "apac"}},
+	"blr": {12.97, 77.59, []string{"bangalore", "bengaluru", "india", "asia", "apac"}},
+	"syd": {-33.87, 151.21, []string{"sydney", "australia", "oceania", "apac"}},
+}
+
+var regionDigits = regexp.MustCompile(`[0-9]+$`)
+
+// regionDatacenter returns the datacenter of a region slug.
+func regionDatacenter(slug string) (datacenter, bool) {
+	dc, ok := datacenters[regionDigits.ReplaceAllString(slug, "")]
+	return dc, ok
+}
+
+// placementAnchors returns the datacenters a proximity hint names: a region slug such as nyc3, its
+// letters, or a city, country or area such as Frankfurt, Canada or Europe.
+func placementAnchors(near string) ([]datacenter, error) {
+	hint := strings.ToLower(strings.Join(strings.Fields(near), " "))
+	if dc, ok := regionDatacenter(hint); ok {
+		return []datacenter{dc}, nil
+	}
+	var anchors []datacenter
+	for _, dc := range datacenters {
+		if slices.Contains(dc.names, hint) {
+			anchors = append(anchors, dc)
+		}
+	}
+	if len(anchors) == 0 {
+		return nil, fmt.Errorf("invalid arguments: Near %q names no region, city, country or area with a datacenter, e.g. nyc3, Frankfurt, India or Europe", near)
+	}
+	return anchors, nil
+}
+
+// distanceKm is the great-circle distance between two datacenters.
+func distanceKm(a, b datacenter) float64 {
+	const earthRadiusKm = 6371
+	rad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat, dLon := rad(b.lat-a.lat), rad(b.lon-a.lon)
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(rad(a.lat))*math.Cos(rad(b.lat))*math.Pow(math.Sin(dLon/2), 2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+// PlacementRequirements is what a droplet needs of its region and size.
+type PlacementRequirements struct {
+	MinVcpus    int     `json:"min_vcpus,omitempty"`
+	MinMemoryGB float64 `json:"min_memory_gb,omitempty"`
+	MinDiskGB   int     `json:"min_disk_gb,omitempty"`
+	// GPUs is the least number of GPUs; sizes with GPUs are only considered when it is set.
+	GPUs            int     `json:"gpus,omitempty"`
+	GPUModel        string  `json:"gpu_model,omitempty"`
+	NeedsVolumes    bool    `json:"needs_volumes,omitempty"`
+	MaxPriceMonthly float64 `json:"max_price_monthly,omitempty"`
+	Near            string  `json:"near,omitempty"`
+}
+
+// fits reports whether size meets the requirements.
+func (r PlacementRequirements) fits(size godo.Size) bool {
+	var gpus int
+	var model string
+	if size.GPUInfo != nil {
+		gpus, model = size.GPUInfo.Count, size.GPUInfo.Model
+	}
+	switch {
+	case !size.Available:
+		return false
+	case size.Vcpus < r.MinVcpus, float64(size.Memory) < r.MinMemoryGB*1024, size.Disk < r.MinDiskGB:
+		return false
+	case r.GPUs == 0 && gpus > 0, gpus < r.GPUs:
+		return false
+	case r.GPUModel != "" && !strings.Contains(strings.ToLower(model), strings.ToLower(r.GPUModel)):
+		return false
+	case r.MaxPriceMonthly > 0 && size.PriceMonthly > r.MaxPriceMonthly:
+		return false
+	}
+	return true
+}
+
+// PlacementOption is a region and size that meets the requirements.
+type PlacementOption struct {
+	Region       string  `json:"region"`
+	RegionName   string  `json:"region_name"`
+	Size         string  `json:"size"`
+	Description  string  `json:"description,omitempty"`
+	Vcpus        int     `json:"vcpus"`
+	MemoryMB     int     `json:"memory_mb"`
+	DiskGB       int     `json:"disk_gb"`
+	GPUs         int     `json:"gpus,omitempty"`
+	GPUModel     string  `json:"gpu_model,omitempty"`
+	PriceMonthly float64 `json:"price_monthly"`
+	PriceHourly  float64 `json:"price_hourly"`
+	Volumes      bool    `json:"volumes"`
+	// DistanceKm is how far the region is from the closest place Near names; it is omitted without
+	// Near and for regions whose location is unknown.
+	DistanceKm *int `json:"distance_km,omitempty"`
+}
+
+// PlacementRecommendation is the result of placement-recommend.
+type PlacementRecommendation struct {
+	Requirements PlacementRequirements `json:"requirements"`
+	// Matched is how many region and size combinations meet the requirements, of which Options are
+	// the best ranked.
+	Matched int               `json:"matched"`
+	Options []PlacementOption `json:"options"`
+	Message string            `json:"message,omitempty"`
+}
+
+// recommendPlacement ranks the region and size combinations that meet the requirements: the
+// regions closest to the anchors first when there are any, then the cheapest sizes.
+func recommendPlacement(regions []godo.Region, sizes []godo.Size, req PlacementRequirements, anchors []datacenter, limit int) PlacementRecommendation {
+	result := PlacementRecommendation{Requirements: req, Options: []PlacementOption{}}
+	for _, region := range regions {
+		if !region.Available {
+			continue
+		}
+		volumes := slices.Contains(region.Features, volumesFeature)
+		if req.NeedsVolumes && !volumes {
+			continue
+		}
+		var distance *int
+		if dc, ok := regionDatacenter(region.Slug); ok && len(anchors) > 0 {
+			km := math.MaxFloat64
+			for _, anchor := range anchors {
+				km = min(km, distanceKm(anchor, dc))
+			}
+			rounded := int(math.Round(km))
+			distance = &rounded
+		}
+		for _, size := range sizes {
+			if !slices.Contains(region.Sizes, size.Slug) || !req.fits(size) {
+				continue
+			}
+			option := PlacementOption{
+				Region:       region.Slug,
+				RegionName:   region.Name,
+				Size:         size.Slug,
+				Description:  size.Description,
+				Vcpus:        size.Vcpus,
+				MemoryMB:     size.Memory,
+				DiskGB:       size.Disk,
+				PriceMonthly: size.PriceMonthly,
+				PriceHourly:  size.PriceHourly,
+				Volumes:      volumes,
+				DistanceKm:   distance,
+			}
+			if size.GPUInfo != nil {
+				option.GPUs, option.GPUModel = size.GPUInfo.Count, size.GPUInfo.Model
+			}
+			result.Options = append(result.Options, option)
+		}
+	}
+
+	slices.SortFunc(result.Options, func(a, b PlacementOption) int {
+		return cmp.Or(
+			compareDistances(a.DistanceKm, b.DistanceKm, len(anchors) > 0),
+			cmp.Compare(a.PriceMonthly, b.PriceMonthly),
+			cmp.Compare(a.Size, b.Size),
+			cmp.Compare(a.Region, b.Region),
+		)
+	})
+	result.Matched = len(result.Options)
+	result.Options = result.Options[:min(limit, len(result.Options))]
+	if result.Matched == 0 {
+		result.Message = "No available size in an available region meets the requirements. Relax MinVcpus, MinMemoryGB, MinDiskGB, GPUs or MaxPriceMonthly, or drop NeedsVolumes."
+	}
+	return result
+}
+
+// compareDistances orders known distances before unknown ones when ranking by proximity.
+func compareDistances(a, b *int, byProximity bool) int {
+	switch {
+	case !byProximity || a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return cmp.Compare(*a, *b)
+}
+
+// PlacementTool provides a tool that recommends where and on what size to run a droplet.
+type PlacementTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+// NewPlacementTool creates a new PlacementTool.
+func NewPlacementTool(client func(ctx context.Context) (*godo.Client, error)) *PlacementTool {
+	return &PlacementTool{client: client}
+}
+
+func (p *PlacementTool) recommend(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	requirements := PlacementRequirements{
+		MinVcpus:        int(args.Number("MinVcpus", 0)),
+		MinMemoryGB:     args.Number("MinMemoryGB", 0),
+		MinDiskGB:       int(args.Number("MinDiskGB", 0)),
+		GPUs:            int(args.Number("GPUs", 0)),
+		GPUModel:        args.String("GPUModel"),
+		NeedsVolumes:    args.Bool("NeedsVolumes", false),
+		MaxPriceMonthly: args.Number("MaxPriceMonthly", 0),
+		Near:            args.String("Near"),
+	}
+	limit := int(args.Number("Limit", defaultPlacementLimit))
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if limit < 1 || limit > maxPlacementLimit {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: Limit must be between 1 and %d", maxPlacementLimit)), nil
+	}
+	if requirements.GPUModel != "" && requirements.GPUs == 0 {
+		requirements.GPUs = 1
+	}
+	var anchors []datacenter
+	if requirements.Near != "" {
+		var err error
+		if anchors, err = placementAnchors(requirements.Near); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
+	client, err := p.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	regions, err := common.ListAll(ctx, resourcePageSize, client.Regions.List)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	sizes, err := common.ListAll(ctx, resourcePageSize, client.Sizes.List)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return common.NewToolResultStructured(recommendPlacement(regions, sizes, requirements, anchors, limit))
+}
+
+// Tools returns the placement-recommend tool.
+func (p *PlacementTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: p.recommend,
+			Tool: mcp.NewTool("placement-recommend",
+				mcp.WithDescription("Recommend region and size combinations for a droplet that meet its requirements, ranked by distance from Near when it is given and then by monthly price, with the resources, prices and volume support of each. Only sizes and regions that are currently available are returned, so the Region and Size of an option can be passed to droplet-create as they are. Sizes with GPUs are only considered when GPUs or GPUModel is set."),
+				common.WithOutputSchema[PlacementRecommendation](),
+				mcp.WithNumber("MinVcpus", mcp.Min(0), mcp.Description("Least number of vCPUs")),
+				mcp.WithNumber("MinMemoryGB", mcp.Min(0), mcp.Description("Least memory in GB, e.g. 0.5 or 8")),
+				mcp.WithNumber("MinDiskGB", mcp.Min(0), mcp.Description("Least disk in GB")),
+				mcp.WithNumber("GPUs", mcp.Min(0), mcp.Description("Least number of GPUs")),
+				mcp.WithString("GPUModel", mcp.Description("Part of the GPU model, e.g. h100 or l40s")),
+				mcp.WithBoolean("NeedsVolumes", mcp.DefaultBool(false), mcp.Description("Whether the droplet needs block storage volumes, which not every region supports")),
+				mcp.WithNumber("MaxPriceMonthly", mcp.Min(0), mcp.Description("Highest monthly price in USD")),
+				mcp.WithString("Near", mcp.Description("Where the droplet should be close to: a region slug such as nyc3, or a city, country or area such as Frankfurt, India or Europe")),
+				mcp.WithNumber("Limit", mcp.Min(1), mcp.Max(maxPlacementLimit), mcp.DefaultNumber(defaultPlacementLimit), mcp.Description("Number of options to return")),
+			),
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package droplet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPlacementTool_recommend(t *testing.T) {
+	regions := []godo.Region{
+		{Slug: "nyc3", Name: "New York 3", Available: true, Features: []string{"storage"}, Sizes: []string{"s-1vcpu-1gb", "s-2vcpu-4gb", "gpu-h100x1-80gb"}},
+		{Slug: "tor1", Name: "Toronto 1", Available: true, Features: []string{"storage"}, Sizes: []string{"s-1vcpu-1gb", "s-2vcpu-4gb", "gpu-h100x1-80gb"}},
+		{Slug: "fra1", Name: "Frankfurt 1", Available: true, Sizes: []string{"s-1vcpu-1gb", "s-2vcpu-4gb"}},
+		{Slug: "ams3", Name: "Amsterdam 3", Available: true, Features: []string{"storage"}, Sizes: []string{"s-2vcpu-4gb"}},
+		{Slug: "sfo1", Name: "San Francisco 1", Available: false, Sizes: []string{"s-1vcpu-1gb"}},
+	}
+	sizes := []godo.Size{
+		{Slug: "s-1vcpu-1gb", Vcpus: 1, Memory: 1024, Disk: 25, PriceMonthly: 6, Available: true},
+		{Slug: "s-2vcpu-4gb", Vcpus: 2, Memory: 4096, Disk: 80, PriceMonthly: 24, Available: true},
+		{Slug: "s-8vcpu-16gb", Vcpus: 8, Memory: 16384, Disk: 320, PriceMonthly: 96, Available: false},
+		{Slug: "gpu-h100x1-80gb", Vcpus: 20, Memory: 245760, Disk: 720, PriceMonthly: 2500, Available: true, GPUInfo: &godo.GPUInfo{Count: 1, Model: "nvidia_h100"}},
+	}
+	setup := func(t *testing.T) *PlacementTool {
+		ctrl := gomock.NewController(t)
+		mockRegions := NewMockRegionsService(ctrl)
+		mockSizes := NewMockSizesService(ctrl)
+		mockRegions.EXPECT().List(gomock.Any(), gomock.Any()).Return(regions, &godo.Response{}, nil).AnyTimes()
+		mockSizes.EXPECT().List(gomock.Any(), gomock.Any()).Return(sizes, &godo.Response{}, nil).AnyTimes()
+		return NewPlacementTool(func(ctx context.Context) (*godo.Client, error) {
+			return &godo.Client{Regions: mockRegions, Sizes: mockSizes}, nil
+		})
+	}
+	call := func(t *testing.T, args map[string]any) (*mcp.CallToolResult, PlacementRecommendation) {
+		t.Helper()
+		resp, err := setup(t).recommend(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		result, _ := resp.StructuredContent.(PlacementRecommendation)
+		return resp, result
+	}
+	placements := func(result PlacementRecommendation) []string {
+		var out []string
+		for _, o := range result.Options {
+			out = append(out, o.Region+"/"+o.Size)
+		}
+		return out
+	}
+
+	t.Run("Cheapest first without a hint", func(t *testing.T) {
+		_, result := call(t, map[string]any{"MinVcpus": float64(2)})
+		require.Equal(t, []string{"ams3/s-2vcpu-4gb", "fra1/s-2vcpu-4gb", "nyc3/s-2vcpu-4gb", "tor1/s-2vcpu-4gb"}, placements(result))
+		require.Nil(t, result.Options[0].DistanceKm)
+	})
+
+	t.Run("Closest first with a hint", func(t *testing.T) {
+		_, result := call(t, map[string]any{"Near": "Europe", "Limit": float64(4)})
+		require.Equal(t, []string{"fra1/s-1vcpu-1gb", "ams3/s-2vcpu-4gb", "fra1/s-2vcpu-4gb", "nyc3/s-1vcpu-1gb"}, placements(result))
+		require.Equal(t, 0, *result.Options[0].DistanceKm)
+		require.InDelta(t, 5570, *result.Options[3].DistanceKm, 50) // from London
+		require.Equal(t, 7, result.Matched)
+	})
+
+	t.Run("Volumes and memory", func(t *testing.T) {
+		_, result := call(t, map[string]any{"NeedsVolumes": true, "MinMemoryGB": float64(2), "Near": "fra1"})
+		require.Equal(t, []string{"ams3/s-2vcpu-4gb", "nyc3/s-2vcpu-4gb", "tor1/s-2vcpu-4gb"}, placements(result))
+	})
+
+	t.Run("GPU", func(t *testing.T) {
+		_, result := call(t, map[string]any{"GPUModel": "H100", "Near": "tor1"})
+		require.Equal(t, []string{"tor1/gpu-h100x1-80gb", "nyc3/gpu-h100x1-80gb"}, placements(result))
+		require.Equal(t, 1, result.Options[0].GPUs)
+		require.Equal(t, 1, result.Requirements.GPUs)
+	})
+
+	t.Run("Nothing fits", func(t *testing.T) {
+		resp, result := call(t, map[string]any{"MinVcpus": float64(64)})
+		require.False(t, resp.IsError)
+		require.Empty(t, result.Options)
+		require.Contains(t, result.Message, "No available size")
+	})
+
+	t.Run("Unknown hint", func(t *testing.T) {
+		resp, _ := call(t, map[string]any{"Near": "Mars"})
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, `Near "Mars" names no region`)
+	})
+}
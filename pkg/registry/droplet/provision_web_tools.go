@@ -0,0 +1,268 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultProvisionImage   = "ubuntu-24-04-x64"
+	defaultProvisionTimeout = 600
+	defaultProvisionPoll    = 5 * time.Second
+	defaultProvisionTTL     = 1800
+	defaultProvisionRecord  = "@"
+)
+
+// webPorts are the ports the firewall of a provisioned web droplet opens.
+var webPorts = []string{"22", "80", "443"}
+
+// anywhere are the sources and destinations of the rules of a provisioned web droplet.
+var anywhere = []string{"0.0.0.0/0", "::/0"}
+
+// OperationStep is the outcome of one step of a composite droplet tool.
+type OperationStep struct {
+	Step     string `json:"step"`
+	Status   string `json:"status"`
+	ActionID int    `json:"action_id,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+// ProvisionWebDropletResult is the consolidated outcome of provision-web-droplet.
+type ProvisionWebDropletResult struct {
+	Succeeded      bool            `json:"succeeded"`
+	DropletID      int             `json:"droplet_id,omitempty"`
+	DropletName    string          `json:"droplet_name"`
+	PublicIPv4     string          `json:"public_ipv4,omitempty"`
+	SSHKeyID       int             `json:"ssh_key_id,omitempty"`
+	FirewallID     string          `json:"firewall_id,omitempty"`
+	DomainRecordID int             `json:"domain_record_id,omitempty"`
+	FQDN           string          `json:"fqdn,omitempty"`
+	Steps          []OperationStep `json:"steps"`
+	RolledBack     bool            `json:"rolled_back,omitempty"`
+	Error          string          `json:"error,omitempty"`
+}
+
+// step records the outcome of a step.
+func (r *ProvisionWebDropletResult) step(name, status, detail string) {
+	r.Steps = append(r.Steps, OperationStep{Step: name, Status: status, Detail: detail})
+}
+
+// ProvisionWebDropletTool provides a composite tool that creates a droplet ready to serve a website:
+// reachable over SSH, HTTP and HTTPS and named in DNS.
+type ProvisionWebDropletTool struct {
+	client       func(ctx context.Context) (*godo.Client, error)
+	pollInterval time.Duration
+}
+
+// NewProvisionWebDropletTool creates a new provision web droplet tool
+func NewProvisionWebDropletTool(client func(ctx context.Context) (*godo.Client, error)) *ProvisionWebDropletTool {
+	return &ProvisionWebDropletTool{
+		client:       client,
+		pollInterval: defaultProvisionPoll,
+	}
+}
+
+// webFirewallRequest returns the firewall of a web droplet: SSH, HTTP and HTTPS in, everything out.
+func webFirewallRequest(name string, dropletID int) *godo.FirewallRequest {
+	var inbound []godo.InboundRule
+	for _, port := range webPorts {
+		inbound = append(inbound, godo.InboundRule{Protocol: "tcp", PortRange: port, Sources: &godo.Sources{Addresses: anywhere}})
+	}
+	var outbound []godo.OutboundRule
+	for _, protocol := range []string{"tcp", "udp", "icmp"} {
+		rule := godo.OutboundRule{Protocol: protocol, Destinations: &godo.Destinations{Addresses: anywhere}}
+		if protocol != "icmp" {
+			rule.PortRange = "all"
+		}
+		outbound = append(outbound, rule)
+	}
+	return &godo.FirewallRequest{
+		Name:          name + "-web",
+		InboundRules:  inbound,
+		OutboundRules: outbound,
+		DropletIDs:    []int{dropletID},
+	}
+}
+
+// provisionWebDroplet creates a droplet, waits for it to become active, attaches a firewall that
+// allows SSH, HTTP and HTTPS and creates an A record for it. When a step fails, everything created
+// so far is deleted again.
+func (p *ProvisionWebDropletTool) provisionWebDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	region := args.RequiredString("Region")
+	size := args.RequiredString("Size")
+	domain := args.RequiredString("Domain")
+	image := args.String("ImageSlug")
+	recordName := args.String("RecordName")
+	ttl := args.Number("TTL", defaultProvisionTTL)
+	sshKeysList := args.List("SSHKeys")
+	publicKey := args.String("SSHPublicKey")
+	tags := args.Strings("Tags")
+	monitoring := args.Bool("Monitoring", true)
+	timeoutSeconds := args.Number("TimeoutSeconds", defaultProvisionTimeout)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if image == "" {
+		image = defaultProvisionImage
+	}
+	if recordName == "" {
+		recordName = defaultProvisionRecord
+	}
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = defaultProvisionTimeout
+	}
+	sshKeys, err := dropletSSHKeys(sshKeysList)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := p.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	result := &ProvisionWebDropletResult{DropletName: name}
+	var rollback []func(ctx context.Context) error
+	// fail records the failed step and deletes everything created so far, newest first, and
+	// returns the steps as an error result. Cleanup runs even if the caller's context has been
+	// cancelled.
+	fail := func(step string, err error) (*mcp.CallToolResult, error) {
+		result.step(step, "failed", err.Error())
+		result.Error = fmt.Sprintf("%s: %v", step, err)
+		cleanupCtx := context.WithoutCancel(ctx)
+		result.RolledBack = len(rollback) > 0
+		for i := len(rollback) - 1; i >= 0; i-- {
+			if err := rollback[i](cleanupCtx); err != nil {
+				result.RolledBack = false
+				result.Error += fmt.Sprintf("; rollback: %v", err)
+			}
+		}
+		res, err := common.NewToolResultStructured(result)
+		if err != nil {
+			return nil, err
+		}
+		res.IsError = true
+		return res, nil
+	}
+
+	if publicKey != "" {
+		key, _, err := client.Keys.Create(ctx, &godo.KeyCreateRequest{Name: name, PublicKey: publicKey})
+		if err != nil {
+			return fail("ssh key create", err)
+		}
+		result.SSHKeyID = key.ID
+		result.step("ssh key create", "done", fmt.Sprintf("key %d", key.ID))
+		sshKeys = append(sshKeys, godo.DropletCreateSSHKey{ID: key.ID})
+		rollback = append(rollback, func(ctx context.Context) error {
+			if _, err := client.Keys.DeleteByID(ctx, key.ID); err != nil {
+				return fmt.Errorf("ssh key %d delete: %w", key.ID, err)
+			}
+			return nil
+		})
+	}
+
+	created, _, err := client.Droplets.Create(ctx, &godo.DropletCreateRequest{
+		Name:       name,
+		Region:     region,
+		Size:       size,
+		Image:      godo.DropletCreateImage{Slug: image},
+		SSHKeys:    sshKeys,
+		Monitoring: monitoring,
+		Tags:       tags,
+	})
+	if err != nil {
+		return fail("droplet create", err)
+	}
+	dropletID := created.ID
+	result.DropletID = dropletID
+	result.step("droplet create", "done", fmt.Sprintf("droplet %d", dropletID))
+	rollback = append(rollback, func(ctx context.Context) error {
+		if _, err := client.Droplets.Delete(ctx, dropletID); err != nil {
+			return fmt.Errorf("droplet %d delete: %w", dropletID, err)
+		}
+		return nil
+	})
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds*float64(time.Second)))
+	err = waitForDropletStatus(waitCtx, client, dropletID, dropletStatusActive, p.pollInterval)
+	cancel()
+	if err != nil {
+		return fail("wait for active", err)
+	}
+	droplet, _, err := client.Droplets.Get(ctx, dropletID)
+	if err != nil {
+		return fail("droplet get", err)
+	}
+	ip, err := droplet.PublicIPv4()
+	if err != nil || ip == "" {
+		return fail("droplet get", fmt.Errorf("droplet %d has no public IPv4 address", dropletID))
+	}
+	result.PublicIPv4 = ip
+	result.step("wait for active", "done", ip)
+
+	firewall, _, err := client.Firewalls.Create(ctx, webFirewallRequest(name, dropletID))
+	if err != nil {
+		return fail("firewall create", err)
+	}
+	result.FirewallID = firewall.ID
+	result.step("firewall create", "done", fmt.Sprintf("firewall %s allows tcp 22, 80 and 443", firewall.ID))
+	rollback = append(rollback, func(ctx context.Context) error {
+		if _, err := client.Firewalls.Delete(ctx, firewall.ID); err != nil {
+			return fmt.Errorf("firewall %s delete: %w", firewall.ID, err)
+		}
+		return nil
+	})
+
+	record, _, err := client.Domains.CreateRecord(ctx, domain, &godo.DomainRecordEditRequest{
+		Type: "A",
+		Name: recordName,
+		Data: ip,
+		TTL:  int(ttl),
+	})
+	if err != nil {
+		return fail("domain record create", err)
+	}
+	result.DomainRecordID = record.ID
+	result.FQDN = domain
+	if recordName != defaultProvisionRecord {
+		result.FQDN = recordName + "." + domain
+	}
+	result.step("domain record create", "done", fmt.Sprintf("%s A %s", result.FQDN, ip))
+
+	result.Succeeded = true
+	return common.NewToolResultStructured(result)
+}
+
+// Tools returns a list of tool functions
+func (p *ProvisionWebDropletTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: p.provisionWebDroplet,
+			Tool: mcp.NewTool("provision-web-droplet",
+				mcp.WithDescription("Provision a web server in one call: creates a droplet (optionally with a new SSH key), waits until it is active, attaches a firewall allowing tcp 22, 80 and 443, and creates an A record for it in an existing domain. If any step fails, everything created so far is deleted and the result reports the failed step."),
+				common.WithOutputSchema[ProvisionWebDropletResult](),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the droplet, also used for the firewall and a new SSH key")),
+				mcp.WithString("Region", mcp.Required(), mcp.Description("Slug of the region (e.g., nyc3)")),
+				mcp.WithString("Size", mcp.Required(), mcp.Description("Slug of the droplet size (e.g., s-1vcpu-1gb)")),
+				mcp.WithString("Domain", mcp.Required(), mcp.Description("Existing domain to create the A record in (e.g., example.com)")),
+				mcp.WithString("RecordName", mcp.DefaultString(defaultProvisionRecord), mcp.Description("Name of the A record within the domain, e.g. www, or @ for the domain itself")),
+				mcp.WithNumber("TTL", mcp.DefaultNumber(defaultProvisionTTL), mcp.Min(30), mcp.Description("TTL of the A record in seconds")),
+				mcp.WithString("ImageSlug", mcp.DefaultString(defaultProvisionImage), mcp.Description("Slug of the image to use")),
+				mcp.WithArray("SSHKeys", mcp.Description("Array of SSH key IDs (numbers) or fingerprints (strings) to add to the droplet"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithString("SSHPublicKey", mcp.Description("Public key to register as a new SSH key and add to the droplet")),
+				mcp.WithArray("Tags", mcp.Description("Array of tag names to apply to the droplet"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithBoolean("Monitoring", mcp.DefaultBool(true), mcp.Description("Whether to enable monitoring")),
+				mcp.WithNumber("TimeoutSeconds", mcp.DefaultNumber(defaultProvisionTimeout), mcp.Description("How long to wait for the droplet to become active")),
+			),
+		},
+	}
+}
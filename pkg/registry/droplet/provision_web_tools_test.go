@@ -0,0 +1,169 @@
+package droplet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type provisionMocks struct {
+	droplets  *MockDropletsService
+	firewalls *MockFirewallsService
+	domains   *MockDomainsService
+	keys      *MockKeysService
+}
+
+func setupProvisionWebDropletToolWithMocks(m provisionMocks) *ProvisionWebDropletTool {
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Droplets:  m.droplets,
+			Firewalls: m.firewalls,
+			Domains:   m.domains,
+			Keys:      m.keys,
+		}, nil
+	}
+	tool := NewProvisionWebDropletTool(client)
+	tool.pollInterval = time.Millisecond
+	return tool
+}
+
+func TestProvisionWebDropletTool_provisionWebDroplet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	active := &godo.Droplet{ID: 7, Name: "web", Status: "active", Networks: &godo.Networks{V4: []godo.NetworkV4{
+		{IPAddress: "10.0.0.7", Type: "private"},
+		{IPAddress: "203.0.113.7", Type: "public"},
+	}}}
+	baseArgs := func(extra map[string]any) map[string]any {
+		args := map[string]any{"Name": "web", "Region": "nyc3", "Size": "s-1vcpu-1gb", "Domain": "example.com"}
+		for k, v := range extra {
+			args[k] = v
+		}
+		return args
+	}
+
+	tests := []struct {
+		name             string
+		args             map[string]any
+		mockSetup        func(provisionMocks)
+		expectError      bool
+		expectSucceeded  bool
+		expectRolledBack bool
+	}{
+		{
+			name: "Provisions everything",
+			args: baseArgs(map[string]any{"RecordName": "www", "SSHPublicKey": "ssh-ed25519 AAAA"}),
+			mockSetup: func(m provisionMocks) {
+				m.keys.EXPECT().Create(gomock.Any(), &godo.KeyCreateRequest{Name: "web", PublicKey: "ssh-ed25519 AAAA"}).Return(&godo.Key{ID: 42}, nil, nil)
+				m.droplets.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *godo.DropletCreateRequest) (*godo.Droplet, *godo.Response, error) {
+					require.Equal(t, godo.DropletCreateImage{Slug: defaultProvisionImage}, req.Image)
+					require.Equal(t, []godo.DropletCreateSSHKey{{ID: 42}}, req.SSHKeys)
+					return &godo.Droplet{ID: 7, Status: "new"}, nil, nil
+				})
+				gomock.InOrder(
+					m.droplets.EXPECT().Get(gomock.Any(), 7).Return(&godo.Droplet{ID: 7, Status: "new"}, nil, nil),
+					m.droplets.EXPECT().Get(gomock.Any(), 7).Return(active, nil, nil).Times(2),
+				)
+				m.firewalls.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error) {
+					require.Equal(t, []int{7}, req.DropletIDs)
+					var ports []string
+					for _, rule := range req.InboundRules {
+						ports = append(ports, rule.PortRange)
+					}
+					require.Equal(t, []string{"22", "80", "443"}, ports)
+					return &godo.Firewall{ID: "fw-1"}, nil, nil
+				})
+				m.domains.EXPECT().CreateRecord(gomock.Any(), "example.com", &godo.DomainRecordEditRequest{
+					Type: "A", Name: "www", Data: "203.0.113.7", TTL: defaultProvisionTTL,
+				}).Return(&godo.DomainRecord{ID: 99}, nil, nil)
+			},
+			expectSucceeded: true,
+		},
+		{
+			name: "Firewall failure rolls back the droplet and key",
+			args: baseArgs(map[string]any{"SSHPublicKey": "ssh-ed25519 AAAA"}),
+			mockSetup: func(m provisionMocks) {
+				m.keys.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&godo.Key{ID: 42}, nil, nil)
+				m.droplets.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&godo.Droplet{ID: 7, Status: "new"}, nil, nil)
+				m.droplets.EXPECT().Get(gomock.Any(), 7).Return(active, nil, nil).Times(2)
+				m.firewalls.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("quota exceeded"))
+				gomock.InOrder(
+					m.droplets.EXPECT().Delete(gomock.Any(), 7).Return(&godo.Response{}, nil),
+					m.keys.EXPECT().DeleteByID(gomock.Any(), 42).Return(&godo.Response{}, nil),
+				)
+			},
+			expectRolledBack: true,
+		},
+		{
+			name: "Record failure also removes the firewall",
+			args: baseArgs(nil),
+			mockSetup: func(m provisionMocks) {
+				m.droplets.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&godo.Droplet{ID: 7, Status: "new"}, nil, nil)
+				m.droplets.EXPECT().Get(gomock.Any(), 7).Return(active, nil, nil).Times(2)
+				m.firewalls.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&godo.Firewall{ID: "fw-1"}, nil, nil)
+				m.domains.EXPECT().CreateRecord(gomock.Any(), "example.com", gomock.Any()).Return(nil, nil, errors.New("domain not found"))
+				gomock.InOrder(
+					m.firewalls.EXPECT().Delete(gomock.Any(), "fw-1").Return(&godo.Response{}, nil),
+					m.droplets.EXPECT().Delete(gomock.Any(), 7).Return(&godo.Response{}, nil),
+				)
+			},
+			expectRolledBack: true,
+		},
+		{
+			name: "Droplet create failure leaves nothing behind",
+			args: baseArgs(nil),
+			mockSetup: func(m provisionMocks) {
+				m.droplets.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("size unavailable"))
+			},
+		},
+		{
+			name:        "Missing domain",
+			args:        map[string]any{"Name": "web", "Region": "nyc3", "Size": "s-1vcpu-1gb"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := provisionMocks{
+				droplets:  NewMockDropletsService(ctrl),
+				firewalls: NewMockFirewallsService(ctrl),
+				domains:   NewMockDomainsService(ctrl),
+				keys:      NewMockKeysService(ctrl),
+			}
+			if tc.mockSetup != nil {
+				tc.mockSetup(m)
+			}
+			tool := setupProvisionWebDropletToolWithMocks(m)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.provisionWebDroplet(context.Background(), req)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.Equal(t, !tc.expectSucceeded, resp.IsError)
+			var result ProvisionWebDropletResult
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+			require.Equal(t, tc.expectSucceeded, result.Succeeded)
+			require.Equal(t, tc.expectRolledBack, result.RolledBack)
+			if tc.expectSucceeded {
+				require.Equal(t, "203.0.113.7", result.PublicIPv4)
+				require.Equal(t, "www.example.com", result.FQDN)
+				require.Equal(t, 99, result.DomainRecordID)
+				require.Empty(t, result.Error)
+			} else {
+				require.NotEmpty(t, result.Error)
+			}
+		})
+	}
+}
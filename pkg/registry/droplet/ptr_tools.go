@@ -0,0 +1,261 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultPTRPoll = 3 * time.Second
+	// ptrRecordTTL is the TTL of the forward records droplet-ptr-set creates.
+	ptrRecordTTL = 3600
+)
+
+// hostnameLabel matches a label of a hostname.
+var hostnameLabel = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// PTRAddress is a public address of a droplet, and the forward record of its reverse DNS name.
+type PTRAddress struct {
+	IP   string `json:"ip"`
+	Type string `json:"type"`
+	// ForwardConfirmed reports whether an A or AAAA record of the hostname points back at the
+	// address in a domain managed in the account, as mail servers check.
+	ForwardConfirmed bool `json:"forward_confirmed"`
+	ForwardRecordID  int  `json:"forward_record_id,omitempty"`
+}
+
+// DropletPTR is the reverse DNS of the public addresses of a droplet.
+type DropletPTR struct {
+	DropletID   int    `json:"droplet_id"`
+	DropletName string `json:"droplet_name"`
+	// Hostname is the name the PTR records of the addresses resolve to, which is the droplet name
+	// when it is a fully qualified domain name; empty when it isn't and no PTR record is set.
+	Hostname string `json:"hostname"`
+	// Domain is the domain managed in the account that holds the forward records of Hostname.
+	Domain    string       `json:"domain,omitempty"`
+	Addresses []PTRAddress `json:"addresses"`
+	Action    *godo.Action `json:"action,omitempty"`
+	Message   string       `json:"message"`
+}
+
+// DropletPTRTool provides tools that read and set the reverse DNS of droplets.
+type DropletPTRTool struct {
+	client       func(ctx context.Context) (*godo.Client, error)
+	pollInterval time.Duration
+}
+
+// NewDropletPTRTool creates a new DropletPTRTool.
+func NewDropletPTRTool(client func(ctx context.Context) (*godo.Client, error)) *DropletPTRTool {
+	return &DropletPTRTool{client: client, pollInterval: defaultPTRPoll}
+}
+
+// isFQDN reports whether name is a fully qualified domain name, which DigitalOcean sets the PTR
+// records of a droplet's addresses to.
+func isFQDN(name string) bool {
+	labels := strings.Split(name, ".")
+	if len(name) > 253 || len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if !hostnameLabel.MatchString(label) {
+			return false
+		}
+	}
+	// the top-level domain is not numeric, or the name would be an IPv4 address.
+	return strings.Trim(labels[len(labels)-1], "0123456789") != ""
+}
+
+// managedDomain returns the longest domain managed in the account that hostname is in, and the
+// name of hostname's records within it; an empty domain when there is none.
+func managedDomain(ctx context.Context, client *godo.Client, hostname string) (string, string, error) {
+	domains, err := common.ListAll(ctx, dropletsPageSize, client.Domains.List)
+	if err != nil {
+		return "", "", fmt.Errorf("domains: %w", err)
+	}
+	var domain string
+	for _, d := range domains {
+		if (hostname == d.Name || strings.HasSuffix(hostname, "."+d.Name)) && len(d.Name) > len(domain) {
+			domain = d.Name
+		}
+	}
+	if domain == "" || hostname == domain {
+		return domain, "@", nil
+	}
+	return domain, strings.TrimSuffix(hostname, "."+domain), nil
+}
+
+// ptrOf returns the reverse DNS of droplet, checking the forward records of its hostname when
+// they are in a domain managed in the account, and the name of those records in the domain.
+func ptrOf(ctx context.Context, client *godo.Client, droplet *godo.Droplet) (*DropletPTR, string, error) {
+	ptr := &DropletPTR{DropletID: droplet.ID, DropletName: droplet.Name, Addresses: []PTRAddress{}}
+	if droplet.Networks != nil {
+		for _, network := range droplet.Networks.V4 {
+			if network.Type == "public" {
+				ptr.Addresses = append(ptr.Addresses, PTRAddress{IP: network.IPAddress, Type: "A"})
+			}
+		}
+		for _, network := range droplet.Networks.V6 {
+			if network.Type == "public" {
+				ptr.Addresses = append(ptr.Addresses, PTRAddress{IP: network.IPAddress, Type: "AAAA"})
+			}
+		}
+	}
+	if !isFQDN(droplet.Name) {
+		return ptr, "", nil
+	}
+	ptr.Hostname = droplet.Name
+
+	domain, recordName, err := managedDomain(ctx, client, ptr.Hostname)
+	if err != nil || domain == "" {
+		return ptr, "", err
+	}
+	ptr.Domain = domain
+	records, err := common.ListAll(ctx, dropletsPageSize, func(ctx context.Context, opt *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+		return client.Domains.RecordsByName(ctx, domain, ptr.Hostname, opt)
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("records of %s: %w", domain, err)
+	}
+	for i := range ptr.Addresses {
+		address := &ptr.Addresses[i]
+		for _, record := range records {
+			if record.Type == address.Type && record.Data == address.IP {
+				address.ForwardConfirmed, address.ForwardRecordID = true, record.ID
+			}
+		}
+	}
+	return ptr, recordName, nil
+}
+
+// getPTR returns the reverse DNS of the public addresses of a droplet.
+func (p *DropletPTRTool) getPTR(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	id := args.RequiredNumber("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := p.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	droplet, _, err := client.Droplets.Get(ctx, int(id))
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	ptr, _, err := ptrOf(ctx, client, droplet)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	switch {
+	case ptr.Hostname == "":
+		ptr.Message = fmt.Sprintf("no PTR records are set, since the droplet name %q is not a fully qualified domain name; set one with droplet-ptr-set", droplet.Name)
+	case ptr.Domain == "":
+		ptr.Message = fmt.Sprintf("the PTR records resolve to %s, whose domain is not managed in this account, so its forward records were not checked", ptr.Hostname)
+	default:
+		ptr.Message = fmt.Sprintf("the PTR records resolve to %s", ptr.Hostname)
+	}
+	return common.NewToolResultStructured(ptr)
+}
+
+// setPTR sets the reverse DNS of the public addresses of a droplet by renaming it to Hostname and,
+// with ForwardRecords, creates the A and AAAA records of Hostname that are missing when its domain
+// is managed in the account.
+func (p *DropletPTRTool) setPTR(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	id := args.RequiredNumber("ID")
+	hostname := strings.ToLower(strings.TrimSuffix(args.RequiredString("Hostname"), "."))
+	forward := args.Bool("ForwardRecords", true)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if !isFQDN(hostname) {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: Hostname %q is not a fully qualified domain name, e.g. mail.example.com", hostname)), nil
+	}
+
+	client, err := p.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	droplet, _, err := client.Droplets.Get(ctx, int(id))
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	// the PTR records of a droplet follow its name.
+	var action *godo.Action
+	if droplet.Name != hostname {
+		if action, _, err = client.DropletActions.Rename(ctx, droplet.ID, hostname); err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		if action, err = waitForAction(ctx, p.pollInterval, func(ctx context.Context) (*godo.Action, *godo.Response, error) {
+			return client.DropletActions.Get(ctx, droplet.ID, action.ID)
+		}); err != nil {
+			return mcp.NewToolResultErrorFromErr("rename failed", err), nil
+		}
+		droplet.Name = hostname
+	}
+
+	ptr, recordName, err := ptrOf(ctx, client, droplet)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	ptr.Action = action
+	if ptr.Domain == "" {
+		ptr.Message = fmt.Sprintf("the PTR records now resolve to %s; its domain is not managed in this account, so create its A and AAAA records where it is hosted for the forward lookup to match", hostname)
+		return common.NewToolResultStructured(ptr)
+	}
+	created := 0
+	for i := range ptr.Addresses {
+		address := &ptr.Addresses[i]
+		if !forward || address.ForwardConfirmed {
+			continue
+		}
+		r, _, err := client.Domains.CreateRecord(ctx, ptr.Domain, &godo.DomainRecordEditRequest{
+			Type: address.Type,
+			Name: recordName,
+			Data: address.IP,
+			TTL:  ptrRecordTTL,
+		})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("the PTR records now resolve to %s, but creating its %s record failed", hostname, address.Type), err), nil
+		}
+		address.ForwardConfirmed, address.ForwardRecordID = true, r.ID
+		created++
+	}
+	ptr.Message = fmt.Sprintf("the PTR records now resolve to %s; %d forward records were created in %s", hostname, created, ptr.Domain)
+	return common.NewToolResultStructured(ptr)
+}
+
+// Tools returns the list of server tools for the reverse DNS of droplets.
+func (p *DropletPTRTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: p.getPTR,
+			Tool: mcp.NewTool("droplet-ptr-get",
+				mcp.WithDescription("Get the reverse DNS (PTR) of the public IPv4 and IPv6 addresses of a droplet. DigitalOcean sets their PTR records to the droplet name when it is a fully qualified domain name. When the name is in a domain managed in the account, also reports whether its A and AAAA records point back at the addresses, as mail servers check."),
+				common.WithOutputSchema[DropletPTR](),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
+			),
+		},
+		{
+			Handler: p.setPTR,
+			Tool: mcp.NewTool("droplet-ptr-set",
+				mcp.WithDescription("Set the reverse DNS (PTR) of the public IPv4 and IPv6 addresses of a droplet, e.g. for a mail server, by renaming the droplet to Hostname. When Hostname is in a domain managed in the account, the missing A and AAAA records of Hostname pointing at the addresses are created too, so the forward lookup matches. Reserved IPs have no configurable PTR records."),
+				common.WithOutputSchema[DropletPTR](),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet")),
+				mcp.WithString("Hostname", mcp.Required(), mcp.Description("Fully qualified domain name the addresses resolve to, e.g. mail.example.com. The droplet is renamed to it")),
+				mcp.WithBoolean("ForwardRecords", mcp.DefaultBool(true), mcp.Description("Create the missing A and AAAA records of Hostname when its domain is managed in the account")),
+			),
+		},
+	}
+}
@@ -0,0 +1,139 @@
+package droplet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIsFQDN(t *testing.T) {
+	for name, want := range map[string]bool{
+		"mail.example.com":    true,
+		"mx-1.eu.example.org": true,
+		"ubuntu-s-1vcpu-nyc1": false,
+		"mail.example.com.":   false,
+		"-mail.example.com":   false,
+		"10.0.0.1":            false,
+		"Mail.Example.com":    false,
+		"mail..example.com":   false,
+		"mail.example.c0m":    true,
+		"mail_1.example.com":  false,
+		"a.b":                 true,
+		"web.example.123":     false,
+	} {
+		require.Equal(t, want, isFQDN(name), name)
+	}
+}
+
+func TestDropletPTRTool(t *testing.T) {
+	networks := &godo.Networks{
+		V4: []godo.NetworkV4{{IPAddress: "203.0.113.10", Type: "public"}, {IPAddress: "10.0.0.5", Type: "private"}},
+		V6: []godo.NetworkV6{{IPAddress: "2001:db8::10", Type: "public"}},
+	}
+	type mocks struct {
+		droplets *MockDropletsService
+		actions  *MockDropletActionsService
+		domains  *MockDomainsService
+	}
+	setup := func(t *testing.T, name string) (*DropletPTRTool, mocks) {
+		ctrl := gomock.NewController(t)
+		m := mocks{NewMockDropletsService(ctrl), NewMockDropletActionsService(ctrl), NewMockDomainsService(ctrl)}
+		m.droplets.EXPECT().Get(gomock.Any(), 10).Return(&godo.Droplet{ID: 10, Name: name, Networks: networks}, nil, nil)
+		client := &godo.Client{Droplets: m.droplets, DropletActions: m.actions, Domains: m.domains}
+		tool := NewDropletPTRTool(func(ctx context.Context) (*godo.Client, error) { return client, nil })
+		tool.pollInterval = time.Millisecond
+		return tool, m
+	}
+	domains := func(m mocks, names ...string) {
+		var list []godo.Domain
+		for _, name := range names {
+			list = append(list, godo.Domain{Name: name})
+		}
+		m.domains.EXPECT().List(gomock.Any(), gomock.Any()).Return(list, &godo.Response{}, nil)
+	}
+	call := func(t *testing.T, handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), args map[string]any) (*mcp.CallToolResult, *DropletPTR) {
+		t.Helper()
+		resp, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		ptr, _ := resp.StructuredContent.(*DropletPTR)
+		return resp, ptr
+	}
+
+	t.Run("Get forward confirmed", func(t *testing.T) {
+		tool, m := setup(t, "mail.example.com")
+		domains(m, "example.com", "eu.example.com")
+		m.domains.EXPECT().RecordsByName(gomock.Any(), "example.com", "mail.example.com", gomock.Any()).Return([]godo.DomainRecord{
+			{ID: 1, Type: "A", Name: "mail", Data: "203.0.113.10"},
+			{ID: 2, Type: "MX", Name: "mail", Data: "mail.example.com"},
+		}, &godo.Response{}, nil)
+
+		resp, ptr := call(t, tool.getPTR, map[string]any{"ID": float64(10)})
+		require.False(t, resp.IsError)
+		require.Equal(t, "mail.example.com", ptr.Hostname)
+		require.Equal(t, "example.com", ptr.Domain)
+		require.Equal(t, []PTRAddress{
+			{IP: "203.0.113.10", Type: "A", ForwardConfirmed: true, ForwardRecordID: 1},
+			{IP: "2001:db8::10", Type: "AAAA"},
+		}, ptr.Addresses)
+	})
+
+	t.Run("Get without a hostname", func(t *testing.T) {
+		tool, _ := setup(t, "ubuntu-s-1vcpu-1gb-nyc1-01")
+		resp, ptr := call(t, tool.getPTR, map[string]any{"ID": float64(10)})
+		require.False(t, resp.IsError)
+		require.Empty(t, ptr.Hostname)
+		require.Contains(t, ptr.Message, "not a fully qualified domain name")
+	})
+
+	t.Run("Set renames and creates the missing records", func(t *testing.T) {
+		tool, m := setup(t, "web-1")
+		m.actions.EXPECT().Rename(gomock.Any(), 10, "mail.example.com").Return(&godo.Action{ID: 7, Status: "in-progress"}, nil, nil)
+		m.actions.EXPECT().Get(gomock.Any(), 10, 7).Return(&godo.Action{ID: 7, Status: godo.ActionCompleted}, nil, nil)
+		domains(m, "example.com")
+		m.domains.EXPECT().RecordsByName(gomock.Any(), "example.com", "mail.example.com", gomock.Any()).Return([]godo.DomainRecord{
+			{ID: 1, Type: "AAAA", Name: "mail", Data: "2001:db8::10"},
+		}, &godo.Response{}, nil)
+		m.domains.EXPECT().CreateRecord(gomock.Any(), "example.com", &godo.DomainRecordEditRequest{
+			Type: "A", Name: "mail", Data: "203.0.113.10", TTL: ptrRecordTTL,
+		}).Return(&godo.DomainRecord{ID: 2}, nil, nil)
+
+		resp, ptr := call(t, tool.setPTR, map[string]any{"ID": float64(10), "Hostname": "Mail.Example.com."})
+		require.False(t, resp.IsError)
+		require.Equal(t, "mail.example.com", ptr.DropletName)
+		require.Equal(t, 7, ptr.Action.ID)
+		require.Equal(t, 2, ptr.Addresses[0].ForwardRecordID)
+		require.True(t, ptr.Addresses[1].ForwardConfirmed)
+		require.Contains(t, ptr.Message, "1 forward records were created")
+	})
+
+	t.Run("Set in a domain hosted elsewhere", func(t *testing.T) {
+		tool, m := setup(t, "mail.example.net")
+		domains(m, "example.com")
+
+		resp, ptr := call(t, tool.setPTR, map[string]any{"ID": float64(10), "Hostname": "mail.example.net"})
+		require.False(t, resp.IsError)
+		require.Nil(t, ptr.Action)
+		require.Contains(t, ptr.Message, "not managed in this account")
+	})
+
+	t.Run("Set rename error", func(t *testing.T) {
+		tool, m := setup(t, "web-1")
+		m.actions.EXPECT().Rename(gomock.Any(), 10, "mail.example.com").Return(nil, nil, errors.New("droplet is locked"))
+
+		resp, _ := call(t, tool.setPTR, map[string]any{"ID": float64(10), "Hostname": "mail.example.com"})
+		require.True(t, resp.IsError)
+	})
+
+	t.Run("Set invalid hostname", func(t *testing.T) {
+		tool := NewDropletPTRTool(func(ctx context.Context) (*godo.Client, error) { return &godo.Client{}, nil })
+		resp, _ := call(t, tool.setPTR, map[string]any{"ID": float64(10), "Hostname": "mail"})
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "not a fully qualified domain name")
+	})
+}
@@ -0,0 +1,347 @@
+package droplet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// ResourceScheme is the URI scheme of the droplet, image, region and size resources.
+	ResourceScheme = "digitalocean://"
+
+	DropletsURI = ResourceScheme + "droplets"
+	ImagesURI   = ResourceScheme + "images"
+	RegionsURI  = ResourceScheme + "regions"
+	SizesURI    = ResourceScheme + "sizes"
+
+	resourcePageSize = 200
+)
+
+// DropletSummary is a droplet as listed by the digitalocean://droplets resource.
+type DropletSummary struct {
+	ID        int      `json:"id"`
+	Name      string   `json:"name"`
+	Status    string   `json:"status"`
+	Region    string   `json:"region,omitempty"`
+	Size      string   `json:"size"`
+	Image     string   `json:"image,omitempty"`
+	PublicIP  string   `json:"public_ipv4,omitempty"`
+	PrivateIP string   `json:"private_ipv4,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+	URI       string   `json:"uri"`
+}
+
+// ImageSummary is an image as listed by the digitalocean://images resource.
+type ImageSummary struct {
+	ID           int      `json:"id"`
+	Slug         string   `json:"slug,omitempty"`
+	Name         string   `json:"name"`
+	Distribution string   `json:"distribution,omitempty"`
+	Type         string   `json:"type"`
+	Public       bool     `json:"public"`
+	Regions      []string `json:"regions"`
+	URI          string   `json:"uri"`
+}
+
+// CatalogResources exposes droplets, images, regions and sizes as MCP resources, so clients
+// can attach live context without calling tools.
+type CatalogResources struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+// NewCatalogResources creates the droplet, image, region and size resources
+func NewCatalogResources(client func(ctx context.Context) (*godo.Client, error)) *CatalogResources {
+	return &CatalogResources{client: client}
+}
+
+func jsonContents(uri string, v any) ([]mcp.ResourceContents, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{URI: uri, MIMEType: "application/json", Text: string(data)},
+	}, nil
+}
+
+// templateArg returns a variable matched from a resource template, falling back to the last
+// segment of the URI.
+func templateArg(req mcp.ReadResourceRequest, name string) string {
+	switch v := req.Params.Arguments[name].(type) {
+	case string:
+		return v
+	case []string:
+		if len(v) > 0 {
+			return v[0]
+		}
+	}
+	uri := req.Params.URI
+	return uri[strings.LastIndex(uri, "/")+1:]
+}
+
+func (c *CatalogResources) getClient(ctx context.Context) (*godo.Client, error) {
+	client, err := c.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	return client, nil
+}
+
+func summarizeDroplet(d godo.Droplet) DropletSummary {
+	s := DropletSummary{
+		ID:     d.ID,
+		Name:   d.Name,
+		Status: d.Status,
+		Size:   d.SizeSlug,
+		Tags:   d.Tags,
+		URI:    fmt.Sprintf("%s/%d", DropletsURI, d.ID),
+	}
+	if d.Region != nil {
+		s.Region = d.Region.Slug
+	}
+	if d.Image != nil {
+		s.Image = d.Image.Slug
+		if s.Image == "" {
+			s.Image = d.Image.Name
+		}
+	}
+	s.PublicIP, _ = d.PublicIPv4()
+	s.PrivateIP, _ = d.PrivateIPv4()
+	return s
+}
+
+func summarizeImage(i godo.Image) ImageSummary {
+	return ImageSummary{
+		ID:           i.ID,
+		Slug:         i.Slug,
+		Name:         i.Name,
+		Distribution: i.Distribution,
+		Type:         i.Type,
+		Public:       i.Public,
+		Regions:      i.Regions,
+		URI:          fmt.Sprintf("%s/%d", ImagesURI, i.ID),
+	}
+}
+
+func (c *CatalogResources) listDroplets(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	client, err := c.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	droplets, err := common.ListAll(ctx, resourcePageSize, client.Droplets.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list droplets: %w", err)
+	}
+	summaries := make([]DropletSummary, 0, len(droplets))
+	for _, d := range droplets {
+		summaries = append(summaries, summarizeDroplet(d))
+	}
+	return jsonContents(req.Params.URI, summaries)
+}
+
+func (c *CatalogResources) getDroplet(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	id, err := strconv.Atoi(templateArg(req, "id"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid droplet ID in %s", req.Params.URI)
+	}
+	client, err := c.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	droplet, _, err := client.Droplets.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get droplet %d: %w", id, err)
+	}
+	return jsonContents(req.Params.URI, droplet)
+}
+
+// listImages lists the distribution images and the account's own images. One-click application
+// images are left out to keep the resource small; they are available from the image-list tool.
+func (c *CatalogResources) listImages(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	client, err := c.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	distributions, err := common.ListAll(ctx, resourcePageSize, client.Images.ListDistribution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list distribution images: %w", err)
+	}
+	user, err := common.ListAll(ctx, resourcePageSize, client.Images.ListUser)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user images: %w", err)
+	}
+	summaries := make([]ImageSummary, 0, len(distributions)+len(user))
+	for _, i := range append(distributions, user...) {
+		summaries = append(summaries, summarizeImage(i))
+	}
+	return jsonContents(req.Params.URI, summaries)
+}
+
+// getImage accepts an image ID or slug.
+func (c *CatalogResources) getImage(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	ref := templateArg(req, "id")
+	client, err := c.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var image *godo.Image
+	if id, convErr := strconv.Atoi(ref); convErr == nil {
+		image, _, err = client.Images.GetByID(ctx, id)
+	} else {
+		image, _, err = client.Images.GetBySlug(ctx, ref)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get image %s: %w", ref, err)
+	}
+	return jsonContents(req.Params.URI, image)
+}
+
+func (c *CatalogResources) listRegions(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	client, err := c.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	regions, err := common.ListAll(ctx, resourcePageSize, client.Regions.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list regions: %w", err)
+	}
+	return jsonContents(req.Params.URI, regions)
+}
+
+// getRegion looks the region up in the region list, since the API has no endpoint for a single region.
+func (c *CatalogResources) getRegion(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	slug := templateArg(req, "slug")
+	client, err := c.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	regions, err := common.ListAll(ctx, resourcePageSize, client.Regions.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list regions: %w", err)
+	}
+	for _, r := range regions {
+		if r.Slug == slug {
+			return jsonContents(req.Params.URI, r)
+		}
+	}
+	return nil, fmt.Errorf("region %s not found", slug)
+}
+
+func (c *CatalogResources) listSizes(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	client, err := c.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sizes, err := common.ListAll(ctx, resourcePageSize, client.Sizes.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sizes: %w", err)
+	}
+	return jsonContents(req.Params.URI, sizes)
+}
+
+// getSize looks the size up in the size list, since the API has no endpoint for a single size.
+func (c *CatalogResources) getSize(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	slug := templateArg(req, "slug")
+	client, err := c.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sizes, err := common.ListAll(ctx, resourcePageSize, client.Sizes.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sizes: %w", err)
+	}
+	for _, s := range sizes {
+		if s.Slug == slug {
+			return jsonContents(req.Params.URI, s)
+		}
+	}
+	return nil, fmt.Errorf("size %s not found", slug)
+}
+
+// Resources returns the list resources for droplets, images, regions and sizes
+func (c *CatalogResources) Resources() []server.ServerResource {
+	return []server.ServerResource{
+		{
+			Resource: mcp.NewResource(DropletsURI, "Droplets",
+				mcp.WithResourceDescription("Summary of every droplet in the account: ID, name, status, region, size, image, IP addresses, tags and the URI of its full details."),
+				mcp.WithMIMEType("application/json"),
+			),
+			Handler: c.listDroplets,
+		},
+		{
+			Resource: mcp.NewResource(ImagesURI, "Images",
+				mcp.WithResourceDescription("Distribution images and the account's own snapshots and custom images. One-click application images are not included."),
+				mcp.WithMIMEType("application/json"),
+			),
+			Handler: c.listImages,
+		},
+		{
+			Resource: mcp.NewResource(RegionsURI, "Regions",
+				mcp.WithResourceDescription("Every DigitalOcean region with its availability, features and available sizes."),
+				mcp.WithMIMEType("application/json"),
+			),
+			Handler: c.listRegions,
+		},
+		{
+			Resource: mcp.NewResource(SizesURI, "Droplet sizes",
+				mcp.WithResourceDescription("Every droplet size with its vCPUs, memory, disk, transfer, prices and regions."),
+				mcp.WithMIMEType("application/json"),
+			),
+			Handler: c.listSizes,
+		},
+	}
+}
+
+// ResourceTemplates returns the resource templates for a single droplet, image, region or size
+func (c *CatalogResources) ResourceTemplates() []server.ServerResourceTemplate {
+	return []server.ServerResourceTemplate{
+		{
+			Template: mcp.NewResourceTemplate(DropletsURI+"/{id}", "Droplet",
+				mcp.WithTemplateDescription("Full details of a droplet by ID."),
+				mcp.WithTemplateMIMEType("application/json"),
+			),
+			Handler: c.getDroplet,
+		},
+		{
+			Template: mcp.NewResourceTemplate(ImagesURI+"/{id}", "Image",
+				mcp.WithTemplateDescription("Full details of an image by ID or slug, e.g. digitalocean://images/ubuntu-24-04-x64."),
+				mcp.WithTemplateMIMEType("application/json"),
+			),
+			Handler: c.getImage,
+		},
+		{
+			Template: mcp.NewResourceTemplate(RegionsURI+"/{slug}", "Region",
+				mcp.WithTemplateDescription("A region by slug, e.g. digitalocean://regions/nyc3."),
+				mcp.WithTemplateMIMEType("application/json"),
+			),
+			Handler: c.getRegion,
+		},
+		{
+			Template: mcp.NewResourceTemplate(SizesURI+"/{slug}", "Droplet size",
+				mcp.WithTemplateDescription("A droplet size by slug, e.g. digitalocean://sizes/s-1vcpu-1gb."),
+				mcp.WithTemplateMIMEType("application/json"),
+			),
+			Handler: c.getSize,
+		},
+	}
+}
+
+// ItemURIs maps the list tools of droplets, images, regions and sizes to the URI template of the
+// resource of one of their items, so that summarized lists can link to the full details.
+func ItemURIs() map[string]string {
+	return map[string]string{
+		"droplet-list": DropletsURI + "/{id}",
+		"image-list":   ImagesURI + "/{id}",
+		"region-list":  RegionsURI + "/{slug}",
+		"size-list":    SizesURI + "/{slug}",
+	}
+}
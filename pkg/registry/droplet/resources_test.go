@@ -0,0 +1,148 @@
+package droplet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type catalogMocks struct {
+	droplets *MockDropletsService
+	images   *MockImagesService
+	regions  *MockRegionsService
+	sizes    *MockSizesService
+}
+
+// setupCatalogServerWithMocks registers the catalog resources with a server, so reads go through
+// the server's resource template matching.
+func setupCatalogServerWithMocks(ctrl *gomock.Controller) (*server.MCPServer, *catalogMocks) {
+	m := &catalogMocks{
+		droplets: NewMockDropletsService(ctrl),
+		images:   NewMockImagesService(ctrl),
+		regions:  NewMockRegionsService(ctrl),
+		sizes:    NewMockSizesService(ctrl),
+	}
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{Droplets: m.droplets, Images: m.images, Regions: m.regions, Sizes: m.sizes}, nil
+	}
+	resources := NewCatalogResources(client)
+	s := server.NewMCPServer("test", "0.0.0")
+	s.AddResources(resources.Resources()...)
+	s.AddResourceTemplates(resources.ResourceTemplates()...)
+	return s, m
+}
+
+// readResource reads uri through the server and returns the text or the JSON-RPC error message.
+func readResource(t *testing.T, s *server.MCPServer, uri string) (string, string) {
+	t.Helper()
+	msg, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "resources/read",
+		"params":  map[string]any{"uri": uri},
+	})
+	require.NoError(t, err)
+	switch resp := s.HandleMessage(context.Background(), msg).(type) {
+	case mcp.JSONRPCResponse:
+		result := resp.Result.(mcp.ReadResourceResult)
+		require.Len(t, result.Contents, 1)
+		text := result.Contents[0].(mcp.TextResourceContents)
+		require.Equal(t, uri, text.URI)
+		return text.Text, ""
+	case mcp.JSONRPCError:
+		return "", resp.Error.Message
+	default:
+		t.Fatalf("unexpected response %T", resp)
+		return "", ""
+	}
+}
+
+func TestCatalogResources_droplets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s, m := setupCatalogServerWithMocks(ctrl)
+
+	m.droplets.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Droplet{{
+		ID:       42,
+		Name:     "web",
+		Status:   "active",
+		SizeSlug: "s-1vcpu-1gb",
+		Region:   &godo.Region{Slug: "nyc3"},
+		Image:    &godo.Image{Slug: "ubuntu-24-04-x64"},
+		Networks: &godo.Networks{V4: []godo.NetworkV4{{IPAddress: "203.0.113.10", Type: "public"}}},
+	}}, &godo.Response{}, nil)
+
+	text, errMsg := readResource(t, s, DropletsURI)
+	require.Empty(t, errMsg)
+	var summaries []DropletSummary
+	require.NoError(t, json.Unmarshal([]byte(text), &summaries))
+	require.Equal(t, []DropletSummary{{
+		ID:       42,
+		Name:     "web",
+		Status:   "active",
+		Region:   "nyc3",
+		Size:     "s-1vcpu-1gb",
+		Image:    "ubuntu-24-04-x64",
+		PublicIP: "203.0.113.10",
+		URI:      "digitalocean://droplets/42",
+	}}, summaries)
+
+	m.droplets.EXPECT().Get(gomock.Any(), 42).Return(&godo.Droplet{ID: 42, Name: "web"}, nil, nil)
+	text, errMsg = readResource(t, s, "digitalocean://droplets/42")
+	require.Empty(t, errMsg)
+	require.Contains(t, text, `"name": "web"`)
+
+	_, errMsg = readResource(t, s, "digitalocean://droplets/web")
+	require.Contains(t, errMsg, "invalid droplet ID")
+}
+
+func TestCatalogResources_images(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s, m := setupCatalogServerWithMocks(ctrl)
+
+	m.images.EXPECT().ListDistribution(gomock.Any(), gomock.Any()).Return([]godo.Image{{ID: 1, Slug: "ubuntu-24-04-x64", Type: "base", Public: true}}, &godo.Response{}, nil)
+	m.images.EXPECT().ListUser(gomock.Any(), gomock.Any()).Return([]godo.Image{{ID: 2, Name: "web-snapshot", Type: "snapshot"}}, &godo.Response{}, nil)
+	text, errMsg := readResource(t, s, ImagesURI)
+	require.Empty(t, errMsg)
+	var summaries []ImageSummary
+	require.NoError(t, json.Unmarshal([]byte(text), &summaries))
+	require.Len(t, summaries, 2)
+	require.Equal(t, "digitalocean://images/2", summaries[1].URI)
+
+	m.images.EXPECT().GetByID(gomock.Any(), 2).Return(&godo.Image{ID: 2, Name: "web-snapshot"}, nil, nil)
+	_, errMsg = readResource(t, s, "digitalocean://images/2")
+	require.Empty(t, errMsg)
+
+	m.images.EXPECT().GetBySlug(gomock.Any(), "ubuntu-24-04-x64").Return(nil, nil, errors.New("not found"))
+	_, errMsg = readResource(t, s, "digitalocean://images/ubuntu-24-04-x64")
+	require.Contains(t, errMsg, "not found")
+}
+
+func TestCatalogResources_regionsAndSizes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s, m := setupCatalogServerWithMocks(ctrl)
+
+	m.regions.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Region{{Slug: "nyc3", Name: "New York 3"}, {Slug: "ams3"}}, &godo.Response{}, nil).Times(2)
+	text, errMsg := readResource(t, s, "digitalocean://regions/nyc3")
+	require.Empty(t, errMsg)
+	require.Contains(t, text, "New York 3")
+	_, errMsg = readResource(t, s, "digitalocean://regions/xyz1")
+	require.Contains(t, errMsg, "region xyz1 not found")
+
+	m.sizes.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Size{{Slug: "s-1vcpu-1gb", Vcpus: 1}}, &godo.Response{}, nil).Times(2)
+	text, errMsg = readResource(t, s, SizesURI)
+	require.Empty(t, errMsg)
+	require.Contains(t, text, "s-1vcpu-1gb")
+	text, errMsg = readResource(t, s, "digitalocean://sizes/s-1vcpu-1gb")
+	require.Empty(t, errMsg)
+	require.Contains(t, text, `"vcpus": 1`)
+}
@@ -1,9 +1,14 @@
 package droplet
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
 
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -74,6 +79,74 @@ func (s *SizesTool) listSizes(ctx context.Context, req mcp.CallToolRequest) (*mc
 	return mcp.NewToolResultText(string(jsonData)), nil
 }
 
+// GPUSize is a droplet size with GPUs, as listed by gpu-size-list.
+type GPUSize struct {
+	Slug         string     `json:"slug"`
+	Description  string     `json:"description,omitempty"`
+	GPUs         int        `json:"gpus"`
+	GPUModel     string     `json:"gpu_model"`
+	GPUVRAM      *godo.VRAM `json:"gpu_vram,omitempty"`
+	Vcpus        int        `json:"vcpus"`
+	Memory       int        `json:"memory"`
+	Disk         int        `json:"disk"`
+	PriceMonthly float64    `json:"price_monthly"`
+	PriceHourly  float64    `json:"price_hourly"`
+	// Regions are the regions the size can currently be created in.
+	Regions []string `json:"regions"`
+}
+
+// GPUSizeList is the result of gpu-size-list.
+type GPUSizeList struct {
+	Sizes []GPUSize `json:"sizes"`
+}
+
+// listGPUSizes lists the available droplet sizes with GPUs, cheapest first, optionally only those
+// offered in a region or of a GPU model.
+func (s *SizesTool) listGPUSizes(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	region := args.String("Region")
+	model := strings.ToLower(args.String("GPUModel"))
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+	sizes, err := common.ListAll(ctx, resourcePageSize, client.Sizes.List)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	result := GPUSizeList{Sizes: []GPUSize{}}
+	for _, size := range sizes {
+		switch {
+		case size.GPUInfo == nil || size.GPUInfo.Count == 0 || !size.Available:
+		case region != "" && !slices.Contains(size.Regions, region):
+		case model != "" && !strings.Contains(strings.ToLower(size.GPUInfo.Model), model):
+		default:
+			result.Sizes = append(result.Sizes, GPUSize{
+				Slug:         size.Slug,
+				Description:  size.Description,
+				GPUs:         size.GPUInfo.Count,
+				GPUModel:     size.GPUInfo.Model,
+				GPUVRAM:      size.GPUInfo.VRAM,
+				Vcpus:        size.Vcpus,
+				Memory:       size.Memory,
+				Disk:         size.Disk,
+				PriceMonthly: size.PriceMonthly,
+				PriceHourly:  size.PriceHourly,
+				Regions:      size.Regions,
+			})
+		}
+	}
+	slices.SortFunc(result.Sizes, func(a, b GPUSize) int {
+		return cmp.Or(cmp.Compare(a.PriceHourly, b.PriceHourly), cmp.Compare(a.Slug, b.Slug))
+	})
+	return common.NewToolResultStructured(result)
+}
+
 // Tools returns the list of server tools for droplet sizes.
 func (s *SizesTool) Tools() []server.ServerTool {
 	return []server.ServerTool{
@@ -82,8 +155,18 @@ func (s *SizesTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool(
 				"size-list",
 				mcp.WithDescription("List all available droplet sizes. Supports pagination."),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultSizesPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultSizesPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultSizesPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultSizesPageSize), mcp.Description("Items per page")),
+			),
+		},
+		{
+			Handler: s.listGPUSizes,
+			Tool: mcp.NewTool(
+				"gpu-size-list",
+				mcp.WithDescription("List the droplet sizes with GPUs that can be created, cheapest first, with their GPU count, model and memory, prices and the regions offering them. GPU sizes are only offered in a few regions; pass one of the regions of a size to droplet-create."),
+				common.WithOutputSchema[GPUSizeList](),
+				mcp.WithString("Region", mcp.Description("Only list sizes offered in this region (e.g., tor1)")),
+				mcp.WithString("GPUModel", mcp.Description("Only list sizes whose GPU model contains this, e.g. h100 or l40s")),
 			),
 		},
 	}
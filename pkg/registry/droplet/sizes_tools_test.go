@@ -130,3 +130,30 @@ func TestSizesTool_listSizes(t *testing.T) {
 		})
 	}
 }
+
+func TestSizesTool_listGPUSizes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSizes := NewMockSizesService(ctrl)
+	mockSizes.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Size{
+		{Slug: "s-1vcpu-1gb", Available: true, PriceHourly: 0.009, Regions: []string{"nyc3", "tor1"}},
+		{Slug: "gpu-h100x8-640gb", Available: true, PriceHourly: 23.92, Regions: []string{"tor1"}, GPUInfo: &godo.GPUInfo{Count: 8, Model: "nvidia_h100"}},
+		{Slug: "gpu-h100x1-80gb", Available: true, PriceHourly: 3.39, Regions: []string{"tor1", "nyc2"}, GPUInfo: &godo.GPUInfo{Count: 1, Model: "nvidia_h100", VRAM: &godo.VRAM{Amount: 80, Unit: "gib"}}},
+		{Slug: "gpu-l40sx1-48gb", Available: true, PriceHourly: 1.57, Regions: []string{"atl1"}, GPUInfo: &godo.GPUInfo{Count: 1, Model: "nvidia_l40s"}},
+		{Slug: "gpu-a100x1-80gb", Available: false, GPUInfo: &godo.GPUInfo{Count: 1, Model: "nvidia_a100"}},
+	}, &godo.Response{}, nil).AnyTimes()
+	tool := setupSizesToolWithMock(mockSizes)
+	slugs := func(args map[string]any) []string {
+		resp, err := tool.listGPUSizes(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		require.False(t, resp.IsError)
+		var out []string
+		for _, size := range resp.StructuredContent.(GPUSizeList).Sizes {
+			out = append(out, size.Slug)
+		}
+		return out
+	}
+
+	require.Equal(t, []string{"gpu-l40sx1-48gb", "gpu-h100x1-80gb", "gpu-h100x8-640gb"}, slugs(map[string]any{}))
+	require.Equal(t, []string{"gpu-h100x1-80gb", "gpu-h100x8-640gb"}, slugs(map[string]any{"Region": "tor1"}))
+	require.Equal(t, []string{"gpu-l40sx1-48gb"}, slugs(map[string]any{"GPUModel": "L40S"}))
+}
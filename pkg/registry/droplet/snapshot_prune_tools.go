@@ -0,0 +1,193 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"mcp-digitalocean/internal/dryrun"
+	"mcp-digitalocean/internal/fanout"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// PrunedSnapshot is a snapshot snapshot-prune matched, and what became of it.
+type PrunedSnapshot struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	ResourceType  string  `json:"resource_type"`
+	ResourceID    string  `json:"resource_id"`
+	CreatedAt     string  `json:"created_at"`
+	SizeGigaBytes float64 `json:"size_gigabytes"`
+	Status        string  `json:"status"`
+	Error         string  `json:"error,omitempty"`
+}
+
+// SnapshotPruneResult lists the snapshots past their retention window, deleted unless DryRun, with
+// the batch holding the snapshots deleted and those that failed.
+type SnapshotPruneResult struct {
+	DryRun    bool             `json:"dry_run,omitempty"`
+	Prefix    string           `json:"prefix"`
+	Cutoff    string           `json:"cutoff"`
+	Snapshots []PrunedSnapshot `json:"snapshots"`
+	Kept      int              `json:"kept"`
+	Message   string           `json:"message"`
+	fanout.Batch[PrunedSnapshot, PrunedSnapshot]
+}
+
+// SnapshotPruneTool provides a tool that enforces a snapshot retention window.
+type SnapshotPruneTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+	now    func() time.Time
+}
+
+// NewSnapshotPruneTool creates a new snapshot prune tool
+func NewSnapshotPruneTool(client func(ctx context.Context) (*godo.Client, error)) *SnapshotPruneTool {
+	return &SnapshotPruneTool{client: client, now: time.Now}
+}
+
+// pruneCandidates returns the snapshots named with prefix that were created before cutoff, keeping
+// the keepLatest newest matching snapshots of each resource whatever their age. It also returns
+// how many matching snapshots were kept.
+func pruneCandidates(snapshots []godo.Snapshot, prefix string, cutoff time.Time, keepLatest int) ([]godo.Snapshot, int) {
+	type dated struct {
+		snapshot godo.Snapshot
+		created  time.Time
+	}
+	byResource := map[string][]dated{}
+	kept := 0
+	for _, s := range snapshots {
+		if !strings.HasPrefix(s.Name, prefix) {
+			continue
+		}
+		created, err := time.Parse(time.RFC3339, s.Created)
+		if err != nil {
+			// a snapshot of unknown age is never pruned.
+			kept++
+			continue
+		}
+		byResource[s.ResourceID] = append(byResource[s.ResourceID], dated{s, created})
+	}
+	var candidates []dated
+	for _, matched := range byResource {
+		sort.Slice(matched, func(i, j int) bool { return matched[i].created.After(matched[j].created) })
+		for i, d := range matched {
+			if i < keepLatest || !d.created.Before(cutoff) {
+				kept++
+				continue
+			}
+			candidates = append(candidates, d)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].created.Before(candidates[j].created) })
+	oldest := make([]godo.Snapshot, 0, len(candidates))
+	for _, d := range candidates {
+		oldest = append(oldest, d.snapshot)
+	}
+	return oldest, kept
+}
+
+// pruneSnapshots deletes the droplet and volume snapshots whose name starts with Prefix and
+// that are older than RetentionDays. With DryRun, the result lists them and nothing is deleted.
+func (s *SnapshotPruneTool) pruneSnapshots(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	prefix := args.RequiredString("Prefix")
+	retentionDays := args.RequiredNumber("RetentionDays")
+	resourceType := args.String("ResourceType")
+	keepLatest := args.Number("KeepLatest", 0)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if prefix == "" {
+		return mcp.NewToolResultError("Prefix must not be empty"), nil
+	}
+	if retentionDays < 0 || keepLatest < 0 {
+		return mcp.NewToolResultError("RetentionDays and KeepLatest must not be negative"), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	list := client.Snapshots.List
+	switch resourceType {
+	case "":
+	case "droplet":
+		list = client.Snapshots.ListDroplet
+	case "volume":
+		list = client.Snapshots.ListVolume
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("ResourceType must be droplet or volume, not %q", resourceType)), nil
+	}
+	snapshots, err := common.ListAll(ctx, dropletsPageSize, list)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	cutoff := s.now().Add(-time.Duration(retentionDays * 24 * float64(time.Hour))).UTC()
+	candidates, kept := pruneCandidates(snapshots, prefix, cutoff, int(keepLatest))
+	result := &SnapshotPruneResult{
+		Prefix:    prefix,
+		Cutoff:    cutoff.Format(time.RFC3339),
+		Snapshots: make([]PrunedSnapshot, 0, len(candidates)),
+		Kept:      kept,
+		Batch:     fanout.NewBatch[PrunedSnapshot, PrunedSnapshot](),
+	}
+	for _, c := range candidates {
+		result.Snapshots = append(result.Snapshots, PrunedSnapshot{
+			ID:            c.ID,
+			Name:          c.Name,
+			ResourceType:  c.ResourceType,
+			ResourceID:    c.ResourceID,
+			CreatedAt:     c.Created,
+			SizeGigaBytes: c.SizeGigaBytes,
+		})
+	}
+
+	if dryrun.Active(ctx) {
+		dryrun.Planned(ctx)
+		for i := range result.Snapshots {
+			result.Snapshots[i].Status = "planned"
+		}
+		result.DryRun = true
+		result.Message = fmt.Sprintf("Dry run: no changes were made. %d snapshots would be deleted and %d kept.", len(candidates), kept)
+		return common.NewToolResultStructured(result)
+	}
+
+	for i := range result.Snapshots {
+		snapshot := &result.Snapshots[i]
+		if _, err := client.Snapshots.Delete(ctx, snapshot.ID); err != nil {
+			snapshot.Status = "failed"
+			snapshot.Error = err.Error()
+			result.Fail(*snapshot, err)
+			continue
+		}
+		snapshot.Status = "deleted"
+		result.Succeed(*snapshot)
+	}
+	result.Message = fmt.Sprintf("deleted %d of %d snapshots past the retention window; %d kept", len(result.Succeeded), len(candidates), kept)
+	return common.NewToolResultStructured(result)
+}
+
+// Tools returns a list of tool functions
+func (s *SnapshotPruneTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.pruneSnapshots,
+			Tool: mcp.NewTool("snapshot-prune",
+				mcp.WithDescription("Enforce a snapshot retention window: deletes the droplet and volume snapshots whose name starts with Prefix and that are older than RetentionDays, optionally keeping the newest KeepLatest of each resource. Run it with DryRun: true first to list the snapshots that would be deleted."),
+				common.WithOutputSchema[SnapshotPruneResult](),
+				mcp.WithString("Prefix", mcp.Required(), mcp.Description("Only snapshots whose name starts with this prefix are pruned")),
+				mcp.WithNumber("RetentionDays", mcp.Required(), mcp.Description("Snapshots older than this many days are deleted")),
+				mcp.WithString("ResourceType", mcp.Enum("droplet", "volume"), mcp.Description("Only prune snapshots of droplets or of volumes. Defaults to both")),
+				mcp.WithNumber("KeepLatest", mcp.DefaultNumber(0), mcp.Description("Keep this many of the newest matching snapshots of each droplet or volume, whatever their age")),
+			),
+		},
+	}
+}
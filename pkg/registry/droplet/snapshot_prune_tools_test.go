@@ -0,0 +1,127 @@
+package droplet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"mcp-digitalocean/internal/dryrun"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSnapshotPruneTool_pruneSnapshots(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshots := []godo.Snapshot{
+		{ID: "1", Name: "nightly-a", ResourceType: "droplet", ResourceID: "10", Created: "2025-05-01T00:00:00Z"},
+		{ID: "2", Name: "nightly-b", ResourceType: "droplet", ResourceID: "10", Created: "2025-05-15T00:00:00Z"},
+		{ID: "3", Name: "nightly-c", ResourceType: "droplet", ResourceID: "10", Created: "2025-06-28T00:00:00Z"},
+		{ID: "4", Name: "nightly-v", ResourceType: "volume", ResourceID: "vol-1", Created: "2025-04-01T00:00:00Z"},
+		{ID: "5", Name: "golden", ResourceType: "droplet", ResourceID: "10", Created: "2024-01-01T00:00:00Z"},
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		dryRun        bool
+		mockSetup     func(*MockSnapshotsService)
+		expectError   bool
+		expectIDs     []string
+		expectStatus  []string
+		expectKept    int
+		expectDeleted int
+	}{
+		{
+			name: "Deletes expired snapshots oldest first",
+			args: map[string]any{"Prefix": "nightly-", "RetentionDays": float64(30)},
+			mockSetup: func(s *MockSnapshotsService) {
+				s.EXPECT().List(gomock.Any(), gomock.Any()).Return(snapshots, nil, nil)
+				gomock.InOrder(
+					s.EXPECT().Delete(gomock.Any(), "4").Return(nil, nil),
+					s.EXPECT().Delete(gomock.Any(), "1").Return(nil, errors.New("locked")),
+					s.EXPECT().Delete(gomock.Any(), "2").Return(nil, nil),
+				)
+			},
+			expectIDs:     []string{"4", "1", "2"},
+			expectStatus:  []string{"deleted", "failed", "deleted"},
+			expectKept:    1,
+			expectDeleted: 2,
+		},
+		{
+			name: "Keeps the newest of each resource",
+			args: map[string]any{"Prefix": "nightly-", "RetentionDays": float64(30), "KeepLatest": float64(2), "ResourceType": "droplet"},
+			mockSetup: func(s *MockSnapshotsService) {
+				s.EXPECT().ListDroplet(gomock.Any(), gomock.Any()).Return(snapshots[:3], nil, nil)
+				s.EXPECT().Delete(gomock.Any(), "1").Return(nil, nil)
+			},
+			expectIDs:     []string{"1"},
+			expectStatus:  []string{"deleted"},
+			expectKept:    2,
+			expectDeleted: 1,
+		},
+		{
+			name:   "Dry run lists the candidates",
+			args:   map[string]any{"Prefix": "nightly-", "RetentionDays": float64(30), dryrun.Arg: true},
+			dryRun: true,
+			mockSetup: func(s *MockSnapshotsService) {
+				s.EXPECT().List(gomock.Any(), gomock.Any()).Return(snapshots, nil, nil)
+			},
+			expectIDs:    []string{"4", "1", "2"},
+			expectStatus: []string{"planned", "planned", "planned"},
+			expectKept:   1,
+		},
+		{
+			name:        "Empty prefix",
+			args:        map[string]any{"Prefix": "", "RetentionDays": float64(30)},
+			expectError: true,
+		},
+		{
+			name:        "Unknown resource type",
+			args:        map[string]any{"Prefix": "nightly-", "RetentionDays": float64(30), "ResourceType": "image"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockSnapshots := NewMockSnapshotsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockSnapshots)
+			}
+			tool := NewSnapshotPruneTool(func(ctx context.Context) (*godo.Client, error) {
+				return &godo.Client{Snapshots: mockSnapshots}, nil
+			})
+			tool.now = func() time.Time { return time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC) }
+			handler := tool.pruneSnapshots
+			if tc.dryRun {
+				handler = dryrun.Middleware(tool.pruneSnapshots)
+			}
+			resp, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "snapshot-prune", Arguments: tc.args}})
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			var result SnapshotPruneResult
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+			var ids, statuses []string
+			for _, s := range result.Snapshots {
+				ids = append(ids, s.ID)
+				statuses = append(statuses, s.Status)
+			}
+			require.Equal(t, tc.expectIDs, ids)
+			require.Equal(t, tc.expectStatus, statuses)
+			require.Equal(t, tc.expectKept, result.Kept)
+			require.Len(t, result.Succeeded, tc.expectDeleted)
+			require.Equal(t, tc.dryRun, result.DryRun)
+		})
+	}
+}
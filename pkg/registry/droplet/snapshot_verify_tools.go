@@ -0,0 +1,238 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultSnapshotVerifyTag     = "snapshot-verify"
+	defaultSnapshotVerifyTimeout = 600
+	defaultSnapshotVerifyPoll    = 10 * time.Second
+	dropletStatusActive          = "active"
+)
+
+// SnapshotVerifyResult is the outcome of a snapshot-verify run.
+type SnapshotVerifyResult struct {
+	SnapshotID      int     `json:"snapshot_id"`
+	SnapshotName    string  `json:"snapshot_name"`
+	DropletID       int     `json:"droplet_id,omitempty"`
+	Region          string  `json:"region"`
+	Size            string  `json:"size"`
+	Tag             string  `json:"tag"`
+	Passed          bool    `json:"passed"`
+	ReachedActive   bool    `json:"reached_active"`
+	CleanedUp       bool    `json:"cleaned_up"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Error           string  `json:"error,omitempty"`
+}
+
+// SnapshotVerifyTool provides a composite tool that proves a snapshot is restorable
+// by booting a throwaway droplet from it.
+type SnapshotVerifyTool struct {
+	client       func(ctx context.Context) (*godo.Client, error)
+	pollInterval time.Duration
+}
+
+// NewSnapshotVerifyTool creates a new snapshot verify tool
+func NewSnapshotVerifyTool(client func(ctx context.Context) (*godo.Client, error)) *SnapshotVerifyTool {
+	return &SnapshotVerifyTool{
+		client:       client,
+		pollInterval: defaultSnapshotVerifyPoll,
+	}
+}
+
+// verifySnapshot creates a temporary droplet from a snapshot, waits for it to become
+// active and destroys it again, reporting whether the snapshot booted successfully.
+func (s *SnapshotVerifyTool) verifySnapshot(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	snapshotID, ok := args["SnapshotID"].(float64)
+	if !ok {
+		return mcp.NewToolResultError("SnapshotID is required"), nil
+	}
+	region, _ := args["Region"].(string)
+	size, _ := args["Size"].(string)
+	tag, _ := args["Tag"].(string)
+	if tag == "" {
+		tag = defaultSnapshotVerifyTag
+	}
+	timeoutSeconds, ok := args["TimeoutSeconds"].(float64)
+	if !ok || timeoutSeconds <= 0 {
+		timeoutSeconds = defaultSnapshotVerifyTimeout
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	image, _, err := client.Images.GetByID(ctx, int(snapshotID))
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	if region == "" {
+		if len(image.Regions) == 0 {
+			return mcp.NewToolResultError("snapshot is not available in any region"), nil
+		}
+		region = image.Regions[0]
+	} else if !slices.Contains(image.Regions, region) {
+		return mcp.NewToolResultErrorf("snapshot is not available in region %s, available regions: %v", region, image.Regions), nil
+	}
+
+	if size == "" {
+		size, err = smallestSizeFor(ctx, client, region, image.MinDiskSize)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to pick a droplet size", err), nil
+		}
+	}
+
+	result := &SnapshotVerifyResult{
+		SnapshotID:   image.ID,
+		SnapshotName: image.Name,
+		Region:       region,
+		Size:         size,
+		Tag:          tag,
+	}
+
+	start := time.Now()
+	droplet, _, err := client.Droplets.Create(ctx, &godo.DropletCreateRequest{
+		Name:   fmt.Sprintf("snapshot-verify-%d-%d", image.ID, start.Unix()),
+		Region: region,
+		Size:   size,
+		Image:  godo.DropletCreateImage{ID: image.ID},
+		Tags:   []string{tag},
+	})
+	if err != nil {
+		result.Error = fmt.Sprintf("droplet create: %v", err)
+		result.DurationSeconds = time.Since(start).Seconds()
+		return common.NewToolResultStructured(result)
+	}
+	result.DropletID = droplet.ID
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds*float64(time.Second)))
+	err = waitForDropletStatus(waitCtx, client, droplet.ID, dropletStatusActive, s.pollInterval)
+	cancel()
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		result.ReachedActive = true
+	}
+
+	// The verification droplet must be removed even if the caller's context has been cancelled.
+	if _, err := client.Droplets.Delete(context.WithoutCancel(ctx), droplet.ID); err != nil {
+		deleteErr := fmt.Sprintf("droplet delete: %v", err)
+		if result.Error != "" {
+			deleteErr = result.Error + "; " + deleteErr
+		}
+		result.Error = deleteErr
+	} else {
+		result.CleanedUp = true
+	}
+
+	result.Passed = result.ReachedActive && result.CleanedUp
+	result.DurationSeconds = time.Since(start).Seconds()
+	return common.NewToolResultStructured(result)
+}
+
+// smallestSizeFor returns the cheapest size available in region whose disk fits minDisk GB.
+func smallestSizeFor(ctx context.Context, client *godo.Client, region string, minDisk int) (string, error) {
+	opt := &godo.ListOptions{Page: 1, PerPage: 200}
+	var best *godo.Size
+	for {
+		sizes, resp, err := client.Sizes.List(ctx, opt)
+		if err != nil {
+			return "", err
+		}
+		for i := range sizes {
+			size := sizes[i]
+			if !size.Available || size.Disk < minDisk || !slices.Contains(size.Regions, region) {
+				continue
+			}
+			if best == nil || size.PriceMonthly < best.PriceMonthly {
+				best = &size
+			}
+		}
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return "", err
+		}
+		opt.Page = page + 1
+	}
+	if best == nil {
+		return "", fmt.Errorf("no size in region %s fits a %d GB disk", region, minDisk)
+	}
+	return best.Slug, nil
+}
+
+// waitForDropletStatus polls a droplet until it reaches status or ctx is done.
+func waitForDropletStatus(ctx context.Context, client *godo.Client, dropletID int, status string, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		droplet, _, err := client.Droplets.Get(ctx, dropletID)
+		if err != nil {
+			return fmt.Errorf("droplet get: %w", err)
+		}
+		if droplet.Status == status {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("droplet %d did not reach status %q (last status %q): %w", dropletID, status, droplet.Status, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForAction polls an action with get until it completes, errors or ctx is done.
+func waitForAction(ctx context.Context, interval time.Duration, get func(ctx context.Context) (*godo.Action, *godo.Response, error)) (*godo.Action, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		action, _, err := get(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("action get: %w", err)
+		}
+		switch action.Status {
+		case godo.ActionCompleted:
+			return action, nil
+		case "errored":
+			return nil, fmt.Errorf("action %d (%s) errored", action.ID, action.Type)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("action %d (%s) did not complete (last status %q): %w", action.ID, action.Type, action.Status, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tools returns a list of tool functions
+func (s *SnapshotVerifyTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.verifySnapshot,
+			Tool: mcp.NewTool("snapshot-verify",
+				mcp.WithDescription("Verify a droplet snapshot is restorable: creates a temporary droplet from the snapshot (tagged with a sandbox tag), waits until it is active, then destroys it and reports pass/fail. The temporary droplet is billed for the few minutes it runs."),
+				common.WithOutputSchema[SnapshotVerifyResult](),
+				mcp.WithNumber("SnapshotID", mcp.Required(), mcp.Description("ID of the snapshot (or backup) image to verify")),
+				mcp.WithString("Region", mcp.Description("Region slug to boot the temporary droplet in. Defaults to the first region the snapshot is available in.")),
+				mcp.WithString("Size", mcp.Description("Size slug for the temporary droplet. Defaults to the cheapest available size whose disk fits the snapshot.")),
+				mcp.WithString("Tag", mcp.DefaultString(defaultSnapshotVerifyTag), mcp.Description("Sandbox tag applied to the temporary droplet")),
+				mcp.WithNumber("TimeoutSeconds", mcp.DefaultNumber(defaultSnapshotVerifyTimeout), mcp.Description("How long to wait for the droplet to become active")),
+			),
+		},
+	}
+}
@@ -0,0 +1,136 @@
+package droplet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupSnapshotVerifyToolWithMocks(droplets *MockDropletsService, images *MockImagesService, sizes *MockSizesService) *SnapshotVerifyTool {
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Droplets: droplets,
+			Images:   images,
+			Sizes:    sizes,
+		}, nil
+	}
+	tool := NewSnapshotVerifyTool(client)
+	tool.pollInterval = time.Millisecond
+	return tool
+}
+
+func TestSnapshotVerifyTool_verifySnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshot := &godo.Image{ID: 100, Name: "nightly", Regions: []string{"nyc3", "sfo3"}, MinDiskSize: 50}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		mockSetup     func(*MockDropletsService, *MockImagesService, *MockSizesService)
+		expectError   bool
+		expectPassed  bool
+		expectSize    string
+		expectCleanup bool
+	}{
+		{
+			name: "Snapshot boots and is cleaned up",
+			args: map[string]any{"SnapshotID": float64(100)},
+			mockSetup: func(d *MockDropletsService, i *MockImagesService, s *MockSizesService) {
+				i.EXPECT().GetByID(gomock.Any(), 100).Return(snapshot, nil, nil)
+				s.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Size{
+					{Slug: "s-1vcpu-1gb", Disk: 25, Available: true, PriceMonthly: 6, Regions: []string{"nyc3"}},
+					{Slug: "s-2vcpu-4gb", Disk: 80, Available: true, PriceMonthly: 24, Regions: []string{"nyc3"}},
+					{Slug: "s-1vcpu-2gb", Disk: 50, Available: true, PriceMonthly: 12, Regions: []string{"nyc3"}},
+					{Slug: "s-1vcpu-2gb-amd", Disk: 50, Available: true, PriceMonthly: 10, Regions: []string{"sfo3"}},
+				}, &godo.Response{}, nil)
+				d.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *godo.DropletCreateRequest) (*godo.Droplet, *godo.Response, error) {
+					require.Equal(t, "nyc3", req.Region)
+					require.Equal(t, "s-1vcpu-2gb", req.Size)
+					require.Equal(t, godo.DropletCreateImage{ID: 100}, req.Image)
+					require.Equal(t, []string{defaultSnapshotVerifyTag}, req.Tags)
+					return &godo.Droplet{ID: 7, Status: "new"}, nil, nil
+				})
+				gomock.InOrder(
+					d.EXPECT().Get(gomock.Any(), 7).Return(&godo.Droplet{ID: 7, Status: "new"}, nil, nil),
+					d.EXPECT().Get(gomock.Any(), 7).Return(&godo.Droplet{ID: 7, Status: "active"}, nil, nil),
+				)
+				d.EXPECT().Delete(gomock.Any(), 7).Return(&godo.Response{}, nil)
+			},
+			expectPassed:  true,
+			expectSize:    "s-1vcpu-2gb",
+			expectCleanup: true,
+		},
+		{
+			name: "Droplet never becomes active",
+			args: map[string]any{"SnapshotID": float64(100), "Size": "s-2vcpu-4gb", "TimeoutSeconds": float64(0.01)},
+			mockSetup: func(d *MockDropletsService, i *MockImagesService, s *MockSizesService) {
+				i.EXPECT().GetByID(gomock.Any(), 100).Return(snapshot, nil, nil)
+				d.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&godo.Droplet{ID: 8, Status: "new"}, nil, nil)
+				d.EXPECT().Get(gomock.Any(), 8).Return(&godo.Droplet{ID: 8, Status: "new"}, nil, nil).AnyTimes()
+				d.EXPECT().Delete(gomock.Any(), 8).Return(&godo.Response{}, nil)
+			},
+			expectPassed:  false,
+			expectSize:    "s-2vcpu-4gb",
+			expectCleanup: true,
+		},
+		{
+			name: "Snapshot not available in requested region",
+			args: map[string]any{"SnapshotID": float64(100), "Region": "ams3"},
+			mockSetup: func(d *MockDropletsService, i *MockImagesService, s *MockSizesService) {
+				i.EXPECT().GetByID(gomock.Any(), 100).Return(snapshot, nil, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "Snapshot lookup fails",
+			args: map[string]any{"SnapshotID": float64(100)},
+			mockSetup: func(d *MockDropletsService, i *MockImagesService, s *MockSizesService) {
+				i.EXPECT().GetByID(gomock.Any(), 100).Return(nil, nil, errors.New("not found"))
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing SnapshotID",
+			args:        map[string]any{},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDroplets := NewMockDropletsService(ctrl)
+			mockImages := NewMockImagesService(ctrl)
+			mockSizes := NewMockSizesService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockDroplets, mockImages, mockSizes)
+			}
+			tool := setupSnapshotVerifyToolWithMocks(mockDroplets, mockImages, mockSizes)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.verifySnapshot(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.False(t, resp.IsError)
+			result := resp.StructuredContent.(*SnapshotVerifyResult)
+			require.Equal(t, tc.expectPassed, result.Passed)
+			require.Equal(t, tc.expectPassed, result.ReachedActive)
+			require.Equal(t, tc.expectCleanup, result.CleanedUp)
+			require.Equal(t, tc.expectSize, result.Size)
+			if !tc.expectPassed {
+				require.NotEmpty(t, result.Error)
+			}
+		})
+	}
+}
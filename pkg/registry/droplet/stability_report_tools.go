@@ -0,0 +1,245 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultStabilityDays          = 7
+	defaultStabilityFlapThreshold = 3
+	stabilityActionsPageSize      = 100
+	// monitoringGapFactor is how many times the typical sample interval two monitoring
+	// samples must be apart before the gap is counted as the droplet being unreachable.
+	monitoringGapFactor = 3
+)
+
+// powerActionTypes are the droplet action types that stop or restart the droplet.
+var powerActionTypes = []string{"reboot", "power_cycle", "power_off", "power_on", "shutdown"}
+
+// DropletStability summarizes the power history of a single droplet.
+type DropletStability struct {
+	ID                     int            `json:"id"`
+	Name                   string         `json:"name"`
+	Status                 string         `json:"status"`
+	PowerEvents            map[string]int `json:"power_events"`
+	TotalPowerEvents       int            `json:"total_power_events"`
+	LastPowerEvent         string         `json:"last_power_event,omitempty"`
+	MonitoringGaps         *int           `json:"monitoring_gaps,omitempty"`
+	MonitoredUptimePercent *float64       `json:"monitored_uptime_percent,omitempty"`
+	Flapping               bool           `json:"flapping"`
+	Error                  string         `json:"error,omitempty"`
+}
+
+// StabilityReport is the result of the droplet-stability-report tool.
+type StabilityReport struct {
+	WindowStart   string             `json:"window_start"`
+	WindowEnd     string             `json:"window_end"`
+	FlapThreshold int                `json:"flap_threshold"`
+	FlappingCount int                `json:"flapping_count"`
+	Droplets      []DropletStability `json:"droplets"`
+}
+
+// StabilityReportTool derives reboot and power-cycle history for droplets.
+type StabilityReportTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+	now    func() time.Time
+}
+
+// NewStabilityReportTool creates a new stability report tool
+func NewStabilityReportTool(client func(ctx context.Context) (*godo.Client, error)) *StabilityReportTool {
+	return &StabilityReportTool{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// stabilityReport reports recent power events for a droplet, or all droplets carrying a tag,
+// and flags the ones that restarted more often than the flap threshold.
+func (s *StabilityReportTool) stabilityReport(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	dropletID, hasID := args["ID"].(float64)
+	tag, _ := args["Tag"].(string)
+	if hasID == (tag != "") {
+		return mcp.NewToolResultError("exactly one of ID or Tag must be provided"), nil
+	}
+	days, ok := args["Days"].(float64)
+	if !ok || days <= 0 {
+		days = defaultStabilityDays
+	}
+	threshold, ok := args["FlapThreshold"].(float64)
+	if !ok || threshold <= 0 {
+		threshold = defaultStabilityFlapThreshold
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	var droplets []godo.Droplet
+	if hasID {
+		droplet, _, err := client.Droplets.Get(ctx, int(dropletID))
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		droplets = []godo.Droplet{*droplet}
+	} else {
+		droplets, err = listDropletsByTag(ctx, client, tag)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+	}
+
+	end := s.now().UTC()
+	start := end.Add(-time.Duration(days * float64(24*time.Hour)))
+	report := &StabilityReport{
+		WindowStart:   start.Format(time.RFC3339),
+		WindowEnd:     end.Format(time.RFC3339),
+		FlapThreshold: int(threshold),
+		Droplets:      make([]DropletStability, 0, len(droplets)),
+	}
+
+	for _, d := range droplets {
+		entry := DropletStability{
+			ID:          d.ID,
+			Name:        d.Name,
+			Status:      d.Status,
+			PowerEvents: map[string]int{},
+		}
+
+		events, err := powerEventsSince(ctx, client, d.ID, start)
+		if err != nil {
+			entry.Error = fmt.Sprintf("failed to list actions: %v", err)
+		}
+		for _, a := range events {
+			entry.PowerEvents[a.Type]++
+			entry.TotalPowerEvents++
+		}
+		if len(events) > 0 && events[0].StartedAt != nil {
+			entry.LastPowerEvent = events[0].StartedAt.UTC().Format(time.RFC3339)
+		}
+
+		if slices.Contains(d.Features, "monitoring") {
+			gaps, uptime, err := monitoringGaps(ctx, client, d.ID, start, end)
+			if err != nil && entry.Error == "" {
+				entry.Error = fmt.Sprintf("failed to read monitoring data: %v", err)
+			} else if err == nil {
+				entry.MonitoringGaps = &gaps
+				entry.MonitoredUptimePercent = &uptime
+			}
+		}
+
+		entry.Flapping = entry.TotalPowerEvents >= int(threshold)
+		if entry.Flapping {
+			report.FlappingCount++
+		}
+		report.Droplets = append(report.Droplets, entry)
+	}
+
+	return common.NewToolResultStructured(report)
+}
+
+// powerEventsSince returns the droplet's power-related actions started after since, newest first.
+// The actions endpoint lists newest first, so paging stops at the first action older than since.
+func powerEventsSince(ctx context.Context, client *godo.Client, dropletID int, since time.Time) ([]godo.Action, error) {
+	var events []godo.Action
+	opt := &godo.ListOptions{Page: 1, PerPage: stabilityActionsPageSize}
+	for {
+		actions, resp, err := client.Droplets.Actions(ctx, dropletID, opt)
+		if err != nil {
+			return events, err
+		}
+		for _, a := range actions {
+			if a.StartedAt != nil && a.StartedAt.Before(since) {
+				return events, nil
+			}
+			if slices.Contains(powerActionTypes, a.Type) {
+				events = append(events, a)
+			}
+		}
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			return events, nil
+		}
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return events, err
+		}
+		opt.Page = page + 1
+	}
+}
+
+// monitoringGaps inspects the droplet's CPU samples between start and end and returns the number
+// of gaps where the monitoring agent stopped reporting, plus the share of the window covered by samples.
+func monitoringGaps(ctx context.Context, client *godo.Client, dropletID int, start, end time.Time) (int, float64, error) {
+	resp, _, err := client.Monitoring.GetDropletCPU(ctx, &godo.DropletMetricsRequest{
+		HostID: strconv.Itoa(dropletID),
+		Start:  start,
+		End:    end,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(resp.Data.Result) == 0 || len(resp.Data.Result[0].Values) < 2 {
+		return 0, 0, nil
+	}
+
+	// All CPU modes share timestamps, so a single stream is enough.
+	values := resp.Data.Result[0].Values
+	intervals := make([]time.Duration, 0, len(values)-1)
+	for i := 1; i < len(values); i++ {
+		intervals = append(intervals, values[i].Timestamp.Sub(values[i-1].Timestamp))
+	}
+	sorted := slices.Clone(intervals)
+	slices.Sort(sorted)
+	step := sorted[len(sorted)/2]
+
+	gaps := 0
+	var missing time.Duration
+	for _, interval := range intervals {
+		if interval > monitoringGapFactor*step {
+			gaps++
+			missing += interval - step
+		}
+	}
+	// Time before the first and after the last sample is also unmonitored.
+	if lead := values[0].Timestamp.Time().Sub(start); lead > monitoringGapFactor*step {
+		missing += lead
+	}
+	if trail := end.Sub(values[len(values)-1].Timestamp.Time()); trail > monitoringGapFactor*step {
+		missing += trail
+	}
+
+	window := end.Sub(start)
+	uptime := 100 * float64(window-missing) / float64(window)
+	if uptime < 0 {
+		uptime = 0
+	}
+	return gaps, uptime, nil
+}
+
+// Tools returns a list of tool functions
+func (s *StabilityReportTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.stabilityReport,
+			Tool: mcp.NewTool("droplet-stability-report",
+				mcp.WithDescription("Report recent reboots and power cycles for a droplet or every droplet with a tag, derived from droplet action history and, for droplets with monitoring enabled, gaps in monitoring data. Droplets with at least FlapThreshold power events in the window are flagged as flapping. Exactly one of ID or Tag must be provided."),
+				common.WithOutputSchema[StabilityReport](),
+				mcp.WithNumber("ID", mcp.Description("ID of a single droplet to report on")),
+				mcp.WithString("Tag", mcp.Description("Tag of the droplets to report on")),
+				mcp.WithNumber("Days", mcp.DefaultNumber(defaultStabilityDays), mcp.Description("Size of the look-back window in days")),
+				mcp.WithNumber("FlapThreshold", mcp.DefaultNumber(defaultStabilityFlapThreshold), mcp.Description("Number of power events in the window at which a droplet is flagged as flapping")),
+			),
+		},
+	}
+}
@@ -0,0 +1,154 @@
+package droplet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupStabilityReportToolWithMocks(droplets *MockDropletsService, monitoring *MockMonitoringService, now time.Time) *StabilityReportTool {
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Droplets:   droplets,
+			Monitoring: monitoring,
+		}, nil
+	}
+	tool := NewStabilityReportTool(client)
+	tool.now = func() time.Time { return now }
+	return tool
+}
+
+func actionAt(actionType string, at time.Time) godo.Action {
+	return godo.Action{Type: actionType, StartedAt: &godo.Timestamp{Time: at}}
+}
+
+func TestStabilityReportTool_stabilityReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	hour := time.Hour
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockDropletsService, *MockMonitoringService)
+		expectError bool
+		check       func(*testing.T, *StabilityReport)
+	}{
+		{
+			name: "Flags flapping droplets across a tag",
+			args: map[string]any{"Tag": "web", "Days": float64(7), "FlapThreshold": float64(2)},
+			mockSetup: func(d *MockDropletsService, m *MockMonitoringService) {
+				d.EXPECT().ListByTag(gomock.Any(), "web", &godo.ListOptions{Page: 1, PerPage: 100}).
+					Return([]godo.Droplet{{ID: 1, Name: "web-1", Status: "active"}, {ID: 2, Name: "web-2", Status: "active"}}, &godo.Response{}, nil)
+				d.EXPECT().Actions(gomock.Any(), 1, gomock.Any()).Return([]godo.Action{
+					actionAt("reboot", now.Add(-1*hour)),
+					actionAt("resize", now.Add(-2*hour)),
+					actionAt("power_cycle", now.Add(-3*hour)),
+					actionAt("reboot", now.Add(-30*24*hour)),
+				}, &godo.Response{}, nil)
+				d.EXPECT().Actions(gomock.Any(), 2, gomock.Any()).Return([]godo.Action{
+					actionAt("snapshot", now.Add(-1*hour)),
+					actionAt("reboot", now.Add(-5*hour)),
+				}, &godo.Response{}, nil)
+			},
+			check: func(t *testing.T, r *StabilityReport) {
+				require.Equal(t, 1, r.FlappingCount)
+				require.Len(t, r.Droplets, 2)
+				require.True(t, r.Droplets[0].Flapping)
+				require.Equal(t, 2, r.Droplets[0].TotalPowerEvents)
+				require.Equal(t, map[string]int{"reboot": 1, "power_cycle": 1}, r.Droplets[0].PowerEvents)
+				require.Equal(t, now.Add(-1*hour).Format(time.RFC3339), r.Droplets[0].LastPowerEvent)
+				require.False(t, r.Droplets[1].Flapping)
+				require.Equal(t, 1, r.Droplets[1].TotalPowerEvents)
+				require.Nil(t, r.Droplets[1].MonitoringGaps)
+			},
+		},
+		{
+			name: "Detects monitoring gaps for a single droplet",
+			args: map[string]any{"ID": float64(3), "Days": float64(1)},
+			mockSetup: func(d *MockDropletsService, m *MockMonitoringService) {
+				d.EXPECT().Get(gomock.Any(), 3).Return(&godo.Droplet{ID: 3, Name: "db", Features: []string{"monitoring"}}, nil, nil)
+				d.EXPECT().Actions(gomock.Any(), 3, gomock.Any()).Return(nil, &godo.Response{}, nil)
+
+				start := now.Add(-24 * hour)
+				var values []metrics.SamplePair
+				for ts := start; !ts.After(now); ts = ts.Add(hour) {
+					// The agent stopped reporting for six hours in the middle of the window.
+					if ts.After(start.Add(10*hour)) && ts.Before(start.Add(16*hour)) {
+						continue
+					}
+					values = append(values, metrics.SamplePair{Timestamp: metrics.TimeFromUnix(ts.Unix()), Value: 1})
+				}
+				m.EXPECT().GetDropletCPU(gomock.Any(), &godo.DropletMetricsRequest{HostID: "3", Start: start, End: now}).
+					Return(&godo.MetricsResponse{Data: godo.MetricsData{Result: []metrics.SampleStream{{Values: values}}}}, nil, nil)
+			},
+			check: func(t *testing.T, r *StabilityReport) {
+				require.Len(t, r.Droplets, 1)
+				require.NotNil(t, r.Droplets[0].MonitoringGaps)
+				require.Equal(t, 1, *r.Droplets[0].MonitoringGaps)
+				require.InDelta(t, 100*float64(19)/24, *r.Droplets[0].MonitoredUptimePercent, 0.01)
+				require.False(t, r.Droplets[0].Flapping)
+			},
+		},
+		{
+			name: "Action history error is reported per droplet",
+			args: map[string]any{"ID": float64(4)},
+			mockSetup: func(d *MockDropletsService, m *MockMonitoringService) {
+				d.EXPECT().Get(gomock.Any(), 4).Return(&godo.Droplet{ID: 4}, nil, nil)
+				d.EXPECT().Actions(gomock.Any(), 4, gomock.Any()).Return(nil, nil, errors.New("api error"))
+			},
+			check: func(t *testing.T, r *StabilityReport) {
+				require.Contains(t, r.Droplets[0].Error, "api error")
+			},
+		},
+		{
+			name: "Droplet lookup fails",
+			args: map[string]any{"ID": float64(5)},
+			mockSetup: func(d *MockDropletsService, m *MockMonitoringService) {
+				d.EXPECT().Get(gomock.Any(), 5).Return(nil, nil, errors.New("not found"))
+			},
+			expectError: true,
+		},
+		{
+			name:        "Both ID and Tag",
+			args:        map[string]any{"ID": float64(1), "Tag": "web"},
+			expectError: true,
+		},
+		{
+			name:        "Neither ID nor Tag",
+			args:        map[string]any{},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDroplets := NewMockDropletsService(ctrl)
+			mockMonitoring := NewMockMonitoringService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockDroplets, mockMonitoring)
+			}
+			tool := setupStabilityReportToolWithMocks(mockDroplets, mockMonitoring, now)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.stabilityReport(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.False(t, resp.IsError)
+			tc.check(t, resp.StructuredContent.(*StabilityReport))
+		})
+	}
+}
@@ -0,0 +1,364 @@
+package droplet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// userDataRefScheme is the scheme of the references to rendered user data that droplet-create accepts.
+	userDataRefScheme = "userdata"
+	// maxUserData is the most user data a droplet accepts.
+	maxUserData = 64 << 10
+)
+
+var (
+	hostnamePattern = regexp.MustCompile(`^(_|(\*\.)?[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*)$`)
+	packagePattern  = regexp.MustCompile(`^[a-z0-9][a-z0-9+.-]*$`)
+	timezonePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_+-]*(/[A-Za-z0-9_+-]+)*$`)
+	versionPattern  = regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`)
+	userPattern     = regexp.MustCompile(`^[a-z_][a-z0-9_-]{0,31}$`)
+)
+
+// cloudConfig is the subset of cloud-init's cloud-config the templates use. It is marshaled
+// rather than written by hand so the result is always valid YAML.
+type cloudConfig struct {
+	Timezone      string      `yaml:"timezone,omitempty"`
+	PackageUpdate bool        `yaml:"package_update,omitempty"`
+	Packages      []string    `yaml:"packages,omitempty"`
+	WriteFiles    []writeFile `yaml:"write_files,omitempty"`
+	// RunCmd holds commands in list form, which cloud-init runs without a shell.
+	RunCmd [][]string `yaml:"runcmd,omitempty"`
+}
+
+type writeFile struct {
+	Path        string `yaml:"path"`
+	Permissions string `yaml:"permissions,omitempty"`
+	Content     string `yaml:"content"`
+}
+
+// templateVariable is a variable of a user data template.
+type templateVariable struct {
+	name        string
+	description string
+	// def is the value of the variable when it is not set.
+	def      string
+	validate func(string) error
+}
+
+// userDataTemplate renders cloud-config from the values of its variables, defaults applied.
+type userDataTemplate struct {
+	description string
+	variables   []templateVariable
+	render      func(vars map[string]string, config *cloudConfig)
+}
+
+func matching(pattern *regexp.Regexp, what string) func(string) error {
+	return func(v string) error {
+		if !pattern.MatchString(v) {
+			return fmt.Errorf("%q is not %s", v, what)
+		}
+		return nil
+	}
+}
+
+func validYAML(v string) error {
+	var doc map[string]any
+	if err := yaml.Unmarshal([]byte(v), &doc); err != nil {
+		return fmt.Errorf("not valid YAML: %w", err)
+	}
+	return nil
+}
+
+func validListenAddress(v string) error {
+	if _, _, err := net.SplitHostPort(v); err != nil {
+		return fmt.Errorf("%q is not host:port, e.g. :9100", v)
+	}
+	return nil
+}
+
+// commonVariables are the variables of every template.
+var commonVariables = []templateVariable{
+	{name: "packages", description: "Comma-separated extra apt packages to install, e.g. git,htop", validate: func(v string) error {
+		for _, p := range splitList(v) {
+			if !packagePattern.MatchString(p) {
+				return fmt.Errorf("%q is not a package name", p)
+			}
+		}
+		return nil
+	}},
+	{name: "timezone", description: "Time zone of the droplet, e.g. Europe/Amsterdam", validate: matching(timezonePattern, "a time zone")},
+}
+
+const nginxSite = `server {
+    listen 80 default_server;
+    listen [::]:80 default_server;
+    server_name %s;
+    root /var/www/html;
+    index index.html;
+
+    location / {
+        try_files $uri $uri/ =404;
+    }
+}
+`
+
+const nodeExporterUnit = `[Unit]
+Description=Prometheus node exporter
+After=network-online.target
+
+[Service]
+User=node_exporter
+ExecStart=/usr/local/bin/node_exporter --web.listen-address=%s
+Restart=on-failure
+
+[Install]
+WantedBy=multi-user.target
+`
+
+// userDataTemplates are the templates of userdata-render, by name.
+var userDataTemplates = map[string]userDataTemplate{
+	"nginx": {
+		description: "nginx serving /var/www/html on port 80",
+		variables: []templateVariable{
+			{name: "server_name", description: "Host name the site answers to", def: "_", validate: matching(hostnamePattern, "a host name")},
+			{name: "index_html", description: "Content of /var/www/html/index.html. Defaults to nginx's welcome page"},
+		},
+		render: func(vars map[string]string, config *cloudConfig) {
+			config.Packages = append(config.Packages, "nginx")
+			config.WriteFiles = append(config.WriteFiles, writeFile{Path: "/etc/nginx/sites-available/default", Permissions: "0644", Content: fmt.Sprintf(nginxSite, vars["server_name"])})
+			if vars["index_html"] != "" {
+				config.WriteFiles = append(config.WriteFiles, writeFile{Path: "/var/www/html/index.html", Permissions: "0644", Content: vars["index_html"]})
+			}
+			config.RunCmd = append(config.RunCmd, []string{"systemctl", "enable", "--now", "nginx"}, []string{"systemctl", "reload", "nginx"})
+		},
+	},
+	"docker": {
+		description: "Docker Engine with Compose, optionally starting a Compose project",
+		variables: []templateVariable{
+			{name: "compose", description: "Compose file to write to /opt/app/compose.yaml and start with docker compose up -d", validate: validYAML},
+			{name: "user", description: "Existing user to add to the docker group", validate: matching(userPattern, "a user name")},
+		},
+		render: func(vars map[string]string, config *cloudConfig) {
+			config.Packages = append(config.Packages, "docker.io", "docker-compose-v2")
+			config.RunCmd = append(config.RunCmd, []string{"systemctl", "enable", "--now", "docker"})
+			if vars["user"] != "" {
+				config.RunCmd = append(config.RunCmd, []string{"usermod", "-aG", "docker", vars["user"]})
+			}
+			if vars["compose"] != "" {
+				config.WriteFiles = append(config.WriteFiles, writeFile{Path: "/opt/app/compose.yaml", Permissions: "0644", Content: vars["compose"]})
+				config.RunCmd = append(config.RunCmd, []string{"docker", "compose", "-f", "/opt/app/compose.yaml", "up", "-d"})
+			}
+		},
+	},
+	"node-exporter": {
+		description: "Prometheus node exporter as a systemd service",
+		variables: []templateVariable{
+			{name: "version", description: "Release of node exporter to install", def: "1.8.2", validate: matching(versionPattern, "a version such as 1.8.2")},
+			{name: "listen_address", description: "Address the metrics are served on", def: ":9100", validate: validListenAddress},
+		},
+		render: func(vars map[string]string, config *cloudConfig) {
+			release := fmt.Sprintf("node_exporter-%s.linux-amd64", vars["version"])
+			config.Packages = append(config.Packages, "curl")
+			config.WriteFiles = append(config.WriteFiles, writeFile{Path: "/etc/systemd/system/node_exporter.service", Permissions: "0644", Content: fmt.Sprintf(nodeExporterUnit, vars["listen_address"])})
+			config.RunCmd = append(config.RunCmd,
+				[]string{"useradd", "--system", "--no-create-home", "--shell", "/usr/sbin/nologin", "node_exporter"},
+				[]string{"sh", "-c", fmt.Sprintf("curl -fsSL https://github.com/prometheus/node_exporter/releases/download/v%s/%s.tar.gz | tar -xz -C /tmp", vars["version"], release)},
+				[]string{"install", "-m", "0755", "/tmp/" + release + "/node_exporter", "/usr/local/bin/node_exporter"},
+				[]string{"systemctl", "daemon-reload"},
+				[]string{"systemctl", "enable", "--now", "node_exporter"},
+			)
+		},
+	},
+}
+
+func splitList(v string) []string {
+	var items []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// RenderedUserData is the result of userdata-render.
+type RenderedUserData struct {
+	Template  string            `json:"template"`
+	Variables map[string]string `json:"variables"`
+	// UserData is the cloud-config document to pass as the user data of a droplet.
+	UserData string `json:"user_data"`
+	// Ref is a reference to UserData that droplet-create accepts as UserDataRef.
+	Ref  string `json:"ref"`
+	Size int    `json:"size"`
+}
+
+// renderUserData renders the named template with vars, which must all be variables of it.
+func renderUserData(name string, vars map[string]string) (*RenderedUserData, error) {
+	tmpl, ok := userDataTemplates[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown template %q; templates are %s", name, strings.Join(slices.Sorted(maps.Keys(userDataTemplates)), ", "))
+	}
+	variables := append(slices.Clone(tmpl.variables), commonVariables...)
+	values := map[string]string{}
+	for _, v := range variables {
+		value := vars[v.name]
+		if value == "" {
+			value = v.def
+		} else if v.validate != nil {
+			if err := v.validate(value); err != nil {
+				return nil, fmt.Errorf("variable %s: %w", v.name, err)
+			}
+		}
+		values[v.name] = value
+	}
+	for k := range vars {
+		if !slices.ContainsFunc(variables, func(v templateVariable) bool { return v.name == k }) {
+			return nil, fmt.Errorf("template %s has no variable %s", name, k)
+		}
+	}
+
+	config := &cloudConfig{PackageUpdate: true, Timezone: values["timezone"]}
+	tmpl.render(values, config)
+	config.Packages = append(config.Packages, splitList(values["packages"])...)
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	userData := "#cloud-config\n" + string(data)
+	if len(userData) > maxUserData {
+		return nil, fmt.Errorf("the rendered user data is %d bytes, more than the %d a droplet accepts", len(userData), maxUserData)
+	}
+
+	query := url.Values{}
+	for k, v := range vars {
+		if v != "" {
+			query.Set(k, v)
+		}
+	}
+	ref := url.URL{Scheme: userDataRefScheme, Host: name, RawQuery: query.Encode()}
+	return &RenderedUserData{Template: name, Variables: values, UserData: userData, Ref: ref.String(), Size: len(userData)}, nil
+}
+
+// resolveUserDataRef renders the user data a userdata-render reference stands for. References
+// carry the template and variables rather than the result, so they stay valid across sessions.
+func resolveUserDataRef(ref string) (string, error) {
+	u, err := url.Parse(ref)
+	if err != nil || u.Scheme != userDataRefScheme || u.Host == "" {
+		return "", fmt.Errorf("%q is not a reference returned by userdata-render", ref)
+	}
+	query, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a reference returned by userdata-render", ref)
+	}
+	vars := map[string]string{}
+	for k := range query {
+		vars[k] = query.Get(k)
+	}
+	rendered, err := renderUserData(u.Host, vars)
+	if err != nil {
+		return "", err
+	}
+	return rendered.UserData, nil
+}
+
+// dropletUserData returns the user data of a droplet create request from the UserData or
+// UserDataRef argument, at most one of which may be set.
+func dropletUserData(args *common.Args) (string, error) {
+	userData := args.String("UserData")
+	ref := args.String("UserDataRef")
+	switch {
+	case userData != "" && ref != "":
+		return "", errors.New("at most one of UserData or UserDataRef may be provided")
+	case ref != "":
+		return resolveUserDataRef(ref)
+	case len(userData) > maxUserData:
+		return "", fmt.Errorf("UserData is %d bytes, more than the %d a droplet accepts", len(userData), maxUserData)
+	}
+	return userData, nil
+}
+
+// UserDataTool provides a tool that renders cloud-init user data from templates.
+type UserDataTool struct{}
+
+// NewUserDataTool creates a new user data tool
+func NewUserDataTool() *UserDataTool {
+	return &UserDataTool{}
+}
+
+func (u *UserDataTool) render(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	name := args.RequiredString("Template")
+	variables := args.Object("Variables")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	vars := map[string]string{}
+	fields, _ := req.GetArguments()["Variables"].(map[string]any)
+	for k := range fields {
+		vars[k] = variables.String(k)
+	}
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	rendered, err := renderUserData(name, vars)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: %s", err)), nil
+	}
+	return common.NewToolResultStructured(rendered)
+}
+
+// templatesDescription lists the templates and their variables for the tool description.
+func templatesDescription() string {
+	var b strings.Builder
+	for _, name := range slices.Sorted(maps.Keys(userDataTemplates)) {
+		tmpl := userDataTemplates[name]
+		fmt.Fprintf(&b, " %s: %s; variables:", name, tmpl.description)
+		for i, v := range tmpl.variables {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			fmt.Fprintf(&b, " %s (%s", v.name, v.description)
+			if v.def != "" {
+				fmt.Fprintf(&b, ", default %s", v.def)
+			}
+			b.WriteString(")")
+		}
+		b.WriteString(".")
+	}
+	b.WriteString(" Every template also takes packages and timezone.")
+	return b.String()
+}
+
+// Tools returns the userdata-render tool.
+func (u *UserDataTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: u.render,
+			Tool: mcp.NewTool("userdata-render",
+				mcp.WithDescription("Render cloud-init user data that sets up a droplet on first boot from a named template and its variables, instead of writing cloud-config by hand. Pass the returned ref as UserDataRef of droplet-create, or user_data as its UserData. Templates:"+templatesDescription()),
+				common.WithOutputSchema[RenderedUserData](),
+				mcp.WithString("Template", mcp.Required(), mcp.Enum(slices.Sorted(maps.Keys(userDataTemplates))...), mcp.Description("Name of the template")),
+				mcp.WithObject("Variables", mcp.Description("Values of the template's variables, as strings"), mcp.AdditionalProperties(map[string]any{"type": "string"})),
+				mcp.WithReadOnlyHintAnnotation(true),
+				mcp.WithDestructiveHintAnnotation(false),
+				mcp.WithIdempotentHintAnnotation(true),
+				mcp.WithOpenWorldHintAnnotation(false),
+			),
+		},
+	}
+}
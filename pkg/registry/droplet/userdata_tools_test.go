@@ -0,0 +1,80 @@
+package droplet
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestUserDataTool_render(t *testing.T) {
+	call := func(t *testing.T, args map[string]any) (*mcp.CallToolResult, *RenderedUserData) {
+		t.Helper()
+		resp, err := NewUserDataTool().render(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		rendered, _ := resp.StructuredContent.(*RenderedUserData)
+		return resp, rendered
+	}
+	parse := func(t *testing.T, userData string) cloudConfig {
+		t.Helper()
+		require.True(t, strings.HasPrefix(userData, "#cloud-config\n"))
+		var config cloudConfig
+		require.NoError(t, yaml.Unmarshal([]byte(userData), &config))
+		return config
+	}
+
+	t.Run("Every template renders with its defaults", func(t *testing.T) {
+		for name := range userDataTemplates {
+			resp, rendered := call(t, map[string]any{"Template": name})
+			require.False(t, resp.IsError, name)
+			config := parse(t, rendered.UserData)
+			require.True(t, config.PackageUpdate, name)
+			require.NotEmpty(t, config.RunCmd, name)
+			require.Equal(t, "userdata://"+name, rendered.Ref)
+		}
+	})
+
+	t.Run("Variables with quotes and newlines stay valid", func(t *testing.T) {
+		compose := "services:\n  web:\n    image: \"nginx:1.27\"\n    ports: [\"80:80\"]\n"
+		resp, rendered := call(t, map[string]any{"Template": "docker", "Variables": map[string]any{"compose": compose, "user": "deploy", "packages": "git, htop"}})
+		require.False(t, resp.IsError)
+		config := parse(t, rendered.UserData)
+		require.Equal(t, []string{"docker.io", "docker-compose-v2", "git", "htop"}, config.Packages)
+		require.Equal(t, compose, config.WriteFiles[0].Content)
+		require.Contains(t, config.RunCmd, []string{"usermod", "-aG", "docker", "deploy"})
+
+		userData, err := resolveUserDataRef(rendered.Ref)
+		require.NoError(t, err)
+		require.Equal(t, rendered.UserData, userData)
+	})
+
+	t.Run("Defaults are filled in", func(t *testing.T) {
+		_, rendered := call(t, map[string]any{"Template": "node-exporter", "Variables": map[string]any{"listen_address": "127.0.0.1:9100"}})
+		require.Equal(t, map[string]string{"version": "1.8.2", "listen_address": "127.0.0.1:9100", "packages": "", "timezone": ""}, rendered.Variables)
+		require.Contains(t, rendered.UserData, "--web.listen-address=127.0.0.1:9100")
+	})
+
+	for name, args := range map[string]map[string]any{
+		"Unknown template":         {"Template": "apache"},
+		"Unknown variable":         {"Template": "nginx", "Variables": map[string]any{"port": "8080"}},
+		"Invalid server name":      {"Template": "nginx", "Variables": map[string]any{"server_name": "example.com; return 301"}},
+		"Invalid compose file":     {"Template": "docker", "Variables": map[string]any{"compose": "services: [web"}},
+		"Invalid version":          {"Template": "node-exporter", "Variables": map[string]any{"version": "latest; rm -rf /"}},
+		"Variable is not a string": {"Template": "nginx", "Variables": map[string]any{"server_name": float64(1)}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, _ := call(t, args)
+			require.True(t, resp.IsError)
+		})
+	}
+}
+
+func TestResolveUserDataRef(t *testing.T) {
+	for _, ref := range []string{"https://example.com/user-data", "userdata://", "userdata://nginx?%zz"} {
+		_, err := resolveUserDataRef(ref)
+		require.Error(t, err, ref)
+	}
+}
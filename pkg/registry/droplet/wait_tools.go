@@ -0,0 +1,154 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultDropletWaitTimeout = 300
+	maxDropletWaitTimeout     = 1800
+	defaultDropletWaitPoll    = 5
+	maxDropletWaitPoll        = 60
+)
+
+// dropletWaitStatuses are the statuses droplet-wait can wait for.
+var dropletWaitStatuses = []string{"new", "active", "off", "archive"}
+
+// DropletWaitResult is the outcome of a droplet-wait call.
+type DropletWaitResult struct {
+	DropletID      int           `json:"droplet_id"`
+	Status         string        `json:"status"`
+	Reached        bool          `json:"reached"`
+	LastStatus     string        `json:"last_status"`
+	Polls          int           `json:"polls"`
+	ElapsedSeconds float64       `json:"elapsed_seconds"`
+	Droplet        *godo.Droplet `json:"droplet,omitempty"`
+}
+
+// DropletWaitTool provides a tool that waits for a droplet to reach a status.
+type DropletWaitTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+	// unit is the duration of one second of TimeoutSeconds and PollIntervalSeconds.
+	unit time.Duration
+}
+
+// NewDropletWaitTool creates a new droplet wait tool
+func NewDropletWaitTool(client func(ctx context.Context) (*godo.Client, error)) *DropletWaitTool {
+	return &DropletWaitTool{client: client, unit: time.Second}
+}
+
+// dropletReached reports whether droplet is in status. An active droplet also needs an IPv4
+// address, since droplets report active shortly before their networking is assigned.
+func dropletReached(droplet *godo.Droplet, status string) bool {
+	if droplet.Status != status {
+		return false
+	}
+	return status != dropletStatusActive || (droplet.Networks != nil && len(droplet.Networks.V4) > 0)
+}
+
+// waitForDroplet polls a droplet until it reaches the target status or the timeout passes,
+// sending a progress notification after every poll that did not reach it.
+func (w *DropletWaitTool) waitForDroplet(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	id := int(args.RequiredNumber("ID"))
+	status := args.String("Status")
+	timeout := args.Number("TimeoutSeconds", defaultDropletWaitTimeout)
+	interval := args.Number("PollIntervalSeconds", defaultDropletWaitPoll)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if status == "" {
+		status = dropletStatusActive
+	}
+
+	var problems []string
+	if !slices.Contains(dropletWaitStatuses, status) {
+		problems = append(problems, fmt.Sprintf("Status must be one of %s", strings.Join(dropletWaitStatuses, ", ")))
+	}
+	if timeout < 1 || timeout > maxDropletWaitTimeout {
+		problems = append(problems, fmt.Sprintf("TimeoutSeconds must be between 1 and %d", maxDropletWaitTimeout))
+	}
+	if interval < 1 || interval > maxDropletWaitPoll {
+		problems = append(problems, fmt.Sprintf("PollIntervalSeconds must be between 1 and %d", maxDropletWaitPoll))
+	}
+	if len(problems) > 0 {
+		return mcp.NewToolResultError("invalid arguments: " + strings.Join(problems, "; ")), nil
+	}
+
+	client, err := w.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout*float64(w.unit)))
+	defer cancel()
+	ticker := time.NewTicker(time.Duration(interval * float64(w.unit)))
+	defer ticker.Stop()
+
+	result := &DropletWaitResult{DropletID: id, Status: status}
+	start := time.Now()
+	for waiting := true; waiting; {
+		droplet, _, err := client.Droplets.Get(waitCtx, id)
+		result.ElapsedSeconds = float64(time.Since(start)) / float64(w.unit)
+		if err != nil && waitCtx.Err() == nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		if err == nil {
+			result.Polls++
+			result.LastStatus = droplet.Status
+			result.Droplet = droplet
+			if dropletReached(droplet, status) {
+				result.Reached = true
+				return common.NewToolResultStructured(result)
+			}
+			common.NotifyProgress(ctx, req, result.ElapsedSeconds, timeout,
+				fmt.Sprintf("droplet %d is %s, waiting for %s", id, droplet.Status, status))
+		}
+		select {
+		case <-waitCtx.Done():
+			waiting = false
+		case <-ticker.C:
+		}
+	}
+	if ctx.Err() != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("stopped waiting for droplet %d: %v", id, ctx.Err())), nil
+	}
+
+	// the last status is still reported, so the caller can decide whether to wait again.
+	res, err := common.NewToolResultStructured(result)
+	if err != nil {
+		return nil, err
+	}
+	res.IsError = true
+	res.Content = append(res.Content, mcp.NewTextContent(fmt.Sprintf(
+		"droplet %d did not reach status %q within %g seconds (last status %q)", id, status, timeout, result.LastStatus)))
+	return res, nil
+}
+
+// Tools returns a list of tool functions
+func (w *DropletWaitTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: w.waitForDroplet,
+			Tool: mcp.NewTool("droplet-wait",
+				mcp.WithDescription("Wait until a droplet reaches a status, e.g. after droplet-create or a power action. Polls the droplet every PollIntervalSeconds and sends progress notifications while waiting; "+
+					"active also waits for the droplet's IPv4 address. Returns the droplet once the status is reached, or an error carrying the last status seen after TimeoutSeconds."),
+				common.WithOutputSchema[DropletWaitResult](),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("ID of the droplet to wait for")),
+				mcp.WithString("Status", mcp.Enum(dropletWaitStatuses...), mcp.DefaultString(dropletStatusActive), mcp.Description("Status to wait for")),
+				mcp.WithNumber("TimeoutSeconds", mcp.DefaultNumber(defaultDropletWaitTimeout), mcp.Min(1), mcp.Max(maxDropletWaitTimeout), mcp.Description("How long to wait before giving up")),
+				mcp.WithNumber("PollIntervalSeconds", mcp.DefaultNumber(defaultDropletWaitPoll), mcp.Min(1), mcp.Max(maxDropletWaitPoll), mcp.Description("How often to check the droplet")),
+			),
+		},
+	}
+}
@@ -0,0 +1,134 @@
+package droplet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// notifySession is an initialized client session that records the notifications sent to it.
+type notifySession struct {
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (s *notifySession) SessionID() string { return "session-1" }
+func (s *notifySession) Initialize()       {}
+func (s *notifySession) Initialized() bool { return true }
+func (s *notifySession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return s.notifications
+}
+
+func setupDropletWaitToolWithMock(droplets *MockDropletsService) *DropletWaitTool {
+	tool := NewDropletWaitTool(func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{Droplets: droplets}, nil
+	})
+	tool.unit = time.Millisecond
+	return tool
+}
+
+func TestDropletWaitTool_waitForDroplet(t *testing.T) {
+	addressed := &godo.Networks{V4: []godo.NetworkV4{{IPAddress: "203.0.113.10", Type: "public"}}}
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockDropletsService)
+		expectError bool
+		reached     bool
+		polls       int
+		progress    int
+	}{
+		{
+			name: "waits until active with an address",
+			args: map[string]any{"ID": float64(1), "PollIntervalSeconds": float64(1)},
+			mockSetup: func(m *MockDropletsService) {
+				gomock.InOrder(
+					m.EXPECT().Get(gomock.Any(), 1).Return(&godo.Droplet{ID: 1, Status: "new"}, nil, nil),
+					m.EXPECT().Get(gomock.Any(), 1).Return(&godo.Droplet{ID: 1, Status: "active"}, nil, nil),
+					m.EXPECT().Get(gomock.Any(), 1).Return(&godo.Droplet{ID: 1, Status: "active", Networks: addressed}, nil, nil),
+				)
+			},
+			reached:  true,
+			polls:    3,
+			progress: 2,
+		},
+		{
+			name: "already off",
+			args: map[string]any{"ID": float64(2), "Status": "off"},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().Get(gomock.Any(), 2).Return(&godo.Droplet{ID: 2, Status: "off"}, nil, nil)
+			},
+			reached: true,
+			polls:   1,
+		},
+		{
+			name: "timeout reports the last status",
+			args: map[string]any{"ID": float64(3), "Status": "off", "TimeoutSeconds": float64(30), "PollIntervalSeconds": float64(60)},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().Get(gomock.Any(), 3).Return(&godo.Droplet{ID: 3, Status: "active"}, nil, nil)
+			},
+			expectError: true,
+			polls:       1,
+			progress:    1,
+		},
+		{
+			name: "api error",
+			args: map[string]any{"ID": float64(4)},
+			mockSetup: func(m *MockDropletsService) {
+				m.EXPECT().Get(gomock.Any(), 4).Return(nil, nil, errors.New("not found"))
+			},
+			expectError: true,
+		},
+		{
+			name:        "invalid status",
+			args:        map[string]any{"ID": float64(5), "Status": "running", "TimeoutSeconds": float64(0)},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			droplets := NewMockDropletsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(droplets)
+			}
+			tool := setupDropletWaitToolWithMock(droplets)
+
+			srv := server.NewMCPServer("test", "0.0.0")
+			srv.AddTools(tool.Tools()...)
+			session := &notifySession{notifications: make(chan mcp.JSONRPCNotification, 10)}
+			msg, err := json.Marshal(map[string]any{
+				"jsonrpc": "2.0",
+				"id":      1,
+				"method":  "tools/call",
+				"params":  map[string]any{"name": "droplet-wait", "arguments": tc.args, "_meta": map[string]any{"progressToken": "wait-1"}},
+			})
+			require.NoError(t, err)
+			resp, ok := srv.HandleMessage(srv.WithContext(context.Background(), session), msg).(mcp.JSONRPCResponse)
+			require.True(t, ok)
+			res := resp.Result.(*mcp.CallToolResult)
+			require.Equal(t, tc.expectError, res.IsError)
+			require.Len(t, session.notifications, tc.progress)
+			for range tc.progress {
+				notification := <-session.notifications
+				require.Equal(t, "notifications/progress", notification.Method)
+				require.Equal(t, "wait-1", notification.Params.AdditionalFields["progressToken"])
+			}
+			if tc.polls == 0 {
+				return
+			}
+			result := res.StructuredContent.(*DropletWaitResult)
+			require.Equal(t, tc.reached, result.Reached)
+			require.Equal(t, tc.polls, result.Polls)
+			require.NotNil(t, result.Droplet)
+		})
+	}
+}
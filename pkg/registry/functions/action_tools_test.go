@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	"mcp-digitalocean/internal/dryrun"
+
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/require"
 )
@@ -186,6 +188,31 @@ func TestActionTool_DeleteAction(t *testing.T) {
 	require.Contains(t, content, "deleted successfully")
 }
 
+func TestActionTool_DeleteAction_dryRun(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("dry run sent %s %s", r.Method, r.URL.Path)
+	}))
+	defer ts.Close()
+
+	resolver := newTestResolver(t, ts, "ns-uuid-1", "test-ns")
+	tool := NewActionTool(resolver)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "functions-delete-action"
+	req.Params.Arguments = map[string]interface{}{
+		"NamespaceID": "ns-uuid-1",
+		"ActionName":  "hello",
+		dryrun.Arg:    true,
+	}
+	resp, err := dryrun.Middleware(tool.deleteAction)(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	result := resp.StructuredContent.(dryrun.Result)
+	require.Len(t, result.Requests, 1)
+	require.Equal(t, http.MethodDelete, result.Requests[0].Method)
+	require.Contains(t, result.Requests[0].URL, "/namespaces/test-ns/actions/hello")
+}
+
 func TestActionTool_InvokeAction(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		require.Equal(t, http.MethodPost, r.Method)
@@ -61,6 +61,9 @@ func (t *DeploymentGuideTool) Tools() []server.ServerTool {
 						"paraphrase the steps to the user.",
 				),
 				mcp.WithReadOnlyHintAnnotation(true),
+				mcp.WithDestructiveHintAnnotation(false),
+				mcp.WithIdempotentHintAnnotation(true),
+				mcp.WithOpenWorldHintAnnotation(false),
 			),
 		},
 	}
@@ -10,6 +10,8 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+
+	"mcp-digitalocean/internal/dryrun"
 )
 
 const owBasePath = "/api/v1"
@@ -25,12 +27,14 @@ type owError struct {
 	Code  string `json:"code,omitempty"`
 }
 
+// newOWClient creates a client of the OpenWhisk API at apiHost. Its mutating requests are recorded
+// rather than sent during dry runs, like those of the API client.
 func newOWClient(apiHost, authKey string) *owClient {
 	apiHost = strings.TrimRight(apiHost, "/")
 	return &owClient{
 		apiHost: apiHost,
 		authKey: authKey,
-		http:    http.DefaultClient,
+		http:    &http.Client{Transport: dryrun.NewTransport(http.DefaultTransport)},
 	}
 }
 
@@ -8,10 +8,16 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+
+	"mcp-digitalocean/internal/dryrun"
 )
 
 var huggingFaceModelsAPIBase = "https://huggingface.co/api/models"
 
+// huggingFaceHTTPClient calls the Hugging Face Hub API. Its requests are only lookups, but it goes
+// through the dry-run transport like every other client, so a dry run never sends a write.
+var huggingFaceHTTPClient = &http.Client{Transport: dryrun.NewTransport(http.DefaultTransport)}
+
 // fetchHuggingFaceCommitSHA resolves the default-branch commit for a Hugging Face repo.
 // Overridden in unit tests.
 var fetchHuggingFaceCommitSHA = defaultFetchHuggingFaceCommitSHA
@@ -46,7 +52,7 @@ func defaultFetchHuggingFaceCommitSHA(ctx context.Context, repoID, hfToken strin
 		req.Header.Set("Authorization", "Bearer "+hfToken)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := huggingFaceHTTPClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("hugging face API request failed: %w", err)
 	}
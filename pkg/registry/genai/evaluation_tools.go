@@ -13,6 +13,8 @@ import (
 	"sync"
 	"time"
 
+	"mcp-digitalocean/internal/dryrun"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -22,8 +24,9 @@ import (
 // With the default API base (https://api.digitalocean.com/), this resolves to .../v2/gen-ai/...
 const genAIAPIPath = "v2/gen-ai"
 
-// presignedUploadHTTPClient performs PUTs to third-party presigned URLs (not the godo client).
-var presignedUploadHTTPClient = &http.Client{Timeout: 15 * time.Minute}
+// presignedUploadHTTPClient performs PUTs to third-party presigned URLs (not the godo client). Like
+// the godo client, it records its uploads rather than sending them during dry runs.
+var presignedUploadHTTPClient = &http.Client{Transport: dryrun.NewTransport(http.DefaultTransport), Timeout: 15 * time.Minute}
 
 // EvaluationService provides helpers for evaluation operations
 type EvaluationService struct {
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -83,64 +85,28 @@ func (a *AlertPolicyTool) listAlertPolicies(ctx context.Context, req mcp.CallToo
 
 // createAlertPolicy creates a new alert policy
 func (a *AlertPolicyTool) createAlertPolicy(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	alertType := req.GetArguments()["Type"].(string)
-	description := req.GetArguments()["Description"].(string)
-	compare := godo.AlertPolicyComp(req.GetArguments()["Compare"].(string))
-	value := float32(req.GetArguments()["Value"].(float64))
-	window := req.GetArguments()["Window"].(string)
-
-	// Parse entities array
-	rawEntities, _ := req.GetArguments()["Entities"]
-	var entities []string
-	if arr, ok := rawEntities.([]interface{}); ok {
-		for _, v := range arr {
-			if s, ok := v.(string); ok {
-				entities = append(entities, s)
-			}
-		}
-	}
-
-	// Parse tags array
-	rawTags, _ := req.GetArguments()["Tags"]
-	var tags []string
-	if arr, ok := rawTags.([]interface{}); ok {
-		for _, v := range arr {
-			if s, ok := v.(string); ok {
-				tags = append(tags, s)
-			}
-		}
-	}
+	args := common.NewArgs(req)
+	alertType := args.RequiredString("Type")
+	description := args.RequiredString("Description")
+	compare := godo.AlertPolicyComp(args.RequiredString("Compare"))
+	value := float32(args.RequiredNumber("Value"))
+	window := args.RequiredString("Window")
+	entities := args.Strings("Entities")
+	tags := args.Strings("Tags")
+	enabled := args.Bool("Enabled", true)
 
 	// Parse alerts
-	rawAlerts, _ := req.GetArguments()["Alerts"]
 	var alerts godo.Alerts
-	if alertsMap, ok := rawAlerts.(map[string]interface{}); ok {
-		// Parse email alerts
-		if rawEmails, ok := alertsMap["Email"].([]interface{}); ok {
-			for _, v := range rawEmails {
-				if email, ok := v.(string); ok {
-					alerts.Email = append(alerts.Email, email)
-				}
-			}
-		}
-
-		// Parse Slack alerts
-		if rawSlack, ok := alertsMap["Slack"].([]interface{}); ok {
-			for _, v := range rawSlack {
-				if slackMap, ok := v.(map[string]interface{}); ok {
-					slackDetails := godo.SlackDetails{
-						URL:     slackMap["URL"].(string),
-						Channel: slackMap["Channel"].(string),
-					}
-					alerts.Slack = append(alerts.Slack, slackDetails)
-				}
-			}
-		}
+	alertsArgs := args.Object("Alerts")
+	alerts.Email = alertsArgs.Strings("Email")
+	for _, slack := range alertsArgs.Objects("Slack") {
+		alerts.Slack = append(alerts.Slack, godo.SlackDetails{
+			URL:     slack.RequiredString("URL"),
+			Channel: slack.RequiredString("Channel"),
+		})
 	}
-
-	enabled := true
-	if v, ok := req.GetArguments()["Enabled"].(bool); ok {
-		enabled = v
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	createRequest := &godo.AlertPolicyCreateRequest{
@@ -180,64 +146,28 @@ func (a *AlertPolicyTool) updateAlertPolicy(ctx context.Context, req mcp.CallToo
 		return mcp.NewToolResultError("Alert Policy UUID is required"), nil
 	}
 
-	alertType := req.GetArguments()["Type"].(string)
-	description := req.GetArguments()["Description"].(string)
-	compare := godo.AlertPolicyComp(req.GetArguments()["Compare"].(string))
-	value := float32(req.GetArguments()["Value"].(float64))
-	window := req.GetArguments()["Window"].(string)
-
-	// Parse entities array
-	rawEntities, _ := req.GetArguments()["Entities"]
-	var entities []string
-	if arr, ok := rawEntities.([]interface{}); ok {
-		for _, v := range arr {
-			if s, ok := v.(string); ok {
-				entities = append(entities, s)
-			}
-		}
-	}
-
-	// Parse tags array
-	rawTags, _ := req.GetArguments()["Tags"]
-	var tags []string
-	if arr, ok := rawTags.([]interface{}); ok {
-		for _, v := range arr {
-			if s, ok := v.(string); ok {
-				tags = append(tags, s)
-			}
-		}
-	}
+	args := common.NewArgs(req)
+	alertType := args.RequiredString("Type")
+	description := args.RequiredString("Description")
+	compare := godo.AlertPolicyComp(args.RequiredString("Compare"))
+	value := float32(args.RequiredNumber("Value"))
+	window := args.RequiredString("Window")
+	entities := args.Strings("Entities")
+	tags := args.Strings("Tags")
+	enabled := args.Bool("Enabled", true)
 
 	// Parse alerts
-	rawAlerts, _ := req.GetArguments()["Alerts"]
 	var alerts godo.Alerts
-	if alertsMap, ok := rawAlerts.(map[string]interface{}); ok {
-		// Parse email alerts
-		if rawEmails, ok := alertsMap["Email"].([]interface{}); ok {
-			for _, v := range rawEmails {
-				if email, ok := v.(string); ok {
-					alerts.Email = append(alerts.Email, email)
-				}
-			}
-		}
-
-		// Parse Slack alerts
-		if rawSlack, ok := alertsMap["Slack"].([]interface{}); ok {
-			for _, v := range rawSlack {
-				if slackMap, ok := v.(map[string]interface{}); ok {
-					slackDetails := godo.SlackDetails{
-						URL:     slackMap["URL"].(string),
-						Channel: slackMap["Channel"].(string),
-					}
-					alerts.Slack = append(alerts.Slack, slackDetails)
-				}
-			}
-		}
+	alertsArgs := args.Object("Alerts")
+	alerts.Email = alertsArgs.Strings("Email")
+	for _, slack := range alertsArgs.Objects("Slack") {
+		alerts.Slack = append(alerts.Slack, godo.SlackDetails{
+			URL:     slack.RequiredString("URL"),
+			Channel: slack.RequiredString("Channel"),
+		})
 	}
-
-	enabled := true
-	if v, ok := req.GetArguments()["Enabled"].(bool); ok {
-		enabled = v
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	updateRequest := &godo.AlertPolicyUpdateRequest{
@@ -304,8 +234,8 @@ func (c *AlertPolicyTool) Tools() []server.ServerTool {
 			Handler: c.listAlertPolicies,
 			Tool: mcp.NewTool("alert-policy-list",
 				mcp.WithDescription("List all Alert Policies in your account with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultAlertPoliciesPage), mcp.Description("Page number for pagination (starts from 1)")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultAlertPoliciesPageSize), mcp.Description("Number of items per page (1-200, default 20)")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultAlertPoliciesPage), mcp.Description("Page number for pagination (starts from 1)")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultAlertPoliciesPageSize), mcp.Description("Number of items per page (1-200, default 20)")),
 			),
 		},
 		{
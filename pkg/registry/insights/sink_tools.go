@@ -0,0 +1,333 @@
+package insights
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// godo has no client for monitoring sinks yet, so these tools call the API through the raw
+// request helpers of the godo client.
+const (
+	sinksAPIPath        = "v2/monitoring/sinks"
+	destinationsAPIPath = sinksAPIPath + "/destinations"
+)
+
+// destinationTypes are the kinds of destinations logs can be forwarded to.
+var destinationTypes = []string{"opensearch_dbaas", "opensearch_ext"}
+
+// DestinationCredentials authenticate against an external OpenSearch cluster.
+type DestinationCredentials struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// DestinationConfig is where a destination sends logs. Managed OpenSearch clusters are named
+// by ClusterUUID, external ones by Endpoint and Credentials.
+type DestinationConfig struct {
+	Endpoint      string                  `json:"endpoint,omitempty"`
+	Credentials   *DestinationCredentials `json:"credentials,omitempty"`
+	ClusterUUID   string                  `json:"cluster_uuid,omitempty"`
+	ClusterName   string                  `json:"cluster_name,omitempty"`
+	IndexName     string                  `json:"index_name,omitempty"`
+	RetentionDays int                     `json:"retention_days,omitempty"`
+}
+
+// Destination is a target of monitoring sinks.
+type Destination struct {
+	ID     string             `json:"id,omitempty"`
+	Name   string             `json:"name"`
+	Type   string             `json:"type"`
+	Config *DestinationConfig `json:"config"`
+}
+
+// SinkResource is a resource whose logs a sink forwards.
+type SinkResource struct {
+	URN  string `json:"urn"`
+	Name string `json:"name,omitempty"`
+}
+
+// Sink forwards the logs of resources to a destination.
+type Sink struct {
+	ID          string         `json:"sink_uuid,omitempty"`
+	Destination *Destination   `json:"destination,omitempty"`
+	Resources   []SinkResource `json:"resources"`
+}
+
+// SinkTool provides tools for monitoring sinks and their destinations
+type SinkTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+// NewSinkTool creates a new monitoring sink tool
+func NewSinkTool(client func(ctx context.Context) (*godo.Client, error)) *SinkTool {
+	return &SinkTool{
+		client: client,
+	}
+}
+
+// sinksRequest sends a request to the monitoring sinks API and decodes the response into out.
+func sinksRequest(ctx context.Context, client *godo.Client, method, path string, body, out any) error {
+	apiReq, err := client.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(ctx, apiReq, out)
+	return err
+}
+
+// createDestination creates a destination that sinks can forward logs to
+func (s *SinkTool) createDestination(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	destType := args.RequiredString("Type")
+	clusterUUID := args.String("ClusterUUID")
+	clusterName := args.String("ClusterName")
+	endpoint := args.String("Endpoint")
+	username := args.String("Username")
+	password := args.String("Password")
+	indexName := args.String("IndexName")
+	retentionDays := args.Number("RetentionDays", 0)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	config := &DestinationConfig{IndexName: indexName, RetentionDays: int(retentionDays)}
+	switch destType {
+	case "opensearch_dbaas":
+		if clusterUUID == "" {
+			return mcp.NewToolResultError("ClusterUUID is required for opensearch_dbaas destinations"), nil
+		}
+		config.ClusterUUID = clusterUUID
+		config.ClusterName = clusterName
+	case "opensearch_ext":
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return mcp.NewToolResultError("Endpoint must be the https URL of the OpenSearch cluster for opensearch_ext destinations"), nil
+		}
+		config.Endpoint = endpoint
+		if username != "" || password != "" {
+			config.Credentials = &DestinationCredentials{Username: username, Password: password}
+		}
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("Type must be one of %s", strings.Join(destinationTypes, ", "))), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	var output struct {
+		Destination *Destination `json:"destination"`
+	}
+	err = sinksRequest(ctx, client, http.MethodPost, destinationsAPIPath, &Destination{Name: name, Type: destType, Config: config}, &output)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonDestination, err := json.MarshalIndent(output.Destination, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonDestination)), nil
+}
+
+// listDestinations lists the destinations of monitoring sinks
+func (s *SinkTool) listDestinations(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	var output struct {
+		Destinations []*Destination `json:"destinations"`
+	}
+	if err := sinksRequest(ctx, client, http.MethodGet, destinationsAPIPath, nil, &output); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if output.Destinations == nil {
+		output.Destinations = []*Destination{}
+	}
+
+	jsonDestinations, err := json.MarshalIndent(output.Destinations, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonDestinations)), nil
+}
+
+// deleteDestination deletes a destination of monitoring sinks
+func (s *SinkTool) deleteDestination(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	id := args.RequiredString("DestinationID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	if err := sinksRequest(ctx, client, http.MethodDelete, destinationsAPIPath+"/"+url.PathEscape(id), nil, nil); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Destination deleted successfully"), nil
+}
+
+// createSink starts forwarding the logs of resources to a destination
+func (s *SinkTool) createSink(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	destinationID := args.RequiredString("DestinationID")
+	urns := args.RequiredStrings("ResourceURNs")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(urns) == 0 {
+		return mcp.NewToolResultError("At least one resource URN is required"), nil
+	}
+
+	resources := make([]SinkResource, 0, len(urns))
+	for _, urn := range urns {
+		if parts := strings.SplitN(urn, ":", 3); len(parts) != 3 || parts[0] != "do" || parts[1] == "" || parts[2] == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("%q is not a resource URN (e.g., do:dbaas:<cluster-uuid>)", urn)), nil
+		}
+		resources = append(resources, SinkResource{URN: urn})
+	}
+
+	body := struct {
+		DestinationUUID string         `json:"destination_uuid"`
+		Resources       []SinkResource `json:"resources"`
+	}{DestinationUUID: destinationID, Resources: resources}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	if err := sinksRequest(ctx, client, http.MethodPost, sinksAPIPath, body, nil); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Sink created: forwarding logs of %d resource(s) to destination %s", len(resources), destinationID)), nil
+}
+
+// listSinks lists monitoring sinks, optionally only those of one resource
+func (s *SinkTool) listSinks(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	resourceURN := args.String("ResourceURN")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	path := sinksAPIPath
+	if resourceURN != "" {
+		path += "?" + url.Values{"resource_id": {resourceURN}}.Encode()
+	}
+	var output struct {
+		Sinks []*Sink `json:"sinks"`
+	}
+	if err := sinksRequest(ctx, client, http.MethodGet, path, nil, &output); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if output.Sinks == nil {
+		output.Sinks = []*Sink{}
+	}
+
+	jsonSinks, err := json.MarshalIndent(output.Sinks, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonSinks)), nil
+}
+
+// deleteSink stops a monitoring sink from forwarding logs
+func (s *SinkTool) deleteSink(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	id := args.RequiredString("SinkID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	if err := sinksRequest(ctx, client, http.MethodDelete, sinksAPIPath+"/"+url.PathEscape(id), nil, nil); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Sink deleted successfully"), nil
+}
+
+// Tools returns the tools for monitoring sinks and destinations
+func (s *SinkTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.createDestination,
+			Tool: mcp.NewTool("monitoring-destination-create",
+				mcp.WithDescription("Create a destination that monitoring sinks forward logs to: a managed OpenSearch cluster (opensearch_dbaas) or an external OpenSearch cluster (opensearch_ext)"),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the destination")),
+				mcp.WithString("Type", mcp.Required(), mcp.Enum(destinationTypes...), mcp.Description("Type of the destination")),
+				mcp.WithString("ClusterUUID", mcp.Description("UUID of the managed OpenSearch cluster (opensearch_dbaas only)")),
+				mcp.WithString("ClusterName", mcp.Description("Name of the managed OpenSearch cluster (opensearch_dbaas only, optional)")),
+				mcp.WithString("Endpoint", mcp.Description("https URL of the external OpenSearch cluster (opensearch_ext only)")),
+				mcp.WithString("Username", mcp.Description("Username for the external OpenSearch cluster (opensearch_ext only)")),
+				mcp.WithString("Password", mcp.Description("Password for the external OpenSearch cluster (opensearch_ext only)")),
+				mcp.WithString("IndexName", mcp.Description("OpenSearch index the logs are written to (optional)")),
+				mcp.WithNumber("RetentionDays", mcp.Min(1), mcp.Description("Days the logs are kept in the index (optional)")),
+			),
+		},
+		{
+			Handler: s.listDestinations,
+			Tool: mcp.NewTool("monitoring-destination-list",
+				mcp.WithDescription("List the destinations monitoring sinks can forward logs to"),
+			),
+		},
+		{
+			Handler: s.deleteDestination,
+			Tool: mcp.NewTool("monitoring-destination-delete",
+				mcp.WithDestructiveHintAnnotation(true),
+				mcp.WithDescription("Delete a monitoring sink destination. Logs already forwarded to it are kept."),
+				mcp.WithString("DestinationID", mcp.Required(), mcp.Description("ID of the destination to delete")),
+			),
+		},
+		{
+			Handler: s.createSink,
+			Tool: mcp.NewTool("monitoring-sink-create",
+				mcp.WithDescription("Create a monitoring sink that forwards the logs of resources to a destination from monitoring-destination-list"),
+				mcp.WithString("DestinationID", mcp.Required(), mcp.Description("ID of the destination to forward logs to")),
+				mcp.WithArray("ResourceURNs", mcp.Required(), mcp.WithStringItems(), mcp.Description("URNs of the resources whose logs are forwarded (e.g., do:dbaas:<cluster-uuid>)")),
+			),
+		},
+		{
+			Handler: s.listSinks,
+			Tool: mcp.NewTool("monitoring-sink-list",
+				mcp.WithDescription("List monitoring sinks with their destinations and resources"),
+				mcp.WithString("ResourceURN", mcp.Description("Only list the sinks of this resource URN (optional)")),
+			),
+		},
+		{
+			Handler: s.deleteSink,
+			Tool: mcp.NewTool("monitoring-sink-delete",
+				mcp.WithDestructiveHintAnnotation(true),
+				mcp.WithDescription("Delete a monitoring sink, which stops forwarding the logs of its resources"),
+				mcp.WithString("SinkID", mcp.Required(), mcp.Description("ID of the sink to delete")),
+			),
+		},
+	}
+}
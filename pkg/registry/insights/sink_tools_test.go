@@ -0,0 +1,204 @@
+package insights
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+type sinkAPIRequest struct {
+	method string
+	path   string
+	query  string
+	body   map[string]any
+}
+
+// setupSinkToolWithServer returns a SinkTool whose client talks to a test server answering every
+// request with status and response, and the requests the server received.
+func setupSinkToolWithServer(t *testing.T, status int, response any) (*SinkTool, *[]sinkAPIRequest) {
+	t.Helper()
+
+	var requests []sinkAPIRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received := sinkAPIRequest{method: r.Method, path: r.URL.Path, query: r.URL.RawQuery}
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+			require.NoError(t, json.Unmarshal(data, &received.body))
+		}
+		requests = append(requests, received)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if response != nil {
+			_ = json.NewEncoder(w).Encode(response)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := godo.New(srv.Client(), godo.SetBaseURL(srv.URL))
+	require.NoError(t, err)
+	return NewSinkTool(func(ctx context.Context) (*godo.Client, error) { return client, nil }), &requests
+}
+
+func TestSinkTool_createDestination(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        map[string]any
+		status      int
+		expectBody  map[string]any
+		expectError bool
+	}{
+		{
+			name:   "Managed OpenSearch destination",
+			args:   map[string]any{"Name": "logs", "Type": "opensearch_dbaas", "ClusterUUID": "os-1", "IndexName": "app-logs", "RetentionDays": float64(14)},
+			status: http.StatusCreated,
+			expectBody: map[string]any{
+				"name":   "logs",
+				"type":   "opensearch_dbaas",
+				"config": map[string]any{"cluster_uuid": "os-1", "index_name": "app-logs", "retention_days": float64(14)},
+			},
+		},
+		{
+			name:   "External OpenSearch destination",
+			args:   map[string]any{"Name": "ext", "Type": "opensearch_ext", "Endpoint": "https://search.example.com:9200", "Username": "doadmin", "Password": "secret"},
+			status: http.StatusCreated,
+			expectBody: map[string]any{
+				"name": "ext",
+				"type": "opensearch_ext",
+				"config": map[string]any{
+					"endpoint":    "https://search.example.com:9200",
+					"credentials": map[string]any{"username": "doadmin", "password": "secret"},
+				},
+			},
+		},
+		{
+			name:        "Managed destination without cluster",
+			args:        map[string]any{"Name": "logs", "Type": "opensearch_dbaas"},
+			expectError: true,
+		},
+		{
+			name:        "External destination without https endpoint",
+			args:        map[string]any{"Name": "ext", "Type": "opensearch_ext", "Endpoint": "search.example.com"},
+			expectError: true,
+		},
+		{
+			name:        "Unknown type",
+			args:        map[string]any{"Name": "logs", "Type": "s3"},
+			expectError: true,
+		},
+		{
+			name:        "API error",
+			args:        map[string]any{"Name": "logs", "Type": "opensearch_dbaas", "ClusterUUID": "os-1"},
+			status:      http.StatusUnprocessableEntity,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response := map[string]any{"destination": map[string]any{"id": "dest-1", "name": tc.args["Name"], "type": tc.args["Type"]}}
+			tool, requests := setupSinkToolWithServer(t, tc.status, response)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.createDestination(context.Background(), req)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			require.Len(t, *requests, 1)
+			require.Equal(t, http.MethodPost, (*requests)[0].method)
+			require.Equal(t, "/v2/monitoring/sinks/destinations", (*requests)[0].path)
+			require.Equal(t, tc.expectBody, (*requests)[0].body)
+			var out Destination
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &out))
+			require.Equal(t, "dest-1", out.ID)
+		})
+	}
+}
+
+func TestSinkTool_createSink(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        map[string]any
+		expectError bool
+	}{
+		{
+			name: "Forwards the logs of a cluster",
+			args: map[string]any{"DestinationID": "dest-1", "ResourceURNs": []any{"do:dbaas:db-1"}},
+		},
+		{
+			name:        "Invalid resource URN",
+			args:        map[string]any{"DestinationID": "dest-1", "ResourceURNs": []any{"db-1"}},
+			expectError: true,
+		},
+		{
+			name:        "No resources",
+			args:        map[string]any{"DestinationID": "dest-1", "ResourceURNs": []any{}},
+			expectError: true,
+		},
+		{
+			name:        "Missing destination",
+			args:        map[string]any{"ResourceURNs": []any{"do:dbaas:db-1"}},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tool, requests := setupSinkToolWithServer(t, http.StatusAccepted, nil)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.createSink(context.Background(), req)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				require.Empty(t, *requests)
+				return
+			}
+			require.False(t, resp.IsError)
+			require.Len(t, *requests, 1)
+			require.Equal(t, "/v2/monitoring/sinks", (*requests)[0].path)
+			require.Equal(t, map[string]any{
+				"destination_uuid": "dest-1",
+				"resources":        []any{map[string]any{"urn": "do:dbaas:db-1"}},
+			}, (*requests)[0].body)
+		})
+	}
+}
+
+func TestSinkTool_listSinks(t *testing.T) {
+	tool, requests := setupSinkToolWithServer(t, http.StatusOK, map[string]any{
+		"sinks": []any{map[string]any{
+			"destination": map[string]any{"id": "dest-1", "name": "logs", "type": "opensearch_dbaas"},
+			"resources":   []any{map[string]any{"urn": "do:dbaas:db-1", "name": "db"}},
+		}},
+	})
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"ResourceURN": "do:dbaas:db-1"}}}
+	resp, err := tool.listSinks(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Equal(t, "resource_id=do%3Adbaas%3Adb-1", (*requests)[0].query)
+
+	var out []Sink
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &out))
+	require.Len(t, out, 1)
+	require.Equal(t, "dest-1", out[0].Destination.ID)
+	require.Equal(t, []SinkResource{{URN: "do:dbaas:db-1", Name: "db"}}, out[0].Resources)
+}
+
+func TestSinkTool_deleteSink(t *testing.T) {
+	tool, requests := setupSinkToolWithServer(t, http.StatusNoContent, nil)
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"SinkID": "sink-1"}}}
+	resp, err := tool.deleteSink(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Equal(t, http.MethodDelete, (*requests)[0].method)
+	require.Equal(t, "/v2/monitoring/sinks/sink-1", (*requests)[0].path)
+}
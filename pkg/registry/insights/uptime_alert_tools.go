@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -95,14 +97,18 @@ func (u *UptimeCheckAlertTool) createUptimeCheckAlert(ctx context.Context, req m
 	if !ok || checkID == "" {
 		return mcp.NewToolResultError("Uptime CheckID is required"), nil
 	}
-	name := req.GetArguments()["Name"].(string)
-	alertType := req.GetArguments()["Type"].(string)
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	alertType := args.RequiredString("Type")
 	var threshold int
 	if vArg, ok := req.GetArguments()["Threshold"].(float64); ok && int(vArg) > 0 {
 		threshold = int(vArg)
 	}
-	period := req.GetArguments()["Period"].(string)
-	comparison := req.GetArguments()["Comparison"].(string)
+	period := args.RequiredString("Period")
+	comparison := args.RequiredString("Comparison")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 	emailsRaw, ok := req.GetArguments()["Emails"]
 	var emails []string
 	if ok && emailsRaw != nil {
@@ -166,14 +172,18 @@ func (u *UptimeCheckAlertTool) updateUptimeCheckAlert(ctx context.Context, req m
 		return mcp.NewToolResultError("UptimeCheck AlertID is required"), nil
 	}
 
-	name := req.GetArguments()["Name"].(string)
-	alertType := req.GetArguments()["Type"].(string)
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	alertType := args.RequiredString("Type")
 	var threshold int
 	if vArg, ok := req.GetArguments()["Threshold"].(float64); ok && int(vArg) > 0 {
 		threshold = int(vArg)
 	}
-	period := req.GetArguments()["Period"].(string)
-	comparison := req.GetArguments()["Comparison"].(string)
+	period := args.RequiredString("Period")
+	comparison := args.RequiredString("Comparison")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 	emailsRaw, ok := req.GetArguments()["Emails"]
 	var emails []string
 	if ok && emailsRaw != nil {
@@ -266,8 +276,8 @@ func (c *UptimeCheckAlertTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool("uptimecheck-alert-list",
 				mcp.WithDescription("List UptimeChecks Alerts with pagination"),
 				mcp.WithString("CheckID", mcp.Required(), mcp.Description("A unique identifier for a check")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultAlertsPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultAlertsPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultAlertsPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultAlertsPageSize), mcp.Description("Items per page")),
 			),
 		},
 		{
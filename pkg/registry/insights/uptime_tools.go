@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -106,9 +108,10 @@ func (c *UptimeTool) listUptimeChecks(ctx context.Context, req mcp.CallToolReque
 
 // createUptimeCheck creates a new UptimeCheck
 func (c *UptimeTool) createUptimeCheck(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	name := req.GetArguments()["Name"].(string)
-	checkType := req.GetArguments()["Type"].(string)
-	target := req.GetArguments()["Target"].(string)
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	checkType := args.RequiredString("Type")
+	target := args.RequiredString("Target")
 
 	rawRegions, _ := req.GetArguments()["Regions"]
 	var regions []string
@@ -120,7 +123,10 @@ func (c *UptimeTool) createUptimeCheck(ctx context.Context, req mcp.CallToolRequ
 		}
 	}
 
-	enabled := req.GetArguments()["Enabled"].(bool)
+	enabled := args.RequiredBool("Enabled")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	createRequest := &godo.CreateUptimeCheckRequest{
 		Name:    name,
@@ -156,10 +162,14 @@ func (c *UptimeTool) updateUptimeCheck(ctx context.Context, req mcp.CallToolRequ
 		return mcp.NewToolResultError("UptimeCheck ID is required"), nil
 	}
 
-	name := req.GetArguments()["Name"].(string)
-	checkType := req.GetArguments()["Type"].(string)
-	target := req.GetArguments()["Target"].(string)
-	enabled := req.GetArguments()["Enabled"].(bool)
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	checkType := args.RequiredString("Type")
+	target := args.RequiredString("Target")
+	enabled := args.RequiredBool("Enabled")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	rawRegions, _ := req.GetArguments()["Regions"]
 	var regions []string
@@ -238,8 +248,8 @@ func (c *UptimeTool) Tools() []server.ServerTool {
 			Handler: c.listUptimeChecks,
 			Tool: mcp.NewTool("uptimecheck-list",
 				mcp.WithDescription("List UptimeChecks with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultChecksPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultChecksPageSize), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultChecksPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultChecksPageSize), mcp.Description("Items per page")),
 			),
 		},
 		{
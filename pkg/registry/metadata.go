@@ -0,0 +1,262 @@
+package registry
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// CategoryMetaKey is the _meta field of a tool naming the category it is listed under, e.g. Compute.
+const CategoryMetaKey = "digitalocean.com/category"
+
+// DefaultCategory is the category of tools that belong to no service, such as do-capabilities.
+const DefaultCategory = "General"
+
+// serviceCategories groups the services into the categories clients list their tools under.
+var serviceCategories = map[string]string{
+	"apps":                   "Compute",
+	"droplets":               "Compute",
+	"doks":                   "Compute",
+	"functions":              "Compute",
+	"docr":                   "Compute",
+	"marketplace":            "Compute",
+	"networking":             "Networking",
+	"spaces":                 "Storage",
+	"volumes":                "Storage",
+	"nfs":                    "Storage",
+	"snapshots":              "Storage",
+	"databases":              "Databases",
+	"genai-evaluation":       "AI",
+	"genai-custom-models":    "AI",
+	"genai-batchinference":   "AI",
+	"dedicated-inference":    "AI",
+	"inference-modelcatalog": "AI",
+	"insights":               "Observability",
+	"accounts":               "Account",
+	"plans":                  "Account",
+	"docs":                   DefaultCategory,
+}
+
+// Category returns the category of the tool with the given name, from the service that registered it.
+func (c Catalog) Category(name string) string {
+	if category, ok := serviceCategories[c[name]]; ok {
+		return category
+	}
+	return DefaultCategory
+}
+
+// titles are the titles of the tools whose names don't read as one, e.g. because the verb is
+// implied or the resource is named after it.
+var titles = map[string]string{
+	"1-click-list":                       "List 1-Click Apps",
+	"apps-create-app-from-spec":          "Create App from Spec",
+	"billing-history-list":               "List Billing History",
+	"change-backup-policy-droplet":       "Change Droplet Backup Policy",
+	"change-kernel-droplet":              "Change Droplet Kernel",
+	"confirm-destructive":                "Confirm Destructive Call",
+	"disable-backups-droplet":            "Disable Droplet Backups",
+	"disable-backups-droplets-tag":       "Disable Backups on Droplets by Tag",
+	"docr-docker-credentials":            "Get Container Registry Docker Credentials",
+	"docr-options":                       "Get Container Registry Options",
+	"doks-bootstrap":                     "Bootstrap Kubernetes Cluster",
+	"doks-upgrade-options":               "Get Kubernetes Upgrade Options",
+	"droplet-action":                     "Get Droplet Action",
+	"droplet-backup-policy":              "Get Droplet Backup Policy",
+	"droplet-create-multi-region":        "Create Droplets in Multiple Regions",
+	"droplet-exec":                       "Run Command on Droplet",
+	"droplet-kernels":                    "List Droplet Kernels",
+	"droplet-wait":                       "Wait for Droplet",
+	"enable-backups-droplet":             "Enable Droplet Backups",
+	"enable-backups-droplets-tag":        "Enable Backups on Droplets by Tag",
+	"enable-ipv6-droplet":                "Enable Droplet IPv6",
+	"enable-ipv6-droplets-tag":           "Enable IPv6 on Droplets by Tag",
+	"enable-private-net-droplets-tag":    "Enable Private Networking on Droplets by Tag",
+	"functions-create-or-update-action":  "Create or Update Functions Action",
+	"functions-create-or-update-package": "Create or Update Functions Package",
+	"genai-models-unified-search":        "Search GenAI Models",
+	"image-action-convert":               "Convert Image to Snapshot",
+	"image-action-transfer":              "Transfer Image to Region",
+	"inventory-list":                     "List Resource Inventory",
+	"key-create":                         "Create SSH Key",
+	"key-delete":                         "Delete SSH Key",
+	"key-get":                            "Get SSH Key",
+	"key-list":                           "List SSH Keys",
+	"lb-purge":                           "Remove All Droplets from Load Balancer",
+	"reserved-ip-reserve":                "Reserve IP",
+	"resource-search":                    "Search Resources",
+	"size-list":                          "List Droplet Sizes",
+	"snapshot-prune":                     "Prune Snapshots",
+	"spaces-acl-set":                     "Set Spaces ACL",
+	"spaces-presign-url":                 "Create Spaces Presigned URL",
+	"tag-apply-bulk":                     "Apply Tags in Bulk",
+}
+
+// titleWords are how name segments are written in titles, for abbreviations and names.
+var titleWords = map[string]string{
+	"1click":      "1-Click",
+	"acl":         "ACL",
+	"apps":        "App",
+	"byoip":       "BYOIP",
+	"ca":          "CA",
+	"cdn":         "CDN",
+	"cert":        "Certificate",
+	"cleanup":     "Clean Up",
+	"cors":        "CORS",
+	"db":          "Database",
+	"do":          "DigitalOcean",
+	"docr":        "Container Registry",
+	"doks":        "Kubernetes",
+	"eval":        "Evaluation",
+	"fwd":         "Forwarding",
+	"genai":       "GenAI",
+	"gpu":         "GPU",
+	"gpus":        "GPUs",
+	"ip":          "IP",
+	"ipv6":        "IPv6",
+	"lb":          "Load Balancer",
+	"lets":        "Let's",
+	"mongodb":     "MongoDB",
+	"mysql":       "MySQL",
+	"net":         "Networking",
+	"nfs":         "NFS",
+	"nodepool":    "Node Pool",
+	"nodepools":   "Node Pools",
+	"opensearch":  "OpenSearch",
+	"os":          "OpenSearch",
+	"postgresql":  "PostgreSQL",
+	"psql":        "PostgreSQL",
+	"ptr":         "PTR",
+	"shutdown":    "Shut Down",
+	"sql":         "SQL",
+	"uptimecheck": "Uptime Check",
+	"url":         "URL",
+	"urn":         "URN",
+	"userdata":    "User Data",
+	"vpc":         "VPC",
+}
+
+// smallWords stay lowercase in titles.
+var smallWords = []string{"and", "by", "for", "from", "in", "of", "or", "to"}
+
+// readTitleVerbs are the verbs a title starts with before any other verb of the name, and
+// titleVerbs the others. The verbs of nounVerbs also name things, e.g. in snapshot-delete, so
+// another verb of the name is picked first.
+var (
+	readTitleVerbs = []string{"get", "list"}
+	titleVerbs     = []string{
+		"add", "apply", "assign", "attach", "cancel", "change", "cleanup", "clone", "convert", "create", "delete", "deploy",
+		"destroy", "detach", "disable", "edit", "enable", "estimate", "find", "flush", "follow", "import", "install", "invoke",
+		"migrate", "promote", "provision", "prune", "purge", "reassign", "recommend", "recycle", "release", "remove", "rename",
+		"render", "reserve", "reset", "resize", "resolve", "restore", "run", "search", "set", "show", "snapshot", "start",
+		"stop", "switch", "sync", "transfer", "unassign", "update", "upgrade", "upload", "use", "validate", "verify",
+	}
+	nounVerbs = []string{"run", "snapshot"}
+)
+
+// Title returns the human-readable title of the tool with the given name: the verb of the name
+// first, then what it acts on, e.g. "Create Database Cluster Topic" for db-cluster-create-topic.
+func Title(name string) string {
+	if title, ok := titles[name]; ok {
+		return title
+	}
+	segments := strings.Split(strings.ReplaceAll(name, "1-click", "1click"), "-")
+
+	if i := titleVerb(segments); i > 0 {
+		verb, subject, object := segments[i], segments[:i], segments[i+1:]
+		switch {
+		case verb == "add" && len(object) > 0:
+			segments = slices.Concat([]string{verb}, object, []string{"to"}, subject)
+		case verb == "remove" && len(object) > 0:
+			segments = slices.Concat([]string{verb}, object, []string{"from"}, subject)
+		default:
+			segments = slices.Concat([]string{verb}, subject, object)
+		}
+		if verb == "list" && len(object) == 0 {
+			// "List Database Clusters" for db-cluster-list.
+			last := len(segments) - 1
+			segments[last] = plural(titleWord(segments[last]))
+		}
+	}
+	if n := len(segments); n >= 2 && segments[n-2] == "droplets" && segments[n-1] == "tag" {
+		segments = slices.Insert(segments, n-1, "by")
+	}
+
+	words := make([]string, len(segments))
+	for i, segment := range segments {
+		if i > 0 && slices.Contains(smallWords, segment) {
+			words[i] = segment
+			continue
+		}
+		words[i] = titleWord(segment)
+	}
+	return strings.Join(words, " ")
+}
+
+// titleVerb returns the index of the verb a title starts with: the first read verb, or else the
+// last other verb, preferring those that don't also name things. It is -1 for names without verbs.
+func titleVerb(segments []string) int {
+	if i := slices.IndexFunc(segments, func(s string) bool { return slices.Contains(readTitleVerbs, s) }); i >= 0 {
+		return i
+	}
+	verb := -1
+	for i, segment := range segments {
+		if !slices.Contains(titleVerbs, segment) {
+			continue
+		}
+		if verb < 0 || !slices.Contains(nounVerbs, segment) || slices.Contains(nounVerbs, segments[verb]) {
+			verb = i
+		}
+	}
+	return verb
+}
+
+// titleWord writes one name segment as a title word.
+func titleWord(segment string) string {
+	if word, ok := titleWords[segment]; ok {
+		return word
+	}
+	if segment == "" || strings.ToUpper(segment[:1]) == segment[:1] {
+		return segment
+	}
+	return strings.ToUpper(segment[:1]) + segment[1:]
+}
+
+// plural returns the plural of a title word.
+func plural(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ss"):
+		return word + "es"
+	case strings.HasSuffix(word, "s"):
+		return word
+	case strings.HasSuffix(word, "y") && !strings.HasSuffix(word, "ey"):
+		return strings.TrimSuffix(word, "y") + "ies"
+	case strings.HasSuffix(word, "ch"), strings.HasSuffix(word, "sh"), strings.HasSuffix(word, "x"):
+		return word + "es"
+	}
+	return word + "s"
+}
+
+// Describe gives every tool registered with s a title, unless it has one, and the category of
+// the service that registered it in the CategoryMetaKey field of its _meta, so clients can show
+// a large catalog grouped by category.
+func Describe(s *server.MCPServer, catalog Catalog) {
+	var updated []server.ServerTool
+	for name, st := range s.ListTools() {
+		tool := st.Tool
+		if tool.Annotations.Title == "" {
+			tool.Annotations.Title = Title(name)
+		}
+		meta := &mcp.Meta{AdditionalFields: map[string]any{}}
+		if tool.Meta != nil {
+			meta.ProgressToken = tool.Meta.ProgressToken
+			maps.Copy(meta.AdditionalFields, tool.Meta.AdditionalFields)
+		}
+		meta.AdditionalFields[CategoryMetaKey] = catalog.Category(name)
+		tool.Meta = meta
+		updated = append(updated, server.ServerTool{Tool: tool, Handler: st.Handler})
+	}
+	s.AddTools(updated...)
+}
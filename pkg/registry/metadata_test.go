@@ -0,0 +1,75 @@
+package registry
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTitle(t *testing.T) {
+	for name, want := range map[string]string{
+		"droplet-list":                    "List Droplets",
+		"droplet-get":                     "Get Droplet",
+		"db-cluster-create-topic":         "Create Database Cluster Topic",
+		"db-cluster-list":                 "List Database Clusters",
+		"db-cluster-list-users":           "List Database Cluster Users",
+		"alert-policy-list":               "List Alert Policies",
+		"byoip-address-list":              "List BYOIP Addresses",
+		"lb-add-fwd-rules":                "Add Forwarding Rules to Load Balancer",
+		"firewall-remove-droplets":        "Remove Droplets from Firewall",
+		"snapshot-delete":                 "Delete Snapshot",
+		"snapshot-droplet":                "Snapshot Droplet",
+		"genai-model-eval-delete-run":     "Delete GenAI Model Evaluation Run",
+		"power-off-droplets-tag":          "Power Off Droplets by Tag",
+		"1-click-kubernetes-app-install":  "Install 1-Click Kubernetes App",
+		"cert-provision-for-domain":       "Provision Certificate for Domain",
+		"lets-encrypt-certificate-create": "Create Let's Encrypt Certificate",
+		"cleanup-by-tag":                  "Clean Up by Tag",
+		"droplet-history":                 "Droplet History",
+		"droplet-wait":                    "Wait for Droplet",
+	} {
+		require.Equal(t, want, Title(name), name)
+	}
+}
+
+func TestCatalog_Category(t *testing.T) {
+	// every service is listed under a category of its own.
+	for svc := range supportedServices {
+		require.Contains(t, serviceCategories, svc)
+	}
+	catalog := Catalog{"droplet-get": "droplets", "lb-get": "networking", "region-list": "common"}
+	require.Equal(t, "Compute", catalog.Category("droplet-get"))
+	require.Equal(t, "Networking", catalog.Category("lb-get"))
+	require.Equal(t, DefaultCategory, catalog.Category("region-list"))
+	require.Equal(t, DefaultCategory, catalog.Category("do-capabilities"))
+}
+
+func TestDescribe(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := server.NewMCPServer("test", "0.0.0")
+	getClient := func(ctx context.Context) (*godo.Client, error) { return godo.NewClient(nil), nil }
+	catalog, err := RegisterWithCatalog(logger, s, getClient, "droplets", "insights")
+	require.NoError(t, err)
+	noop := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) { return nil, nil }
+	s.AddTool(mcp.NewTool("server-version", mcp.WithTitleAnnotation("Version of this server")), noop)
+
+	Describe(s, catalog)
+
+	for name, st := range s.ListTools() {
+		require.NotEmpty(t, st.Tool.Annotations.Title, name)
+		require.NotNil(t, st.Tool.Meta, name)
+		require.NotEmpty(t, st.Tool.Meta.AdditionalFields[CategoryMetaKey], name)
+	}
+	tools := s.ListTools()
+	require.Equal(t, "Delete Droplet", tools["droplet-delete"].Tool.Annotations.Title)
+	require.Equal(t, "Compute", tools["droplet-delete"].Tool.Meta.AdditionalFields[CategoryMetaKey])
+	require.Equal(t, "Observability", tools["uptimecheck-list"].Tool.Meta.AdditionalFields[CategoryMetaKey])
+	require.Equal(t, "Version of this server", tools["server-version"].Tool.Annotations.Title)
+	require.Equal(t, DefaultCategory, tools["server-version"].Tool.Meta.AdditionalFields[CategoryMetaKey])
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
+
+	"mcp-digitalocean/pkg/registry/common"
 
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -75,19 +78,22 @@ func (t *BYOIPPrefixTool) listBYOIPPrefix(ctx context.Context, req mcp.CallToolR
 
 // createBYOIPPrefix creates a new BYOIP prefix for a user
 func (t *BYOIPPrefixTool) createBYOIPPrefix(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	prefix, ok := req.GetArguments()["prefix"].(string)
+	prefix, ok := req.GetArguments()["Prefix"].(string)
 	if !ok || prefix == "" {
-		return mcp.NewToolResultError("prefix is required"), nil
+		return mcp.NewToolResultError("Prefix is required"), nil
+	}
+	if _, _, err := net.ParseCIDR(prefix); err != nil {
+		return mcp.NewToolResultError("Prefix must be a CIDR (e.g., 192.0.2.0/24)"), nil
 	}
 
-	signature, ok := req.GetArguments()["signature"].(string)
+	signature, ok := req.GetArguments()["Signature"].(string)
 	if !ok || signature == "" {
-		return mcp.NewToolResultError("signature is required"), nil
+		return mcp.NewToolResultError("Signature is required"), nil
 	}
 
-	region, ok := req.GetArguments()["region"].(string)
+	region, ok := req.GetArguments()["Region"].(string)
 	if !ok || region == "" {
-		return mcp.NewToolResultError("region is required"), nil
+		return mcp.NewToolResultError("Region is required"), nil
 	}
 
 	client, err := t.client(ctx)
@@ -147,6 +153,66 @@ func (t *BYOIPPrefixTool) getByOIPPrefixResources(ctx context.Context, req mcp.C
 	return mcp.NewToolResultText(string(jsonData)), nil
 }
 
+// BYOIPAddressList is the result of byoip-address-list.
+type BYOIPAddressList struct {
+	UUID           string                     `json:"uuid"`
+	Prefix         string                     `json:"prefix"`
+	Region         string                     `json:"region"`
+	Status         string                     `json:"status"`
+	Advertised     bool                       `json:"advertised"`
+	TotalAddresses uint64                     `json:"total_addresses,omitempty"`
+	AssignedCount  int                        `json:"assigned_count"`
+	Addresses      []godo.BYOIPPrefixResource `json:"addresses"`
+}
+
+// listBYOIPAddresses lists every address of a BYOIP prefix that is assigned to a resource,
+// following all pages, with the size of the prefix so the free addresses are known too
+func (t *BYOIPPrefixTool) listBYOIPAddresses(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	prefixUUID, ok := req.GetArguments()["UUID"].(string)
+	if !ok || prefixUUID == "" {
+		return mcp.NewToolResultError("UUID is required"), nil
+	}
+
+	client, err := t.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	byoipPrefix, _, err := client.BYOIPPrefixes.Get(ctx, prefixUUID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	addresses, err := common.ListAll(ctx, 200, func(ctx context.Context, opt *godo.ListOptions) ([]godo.BYOIPPrefixResource, *godo.Response, error) {
+		return client.BYOIPPrefixes.GetResources(ctx, prefixUUID, opt)
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	result := BYOIPAddressList{
+		UUID:          byoipPrefix.UUID,
+		Prefix:        byoipPrefix.Prefix,
+		Region:        byoipPrefix.Region,
+		Status:        byoipPrefix.Status,
+		Advertised:    byoipPrefix.Advertised,
+		AssignedCount: len(addresses),
+		Addresses:     addresses,
+	}
+	if _, ipNet, err := net.ParseCIDR(byoipPrefix.Prefix); err == nil {
+		ones, bits := ipNet.Mask.Size()
+		if bits-ones < 64 {
+			result.TotalAddresses = uint64(1) << (bits - ones)
+		}
+	}
+
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
 // deleteBYOIPPrefix deletes BYOIP prefix by UUID
 func (t *BYOIPPrefixTool) deleteBYOIPPrefix(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	prefiUUID, ok := req.GetArguments()["UUID"].(string)
@@ -181,8 +247,8 @@ func (t *BYOIPPrefixTool) Tools() []server.ServerTool {
 			Handler: t.listBYOIPPrefix,
 			Tool: mcp.NewTool("byoip-prefix-list",
 				mcp.WithDescription("List BYOIP prefixes"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Number of items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Number of items per page")),
 			),
 		},
 		{
@@ -190,15 +256,22 @@ func (t *BYOIPPrefixTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool("byoip-prefix-resources-get",
 				mcp.WithDescription("Get all resources for a BYOIP prefix"),
 				mcp.WithString("UUID", mcp.Required(), mcp.Description("The UUID of the BYOIP prefix")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Number of items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Number of items per page")),
+			),
+		},
+		{
+			Handler: t.listBYOIPAddresses,
+			Tool: mcp.NewTool("byoip-address-list",
+				mcp.WithDescription("List all addresses of a BYOIP prefix that are assigned to resources, with the number of addresses in the prefix"),
+				mcp.WithString("UUID", mcp.Required(), mcp.Description("The UUID of the BYOIP prefix")),
 			),
 		},
 		{
 			Handler: t.createBYOIPPrefix,
 			Tool: mcp.NewTool("byoip-prefix-create",
-				mcp.WithDescription("Create a new BYOIP prefix"),
-				mcp.WithString("Prefix", mcp.Required(), mcp.Description("The CIDR of the BYOIP prefix")),
+				mcp.WithDescription("Create a new BYOIP prefix. The signature proves ownership of the prefix; the prefix is validated before it can be advertised."),
+				mcp.WithString("Prefix", mcp.Required(), mcp.Description("The CIDR of the BYOIP prefix (e.g., 192.0.2.0/24)")),
 				mcp.WithString("Signature", mcp.Required(), mcp.Description("The signature for the prefix")),
 				mcp.WithString("Region", mcp.Required(), mcp.Description("The region for the prefix")),
 			),
@@ -206,6 +279,7 @@ func (t *BYOIPPrefixTool) Tools() []server.ServerTool {
 		{
 			Handler: t.deleteBYOIPPrefix,
 			Tool: mcp.NewTool("byoip-prefix-delete",
+				mcp.WithDestructiveHintAnnotation(true),
 				mcp.WithDescription("Delete a BYOIP prefix"),
 				mcp.WithString("UUID", mcp.Required(), mcp.Description("The UUID of the BYOIP prefix")),
 			),
@@ -209,9 +209,9 @@ func TestBYOIPPrefixTool_createBYOIPPrefix(t *testing.T) {
 		{
 			name: "Create BYOIP prefix success",
 			args: map[string]any{
-				"prefix":    "192.0.2.0/24",
-				"signature": "test-signature-abc123",
-				"region":    "nyc3",
+				"Prefix":    "192.0.2.0/24",
+				"Signature": "test-signature-abc123",
+				"Region":    "nyc3",
 			},
 			mockSetup: func(m *MockBYOIPPrefixesService) {
 				m.EXPECT().
@@ -228,8 +228,8 @@ func TestBYOIPPrefixTool_createBYOIPPrefix(t *testing.T) {
 		{
 			name: "Missing prefix argument",
 			args: map[string]any{
-				"signature": "test-signature-abc123",
-				"region":    "nyc3",
+				"Signature": "test-signature-abc123",
+				"Region":    "nyc3",
 			},
 			mockSetup:   func(m *MockBYOIPPrefixesService) {},
 			expectError: true,
@@ -237,8 +237,18 @@ func TestBYOIPPrefixTool_createBYOIPPrefix(t *testing.T) {
 		{
 			name: "Missing signature argument",
 			args: map[string]any{
-				"prefix": "192.0.2.0/24",
-				"region": "nyc3",
+				"Prefix": "192.0.2.0/24",
+				"Region": "nyc3",
+			},
+			mockSetup:   func(m *MockBYOIPPrefixesService) {},
+			expectError: true,
+		},
+		{
+			name: "Prefix is not a CIDR",
+			args: map[string]any{
+				"Prefix":    "192.0.2.1",
+				"Signature": "test-signature-abc123",
+				"Region":    "nyc3",
 			},
 			mockSetup:   func(m *MockBYOIPPrefixesService) {},
 			expectError: true,
@@ -246,8 +256,8 @@ func TestBYOIPPrefixTool_createBYOIPPrefix(t *testing.T) {
 		{
 			name: "Missing region argument",
 			args: map[string]any{
-				"prefix":    "192.0.2.0/24",
-				"signature": "test-signature-abc123",
+				"Prefix":    "192.0.2.0/24",
+				"Signature": "test-signature-abc123",
 			},
 			mockSetup:   func(m *MockBYOIPPrefixesService) {},
 			expectError: true,
@@ -255,9 +265,9 @@ func TestBYOIPPrefixTool_createBYOIPPrefix(t *testing.T) {
 		{
 			name: "API error",
 			args: map[string]any{
-				"prefix":    "192.0.2.0/24",
-				"signature": "test-signature-abc123",
-				"region":    "nyc3",
+				"Prefix":    "192.0.2.0/24",
+				"Signature": "test-signature-abc123",
+				"Region":    "nyc3",
 			},
 			mockSetup: func(m *MockBYOIPPrefixesService) {
 				m.EXPECT().
@@ -452,3 +462,84 @@ func TestBYOIPPrefixTool_deleteBYOIPPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestBYOIPPrefixTool_listBYOIPAddresses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testPrefix := &godo.BYOIPPrefix{UUID: "prefix-uuid", Prefix: "5.42.203.0/24", Region: "syd1", Status: "active", Advertised: true}
+	page1 := []godo.BYOIPPrefixResource{{ID: 3, BYOIP: "5.42.203.4", Resource: "do:droplet:1", Region: "syd1"}}
+	page2 := []godo.BYOIPPrefixResource{{ID: 4, BYOIP: "5.42.203.5", Resource: "do:droplet:2", Region: "syd1"}}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		mockSetup     func(*MockBYOIPPrefixesService)
+		expectError   bool
+		expectedCount int
+	}{
+		{
+			name: "Follows all pages",
+			args: map[string]any{"UUID": "prefix-uuid"},
+			mockSetup: func(m *MockBYOIPPrefixesService) {
+				m.EXPECT().Get(gomock.Any(), "prefix-uuid").Return(testPrefix, nil, nil).Times(1)
+				m.EXPECT().
+					GetResources(gomock.Any(), "prefix-uuid", &godo.ListOptions{Page: 1, PerPage: 200}).
+					Return(page1, &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Next: "https://api.digitalocean.com/v2/byoip_prefixes/prefix-uuid/ips?page=2", Last: "https://api.digitalocean.com/v2/byoip_prefixes/prefix-uuid/ips?page=2"}}}, nil).
+					Times(1)
+				m.EXPECT().
+					GetResources(gomock.Any(), "prefix-uuid", &godo.ListOptions{Page: 2, PerPage: 200}).
+					Return(page2, &godo.Response{Links: &godo.Links{}}, nil).
+					Times(1)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "No assigned addresses",
+			args: map[string]any{"UUID": "prefix-uuid"},
+			mockSetup: func(m *MockBYOIPPrefixesService) {
+				m.EXPECT().Get(gomock.Any(), "prefix-uuid").Return(testPrefix, nil, nil).Times(1)
+				m.EXPECT().GetResources(gomock.Any(), "prefix-uuid", gomock.Any()).Return(nil, nil, nil).Times(1)
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "API error",
+			args: map[string]any{"UUID": "prefix-uuid"},
+			mockSetup: func(m *MockBYOIPPrefixesService) {
+				m.EXPECT().Get(gomock.Any(), "prefix-uuid").Return(nil, nil, errors.New("api error")).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing UUID argument",
+			args:        map[string]any{},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockBYOIP := NewMockBYOIPPrefixesService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockBYOIP)
+			}
+			tool := setupBYOIPPrefixToolWithMocks(mockBYOIP)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.listBYOIPAddresses(context.Background(), req)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			var out BYOIPAddressList
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &out))
+			require.Equal(t, "5.42.203.0/24", out.Prefix)
+			require.Equal(t, uint64(256), out.TotalAddresses)
+			require.Equal(t, tc.expectedCount, out.AssignedCount)
+			require.Len(t, out.Addresses, tc.expectedCount)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultCertTimeout = 900
+	defaultCertPoll    = 10 * time.Second
+	certPageSize       = 200
+)
+
+// CertProvisionResult is the outcome of cert-provision-for-domain.
+type CertProvisionResult struct {
+	Domain      string            `json:"domain"`
+	Certificate *godo.Certificate `json:"certificate"`
+	// Reused reports whether a Let's Encrypt certificate of the same DNS names already existed and
+	// was used instead of requesting another.
+	Reused         bool                 `json:"reused"`
+	LoadBalancerID string               `json:"load_balancer_id,omitempty"`
+	ForwardingRule *godo.ForwardingRule `json:"forwarding_rule,omitempty"`
+	Message        string               `json:"message"`
+}
+
+// CertProvisionTool provides a tool that provisions a Let's Encrypt certificate for a domain on
+// DigitalOcean DNS.
+type CertProvisionTool struct {
+	client       func(ctx context.Context) (*godo.Client, error)
+	pollInterval time.Duration
+}
+
+// NewCertProvisionTool creates a new cert provision tool
+func NewCertProvisionTool(client func(ctx context.Context) (*godo.Client, error)) *CertProvisionTool {
+	return &CertProvisionTool{client: client, pollInterval: defaultCertPoll}
+}
+
+// inDomain reports whether the DNS name, which may be a wildcard, is domain or one of its
+// subdomains.
+func inDomain(name, domain string) bool {
+	name = strings.TrimPrefix(name, "*.")
+	return name == domain || strings.HasSuffix(name, "."+domain)
+}
+
+// existingCertificate returns the Let's Encrypt certificate of exactly dnsNames that has not
+// failed, or nil when there is none.
+func existingCertificate(ctx context.Context, client *godo.Client, dnsNames []string) (*godo.Certificate, error) {
+	certificates, err := common.ListAll(ctx, certPageSize, client.Certificates.List)
+	if err != nil {
+		return nil, err
+	}
+	want := slices.Sorted(slices.Values(dnsNames))
+	for _, c := range certificates {
+		if c.Type == "lets_encrypt" && c.State != "error" && slices.Equal(slices.Sorted(slices.Values(c.DNSNames)), want) {
+			return &c, nil
+		}
+	}
+	return nil, nil
+}
+
+// findLoadBalancer returns the load balancer with the ID or the name nameOrID.
+func findLoadBalancer(ctx context.Context, client *godo.Client, nameOrID string) (*godo.LoadBalancer, error) {
+	loadBalancers, err := common.ListAll(ctx, certPageSize, client.LoadBalancers.List)
+	if err != nil {
+		return nil, err
+	}
+	var named []godo.LoadBalancer
+	for _, lb := range loadBalancers {
+		if lb.ID == nameOrID {
+			return &lb, nil
+		}
+		if lb.Name == nameOrID {
+			named = append(named, lb)
+		}
+	}
+	switch len(named) {
+	case 0:
+		return nil, fmt.Errorf("no load balancer is named %q", nameOrID)
+	case 1:
+		return &named[0], nil
+	}
+	return nil, fmt.Errorf("%d load balancers are named %q; pass the ID instead", len(named), nameOrID)
+}
+
+// attachCertificate makes the HTTPS rule on port 443 of lb terminate TLS with certificateID. An
+// existing rule on that port is replaced; otherwise one is added forwarding to the target of the
+// HTTP rule on port 80, or to HTTP on port 80. It returns the rule.
+func attachCertificate(ctx context.Context, client *godo.Client, lb *godo.LoadBalancer, certificateID string) (*godo.ForwardingRule, error) {
+	rule := godo.ForwardingRule{EntryProtocol: "https", EntryPort: 443, TargetProtocol: "http", TargetPort: 80, CertificateID: certificateID}
+	var existing *godo.ForwardingRule
+	for _, r := range lb.ForwardingRules {
+		switch {
+		case r.EntryPort == 443:
+			existing = &r
+		case r.EntryPort == 80 && r.EntryProtocol == "http":
+			rule.TargetProtocol, rule.TargetPort = r.TargetProtocol, r.TargetPort
+		}
+	}
+	if existing != nil {
+		if existing.CertificateID == certificateID {
+			return existing, nil
+		}
+		rule.TargetProtocol, rule.TargetPort = existing.TargetProtocol, existing.TargetPort
+		if existing.EntryProtocol == "http2" || existing.EntryProtocol == "http3" {
+			rule.EntryProtocol = existing.EntryProtocol
+		}
+		// a rule is identified by its entry port, so the old rule goes before the new one is added.
+		if _, err := client.LoadBalancers.RemoveForwardingRules(ctx, lb.ID, *existing); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := client.LoadBalancers.AddForwardingRules(ctx, lb.ID, rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// provisionForDomain requests a Let's Encrypt certificate for names in a domain on DigitalOcean
+// DNS, waits for it to be issued and optionally attaches it to a load balancer.
+func (c *CertProvisionTool) provisionForDomain(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	domain := strings.ToLower(strings.TrimSuffix(args.RequiredString("Domain"), "."))
+	dnsNames := args.Strings("DnsNames")
+	name := args.String("Name")
+	lbName := args.String("LoadBalancer")
+	timeout := args.Number("TimeoutSeconds", defaultCertTimeout)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(dnsNames) == 0 {
+		dnsNames = []string{domain}
+	}
+	for _, dnsName := range dnsNames {
+		if !inDomain(dnsName, domain) {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: DNS name %s is not in the domain %s", dnsName, domain)), nil
+		}
+	}
+	if name == "" {
+		name = domain + "-letsencrypt"
+	}
+
+	client, err := c.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	// Let's Encrypt certificates are validated with DNS records DigitalOcean adds to the domain,
+	// so the domain has to be managed on DigitalOcean DNS.
+	if _, resp, err := client.Domains.Get(ctx, domain); err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return mcp.NewToolResultError(fmt.Sprintf("the domain %s is not on DigitalOcean DNS; add it with domain-create and point its NS records at ns1.digitalocean.com, ns2.digitalocean.com and ns3.digitalocean.com first", domain)), nil
+		}
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	var lb *godo.LoadBalancer
+	if lbName != "" {
+		if lb, err = findLoadBalancer(ctx, client, lbName); err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+	}
+
+	result := &CertProvisionResult{Domain: domain}
+	certificate, err := existingCertificate(ctx, client, dnsNames)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if certificate != nil {
+		result.Reused = true
+	} else if certificate, _, err = client.Certificates.Create(ctx, &godo.CertificateRequest{Name: name, DNSNames: dnsNames, Type: "lets_encrypt"}); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if certificate.State != "verified" {
+		waitCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout*float64(time.Second)))
+		defer cancel()
+		if certificate, err = common.WaitForCertificate(waitCtx, client, certificate.ID, c.pollInterval); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				err = fmt.Errorf("%w; call cert-provision-for-domain again to keep waiting", err)
+			}
+			return mcp.NewToolResultErrorFromErr("certificate not issued", err), nil
+		}
+	}
+	result.Certificate = certificate
+
+	if lb != nil {
+		if result.ForwardingRule, err = attachCertificate(ctx, client, lb, certificate.ID); err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("certificate %s was issued, but attaching it to load balancer %s failed", certificate.ID, lb.ID), err), nil
+		}
+		result.LoadBalancerID = lb.ID
+		result.Message = fmt.Sprintf("certificate %s for %s is issued and terminates HTTPS on port 443 of load balancer %s", certificate.ID, strings.Join(dnsNames, ", "), lb.Name)
+	} else {
+		result.Message = fmt.Sprintf("certificate %s for %s is issued", certificate.ID, strings.Join(dnsNames, ", "))
+	}
+
+	res, err := common.NewToolResultStructured(result)
+	if err != nil || !result.Reused {
+		return res, err
+	}
+	return common.ExistingResult(res, fmt.Sprintf("An existing Let's Encrypt certificate, %s, covers these DNS names, so no certificate was requested.", certificate.ID)), nil
+}
+
+// Tools returns the cert-provision-for-domain tool.
+func (c *CertProvisionTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: c.provisionForDomain,
+			Tool: mcp.NewTool("cert-provision-for-domain",
+				mcp.WithDescription("Provision a Let's Encrypt certificate for a domain on DigitalOcean DNS in one call: checks the domain is managed on DigitalOcean, requests the certificate, or reuses one covering the same DNS names, waits until it is issued and, with LoadBalancer, makes the load balancer terminate HTTPS on port 443 with it. Calling it again after a timeout resumes waiting."),
+				common.WithOutputSchema[CertProvisionResult](),
+				mcp.WithString("Domain", mcp.Required(), mcp.Description("Domain managed on DigitalOcean DNS, e.g. example.com")),
+				mcp.WithArray("DnsNames", mcp.Description("DNS names of the certificate within Domain, including wildcards, e.g. [\"example.com\", \"*.example.com\"]. Defaults to Domain"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithString("Name", mcp.Description("Name of the certificate. Defaults to <Domain>-letsencrypt")),
+				mcp.WithString("LoadBalancer", mcp.Description("ID or name of a load balancer to attach the certificate to")),
+				mcp.WithNumber("TimeoutSeconds", mcp.DefaultNumber(defaultCertTimeout), mcp.Description("How long to wait for the certificate to be issued")),
+			),
+		},
+	}
+}
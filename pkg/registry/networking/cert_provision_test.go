@@ -0,0 +1,121 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCertProvisionTool_provisionForDomain(t *testing.T) {
+	type mocks struct {
+		certs   *MockCertificatesService
+		domains *MockDomainsService
+		lbs     *MockLoadBalancersService
+	}
+	setup := func(t *testing.T) (*CertProvisionTool, mocks) {
+		ctrl := gomock.NewController(t)
+		m := mocks{NewMockCertificatesService(ctrl), NewMockDomainsService(ctrl), NewMockLoadBalancersService(ctrl)}
+		client := &godo.Client{Certificates: m.certs, Domains: m.domains, LoadBalancers: m.lbs}
+		tool := NewCertProvisionTool(func(ctx context.Context) (*godo.Client, error) { return client, nil })
+		tool.pollInterval = time.Millisecond
+		return tool, m
+	}
+	call := func(t *testing.T, tool *CertProvisionTool, args map[string]any) (*mcp.CallToolResult, *CertProvisionResult) {
+		t.Helper()
+		resp, err := tool.provisionForDomain(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		result, _ := resp.StructuredContent.(*CertProvisionResult)
+		return resp, result
+	}
+	onDNS := func(m mocks) {
+		m.domains.EXPECT().Get(gomock.Any(), "example.com").Return(&godo.Domain{Name: "example.com"}, nil, nil)
+	}
+
+	t.Run("Requests, waits and attaches", func(t *testing.T) {
+		tool, m := setup(t)
+		onDNS(m)
+		m.lbs.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.LoadBalancer{{
+			ID:              "lb-1",
+			Name:            "web",
+			ForwardingRules: []godo.ForwardingRule{{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 8080}},
+		}}, &godo.Response{}, nil)
+		m.certs.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Certificate{
+			{ID: "other", Type: "lets_encrypt", DNSNames: []string{"example.com"}, State: "verified"},
+		}, &godo.Response{}, nil)
+		m.certs.EXPECT().Create(gomock.Any(), &godo.CertificateRequest{
+			Name: "example.com-letsencrypt", DNSNames: []string{"example.com", "www.example.com"}, Type: "lets_encrypt",
+		}).Return(&godo.Certificate{ID: "cert-1", State: "pending"}, nil, nil)
+		gomock.InOrder(
+			m.certs.EXPECT().Get(gomock.Any(), "cert-1").Return(&godo.Certificate{ID: "cert-1", State: "pending"}, nil, nil),
+			m.certs.EXPECT().Get(gomock.Any(), "cert-1").Return(&godo.Certificate{ID: "cert-1", State: "verified"}, nil, nil),
+		)
+		m.lbs.EXPECT().AddForwardingRules(gomock.Any(), "lb-1", godo.ForwardingRule{
+			EntryProtocol: "https", EntryPort: 443, TargetProtocol: "http", TargetPort: 8080, CertificateID: "cert-1",
+		}).Return(nil, nil)
+
+		resp, result := call(t, tool, map[string]any{"Domain": "Example.com.", "DnsNames": []any{"example.com", "www.example.com"}, "LoadBalancer": "web"})
+		require.False(t, resp.IsError)
+		require.False(t, result.Reused)
+		require.Equal(t, "verified", result.Certificate.State)
+		require.Equal(t, "lb-1", result.LoadBalancerID)
+		require.Nil(t, resp.Meta)
+	})
+
+	t.Run("Reuses a certificate and replaces the HTTPS rule", func(t *testing.T) {
+		tool, m := setup(t)
+		onDNS(m)
+		existing := godo.ForwardingRule{EntryProtocol: "http2", EntryPort: 443, TargetProtocol: "http", TargetPort: 3000, CertificateID: "old"}
+		m.lbs.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.LoadBalancer{{ID: "lb-1", Name: "web", ForwardingRules: []godo.ForwardingRule{existing}}}, &godo.Response{}, nil)
+		m.certs.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Certificate{
+			{ID: "cert-1", Type: "lets_encrypt", DNSNames: []string{"example.com"}, State: "verified"},
+		}, &godo.Response{}, nil)
+		gomock.InOrder(
+			m.lbs.EXPECT().RemoveForwardingRules(gomock.Any(), "lb-1", existing).Return(nil, nil),
+			m.lbs.EXPECT().AddForwardingRules(gomock.Any(), "lb-1", godo.ForwardingRule{
+				EntryProtocol: "http2", EntryPort: 443, TargetProtocol: "http", TargetPort: 3000, CertificateID: "cert-1",
+			}).Return(nil, nil),
+		)
+
+		resp, result := call(t, tool, map[string]any{"Domain": "example.com", "LoadBalancer": "lb-1"})
+		require.False(t, resp.IsError)
+		require.True(t, result.Reused)
+		require.Equal(t, true, resp.Meta.AdditionalFields[common.ExistingMetaKey])
+	})
+
+	t.Run("Domain not on DigitalOcean DNS", func(t *testing.T) {
+		tool, m := setup(t)
+		m.domains.EXPECT().Get(gomock.Any(), "example.com").Return(nil, &godo.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errors.New("not found"))
+
+		resp, _ := call(t, tool, map[string]any{"Domain": "example.com"})
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "not on DigitalOcean DNS")
+	})
+
+	t.Run("Issuance fails", func(t *testing.T) {
+		tool, m := setup(t)
+		onDNS(m)
+		m.certs.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, &godo.Response{}, nil)
+		m.certs.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&godo.Certificate{ID: "cert-1", State: "pending"}, nil, nil)
+		m.certs.EXPECT().Get(gomock.Any(), "cert-1").Return(&godo.Certificate{ID: "cert-1", State: "error"}, nil, nil)
+
+		resp, _ := call(t, tool, map[string]any{"Domain": "example.com"})
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "failed to issue")
+	})
+
+	t.Run("DNS name outside the domain", func(t *testing.T) {
+		tool, _ := setup(t)
+		resp, _ := call(t, tool, map[string]any{"Domain": "example.com", "DnsNames": []any{"*.example.com", "example.net"}})
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "example.net is not in the domain example.com")
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -24,10 +26,14 @@ func NewCertificateTool(client func(ctx context.Context) (*godo.Client, error))
 
 // createCustomCertificate creates a new certificate
 func (c *CertificateTool) createCustomCertificate(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	name := req.GetArguments()["Name"].(string)
-	privateKey := req.GetArguments()["PrivateKey"].(string)
-	leafCertificate := req.GetArguments()["LeafCertificate"].(string)
-	certificateChain := req.GetArguments()["CertificateChain"].(string)
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	privateKey := args.RequiredString("PrivateKey")
+	leafCertificate := args.RequiredString("LeafCertificate")
+	certificateChain := args.RequiredString("CertificateChain")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	certRequest := &godo.CertificateRequest{
 		Name:             name,
@@ -57,16 +63,16 @@ func (c *CertificateTool) createCustomCertificate(ctx context.Context, req mcp.C
 
 // createLetsEncryptCertificate creates a new LetsEncrypt certificate
 func (c *CertificateTool) createLetsEncryptCertificate(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	name := req.GetArguments()["Name"].(string)
-	dnsNames := req.GetArguments()["DnsNames"].([]any)
-	dnsNamesStr := make([]string, len(dnsNames))
-	for i, dnsName := range dnsNames {
-		dnsNamesStr[i] = dnsName.(string)
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	dnsNames := args.RequiredStrings("DnsNames")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	certRequest := &godo.CertificateRequest{
 		Name:     name,
-		DNSNames: dnsNamesStr,
+		DNSNames: dnsNames,
 		Type:     "lets_encrypt",
 	}
 
@@ -90,7 +96,11 @@ func (c *CertificateTool) createLetsEncryptCertificate(ctx context.Context, req
 
 // deleteCertificate deletes a certificate
 func (c *CertificateTool) deleteCertificate(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	certID := req.GetArguments()["ID"].(string)
+	args := common.NewArgs(req)
+	certID := args.RequiredString("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := c.client(ctx)
 	if err != nil {
@@ -171,8 +181,8 @@ func (c *CertificateTool) Tools() []server.ServerTool {
 			Handler: c.listCertificates,
 			Tool: mcp.NewTool("certificate-list",
 				mcp.WithDescription("List certificates with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Items per page")),
 			),
 		},
 		{
@@ -277,6 +277,14 @@ func TestCertificateTool_createLetsEncryptCertificate(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			name: "DNS name that is not a string",
+			args: map[string]any{
+				"Name":     "mistyped-dns-cert",
+				"DnsNames": []any{"example.com", float64(42)},
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+
+	"mcp-digitalocean/pkg/registry/common"
 
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -130,8 +133,13 @@ func (d *DomainsTool) listDomainRecords(ctx context.Context, req mcp.CallToolReq
 }
 
 func (d *DomainsTool) createDomain(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	name := req.GetArguments()["Name"].(string)
-	ipAddress := req.GetArguments()["IPAddress"].(string)
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	ipAddress := args.RequiredString("IPAddress")
+	ifNotExists := args.Bool(common.IfNotExistsArg, false)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	createRequest := &godo.DomainCreateRequest{
 		Name:      name,
@@ -143,6 +151,21 @@ func (d *DomainsTool) createDomain(ctx context.Context, req mcp.CallToolRequest)
 		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
 	}
 
+	if ifNotExists {
+		existing, resp, err := client.Domains.Get(ctx, name)
+		switch {
+		case err == nil:
+			jsonDomain, err := json.MarshalIndent(existing, "", "  ")
+			if err != nil {
+				return nil, fmt.Errorf("marshal error: %w", err)
+			}
+			return common.ExistingResult(mcp.NewToolResultText(string(jsonDomain)), fmt.Sprintf(
+				"Domain %s already exists; it was not created and its records were not changed.", existing.Name)), nil
+		case resp == nil || resp.StatusCode != http.StatusNotFound:
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+	}
+
 	domain, _, err := client.Domains.Create(ctx, createRequest)
 	if err != nil {
 		return mcp.NewToolResultErrorFromErr("api error", err), nil
@@ -157,7 +180,11 @@ func (d *DomainsTool) createDomain(ctx context.Context, req mcp.CallToolRequest)
 }
 
 func (d *DomainsTool) deleteDomain(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	name := req.GetArguments()["Name"].(string)
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := d.client(ctx)
 	if err != nil {
@@ -173,10 +200,14 @@ func (d *DomainsTool) deleteDomain(ctx context.Context, req mcp.CallToolRequest)
 }
 
 func (d *DomainsTool) createRecord(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	domain := req.GetArguments()["Domain"].(string)
-	recordType := req.GetArguments()["Type"].(string)
-	name := req.GetArguments()["Name"].(string)
-	data := req.GetArguments()["Data"].(string)
+	args := common.NewArgs(req)
+	domain := args.RequiredString("Domain")
+	recordType := args.RequiredString("Type")
+	name := args.RequiredString("Name")
+	data := args.RequiredString("Data")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	createRequest := &godo.DomainRecordEditRequest{
 		Type: recordType,
@@ -203,8 +234,12 @@ func (d *DomainsTool) createRecord(ctx context.Context, req mcp.CallToolRequest)
 }
 
 func (d *DomainsTool) deleteRecord(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	domain := req.GetArguments()["Domain"].(string)
-	recordID := int(req.GetArguments()["RecordID"].(float64))
+	args := common.NewArgs(req)
+	domain := args.RequiredString("Domain")
+	recordID := int(args.RequiredNumber("RecordID"))
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := d.client(ctx)
 	if err != nil {
@@ -220,11 +255,15 @@ func (d *DomainsTool) deleteRecord(ctx context.Context, req mcp.CallToolRequest)
 }
 
 func (d *DomainsTool) editRecord(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	domain := req.GetArguments()["Domain"].(string)
-	recordID := int(req.GetArguments()["RecordID"].(float64))
-	recordType := req.GetArguments()["Type"].(string)
-	name := req.GetArguments()["Name"].(string)
-	data := req.GetArguments()["Data"].(string)
+	args := common.NewArgs(req)
+	domain := args.RequiredString("Domain")
+	recordID := int(args.RequiredNumber("RecordID"))
+	recordType := args.RequiredString("Type")
+	name := args.RequiredString("Name")
+	data := args.RequiredString("Data")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	editRequest := &godo.DomainRecordEditRequest{
 		Type: recordType,
@@ -263,8 +302,8 @@ func (d *DomainsTool) Tools() []server.ServerTool {
 			Handler: d.listDomains,
 			Tool: mcp.NewTool("domain-list",
 				mcp.WithDescription("List domains with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Items per page")),
 			),
 		},
 		{
@@ -280,8 +319,8 @@ func (d *DomainsTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool("domain-record-list",
 				mcp.WithDescription("List domain records for a domain with pagination"),
 				mcp.WithString("Domain", mcp.Required(), mcp.Description("Domain name")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Items per page")),
 			),
 		},
 		{
@@ -290,6 +329,7 @@ func (d *DomainsTool) Tools() []server.ServerTool {
 				mcp.WithDescription("Create a new domain"),
 				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the domain")),
 				mcp.WithString("IPAddress", mcp.Required(), mcp.Description("IP address for the domain")),
+				common.WithIfNotExists("domain"),
 			),
 		},
 		{
@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net/http"
 	"testing"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/require"
@@ -374,6 +377,7 @@ func TestDomainsTool_createDomain(t *testing.T) {
 		args        map[string]any
 		mockSetup   func(*MockDomainsService)
 		expectError bool
+		existing    bool
 	}{
 		{
 			name: "Successful create",
@@ -408,6 +412,44 @@ func TestDomainsTool_createDomain(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "IfNotExists returns the existing domain",
+			args: map[string]any{
+				"Name":        "example.com",
+				"IPAddress":   "203.0.113.10",
+				"IfNotExists": true,
+			},
+			mockSetup: func(m *MockDomainsService) {
+				m.EXPECT().Get(gomock.Any(), "example.com").Return(testDomain, &godo.Response{}, nil).Times(1)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			existing: true,
+		},
+		{
+			name: "IfNotExists creates a missing domain",
+			args: map[string]any{
+				"Name":        "example.com",
+				"IPAddress":   "203.0.113.10",
+				"IfNotExists": true,
+			},
+			mockSetup: func(m *MockDomainsService) {
+				notFound := &godo.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+				m.EXPECT().Get(gomock.Any(), "example.com").Return(nil, notFound, errors.New("not found")).Times(1)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Return(testDomain, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "IfNotExists reports lookup errors",
+			args: map[string]any{
+				"Name":        "example.com",
+				"IPAddress":   "203.0.113.10",
+				"IfNotExists": true,
+			},
+			mockSetup: func(m *MockDomainsService) {
+				m.EXPECT().Get(gomock.Any(), "example.com").Return(nil, nil, errors.New("api error")).Times(1)
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -430,6 +472,7 @@ func TestDomainsTool_createDomain(t *testing.T) {
 			var outDomain godo.Domain
 			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outDomain))
 			require.Equal(t, testDomain.Name, outDomain.Name)
+			require.Equal(t, tc.existing, resp.Meta != nil && resp.Meta.AdditionalFields[common.ExistingMetaKey] == true)
 		})
 	}
 }
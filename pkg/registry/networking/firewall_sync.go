@@ -0,0 +1,309 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"mcp-digitalocean/internal/dryrun"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// FirewallRulesDiff lists the rules a sync adds or removes.
+type FirewallRulesDiff struct {
+	InboundRules  []godo.InboundRule  `json:"inbound_rules"`
+	OutboundRules []godo.OutboundRule `json:"outbound_rules"`
+}
+
+// FirewallSyncResult is the diff between a firewall's rules and the desired rule set, applied
+// unless DryRun.
+type FirewallSyncResult struct {
+	DryRun     bool              `json:"dry_run,omitempty"`
+	FirewallID string            `json:"firewall_id"`
+	Name       string            `json:"name"`
+	InSync     bool              `json:"in_sync"`
+	Added      FirewallRulesDiff `json:"added"`
+	Removed    FirewallRulesDiff `json:"removed"`
+	Unchanged  int               `json:"unchanged"`
+	Message    string            `json:"message"`
+}
+
+// normalizePorts maps the spellings of "every port" to one, and drops the ports of icmp, which
+// has none.
+func normalizePorts(protocol, ports string) string {
+	if protocol == "icmp" {
+		return ""
+	}
+	switch ports {
+	case "", "0", "all", "1-65535":
+		return "all"
+	}
+	return ports
+}
+
+// endpointsKey is the canonical form of the sources or destinations of a rule, independent of
+// their order.
+func endpointsKey(addresses, tags []string, dropletIDs []int, lbUIDs, kubernetesIDs []string) string {
+	sorted := func(values []string) string {
+		values = slices.Clone(values)
+		slices.Sort(values)
+		return strings.Join(values, ",")
+	}
+	ids := make([]string, 0, len(dropletIDs))
+	for _, id := range dropletIDs {
+		ids = append(ids, strconv.Itoa(id))
+	}
+	return strings.Join([]string{sorted(addresses), sorted(tags), sorted(ids), sorted(lbUIDs), sorted(kubernetesIDs)}, "|")
+}
+
+func inboundKey(rule godo.InboundRule) string {
+	protocol := strings.ToLower(rule.Protocol)
+	key := protocol + "/" + normalizePorts(protocol, rule.PortRange)
+	if s := rule.Sources; s != nil {
+		key += "/" + endpointsKey(s.Addresses, s.Tags, s.DropletIDs, s.LoadBalancerUIDs, s.KubernetesIDs)
+	}
+	return key
+}
+
+func outboundKey(rule godo.OutboundRule) string {
+	protocol := strings.ToLower(rule.Protocol)
+	key := protocol + "/" + normalizePorts(protocol, rule.PortRange)
+	if d := rule.Destinations; d != nil {
+		key += "/" + endpointsKey(d.Addresses, d.Tags, d.DropletIDs, d.LoadBalancerUIDs, d.KubernetesIDs)
+	}
+	return key
+}
+
+// diffRules returns the desired rules missing from current, the current rules missing from
+// desired and the number of rules in both, comparing rules by key.
+func diffRules[T any](current, desired []T, key func(T) string) (add, remove []T, unchanged int) {
+	currentKeys := map[string]bool{}
+	for _, rule := range current {
+		currentKeys[key(rule)] = true
+	}
+	desiredKeys := map[string]bool{}
+	for _, rule := range desired {
+		k := key(rule)
+		if desiredKeys[k] {
+			continue
+		}
+		desiredKeys[k] = true
+		if currentKeys[k] {
+			unchanged++
+		} else {
+			add = append(add, rule)
+		}
+	}
+	for _, rule := range current {
+		if !desiredKeys[key(rule)] {
+			remove = append(remove, rule)
+		}
+	}
+	return add, remove, unchanged
+}
+
+// ruleEndpoints reads the sources or destinations of a desired rule: addresses under
+// addressesName, plus tags, droplet IDs, load balancer UIDs and Kubernetes cluster IDs.
+func ruleEndpoints(rule *common.Args, addressesName string) (addresses, tags []string, dropletIDs []int, lbUIDs, kubernetesIDs []string) {
+	for _, v := range rule.List("DropletIDs") {
+		if id, ok := v.(float64); ok {
+			dropletIDs = append(dropletIDs, int(id))
+		}
+	}
+	return rule.Strings(addressesName), rule.Strings("Tags"), dropletIDs, rule.Strings("LoadBalancerUIDs"), rule.Strings("KubernetesIDs")
+}
+
+// noEndpoints reports whether a rule allows traffic from or to nothing.
+func noEndpoints(addresses, tags []string, dropletIDs []int, lbUIDs, kubernetesIDs []string) bool {
+	return len(addresses) == 0 && len(tags) == 0 && len(dropletIDs) == 0 && len(lbUIDs) == 0 && len(kubernetesIDs) == 0
+}
+
+// desiredRules reads the complete desired rule sets of a firewall-sync call. The set of a
+// direction whose argument is absent is nil.
+func desiredRules(args *common.Args) ([]godo.InboundRule, []godo.OutboundRule) {
+	var inboundRules []godo.InboundRule
+	if args.Has("InboundRules") {
+		inboundRules = []godo.InboundRule{}
+	}
+	for _, rule := range args.Objects("InboundRules") {
+		addresses, tags, dropletIDs, lbUIDs, kubernetesIDs := ruleEndpoints(rule, "Sources")
+		inboundRules = append(inboundRules, godo.InboundRule{
+			Protocol:  rule.RequiredString("Protocol"),
+			PortRange: rule.String("PortRange"),
+			Sources: &godo.Sources{
+				Addresses:        addresses,
+				Tags:             tags,
+				DropletIDs:       dropletIDs,
+				LoadBalancerUIDs: lbUIDs,
+				KubernetesIDs:    kubernetesIDs,
+			},
+		})
+	}
+
+	var outboundRules []godo.OutboundRule
+	if args.Has("OutboundRules") {
+		outboundRules = []godo.OutboundRule{}
+	}
+	for _, rule := range args.Objects("OutboundRules") {
+		addresses, tags, dropletIDs, lbUIDs, kubernetesIDs := ruleEndpoints(rule, "Destinations")
+		outboundRules = append(outboundRules, godo.OutboundRule{
+			Protocol:  rule.RequiredString("Protocol"),
+			PortRange: rule.String("PortRange"),
+			Destinations: &godo.Destinations{
+				Addresses:        addresses,
+				Tags:             tags,
+				DropletIDs:       dropletIDs,
+				LoadBalancerUIDs: lbUIDs,
+				KubernetesIDs:    kubernetesIDs,
+			},
+		})
+	}
+	return inboundRules, outboundRules
+}
+
+// findFirewallByName returns the firewall named name, which must be unique.
+func findFirewallByName(ctx context.Context, client *godo.Client, name string) (*godo.Firewall, error) {
+	firewalls, err := common.ListAll(ctx, 200, client.Firewalls.List)
+	if err != nil {
+		return nil, err
+	}
+	var found []godo.Firewall
+	for _, firewall := range firewalls {
+		if firewall.Name == name {
+			found = append(found, firewall)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &found[0], nil
+	}
+	ids := make([]string, 0, len(found))
+	for _, firewall := range found {
+		ids = append(ids, firewall.ID)
+	}
+	return nil, fmt.Errorf("%d firewalls are named %q (%s); rename them apart first", len(found), name, strings.Join(ids, ", "))
+}
+
+// syncFirewall converges the rules of a named firewall on the desired rule set: it adds the
+// desired rules the firewall lacks and then removes the rules not desired, leaving the rules in
+// both untouched. Adding first means traffic allowed before and after is never cut off. The rules
+// of a direction whose argument is absent are left as they are; an empty array removes them.
+func (f *FirewallTool) syncFirewall(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	inboundRules, outboundRules := desiredRules(args)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if inboundRules == nil && outboundRules == nil {
+		return mcp.NewToolResultError("pass the complete InboundRules, OutboundRules or both; the rules of a direction left out are not changed"), nil
+	}
+	for _, rule := range inboundRules {
+		if src := rule.Sources; noEndpoints(src.Addresses, src.Tags, src.DropletIDs, src.LoadBalancerUIDs, src.KubernetesIDs) {
+			return mcp.NewToolResultError(fmt.Sprintf("inbound %s rule %q has no Sources, Tags, DropletIDs, LoadBalancerUIDs or KubernetesIDs", rule.Protocol, rule.PortRange)), nil
+		}
+	}
+	for _, rule := range outboundRules {
+		if dst := rule.Destinations; noEndpoints(dst.Addresses, dst.Tags, dst.DropletIDs, dst.LoadBalancerUIDs, dst.KubernetesIDs) {
+			return mcp.NewToolResultError(fmt.Sprintf("outbound %s rule %q has no Destinations, Tags, DropletIDs, LoadBalancerUIDs or KubernetesIDs", rule.Protocol, rule.PortRange)), nil
+		}
+	}
+
+	client, err := f.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	firewall, err := findFirewallByName(ctx, client, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if firewall == nil {
+		return mcp.NewToolResultError(fmt.Sprintf("no firewall is named %q; create it with firewall-create first", name)), nil
+	}
+
+	var addInbound, removeInbound []godo.InboundRule
+	var addOutbound, removeOutbound []godo.OutboundRule
+	var unchangedInbound, unchangedOutbound int
+	if inboundRules != nil {
+		addInbound, removeInbound, unchangedInbound = diffRules(firewall.InboundRules, inboundRules, inboundKey)
+	}
+	if outboundRules != nil {
+		addOutbound, removeOutbound, unchangedOutbound = diffRules(firewall.OutboundRules, outboundRules, outboundKey)
+	}
+	result := &FirewallSyncResult{
+		FirewallID: firewall.ID,
+		Name:       firewall.Name,
+		Added:      FirewallRulesDiff{InboundRules: addInbound, OutboundRules: addOutbound},
+		Removed:    FirewallRulesDiff{InboundRules: removeInbound, OutboundRules: removeOutbound},
+		Unchanged:  unchangedInbound + unchangedOutbound,
+	}
+	adds := len(addInbound) + len(addOutbound)
+	removes := len(removeInbound) + len(removeOutbound)
+	if adds == 0 && removes == 0 {
+		result.InSync = true
+		result.Message = "the firewall already has exactly the desired rules"
+		return common.NewToolResultStructured(result)
+	}
+
+	if dryrun.Active(ctx) {
+		dryrun.Planned(ctx)
+		result.DryRun = true
+		result.Message = fmt.Sprintf("Dry run: no changes were made. %d rules would be added and %d removed.", adds, removes)
+		return common.NewToolResultStructured(result)
+	}
+
+	if adds > 0 {
+		if _, err := client.Firewalls.AddRules(ctx, firewall.ID, &godo.FirewallRulesRequest{
+			InboundRules:  addInbound,
+			OutboundRules: addOutbound,
+		}); err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+	}
+	if removes > 0 {
+		if _, err := client.Firewalls.RemoveRules(ctx, firewall.ID, &godo.FirewallRulesRequest{
+			InboundRules:  removeInbound,
+			OutboundRules: removeOutbound,
+		}); err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("api error: %d rules were added but the removal failed", adds), err), nil
+		}
+	}
+	result.InSync = true
+	result.Message = fmt.Sprintf("added %d rules and removed %d", adds, removes)
+	return common.NewToolResultStructured(result)
+}
+
+// syncRuleSchema returns the schema of a desired rule whose addresses are named addressesName.
+func syncRuleSchema(direction, addressesName string) map[string]any {
+	list := func(description string) map[string]any {
+		return map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": description}
+	}
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"Protocol": map[string]any{
+				"type":        "string",
+				"description": "Protocol (tcp, udp, icmp)",
+			},
+			"PortRange": map[string]any{
+				"type":        "string",
+				"description": "Port range (e.g., '80', '443', '8000-8080', 'all'). Not used with icmp",
+			},
+			addressesName:      list("IP addresses or CIDR blocks"),
+			"Tags":             list("Tags of droplets"),
+			"DropletIDs":       map[string]any{"type": "array", "items": map[string]any{"type": "number"}, "description": "IDs of droplets"},
+			"LoadBalancerUIDs": list("IDs of load balancers"),
+			"KubernetesIDs":    list("IDs of Kubernetes clusters"),
+		},
+		"required":    []string{"Protocol"},
+		"description": direction + " firewall rule",
+	}
+}
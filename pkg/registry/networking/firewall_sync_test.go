@@ -0,0 +1,189 @@
+package networking
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"mcp-digitalocean/internal/dryrun"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFirewallTool_syncFirewall(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	existing := godo.Firewall{
+		ID:   "fw-1",
+		Name: "web",
+		InboundRules: []godo.InboundRule{
+			{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: []string{"0.0.0.0/0", "::/0"}}},
+			{Protocol: "tcp", PortRange: "80", Sources: &godo.Sources{Addresses: []string{"0.0.0.0/0"}}},
+		},
+		OutboundRules: []godo.OutboundRule{
+			{Protocol: "tcp", PortRange: "0", Destinations: &godo.Destinations{Addresses: []string{"0.0.0.0/0"}}},
+		},
+	}
+	desired := map[string]any{
+		"Name": "web",
+		"InboundRules": []any{
+			// same as the existing rule, with the addresses in another order
+			map[string]any{"Protocol": "tcp", "PortRange": "22", "Sources": []any{"::/0", "0.0.0.0/0"}},
+			map[string]any{"Protocol": "tcp", "PortRange": "443", "Sources": []any{"0.0.0.0/0"}},
+			map[string]any{"Protocol": "tcp", "PortRange": "5432", "Tags": []any{"db"}},
+		},
+		"OutboundRules": []any{
+			map[string]any{"Protocol": "tcp", "PortRange": "all", "Destinations": []any{"0.0.0.0/0"}},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		args            map[string]any
+		dryRun          bool
+		mockSetup       func(*MockFirewallsService)
+		expectError     bool
+		expectAdded     int
+		expectRemoved   int
+		expectUnchanged int
+		expectInSync    bool
+	}{
+		{
+			name: "Applies the delta",
+			args: desired,
+			mockSetup: func(m *MockFirewallsService) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Firewall{{ID: "fw-0", Name: "db"}, existing}, nil, nil)
+				gomock.InOrder(
+					m.EXPECT().AddRules(gomock.Any(), "fw-1", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, req *godo.FirewallRulesRequest) (*godo.Response, error) {
+						require.Len(t, req.InboundRules, 2)
+						require.Equal(t, "443", req.InboundRules[0].PortRange)
+						require.Equal(t, []string{"db"}, req.InboundRules[1].Sources.Tags)
+						require.Empty(t, req.OutboundRules)
+						return nil, nil
+					}),
+					m.EXPECT().RemoveRules(gomock.Any(), "fw-1", &godo.FirewallRulesRequest{
+						InboundRules: []godo.InboundRule{existing.InboundRules[1]},
+					}).Return(nil, nil),
+				)
+			},
+			expectAdded:     2,
+			expectRemoved:   1,
+			expectUnchanged: 2,
+			expectInSync:    true,
+		},
+		{
+			name:   "Dry run only diffs",
+			args:   map[string]any{"Name": "web", "InboundRules": desired["InboundRules"], "OutboundRules": desired["OutboundRules"], dryrun.Arg: true},
+			dryRun: true,
+			mockSetup: func(m *MockFirewallsService) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Firewall{existing}, nil, nil)
+			},
+			expectAdded:     2,
+			expectRemoved:   1,
+			expectUnchanged: 2,
+		},
+		{
+			name: "Already in sync",
+			args: map[string]any{
+				"Name": "web",
+				"InboundRules": []any{
+					map[string]any{"Protocol": "tcp", "PortRange": "22", "Sources": []any{"0.0.0.0/0", "::/0"}},
+					map[string]any{"Protocol": "tcp", "PortRange": "80", "Sources": []any{"0.0.0.0/0"}},
+				},
+				"OutboundRules": []any{
+					map[string]any{"Protocol": "TCP", "Destinations": []any{"0.0.0.0/0"}},
+				},
+			},
+			mockSetup: func(m *MockFirewallsService) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Firewall{existing}, nil, nil)
+			},
+			expectUnchanged: 3,
+			expectInSync:    true,
+		},
+		{
+			name: "Leaves the direction left out unchanged",
+			args: map[string]any{"Name": "web", "InboundRules": desired["InboundRules"]},
+			mockSetup: func(m *MockFirewallsService) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Firewall{existing}, nil, nil)
+				m.EXPECT().AddRules(gomock.Any(), "fw-1", gomock.Any()).Return(nil, nil)
+				m.EXPECT().RemoveRules(gomock.Any(), "fw-1", &godo.FirewallRulesRequest{
+					InboundRules: []godo.InboundRule{existing.InboundRules[1]},
+				}).Return(nil, nil)
+			},
+			expectAdded:     2,
+			expectRemoved:   1,
+			expectUnchanged: 1,
+			expectInSync:    true,
+		},
+		{
+			name: "An empty array removes the rules of its direction",
+			args: map[string]any{"Name": "web", "OutboundRules": []any{}},
+			mockSetup: func(m *MockFirewallsService) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Firewall{existing}, nil, nil)
+				m.EXPECT().RemoveRules(gomock.Any(), "fw-1", &godo.FirewallRulesRequest{
+					OutboundRules: existing.OutboundRules,
+				}).Return(nil, nil)
+			},
+			expectRemoved: 1,
+			expectInSync:  true,
+		},
+		{
+			name: "Firewall not found",
+			args: desired,
+			mockSetup: func(m *MockFirewallsService) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "Ambiguous name",
+			args: desired,
+			mockSetup: func(m *MockFirewallsService) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Firewall{existing, {ID: "fw-2", Name: "web"}}, nil, nil)
+			},
+			expectError: true,
+		},
+		{
+			name:        "No rule set",
+			args:        map[string]any{"Name": "web"},
+			expectError: true,
+		},
+		{
+			name:        "Rule without sources",
+			args:        map[string]any{"Name": "web", "InboundRules": []any{map[string]any{"Protocol": "tcp", "PortRange": "22"}}},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockFirewalls := NewMockFirewallsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockFirewalls)
+			}
+			tool := setupFirewallToolWithMock(mockFirewalls)
+			handler := tool.syncFirewall
+			if tc.dryRun {
+				handler = dryrun.Middleware(tool.syncFirewall)
+			}
+			resp, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "firewall-sync", Arguments: tc.args}})
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			var result FirewallSyncResult
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+			require.Equal(t, tc.expectAdded, len(result.Added.InboundRules)+len(result.Added.OutboundRules))
+			require.Equal(t, tc.expectRemoved, len(result.Removed.InboundRules)+len(result.Removed.OutboundRules))
+			require.Equal(t, tc.expectUnchanged, result.Unchanged)
+			require.Equal(t, tc.expectInSync, result.InSync)
+			require.Equal(t, tc.dryRun, result.DryRun)
+		})
+	}
+}
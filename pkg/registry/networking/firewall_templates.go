@@ -0,0 +1,249 @@
+package networking
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// anywhere are the addresses of every IPv4 and IPv6 host.
+var anywhere = []string{"0.0.0.0/0", "::/0"}
+
+// privateRanges are the networks db-private accepts Sources from: the private IPv4 ranges, which
+// VPCs are carved from, and IPv6 unique local addresses.
+var privateRanges = []netip.Prefix{
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+	netip.MustParsePrefix("fc00::/7"),
+}
+
+// databasePorts are the ports of the engines db-private opens.
+var databasePorts = map[string]string{
+	"mysql":      "3306",
+	"pg":         "5432",
+	"redis":      "6379",
+	"valkey":     "6379",
+	"mongodb":    "27017",
+	"kafka":      "9092",
+	"opensearch": "9200",
+}
+
+// kubernetesNodePorts is the range of NodePort services, which load balancers forward to.
+const kubernetesNodePorts = "30000-32767"
+
+// firewallTemplateArgs are the arguments a template builds its rules from.
+type firewallTemplateArgs struct {
+	sshSources []string
+	sources    []string
+	sourceTags []string
+	engine     string
+	lbUIDs     []string
+	tags       []string
+}
+
+// firewallTemplate is a built-in rule set of firewall-create-from-template.
+type firewallTemplate struct {
+	name        string
+	description string
+	rules       func(a firewallTemplateArgs) ([]godo.InboundRule, error)
+}
+
+// firewallTemplates are the templates of firewall-create-from-template, in the order they are
+// documented. Every template allows all outbound traffic.
+var firewallTemplates = []firewallTemplate{
+	{
+		name:        "web",
+		description: "HTTP and HTTPS from anywhere, SSH from SSHSources (default anywhere)",
+		rules: func(a firewallTemplateArgs) ([]godo.InboundRule, error) {
+			return []godo.InboundRule{
+				tcpFrom("22", &godo.Sources{Addresses: orAnywhere(a.sshSources)}),
+				tcpFrom("80", &godo.Sources{Addresses: anywhere}),
+				tcpFrom("443", &godo.Sources{Addresses: anywhere}),
+			}, nil
+		},
+	},
+	{
+		name:        "ssh-only",
+		description: "SSH from SSHSources (default anywhere) and nothing else",
+		rules: func(a firewallTemplateArgs) ([]godo.InboundRule, error) {
+			return []godo.InboundRule{tcpFrom("22", &godo.Sources{Addresses: orAnywhere(a.sshSources)})}, nil
+		},
+	},
+	{
+		name:        "db-private",
+		description: "the port of Engine from private Sources and SourceTags only, plus SSH when SSHSources is given",
+		rules: func(a firewallTemplateArgs) ([]godo.InboundRule, error) {
+			port, ok := databasePorts[a.engine]
+			if !ok {
+				return nil, fmt.Errorf("db-private needs Engine, one of %s", strings.Join(databaseEngines(), ", "))
+			}
+			if len(a.sources) == 0 && len(a.sourceTags) == 0 {
+				return nil, fmt.Errorf("db-private needs Sources or SourceTags to allow the database port from")
+			}
+			for _, source := range a.sources {
+				if !isPrivate(source) {
+					return nil, fmt.Errorf("db-private only opens port %s to private networks, and %s is not in %s", port, source, privateRangesList())
+				}
+			}
+			rules := []godo.InboundRule{tcpFrom(port, &godo.Sources{Addresses: a.sources, Tags: a.sourceTags})}
+			if len(a.sshSources) > 0 {
+				rules = append(rules, tcpFrom("22", &godo.Sources{Addresses: a.sshSources}))
+			}
+			return rules, nil
+		},
+	},
+	{
+		name:        "k8s-nodes",
+		description: "all traffic between the droplets carrying Tags, and NodePorts from LoadBalancerUIDs",
+		rules: func(a firewallTemplateArgs) ([]godo.InboundRule, error) {
+			if len(a.tags) == 0 {
+				return nil, fmt.Errorf("k8s-nodes needs the Tags of the node droplets")
+			}
+			rules := []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "all", Sources: &godo.Sources{Tags: a.tags}},
+				{Protocol: "udp", PortRange: "all", Sources: &godo.Sources{Tags: a.tags}},
+				{Protocol: "icmp", Sources: &godo.Sources{Tags: a.tags}},
+			}
+			if len(a.lbUIDs) > 0 {
+				rules = append(rules, tcpFrom(kubernetesNodePorts, &godo.Sources{LoadBalancerUIDs: a.lbUIDs}))
+			}
+			return rules, nil
+		},
+	},
+}
+
+func tcpFrom(ports string, sources *godo.Sources) godo.InboundRule {
+	return godo.InboundRule{Protocol: "tcp", PortRange: ports, Sources: sources}
+}
+
+// orAnywhere returns addresses, or anywhere when there are none.
+func orAnywhere(addresses []string) []string {
+	if len(addresses) == 0 {
+		return anywhere
+	}
+	return addresses
+}
+
+// allOutbound are the outbound rules of every template: all traffic to anywhere.
+func allOutbound() []godo.OutboundRule {
+	return []godo.OutboundRule{
+		{Protocol: "tcp", PortRange: "all", Destinations: &godo.Destinations{Addresses: anywhere}},
+		{Protocol: "udp", PortRange: "all", Destinations: &godo.Destinations{Addresses: anywhere}},
+		{Protocol: "icmp", Destinations: &godo.Destinations{Addresses: anywhere}},
+	}
+}
+
+// isPrivate reports whether the address or CIDR block lies within one of the privateRanges.
+func isPrivate(source string) bool {
+	prefix, err := netip.ParsePrefix(source)
+	if err != nil {
+		addr, err := netip.ParseAddr(source)
+		if err != nil {
+			return false
+		}
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+	return slices.ContainsFunc(privateRanges, func(r netip.Prefix) bool {
+		return r.Bits() <= prefix.Bits() && r.Contains(prefix.Addr())
+	})
+}
+
+func privateRangesList() string {
+	ranges := make([]string, 0, len(privateRanges))
+	for _, r := range privateRanges {
+		ranges = append(ranges, r.String())
+	}
+	return strings.Join(ranges, ", ")
+}
+
+func databaseEngines() []string {
+	engines := make([]string, 0, len(databasePorts))
+	for engine := range databasePorts {
+		engines = append(engines, engine)
+	}
+	slices.Sort(engines)
+	return engines
+}
+
+func firewallTemplateNames() []string {
+	names := make([]string, 0, len(firewallTemplates))
+	for _, t := range firewallTemplates {
+		names = append(names, t.name)
+	}
+	return names
+}
+
+// firewallTemplatesDescription lists the templates for the tool description.
+func firewallTemplatesDescription() string {
+	lines := make([]string, 0, len(firewallTemplates))
+	for _, t := range firewallTemplates {
+		lines = append(lines, fmt.Sprintf("%s: %s", t.name, t.description))
+	}
+	return strings.Join(lines, "; ")
+}
+
+// createFirewallFromTemplate creates a firewall with the rules of a built-in template, so common
+// firewalls don't need their rules written out, and a database port is never opened to the
+// internet by mistake.
+func (f *FirewallTool) createFirewallFromTemplate(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	templateName := args.RequiredString("Template")
+	a := firewallTemplateArgs{
+		sshSources: args.Strings("SSHSources"),
+		sources:    args.Strings("Sources"),
+		sourceTags: args.Strings("SourceTags"),
+		engine:     strings.ToLower(args.String("Engine")),
+		lbUIDs:     args.Strings("LoadBalancerUIDs"),
+		tags:       args.Strings("Tags"),
+	}
+	var dropletIDs []int
+	for _, v := range args.List("DropletIDs") {
+		if id, ok := v.(float64); ok {
+			dropletIDs = append(dropletIDs, int(id))
+		}
+	}
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	i := slices.IndexFunc(firewallTemplates, func(t firewallTemplate) bool { return t.name == templateName })
+	if i < 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: Template must be one of %s", strings.Join(firewallTemplateNames(), ", "))), nil
+	}
+	inbound, err := firewallTemplates[i].rules(a)
+	if err != nil {
+		return mcp.NewToolResultError("invalid arguments: " + err.Error()), nil
+	}
+
+	client, err := f.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	firewall, _, err := client.Firewalls.Create(ctx, &godo.FirewallRequest{
+		Name:          name,
+		InboundRules:  inbound,
+		OutboundRules: allOutbound(),
+		DropletIDs:    dropletIDs,
+		Tags:          a.tags,
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonFirewall, err := json.MarshalIndent(firewall, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonFirewall)), nil
+}
@@ -0,0 +1,118 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFirewallTool_createFirewallFromTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        map[string]any
+		inbound     []godo.InboundRule
+		dropletIDs  []int
+		tags        []string
+		apiErr      error
+		expectError bool
+	}{
+		{
+			name: "Web",
+			args: map[string]any{"Name": "web", "Template": "web", "DropletIDs": []any{float64(1), float64(2)}},
+			inbound: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: anywhere}},
+				{Protocol: "tcp", PortRange: "80", Sources: &godo.Sources{Addresses: anywhere}},
+				{Protocol: "tcp", PortRange: "443", Sources: &godo.Sources{Addresses: anywhere}},
+			},
+			dropletIDs: []int{1, 2},
+		},
+		{
+			name: "SSH only from an office",
+			args: map[string]any{"Name": "bastion", "Template": "ssh-only", "SSHSources": []any{"203.0.113.0/24"}},
+			inbound: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "22", Sources: &godo.Sources{Addresses: []string{"203.0.113.0/24"}}},
+			},
+		},
+		{
+			name: "Private database",
+			args: map[string]any{"Name": "db", "Template": "db-private", "Engine": "MySQL", "Sources": []any{"10.10.0.0/16"}, "SourceTags": []any{"app"}, "Tags": []any{"db"}},
+			inbound: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "3306", Sources: &godo.Sources{Addresses: []string{"10.10.0.0/16"}, Tags: []string{"app"}}},
+			},
+			tags: []string{"db"},
+		},
+		{
+			name: "Kubernetes nodes",
+			args: map[string]any{"Name": "nodes", "Template": "k8s-nodes", "Tags": []any{"k8s-worker"}, "LoadBalancerUIDs": []any{"lb-1"}},
+			inbound: []godo.InboundRule{
+				{Protocol: "tcp", PortRange: "all", Sources: &godo.Sources{Tags: []string{"k8s-worker"}}},
+				{Protocol: "udp", PortRange: "all", Sources: &godo.Sources{Tags: []string{"k8s-worker"}}},
+				{Protocol: "icmp", Sources: &godo.Sources{Tags: []string{"k8s-worker"}}},
+				{Protocol: "tcp", PortRange: "30000-32767", Sources: &godo.Sources{LoadBalancerUIDs: []string{"lb-1"}}},
+			},
+			tags: []string{"k8s-worker"},
+		},
+		{
+			name:        "Database port open to the internet",
+			args:        map[string]any{"Name": "db", "Template": "db-private", "Engine": "mysql", "Sources": []any{"0.0.0.0/0"}},
+			expectError: true,
+		},
+		{
+			name:        "Database source straddling a private range",
+			args:        map[string]any{"Name": "db", "Template": "db-private", "Engine": "pg", "Sources": []any{"10.0.0.0/7"}},
+			expectError: true,
+		},
+		{
+			name:        "Database without sources",
+			args:        map[string]any{"Name": "db", "Template": "db-private", "Engine": "pg"},
+			expectError: true,
+		},
+		{
+			name:        "Kubernetes nodes without tags",
+			args:        map[string]any{"Name": "nodes", "Template": "k8s-nodes"},
+			expectError: true,
+		},
+		{
+			name:        "Unknown template",
+			args:        map[string]any{"Name": "fw", "Template": "open"},
+			expectError: true,
+		},
+		{
+			name:        "API error",
+			args:        map[string]any{"Name": "web", "Template": "web"},
+			apiErr:      errors.New("quota exceeded"),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockFirewalls := NewMockFirewallsService(ctrl)
+			if tc.inbound != nil || tc.apiErr != nil {
+				mockFirewalls.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, fr *godo.FirewallRequest) (*godo.Firewall, *godo.Response, error) {
+						if tc.apiErr != nil {
+							return nil, nil, tc.apiErr
+						}
+						require.Equal(t, tc.inbound, fr.InboundRules)
+						require.Equal(t, allOutbound(), fr.OutboundRules)
+						require.Equal(t, tc.dropletIDs, fr.DropletIDs)
+						require.Equal(t, tc.tags, fr.Tags)
+						return &godo.Firewall{ID: "fw-1", Name: fr.Name}, nil, nil
+					})
+			}
+			tool := setupFirewallToolWithMock(mockFirewalls)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.createFirewallFromTemplate(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, resp.IsError)
+		})
+	}
+}
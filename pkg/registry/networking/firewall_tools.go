@@ -4,12 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
 
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// firewallsPageSize is the page size firewalls are listed with to find one by name.
+const firewallsPageSize = 200
+
 // FirewallTool provides firewall management tools
 type FirewallTool struct {
 	client func(ctx context.Context) (*godo.Client, error)
@@ -74,13 +80,18 @@ func (f *FirewallTool) listFirewalls(ctx context.Context, req mcp.CallToolReques
 
 // createFirewall creates a new firewall
 func (f *FirewallTool) createFirewall(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	name := req.GetArguments()["Name"].(string)
-	inboundProtocol := req.GetArguments()["InboundProtocol"].(string)
-	inboundPortRange := req.GetArguments()["InboundPortRange"].(string)
-	inboundSource := req.GetArguments()["InboundSource"].(string)
-	outboundProtocol := req.GetArguments()["OutboundProtocol"].(string)
-	outboundPortRange := req.GetArguments()["OutboundPortRange"].(string)
-	outboundDestination := req.GetArguments()["OutboundDestination"].(string)
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	inboundProtocol := args.RequiredString("InboundProtocol")
+	inboundPortRange := args.RequiredString("InboundPortRange")
+	inboundSource := args.RequiredString("InboundSource")
+	outboundProtocol := args.RequiredString("OutboundProtocol")
+	outboundPortRange := args.RequiredString("OutboundPortRange")
+	outboundDestination := args.RequiredString("OutboundDestination")
+	ifNotExists := args.Bool(common.IfNotExistsArg, false)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	dropletIDs := make([]any, 0)
 	if v, ok := req.GetArguments()["DropletIDs"].([]any); ok {
@@ -130,6 +141,36 @@ func (f *FirewallTool) createFirewall(ctx context.Context, req mcp.CallToolReque
 		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
 	}
 
+	if ifNotExists {
+		firewalls, err := common.ListAll(ctx, firewallsPageSize, client.Firewalls.List)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		var existing []godo.Firewall
+		for _, firewall := range firewalls {
+			if firewall.Name == name {
+				existing = append(existing, firewall)
+			}
+		}
+		switch len(existing) {
+		case 0:
+		case 1:
+			jsonFirewall, err := json.MarshalIndent(existing[0], "", "  ")
+			if err != nil {
+				return nil, fmt.Errorf("marshal error: %w", err)
+			}
+			return common.ExistingResult(mcp.NewToolResultText(string(jsonFirewall)), fmt.Sprintf(
+				"Firewall %s named %q already exists; it was not created and its rules were not changed.", existing[0].ID, name)), nil
+		default:
+			ids := make([]string, len(existing))
+			for i, firewall := range existing {
+				ids[i] = firewall.ID
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("%d firewalls are named %q (IDs %s); use firewall-get with one of them instead of IfNotExists",
+				len(existing), name, strings.Join(ids, ", "))), nil
+		}
+	}
+
 	firewall, _, err := client.Firewalls.Create(ctx, firewallRequest)
 	if err != nil {
 		return mcp.NewToolResultErrorFromErr("api error", err), nil
@@ -145,7 +186,11 @@ func (f *FirewallTool) createFirewall(ctx context.Context, req mcp.CallToolReque
 
 // deleteFirewall deletes a firewall
 func (f *FirewallTool) deleteFirewall(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	firewallID := req.GetArguments()["ID"].(string)
+	args := common.NewArgs(req)
+	firewallID := args.RequiredString("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := f.client(ctx)
 	if err != nil {
@@ -161,8 +206,12 @@ func (f *FirewallTool) deleteFirewall(ctx context.Context, req mcp.CallToolReque
 
 // addDroplets adds one or more droplet to a firewall
 func (f *FirewallTool) addDroplets(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	firewallID := req.GetArguments()["ID"].(string)
-	dropletIDs := req.GetArguments()["DropletIDs"].([]any)
+	args := common.NewArgs(req)
+	firewallID := args.RequiredString("ID")
+	dropletIDs := args.RequiredList("DropletIDs")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 	dIDs := make([]int, len(dropletIDs))
 	for i, id := range dropletIDs {
 		if did, ok := id.(float64); ok {
@@ -183,8 +232,12 @@ func (f *FirewallTool) addDroplets(ctx context.Context, req mcp.CallToolRequest)
 }
 
 func (f *FirewallTool) removeDroplets(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	firewallID := req.GetArguments()["ID"].(string)
-	dropletIDs := req.GetArguments()["DropletIDs"].([]any)
+	args := common.NewArgs(req)
+	firewallID := args.RequiredString("ID")
+	dropletIDs := args.RequiredList("DropletIDs")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 	dIDs := make([]int, len(dropletIDs))
 	for i, id := range dropletIDs {
 		if did, ok := id.(float64); ok {
@@ -206,8 +259,12 @@ func (f *FirewallTool) removeDroplets(ctx context.Context, req mcp.CallToolReque
 
 // addTags adds one or more tags to a firewall
 func (f *FirewallTool) addTags(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	firewallID := req.GetArguments()["ID"].(string)
-	tagNames := req.GetArguments()["Tags"].([]any)
+	args := common.NewArgs(req)
+	firewallID := args.RequiredString("ID")
+	tagNames := args.RequiredList("Tags")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 	tagNamesStr := make([]string, len(tagNames))
 	for i, v := range tagNames {
 		if tag, ok := v.(string); ok {
@@ -229,8 +286,12 @@ func (f *FirewallTool) addTags(ctx context.Context, req mcp.CallToolRequest) (*m
 
 // removeTags removes one or more tags from a firewall
 func (f *FirewallTool) removeTags(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	firewallID := req.GetArguments()["ID"].(string)
-	tagNames := req.GetArguments()["Tags"].([]any)
+	args := common.NewArgs(req)
+	firewallID := args.RequiredString("ID")
+	tagNames := args.RequiredList("Tags")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 	tagNamesStr := make([]string, len(tagNames))
 	for i, v := range tagNames {
 		if tag, ok := v.(string); ok {
@@ -252,57 +313,11 @@ func (f *FirewallTool) removeTags(ctx context.Context, req mcp.CallToolRequest)
 
 // addRules adds one or more rules to a firewall
 func (f *FirewallTool) addRules(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	firewallID := req.GetArguments()["ID"].(string)
-
-	var inboundRules []godo.InboundRule
-	var outboundRules []godo.OutboundRule
-
-	// Process inbound rules if provided
-	if inboundData, ok := req.GetArguments()["InboundRules"]; ok && inboundData != nil {
-		inboundRulesList := inboundData.([]any)
-		for _, ruleData := range inboundRulesList {
-			rule := ruleData.(map[string]any)
-
-			protocol := rule["Protocol"].(string)
-			portRange := rule["PortRange"].(string)
-			sources := rule["Sources"].([]any)
-
-			sourceAddresses := make([]string, len(sources))
-			for i, source := range sources {
-				sourceAddresses[i] = source.(string)
-			}
-
-			inboundRule := godo.InboundRule{
-				Protocol:  protocol,
-				PortRange: portRange,
-				Sources:   &godo.Sources{Addresses: sourceAddresses},
-			}
-			inboundRules = append(inboundRules, inboundRule)
-		}
-	}
-
-	// Process outbound rules if provided
-	if outboundData, ok := req.GetArguments()["OutboundRules"]; ok && outboundData != nil {
-		outboundRulesList := outboundData.([]any)
-		for _, ruleData := range outboundRulesList {
-			rule := ruleData.(map[string]any)
-
-			protocol := rule["Protocol"].(string)
-			portRange := rule["PortRange"].(string)
-			destinations := rule["Destinations"].([]any)
-
-			destAddresses := make([]string, len(destinations))
-			for i, dest := range destinations {
-				destAddresses[i] = dest.(string)
-			}
-
-			outboundRule := godo.OutboundRule{
-				Protocol:     protocol,
-				PortRange:    portRange,
-				Destinations: &godo.Destinations{Addresses: destAddresses},
-			}
-			outboundRules = append(outboundRules, outboundRule)
-		}
+	args := common.NewArgs(req)
+	firewallID := args.RequiredString("ID")
+	inboundRules, outboundRules := firewallRules(args)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	if len(inboundRules) == 0 && len(outboundRules) == 0 {
@@ -329,57 +344,11 @@ func (f *FirewallTool) addRules(ctx context.Context, req mcp.CallToolRequest) (*
 
 // removeRules removes one or more rules from a firewall
 func (f *FirewallTool) removeRules(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	firewallID := req.GetArguments()["ID"].(string)
-
-	var inboundRules []godo.InboundRule
-	var outboundRules []godo.OutboundRule
-
-	// Process inbound rules if provided
-	if inboundData, ok := req.GetArguments()["InboundRules"]; ok && inboundData != nil {
-		inboundRulesList := inboundData.([]any)
-		for _, ruleData := range inboundRulesList {
-			rule := ruleData.(map[string]any)
-
-			protocol := rule["Protocol"].(string)
-			portRange := rule["PortRange"].(string)
-			sources := rule["Sources"].([]any)
-
-			sourceAddresses := make([]string, len(sources))
-			for i, source := range sources {
-				sourceAddresses[i] = source.(string)
-			}
-
-			inboundRule := godo.InboundRule{
-				Protocol:  protocol,
-				PortRange: portRange,
-				Sources:   &godo.Sources{Addresses: sourceAddresses},
-			}
-			inboundRules = append(inboundRules, inboundRule)
-		}
-	}
-
-	// Process outbound rules if provided
-	if outboundData, ok := req.GetArguments()["OutboundRules"]; ok && outboundData != nil {
-		outboundRulesList := outboundData.([]any)
-		for _, ruleData := range outboundRulesList {
-			rule := ruleData.(map[string]any)
-
-			protocol := rule["Protocol"].(string)
-			portRange := rule["PortRange"].(string)
-			destinations := rule["Destinations"].([]any)
-
-			destAddresses := make([]string, len(destinations))
-			for i, dest := range destinations {
-				destAddresses[i] = dest.(string)
-			}
-
-			outboundRule := godo.OutboundRule{
-				Protocol:     protocol,
-				PortRange:    portRange,
-				Destinations: &godo.Destinations{Addresses: destAddresses},
-			}
-			outboundRules = append(outboundRules, outboundRule)
-		}
+	args := common.NewArgs(req)
+	firewallID := args.RequiredString("ID")
+	inboundRules, outboundRules := firewallRules(args)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	if len(inboundRules) == 0 && len(outboundRules) == 0 {
@@ -418,8 +387,8 @@ func (f *FirewallTool) Tools() []server.ServerTool {
 			Handler: f.listFirewalls,
 			Tool: mcp.NewTool("firewall-list",
 				mcp.WithDescription("List firewalls with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Items per page")),
 			),
 		},
 		{
@@ -441,6 +410,26 @@ func (f *FirewallTool) Tools() []server.ServerTool {
 					"type":        "string",
 					"description": "Tag to apply",
 				})),
+				common.WithIfNotExists("firewall"),
+			),
+		},
+		{
+			Handler: f.createFirewallFromTemplate,
+			Tool: mcp.NewTool("firewall-create-from-template",
+				mcp.WithDescription("Create a firewall from a built-in rule template instead of writing its rules out. Every template allows all outbound traffic; the inbound rules are "+
+					firewallTemplatesDescription()+". db-private refuses public Sources, so a database port is never opened to the internet."),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the firewall")),
+				mcp.WithString("Template", mcp.Required(), mcp.Enum(firewallTemplateNames()...), mcp.Description("Rule template of the firewall")),
+				mcp.WithArray("SSHSources", mcp.Description("IP addresses or CIDR blocks allowed to connect over SSH"), mcp.WithStringItems()),
+				mcp.WithString("Engine", mcp.Enum(databaseEngines()...), mcp.Description("Database engine whose port db-private opens")),
+				mcp.WithArray("Sources", mcp.Description("Private IP addresses or CIDR blocks, e.g. the VPC range, db-private allows the database port from"), mcp.WithStringItems()),
+				mcp.WithArray("SourceTags", mcp.Description("Tags of the droplets db-private allows the database port from"), mcp.WithStringItems()),
+				mcp.WithArray("LoadBalancerUIDs", mcp.Description("IDs of the load balancers k8s-nodes allows NodePorts from"), mcp.WithStringItems()),
+				mcp.WithArray("DropletIDs", mcp.Description("Droplet IDs to apply the firewall to"), mcp.Items(map[string]any{
+					"type":        "number",
+					"description": "droplet ID to apply the firewall to",
+				})),
+				mcp.WithArray("Tags", mcp.Description("Tags to apply the firewall to; for k8s-nodes, the tags of the node droplets"), mcp.WithStringItems()),
 			),
 		},
 		{
@@ -601,5 +590,37 @@ func (f *FirewallTool) Tools() []server.ServerTool {
 				})),
 			),
 		},
+		{
+			Handler: f.syncFirewall,
+			Tool: mcp.NewTool("firewall-sync",
+				mcp.WithDescription("Converge the rules of a named firewall on a desired rule set: adds the desired rules it lacks, then removes the rules not desired, and leaves matching rules untouched. The desired set of a direction is complete, so every rule of it not listed is removed; a direction left out is not changed, and an empty array removes all of its rules. Returns the diff; with DryRun the diff is computed but not applied. Running it again with the same rules changes nothing."),
+				common.WithOutputSchema[FirewallSyncResult](),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the firewall")),
+				mcp.WithArray("InboundRules", mcp.Description("Complete desired set of inbound rules. When left out, the inbound rules are not changed; [] removes them all"), mcp.Items(syncRuleSchema("Inbound", "Sources"))),
+				mcp.WithArray("OutboundRules", mcp.Description("Complete desired set of outbound rules. When left out, the outbound rules are not changed; [] removes them all"), mcp.Items(syncRuleSchema("Outbound", "Destinations"))),
+			),
+		},
+	}
+}
+
+// firewallRules reads the InboundRules and OutboundRules arguments of a rules request.
+func firewallRules(args *common.Args) ([]godo.InboundRule, []godo.OutboundRule) {
+	var inboundRules []godo.InboundRule
+	for _, rule := range args.Objects("InboundRules") {
+		inboundRules = append(inboundRules, godo.InboundRule{
+			Protocol:  rule.RequiredString("Protocol"),
+			PortRange: rule.RequiredString("PortRange"),
+			Sources:   &godo.Sources{Addresses: rule.RequiredStrings("Sources")},
+		})
 	}
+
+	var outboundRules []godo.OutboundRule
+	for _, rule := range args.Objects("OutboundRules") {
+		outboundRules = append(outboundRules, godo.OutboundRule{
+			Protocol:     rule.RequiredString("Protocol"),
+			PortRange:    rule.RequiredString("PortRange"),
+			Destinations: &godo.Destinations{Addresses: rule.RequiredStrings("Destinations")},
+		})
+	}
+	return inboundRules, outboundRules
 }
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"testing"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/require"
@@ -187,6 +189,7 @@ func TestFirewallTool_createFirewall(t *testing.T) {
 		args        map[string]any
 		mockSetup   func(*MockFirewallsService)
 		expectError bool
+		existing    bool
 	}{
 		{
 			name: "Successful create",
@@ -265,6 +268,67 @@ func TestFirewallTool_createFirewall(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "IfNotExists returns the existing firewall",
+			args: map[string]any{
+				"Name":                "test-fw",
+				"InboundProtocol":     "tcp",
+				"InboundPortRange":    "80",
+				"InboundSource":       "0.0.0.0/0",
+				"OutboundProtocol":    "udp",
+				"OutboundPortRange":   "53",
+				"OutboundDestination": "8.8.8.8/32",
+				"IfNotExists":         true,
+			},
+			mockSetup: func(m *MockFirewallsService) {
+				m.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return([]godo.Firewall{{ID: "fw-000", Name: "other-fw"}, *testFirewall}, &godo.Response{}, nil).
+					Times(1)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+			},
+			existing: true,
+		},
+		{
+			name: "IfNotExists creates a missing firewall",
+			args: map[string]any{
+				"Name":                "test-fw",
+				"InboundProtocol":     "tcp",
+				"InboundPortRange":    "80",
+				"InboundSource":       "0.0.0.0/0",
+				"OutboundProtocol":    "udp",
+				"OutboundPortRange":   "53",
+				"OutboundDestination": "8.8.8.8/32",
+				"IfNotExists":         true,
+			},
+			mockSetup: func(m *MockFirewallsService) {
+				m.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return([]godo.Firewall{{ID: "fw-000", Name: "other-fw"}}, &godo.Response{}, nil).
+					Times(1)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).Return(testFirewall, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "IfNotExists fails when several firewalls have the name",
+			args: map[string]any{
+				"Name":                "test-fw",
+				"InboundProtocol":     "tcp",
+				"InboundPortRange":    "80",
+				"InboundSource":       "0.0.0.0/0",
+				"OutboundProtocol":    "udp",
+				"OutboundPortRange":   "53",
+				"OutboundDestination": "8.8.8.8/32",
+				"IfNotExists":         true,
+			},
+			mockSetup: func(m *MockFirewallsService) {
+				m.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return([]godo.Firewall{*testFirewall, *testFirewall}, &godo.Response{}, nil).
+					Times(1)
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -287,6 +351,7 @@ func TestFirewallTool_createFirewall(t *testing.T) {
 			var outFirewall godo.Firewall
 			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outFirewall))
 			require.Equal(t, testFirewall.ID, outFirewall.ID)
+			require.Equal(t, tc.existing, resp.Meta != nil && resp.Meta.AdditionalFields[common.ExistingMetaKey] == true)
 		})
 	}
 }
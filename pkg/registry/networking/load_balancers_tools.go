@@ -2,14 +2,22 @@ package networking
 
 import (
 	"context"
+	_ "embed"
 	"encoding/json"
 	"fmt"
+	"net"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
 
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
+//go:embed examples/lb-create.json
+var lbCreateExample []byte
+
 // LoadBalancersTool provides load balancer management tools
 type LoadBalancersTool struct {
 	client func(ctx context.Context) (*godo.Client, error)
@@ -22,52 +30,139 @@ func NewLoadBalancersTool(client func(ctx context.Context) (*godo.Client, error)
 	}
 }
 
-func parseForwardingRules(rules []any) ([]godo.ForwardingRule, *mcp.CallToolResult) {
+// forwardingRuleSchema is the schema of the forwarding rules parseForwardingRules reads.
+var forwardingRuleSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"EntryProtocol":  map[string]any{"type": "string", "enum": []string{"http", "https", "http2", "http3", "tcp", "udp"}, "description": "Protocol of the traffic the load balancer receives"},
+		"EntryPort":      map[string]any{"type": "number", "description": "Port the load balancer receives traffic on"},
+		"TargetProtocol": map[string]any{"type": "string", "enum": []string{"http", "https", "http2", "tcp", "udp"}, "description": "Protocol of the traffic sent to the droplets"},
+		"TargetPort":     map[string]any{"type": "number", "description": "Droplet port the traffic is sent to"},
+		"TlsPassthrough": map[string]any{"type": "boolean", "description": "Whether HTTPS traffic is passed to the droplets without being decrypted"},
+		"CertificateID":  map[string]any{"type": "string", "description": "ID of the TLS certificate the load balancer decrypts HTTPS traffic with"},
+	},
+	"required": []string{"EntryProtocol", "EntryPort", "TargetProtocol", "TargetPort"},
+}
+
+// parseForwardingRules reads the ForwardingRules argument of a load balancer request.
+func parseForwardingRules(args *common.Args) []godo.ForwardingRule {
 	forwardingRules := []godo.ForwardingRule{}
-	for _, ruleData := range rules {
-		rule, ok := ruleData.(map[string]any)
-		if !ok {
-			return nil, mcp.NewToolResultError("invalid rule format")
-		}
+	for _, rule := range args.Objects("ForwardingRules") {
+		forwardingRules = append(forwardingRules, godo.ForwardingRule{
+			EntryProtocol:  rule.RequiredString("EntryProtocol"),
+			EntryPort:      int(rule.RequiredNumber("EntryPort")),
+			TargetProtocol: rule.RequiredString("TargetProtocol"),
+			TargetPort:     int(rule.RequiredNumber("TargetPort")),
+			TlsPassthrough: rule.Bool("TlsPassthrough", false),
+			CertificateID:  rule.String("CertificateID"),
+		})
+	}
+	return forwardingRules
+}
 
-		entryProtocol, ok := rule["EntryProtocol"].(string)
-		if !ok {
-			return nil, mcp.NewToolResultError("EntryProtocol must be a string")
+// parseHealthCheck parses the HealthCheck argument of a load balancer. Settings that are not
+// passed are left to the API defaults.
+func parseHealthCheck(hc map[string]any) (*godo.HealthCheck, *mcp.CallToolResult) {
+	healthCheck := &godo.HealthCheck{}
+	if protocol, ok := hc["Protocol"].(string); ok {
+		switch protocol {
+		case "http", "https", "tcp":
+			healthCheck.Protocol = protocol
+		default:
+			return nil, mcp.NewToolResultError("HealthCheck Protocol must be one of http, https or tcp")
 		}
-		entryPort, ok := rule["EntryPort"].(float64)
-		if !ok {
-			return nil, mcp.NewToolResultError("EntryPort must be a number")
+	}
+	if port, ok := hc["Port"].(float64); ok {
+		healthCheck.Port = int(port)
+	}
+	if path, ok := hc["Path"].(string); ok {
+		if healthCheck.Protocol == "tcp" {
+			return nil, mcp.NewToolResultError("HealthCheck Path is only used by http and https health checks")
 		}
-		targetProtocol, ok := rule["TargetProtocol"].(string)
-		if !ok {
-			return nil, mcp.NewToolResultError("TargetProtocol must be a string")
+		healthCheck.Path = path
+	}
+	for _, setting := range []struct {
+		name  string
+		field *int
+	}{
+		{"CheckIntervalSeconds", &healthCheck.CheckIntervalSeconds},
+		{"ResponseTimeoutSeconds", &healthCheck.ResponseTimeoutSeconds},
+		{"HealthyThreshold", &healthCheck.HealthyThreshold},
+		{"UnhealthyThreshold", &healthCheck.UnhealthyThreshold},
+	} {
+		if val, ok := hc[setting.name].(float64); ok {
+			if val < 1 {
+				return nil, mcp.NewToolResultError(fmt.Sprintf("HealthCheck %s must be at least 1", setting.name))
+			}
+			*setting.field = int(val)
 		}
-		targetPort, ok := rule["TargetPort"].(float64)
+	}
+	if proxyProtocol, ok := hc["ProxyProtocol"].(bool); ok {
+		healthCheck.ProxyProtocol = &proxyProtocol
+	}
+	return healthCheck, nil
+}
+
+// parseStickySessions parses the StickySessions argument of a load balancer.
+func parseStickySessions(ss map[string]any) (*godo.StickySessions, *mcp.CallToolResult) {
+	sessionType, _ := ss["Type"].(string)
+	switch sessionType {
+	case "none":
+		return &godo.StickySessions{Type: sessionType}, nil
+	case "cookies":
+		cookieName, _ := ss["CookieName"].(string)
+		cookieTTL, _ := ss["CookieTtlSeconds"].(float64)
+		if cookieName == "" || cookieTTL < 1 {
+			return nil, mcp.NewToolResultError("StickySessions of type cookies need a CookieName and a CookieTtlSeconds of at least 1")
+		}
+		return &godo.StickySessions{Type: sessionType, CookieName: cookieName, CookieTtlSeconds: int(cookieTTL)}, nil
+	default:
+		return nil, mcp.NewToolResultError("StickySessions Type must be none or cookies")
+	}
+}
+
+// parseFirewallRules parses the Allow or Deny list of a load balancer firewall. Plain IP
+// addresses and CIDRs are accepted as well as rules already in the ip:<address> and
+// cidr:<block> forms of the API.
+func parseFirewallRules(list string, rules []any) ([]string, *mcp.CallToolResult) {
+	parsed := []string{}
+	for _, r := range rules {
+		rule, ok := r.(string)
 		if !ok {
-			return nil, mcp.NewToolResultError("TargetPort must be a number")
+			return nil, mcp.NewToolResultError(fmt.Sprintf("Firewall %s rules must be strings", list))
 		}
-		// set tlsPassthrough to false if not provided
-		tlsPassthrough := false
-		if val, ok := rule["TlsPassthrough"].(bool); ok {
-			tlsPassthrough = val
+		source := strings.TrimPrefix(strings.TrimPrefix(rule, "ip:"), "cidr:")
+		if ip := net.ParseIP(source); ip != nil && !strings.HasPrefix(rule, "cidr:") {
+			parsed = append(parsed, godo.IPSourceFirewall(source))
+			continue
 		}
-		// set the certificate id to empty string if not provided
-		certificateID := ""
-		if val, ok := rule["CertificateID"].(string); ok {
-			certificateID = val
+		if _, _, err := net.ParseCIDR(source); err == nil && !strings.HasPrefix(rule, "ip:") {
+			parsed = append(parsed, godo.CIDRSourceFirewall(source))
+			continue
 		}
+		return nil, mcp.NewToolResultError(fmt.Sprintf("Firewall %s rule %q is not an IP address or CIDR", list, rule))
+	}
+	return parsed, nil
+}
 
-		forwardingRule := godo.ForwardingRule{
-			EntryProtocol:  entryProtocol,
-			EntryPort:      int(entryPort),
-			TargetProtocol: targetProtocol,
-			TargetPort:     int(targetPort),
-			TlsPassthrough: tlsPassthrough,
-			CertificateID:  certificateID,
+// parseFirewall parses the Firewall argument of a load balancer.
+func parseFirewall(fw map[string]any) (*godo.LBFirewall, *mcp.CallToolResult) {
+	firewall := &godo.LBFirewall{}
+	if allow, ok := fw["Allow"].([]any); ok {
+		rules, errResult := parseFirewallRules("Allow", allow)
+		if errResult != nil {
+			return nil, errResult
 		}
-		forwardingRules = append(forwardingRules, forwardingRule)
+		firewall.Allow = rules
 	}
-	return forwardingRules, nil
+	if deny, ok := fw["Deny"].([]any); ok {
+		rules, errResult := parseFirewallRules("Deny", deny)
+		if errResult != nil {
+			return nil, errResult
+		}
+		firewall.Deny = rules
+	}
+	return firewall, nil
 }
 
 func (l *LoadBalancersTool) createLoadBalancer(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -82,6 +177,10 @@ func (l *LoadBalancersTool) createLoadBalancer(ctx context.Context, req mcp.Call
 	sizeUnit, _ := args["SizeUnit"].(float64)
 	networkStack, _ := args["NetworkStack"].(string)
 	projectID, _ := args["ProjectID"].(string)
+	project, _ := args[common.ProjectArg].(string)
+	if projectID != "" && project != "" {
+		return mcp.NewToolResultError("pass either ProjectID or Project, not both"), nil
+	}
 
 	lbr := &godo.LoadBalancerRequest{
 		Name:         name,
@@ -139,13 +238,10 @@ func (l *LoadBalancersTool) createLoadBalancer(ctx context.Context, req mcp.Call
 		lbr.Region = region
 
 		// Parse forwarding rules
-		forwardingRules := []godo.ForwardingRule{}
-		if rules, ok := args["ForwardingRules"]; ok && rules != nil {
-			var err *mcp.CallToolResult
-			forwardingRules, err = parseForwardingRules(rules.([]any))
-			if err != nil {
-				return err, nil
-			}
+		ruleArgs := common.NewArgs(req)
+		forwardingRules := parseForwardingRules(ruleArgs)
+		if err := ruleArgs.Err(); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
 		if len(forwardingRules) == 0 {
@@ -183,6 +279,13 @@ func (l *LoadBalancersTool) createLoadBalancer(ctx context.Context, req mcp.Call
 		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
 	}
 
+	// the load balancer is created in the project, so it never sits in the default project.
+	if project != "" {
+		if lbr.ProjectID, err = common.ResolveProject(ctx, client, project); err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+	}
+
 	lb, _, err := client.LoadBalancers.Create(ctx, lbr)
 	if err != nil {
 		return mcp.NewToolResultErrorFromErr("api error", err), nil
@@ -195,7 +298,11 @@ func (l *LoadBalancersTool) createLoadBalancer(ctx context.Context, req mcp.Call
 }
 
 func (l *LoadBalancersTool) deleteLoadBalancer(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	lbID := req.GetArguments()["LoadBalancerID"].(string)
+	args := common.NewArgs(req)
+	lbID := args.RequiredString("LoadBalancerID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := l.client(ctx)
 	if err != nil {
@@ -211,7 +318,11 @@ func (l *LoadBalancersTool) deleteLoadBalancer(ctx context.Context, req mcp.Call
 }
 
 func (l *LoadBalancersTool) deleteLoadBalancerCache(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	lbID := req.GetArguments()["LoadBalancerID"].(string)
+	args := common.NewArgs(req)
+	lbID := args.RequiredString("LoadBalancerID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := l.client(ctx)
 	if err != nil {
@@ -336,6 +447,49 @@ func (l *LoadBalancersTool) removeDroplets(ctx context.Context, req mcp.CallTool
 	return mcp.NewToolResultText("Droplets removed successfully"), nil
 }
 
+// LoadBalancerPurgeResult is the result of lb-purge.
+type LoadBalancerPurgeResult struct {
+	LoadBalancerID    string `json:"load_balancer_id"`
+	RemovedDropletIDs []int  `json:"removed_droplet_ids"`
+}
+
+// purgeDroplets removes all droplets from a load balancer, e.g. to swap a blue pool of droplets
+// for a green one with lb-add-droplets.
+func (l *LoadBalancersTool) purgeDroplets(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	lbID := args.RequiredString("LoadBalancerID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := l.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	lb, _, err := client.LoadBalancers.Get(ctx, lbID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if lb.Tag != "" {
+		return mcp.NewToolResultError(fmt.Sprintf("Load balancer %s targets the droplets tagged %s; remove the tag from the droplets instead", lbID, lb.Tag)), nil
+	}
+	result := LoadBalancerPurgeResult{LoadBalancerID: lbID, RemovedDropletIDs: []int{}}
+	if len(lb.DropletIDs) > 0 {
+		_, err = client.LoadBalancers.RemoveDroplets(ctx, lbID, lb.DropletIDs...)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		result.RemovedDropletIDs = lb.DropletIDs
+	}
+
+	jsonResult, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonResult)), nil
+}
+
 func (l *LoadBalancersTool) updateLoadBalancer(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	args := req.GetArguments()
 	lbID, ok := args["LoadBalancerID"].(string)
@@ -418,17 +572,36 @@ func (l *LoadBalancersTool) updateLoadBalancer(ctx context.Context, req mcp.Call
 		lbr.Region = region
 
 		// Parse forwarding rules
-		forwardingRules := []godo.ForwardingRule{}
-		if rules, ok := args["ForwardingRules"]; ok && rules != nil {
-			var err *mcp.CallToolResult
-			forwardingRules, err = parseForwardingRules(rules.([]any))
-			if err != nil {
-				return err, nil
-			}
+		ruleArgs := common.NewArgs(req)
+		forwardingRules := parseForwardingRules(ruleArgs)
+		if err := ruleArgs.Err(); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 		lbr.ForwardingRules = forwardingRules
 	}
 
+	if hc, ok := args["HealthCheck"].(map[string]any); ok && len(hc) > 0 {
+		healthCheck, errResult := parseHealthCheck(hc)
+		if errResult != nil {
+			return errResult, nil
+		}
+		lbr.HealthCheck = healthCheck
+	}
+	if ss, ok := args["StickySessions"].(map[string]any); ok && len(ss) > 0 {
+		stickySessions, errResult := parseStickySessions(ss)
+		if errResult != nil {
+			return errResult, nil
+		}
+		lbr.StickySessions = stickySessions
+	}
+	if fw, ok := args["Firewall"].(map[string]any); ok && len(fw) > 0 {
+		firewall, errResult := parseFirewall(fw)
+		if errResult != nil {
+			return errResult, nil
+		}
+		lbr.Firewall = firewall
+	}
+
 	// Target identifiers are optional but only one can be provided
 	tag, _ := args["Tag"].(string)
 	dropletIDs, _ := args["DropletIDs"].([]any)
@@ -475,13 +648,10 @@ func (l *LoadBalancersTool) addForwardingRules(ctx context.Context, req mcp.Call
 	}
 
 	// Parse forwarding rules
-	forwardingRules := []godo.ForwardingRule{}
-	if rules, ok := req.GetArguments()["ForwardingRules"]; ok && rules != nil {
-		var err *mcp.CallToolResult
-		forwardingRules, err = parseForwardingRules(rules.([]any))
-		if err != nil {
-			return err, nil
-		}
+	args := common.NewArgs(req)
+	forwardingRules := parseForwardingRules(args)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 	if len(forwardingRules) == 0 {
 		return mcp.NewToolResultError("At least one forwarding rule must be provided"), nil
@@ -507,13 +677,10 @@ func (l *LoadBalancersTool) removeForwardingRules(ctx context.Context, req mcp.C
 	}
 
 	// Parse forwarding rules
-	forwardingRules := []godo.ForwardingRule{}
-	if rules, ok := req.GetArguments()["ForwardingRules"]; ok && rules != nil {
-		var err *mcp.CallToolResult
-		forwardingRules, err = parseForwardingRules(rules.([]any))
-		if err != nil {
-			return err, nil
-		}
+	args := common.NewArgs(req)
+	forwardingRules := parseForwardingRules(args)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 	if len(forwardingRules) == 0 {
 		return mcp.NewToolResultError("At least one forwarding rule must be provided"), nil
@@ -540,16 +707,18 @@ func (l *LoadBalancersTool) Tools() []server.ServerTool {
 				mcp.WithDescription("Create a new Load Balancer"),
 				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the load balancer")),
 				mcp.WithString("Region", mcp.Description("Region slug (e.g., nyc3)")),
-				mcp.WithArray("DropletIDs", mcp.Description("IDs of the Droplets assigned to the load balancer"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithArray("DropletIDs", mcp.Description("IDs of the Droplets assigned to the load balancer"), mcp.Items(map[string]any{"type": "number"})),
 				mcp.WithString("Tag", mcp.Description("Droplet tag corresponding to Droplets assigned to the load balancer")),
-				mcp.WithArray("ForwardingRules", mcp.Description("Forwarding rules for a load balancer"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithArray("ForwardingRules", mcp.Description("Forwarding rules for a load balancer"), mcp.Items(forwardingRuleSchema)),
 				mcp.WithString("Type", mcp.Description("Type of the load balancer (REGIONAL, REGIONAL_NETWORK, GLOBAL)")),
 				mcp.WithString("Network", mcp.Description("Network type of the load balancer (EXTERNAL, INTERNAL)")),
 				mcp.WithNumber("SizeUnit", mcp.DefaultNumber(2), mcp.Description("Size of the load balancer in units appropriate to its type")),
 				mcp.WithString("NetworkStack", mcp.Description("Network stack of the load balancer (IPV4, DUALSTACK)")),
 				mcp.WithString("ProjectID", mcp.Description("Project ID to which the load balancer will be assigned")),
+				common.WithProject("load balancer"),
 				mcp.WithArray("TargetLoadBalancerIDs", mcp.Description("IDs of the target regional load balancers for a global load balancer"), mcp.Items(map[string]any{"type": "string"})),
 				mcp.WithObject("GLBSettings", mcp.Description("Forward configurations for a global load balancer")),
+				common.WithExample(lbCreateExample),
 			),
 		},
 		{
@@ -579,8 +748,8 @@ func (l *LoadBalancersTool) Tools() []server.ServerTool {
 			Handler: l.listLoadBalancers,
 			Tool: mcp.NewTool("lb-list",
 				mcp.WithDescription("List Load Balancers with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Items per page")),
 			),
 		},
 		{
@@ -588,7 +757,7 @@ func (l *LoadBalancersTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool("lb-add-droplets",
 				mcp.WithDescription("Add Droplets to a Load Balancer"),
 				mcp.WithString("LoadBalancerID", mcp.Required(), mcp.Description("ID of the load balancer")),
-				mcp.WithArray("DropletIDs", mcp.Required(), mcp.Description("IDs of the droplets to add"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithArray("DropletIDs", mcp.Required(), mcp.Description("IDs of the droplets to add"), mcp.Items(map[string]any{"type": "number"})),
 			),
 		},
 		{
@@ -596,7 +765,7 @@ func (l *LoadBalancersTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool("lb-remove-droplets",
 				mcp.WithDescription("Remove Droplets from a Load Balancer"),
 				mcp.WithString("LoadBalancerID", mcp.Required(), mcp.Description("ID of the load balancer")),
-				mcp.WithArray("DropletIDs", mcp.Required(), mcp.Description("IDs of the droplets to remove"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithArray("DropletIDs", mcp.Required(), mcp.Description("IDs of the droplets to remove"), mcp.Items(map[string]any{"type": "number"})),
 			),
 		},
 		{
@@ -606,9 +775,9 @@ func (l *LoadBalancersTool) Tools() []server.ServerTool {
 				mcp.WithString("LoadBalancerID", mcp.Required(), mcp.Description("ID of the load balancer")),
 				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the load balancer")),
 				mcp.WithString("Region", mcp.Description("Region slug (e.g., nyc3)")),
-				mcp.WithArray("DropletIDs", mcp.Description("IDs of the Droplets assigned to the load balancer"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithArray("DropletIDs", mcp.Description("IDs of the Droplets assigned to the load balancer"), mcp.Items(map[string]any{"type": "number"})),
 				mcp.WithString("Tag", mcp.Description("Droplet tag corresponding to Droplets assigned to the load balancer")),
-				mcp.WithArray("ForwardingRules", mcp.Description("Forwarding rules for a load balancer"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithArray("ForwardingRules", mcp.Description("Forwarding rules for a load balancer"), mcp.Items(forwardingRuleSchema)),
 				mcp.WithString("Type", mcp.Required(), mcp.Description("Type of the load balancer (REGIONAL, REGIONAL_NETWORK, GLOBAL)")),
 				mcp.WithString("Network", mcp.Description("Network type of the load balancer (EXTERNAL, INTERNAL)")),
 				mcp.WithNumber("SizeUnit", mcp.DefaultNumber(2), mcp.Description("Size of the load balancer in units appropriate to its type")),
@@ -616,6 +785,42 @@ func (l *LoadBalancersTool) Tools() []server.ServerTool {
 				mcp.WithString("ProjectID", mcp.Description("Project ID to which the load balancer will be assigned")),
 				mcp.WithArray("TargetLoadBalancerIDs", mcp.Description("IDs of the target regional load balancers for a global load balancer"), mcp.Items(map[string]any{"type": "string"})),
 				mcp.WithObject("GLBSettings", mcp.Description("Forward configurations for a global load balancer")),
+				mcp.WithObject("HealthCheck",
+					mcp.Description("Health check the load balancer runs against its droplets"),
+					mcp.Properties(map[string]any{
+						"Protocol":               map[string]any{"type": "string", "enum": []string{"http", "https", "tcp"}, "description": "Protocol of the health check"},
+						"Port":                   map[string]any{"type": "number", "description": "Droplet port the health check connects to"},
+						"Path":                   map[string]any{"type": "string", "description": "Path requested by http and https health checks (e.g., /healthz)"},
+						"CheckIntervalSeconds":   map[string]any{"type": "number", "description": "Seconds between two health checks"},
+						"ResponseTimeoutSeconds": map[string]any{"type": "number", "description": "Seconds to wait for a response before a check fails"},
+						"HealthyThreshold":       map[string]any{"type": "number", "description": "Passed checks in a row before a droplet receives traffic again"},
+						"UnhealthyThreshold":     map[string]any{"type": "number", "description": "Failed checks in a row before a droplet stops receiving traffic"},
+						"ProxyProtocol":          map[string]any{"type": "boolean", "description": "Whether health checks use the PROXY protocol"},
+					}),
+				),
+				mcp.WithObject("StickySessions",
+					mcp.Description("Sticky sessions, which send the requests of a client to the same droplet"),
+					mcp.Properties(map[string]any{
+						"Type":             map[string]any{"type": "string", "enum": []string{"none", "cookies"}, "description": "none disables sticky sessions"},
+						"CookieName":       map[string]any{"type": "string", "description": "Name of the session cookie (cookies only)"},
+						"CookieTtlSeconds": map[string]any{"type": "number", "description": "Lifetime of the session cookie in seconds (cookies only)"},
+					}),
+				),
+				mcp.WithObject("Firewall",
+					mcp.Description("Client IP addresses and CIDRs the load balancer accepts or rejects traffic from"),
+					mcp.Properties(map[string]any{
+						"Allow": map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": "IP addresses or CIDRs (e.g., 203.0.113.7 or 10.0.0.0/8) to accept traffic from"},
+						"Deny":  map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": "IP addresses or CIDRs to reject traffic from"},
+					}),
+				),
+			),
+		},
+		{
+			Handler: l.purgeDroplets,
+			Tool: mcp.NewTool("lb-purge",
+				mcp.WithDestructiveHintAnnotation(true),
+				mcp.WithDescription("Remove all Droplets from a Load Balancer, e.g. to swap the droplets behind it in a blue/green deployment with lb-add-droplets. Not supported for load balancers that target droplets by tag."),
+				mcp.WithString("LoadBalancerID", mcp.Required(), mcp.Description("ID of the load balancer")),
 			),
 		},
 		{
@@ -623,7 +828,7 @@ func (l *LoadBalancersTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool("lb-add-fwd-rules",
 				mcp.WithDescription("Add Forwarding Rules to a Load Balancer"),
 				mcp.WithString("LoadBalancerID", mcp.Required(), mcp.Description("ID of the load balancer")),
-				mcp.WithArray("ForwardingRules", mcp.Required(), mcp.Description("Forwarding rules to add"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithArray("ForwardingRules", mcp.Required(), mcp.Description("Forwarding rules to add"), mcp.Items(forwardingRuleSchema)),
 			),
 		},
 		{
@@ -631,7 +836,7 @@ func (l *LoadBalancersTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool("lb-remove-fwd-rules",
 				mcp.WithDescription("Remove Forwarding Rules from a Load Balancer"),
 				mcp.WithString("LoadBalancerID", mcp.Required(), mcp.Description("ID of the load balancer")),
-				mcp.WithArray("ForwardingRules", mcp.Required(), mcp.Description("Forwarding rules to remove"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithArray("ForwardingRules", mcp.Required(), mcp.Description("Forwarding rules to remove"), mcp.Items(forwardingRuleSchema)),
 			),
 		},
 	}
@@ -10,6 +10,8 @@ import (
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+
+	"mcp-digitalocean/internal/testhelpers"
 )
 
 func setupLoadBalancersToolWithMock(loadBalancers *MockLoadBalancersService) *LoadBalancersTool {
@@ -246,6 +248,17 @@ func TestLoadBalancersTool_createLoadBalancer(t *testing.T) {
 			expectError: true,
 			expectText:  "At least one forwarding rule must be provided",
 		},
+		{
+			name: "ForwardingRules that is not an array",
+			args: map[string]any{
+				"Region":          "nyc3",
+				"Name":            "example-lb",
+				"ForwardingRules": "http:80",
+			},
+			mockSetup:   nil,
+			expectError: true,
+			expectText:  "ForwardingRules must be an array",
+		},
 		{
 			name: "API error",
 			args: map[string]any{
@@ -296,6 +309,65 @@ func TestLoadBalancersTool_createLoadBalancer(t *testing.T) {
 	}
 }
 
+func TestLoadBalancersTool_createLoadBalancerExample(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	args := map[string]any{
+		"Name":       "web-lb",
+		"Region":     "nyc3",
+		"DropletIDs": []any{float64(3164444), float64(3164445)},
+		"ForwardingRules": []any{
+			map[string]any{"EntryProtocol": "http", "EntryPort": float64(80), "TargetProtocol": "http", "TargetPort": float64(8080)},
+			map[string]any{
+				"EntryProtocol":  "https",
+				"EntryPort":      float64(443),
+				"TargetProtocol": "http",
+				"TargetPort":     float64(8080),
+				"CertificateID":  "892071a0-bb95-49bc-8021-3afd67a210bf",
+			},
+		},
+	}
+	rules := []godo.ForwardingRule{
+		{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 8080},
+		{EntryProtocol: "https", EntryPort: 443, TargetProtocol: "http", TargetPort: 8080, CertificateID: "892071a0-bb95-49bc-8021-3afd67a210bf"},
+	}
+	mockLoadBalancers := NewMockLoadBalancersService(ctrl)
+	mockLoadBalancers.EXPECT().
+		Create(gomock.Any(), &godo.LoadBalancerRequest{
+			Name:            "web-lb",
+			Region:          "nyc3",
+			DropletIDs:      []int{3164444, 3164445},
+			ForwardingRules: rules,
+		}).
+		Return(&godo.LoadBalancer{
+			ID:              "4de7ac8b-495b-4884-9a69-1050c6793cd6",
+			Name:            "web-lb",
+			Status:          "new",
+			SizeUnit:        1,
+			Created:         "2026-03-02T17:03:29Z",
+			Region:          &godo.Region{Slug: "nyc3", Name: "New York 3"},
+			ForwardingRules: rules,
+			HealthCheck: &godo.HealthCheck{
+				Protocol:               "http",
+				Port:                   8080,
+				Path:                   "/",
+				CheckIntervalSeconds:   10,
+				ResponseTimeoutSeconds: 5,
+				HealthyThreshold:       3,
+				UnhealthyThreshold:     5,
+			},
+			StickySessions: &godo.StickySessions{Type: "none"},
+			DropletIDs:     []int{3164444, 3164445},
+			VPCUUID:        "c33931f2-a26a-4e61-b85c-4e95a2ec431b",
+		}, nil, nil)
+
+	tool := setupLoadBalancersToolWithMock(mockLoadBalancers)
+	resp, err := tool.createLoadBalancer(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	require.NoError(t, err)
+	testhelpers.CheckExample(t, "examples/lb-create.json", args, resp)
+}
+
 func TestLoadBalancersTool_deleteLoadBalancer(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -738,6 +810,80 @@ func TestLoadBalancersTool_removeDroplets(t *testing.T) {
 	}
 }
 
+func TestLoadBalancersTool_purgeDroplets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		mockSetup     func(m *MockLoadBalancersService)
+		expectError   bool
+		expectRemoved []int
+	}{
+		{
+			name: "Removes all droplets",
+			args: map[string]any{"LoadBalancerID": "12345"},
+			mockSetup: func(m *MockLoadBalancersService) {
+				m.EXPECT().Get(gomock.Any(), "12345").Return(&godo.LoadBalancer{ID: "12345", DropletIDs: []int{111, 222}}, nil, nil).Times(1)
+				m.EXPECT().RemoveDroplets(gomock.Any(), "12345", []int{111, 222}).Return(nil, nil).Times(1)
+			},
+			expectRemoved: []int{111, 222},
+		},
+		{
+			name: "No droplets to remove",
+			args: map[string]any{"LoadBalancerID": "12345"},
+			mockSetup: func(m *MockLoadBalancersService) {
+				m.EXPECT().Get(gomock.Any(), "12345").Return(&godo.LoadBalancer{ID: "12345"}, nil, nil).Times(1)
+			},
+			expectRemoved: []int{},
+		},
+		{
+			name: "Tag targeted load balancer",
+			args: map[string]any{"LoadBalancerID": "12345"},
+			mockSetup: func(m *MockLoadBalancersService) {
+				m.EXPECT().Get(gomock.Any(), "12345").Return(&godo.LoadBalancer{ID: "12345", Tag: "web", DropletIDs: []int{111}}, nil, nil).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name: "API error",
+			args: map[string]any{"LoadBalancerID": "12345"},
+			mockSetup: func(m *MockLoadBalancersService) {
+				m.EXPECT().Get(gomock.Any(), "12345").Return(&godo.LoadBalancer{ID: "12345", DropletIDs: []int{111}}, nil, nil).Times(1)
+				m.EXPECT().RemoveDroplets(gomock.Any(), "12345", []int{111}).Return(nil, errors.New("api error")).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing load balancer ID argument",
+			args:        map[string]any{},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockLoadBalancers := NewMockLoadBalancersService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockLoadBalancers)
+			}
+			tool := setupLoadBalancersToolWithMock(mockLoadBalancers)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.purgeDroplets(context.Background(), req)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			var result LoadBalancerPurgeResult
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+			require.Equal(t, tc.expectRemoved, result.RemovedDropletIDs)
+		})
+	}
+}
+
 func TestLoadBalancersTool_updateLoadBalancer(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -793,6 +939,86 @@ func TestLoadBalancersTool_updateLoadBalancer(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			name: "Successful update with health check, sticky sessions and firewall",
+			args: map[string]any{
+				"LoadBalancerID": "12345",
+				"Name":           "example-lb-updated",
+				"Type":           "REGIONAL",
+				"Region":         "nyc3",
+				"HealthCheck": map[string]any{
+					"Protocol":             "http",
+					"Port":                 float64(8080),
+					"Path":                 "/healthz",
+					"CheckIntervalSeconds": float64(10),
+					"UnhealthyThreshold":   float64(3),
+				},
+				"StickySessions": map[string]any{"Type": "cookies", "CookieName": "DO-LB", "CookieTtlSeconds": float64(300)},
+				"Firewall": map[string]any{
+					"Allow": []any{"203.0.113.7", "cidr:10.0.0.0/8"},
+					"Deny":  []any{"198.51.100.0/24"},
+				},
+			},
+			mockSetup: func(m *MockLoadBalancersService) {
+				m.EXPECT().
+					Update(gomock.Any(), "12345", &godo.LoadBalancerRequest{
+						Region:          "nyc3",
+						Name:            "example-lb-updated",
+						Type:            "REGIONAL",
+						ForwardingRules: []godo.ForwardingRule{},
+						HealthCheck: &godo.HealthCheck{
+							Protocol:             "http",
+							Port:                 8080,
+							Path:                 "/healthz",
+							CheckIntervalSeconds: 10,
+							UnhealthyThreshold:   3,
+						},
+						StickySessions: &godo.StickySessions{Type: "cookies", CookieName: "DO-LB", CookieTtlSeconds: 300},
+						Firewall: &godo.LBFirewall{
+							Allow: []string{"ip:203.0.113.7", "cidr:10.0.0.0/8"},
+							Deny:  []string{"cidr:198.51.100.0/24"},
+						},
+					}).
+					Return(testLoadBalancer, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "Invalid health check protocol",
+			args: map[string]any{
+				"LoadBalancerID": "12345",
+				"Name":           "example-lb-updated",
+				"Type":           "REGIONAL",
+				"Region":         "nyc3",
+				"HealthCheck":    map[string]any{"Protocol": "udp"},
+			},
+			expectError: true,
+			expectText:  "HealthCheck Protocol must be one of http, https or tcp",
+		},
+		{
+			name: "Cookie sticky sessions without cookie name",
+			args: map[string]any{
+				"LoadBalancerID": "12345",
+				"Name":           "example-lb-updated",
+				"Type":           "REGIONAL",
+				"Region":         "nyc3",
+				"StickySessions": map[string]any{"Type": "cookies", "CookieTtlSeconds": float64(300)},
+			},
+			expectError: true,
+			expectText:  "CookieName",
+		},
+		{
+			name: "Invalid firewall rule",
+			args: map[string]any{
+				"LoadBalancerID": "12345",
+				"Name":           "example-lb-updated",
+				"Type":           "REGIONAL",
+				"Region":         "nyc3",
+				"Firewall":       map[string]any{"Deny": []any{"ip:10.0.0.0/8"}},
+			},
+			expectError: true,
+			expectText:  "is not an IP address or CIDR",
+		},
 		{
 			name: "Successful update Global Load Balancer",
 			args: map[string]any{
@@ -974,6 +1200,26 @@ func TestLoadBalancersTool_updateLoadBalancer(t *testing.T) {
 			expectError: true,
 			expectText:  "Region is required for REGIONAL and REGIONAL_NETWORK load balancers",
 		},
+		{
+			name: "Forwarding rule with a mistyped port",
+			args: map[string]any{
+				"LoadBalancerID": "12345",
+				"Name":           "example-lb",
+				"Type":           "REGIONAL",
+				"Region":         "nyc3",
+				"ForwardingRules": []any{
+					map[string]any{
+						"EntryProtocol":  "http",
+						"EntryPort":      "eighty",
+						"TargetProtocol": "http",
+						"TargetPort":     float64(80),
+					},
+				},
+			},
+			mockSetup:   nil,
+			expectError: true,
+			expectText:  "ForwardingRules[0].EntryPort must be a number",
+		},
 		{
 			name: "Both DropletIDs and Tag arguments cannot be provided",
 			args: map[string]any{
@@ -1154,6 +1400,15 @@ func TestLoadBalancersTool_addForwardingRules(t *testing.T) {
 			expectError: true,
 			expectText:  "Forwarding Rules are required",
 		},
+		{
+			name: "ForwardingRules that is not an array",
+			args: map[string]any{
+				"LoadBalancerID":  "12345",
+				"ForwardingRules": map[string]any{"EntryProtocol": "http"},
+			},
+			mockSetup:   nil,
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1269,6 +1524,15 @@ func TestLoadBalancersTool_removeForwardingRules(t *testing.T) {
 			expectError: true,
 			expectText:  "At least one forwarding rule must be provided",
 		},
+		{
+			name: "Forwarding rule that is not an object",
+			args: map[string]any{
+				"LoadBalancerID":  "12345",
+				"ForwardingRules": []any{"http:80"},
+			},
+			mockSetup:   nil,
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1292,3 +1556,57 @@ func TestLoadBalancersTool_removeForwardingRules(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadBalancersTool_createLoadBalancerInProject(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockLoadBalancersService, *MockProjectsService)
+		expectError bool
+	}{
+		{
+			name: "Project name is created into",
+			args: map[string]any{"Name": "lb", "Region": "nyc3", "Project": "web", "ForwardingRules": []any{map[string]any{"EntryProtocol": "http", "EntryPort": float64(80), "TargetProtocol": "http", "TargetPort": float64(80)}}},
+			mockSetup: func(l *MockLoadBalancersService, p *MockProjectsService) {
+				p.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Project{{ID: "p-1", Name: "web"}}, nil, nil)
+				l.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, lbr *godo.LoadBalancerRequest) (*godo.LoadBalancer, *godo.Response, error) {
+						require.Equal(t, "p-1", lbr.ProjectID)
+						return &godo.LoadBalancer{ID: "lb-1"}, nil, nil
+					})
+			},
+		},
+		{
+			name:        "Project and ProjectID",
+			args:        map[string]any{"Name": "lb", "Project": "web", "ProjectID": "p-1"},
+			expectError: true,
+		},
+		{
+			name: "Unknown project",
+			args: map[string]any{"Name": "lb", "Project": "prod", "Region": "nyc3", "ForwardingRules": []any{map[string]any{"EntryProtocol": "http", "EntryPort": float64(80), "TargetProtocol": "http", "TargetPort": float64(80)}}},
+			mockSetup: func(l *MockLoadBalancersService, p *MockProjectsService) {
+				p.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockLBs := NewMockLoadBalancersService(ctrl)
+			mockProjects := NewMockProjectsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockLBs, mockProjects)
+			}
+			tool := NewLoadBalancersTool(func(ctx context.Context) (*godo.Client, error) {
+				return &godo.Client{LoadBalancers: mockLBs, Projects: mockProjects}, nil
+			})
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.createLoadBalancer(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, resp.IsError)
+		})
+	}
+}
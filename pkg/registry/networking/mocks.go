@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/digitalocean/godo (interfaces: CertificatesService,DomainsService,FirewallsService,LoadBalancersService,PartnerAttachmentService,ReservedIPsService,ReservedIPV6sService,ReservedIPActionsService,ReservedIPV6ActionsService,VPCsService,BYOIPPrefixesService)
+// Source: github.com/digitalocean/godo (interfaces: CertificatesService,DomainsService,FirewallsService,LoadBalancersService,PartnerAttachmentService,ReservedIPsService,ReservedIPV6sService,ReservedIPActionsService,ReservedIPV6ActionsService,VPCsService,BYOIPPrefixesService,ProjectsService)
 //
 // Generated by this command:
 //
-//	mockgen -destination=./mocks.go -package networking github.com/digitalocean/godo CertificatesService,DomainsService,FirewallsService,LoadBalancersService,PartnerAttachmentService,ReservedIPsService,ReservedIPV6sService,ReservedIPActionsService,ReservedIPV6ActionsService,VPCsService,BYOIPPrefixesService
+//	mockgen -destination=./mocks.go -package networking github.com/digitalocean/godo CertificatesService,DomainsService,FirewallsService,LoadBalancersService,PartnerAttachmentService,ReservedIPsService,ReservedIPV6sService,ReservedIPActionsService,ReservedIPV6ActionsService,VPCsService,BYOIPPrefixesService,ProjectsService
 //
 
 // Package networking is a generated GoMock package.
@@ -1663,3 +1663,159 @@ func (mr *MockBYOIPPrefixesServiceMockRecorder) Update(arg0, arg1, arg2 any) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBYOIPPrefixesService)(nil).Update), arg0, arg1, arg2)
 }
+
+// MockProjectsService is a mock of ProjectsService interface.
+type MockProjectsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectsServiceMockRecorder is the mock recorder for MockProjectsService.
+type MockProjectsServiceMockRecorder struct {
+	mock *MockProjectsService
+}
+
+// NewMockProjectsService creates a new mock instance.
+func NewMockProjectsService(ctrl *gomock.Controller) *MockProjectsService {
+	mock := &MockProjectsService{ctrl: ctrl}
+	mock.recorder = &MockProjectsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectsService) EXPECT() *MockProjectsServiceMockRecorder {
+	return m.recorder
+}
+
+// AssignResources mocks base method.
+func (m *MockProjectsService) AssignResources(arg0 context.Context, arg1 string, arg2 ...any) ([]godo.ProjectResource, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AssignResources", varargs...)
+	ret0, _ := ret[0].([]godo.ProjectResource)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AssignResources indicates an expected call of AssignResources.
+func (mr *MockProjectsServiceMockRecorder) AssignResources(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignResources", reflect.TypeOf((*MockProjectsService)(nil).AssignResources), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockProjectsService) Create(arg0 context.Context, arg1 *godo.CreateProjectRequest) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectsService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockProjectsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProjectsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectsService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockProjectsService) Get(arg0 context.Context, arg1 string) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProjectsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProjectsService)(nil).Get), arg0, arg1)
+}
+
+// GetDefault mocks base method.
+func (m *MockProjectsService) GetDefault(arg0 context.Context) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefault", arg0)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDefault indicates an expected call of GetDefault.
+func (mr *MockProjectsServiceMockRecorder) GetDefault(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefault", reflect.TypeOf((*MockProjectsService)(nil).GetDefault), arg0)
+}
+
+// List mocks base method.
+func (m *MockProjectsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectsService)(nil).List), arg0, arg1)
+}
+
+// ListResources mocks base method.
+func (m *MockProjectsService) ListResources(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.ProjectResource, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.ProjectResource)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockProjectsServiceMockRecorder) ListResources(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockProjectsService)(nil).ListResources), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockProjectsService) Update(arg0 context.Context, arg1 string, arg2 *godo.UpdateProjectRequest) (*godo.Project, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Project)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProjectsServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectsService)(nil).Update), arg0, arg1, arg2)
+}
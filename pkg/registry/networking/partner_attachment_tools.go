@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -21,9 +23,13 @@ func NewPartnerAttachmentTool(client func(ctx context.Context) (*godo.Client, er
 }
 
 func (p *PartnerAttachmentTool) createPartnerAttachment(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	name := req.GetArguments()["Name"].(string)
-	region := req.GetArguments()["Region"].(string)
-	bandwidth := int(req.GetArguments()["Bandwidth"].(float64))
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	region := args.RequiredString("Region")
+	bandwidth := int(args.RequiredNumber("Bandwidth"))
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	createRequest := &godo.PartnerAttachmentCreateRequest{
 		Name:                      name,
@@ -100,7 +106,11 @@ func (p *PartnerAttachmentTool) listPartnerAttachments(ctx context.Context, req
 }
 
 func (p *PartnerAttachmentTool) deletePartnerAttachment(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	id := req.GetArguments()["ID"].(string)
+	args := common.NewArgs(req)
+	id := args.RequiredString("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := p.client(ctx)
 	if err != nil {
@@ -115,7 +125,11 @@ func (p *PartnerAttachmentTool) deletePartnerAttachment(ctx context.Context, req
 }
 
 func (p *PartnerAttachmentTool) getServiceKey(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	id := req.GetArguments()["ID"].(string)
+	args := common.NewArgs(req)
+	id := args.RequiredString("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := p.client(ctx)
 	if err != nil {
@@ -136,7 +150,11 @@ func (p *PartnerAttachmentTool) getServiceKey(ctx context.Context, req mcp.CallT
 }
 
 func (p *PartnerAttachmentTool) getBGPConfig(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	id := req.GetArguments()["ID"].(string)
+	args := common.NewArgs(req)
+	id := args.RequiredString("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := p.client(ctx)
 	if err != nil {
@@ -157,9 +175,13 @@ func (p *PartnerAttachmentTool) getBGPConfig(ctx context.Context, req mcp.CallTo
 }
 
 func (p *PartnerAttachmentTool) updatePartnerAttachment(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	id := req.GetArguments()["ID"].(string)
-	name := req.GetArguments()["Name"].(string)
-	vpcIDs := req.GetArguments()["VPCIDs"].([]any)
+	args := common.NewArgs(req)
+	id := args.RequiredString("ID")
+	name := args.RequiredString("Name")
+	vpcIDs := args.RequiredList("VPCIDs")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 	vpcIDsStr := make([]string, len(vpcIDs))
 	for i, v := range vpcIDs {
 		if vStr, ok := v.(string); ok {
@@ -203,8 +225,8 @@ func (p *PartnerAttachmentTool) Tools() []server.ServerTool {
 			Handler: p.listPartnerAttachments,
 			Tool: mcp.NewTool("partner-attachment-list",
 				mcp.WithDescription("List partner attachments with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Items per page")),
 			),
 		},
 		{
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/netip"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -70,10 +72,15 @@ func (t *ReservedIPTool) listReservedIPs(ctx context.Context, req mcp.CallToolRe
 		perPage = int(v)
 	}
 
+	args := common.NewArgs(req)
+	ipType := args.RequiredString("Type") // "ipv4" or "ipv6"
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	opts := &godo.ListOptions{Page: page, PerPage: perPage}
 	var ips any
 	var err error
-	ipType := req.GetArguments()["Type"].(string) // "ipv4" or "ipv6"
 
 	client, err := t.client(ctx)
 	if err != nil {
@@ -100,8 +107,12 @@ func (t *ReservedIPTool) listReservedIPs(ctx context.Context, req mcp.CallToolRe
 
 // reserveIP reserves a new IPv4 or IPv6
 func (t *ReservedIPTool) reserveIP(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	region := req.GetArguments()["Region"].(string)
-	ipType := req.GetArguments()["Type"].(string) // "ipv4" or "ipv6"
+	args := common.NewArgs(req)
+	region := args.RequiredString("Region")
+	ipType := args.RequiredString("Type") // "ipv4" or "ipv6"
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	var reservedIP any
 	var err error
@@ -134,8 +145,12 @@ func (t *ReservedIPTool) reserveIP(ctx context.Context, req mcp.CallToolRequest)
 
 // releaseIP releases a reserved IPv4 or IPv6
 func (t *ReservedIPTool) releaseIP(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	ip := req.GetArguments()["IP"].(string)
-	ipType := req.GetArguments()["Type"].(string) // "ipv4" or "ipv6"
+	args := common.NewArgs(req)
+	ip := args.RequiredString("IP")
+	ipType := args.RequiredString("Type") // "ipv4" or "ipv6"
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	var err error
 
@@ -162,9 +177,13 @@ func (t *ReservedIPTool) releaseIP(ctx context.Context, req mcp.CallToolRequest)
 
 // assignIP assigns a reserved IP to a droplet
 func (t *ReservedIPTool) assignIP(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	ip := req.GetArguments()["IP"].(string)
-	dropletID := int(req.GetArguments()["DropletID"].(float64))
-	ipType := req.GetArguments()["Type"].(string) // "ipv4" or "ipv6"
+	args := common.NewArgs(req)
+	ip := args.RequiredString("IP")
+	dropletID := int(args.RequiredNumber("DropletID"))
+	ipType := args.RequiredString("Type") // "ipv4" or "ipv6"
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	var action *godo.Action
 	var err error
@@ -197,8 +216,12 @@ func (t *ReservedIPTool) assignIP(ctx context.Context, req mcp.CallToolRequest)
 
 // unassignIP unassigns a reserved IP from a droplet
 func (t *ReservedIPTool) unassignIP(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	ip := req.GetArguments()["IP"].(string)
-	ipType := req.GetArguments()["Type"].(string) // "ipv4" or "ipv6"
+	args := common.NewArgs(req)
+	ip := args.RequiredString("IP")
+	ipType := args.RequiredString("Type") // "ipv4" or "ipv6"
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	var action *godo.Action
 	var err error
@@ -244,8 +267,8 @@ func (t *ReservedIPTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool("reserved-ip-list",
 				mcp.WithDescription("List reserved IPv4 or IPv6 addresses with pagination"),
 				mcp.WithString("Type", mcp.Required(), mcp.Description("Type of IP to list ('ipv4' or 'ipv6')")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number (default: 1)")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Items per page (default: 20)")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number (default: 1)")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Items per page (default: 20)")),
 			),
 		},
 		{
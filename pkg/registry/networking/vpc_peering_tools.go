@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -71,11 +73,13 @@ func (t *VPCPeeringTool) listVPCPeerings(ctx context.Context, req mcp.CallToolRe
 }
 
 func (t *VPCPeeringTool) createPeering(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.GetArguments()
-
-	peeringName := args["Name"].(string)
-	vpc1 := args["Vpc1"].(string)
-	vpc2 := args["Vpc2"].(string)
+	args := common.NewArgs(req)
+	peeringName := args.RequiredString("Name")
+	vpc1 := args.RequiredString("Vpc1")
+	vpc2 := args.RequiredString("Vpc2")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := t.client(ctx)
 	if err != nil {
@@ -100,9 +104,11 @@ func (t *VPCPeeringTool) createPeering(ctx context.Context, req mcp.CallToolRequ
 }
 
 func (t *VPCPeeringTool) deletePeering(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.GetArguments()
-
-	peeringID := args["ID"].(string)
+	args := common.NewArgs(req)
+	peeringID := args.RequiredString("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := t.client(ctx)
 	if err != nil {
@@ -131,8 +137,8 @@ func (t *VPCPeeringTool) Tools() []server.ServerTool {
 			Handler: t.listVPCPeerings,
 			Tool: mcp.NewTool("vpc-peering-list",
 				mcp.WithDescription("List VPC Peering connections with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Items per page")),
 			),
 		},
 		{
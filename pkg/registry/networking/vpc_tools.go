@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -74,8 +76,12 @@ func (v *VPCTool) listVPCs(ctx context.Context, req mcp.CallToolRequest) (*mcp.C
 
 // createVPC creates a new VPC
 func (v *VPCTool) createVPC(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	name := req.GetArguments()["Name"].(string)
-	region := req.GetArguments()["Region"].(string)
+	args := common.NewArgs(req)
+	name := args.RequiredString("Name")
+	region := args.RequiredString("Region")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	createRequest := &godo.VPCCreateRequest{
 		Name:       name,
@@ -112,7 +118,11 @@ func (v *VPCTool) createVPC(ctx context.Context, req mcp.CallToolRequest) (*mcp.
 
 // listVPCMembers lists members of a VPC
 func (v *VPCTool) listVPCMembers(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	vpcID := req.GetArguments()["ID"].(string)
+	args := common.NewArgs(req)
+	vpcID := args.RequiredString("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := v.client(ctx)
 	if err != nil {
@@ -134,7 +144,11 @@ func (v *VPCTool) listVPCMembers(ctx context.Context, req mcp.CallToolRequest) (
 
 // deleteVPC deletes a VPC
 func (v *VPCTool) deleteVPC(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	vpcID := req.GetArguments()["ID"].(string)
+	args := common.NewArgs(req)
+	vpcID := args.RequiredString("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := v.client(ctx)
 	if err != nil {
@@ -163,8 +177,8 @@ func (v *VPCTool) Tools() []server.ServerTool {
 			Handler: v.listVPCs,
 			Tool: mcp.NewTool("vpc-list",
 				mcp.WithDescription("List VPCs with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Items per page")),
 			),
 		},
 		{
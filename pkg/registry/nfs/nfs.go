@@ -288,8 +288,8 @@ func (n *NfsTool) Tools() []server.ServerTool {
 				"nfs-file-share-list",
 				mcp.WithDescription("List nfs file shares with optional Region filters. Supports pagination."),
 				mcp.WithString("Region", mcp.Description("Optional region filtering parameter")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number of the results to fetch")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Number of items returned per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number of the results to fetch")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Number of items returned per page")),
 			),
 		},
 		{
@@ -315,8 +315,8 @@ func (n *NfsTool) Tools() []server.ServerTool {
 				mcp.WithDescription("List all NFS snapshots - supports pagination and filtering by region and share ID"),
 				mcp.WithString("Region", mcp.Description("Optional region of the NFS snapshot")),
 				mcp.WithString("ShareID", mcp.Description("Optional ID of the NFS share to list snapshots for")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number of the results to fetch")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Number of items returned per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number of the results to fetch")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Number of items returned per page")),
 			),
 		},
 		{
@@ -0,0 +1,3 @@
+package plan
+
+//go:generate mockgen -destination=./mocks.go -package plan github.com/digitalocean/godo DropletsService,StorageService,StorageActionsService,DomainsService
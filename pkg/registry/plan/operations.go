@@ -0,0 +1,222 @@
+package plan
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+// operationType describes how an operation is applied and how it is undone.
+type operationType struct {
+	description string
+	required    []string
+	outputs     []string
+	apply       func(ctx context.Context, w *waiter, client *godo.Client, params map[string]any) (map[string]any, error)
+	rollback    func(ctx context.Context, w *waiter, client *godo.Client, params, outputs map[string]any) error
+}
+
+// operationTypes are the operations a plan can contain.
+var operationTypes = map[string]operationType{
+	"droplet-create": {
+		description: "Create a droplet and wait until it is active. Params: Name, Region, Size, ImageSlug, optional SSHKeys, Tags, VPCUUID, UserData.",
+		required:    []string{"Name", "Region", "Size", "ImageSlug"},
+		outputs:     []string{"id", "name", "ipv4", "private_ipv4"},
+		apply:       applyDropletCreate,
+		rollback: func(ctx context.Context, w *waiter, client *godo.Client, params, outputs map[string]any) error {
+			_, err := client.Droplets.Delete(ctx, intParam(outputs, "id"))
+			return err
+		},
+	},
+	"volume-create": {
+		description: "Create a block storage volume. Params: Name, Region, SizeGigaBytes, optional FilesystemType.",
+		required:    []string{"Name", "Region", "SizeGigaBytes"},
+		outputs:     []string{"id", "name"},
+		apply: func(ctx context.Context, w *waiter, client *godo.Client, params map[string]any) (map[string]any, error) {
+			volume, _, err := client.Storage.CreateVolume(ctx, &godo.VolumeCreateRequest{
+				Name:           stringParam(params, "Name"),
+				Region:         stringParam(params, "Region"),
+				SizeGigaBytes:  int64(intParam(params, "SizeGigaBytes")),
+				FilesystemType: stringParam(params, "FilesystemType"),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return map[string]any{"id": volume.ID, "name": volume.Name}, nil
+		},
+		rollback: func(ctx context.Context, w *waiter, client *godo.Client, params, outputs map[string]any) error {
+			_, err := client.Storage.DeleteVolume(ctx, stringParam(outputs, "id"))
+			return err
+		},
+	},
+	"volume-attach": {
+		description: "Attach a volume to a droplet and wait for the action to complete. Params: VolumeID, DropletID.",
+		required:    []string{"VolumeID", "DropletID"},
+		outputs:     []string{"action_id"},
+		apply: func(ctx context.Context, w *waiter, client *godo.Client, params map[string]any) (map[string]any, error) {
+			volumeID := stringParam(params, "VolumeID")
+			action, _, err := client.StorageActions.Attach(ctx, volumeID, intParam(params, "DropletID"))
+			if err != nil {
+				return nil, err
+			}
+			if err := w.volumeAction(ctx, client, volumeID, action.ID); err != nil {
+				return nil, err
+			}
+			return map[string]any{"action_id": action.ID}, nil
+		},
+		rollback: func(ctx context.Context, w *waiter, client *godo.Client, params, outputs map[string]any) error {
+			volumeID := stringParam(params, "VolumeID")
+			action, _, err := client.StorageActions.DetachByDropletID(ctx, volumeID, intParam(params, "DropletID"))
+			if err != nil {
+				return err
+			}
+			// the volume can only be deleted once the detach has completed.
+			return w.volumeAction(ctx, client, volumeID, action.ID)
+		},
+	},
+	"dns-record-create": {
+		description: "Add a DNS record to a domain. Params: Domain, Type, Name, Data, optional TTL, Priority.",
+		required:    []string{"Domain", "Type", "Name", "Data"},
+		outputs:     []string{"id"},
+		apply: func(ctx context.Context, w *waiter, client *godo.Client, params map[string]any) (map[string]any, error) {
+			record, _, err := client.Domains.CreateRecord(ctx, stringParam(params, "Domain"), &godo.DomainRecordEditRequest{
+				Type:     stringParam(params, "Type"),
+				Name:     stringParam(params, "Name"),
+				Data:     stringParam(params, "Data"),
+				TTL:      intParam(params, "TTL"),
+				Priority: intParam(params, "Priority"),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return map[string]any{"id": record.ID}, nil
+		},
+		rollback: func(ctx context.Context, w *waiter, client *godo.Client, params, outputs map[string]any) error {
+			_, err := client.Domains.DeleteRecord(ctx, stringParam(params, "Domain"), intParam(outputs, "id"))
+			return err
+		},
+	},
+}
+
+// supportedTypes returns the sorted, comma-separated operation types.
+func supportedTypes() string {
+	types := make([]string, 0, len(operationTypes))
+	for t := range operationTypes {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return strings.Join(types, ", ")
+}
+
+func applyDropletCreate(ctx context.Context, w *waiter, client *godo.Client, params map[string]any) (map[string]any, error) {
+	createReq := &godo.DropletCreateRequest{
+		Name:     stringParam(params, "Name"),
+		Region:   stringParam(params, "Region"),
+		Size:     stringParam(params, "Size"),
+		Image:    godo.DropletCreateImage{Slug: stringParam(params, "ImageSlug")},
+		Tags:     stringsParam(params, "Tags"),
+		VPCUUID:  stringParam(params, "VPCUUID"),
+		UserData: stringParam(params, "UserData"),
+	}
+	if keys, ok := params["SSHKeys"].([]any); ok {
+		for _, key := range keys {
+			switch v := key.(type) {
+			case float64:
+				createReq.SSHKeys = append(createReq.SSHKeys, godo.DropletCreateSSHKey{ID: int(v)})
+			case string:
+				createReq.SSHKeys = append(createReq.SSHKeys, godo.DropletCreateSSHKey{Fingerprint: v})
+			}
+		}
+	}
+
+	droplet, _, err := client.Droplets.Create(ctx, createReq)
+	if err != nil {
+		return nil, err
+	}
+	outputs := map[string]any{"id": droplet.ID, "name": droplet.Name}
+	active, err := w.dropletActive(ctx, client, droplet.ID)
+	if err != nil {
+		// the droplet exists, so it is rolled back along with the applied operations.
+		return outputs, err
+	}
+	outputs["ipv4"], _ = active.PublicIPv4()
+	outputs["private_ipv4"], _ = active.PrivateIPv4()
+	return outputs, nil
+}
+
+// waiter polls the API until asynchronous operations settle.
+type waiter struct {
+	interval time.Duration
+	timeout  time.Duration
+}
+
+func (w *waiter) dropletActive(ctx context.Context, client *godo.Client, id int) (*godo.Droplet, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+	for {
+		droplet, _, err := client.Droplets.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if droplet.Status == "active" {
+			return droplet, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("droplet %d is still %s: %w", id, droplet.Status, ctx.Err())
+		case <-time.After(w.interval):
+		}
+	}
+}
+
+func (w *waiter) volumeAction(ctx context.Context, client *godo.Client, volumeID string, actionID int) error {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+	for {
+		action, _, err := client.StorageActions.Get(ctx, volumeID, actionID)
+		if err != nil {
+			return err
+		}
+		switch action.Status {
+		case godo.ActionCompleted:
+			return nil
+		case "errored":
+			return fmt.Errorf("volume action %d errored", actionID)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("volume action %d is still %s: %w", actionID, action.Status, ctx.Err())
+		case <-time.After(w.interval):
+		}
+	}
+}
+
+func stringParam(params map[string]any, key string) string {
+	s, _ := params[key].(string)
+	return s
+}
+
+// intParam accepts JSON numbers from the caller and ints from the outputs of other operations.
+func intParam(params map[string]any, key string) int {
+	switch v := params[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	}
+	return 0
+}
+
+func stringsParam(params map[string]any, key string) []string {
+	raw, _ := params[key].([]any)
+	var out []string
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,263 @@
+package plan
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	middleware "mcp-digitalocean/internal"
+
+	"github.com/google/uuid"
+)
+
+// Plan statuses.
+const (
+	StatusPending        = "pending"
+	StatusApplying       = "applying"
+	StatusApplied        = "applied"
+	StatusRolledBack     = "rolled_back"
+	StatusRollbackFailed = "rollback_failed"
+)
+
+// Operation statuses. An operation that was never reached after a failure is skipped.
+const (
+	OperationPending        = "pending"
+	OperationApplied        = "applied"
+	OperationFailed         = "failed"
+	OperationRolledBack     = "rolled_back"
+	OperationRollbackFailed = "rollback_failed"
+	OperationSkipped        = "skipped"
+)
+
+// maxPlansPerCaller bounds the plans kept in memory for one caller; the oldest are dropped first.
+const maxPlansPerCaller = 50
+
+// referencePattern matches a parameter value that refers to an output of another operation, e.g. ${web.id}.
+var referencePattern = regexp.MustCompile(`^\$\{([A-Za-z0-9_-]+)\.([A-Za-z0-9_]+)\}$`)
+
+// Operation is one intended change in a plan.
+type Operation struct {
+	ID        string         `json:"id"`
+	Type      string         `json:"type"`
+	Params    map[string]any `json:"params"`
+	DependsOn []string       `json:"depends_on,omitempty"`
+	Status    string         `json:"status"`
+	Outputs   map[string]any `json:"outputs,omitempty"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// Plan is a reviewable set of operations applied in dependency order.
+type Plan struct {
+	ID         string       `json:"id"`
+	Name       string       `json:"name,omitempty"`
+	Status     string       `json:"status"`
+	CreatedAt  time.Time    `json:"created_at"`
+	AppliedAt  *time.Time   `json:"applied_at,omitempty"`
+	Order      []string     `json:"order"`
+	Operations []*Operation `json:"operations"`
+}
+
+// operation returns the operation with the given ID, or nil.
+func (p *Plan) operation(id string) *Operation {
+	for _, op := range p.Operations {
+		if op.ID == id {
+			return op
+		}
+	}
+	return nil
+}
+
+// dependencies returns the explicit and referenced dependencies of op, sorted.
+func dependencies(op *Operation) []string {
+	deps := slices.Clone(op.DependsOn)
+	for _, value := range op.Params {
+		if s, ok := value.(string); ok {
+			if m := referencePattern.FindStringSubmatch(s); m != nil {
+				deps = append(deps, m[1])
+			}
+		}
+	}
+	sort.Strings(deps)
+	return slices.Compact(deps)
+}
+
+// validate checks the operations of a plan and computes their execution order.
+func validate(ops []*Operation) ([]string, error) {
+	byID := map[string]*Operation{}
+	for i, op := range ops {
+		if op.ID == "" {
+			return nil, fmt.Errorf("operation %d: id is required", i+1)
+		}
+		if _, ok := byID[op.ID]; ok {
+			return nil, fmt.Errorf("operation %s: duplicate id", op.ID)
+		}
+		opType, ok := operationTypes[op.Type]
+		if !ok {
+			return nil, fmt.Errorf("operation %s: unsupported type %q, supported types are: %s", op.ID, op.Type, supportedTypes())
+		}
+		for _, param := range opType.required {
+			if v, ok := op.Params[param]; !ok || v == nil || v == "" {
+				return nil, fmt.Errorf("operation %s: %s requires the %s parameter", op.ID, op.Type, param)
+			}
+		}
+		byID[op.ID] = op
+	}
+
+	for _, op := range ops {
+		for _, dep := range op.DependsOn {
+			if _, ok := byID[dep]; !ok {
+				return nil, fmt.Errorf("operation %s: depends on unknown operation %s", op.ID, dep)
+			}
+		}
+		for param, value := range op.Params {
+			s, ok := value.(string)
+			if !ok {
+				continue
+			}
+			m := referencePattern.FindStringSubmatch(s)
+			if m == nil {
+				continue
+			}
+			ref, ok := byID[m[1]]
+			if !ok {
+				return nil, fmt.Errorf("operation %s: parameter %s refers to unknown operation %s", op.ID, param, m[1])
+			}
+			if !slices.Contains(operationTypes[ref.Type].outputs, m[2]) {
+				return nil, fmt.Errorf("operation %s: parameter %s refers to unknown output %q of %s, available outputs are: %v", op.ID, param, m[2], ref.Type, operationTypes[ref.Type].outputs)
+			}
+		}
+	}
+
+	return executionOrder(ops)
+}
+
+// executionOrder sorts the operations topologically. Operations whose dependencies are met run
+// in the order they were declared.
+func executionOrder(ops []*Operation) ([]string, error) {
+	remaining := map[string][]string{}
+	for _, op := range ops {
+		remaining[op.ID] = dependencies(op)
+	}
+
+	order := make([]string, 0, len(ops))
+	done := map[string]bool{}
+	for len(order) < len(ops) {
+		progressed := false
+		for _, op := range ops {
+			if done[op.ID] {
+				continue
+			}
+			ready := true
+			for _, dep := range remaining[op.ID] {
+				if !done[dep] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				done[op.ID] = true
+				order = append(order, op.ID)
+				progressed = true
+				break
+			}
+		}
+		if !progressed {
+			var cycle []string
+			for _, op := range ops {
+				if !done[op.ID] {
+					cycle = append(cycle, op.ID)
+				}
+			}
+			return nil, fmt.Errorf("operations %v have circular dependencies", cycle)
+		}
+	}
+	return order, nil
+}
+
+// resolveParams replaces references to outputs of applied operations with their values.
+func resolveParams(p *Plan, op *Operation) (map[string]any, error) {
+	params := make(map[string]any, len(op.Params))
+	for key, value := range op.Params {
+		s, ok := value.(string)
+		m := referencePattern.FindStringSubmatch(s)
+		if !ok || m == nil {
+			params[key] = value
+			continue
+		}
+		ref := p.operation(m[1])
+		if ref == nil || ref.Status != OperationApplied {
+			return nil, fmt.Errorf("parameter %s refers to %s, which was not applied", key, m[1])
+		}
+		out, ok := ref.Outputs[m[2]]
+		if !ok || out == nil || out == "" {
+			return nil, fmt.Errorf("parameter %s refers to output %s of %s, which is empty", key, m[2], m[1])
+		}
+		params[key] = out
+	}
+	return params, nil
+}
+
+// store keeps plans in memory, scoped to the caller.
+type store struct {
+	mu    sync.Mutex
+	plans map[string][]*Plan
+}
+
+func newStore() *store {
+	return &store{plans: map[string][]*Plan{}}
+}
+
+// callerKey scopes plans to the caller's credentials. In HTTP transport mode each user has a
+// distinct auth token on the context; in stdio mode all plans belong to the single caller.
+func callerKey(ctx context.Context) string {
+	auth, _ := ctx.Value(middleware.AuthKey{}).(string)
+	if auth == "" {
+		return ""
+	}
+	h := sha256.Sum256([]byte(auth))
+	return hex.EncodeToString(h[:8])
+}
+
+func (s *store) add(ctx context.Context, p *Plan) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := callerKey(ctx)
+	plans := append(s.plans[key], p)
+	if len(plans) > maxPlansPerCaller {
+		plans = plans[len(plans)-maxPlansPerCaller:]
+	}
+	s.plans[key] = plans
+}
+
+func (s *store) get(ctx context.Context, id string) (*Plan, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range s.plans[callerKey(ctx)] {
+		if p.ID == id {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// update runs fn on the plan while holding the store lock.
+func (s *store) update(ctx context.Context, id string, fn func(p *Plan) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range s.plans[callerKey(ctx)] {
+		if p.ID == id {
+			return fn(p)
+		}
+	}
+	return fmt.Errorf("plan %s not found", id)
+}
+
+func newPlanID() string {
+	return uuid.NewString()
+}
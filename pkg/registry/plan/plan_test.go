@@ -0,0 +1,90 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func webOperations() []*Operation {
+	return []*Operation{
+		{ID: "dns", Type: "dns-record-create", Params: map[string]any{"Domain": "example.com", "Type": "A", "Name": "www", "Data": "${web.ipv4}"}},
+		{ID: "attach", Type: "volume-attach", Params: map[string]any{"VolumeID": "${data.id}", "DropletID": "${web.id}"}},
+		{ID: "web", Type: "droplet-create", Params: map[string]any{"Name": "web", "Region": "nyc3", "Size": "s-1vcpu-1gb", "ImageSlug": "ubuntu-24-04-x64"}},
+		{ID: "data", Type: "volume-create", Params: map[string]any{"Name": "data", "Region": "nyc3", "SizeGigaBytes": float64(10)}},
+	}
+}
+
+func TestValidate_order(t *testing.T) {
+	order, err := validate(webOperations())
+	require.NoError(t, err)
+	require.Equal(t, []string{"web", "dns", "data", "attach"}, order)
+}
+
+func TestValidate_errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(ops []*Operation)
+		expect string
+	}{
+		{
+			name:   "Unsupported type",
+			mutate: func(ops []*Operation) { ops[0].Type = "droplet-explode" },
+			expect: "unsupported type",
+		},
+		{
+			name:   "Duplicate ID",
+			mutate: func(ops []*Operation) { ops[1].ID = "dns" },
+			expect: "duplicate id",
+		},
+		{
+			name:   "Missing parameter",
+			mutate: func(ops []*Operation) { delete(ops[2].Params, "Region") },
+			expect: "requires the Region parameter",
+		},
+		{
+			name:   "Unknown reference",
+			mutate: func(ops []*Operation) { ops[0].Params["Data"] = "${api.ipv4}" },
+			expect: "unknown operation api",
+		},
+		{
+			name:   "Unknown output",
+			mutate: func(ops []*Operation) { ops[0].Params["Data"] = "${web.ipv6}" },
+			expect: `unknown output "ipv6"`,
+		},
+		{
+			name:   "Unknown dependency",
+			mutate: func(ops []*Operation) { ops[3].DependsOn = []string{"db"} },
+			expect: "unknown operation db",
+		},
+		{
+			name:   "Cycle",
+			mutate: func(ops []*Operation) { ops[2].DependsOn = []string{"attach"} },
+			expect: "circular dependencies",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ops := webOperations()
+			tc.mutate(ops)
+			_, err := validate(ops)
+			require.ErrorContains(t, err, tc.expect)
+		})
+	}
+}
+
+func TestResolveParams(t *testing.T) {
+	ops := webOperations()
+	p := &Plan{Operations: ops}
+	ops[2].Status = OperationApplied
+	ops[2].Outputs = map[string]any{"id": 42, "ipv4": "203.0.113.10"}
+
+	params, err := resolveParams(p, ops[0])
+	require.NoError(t, err)
+	require.Equal(t, "203.0.113.10", params["Data"])
+	require.Equal(t, "${web.ipv4}", ops[0].Params["Data"], "the plan keeps the reference")
+
+	_, err = resolveParams(p, ops[1])
+	require.ErrorContains(t, err, "data, which was not applied")
+}
@@ -0,0 +1,372 @@
+package plan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"mcp-digitalocean/internal/dryrun"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultWaitTimeout  = 10 * time.Minute
+)
+
+// PlanTool provides the plan-create, plan-show and plan-apply tools
+type PlanTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+	store  *store
+	waiter *waiter
+	now    func() time.Time
+}
+
+// NewPlanTool creates a new plan tool. Plans are kept in memory for the lifetime of the server.
+func NewPlanTool(client func(ctx context.Context) (*godo.Client, error)) *PlanTool {
+	return &PlanTool{
+		client: client,
+		store:  newStore(),
+		waiter: &waiter{interval: defaultPollInterval, timeout: defaultWaitTimeout},
+		now:    time.Now,
+	}
+}
+
+// planView is how a plan is rendered by the plan tools.
+type planView struct {
+	*Plan
+	Steps []string `json:"steps"`
+}
+
+// render returns the plan as JSON, with one human-readable line per operation in execution order.
+// The caller must hold the store lock.
+func render(p *Plan) (string, error) {
+	view := planView{Plan: p}
+	for i, id := range p.Order {
+		op := p.operation(id)
+		keys := make([]string, 0, len(op.Params))
+		for k := range op.Params {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		params := make([]string, 0, len(keys))
+		for _, k := range keys {
+			params = append(params, fmt.Sprintf("%s=%v", k, op.Params[k]))
+		}
+		step := fmt.Sprintf("%d. [%s] %s %s (%s)", i+1, op.Status, op.Type, op.ID, strings.Join(params, ", "))
+		if deps := dependencies(op); len(deps) > 0 {
+			step += " after " + strings.Join(deps, ", ")
+		}
+		view.Steps = append(view.Steps, step)
+	}
+	jsonPlan, err := json.MarshalIndent(view, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal error: %w", err)
+	}
+	return string(jsonPlan), nil
+}
+
+// parseOperations decodes the Operations argument.
+func parseOperations(raw any) ([]*Operation, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	var ops []*Operation
+	if err := json.Unmarshal(data, &ops); err != nil {
+		return nil, fmt.Errorf("invalid operations: %w", err)
+	}
+	for _, op := range ops {
+		op.Status = OperationPending
+		op.Outputs = nil
+		op.Error = ""
+	}
+	return ops, nil
+}
+
+func (t *PlanTool) createPlan(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	ops, err := parseOperations(args["Operations"])
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(ops) == 0 {
+		return mcp.NewToolResultError("at least one operation is required"), nil
+	}
+	name, _ := args["Name"].(string)
+
+	// add the operations to an existing plan that was not applied yet.
+	if planID, _ := args["PlanID"].(string); planID != "" {
+		var rendered string
+		err := t.store.update(ctx, planID, func(p *Plan) error {
+			if p.Status != StatusPending {
+				return fmt.Errorf("plan %s is %s and can no longer be changed", p.ID, p.Status)
+			}
+			combined := append(append([]*Operation{}, p.Operations...), ops...)
+			order, err := validate(combined)
+			if err != nil {
+				return err
+			}
+			if dryrun.Active(ctx) {
+				// a dry run shows the plan the operations would make, and leaves the stored one as it is.
+				preview := *p
+				preview.Operations, preview.Order = combined, order
+				if name != "" {
+					preview.Name = name
+				}
+				rendered, err = render(&preview)
+				return err
+			}
+			p.Operations, p.Order = combined, order
+			if name != "" {
+				p.Name = name
+			}
+			rendered, err = render(p)
+			return err
+		})
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		dryrun.Planned(ctx)
+		return mcp.NewToolResultText(rendered), nil
+	}
+
+	order, err := validate(ops)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	p := &Plan{
+		ID:         newPlanID(),
+		Name:       name,
+		Status:     StatusPending,
+		CreatedAt:  t.now().UTC(),
+		Order:      order,
+		Operations: ops,
+	}
+	if dryrun.Active(ctx) {
+		// the operations are valid; a dry run shows the plan without keeping it.
+		dryrun.Planned(ctx)
+		rendered, err := render(p)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultText(rendered), nil
+	}
+	t.store.add(ctx, p)
+
+	var rendered string
+	err = t.store.update(ctx, p.ID, func(p *Plan) error {
+		rendered, err = render(p)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(rendered), nil
+}
+
+func (t *PlanTool) showPlan(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	planID, _ := req.GetArguments()["PlanID"].(string)
+	if planID == "" {
+		return mcp.NewToolResultError("PlanID is required"), nil
+	}
+	var rendered string
+	err := t.store.update(ctx, planID, func(p *Plan) error {
+		var err error
+		rendered, err = render(p)
+		return err
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(rendered), nil
+}
+
+func (t *PlanTool) applyPlan(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	planID, _ := req.GetArguments()["PlanID"].(string)
+	if planID == "" {
+		return mcp.NewToolResultError("PlanID is required"), nil
+	}
+	// a dry run shows the steps the plan would run and leaves it pending, so it can still be applied.
+	dryRun := dryrun.Active(ctx)
+	var rendered string
+	err := t.store.update(ctx, planID, func(p *Plan) error {
+		if p.Status != StatusPending {
+			return fmt.Errorf("plan %s is %s and cannot be applied again", p.ID, p.Status)
+		}
+		if dryRun {
+			var err error
+			rendered, err = render(p)
+			return err
+		}
+		p.Status = StatusApplying
+		return nil
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if dryRun {
+		dryrun.Planned(ctx)
+		return mcp.NewToolResultText(rendered), nil
+	}
+	p, _ := t.store.get(ctx, planID)
+
+	client, err := t.client(ctx)
+	if err != nil {
+		t.store.mu.Lock()
+		p.Status = StatusPending
+		t.store.mu.Unlock()
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	failed := t.apply(ctx, client, p)
+
+	t.store.mu.Lock()
+	defer t.store.mu.Unlock()
+	rendered, err = render(p)
+	if err != nil {
+		return nil, err
+	}
+	if failed {
+		return mcp.NewToolResultError(rendered), nil
+	}
+	return mcp.NewToolResultText(rendered), nil
+}
+
+// apply runs the operations of p in order and rolls back the applied ones when an operation fails.
+// It reports whether the plan failed.
+func (t *PlanTool) apply(ctx context.Context, client *godo.Client, p *Plan) bool {
+	lock, unlock := t.store.mu.Lock, t.store.mu.Unlock
+	lock()
+	order := p.Order
+	unlock()
+
+	// the resolved params of each operation, needed to undo it.
+	resolved := map[string]map[string]any{}
+	var undo []*Operation
+	var failed bool
+	for _, id := range order {
+		lock()
+		op := p.operation(id)
+		params, err := resolveParams(p, op)
+		unlock()
+
+		var outputs map[string]any
+		if err == nil {
+			outputs, err = operationTypes[op.Type].apply(ctx, t.waiter, client, params)
+		}
+
+		lock()
+		op.Outputs = outputs
+		if err != nil {
+			op.Status = OperationFailed
+			op.Error = err.Error()
+		} else {
+			op.Status = OperationApplied
+		}
+		unlock()
+
+		// an operation that failed after creating something, e.g. a droplet that never became
+		// active, is undone along with the applied ones.
+		if outputs != nil {
+			resolved[id] = params
+			undo = append(undo, op)
+		}
+		if err != nil {
+			failed = true
+			break
+		}
+	}
+
+	if !failed {
+		lock()
+		now := t.now().UTC()
+		p.Status, p.AppliedAt = StatusApplied, &now
+		unlock()
+		return false
+	}
+
+	// roll back even when the caller went away, so no half-applied plan is left behind.
+	rollbackCtx := context.WithoutCancel(ctx)
+	status := StatusRolledBack
+	for i := len(undo) - 1; i >= 0; i-- {
+		op := undo[i]
+		err := operationTypes[op.Type].rollback(rollbackCtx, t.waiter, client, resolved[op.ID], op.Outputs)
+		lock()
+		switch {
+		case err != nil:
+			op.Status = OperationRollbackFailed
+			op.Error = strings.TrimPrefix(op.Error+"; rollback: "+err.Error(), "; ")
+			status = StatusRollbackFailed
+		case op.Status == OperationApplied:
+			op.Status = OperationRolledBack
+		}
+		unlock()
+	}
+
+	lock()
+	defer unlock()
+	for _, op := range p.Operations {
+		if op.Status == OperationPending {
+			op.Status = OperationSkipped
+		}
+	}
+	p.Status = status
+	return true
+}
+
+// Tools returns the plan tools
+func (t *PlanTool) Tools() []server.ServerTool {
+	types := make([]string, 0, len(operationTypes))
+	for name := range operationTypes {
+		types = append(types, name)
+	}
+	sort.Strings(types)
+	var typeDocs []string
+	for _, name := range types {
+		typeDocs = append(typeDocs, name+": "+operationTypes[name].description)
+	}
+
+	return []server.ServerTool{
+		{
+			Handler: t.createPlan,
+			Tool: mcp.NewTool("plan-create",
+				mcp.WithDescription("Create a plan of infrastructure changes to review before applying them, or add operations to a pending plan. Nothing is changed until plan-apply is called. "+
+					"A parameter set to ${<operation id>.<output>} uses an output of another operation, e.g. ${web.ipv4} or ${data.id}, and makes the operation depend on it. "+
+					"Operation types: "+strings.Join(typeDocs, " ")),
+				mcp.WithString("Name", mcp.Description("Optional name of the plan")),
+				mcp.WithString("PlanID", mcp.Description("ID of a pending plan to add the operations to. When empty, a new plan is created")),
+				mcp.WithArray("Operations", mcp.Required(), mcp.Description("Operations of the plan"), mcp.Items(map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"id":         map[string]any{"type": "string", "description": "Unique ID of the operation within the plan, used in references and depends_on"},
+						"type":       map[string]any{"type": "string", "enum": types, "description": "Operation type"},
+						"params":     map[string]any{"type": "object", "description": "Parameters of the operation"},
+						"depends_on": map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": "IDs of operations that must be applied first"},
+					},
+					"required": []string{"id", "type", "params"},
+				})),
+			),
+		},
+		{
+			Handler: t.showPlan,
+			Tool: mcp.NewTool("plan-show",
+				mcp.WithDescription("Show a plan with its operations in execution order, their status and, once applied, their outputs"),
+				mcp.WithString("PlanID", mcp.Required(), mcp.Description("ID of the plan")),
+			),
+		},
+		{
+			Handler: t.applyPlan,
+			Tool: mcp.NewTool("plan-apply",
+				mcp.WithDescription("Apply a pending plan, running its operations in dependency order. If an operation fails, the operations already applied are rolled back in reverse order and the rest are skipped. A plan can only be applied once."),
+				mcp.WithString("PlanID", mcp.Required(), mcp.Description("ID of the plan to apply")),
+			),
+		},
+	}
+}
@@ -0,0 +1,199 @@
+package plan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"mcp-digitalocean/internal/dryrun"
+)
+
+type planMocks struct {
+	droplets       *MockDropletsService
+	storage        *MockStorageService
+	storageActions *MockStorageActionsService
+	domains        *MockDomainsService
+}
+
+func setupPlanToolWithMocks(ctrl *gomock.Controller) (*PlanTool, *planMocks) {
+	m := &planMocks{
+		droplets:       NewMockDropletsService(ctrl),
+		storage:        NewMockStorageService(ctrl),
+		storageActions: NewMockStorageActionsService(ctrl),
+		domains:        NewMockDomainsService(ctrl),
+	}
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Droplets:       m.droplets,
+			Storage:        m.storage,
+			StorageActions: m.storageActions,
+			Domains:        m.domains,
+		}, nil
+	}
+	tool := NewPlanTool(client)
+	tool.waiter = &waiter{interval: time.Millisecond, timeout: time.Second}
+	return tool, m
+}
+
+func callPlanTool(t *testing.T, handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), args map[string]any) (*mcp.CallToolResult, planView) {
+	t.Helper()
+	res, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	require.NoError(t, err)
+	var view planView
+	_ = json.Unmarshal([]byte(res.Content[0].(mcp.TextContent).Text), &view)
+	return res, view
+}
+
+// operationArgs converts operations into the Operations argument as an MCP client sends it.
+func operationArgs(t *testing.T, ops []*Operation) []any {
+	t.Helper()
+	data, err := json.Marshal(ops)
+	require.NoError(t, err)
+	var args []any
+	require.NoError(t, json.Unmarshal(data, &args))
+	return args
+}
+
+func activeDroplet() *godo.Droplet {
+	return &godo.Droplet{
+		ID:     42,
+		Name:   "web",
+		Status: "active",
+		Networks: &godo.Networks{V4: []godo.NetworkV4{
+			{IPAddress: "203.0.113.10", Type: "public"},
+			{IPAddress: "10.0.0.5", Type: "private"},
+		}},
+	}
+}
+
+func TestPlanTool_createAndShow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tool, _ := setupPlanToolWithMocks(ctrl)
+
+	res, view := callPlanTool(t, tool.createPlan, map[string]any{"Name": "web stack", "Operations": operationArgs(t, webOperations()[2:])})
+	require.False(t, res.IsError)
+	require.Equal(t, StatusPending, view.Status)
+	require.Equal(t, []string{"web", "data"}, view.Order)
+
+	// add the DNS record and the attachment to the pending plan.
+	res, view = callPlanTool(t, tool.createPlan, map[string]any{"PlanID": view.ID, "Operations": operationArgs(t, webOperations()[:2])})
+	require.False(t, res.IsError)
+	require.Equal(t, []string{"web", "data", "dns", "attach"}, view.Order)
+	require.Len(t, view.Steps, 4)
+	require.Contains(t, view.Steps[3], "after data, web")
+
+	res, shown := callPlanTool(t, tool.showPlan, map[string]any{"PlanID": view.ID})
+	require.False(t, res.IsError)
+	require.Equal(t, view.Steps, shown.Steps)
+
+	res, _ = callPlanTool(t, tool.showPlan, map[string]any{"PlanID": "missing"})
+	require.True(t, res.IsError)
+
+	res, _ = callPlanTool(t, tool.createPlan, map[string]any{"Operations": []any{map[string]any{"id": "x", "type": "droplet-create", "params": map[string]any{}}}})
+	require.True(t, res.IsError)
+}
+
+func TestPlanTool_dryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	// no API call is expected: a dry run never reaches the client.
+	tool, _ := setupPlanToolWithMocks(ctrl)
+	create, apply := dryrun.Middleware(tool.createPlan), dryrun.Middleware(tool.applyPlan)
+
+	res, preview := callPlanTool(t, create, map[string]any{"DryRun": true, "Operations": operationArgs(t, webOperations()[2:])})
+	require.False(t, res.IsError)
+	require.Equal(t, []string{"web", "data"}, preview.Order)
+	res, _ = callPlanTool(t, tool.showPlan, map[string]any{"PlanID": preview.ID})
+	require.True(t, res.IsError, "a dry run does not keep the plan")
+
+	_, view := callPlanTool(t, tool.createPlan, map[string]any{"Operations": operationArgs(t, webOperations()[2:])})
+
+	// adding operations in a dry run shows the combined plan and leaves the stored one unchanged.
+	res, preview = callPlanTool(t, create, map[string]any{"DryRun": true, "PlanID": view.ID, "Operations": operationArgs(t, webOperations()[:2])})
+	require.False(t, res.IsError)
+	require.Equal(t, []string{"web", "data", "dns", "attach"}, preview.Order)
+	_, shown := callPlanTool(t, tool.showPlan, map[string]any{"PlanID": view.ID})
+	require.Equal(t, []string{"web", "data"}, shown.Order)
+
+	// a dry run of plan-apply lists the steps and leaves the plan pending.
+	res, preview = callPlanTool(t, apply, map[string]any{"DryRun": true, "PlanID": view.ID})
+	require.False(t, res.IsError)
+	require.Equal(t, StatusPending, preview.Status)
+	require.Len(t, preview.Steps, 2)
+	_, shown = callPlanTool(t, tool.showPlan, map[string]any{"PlanID": view.ID})
+	require.Equal(t, StatusPending, shown.Status)
+	for _, op := range shown.Operations {
+		require.Equal(t, OperationPending, op.Status, op.ID)
+	}
+}
+
+func TestPlanTool_apply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tool, m := setupPlanToolWithMocks(ctrl)
+
+	_, view := callPlanTool(t, tool.createPlan, map[string]any{"Operations": operationArgs(t, webOperations())})
+
+	gomock.InOrder(
+		m.droplets.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&godo.Droplet{ID: 42, Name: "web", Status: "new"}, nil, nil),
+		m.droplets.EXPECT().Get(gomock.Any(), 42).Return(&godo.Droplet{ID: 42, Status: "new"}, nil, nil),
+		m.droplets.EXPECT().Get(gomock.Any(), 42).Return(activeDroplet(), nil, nil),
+		m.domains.EXPECT().CreateRecord(gomock.Any(), "example.com", &godo.DomainRecordEditRequest{Type: "A", Name: "www", Data: "203.0.113.10"}).
+			Return(&godo.DomainRecord{ID: 7}, nil, nil),
+		m.storage.EXPECT().CreateVolume(gomock.Any(), gomock.Any()).Return(&godo.Volume{ID: "vol-1", Name: "data"}, nil, nil),
+		m.storageActions.EXPECT().Attach(gomock.Any(), "vol-1", 42).Return(&godo.Action{ID: 99, Status: godo.ActionInProgress}, nil, nil),
+		m.storageActions.EXPECT().Get(gomock.Any(), "vol-1", 99).Return(&godo.Action{ID: 99, Status: godo.ActionCompleted}, nil, nil),
+	)
+
+	res, applied := callPlanTool(t, tool.applyPlan, map[string]any{"PlanID": view.ID})
+	require.False(t, res.IsError)
+	require.Equal(t, StatusApplied, applied.Status)
+	require.NotNil(t, applied.AppliedAt)
+	for _, op := range applied.Operations {
+		require.Equal(t, OperationApplied, op.Status, op.ID)
+	}
+
+	res, _ = callPlanTool(t, tool.applyPlan, map[string]any{"PlanID": view.ID})
+	require.True(t, res.IsError, "a plan can only be applied once")
+}
+
+func TestPlanTool_applyRollsBack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tool, m := setupPlanToolWithMocks(ctrl)
+
+	_, view := callPlanTool(t, tool.createPlan, map[string]any{"Operations": operationArgs(t, webOperations())})
+
+	gomock.InOrder(
+		m.droplets.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&godo.Droplet{ID: 42, Name: "web"}, nil, nil),
+		m.droplets.EXPECT().Get(gomock.Any(), 42).Return(activeDroplet(), nil, nil),
+		m.domains.EXPECT().CreateRecord(gomock.Any(), "example.com", gomock.Any()).Return(&godo.DomainRecord{ID: 7}, nil, nil),
+		m.storage.EXPECT().CreateVolume(gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("volume limit reached")),
+		// applied operations are undone in reverse order.
+		m.domains.EXPECT().DeleteRecord(gomock.Any(), "example.com", 7).Return(nil, nil),
+		m.droplets.EXPECT().Delete(gomock.Any(), 42).Return(nil, errors.New("droplet is locked")),
+	)
+
+	res, failed := callPlanTool(t, tool.applyPlan, map[string]any{"PlanID": view.ID})
+	require.True(t, res.IsError)
+	require.Equal(t, StatusRollbackFailed, failed.Status)
+
+	statuses := map[string]string{}
+	for _, op := range failed.Operations {
+		statuses[op.ID] = op.Status
+	}
+	require.Equal(t, map[string]string{
+		"web":    OperationRollbackFailed,
+		"dns":    OperationRolledBack,
+		"data":   OperationFailed,
+		"attach": OperationSkipped,
+	}, statuses)
+}
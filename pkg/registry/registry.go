@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
+	"sort"
 	"strings"
 
+	"mcp-digitalocean/internal/redact"
 	"mcp-digitalocean/pkg/registry/account"
 	"mcp-digitalocean/pkg/registry/apps"
 	"mcp-digitalocean/pkg/registry/common"
@@ -24,6 +27,8 @@ import (
 	"mcp-digitalocean/pkg/registry/marketplace"
 	"mcp-digitalocean/pkg/registry/networking"
 	"mcp-digitalocean/pkg/registry/nfs"
+	"mcp-digitalocean/pkg/registry/plan"
+	"mcp-digitalocean/pkg/registry/snapshot"
 	"mcp-digitalocean/pkg/registry/spaces"
 	"mcp-digitalocean/pkg/registry/volumes"
 
@@ -54,6 +59,8 @@ var supportedServices = map[string]struct{}{
 	"volumes":                {},
 	"functions":              {},
 	"nfs":                    {},
+	"snapshots":              {},
+	"plans":                  {},
 }
 
 // registerAppTools registers the app platform tools with the MCP server.
@@ -71,23 +78,53 @@ func registerAppTools(s *server.MCPServer, getClient getClientFn) error {
 // registerCommonTools registers the common tools with the MCP server.
 func registerCommonTools(s *server.MCPServer, getClient getClientFn) error {
 	s.AddTools(common.NewRegionTools(getClient).Tools()...)
+	s.AddTools(common.NewURNTools(getClient).Tools()...)
+	s.AddTools(common.NewTagTools(getClient).Tools()...)
 
 	return nil
 }
 
-// registerDropletTools registers the droplet tools with the MCP server.
-func registerDropletTools(s *server.MCPServer, getClient getClientFn) error {
+// registerDropletTools registers the droplet tools and resources with the MCP server.
+func registerDropletTools(s *server.MCPServer, getClient getClientFn, opts Options) error {
 	s.AddTools(droplet.NewDropletTool(getClient).Tools()...)
 	s.AddTools(droplet.NewDropletActionsTool(getClient).Tools()...)
 	s.AddTools(droplet.NewImageTool(getClient).Tools()...)
 	s.AddTools(droplet.NewImageActionsTool(getClient).Tools()...)
 	s.AddTools(droplet.NewSizesTool(getClient).Tools()...)
+	s.AddTools(droplet.NewPlacementTool(getClient).Tools()...)
+	s.AddTools(droplet.NewSnapshotVerifyTool(getClient).Tools()...)
+	s.AddTools(droplet.NewSnapshotPruneTool(getClient).Tools()...)
+	s.AddTools(droplet.NewStabilityReportTool(getClient).Tools()...)
+	s.AddTools(droplet.NewDropletHistoryTool(getClient).Tools()...)
+	s.AddTools(droplet.NewBandwidthReportTool(getClient).Tools()...)
+	s.AddTools(droplet.NewProvisionWebDropletTool(getClient).Tools()...)
+	s.AddTools(droplet.NewDropletCloneTool(getClient).Tools()...)
+	s.AddTools(droplet.NewDropletMultiRegionTool(getClient).Tools()...)
+	s.AddTools(droplet.NewDropletPTRTool(getClient).Tools()...)
+	s.AddTools(droplet.NewCleanupByTagTool(getClient).Tools()...)
+	s.AddTools(droplet.NewDropletWaitTool(getClient).Tools()...)
+	s.AddTools(droplet.NewUserDataTool().Tools()...)
+	// droplet-exec runs arbitrary commands, so it is only there when a key is configured for it.
+	if opts.DropletExecKeyFile != "" {
+		execTool, err := droplet.NewDropletExecTool(getClient, opts.DropletExecKeyFile, opts.DropletExecKnownHostsFile)
+		if err != nil {
+			return err
+		}
+		s.AddTools(execTool.Tools()...)
+	}
+	catalogResources := droplet.NewCatalogResources(getClient)
+	s.AddResources(redact.Resources(catalogResources.Resources()...)...)
+	imageAliases := droplet.NewImageAliasCatalog(getClient)
+	s.AddTools(imageAliases.Tools()...)
+	s.AddResources(redact.Resources(imageAliases.Resources()...)...)
+	s.AddResourceTemplates(redact.ResourceTemplates(catalogResources.ResourceTemplates()...)...)
 	return nil
 }
 
 // registerNetworkingTools registers the networking tools with the MCP server.
 func registerNetworkingTools(s *server.MCPServer, getClient getClientFn) error {
 	s.AddTools(networking.NewCertificateTool(getClient).Tools()...)
+	s.AddTools(networking.NewCertProvisionTool(getClient).Tools()...)
 	s.AddTools(networking.NewDomainsTool(getClient).Tools()...)
 	s.AddTools(networking.NewFirewallTool(getClient).Tools()...)
 	s.AddTools(networking.NewLoadBalancersTool(getClient).Tools()...)
@@ -104,19 +141,30 @@ func registerNetworkingTools(s *server.MCPServer, getClient getClientFn) error {
 func registerAccountTools(s *server.MCPServer, getClient getClientFn) error {
 	s.AddTools(account.NewAccountTools(getClient).Tools()...)
 	s.AddTools(account.NewActionTools(getClient).Tools()...)
+	s.AddTools(account.NewActionFollowTool(getClient).Tools()...)
 	s.AddTools(account.NewBalanceTools(getClient).Tools()...)
 	s.AddTools(account.NewBillingTools(getClient).Tools()...)
 	s.AddTools(account.NewInvoiceTools(getClient).Tools()...)
 	s.AddTools(account.NewKeysTool(getClient).Tools()...)
+	s.AddTools(account.NewInventoryTools(getClient).Tools()...)
+	s.AddTools(account.NewCostTools(getClient).Tools()...)
+	s.AddTools(account.NewOrphanTools(getClient).Tools()...)
+	s.AddTools(account.NewSearchTools(getClient).Tools()...)
+	s.AddResources(redact.Resources(account.NewInventoryResource(getClient).Resources()...)...)
 
 	return nil
 }
 
 // registerSpacesTools registers the spaces tools and resources with the MCP server.
-func registerSpacesTools(s *server.MCPServer, getClient getClientFn) error {
+func registerSpacesTools(s *server.MCPServer, getClient getClientFn, opts Options) error {
 	// Register the tools for spaces keys
 	s.AddTools(spaces.NewSpacesKeysTool(getClient).Tools()...)
 	s.AddTools(spaces.NewCDNTool(getClient).Tools()...)
+	s.AddTools(spaces.NewStaticSiteTool(getClient).Tools()...)
+	// Buckets and objects are only reachable through the S3 API, which needs a Spaces key.
+	if opts.SpacesAccessKeyID != "" && opts.SpacesSecretAccessKey != "" {
+		s.AddTools(spaces.NewS3Tool(opts.SpacesAccessKeyID, opts.SpacesSecretAccessKey).Tools()...)
+	}
 
 	return nil
 }
@@ -165,11 +213,15 @@ func registerInsightsTools(s *server.MCPServer, getClient getClientFn) error {
 	s.AddTools(insights.NewUptimeTool(getClient).Tools()...)
 	s.AddTools(insights.NewUptimeCheckAlertTool(getClient).Tools()...)
 	s.AddTools(insights.NewAlertPolicyTool(getClient).Tools()...)
+	s.AddTools(insights.NewSinkTool(getClient).Tools()...)
 	return nil
 }
 
-func registerDOKSTools(s *server.MCPServer, getClient getClientFn) error {
+func registerDOKSTools(s *server.MCPServer, getClient getClientFn, opts Options) error {
 	s.AddTools(doks.NewDoksTool(getClient).Tools()...)
+	s.AddTools(doks.NewCredentialsTool(getClient, opts.KubeconfigDir).Tools()...)
+	s.AddTools(doks.NewUpgradeTool(getClient).Tools()...)
+	s.AddTools(doks.NewBootstrapTool(getClient, opts.KubeconfigDir).Tools()...)
 
 	return nil
 }
@@ -203,14 +255,19 @@ func registerFunctionsTools(s *server.MCPServer, getClient getClientFn) error {
 
 func registerDatabasesTools(s *server.MCPServer, getClient getClientFn) error {
 	s.AddTools(dbaas.NewClusterTool(getClient).Tools()...)
+	s.AddTools(dbaas.NewBackupTool(getClient).Tools()...)
 	s.AddTools(dbaas.NewFirewallTool(getClient).Tools()...)
+	s.AddTools(dbaas.NewConfigTool(getClient).Tools()...)
 	s.AddTools(dbaas.NewKafkaTool(getClient).Tools()...)
+	s.AddTools(dbaas.NewTopicTool(getClient).Tools()...)
 	s.AddTools(dbaas.NewMongoTool(getClient).Tools()...)
 	s.AddTools(dbaas.NewMysqlTool(getClient).Tools()...)
 	s.AddTools(dbaas.NewOpenSearchTool(getClient).Tools()...)
 	s.AddTools(dbaas.NewPostgreSQLTool(getClient).Tools()...)
 	s.AddTools(dbaas.NewRedisTool(getClient).Tools()...)
+	s.AddTools(dbaas.NewReplicaTool(getClient).Tools()...)
 	s.AddTools(dbaas.NewUserTool(getClient).Tools()...)
+	s.AddTools(dbaas.NewConnectionTool(getClient).Tools()...)
 
 	return nil
 }
@@ -227,14 +284,95 @@ func registerNfsTools(s *server.MCPServer, getClient getClientFn) error {
 	return nil
 }
 
+func registerSnapshotTools(s *server.MCPServer, getClient getClientFn) error {
+	s.AddTools(snapshot.NewSnapshotTool(getClient).Tools()...)
+	return nil
+}
+
+// registerPlanTools registers the plan/apply workflow tools with the MCP server.
+func registerPlanTools(s *server.MCPServer, getClient getClientFn) error {
+	s.AddTools(plan.NewPlanTool(getClient).Tools()...)
+	return nil
+}
+
+// Catalog maps the name of every registered tool to the service that registered it.
+type Catalog map[string]string
+
+// Services returns the sorted names of the services present in the catalog.
+func (c Catalog) Services() []string {
+	set := map[string]struct{}{}
+	for _, svc := range c {
+		set[svc] = struct{}{}
+	}
+	services := make([]string, 0, len(set))
+	for svc := range set {
+		services = append(services, svc)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// ItemURIs maps list tools to the URI template of the resource of one of their items. The
+// resources are registered with the droplets service, so the map is empty without it.
+func (c Catalog) ItemURIs() map[string]string {
+	if !slices.Contains(c.Services(), "droplets") {
+		return map[string]string{}
+	}
+	return droplet.ItemURIs()
+}
+
 // Register registers the set of tools for the specified services with the MCP server.
 // We either register a subset of tools of the services are specified, or we register all tools if no services are specified.
 func Register(logger *slog.Logger, s *server.MCPServer, getClient getClientFn, servicesToActivate ...string) error {
+	_, err := RegisterWithCatalog(logger, s, getClient, servicesToActivate...)
+	return err
+}
+
+// Options configures the registered tools beyond the services they belong to.
+type Options struct {
+	// KubeconfigDir is the directory below which doks-credentials-get may write kubeconfigs.
+	// When empty, it only returns them.
+	KubeconfigDir string
+	// SpacesAccessKeyID and SpacesSecretAccessKey are the Spaces access key the bucket and object
+	// tools sign S3 requests with. When either is empty, those tools are not registered.
+	SpacesAccessKeyID     string
+	SpacesSecretAccessKey string
+	// DropletExecKeyFile is the SSH private key droplet-exec logs in to droplets with. When empty,
+	// droplet-exec is not registered.
+	DropletExecKeyFile string
+	// DropletExecKnownHostsFile is the known_hosts file droplet-exec checks host keys against.
+	// When empty, the first key each droplet presents is trusted.
+	DropletExecKnownHostsFile string
+}
+
+// RegisterWithCatalog behaves like Register and also returns which service registered each tool.
+// Common tools are reported under the "common" service.
+func RegisterWithCatalog(logger *slog.Logger, s *server.MCPServer, getClient getClientFn, servicesToActivate ...string) (Catalog, error) {
+	return RegisterWithOptions(logger, s, getClient, Options{}, servicesToActivate...)
+}
+
+// RegisterWithOptions behaves like RegisterWithCatalog with the tools configured by opts.
+func RegisterWithOptions(logger *slog.Logger, s *server.MCPServer, getClient getClientFn, opts Options, servicesToActivate ...string) (Catalog, error) {
+	servicesToActivate, err := normalizeServices(servicesToActivate)
+	if err != nil {
+		return nil, err
+	}
 	if len(servicesToActivate) == 0 {
 		logger.Warn("no services specified, loading all supported services")
 		for k := range supportedServices {
 			servicesToActivate = append(servicesToActivate, k)
 		}
+		sort.Strings(servicesToActivate)
+	}
+
+	catalog := Catalog{}
+	// record the tools added since the last call under svc.
+	track := func(svc string) {
+		for name := range s.ListTools() {
+			if _, ok := catalog[name]; !ok {
+				catalog[name] = svc
+			}
+		}
 	}
 
 	for _, svc := range servicesToActivate {
@@ -242,91 +380,147 @@ func Register(logger *slog.Logger, s *server.MCPServer, getClient getClientFn, s
 		switch svc {
 		case "apps":
 			if err := registerAppTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register app tools: %w", err)
+				return nil, fmt.Errorf("failed to register app tools: %w", err)
 			}
 		case "networking":
 			if err := registerNetworkingTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register networking tools: %w", err)
+				return nil, fmt.Errorf("failed to register networking tools: %w", err)
 			}
 		case "droplets":
-			if err := registerDropletTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register droplets tool: %w", err)
+			if err := registerDropletTools(s, getClient, opts); err != nil {
+				return nil, fmt.Errorf("failed to register droplets tool: %w", err)
 			}
 		case "accounts":
 			if err := registerAccountTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register account tools: %w", err)
+				return nil, fmt.Errorf("failed to register account tools: %w", err)
 			}
 		case "spaces":
-			if err := registerSpacesTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register spaces tools: %w", err)
+			if err := registerSpacesTools(s, getClient, opts); err != nil {
+				return nil, fmt.Errorf("failed to register spaces tools: %w", err)
 			}
 		case "databases":
 			if err := registerDatabasesTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register databases tools: %w", err)
+				return nil, fmt.Errorf("failed to register databases tools: %w", err)
 			}
 		case "marketplace":
 			if err := registerMarketplaceTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register marketplace tools: %w", err)
+				return nil, fmt.Errorf("failed to register marketplace tools: %w", err)
 			}
 		case "dedicated-inference":
 			if err := registerDedicatedInferenceTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register dedicated-inference tools: %w", err)
+				return nil, fmt.Errorf("failed to register dedicated-inference tools: %w", err)
 			}
 		case "inference-modelcatalog":
 			if err := registerModelCatalogTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register inference-modelcatalog tools: %w", err)
+				return nil, fmt.Errorf("failed to register inference-modelcatalog tools: %w", err)
 			}
 		case "genai-evaluation":
 			if err := registerGenAIEvaluationTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register genai-evaluation tools: %w", err)
+				return nil, fmt.Errorf("failed to register genai-evaluation tools: %w", err)
 			}
 		case "genai-custom-models":
 			if err := registerGenAICustomModelsTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register genai-custom-models tools: %w", err)
+				return nil, fmt.Errorf("failed to register genai-custom-models tools: %w", err)
 			}
 		case "genai-batchinference":
 			if err := registerGenAIBatchInferenceTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register genai-batchinference tools: %w", err)
+				return nil, fmt.Errorf("failed to register genai-batchinference tools: %w", err)
 			}
 		case "insights":
 			if err := registerInsightsTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register insights tools: %w", err)
+				return nil, fmt.Errorf("failed to register insights tools: %w", err)
 			}
 		case "doks":
-			if err := registerDOKSTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register DOKS tools: %w", err)
+			if err := registerDOKSTools(s, getClient, opts); err != nil {
+				return nil, fmt.Errorf("failed to register DOKS tools: %w", err)
 			}
 		case "docr":
 			if err := registerDOCRTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register DOCR tools: %w", err)
+				return nil, fmt.Errorf("failed to register DOCR tools: %w", err)
 			}
 		case "docs":
 			if err := registerDocsTools(s); err != nil {
-				return fmt.Errorf("failed to register docs tools: %w", err)
+				return nil, fmt.Errorf("failed to register docs tools: %w", err)
 			}
 		case "volumes":
 			if err := registerVolumesTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register volumes tools: %w", err)
+				return nil, fmt.Errorf("failed to register volumes tools: %w", err)
 			}
 		case "functions":
 			if err := registerFunctionsTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register functions tools: %w", err)
+				return nil, fmt.Errorf("failed to register functions tools: %w", err)
 			}
 		case "nfs":
 			if err := registerNfsTools(s, getClient); err != nil {
-				return fmt.Errorf("failed to register nfs tools: %w", err)
+				return nil, fmt.Errorf("failed to register nfs tools: %w", err)
+			}
+		case "snapshots":
+			if err := registerSnapshotTools(s, getClient); err != nil {
+				return nil, fmt.Errorf("failed to register snapshot tools: %w", err)
+			}
+		case "plans":
+			if err := registerPlanTools(s, getClient); err != nil {
+				return nil, fmt.Errorf("failed to register plan tools: %w", err)
 			}
 		default:
-			return fmt.Errorf("unsupported service: %s, supported service are: %v", svc, setToString(supportedServices))
+			return nil, fmt.Errorf("unsupported service: %s, supported service are: %v", svc, setToString(supportedServices))
 		}
+		track(svc)
 	}
 
 	// Common tools are always registered because they provide common functionality for all services such as region resources
 	if err := registerCommonTools(s, getClient); err != nil {
-		return fmt.Errorf("failed to register common tools: %w", err)
+		return nil, fmt.Errorf("failed to register common tools: %w", err)
 	}
+	track("common")
 
-	return nil
+	return catalog, nil
+}
+
+// FilterTools removes every registered tool whose name is rejected by allowed, so it is neither
+// listed nor callable. It returns the names of the removed tools.
+func FilterTools(s *server.MCPServer, allowed func(name string) bool) []string {
+	var removed []string
+	for name := range s.ListTools() {
+		if !allowed(name) {
+			removed = append(removed, name)
+		}
+	}
+	if len(removed) > 0 {
+		s.DeleteTools(removed...)
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// normalizeServices trims, lower-cases and de-duplicates the requested services, preserving their order.
+// All unsupported services are reported at once so a typo does not leave the server half registered.
+func normalizeServices(services []string) ([]string, error) {
+	var (
+		result      []string
+		unsupported []string
+	)
+	seen := make(map[string]struct{}, len(services))
+	for _, svc := range services {
+		svc = strings.ToLower(strings.TrimSpace(svc))
+		if svc == "" {
+			continue
+		}
+		if _, ok := seen[svc]; ok {
+			continue
+		}
+		seen[svc] = struct{}{}
+		if _, ok := supportedServices[svc]; !ok {
+			unsupported = append(unsupported, svc)
+			continue
+		}
+		result = append(result, svc)
+	}
+	if len(unsupported) > 0 {
+		return nil, fmt.Errorf("unsupported service: %s, supported service are: %v", strings.Join(unsupported, ","), setToString(supportedServices))
+	}
+
+	return result, nil
 }
 
 func setToString(set map[string]struct{}) string {
@@ -334,6 +528,7 @@ func setToString(set map[string]struct{}) string {
 	for key := range set {
 		result = append(result, key)
 	}
+	sort.Strings(result)
 
 	return strings.Join(result, ",")
 }
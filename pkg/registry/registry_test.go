@@ -0,0 +1,111 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+
+	"mcp-digitalocean/pkg/registry/common"
+)
+
+func TestNormalizeServices(t *testing.T) {
+	tests := []struct {
+		name        string
+		services    []string
+		expected    []string
+		expectError string
+	}{
+		{
+			name:     "Trims, lower-cases and de-duplicates",
+			services: []string{" droplets", "Networking ", "droplets", ""},
+			expected: []string{"droplets", "networking"},
+		},
+		{
+			name:     "Empty input",
+			services: []string{" "},
+		},
+		{
+			name:        "Reports every unsupported service",
+			services:    []string{"droplets", "images", "kubernetes"},
+			expectError: "unsupported service: images,kubernetes",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := normalizeServices(tc.services)
+			if tc.expectError != "" {
+				require.ErrorContains(t, err, tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRegister_onlyRequestedServices(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := server.NewMCPServer("test", "0.0.0")
+	getClient := func(ctx context.Context) (*godo.Client, error) { return godo.NewClient(nil), nil }
+
+	catalog, err := RegisterWithCatalog(logger, s, getClient, "droplets", " droplets")
+	require.NoError(t, err)
+
+	tools := s.ListTools()
+	require.Contains(t, tools, "droplet-get")
+	require.NotContains(t, tools, "domain-create")
+	require.Equal(t, "droplets", catalog["droplet-get"])
+	require.Len(t, catalog, len(tools))
+	require.Equal(t, []string{"common", "droplets"}, catalog.Services())
+}
+
+func TestRegister_examples(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := server.NewMCPServer("test", "0.0.0")
+	getClient := func(ctx context.Context) (*godo.Client, error) { return godo.NewClient(nil), nil }
+	_, err := RegisterWithCatalog(logger, s, getClient)
+	require.NoError(t, err)
+
+	tools := s.ListTools()
+	for _, name := range []string{"lb-create", "apps-create-app-from-spec", "doks-create-cluster"} {
+		tool := tools[name].Tool
+		require.NotNil(t, tool.Meta, name)
+		example, ok := tool.Meta.AdditionalFields[common.ExampleMetaKey].(common.Example)
+		require.True(t, ok, name)
+		require.Contains(t, tool.Description, "Example arguments: ", name)
+
+		// the example only passes arguments the tool declares.
+		schema, err := json.Marshal(tool)
+		require.NoError(t, err)
+		var declared struct {
+			InputSchema struct {
+				Properties map[string]any `json:"properties"`
+			} `json:"inputSchema"`
+		}
+		require.NoError(t, json.Unmarshal(schema, &declared))
+		for arg := range example.Arguments {
+			require.Contains(t, declared.InputSchema.Properties, arg, name)
+		}
+		require.NotEmpty(t, example.Output, name)
+	}
+}
+
+func TestFilterTools(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s := server.NewMCPServer("test", "0.0.0")
+	getClient := func(ctx context.Context) (*godo.Client, error) { return godo.NewClient(nil), nil }
+	require.NoError(t, Register(logger, s, getClient, "droplets"))
+
+	removed := FilterTools(s, func(name string) bool { return name != "droplet-delete" })
+
+	require.Equal(t, []string{"droplet-delete"}, removed)
+	require.NotContains(t, s.ListTools(), "droplet-delete")
+	require.Contains(t, s.ListTools(), "droplet-get")
+}
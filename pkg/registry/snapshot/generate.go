@@ -0,0 +1,3 @@
+package snapshot
+
+//go:generate mockgen -destination=./mocks.go -package snapshot github.com/digitalocean/godo SnapshotsService
@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/digitalocean/godo (interfaces: SnapshotsService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks.go -package snapshot github.com/digitalocean/godo SnapshotsService
+//
+
+// Package snapshot is a generated GoMock package.
+package snapshot
+
+import (
+	context "context"
+	reflect "reflect"
+
+	godo "github.com/digitalocean/godo"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSnapshotsService is a mock of SnapshotsService interface.
+type MockSnapshotsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSnapshotsServiceMockRecorder is the mock recorder for MockSnapshotsService.
+type MockSnapshotsServiceMockRecorder struct {
+	mock *MockSnapshotsService
+}
+
+// NewMockSnapshotsService creates a new mock instance.
+func NewMockSnapshotsService(ctrl *gomock.Controller) *MockSnapshotsService {
+	mock := &MockSnapshotsService{ctrl: ctrl}
+	mock.recorder = &MockSnapshotsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshotsService) EXPECT() *MockSnapshotsServiceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSnapshotsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSnapshotsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSnapshotsService)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockSnapshotsService) Get(arg0 context.Context, arg1 string) (*godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSnapshotsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSnapshotsService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockSnapshotsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockSnapshotsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSnapshotsService)(nil).List), arg0, arg1)
+}
+
+// ListDroplet mocks base method.
+func (m *MockSnapshotsService) ListDroplet(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDroplet", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDroplet indicates an expected call of ListDroplet.
+func (mr *MockSnapshotsServiceMockRecorder) ListDroplet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDroplet", reflect.TypeOf((*MockSnapshotsService)(nil).ListDroplet), arg0, arg1)
+}
+
+// ListVolume mocks base method.
+func (m *MockSnapshotsService) ListVolume(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolume", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVolume indicates an expected call of ListVolume.
+func (mr *MockSnapshotsServiceMockRecorder) ListVolume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolume", reflect.TypeOf((*MockSnapshotsService)(nil).ListVolume), arg0, arg1)
+}
+
+// ListVolumeSnapshotByRegion mocks base method.
+func (m *MockSnapshotsService) ListVolumeSnapshotByRegion(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumeSnapshotByRegion", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.Snapshot)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVolumeSnapshotByRegion indicates an expected call of ListVolumeSnapshotByRegion.
+func (mr *MockSnapshotsServiceMockRecorder) ListVolumeSnapshotByRegion(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumeSnapshotByRegion", reflect.TypeOf((*MockSnapshotsService)(nil).ListVolumeSnapshotByRegion), arg0, arg1, arg2)
+}
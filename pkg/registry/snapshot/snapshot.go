@@ -0,0 +1,154 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultSnapshotListPage    = 1
+	defaultSnapshotListPerPage = 50
+	maxSnapshotListPerPage     = 200
+)
+
+// resourceTypes are the values of the ResourceType filter of snapshot-list.
+var resourceTypes = []string{"droplet", "volume"}
+
+// SnapshotTool provides tools for the snapshots of droplets and volumes.
+type SnapshotTool struct {
+	client func(ctx context.Context) (*godo.Client, error)
+}
+
+// NewSnapshotTool creates a new SnapshotTool instance
+func NewSnapshotTool(client func(ctx context.Context) (*godo.Client, error)) *SnapshotTool {
+	return &SnapshotTool{client: client}
+}
+
+// snapshotID returns the ID argument. Droplet snapshot IDs are numbers, so a number is accepted
+// as well as a string.
+func snapshotID(req mcp.CallToolRequest) (string, bool) {
+	switch id := req.GetArguments()["ID"].(type) {
+	case string:
+		id = strings.TrimSpace(id)
+		return id, id != ""
+	case float64:
+		return strconv.FormatInt(int64(id), 10), true
+	}
+	return "", false
+}
+
+// listSnapshots lists the snapshots of the account, optionally only those of droplets or of volumes.
+func (s *SnapshotTool) listSnapshots(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	resourceType := args.String("ResourceType")
+	page := int(args.Number("Page", defaultSnapshotListPage))
+	perPage := int(args.Number("PerPage", defaultSnapshotListPerPage))
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if resourceType != "" && !slices.Contains(resourceTypes, resourceType) {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: ResourceType must be one of %s", strings.Join(resourceTypes, ", "))), nil
+	}
+	page = max(page, 1)
+	perPage = min(max(perPage, 1), maxSnapshotListPerPage)
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	opt := &godo.ListOptions{Page: page, PerPage: perPage}
+	list := client.Snapshots.List
+	switch resourceType {
+	case "droplet":
+		list = client.Snapshots.ListDroplet
+	case "volume":
+		list = client.Snapshots.ListVolume
+	}
+	snapshots, _, err := list(ctx, opt)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if snapshots == nil {
+		snapshots = []godo.Snapshot{}
+	}
+	return common.NewToolResultStructured(snapshots)
+}
+
+// getSnapshot gets a droplet or volume snapshot by ID.
+func (s *SnapshotTool) getSnapshot(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := snapshotID(req)
+	if !ok {
+		return mcp.NewToolResultError("ID is required"), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	snapshot, _, err := client.Snapshots.Get(ctx, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return common.NewToolResultStructured(snapshot)
+}
+
+// deleteSnapshot deletes a droplet or volume snapshot by ID.
+func (s *SnapshotTool) deleteSnapshot(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := snapshotID(req)
+	if !ok {
+		return mcp.NewToolResultError("ID is required"), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	if _, err := client.Snapshots.Delete(ctx, id); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Snapshot %s deleted successfully", id)), nil
+}
+
+// Tools returns MCP server tools for droplet and volume snapshots (list, get, delete).
+func (s *SnapshotTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.listSnapshots,
+			Tool: mcp.NewTool(
+				"snapshot-list",
+				mcp.WithDescription("List the snapshots of droplets and volumes, optionally of one resource type. Supports pagination."),
+				mcp.WithString("ResourceType", mcp.Enum(resourceTypes...), mcp.Description("Only list snapshots of droplets or of volumes")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultSnapshotListPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(maxSnapshotListPerPage), mcp.DefaultNumber(defaultSnapshotListPerPage), mcp.Description("Snapshots per page")),
+			),
+		},
+		{
+			Handler: s.getSnapshot,
+			Tool: mcp.NewTool(
+				"snapshot-get",
+				mcp.WithDescription("Get a droplet or volume snapshot by ID"),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("The ID of the snapshot to get")),
+			),
+		},
+		{
+			Handler: s.deleteSnapshot,
+			Tool: mcp.NewTool(
+				"snapshot-delete",
+				mcp.WithDescription("Delete a droplet or volume snapshot by ID. Volumes and droplets created from it are not affected."),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("The ID of the snapshot to delete")),
+			),
+		},
+	}
+}
@@ -0,0 +1,203 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupSnapshotToolWithMocks(snapshots *MockSnapshotsService) *SnapshotTool {
+	client := func(ctx context.Context) (*godo.Client, error) {
+		return &godo.Client{
+			Snapshots: snapshots,
+		}, nil
+	}
+	return NewSnapshotTool(client)
+}
+
+func TestSnapshotTool_listSnapshots(t *testing.T) {
+	dropletSnapshot := godo.Snapshot{ID: "123", Name: "web-1", ResourceType: "droplet"}
+	volumeSnapshot := godo.Snapshot{ID: "a1b2", Name: "data", ResourceType: "volume"}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockSnapshotsService)
+		expectError bool
+		expected    []godo.Snapshot
+	}{
+		{
+			name: "All snapshots",
+			args: map[string]any{},
+			mockSetup: func(m *MockSnapshotsService) {
+				m.EXPECT().
+					List(gomock.Any(), &godo.ListOptions{Page: 1, PerPage: 50}).
+					Return([]godo.Snapshot{dropletSnapshot, volumeSnapshot}, nil, nil)
+			},
+			expected: []godo.Snapshot{dropletSnapshot, volumeSnapshot},
+		},
+		{
+			name: "Droplet snapshots",
+			args: map[string]any{"ResourceType": "droplet", "Page": float64(2), "PerPage": float64(500)},
+			mockSetup: func(m *MockSnapshotsService) {
+				m.EXPECT().
+					ListDroplet(gomock.Any(), &godo.ListOptions{Page: 2, PerPage: 200}).
+					Return([]godo.Snapshot{dropletSnapshot}, nil, nil)
+			},
+			expected: []godo.Snapshot{dropletSnapshot},
+		},
+		{
+			name: "Volume snapshots",
+			args: map[string]any{"ResourceType": "volume"},
+			mockSetup: func(m *MockSnapshotsService) {
+				m.EXPECT().
+					ListVolume(gomock.Any(), gomock.Any()).
+					Return(nil, nil, nil)
+			},
+			expected: []godo.Snapshot{},
+		},
+		{
+			name:        "Invalid resource type",
+			args:        map[string]any{"ResourceType": "image"},
+			expectError: true,
+		},
+		{
+			name: "API error",
+			args: map[string]any{},
+			mockSetup: func(m *MockSnapshotsService) {
+				m.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil, errors.New("api error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSnapshots := NewMockSnapshotsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockSnapshots)
+			}
+			tool := setupSnapshotToolWithMocks(mockSnapshots)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.listSnapshots(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, resp.IsError)
+			if tc.expectError {
+				return
+			}
+			require.Equal(t, tc.expected, resp.StructuredContent)
+		})
+	}
+}
+
+func TestSnapshotTool_getSnapshot(t *testing.T) {
+	snapshot := &godo.Snapshot{ID: "123", Name: "web-1", ResourceType: "droplet"}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockSnapshotsService)
+		expectError bool
+	}{
+		{
+			name: "String ID",
+			args: map[string]any{"ID": "123"},
+			mockSetup: func(m *MockSnapshotsService) {
+				m.EXPECT().Get(gomock.Any(), "123").Return(snapshot, nil, nil)
+			},
+		},
+		{
+			name: "Numeric droplet snapshot ID",
+			args: map[string]any{"ID": float64(123)},
+			mockSetup: func(m *MockSnapshotsService) {
+				m.EXPECT().Get(gomock.Any(), "123").Return(snapshot, nil, nil)
+			},
+		},
+		{
+			name:        "Missing ID",
+			args:        map[string]any{"ID": " "},
+			expectError: true,
+		},
+		{
+			name: "API error",
+			args: map[string]any{"ID": "missing"},
+			mockSetup: func(m *MockSnapshotsService) {
+				m.EXPECT().Get(gomock.Any(), "missing").Return(nil, nil, errors.New("not found"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSnapshots := NewMockSnapshotsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockSnapshots)
+			}
+			tool := setupSnapshotToolWithMocks(mockSnapshots)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.getSnapshot(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, resp.IsError)
+			if tc.expectError {
+				return
+			}
+			require.Equal(t, snapshot, resp.StructuredContent)
+		})
+	}
+}
+
+func TestSnapshotTool_deleteSnapshot(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockSnapshotsService)
+		expectError bool
+	}{
+		{
+			name: "Successful delete",
+			args: map[string]any{"ID": "a1b2"},
+			mockSetup: func(m *MockSnapshotsService) {
+				m.EXPECT().Delete(gomock.Any(), "a1b2").Return(nil, nil)
+			},
+		},
+		{
+			name:        "Missing ID",
+			args:        map[string]any{},
+			expectError: true,
+		},
+		{
+			name: "API error",
+			args: map[string]any{"ID": "a1b2"},
+			mockSetup: func(m *MockSnapshotsService) {
+				m.EXPECT().Delete(gomock.Any(), "a1b2").Return(nil, errors.New("forbidden"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSnapshots := NewMockSnapshotsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockSnapshots)
+			}
+			tool := setupSnapshotToolWithMocks(mockSnapshots)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.deleteSnapshot(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, resp.IsError)
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -76,8 +78,12 @@ func (c *CDNTool) listCDNs(ctx context.Context, req mcp.CallToolRequest) (*mcp.C
 
 // createCDN creates a new CDN
 func (c *CDNTool) createCDN(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	origin := req.GetArguments()["Origin"].(string)
-	ttl := uint32(req.GetArguments()["TTL"].(float64))
+	args := common.NewArgs(req)
+	origin := args.RequiredString("Origin")
+	ttl := uint32(args.RequiredNumber("TTL"))
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	createRequest := &godo.CDNCreateRequest{
 		Origin: origin,
@@ -104,7 +110,11 @@ func (c *CDNTool) createCDN(ctx context.Context, req mcp.CallToolRequest) (*mcp.
 
 // deleteCDN deletes a CDN
 func (c *CDNTool) deleteCDN(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	cdnID := req.GetArguments()["ID"].(string)
+	args := common.NewArgs(req)
+	cdnID := args.RequiredString("ID")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	client, err := c.client(ctx)
 	if err != nil {
@@ -121,8 +131,12 @@ func (c *CDNTool) deleteCDN(ctx context.Context, req mcp.CallToolRequest) (*mcp.
 
 // flushCDNCache flushes the cache of a CDN
 func (c *CDNTool) flushCDNCache(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	cdnID := req.GetArguments()["ID"].(string)
-	files := req.GetArguments()["Files"].([]any)
+	args := common.NewArgs(req)
+	cdnID := args.RequiredString("ID")
+	files := args.RequiredList("Files")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	filesStr := make([]string, len(files))
 	for i, file := range files {
@@ -162,8 +176,8 @@ func (c *CDNTool) Tools() []server.ServerTool {
 			Handler: c.listCDNs,
 			Tool: mcp.NewTool("spaces-cdn-list",
 				mcp.WithDescription("List CDNs with pagination"),
-				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Items per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(20), mcp.Description("Items per page")),
 			),
 		},
 		{
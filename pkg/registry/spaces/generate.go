@@ -1,3 +1,3 @@
 package spaces
 
-//go:generate mockgen -destination=./mocks.go -package spaces github.com/digitalocean/godo SpacesKeysService,CDNService
+//go:generate mockgen -destination=./mocks.go -package spaces github.com/digitalocean/godo SpacesKeysService,CDNService,CertificatesService,DomainsService
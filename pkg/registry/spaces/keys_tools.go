@@ -242,8 +242,8 @@ func (s *KeysTool) Tools() []server.ServerTool {
 			Handler: s.listSpacesKeys,
 			Tool: mcp.NewTool("spaces-key-list",
 				mcp.WithDescription("List all Spaces keys"),
-				mcp.WithNumber("Page", mcp.Required(), mcp.DefaultNumber(1), mcp.Description("Page number for pagination")),
-				mcp.WithNumber("PerPage", mcp.Required(), mcp.DefaultNumber(10), mcp.Description("Number of items per page"), mcp.Max(100)),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.Required(), mcp.DefaultNumber(1), mcp.Description("Page number for pagination")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Required(), mcp.DefaultNumber(10), mcp.Description("Number of items per page"), mcp.Max(100)),
 			),
 		},
 		{
@@ -0,0 +1,250 @@
+package spaces
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"mcp-digitalocean/internal/dryrun"
+)
+
+const (
+	// defaultS3Endpoint is the S3-compatible endpoint of the Spaces of a region.
+	defaultS3Endpoint = "https://%s.digitaloceanspaces.com"
+	s3Timeout         = 60 * time.Second
+	// unsignedPayload is the payload hash of presigned URLs, whose body is not known when signing.
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	amzDateFormat   = "20060102T150405Z"
+)
+
+var (
+	// spacesRegion matches the slug of a region, which names the host of its endpoint.
+	spacesRegion = regexp.MustCompile(`^[a-z]{3}[0-9]$`)
+	bucketName   = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+)
+
+// S3Error is an error returned by the S3-compatible API of Spaces.
+type S3Error struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+	RequestID  string `xml:"RequestId"`
+}
+
+func (e *S3Error) Error() string {
+	msg := fmt.Sprintf("%d %s", e.StatusCode, e.Code)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.RequestID != "" {
+		msg += " (request " + e.RequestID + ")"
+	}
+	return msg
+}
+
+// s3Client makes requests to the S3-compatible API of Spaces, signed with AWS Signature Version 4
+// and a Spaces access key. Buckets are addressed by path.
+type s3Client struct {
+	accessKey string
+	secretKey string
+	endpoint  string
+	http      *http.Client
+	now       func() time.Time
+}
+
+// newS3Client creates a client signing with the Spaces access key accessKey and its secret. Its
+// mutating requests are recorded rather than sent during dry runs, like those of the API client.
+func newS3Client(accessKey, secretKey string) *s3Client {
+	return &s3Client{
+		accessKey: accessKey,
+		secretKey: secretKey,
+		endpoint:  defaultS3Endpoint,
+		http:      &http.Client{Transport: dryrun.NewTransport(http.DefaultTransport), Timeout: s3Timeout},
+		now:       time.Now,
+	}
+}
+
+// objectURL returns the URL of key in bucket in the Spaces of region, or of the bucket when key
+// is empty, or of the region's endpoint when bucket is empty too.
+func (c *s3Client) objectURL(region, bucket, key string, query url.Values) (*url.URL, error) {
+	if !spacesRegion.MatchString(region) {
+		return nil, fmt.Errorf("invalid arguments: %q is not a region slug, e.g. nyc3", region)
+	}
+	u, err := url.Parse(fmt.Sprintf(c.endpoint, region))
+	if err != nil {
+		return nil, err
+	}
+	if bucket != "" {
+		if !bucketName.MatchString(bucket) {
+			return nil, fmt.Errorf("invalid arguments: %q is not a bucket name", bucket)
+		}
+		u.Path = "/" + bucket
+		if key != "" {
+			u.Path += "/" + key
+		}
+	} else {
+		u.Path = "/"
+	}
+	// the path is sent encoded as it is signed.
+	u.RawPath = canonicalPath(u.Path)
+	u.RawQuery = canonicalQuery(query)
+	return u, nil
+}
+
+// do sends a signed request and decodes the XML response into out, when not nil.
+func (c *s3Client) do(ctx context.Context, method string, u *url.URL, header http.Header, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	c.sign(req, body)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		s3Err := &S3Error{StatusCode: resp.StatusCode}
+		if xml.Unmarshal(data, s3Err) != nil || s3Err.Code == "" {
+			s3Err.Code = http.StatusText(resp.StatusCode)
+		}
+		return s3Err
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return xml.Unmarshal(data, out)
+}
+
+// sign adds the Authorization header of AWS Signature Version 4 to req, signing its host and
+// every header it has.
+func (c *s3Client) sign(req *http.Request, body []byte) {
+	now := c.now().UTC()
+	sum := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(sum[:])
+	req.Header.Set("X-Amz-Date", now.Format(amzDateFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	names := []string{"host"}
+	values := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		name := strings.ToLower(k)
+		names = append(names, name)
+		values[name] = strings.Join(v, ",")
+	}
+	slices.Sort(names)
+	var headers strings.Builder
+	for _, name := range names {
+		headers.WriteString(name + ":" + strings.TrimSpace(values[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	scope, signature := c.signature(now, signingRegion(req.URL), strings.Join([]string{
+		req.Method, canonicalPath(req.URL.Path), canonicalQuery(req.URL.Query()), headers.String(), signedHeaders, payloadHash,
+	}, "\n"))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", c.accessKey, scope, signedHeaders, signature))
+}
+
+// presign returns u with the query parameters of AWS Signature Version 4 that let anyone holding
+// it make a method request to it until expires has passed.
+func (c *s3Client) presign(method string, u *url.URL, expires time.Duration) string {
+	now := c.now().UTC()
+	scope := now.Format("20060102") + "/" + signingRegion(u) + "/s3/aws4_request"
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	query.Set("X-Amz-Credential", c.accessKey+"/"+scope)
+	query.Set("X-Amz-Date", now.Format(amzDateFormat))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expires.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	_, signature := c.signature(now, signingRegion(u), strings.Join([]string{
+		method, canonicalPath(u.Path), canonicalQuery(query), "host:" + u.Host + "\n", "host", unsignedPayload,
+	}, "\n"))
+	query.Set("X-Amz-Signature", signature)
+	signed := *u
+	signed.RawQuery = canonicalQuery(query)
+	return signed.String()
+}
+
+// signature returns the scope of the credential and the signature of canonicalRequest.
+func (c *s3Client) signature(now time.Time, region, canonicalRequest string) (string, string) {
+	date := now.Format("20060102")
+	scope := date + "/" + region + "/s3/aws4_request"
+	sum := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + now.Format(amzDateFormat) + "\n" + scope + "\n" + hex.EncodeToString(sum[:])
+
+	key := []byte("AWS4" + c.secretKey)
+	for _, part := range []string{date, region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	return scope, hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// signingRegion returns the region a request to u is signed for: the first label of a Spaces host, or
+// us-east-1, which S3-compatible APIs accept for any other host.
+func signingRegion(u *url.URL) string {
+	host := u.Hostname()
+	if label, rest, ok := strings.Cut(host, "."); ok && strings.HasPrefix(rest, "digitaloceanspaces.com") {
+		return label
+	}
+	return "us-east-1"
+}
+
+// uriEncode percent-encodes s as Signature Version 4 requires, leaving slashes when path is set.
+func uriEncode(s string, path bool) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~', path && c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func canonicalPath(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return uriEncode(path, true)
+}
+
+// canonicalQuery encodes query sorted by name, with names that have no value as name=.
+func canonicalQuery(query url.Values) string {
+	var pairs []string
+	for name, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(name, false)+"="+uriEncode(value, false))
+		}
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, "&")
+}
@@ -0,0 +1,364 @@
+package spaces
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultPresignExpiry = 3600
+	// maxPresignExpiry is the longest a Signature Version 4 URL may be valid, a week.
+	maxPresignExpiry = 7 * 24 * 3600
+	maxObjectKeys    = 1000
+)
+
+var (
+	presignMethods = []string{http.MethodGet, http.MethodPut, http.MethodHead, http.MethodDelete}
+	corsMethods    = []string{http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodHead}
+	cannedACLs     = []string{"private", "public-read"}
+)
+
+// Bucket is a Spaces bucket.
+type Bucket struct {
+	Name         string    `json:"name"`
+	Region       string    `json:"region"`
+	CreationDate time.Time `json:"creation_date"`
+}
+
+// BucketList is the result of spaces-bucket-list.
+type BucketList struct {
+	Region  string   `json:"region"`
+	Buckets []Bucket `json:"buckets"`
+}
+
+// Object is an object in a Spaces bucket.
+type Object struct {
+	Key          string    `json:"key"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"last_modified"`
+	ETag         string    `json:"etag"`
+	StorageClass string    `json:"storage_class,omitempty"`
+}
+
+// ObjectList is a page of the objects of a bucket.
+type ObjectList struct {
+	Bucket  string   `json:"bucket"`
+	Prefix  string   `json:"prefix,omitempty"`
+	Objects []Object `json:"objects"`
+	// CommonPrefixes are the "directories" below Prefix when listed with a Delimiter.
+	CommonPrefixes []string `json:"common_prefixes,omitempty"`
+	// NextContinuationToken is passed as ContinuationToken to list the next page; empty on the last.
+	NextContinuationToken string `json:"next_continuation_token,omitempty"`
+}
+
+// PresignedURL is a URL that grants a request to an object without credentials until it expires.
+type PresignedURL struct {
+	URL       string    `json:"url"`
+	Method    string    `json:"method"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CORSRule is a cross-origin rule of a bucket.
+type CORSRule struct {
+	AllowedOrigins []string `json:"allowed_origins" xml:"AllowedOrigin"`
+	AllowedMethods []string `json:"allowed_methods" xml:"AllowedMethod"`
+	AllowedHeaders []string `json:"allowed_headers,omitempty" xml:"AllowedHeader,omitempty"`
+	MaxAgeSeconds  int      `json:"max_age_seconds,omitempty" xml:"MaxAgeSeconds,omitempty"`
+}
+
+type listAllMyBucketsResult struct {
+	Buckets []struct {
+		Name         string    `xml:"Name"`
+		CreationDate time.Time `xml:"CreationDate"`
+	} `xml:"Buckets>Bucket"`
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+		ETag         string    `xml:"ETag"`
+		StorageClass string    `xml:"StorageClass"`
+	} `xml:"Contents"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+type corsConfiguration struct {
+	XMLName xml.Name   `xml:"CORSConfiguration"`
+	Rules   []CORSRule `xml:"CORSRule"`
+}
+
+// S3Tool provides tools for the buckets and objects of Spaces through their S3-compatible API,
+// which godo does not cover. It signs with a Spaces access key rather than the API token.
+type S3Tool struct {
+	s3 *s3Client
+}
+
+// NewS3Tool creates a new S3 tool signing with the Spaces access key accessKey and its secret.
+func NewS3Tool(accessKey, secretKey string) *S3Tool {
+	return &S3Tool{s3: newS3Client(accessKey, secretKey)}
+}
+
+func (s *S3Tool) listBuckets(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	region := args.RequiredString("Region")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	u, err := s.s3.objectURL(region, "", "", nil)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var listed listAllMyBucketsResult
+	if err := s.s3.do(ctx, http.MethodGet, u, nil, nil, &listed); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	result := BucketList{Region: region, Buckets: []Bucket{}}
+	for _, b := range listed.Buckets {
+		result.Buckets = append(result.Buckets, Bucket{Name: b.Name, Region: region, CreationDate: b.CreationDate})
+	}
+	return common.NewToolResultStructured(result)
+}
+
+func (s *S3Tool) listObjects(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	region := args.RequiredString("Region")
+	bucket := args.RequiredString("Bucket")
+	prefix := args.String("Prefix")
+	delimiter := args.String("Delimiter")
+	maxKeys := args.Number("MaxKeys", maxObjectKeys)
+	token := args.String("ContinuationToken")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	query := url.Values{"list-type": {"2"}, "max-keys": {strconv.Itoa(min(max(int(maxKeys), 1), maxObjectKeys))}}
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if delimiter != "" {
+		query.Set("delimiter", delimiter)
+	}
+	if token != "" {
+		query.Set("continuation-token", token)
+	}
+	u, err := s.s3.objectURL(region, bucket, "", query)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var listed listBucketResult
+	if err := s.s3.do(ctx, http.MethodGet, u, nil, nil, &listed); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	result := ObjectList{Bucket: bucket, Prefix: prefix, Objects: []Object{}}
+	for _, o := range listed.Contents {
+		result.Objects = append(result.Objects, Object{Key: o.Key, Size: o.Size, LastModified: o.LastModified, ETag: strings.Trim(o.ETag, `"`), StorageClass: o.StorageClass})
+	}
+	for _, p := range listed.CommonPrefixes {
+		result.CommonPrefixes = append(result.CommonPrefixes, p.Prefix)
+	}
+	if listed.IsTruncated {
+		result.NextContinuationToken = listed.NextContinuationToken
+	}
+	return common.NewToolResultStructured(result)
+}
+
+func (s *S3Tool) presignURL(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	region := args.RequiredString("Region")
+	bucket := args.RequiredString("Bucket")
+	key := args.RequiredString("Key")
+	method := strings.ToUpper(args.String("Method"))
+	expiry := args.Number("ExpiresSeconds", defaultPresignExpiry)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if method == "" {
+		method = http.MethodGet
+	}
+	if !slices.Contains(presignMethods, method) {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: Method must be one of %s", strings.Join(presignMethods, ", "))), nil
+	}
+	if expiry < 1 || expiry > maxPresignExpiry {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: ExpiresSeconds must be between 1 and %d", maxPresignExpiry)), nil
+	}
+	u, err := s.s3.objectURL(region, bucket, key, nil)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	expires := time.Duration(expiry) * time.Second
+	return common.NewToolResultStructured(PresignedURL{
+		URL:       s.s3.presign(method, u, expires),
+		Method:    method,
+		ExpiresAt: s.s3.now().UTC().Add(expires).Truncate(time.Second),
+	})
+}
+
+func (s *S3Tool) setBucketCORS(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	region := args.RequiredString("Region")
+	bucket := args.RequiredString("Bucket")
+	hasRules := args.Has("Rules")
+	ruleArgs := args.Objects("Rules")
+	var rules []CORSRule
+	for _, r := range ruleArgs {
+		rules = append(rules, CORSRule{
+			AllowedOrigins: r.RequiredStrings("AllowedOrigins"),
+			AllowedMethods: r.RequiredStrings("AllowedMethods"),
+			AllowedHeaders: r.Strings("AllowedHeaders"),
+			MaxAgeSeconds:  int(r.Number("MaxAgeSeconds", 0)),
+		})
+	}
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	// an absent Rules is not taken to mean removing the configuration, which an empty one does.
+	if !hasRules {
+		return mcp.NewToolResultError("invalid arguments: Rules is required; pass [] to remove the CORS configuration"), nil
+	}
+	for i, rule := range rules {
+		for _, m := range rule.AllowedMethods {
+			if !slices.Contains(corsMethods, m) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: Rules[%d].AllowedMethods: %q is not one of %s", i, m, strings.Join(corsMethods, ", "))), nil
+			}
+		}
+	}
+	u, err := s.s3.objectURL(region, bucket, "", url.Values{"cors": {""}})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// no rules removes the CORS configuration.
+	if len(rules) == 0 {
+		if err := s.s3.do(ctx, http.MethodDelete, u, nil, nil, nil); err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Removed the CORS configuration of bucket %s", bucket)), nil
+	}
+	body, err := xml.Marshal(corsConfiguration{Rules: rules})
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	sum := md5.Sum(body)
+	header := http.Header{"Content-Type": {"application/xml"}, "Content-Md5": {base64.StdEncoding.EncodeToString(sum[:])}}
+	if err := s.s3.do(ctx, http.MethodPut, u, header, body, nil); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Set %d CORS rules on bucket %s", len(rules), bucket)), nil
+}
+
+func (s *S3Tool) setACL(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	region := args.RequiredString("Region")
+	bucket := args.RequiredString("Bucket")
+	key := args.String("Key")
+	acl := args.RequiredString("ACL")
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if !slices.Contains(cannedACLs, acl) {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: ACL must be one of %s", strings.Join(cannedACLs, ", "))), nil
+	}
+	u, err := s.s3.objectURL(region, bucket, key, url.Values{"acl": {""}})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if err := s.s3.do(ctx, http.MethodPut, u, http.Header{"X-Amz-Acl": {acl}}, nil, nil); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if key != "" {
+		return mcp.NewToolResultText(fmt.Sprintf("Object %s in bucket %s is now %s", key, bucket, acl)), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Bucket %s is now %s", bucket, acl)), nil
+}
+
+// Tools returns the tools for the buckets and objects of Spaces.
+func (s *S3Tool) Tools() []server.ServerTool {
+	region := mcp.WithString("Region", mcp.Required(), mcp.Description("Slug of the region of the Spaces, e.g. nyc3"))
+	bucket := mcp.WithString("Bucket", mcp.Required(), mcp.Description("Name of the bucket"))
+	return []server.ServerTool{
+		{
+			Handler: s.listBuckets,
+			Tool: mcp.NewTool("spaces-bucket-list",
+				mcp.WithDescription("List the Spaces buckets of a region that the configured Spaces access key can see"),
+				common.WithOutputSchema[BucketList](),
+				region,
+			),
+		},
+		{
+			Handler: s.listObjects,
+			Tool: mcp.NewTool("spaces-object-list",
+				mcp.WithDescription("List a page of the objects of a Spaces bucket, optionally below a prefix. Pass NextContinuationToken as ContinuationToken for the next page"),
+				common.WithOutputSchema[ObjectList](),
+				region,
+				bucket,
+				mcp.WithString("Prefix", mcp.Description("Only list the objects whose key starts with this prefix, e.g. images/")),
+				mcp.WithString("Delimiter", mcp.Description("Group the keys below Prefix up to this character into common prefixes, e.g. / to list one directory level")),
+				mcp.WithNumber("MaxKeys", mcp.DefaultNumber(maxObjectKeys), mcp.Min(1), mcp.Max(maxObjectKeys), mcp.Description("Most objects to return")),
+				mcp.WithString("ContinuationToken", mcp.Description("Token of the page to list, from a previous call")),
+			),
+		},
+		{
+			Handler: s.presignURL,
+			Tool: mcp.NewTool("spaces-presign-url",
+				mcp.WithDescription("Create a presigned URL that lets anyone holding it get, upload, check or delete one object of a Spaces bucket without credentials until it expires, e.g. to share a private file or let a client upload directly"),
+				common.WithOutputSchema[PresignedURL](),
+				region,
+				bucket,
+				mcp.WithString("Key", mcp.Required(), mcp.Description("Key of the object, e.g. reports/2026-09.pdf")),
+				mcp.WithString("Method", mcp.Enum(presignMethods...), mcp.DefaultString(http.MethodGet), mcp.Description("HTTP method the URL allows")),
+				mcp.WithNumber("ExpiresSeconds", mcp.DefaultNumber(defaultPresignExpiry), mcp.Min(1), mcp.Max(maxPresignExpiry), mcp.Description("How long the URL is valid, at most a week")),
+			),
+		},
+		{
+			Handler: s.setBucketCORS,
+			Tool: mcp.NewTool("spaces-bucket-cors-set",
+				mcp.WithDescription("Replace the CORS rules of a Spaces bucket, which let web pages on other origins make requests to it. An empty Rules removes the CORS configuration"),
+				region,
+				bucket,
+				mcp.WithArray("Rules", mcp.Required(), mcp.Description("CORS rules of the bucket"), mcp.Items(map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"AllowedOrigins": map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": "Origins allowed, e.g. https://example.com, or *"},
+						"AllowedMethods": map[string]any{"type": "array", "items": map[string]any{"type": "string", "enum": corsMethods}, "description": "HTTP methods allowed"},
+						"AllowedHeaders": map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": "Request headers allowed, or *"},
+						"MaxAgeSeconds":  map[string]any{"type": "number", "description": "How long browsers may cache the preflight response"},
+					},
+					"required": []string{"AllowedOrigins", "AllowedMethods"},
+				})),
+			),
+		},
+		{
+			Handler: s.setACL,
+			Tool: mcp.NewTool("spaces-acl-set",
+				mcp.WithDescription("Make a Spaces bucket, or one object in it with Key, private or public-read. Public objects can be read by anyone with their URL"),
+				region,
+				bucket,
+				mcp.WithString("Key", mcp.Description("Key of the object to set the ACL of. Defaults to the bucket itself")),
+				mcp.WithString("ACL", mcp.Required(), mcp.Enum(cannedACLs...), mcp.Description("Canned ACL to apply")),
+			),
+		},
+	}
+}
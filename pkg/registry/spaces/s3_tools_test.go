@@ -0,0 +1,171 @@
+package spaces
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+// toServer sends every request to the test server, whatever host it was made for.
+type toServer struct{ url *url.URL }
+
+func (t toServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme, req.URL.Host = t.url.Scheme, t.url.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+type s3Request struct {
+	Method string
+	Host   string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   string
+}
+
+func setupS3Tool(t *testing.T, status int, body string) (*S3Tool, *[]s3Request) {
+	var requests []s3Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		requests = append(requests, s3Request{r.Method, r.Host, r.URL.EscapedPath(), r.URL.Query(), r.Header, string(data)})
+		w.WriteHeader(status)
+		io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+	u, _ := url.Parse(srv.URL)
+
+	tool := NewS3Tool("DO00EXAMPLE", "secret")
+	tool.s3.http = &http.Client{Transport: toServer{u}}
+	tool.s3.now = func() time.Time { return time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC) }
+	return tool, &requests
+}
+
+func callS3Tool(t *testing.T, handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	resp, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	require.NoError(t, err)
+	return resp
+}
+
+func TestS3Tool_listObjects(t *testing.T) {
+	tool, requests := setupS3Tool(t, http.StatusOK, `<ListBucketResult>
+<Contents><Key>img/logo.png</Key><Size>2048</Size><LastModified>2026-09-30T08:00:00.000Z</LastModified><ETag>"abc"</ETag><StorageClass>STANDARD</StorageClass></Contents>
+<CommonPrefixes><Prefix>img/icons/</Prefix></CommonPrefixes>
+<IsTruncated>true</IsTruncated><NextContinuationToken>next</NextContinuationToken>
+</ListBucketResult>`)
+
+	resp := callS3Tool(t, tool.listObjects, map[string]any{"Region": "nyc3", "Bucket": "assets", "Prefix": "img/", "Delimiter": "/", "MaxKeys": float64(5000)})
+	require.False(t, resp.IsError)
+	list := resp.StructuredContent.(ObjectList)
+	require.Equal(t, []Object{{Key: "img/logo.png", Size: 2048, LastModified: time.Date(2026, time.September, 30, 8, 0, 0, 0, time.UTC), ETag: "abc", StorageClass: "STANDARD"}}, list.Objects)
+	require.Equal(t, []string{"img/icons/"}, list.CommonPrefixes)
+	require.Equal(t, "next", list.NextContinuationToken)
+
+	require.Len(t, *requests, 1)
+	r := (*requests)[0]
+	require.Equal(t, "nyc3.digitaloceanspaces.com", r.Host)
+	require.Equal(t, "/assets", r.Path)
+	require.Equal(t, url.Values{"list-type": {"2"}, "max-keys": {"1000"}, "prefix": {"img/"}, "delimiter": {"/"}}, r.Query)
+	require.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=DO00EXAMPLE/20261001/nyc3/s3/aws4_request, "))
+}
+
+func TestS3Tool_listBuckets(t *testing.T) {
+	t.Run("Lists", func(t *testing.T) {
+		tool, _ := setupS3Tool(t, http.StatusOK, `<ListAllMyBucketsResult><Buckets>
+<Bucket><Name>assets</Name><CreationDate>2025-01-02T03:04:05.000Z</CreationDate></Bucket>
+</Buckets></ListAllMyBucketsResult>`)
+
+		resp := callS3Tool(t, tool.listBuckets, map[string]any{"Region": "ams3"})
+		require.False(t, resp.IsError)
+		require.Equal(t, []Bucket{{Name: "assets", Region: "ams3", CreationDate: time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC)}}, resp.StructuredContent.(BucketList).Buckets)
+	})
+
+	t.Run("S3 error", func(t *testing.T) {
+		tool, _ := setupS3Tool(t, http.StatusForbidden, `<Error><Code>InvalidAccessKeyId</Code><RequestId>tx1</RequestId></Error>`)
+
+		resp := callS3Tool(t, tool.listBuckets, map[string]any{"Region": "ams3"})
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "403 InvalidAccessKeyId (request tx1)")
+	})
+
+	t.Run("Invalid region", func(t *testing.T) {
+		tool, requests := setupS3Tool(t, http.StatusOK, "")
+
+		resp := callS3Tool(t, tool.listBuckets, map[string]any{"Region": "evil.example.com/"})
+		require.True(t, resp.IsError)
+		require.Empty(t, *requests)
+	})
+}
+
+func TestS3Tool_presignURL(t *testing.T) {
+	tool, requests := setupS3Tool(t, http.StatusOK, "")
+
+	resp := callS3Tool(t, tool.presignURL, map[string]any{"Region": "sfo3", "Bucket": "uploads", "Key": "in/report.pdf", "Method": "put", "ExpiresSeconds": float64(600)})
+	require.False(t, resp.IsError)
+	presigned := resp.StructuredContent.(PresignedURL)
+	require.Equal(t, http.MethodPut, presigned.Method)
+	require.Equal(t, time.Date(2026, time.October, 1, 12, 10, 0, 0, time.UTC), presigned.ExpiresAt)
+	require.True(t, strings.HasPrefix(presigned.URL, "https://sfo3.digitaloceanspaces.com/uploads/in/report.pdf?X-Amz-Algorithm=AWS4-HMAC-SHA256&"))
+	require.Contains(t, presigned.URL, "X-Amz-Expires=600")
+	require.Empty(t, *requests)
+
+	resp = callS3Tool(t, tool.presignURL, map[string]any{"Region": "sfo3", "Bucket": "uploads", "Key": "a", "ExpiresSeconds": float64(maxPresignExpiry + 1)})
+	require.True(t, resp.IsError)
+}
+
+func TestS3Tool_setBucketCORS(t *testing.T) {
+	t.Run("Puts the rules", func(t *testing.T) {
+		tool, requests := setupS3Tool(t, http.StatusOK, "")
+
+		resp := callS3Tool(t, tool.setBucketCORS, map[string]any{"Region": "nyc3", "Bucket": "assets", "Rules": []any{
+			map[string]any{"AllowedOrigins": []any{"https://example.com"}, "AllowedMethods": []any{"GET", "HEAD"}, "MaxAgeSeconds": float64(300)},
+		}})
+		require.False(t, resp.IsError)
+		r := (*requests)[0]
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, url.Values{"cors": {""}}, r.Query)
+		require.NotEmpty(t, r.Header.Get("Content-Md5"))
+		require.Equal(t, "<CORSConfiguration><CORSRule><AllowedOrigin>https://example.com</AllowedOrigin><AllowedMethod>GET</AllowedMethod><AllowedMethod>HEAD</AllowedMethod><MaxAgeSeconds>300</MaxAgeSeconds></CORSRule></CORSConfiguration>", r.Body)
+	})
+
+	t.Run("Empty rules delete the configuration", func(t *testing.T) {
+		tool, requests := setupS3Tool(t, http.StatusNoContent, "")
+
+		resp := callS3Tool(t, tool.setBucketCORS, map[string]any{"Region": "nyc3", "Bucket": "assets", "Rules": []any{}})
+		require.False(t, resp.IsError)
+		require.Equal(t, http.MethodDelete, (*requests)[0].Method)
+	})
+
+	t.Run("Invalid method", func(t *testing.T) {
+		tool, requests := setupS3Tool(t, http.StatusOK, "")
+
+		resp := callS3Tool(t, tool.setBucketCORS, map[string]any{"Region": "nyc3", "Bucket": "assets", "Rules": []any{
+			map[string]any{"AllowedOrigins": []any{"*"}, "AllowedMethods": []any{"PATCH"}},
+		}})
+		require.True(t, resp.IsError)
+		require.Empty(t, *requests)
+	})
+}
+
+func TestS3Tool_setACL(t *testing.T) {
+	tool, requests := setupS3Tool(t, http.StatusOK, "")
+
+	resp := callS3Tool(t, tool.setACL, map[string]any{"Region": "nyc3", "Bucket": "assets", "Key": "img/logo.png", "ACL": "public-read"})
+	require.False(t, resp.IsError)
+	r := (*requests)[0]
+	require.Equal(t, "/assets/img/logo.png", r.Path)
+	require.Equal(t, "public-read", r.Header.Get("X-Amz-Acl"))
+	require.Contains(t, r.Header.Get("Authorization"), "SignedHeaders=host;x-amz-acl;x-amz-content-sha256;x-amz-date")
+
+	resp = callS3Tool(t, tool.setACL, map[string]any{"Region": "nyc3", "Bucket": "assets", "ACL": "authenticated-read"})
+	require.True(t, resp.IsError)
+}
@@ -0,0 +1,316 @@
+package spaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"mcp-digitalocean/internal/dryrun"
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultSiteTTL     = 3600
+	defaultSiteTimeout = 900
+	defaultSitePoll    = 10 * time.Second
+	siteRecordTTL      = 3600
+	sitePageSize       = 200
+)
+
+// cdnTTLs are the cache lifetimes, in seconds, that a CDN endpoint accepts.
+var cdnTTLs = []float64{60, 600, 3600, 86400, 604800}
+
+// Actions of the steps of deploy-static-site.
+const (
+	siteCreate = "create"
+	siteUpdate = "update"
+	siteReuse  = "reuse"
+	// siteManual is a step left to the caller, i.e. a DNS record at a provider other than DigitalOcean.
+	siteManual = "manual"
+)
+
+// StaticSiteStep is the certificate, CDN or DNS step of deploy-static-site.
+type StaticSiteStep struct {
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+	ID       string `json:"id,omitempty"`
+	Detail   string `json:"detail"`
+}
+
+// StaticSite is the outcome of deploy-static-site, or its plan during a dry run.
+type StaticSite struct {
+	DryRun bool   `json:"dry_run,omitempty"`
+	Domain string `json:"domain"`
+	Origin string `json:"origin"`
+	// Zone is the domain on DigitalOcean DNS that Domain is in; empty when its DNS is hosted elsewhere.
+	Zone string `json:"zone,omitempty"`
+	// CNAMETarget is the CDN endpoint that Domain is a CNAME record of.
+	CNAMETarget string             `json:"cname_target"`
+	Certificate *godo.Certificate  `json:"certificate,omitempty"`
+	CDN         *godo.CDN          `json:"cdn,omitempty"`
+	Record      *godo.DomainRecord `json:"record,omitempty"`
+	Steps       []StaticSiteStep   `json:"steps"`
+	Message     string             `json:"message"`
+}
+
+// StaticSiteTool provides a tool that serves a Spaces bucket on a custom domain through the CDN.
+type StaticSiteTool struct {
+	client       func(ctx context.Context) (*godo.Client, error)
+	pollInterval time.Duration
+}
+
+// NewStaticSiteTool creates a new static site tool
+func NewStaticSiteTool(client func(ctx context.Context) (*godo.Client, error)) *StaticSiteTool {
+	return &StaticSiteTool{client: client, pollInterval: defaultSitePoll}
+}
+
+// covers reports whether a certificate of dnsNames is valid for domain, directly or through a
+// wildcard of its parent.
+func covers(dnsNames []string, domain string) bool {
+	_, parent, _ := strings.Cut(domain, ".")
+	return slices.Contains(dnsNames, domain) || slices.Contains(dnsNames, "*."+parent)
+}
+
+// sameHost compares DNS names regardless of case and of a trailing dot.
+func sameHost(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+// siteZone returns the longest domain on DigitalOcean DNS that domain is in, or "" when there is none.
+func siteZone(ctx context.Context, client *godo.Client, domain string) (string, error) {
+	domains, err := common.ListAll(ctx, sitePageSize, client.Domains.List)
+	if err != nil {
+		return "", err
+	}
+	var zone string
+	for _, d := range domains {
+		if (domain == d.Name || strings.HasSuffix(domain, "."+d.Name)) && len(d.Name) > len(zone) {
+			zone = d.Name
+		}
+	}
+	return zone, nil
+}
+
+// plan looks up the certificate, CDN endpoint and DNS record of the site and decides the step
+// each needs. It makes no changes.
+func (s *StaticSiteTool) plan(ctx context.Context, client *godo.Client, site *StaticSite, certificateID string) error {
+	if certificateID != "" {
+		certificate, _, err := client.Certificates.Get(ctx, certificateID)
+		if err != nil {
+			return err
+		}
+		if !covers(certificate.DNSNames, site.Domain) {
+			return fmt.Errorf("certificate %s is for %s, not %s", certificateID, strings.Join(certificate.DNSNames, ", "), site.Domain)
+		}
+		site.Certificate = certificate
+	} else {
+		certificates, err := common.ListAll(ctx, sitePageSize, client.Certificates.List)
+		if err != nil {
+			return err
+		}
+		for _, c := range certificates {
+			if c.State != "error" && covers(c.DNSNames, site.Domain) {
+				site.Certificate = &c
+				break
+			}
+		}
+	}
+	if site.Certificate != nil {
+		site.Steps = append(site.Steps, StaticSiteStep{Resource: "certificate", Action: siteReuse, ID: site.Certificate.ID, Detail: fmt.Sprintf("certificate %s covers %s", site.Certificate.Name, site.Domain)})
+	} else {
+		site.Steps = append(site.Steps, StaticSiteStep{Resource: "certificate", Action: siteCreate, Detail: fmt.Sprintf("request a Let's Encrypt certificate for %s", site.Domain)})
+	}
+
+	cdns, err := common.ListAll(ctx, sitePageSize, client.CDNs.List)
+	if err != nil {
+		return err
+	}
+	for _, c := range cdns {
+		if c.Origin == site.Origin {
+			site.CDN = &c
+		} else if sameHost(c.CustomDomain, site.Domain) {
+			return fmt.Errorf("%s is already the custom domain of CDN endpoint %s of origin %s", site.Domain, c.ID, c.Origin)
+		}
+	}
+	switch {
+	case site.CDN == nil:
+		site.Steps = append(site.Steps, StaticSiteStep{Resource: "cdn", Action: siteCreate, Detail: fmt.Sprintf("create a CDN endpoint of %s for %s", site.Origin, site.Domain)})
+	case sameHost(site.CDN.CustomDomain, site.Domain) && site.Certificate != nil && site.CDN.CertificateID == site.Certificate.ID:
+		site.CNAMETarget = site.CDN.Endpoint
+		site.Steps = append(site.Steps, StaticSiteStep{Resource: "cdn", Action: siteReuse, ID: site.CDN.ID, Detail: fmt.Sprintf("CDN endpoint %s already serves %s", site.CDN.Endpoint, site.Domain)})
+	default:
+		site.CNAMETarget = site.CDN.Endpoint
+		site.Steps = append(site.Steps, StaticSiteStep{Resource: "cdn", Action: siteUpdate, ID: site.CDN.ID, Detail: fmt.Sprintf("set the custom domain of CDN endpoint %s to %s", site.CDN.Endpoint, site.Domain)})
+	}
+
+	if site.Zone == "" {
+		site.Steps = append(site.Steps, StaticSiteStep{Resource: "dns", Action: siteManual, Detail: fmt.Sprintf("create a CNAME record of %s pointing at %s with the DNS provider of the domain", site.Domain, site.CNAMETarget)})
+		return nil
+	}
+	records, _, err := client.Domains.RecordsByName(ctx, site.Zone, site.Domain, &godo.ListOptions{PerPage: sitePageSize})
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		switch r.Type {
+		case "CNAME":
+			site.Record = &r
+		case "A", "AAAA":
+			// a name with a CNAME record can have no other records.
+			return fmt.Errorf("%s already has an %s record (%d) pointing at %s; delete it before serving the site there", site.Domain, r.Type, r.ID, r.Data)
+		}
+	}
+	switch {
+	case site.Record == nil:
+		site.Steps = append(site.Steps, StaticSiteStep{Resource: "dns", Action: siteCreate, Detail: fmt.Sprintf("create a CNAME record of %s pointing at %s", site.Domain, site.CNAMETarget)})
+	case sameHost(site.Record.Data, site.CNAMETarget):
+		site.Steps = append(site.Steps, StaticSiteStep{Resource: "dns", Action: siteReuse, ID: fmt.Sprint(site.Record.ID), Detail: fmt.Sprintf("%s is already a CNAME of %s", site.Domain, site.CNAMETarget)})
+	default:
+		site.Steps = append(site.Steps, StaticSiteStep{Resource: "dns", Action: siteUpdate, ID: fmt.Sprint(site.Record.ID), Detail: fmt.Sprintf("point the CNAME record of %s at %s instead of %s", site.Domain, site.CNAMETarget, site.Record.Data)})
+	}
+	return nil
+}
+
+// deployStaticSite serves a Spaces bucket on a custom domain: it provisions a certificate for the
+// domain, creates or updates the CDN endpoint of the bucket and points the domain at it.
+func (s *StaticSiteTool) deployStaticSite(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := common.NewArgs(req)
+	bucket := args.RequiredString("Bucket")
+	region := args.RequiredString("Region")
+	domain := strings.ToLower(strings.TrimSuffix(args.RequiredString("Domain"), "."))
+	certificateID := args.String("CertificateID")
+	ttl := args.Number("TTL", defaultSiteTTL)
+	timeout := args.Number("TimeoutSeconds", defaultSiteTimeout)
+	if err := args.Err(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if !spacesRegion.MatchString(region) {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: %q is not a region slug, e.g. nyc3", region)), nil
+	}
+	if !bucketName.MatchString(bucket) {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: %q is not a bucket name", bucket)), nil
+	}
+	if !strings.Contains(domain, ".") {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: %q is not a domain name, e.g. static.example.com", domain)), nil
+	}
+	if !slices.Contains(cdnTTLs, ttl) {
+		return mcp.NewToolResultError("invalid arguments: TTL must be one of 60, 600, 3600, 86400 or 604800"), nil
+	}
+
+	client, err := s.client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DigitalOcean client: %w", err)
+	}
+
+	site := &StaticSite{
+		Domain:      domain,
+		Origin:      fmt.Sprintf("%s.%s.digitaloceanspaces.com", bucket, region),
+		CNAMETarget: fmt.Sprintf("%s.%s.cdn.digitaloceanspaces.com", bucket, region),
+		Steps:       []StaticSiteStep{},
+	}
+	if site.Zone, err = siteZone(ctx, client, domain); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if site.Zone == domain {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid arguments: %s is the apex of its zone, which cannot have a CNAME record; use a subdomain such as static.%s", domain, domain)), nil
+	}
+	// Let's Encrypt validates the names of a certificate with records DigitalOcean adds to their zone.
+	if site.Zone == "" && certificateID == "" {
+		return mcp.NewToolResultError(fmt.Sprintf("%s is not in a domain on DigitalOcean DNS, so no Let's Encrypt certificate can be issued for it; pass the CertificateID of an uploaded certificate, or add its domain with domain-create first", domain)), nil
+	}
+	if err := s.plan(ctx, client, site, certificateID); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	if dryrun.Active(ctx) {
+		dryrun.Planned(ctx)
+		site.DryRun = true
+		site.Message = "Dry run: no changes were made. The steps would be taken in order."
+		return common.NewToolResultStructured(site)
+	}
+
+	// the certificate is issued before the CDN endpoint, which can only use a verified one.
+	if site.Certificate == nil {
+		if site.Certificate, _, err = client.Certificates.Create(ctx, &godo.CertificateRequest{Name: domain + "-cdn", DNSNames: []string{domain}, Type: "lets_encrypt"}); err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		site.Steps[0].ID = site.Certificate.ID
+	}
+	if site.Certificate.State != "verified" {
+		waitCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout*float64(time.Second)))
+		defer cancel()
+		certificate, err := common.WaitForCertificate(waitCtx, client, site.Certificate.ID, s.pollInterval)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				err = fmt.Errorf("%w; call deploy-static-site again to keep waiting", err)
+			}
+			return mcp.NewToolResultErrorFromErr("certificate not issued", err), nil
+		}
+		site.Certificate = certificate
+	}
+
+	switch step := &site.Steps[1]; step.Action {
+	case siteCreate:
+		if site.CDN, _, err = client.CDNs.Create(ctx, &godo.CDNCreateRequest{Origin: site.Origin, TTL: uint32(ttl), CustomDomain: domain, CertificateID: site.Certificate.ID}); err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("certificate %s is issued, but creating the CDN endpoint failed", site.Certificate.ID), err), nil
+		}
+		step.ID = site.CDN.ID
+	case siteUpdate:
+		if site.CDN, _, err = client.CDNs.UpdateCustomDomain(ctx, site.CDN.ID, &godo.CDNUpdateCustomDomainRequest{CustomDomain: domain, CertificateID: site.Certificate.ID}); err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("certificate %s is issued, but updating CDN endpoint %s failed", site.Certificate.ID, step.ID), err), nil
+		}
+	}
+	site.CNAMETarget = site.CDN.Endpoint
+
+	record := &godo.DomainRecordEditRequest{Type: "CNAME", Name: strings.TrimSuffix(domain, "."+site.Zone), Data: site.CNAMETarget + ".", TTL: siteRecordTTL}
+	switch step := &site.Steps[2]; step.Action {
+	case siteCreate:
+		if site.Record, _, err = client.Domains.CreateRecord(ctx, site.Zone, record); err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("CDN endpoint %s serves %s, but creating its CNAME record failed", site.CDN.ID, domain), err), nil
+		}
+		step.ID = fmt.Sprint(site.Record.ID)
+	case siteUpdate:
+		if site.Record, _, err = client.Domains.EditRecord(ctx, site.Zone, site.Record.ID, record); err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("CDN endpoint %s serves %s, but updating its CNAME record failed", site.CDN.ID, domain), err), nil
+		}
+	case siteManual:
+		step.Detail = fmt.Sprintf("create a CNAME record of %s pointing at %s with the DNS provider of the domain", domain, site.CNAMETarget)
+	}
+
+	if site.Zone == "" {
+		site.Message = fmt.Sprintf("%s is served from %s by CDN endpoint %s once a CNAME record of it points at %s", domain, site.Origin, site.CDN.ID, site.CNAMETarget)
+	} else {
+		site.Message = fmt.Sprintf("%s is served from %s by CDN endpoint %s; DNS changes can take a few minutes to propagate", domain, site.Origin, site.CDN.ID)
+	}
+	res, err := common.NewToolResultStructured(site)
+	if err != nil || site.Steps[1].Action == siteCreate {
+		return res, err
+	}
+	return common.ExistingResult(res, fmt.Sprintf("The CDN endpoint of %s, %s, already existed, so none was created.", site.Origin, site.CDN.ID)), nil
+}
+
+// Tools returns the deploy-static-site tool.
+func (s *StaticSiteTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: s.deployStaticSite,
+			Tool: mcp.NewTool("deploy-static-site",
+				mcp.WithDescription("Serve a Spaces bucket as a static site on a custom domain in one call: provisions a Let's Encrypt certificate for the domain, or reuses one covering it, creates the bucket's CDN endpoint with the domain and certificate, or updates the existing one, and creates the CNAME record of the domain pointing at the endpoint when its zone is on DigitalOcean DNS. Returns the CNAME target and the step taken for each resource; calling it again skips the steps already done."),
+				common.WithOutputSchema[StaticSite](),
+				mcp.WithString("Bucket", mcp.Required(), mcp.Description("Name of the Spaces bucket holding the site")),
+				mcp.WithString("Region", mcp.Required(), mcp.Description("Slug of the region of the bucket, e.g. nyc3")),
+				mcp.WithString("Domain", mcp.Required(), mcp.Description("Custom domain of the site, a subdomain such as static.example.com since a zone apex cannot have a CNAME record")),
+				mcp.WithString("CertificateID", mcp.Description("ID of a certificate covering Domain to use instead of a Let's Encrypt one. Required when the domain's DNS is not on DigitalOcean")),
+				mcp.WithNumber("TTL", mcp.DefaultNumber(defaultSiteTTL), mcp.Description("Cache lifetime of the CDN in seconds: 60, 600, 3600, 86400 or 604800")),
+				mcp.WithNumber("TimeoutSeconds", mcp.DefaultNumber(defaultSiteTimeout), mcp.Description("How long to wait for a Let's Encrypt certificate to be issued")),
+			),
+		},
+	}
+}
@@ -0,0 +1,131 @@
+package spaces
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"mcp-digitalocean/pkg/registry/common"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStaticSiteTool_deployStaticSite(t *testing.T) {
+	type mocks struct {
+		cdns    *MockCDNService
+		certs   *MockCertificatesService
+		domains *MockDomainsService
+	}
+	setup := func(t *testing.T, zones ...string) (*StaticSiteTool, mocks) {
+		ctrl := gomock.NewController(t)
+		m := mocks{NewMockCDNService(ctrl), NewMockCertificatesService(ctrl), NewMockDomainsService(ctrl)}
+		var list []godo.Domain
+		for _, zone := range zones {
+			list = append(list, godo.Domain{Name: zone})
+		}
+		m.domains.EXPECT().List(gomock.Any(), gomock.Any()).Return(list, &godo.Response{}, nil).AnyTimes()
+		client := &godo.Client{CDNs: m.cdns, Certificates: m.certs, Domains: m.domains}
+		tool := NewStaticSiteTool(func(ctx context.Context) (*godo.Client, error) { return client, nil })
+		tool.pollInterval = time.Millisecond
+		return tool, m
+	}
+	call := func(t *testing.T, tool *StaticSiteTool, args map[string]any) (*mcp.CallToolResult, *StaticSite) {
+		t.Helper()
+		resp, err := tool.deployStaticSite(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		site, _ := resp.StructuredContent.(*StaticSite)
+		return resp, site
+	}
+	args := map[string]any{"Bucket": "site", "Region": "nyc3", "Domain": "static.example.com"}
+	endpoint := "site.nyc3.cdn.digitaloceanspaces.com"
+
+	t.Run("Creates every resource", func(t *testing.T) {
+		tool, m := setup(t, "example.com")
+		m.certs.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Certificate{
+			{ID: "other", DNSNames: []string{"www.example.com"}, State: "verified"},
+		}, &godo.Response{}, nil)
+		m.cdns.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, &godo.Response{}, nil)
+		m.domains.EXPECT().RecordsByName(gomock.Any(), "example.com", "static.example.com", gomock.Any()).Return(nil, &godo.Response{}, nil)
+		m.certs.EXPECT().Create(gomock.Any(), &godo.CertificateRequest{Name: "static.example.com-cdn", DNSNames: []string{"static.example.com"}, Type: "lets_encrypt"}).
+			Return(&godo.Certificate{ID: "cert-1", State: "pending"}, nil, nil)
+		m.certs.EXPECT().Get(gomock.Any(), "cert-1").Return(&godo.Certificate{ID: "cert-1", State: "verified"}, nil, nil)
+		m.cdns.EXPECT().Create(gomock.Any(), &godo.CDNCreateRequest{
+			Origin: "site.nyc3.digitaloceanspaces.com", TTL: 3600, CustomDomain: "static.example.com", CertificateID: "cert-1",
+		}).Return(&godo.CDN{ID: "cdn-1", Endpoint: endpoint}, nil, nil)
+		m.domains.EXPECT().CreateRecord(gomock.Any(), "example.com", &godo.DomainRecordEditRequest{
+			Type: "CNAME", Name: "static", Data: endpoint + ".", TTL: siteRecordTTL,
+		}).Return(&godo.DomainRecord{ID: 9}, nil, nil)
+
+		resp, site := call(t, tool, args)
+		require.False(t, resp.IsError)
+		require.Equal(t, endpoint, site.CNAMETarget)
+		require.Equal(t, []StaticSiteStep{
+			{Resource: "certificate", Action: siteCreate, ID: "cert-1", Detail: "request a Let's Encrypt certificate for static.example.com"},
+			{Resource: "cdn", Action: siteCreate, ID: "cdn-1", Detail: "create a CDN endpoint of site.nyc3.digitaloceanspaces.com for static.example.com"},
+			{Resource: "dns", Action: siteCreate, ID: "9", Detail: "create a CNAME record of static.example.com pointing at " + endpoint},
+		}, site.Steps)
+		require.Nil(t, resp.Meta)
+	})
+
+	t.Run("Reuses what exists and updates the rest", func(t *testing.T) {
+		tool, m := setup(t, "example.com")
+		m.certs.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Certificate{
+			{ID: "wild", Name: "wildcard", DNSNames: []string{"*.example.com"}, State: "verified"},
+		}, &godo.Response{}, nil)
+		m.cdns.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.CDN{
+			{ID: "cdn-1", Origin: "site.nyc3.digitaloceanspaces.com", Endpoint: endpoint},
+		}, &godo.Response{}, nil)
+		m.domains.EXPECT().RecordsByName(gomock.Any(), "example.com", "static.example.com", gomock.Any()).Return([]godo.DomainRecord{
+			{ID: 9, Type: "CNAME", Name: "static", Data: endpoint + "."},
+		}, &godo.Response{}, nil)
+		m.cdns.EXPECT().UpdateCustomDomain(gomock.Any(), "cdn-1", &godo.CDNUpdateCustomDomainRequest{CustomDomain: "static.example.com", CertificateID: "wild"}).
+			Return(&godo.CDN{ID: "cdn-1", Endpoint: endpoint, CustomDomain: "static.example.com", CertificateID: "wild"}, nil, nil)
+
+		resp, site := call(t, tool, args)
+		require.False(t, resp.IsError)
+		require.Equal(t, []string{siteReuse, siteUpdate, siteReuse}, []string{site.Steps[0].Action, site.Steps[1].Action, site.Steps[2].Action})
+		require.Equal(t, true, resp.Meta.AdditionalFields[common.ExistingMetaKey])
+	})
+
+	t.Run("DNS hosted elsewhere", func(t *testing.T) {
+		tool, m := setup(t)
+		m.certs.EXPECT().Get(gomock.Any(), "custom").Return(&godo.Certificate{ID: "custom", DNSNames: []string{"static.example.com"}, State: "verified"}, nil, nil)
+		m.cdns.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, &godo.Response{}, nil)
+		m.cdns.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&godo.CDN{ID: "cdn-1", Endpoint: endpoint}, nil, nil)
+
+		resp, site := call(t, tool, map[string]any{"Bucket": "site", "Region": "nyc3", "Domain": "static.example.com", "CertificateID": "custom"})
+		require.False(t, resp.IsError)
+		require.Equal(t, siteManual, site.Steps[2].Action)
+		require.Contains(t, site.Message, "once a CNAME record of it points at "+endpoint)
+	})
+
+	t.Run("DNS hosted elsewhere without a certificate", func(t *testing.T) {
+		tool, _ := setup(t)
+		resp, _ := call(t, tool, args)
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "pass the CertificateID")
+	})
+
+	t.Run("Zone apex", func(t *testing.T) {
+		tool, _ := setup(t, "example.com")
+		resp, _ := call(t, tool, map[string]any{"Bucket": "site", "Region": "nyc3", "Domain": "example.com"})
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "cannot have a CNAME record")
+	})
+
+	t.Run("Conflicting record", func(t *testing.T) {
+		tool, m := setup(t, "example.com")
+		m.certs.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, &godo.Response{}, nil)
+		m.cdns.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, &godo.Response{}, nil)
+		m.domains.EXPECT().RecordsByName(gomock.Any(), "example.com", "static.example.com", gomock.Any()).Return([]godo.DomainRecord{
+			{ID: 3, Type: "A", Name: "static", Data: "203.0.113.10"},
+		}, &godo.Response{}, nil)
+
+		resp, _ := call(t, tool, args)
+		require.True(t, resp.IsError)
+		require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "already has an A record")
+	})
+}
@@ -1,3 +1,3 @@
 package volumes
 
-//go:generate mockgen -destination=./mocks.go -package volumes github.com/digitalocean/godo StorageService,StorageActionsService
+//go:generate mockgen -destination=./mocks.go -package volumes github.com/digitalocean/godo StorageService,StorageActionsService,ProjectsService,RegionsService,AccountService
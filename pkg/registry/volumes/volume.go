@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 
+	"mcp-digitalocean/pkg/registry/common"
+
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -45,6 +47,7 @@ func (vt *VolumeTool) createVolume(ctx context.Context, req mcp.CallToolRequest)
 	description, _ := args["Description"].(string)
 	filesystemType, _ := args["FilesystemType"].(string)
 	filesystemLabel, _ := args["FilesystemLabel"].(string)
+	project, _ := args[common.ProjectArg].(string)
 	tagsArg, _ := args["Tags"].([]any)
 
 	var tags []string
@@ -70,10 +73,29 @@ func (vt *VolumeTool) createVolume(ctx context.Context, req mcp.CallToolRequest)
 		return mcp.NewToolResultErrorFromErr("Error getting DigitalOcean client", err), nil
 	}
 
+	if err := common.PreflightVolume(ctx, client, volumeCreateRequest.SizeGigaBytes, region); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	var projectID string
+	if project != "" {
+		if projectID, err = common.ResolveProject(ctx, client, project); err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+	}
+
 	volume, _, err := client.Storage.CreateVolume(ctx, volumeCreateRequest)
 	if err != nil {
 		return mcp.NewToolResultErrorFromErr("api error", err), nil
 	}
+	if projectID != "" {
+		if err := common.AssignCreated(ctx, client, projectID, volume.URN(), func(ctx context.Context) error {
+			_, err := client.Storage.DeleteVolume(ctx, volume.ID)
+			return err
+		}); err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+	}
 
 	jsonVolume, err := json.MarshalIndent(volume, "", "  ")
 	if err != nil {
@@ -344,6 +366,7 @@ func (vt *VolumeTool) Tools() []server.ServerTool {
 				mcp.WithString("FilesystemType", mcp.Description("The filesystem type for the volume, e.g. ext4 or xfs (optional)")),
 				mcp.WithString("FilesystemLabel", mcp.Description("The filesystem label for the volume (optional)")),
 				mcp.WithArray("Tags", mcp.Description("Tags to apply"), mcp.Items(map[string]any{"type": "string"})),
+				common.WithProject("volume"),
 			),
 		},
 		{
@@ -353,8 +376,8 @@ func (vt *VolumeTool) Tools() []server.ServerTool {
 				mcp.WithDescription("List block storage volumes with optional Name/Region filters. Supports pagination."),
 				mcp.WithString("Name", mcp.Description("Name filtering parameter")),
 				mcp.WithString("Region", mcp.Description("Region filtering parameter")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultVolumeListPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultVolumeListPerPage), mcp.Description("Volumes per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultVolumeListPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultVolumeListPerPage), mcp.Description("Volumes per page")),
 			),
 		},
 		{
@@ -389,8 +412,8 @@ func (vt *VolumeTool) Tools() []server.ServerTool {
 				"volume-snapshot-list",
 				mcp.WithDescription("List snapshots for a volume. Supports pagination."),
 				mcp.WithString("VolumeID", mcp.Required(), mcp.Description("The ID of the volume to list snapshots for")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultVolumeListPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultVolumeListPerPage), mcp.Description("Snapshots per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultVolumeListPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultVolumeListPerPage), mcp.Description("Snapshots per page")),
 			),
 		},
 		{
@@ -204,8 +204,8 @@ func (v *VolumeActionsTool) Tools() []server.ServerTool {
 			Tool: mcp.NewTool("volume-action-list",
 				mcp.WithDescription("List volume actions"),
 				mcp.WithString("VolumeID", mcp.Required(), mcp.Description("The ID of the volume")),
-				mcp.WithNumber("Page", mcp.DefaultNumber(defaultVolumeListPage), mcp.Description("Page number")),
-				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultVolumeListPerPage), mcp.Description("Actions per page")),
+				mcp.WithNumber("Page", mcp.Min(1), mcp.DefaultNumber(defaultVolumeListPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.Min(1), mcp.Max(200), mcp.DefaultNumber(defaultVolumeListPerPage), mcp.Description("Actions per page")),
 			),
 		},
 		{
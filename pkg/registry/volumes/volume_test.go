@@ -21,6 +21,23 @@ func setupVolumeToolWithMocks(storage *MockStorageService) *VolumeTool {
 	return NewVolumeTool(client)
 }
 
+// withPassingPreflight adds to c the services the preflight checks of volume-create look at,
+// answering that the account has room for volumes in nyc1.
+func withPassingPreflight(ctrl *gomock.Controller, c *godo.Client) *godo.Client {
+	account := NewMockAccountService(ctrl)
+	account.EXPECT().Get(gomock.Any()).Return(&godo.Account{VolumeLimit: 100}, nil, nil).AnyTimes()
+	regions := NewMockRegionsService(ctrl)
+	regions.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Region{
+		{Slug: "nyc1", Available: true, Features: []string{"storage"}},
+	}, nil, nil).AnyTimes()
+	if storage, ok := c.Storage.(*MockStorageService); ok {
+		storage.EXPECT().ListVolumes(gomock.Any(), &godo.ListVolumeParams{ListOptions: &godo.ListOptions{PerPage: 1}}).
+			Return(nil, &godo.Response{Meta: &godo.Meta{Total: 2}}, nil).AnyTimes()
+	}
+	c.Account, c.Regions = account, regions
+	return c
+}
+
 func TestVolumeTool_createVolume(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -107,7 +124,9 @@ func TestVolumeTool_createVolume(t *testing.T) {
 			if tc.mockSetup != nil {
 				tc.mockSetup(mockVolumes)
 			}
-			tool := setupVolumeToolWithMocks(mockVolumes)
+			tool := NewVolumeTool(func(ctx context.Context) (*godo.Client, error) {
+				return withPassingPreflight(ctrl, &godo.Client{Storage: mockVolumes}), nil
+			})
 			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
 			resp, err := tool.createVolume(context.Background(), req)
 
@@ -702,3 +721,50 @@ func TestVolumeTool_getSnapshotByID(t *testing.T) {
 		})
 	}
 }
+
+func TestVolumeTool_createVolumeInProject(t *testing.T) {
+	createArgs := map[string]any{"Name": "data", "SizeGigaBytes": float64(10), "Region": "nyc1", "Project": "p-1"}
+	projects := []godo.Project{{ID: "p-1", Name: "web"}}
+	created := &godo.Volume{ID: "vol-1", Name: "data"}
+	tests := []struct {
+		name        string
+		mockSetup   func(*MockStorageService, *MockProjectsService)
+		expectError bool
+	}{
+		{
+			name: "Assigned after create",
+			mockSetup: func(s *MockStorageService, p *MockProjectsService) {
+				p.EXPECT().List(gomock.Any(), gomock.Any()).Return(projects, nil, nil)
+				s.EXPECT().CreateVolume(gomock.Any(), gomock.Any()).Return(created, nil, nil)
+				p.EXPECT().AssignResources(gomock.Any(), "p-1", "do:volume:vol-1").Return(nil, nil, nil)
+			},
+		},
+		{
+			name: "Failed assignment deletes the volume",
+			mockSetup: func(s *MockStorageService, p *MockProjectsService) {
+				p.EXPECT().List(gomock.Any(), gomock.Any()).Return(projects, nil, nil)
+				s.EXPECT().CreateVolume(gomock.Any(), gomock.Any()).Return(created, nil, nil)
+				p.EXPECT().AssignResources(gomock.Any(), "p-1", "do:volume:vol-1").Return(nil, nil, errors.New("forbidden"))
+				s.EXPECT().DeleteVolume(gomock.Any(), "vol-1").Return(nil, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStorage := NewMockStorageService(ctrl)
+			mockProjects := NewMockProjectsService(ctrl)
+			tc.mockSetup(mockStorage, mockProjects)
+			tool := NewVolumeTool(func(ctx context.Context) (*godo.Client, error) {
+				return withPassingPreflight(ctrl, &godo.Client{Storage: mockStorage, Projects: mockProjects}), nil
+			})
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: createArgs}}
+			resp, err := tool.createVolume(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectError, resp.IsError)
+		})
+	}
+}
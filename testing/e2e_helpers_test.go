@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -307,9 +308,6 @@ func DeleteResource(t *testing.T, resourceType string, id any) {
 	ctx, c := getTestClient(t)
 
 	toolName := fmt.Sprintf("%s-delete", resourceType)
-	if resourceType == "snapshot" {
-		toolName = "image-delete"
-	}
 
 	args := map[string]any{
 		"ID": id,
@@ -346,8 +344,13 @@ func deleteResourceWithGodo(t *testing.T, ctx context.Context, gclient *godo.Cli
 	switch resourceType {
 	case "droplet":
 		_, err = gclient.Droplets.Delete(ctx, idInt)
-	case "image", "snapshot":
+	case "image":
 		_, err = gclient.Images.Delete(ctx, idInt)
+	case "snapshot":
+		if idString == "" {
+			idString = strconv.Itoa(idInt)
+		}
+		_, err = gclient.Snapshots.Delete(ctx, idString)
 	case "volume":
 		_, err = gclient.Storage.DeleteVolume(ctx, idString)
 	case "nfs-file-share":
@@ -548,9 +551,17 @@ func selectRegion(t *testing.T) string {
 func WaitForDropletActive(t *testing.T, dropletID int, timeout time.Duration) godo.Droplet {
 	t.Helper()
 
-	d, err := WaitForDropletCondition(t, dropletID, testhelpers.IsDropletActive, resourcePollInterval, timeout)
-	require.NoError(t, err, "WaitForDropletActive failed")
-	return *d
+	result := callTool[struct {
+		Reached bool         `json:"reached"`
+		Droplet godo.Droplet `json:"droplet"`
+	}](t, "droplet-wait", map[string]any{
+		"ID":                  float64(dropletID),
+		"Status":              "active",
+		"TimeoutSeconds":      timeout.Seconds(),
+		"PollIntervalSeconds": resourcePollInterval.Seconds(),
+	})
+	require.True(t, result.Reached, "WaitForDropletActive failed")
+	return result.Droplet
 }
 
 func WaitForImageAvailable(t *testing.T, imageID int, timeout time.Duration) godo.Image {